@@ -3,7 +3,7 @@ package cdp
 // Atom JS functions
 const (
 	atomClearInput       = `function(){("INPUT"===this.nodeName||"TEXTAREA"===this.nodeName)?this.value="":this.innerText=""}`
-	atomGetInnerText     = `function(){return this.value||this.innerText}`
+	atomGetInnerText     = `function(){if(this.value)return this.value;const s=e=>e.shadowRoot||"slot"===e.localName;if(!s(this)&&!Array.from(this.querySelectorAll("*")).some(s))return this.innerText;const h=["SCRIPT","STYLE","TEMPLATE"],w=n=>{if(3===n.nodeType)return n.textContent;if(1!==n.nodeType&&11!==n.nodeType)return"";if(11===n.nodeType)return Array.from(n.childNodes).map(w).join("");if(h.includes(n.nodeName))return"";const d=getComputedStyle(n).display;if("none"===d)return"";let t;if("slot"===n.localName&&n.assignedNodes({flatten:!0}).length)t=n.assignedNodes({flatten:!0}).map(w).join("");else t=Array.from((n.shadowRoot||n).childNodes).map(w).join("");return d.startsWith("inline")||"contents"===d?t:" "+t+" "};return w(this).replace(/\s+/g," ").trim()}`
 	atomDispatchEvents   = `function(l){for(const e of l)this.dispatchEvent(new Event(e,{'bubbles':!0}))}`
 	atomSelect           = `function(a){const b=Array.from(this.options);this.value=void 0;for(const c of b)if(c.selected=a.includes(c.value),c.selected&&!this.multiple)break}`
 	atomGetSelected      = `function(){return Array.from(this.options).filter(a=>a.selected).map(a=>a.value)}`
@@ -14,7 +14,11 @@ const (
 	atomGetComputedStyle = `function(s){return getComputedStyle(this)[s]}`
 	atomSetAttr          = `function(a,v){this.setAttribute(a,v)}`
 	atomGetAttr          = `function(a){return this.getAttribute(a)}`
-	atomIsVisible        = `function(){const b=this.getBoundingClientRect(),c=window.getComputedStyle(this);return c&&"hidden"!==c.visibility&&!c.disabled&&!!(b.top||b.bottom||b.width||b.height)}`
+	atomIsVisible        = `function(){const v=e=>{if(3===e.nodeType){const r=document.createRange();r.selectNodeContents(e);const b=r.getBoundingClientRect();return!!(b.width||b.height)}if(1!==e.nodeType)return!1;const c=window.getComputedStyle(e);if(c&&"contents"===c.display){const a="slot"===e.localName?e.assignedNodes({flatten:!0}):[];return(a.length?a:Array.from(e.childNodes)).some(v)}const b=e.getBoundingClientRect();return c&&"hidden"!==c.visibility&&!c.disabled&&!!(b.top||b.bottom||b.width||b.height)};return v(this)}`
+	atomShadowRoot       = `function(){return this.shadowRoot}`
+	atomIsStable         = `function(){return new Promise(r=>{const a=this.getBoundingClientRect();requestAnimationFrame(()=>requestAnimationFrame(()=>{const b=this.getBoundingClientRect();r(a.x===b.x&&a.y===b.y&&a.width===b.width&&a.height===b.height)}))})}`
+	atomIsEnabled        = `function(){return!(this.matches(":disabled")||"true"===this.getAttribute("aria-disabled"))}`
+	atomReceivesEvents   = `function(){const b=this.getBoundingClientRect(),x=b.left+b.width/2,y=b.top+b.height/2;let e=this.ownerDocument.elementFromPoint(x,y);for(;e&&e.shadowRoot;){const i=e.shadowRoot.elementFromPoint(x,y);if(!i||i===e)break;e=i}for(;e;e=e.parentNode||e.host)if(e===this)return!0;return!1}`
 	atomClickDone        = `function(){return this._cc}`
 	atomPreventMissClick = `function(){this._cc=!1,tt=this,z=function(b){for(var c=b;c;c=c.parentNode)if(c==tt)return!0;return!1},i=function(b){if (z(b.target)) {tt._cc=!0;} else {b.stopPropagation();b.preventDefault()}},document.addEventListener("click",i,{capture:!0,once:!0})}`
 	atomMutationObserver = `function(b,d,c){return new Promise(e=>{const f=new MutationObserver(b=>{for(var c of b){e(c.type),f.disconnect();break}});f.observe(this,{attributes:b,childList:d,subtree:c})})}`
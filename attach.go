@@ -0,0 +1,201 @@
+package cdp
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+// child target types
+const (
+	targetIFrame        = "iframe"
+	targetWorker        = "worker"
+	targetSharedWorker  = "shared_worker"
+	targetServiceWorker = "service_worker"
+)
+
+// children auto-attached targets of session (out-of-process frames and workers), shared by copies of session
+type children struct {
+	mutex   *sync.Mutex
+	targets map[string]*child // by session id
+}
+
+type child struct {
+	session *Session
+	info    *devtool.TargetInfo
+}
+
+func newChildren() *children {
+	return &children{mutex: &sync.Mutex{}, targets: map[string]*child{}}
+}
+
+func (c *children) add(ch *child) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.targets[ch.session.id] = ch
+}
+
+func (c *children) remove(sessionID string) *child {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	ch := c.targets[sessionID]
+	delete(c.targets, sessionID)
+	return ch
+}
+
+func (c *children) list() []*child {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	list := make([]*child, 0, len(c.targets))
+	for _, ch := range c.targets {
+		list = append(list, ch)
+	}
+	return list
+}
+
+// setAutoAttach attach to out-of-process frames and workers of target, they are paused until attached session is set up
+func (session Session) setAutoAttach() error {
+	return session.call("Target.setAutoAttach", Map{
+		"autoAttach":             true,
+		"waitForDebuggerOnStart": true,
+		"flatten":                true,
+	}, nil)
+}
+
+// attachedToTarget registers auto-attached child, called by listener so child's events are not lost
+func (session Session) attachedToTarget(params []byte) {
+	event := new(devtool.AttachedToTarget)
+	if err := json.Unmarshal(params, event); err != nil || event.TargetInfo == nil {
+		session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: "Target.attachedToTarget", Error: err}, params)
+		return
+	}
+	s := newSession(session.ws)
+	s.id = event.SessionID
+	s.target = event.TargetInfo.TargetID
+	s.deadline = session.deadline
+	session.ws.register(s.id, s.target, s.broadcast)
+	go s.listener()
+	session.children.add(&child{session: s, info: event.TargetInfo})
+	go func() {
+		if err := s.setupChild(event.TargetInfo.Type, event.WaitingForDebugger); err != nil {
+			s.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: s.id, Method: "Target.attachedToTarget", Error: err}, nil)
+		}
+	}()
+}
+
+// setupChild enables domains of child, child is resumed even if setup fails, otherwise its target stays paused forever
+func (session Session) setupChild(targetType string, waitingForDebugger bool) (err error) {
+	if waitingForDebugger {
+		defer func() {
+			if resumeErr := session.call("Runtime.runIfWaitingForDebugger", nil, nil); err == nil {
+				err = resumeErr
+			}
+		}()
+	}
+	if targetType == targetIFrame {
+		if err = session.setAutoAttach(); err != nil {
+			return err
+		}
+		if err = session.call("Page.enable", nil, nil); err != nil {
+			return err
+		}
+		if err = session.loadFrameTree(); err != nil {
+			return err
+		}
+		if err = session.call("Page.setLifecycleEventsEnabled", Map{"enabled": true}, nil); err != nil {
+			return err
+		}
+	}
+	return session.call("Runtime.enable", nil, nil)
+}
+
+// detachedFromTarget stops listener of detached child
+func (session Session) detachedFromTarget(params []byte) {
+	event := new(devtool.DetachedFromTarget)
+	if err := json.Unmarshal(params, event); err != nil {
+		return
+	}
+	if ch := session.children.remove(event.SessionID); ch != nil {
+		if session.switchedSession() == ch.session.id {
+			session.state.reset()
+		}
+		ch.session.broadcast.close()
+	}
+}
+
+// detachChildren stops listeners of all children when session is closed
+func (session Session) detachChildren() {
+	for _, ch := range session.children.list() {
+		session.children.remove(ch.session.id)
+		ch.session.broadcast.close()
+	}
+}
+
+// descendants returns children of session and their children with given types
+func (session Session) descendants(types ...string) []*Session {
+	var result []*Session
+	for _, ch := range session.children.list() {
+		for _, t := range types {
+			if ch.info.Type == t {
+				result = append(result, ch.session)
+				break
+			}
+		}
+		result = append(result, ch.session.descendants(types...)...)
+	}
+	return result
+}
+
+// frameSession returns session of out-of-process frame, nil if frame is rendered in process of session's target
+func (session Session) frameSession(frameID string) *Session {
+	for _, s := range session.descendants(targetIFrame) {
+		if s.target == frameID {
+			return s
+		}
+	}
+	return nil
+}
+
+// Frames returns sessions of out-of-process frames (including nested ones),
+// same-process frames are reachable with SwitchTo only
+func (session Session) Frames() []*Session {
+	return session.descendants(targetIFrame)
+}
+
+// Workers returns sessions of dedicated and shared workers of page and its out-of-process frames
+func (session Session) Workers() []*Session {
+	return session.descendants(targetWorker, targetSharedWorker)
+}
+
+// ServiceWorkers attach to all service workers of browser
+func (c Browser) ServiceWorkers() ([]*Session, error) {
+	targets, err := c.session().GetTargets()
+	if err != nil {
+		return nil, err
+	}
+	var workers []*Session
+	for _, t := range targets {
+		if t.Type != targetServiceWorker {
+			continue
+		}
+		s := c.session()
+		if err = s.attach(t.TargetID); err != nil {
+			c.detach(workers)
+			return nil, err
+		}
+		workers = append(workers, s)
+		if err = s.call("Runtime.enable", nil, nil); err != nil {
+			c.detach(workers)
+			return nil, err
+		}
+	}
+	return workers, nil
+}
+
+// detach detaches sessions from their targets, listeners are stopped by Target.detachedFromTarget
+func (c Browser) detach(sessions []*Session) {
+	for _, s := range sessions {
+		_ = c.session().call("Target.detachFromTarget", Map{"sessionId": s.id}, nil)
+	}
+}
@@ -1,19 +1,17 @@
 package cdp
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
-	"os/exec"
 	"strings"
 	"time"
+
+	"github.com/ecwid/cdp/pkg/devtool"
 )
 
 // BrowserTarget ...
@@ -39,10 +37,12 @@ type BrowserVersion struct {
 
 // Browser ...
 type Browser struct {
-	url      *url.URL
-	cmd      *exec.Cmd
-	wsClient *WSClient
-	deadline time.Duration
+	url               *url.URL
+	process           *process
+	wsClient          *WSClient
+	deadline          time.Duration
+	userDataDir       string
+	removeUserDataDir bool
 }
 
 // GetWSClient ...
@@ -50,51 +50,116 @@ func (c Browser) GetWSClient() *WSClient {
 	return c.wsClient
 }
 
+// session returns browser-wide session that is not attached to any target
+func (c Browser) session() *Session {
+	s := newSession(c.wsClient)
+	s.deadline = c.deadline
+	return s
+}
+
 // Crash ...
 func (c Browser) Crash() {
-	c.wsClient.sendOverProtocol("", "Browser.crash", nil)
+	c.wsClient.sendOverProtocol(context.Background(), "", "Browser.crash", nil)
 }
 
 func (c Browser) request(path string, response interface{}) error {
-	r, err := http.Get("http://" + c.url.Host + path)
+	return getJSON(httpEndpoint(c.url)+path, response)
+}
+
+func getJSON(url string, response interface{}) error {
+	r, err := http.Get(url)
 	if err != nil {
 		return err
 	}
+	defer r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s responded with %s", url, r.Status)
+	}
 	return json.NewDecoder(r.Body).Decode(response)
 }
 
+// httpEndpoint converts devtools url (websocket or http) to http base url of browser
+func httpEndpoint(u *url.URL) string {
+	scheme := "http"
+	if u.Scheme == "wss" || u.Scheme == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + u.Host
+}
+
 // GetVersion ...
 func (c Browser) GetVersion() (BrowserVersion, error) {
+	if c.url == nil {
+		return c.getVersion()
+	}
 	var result = BrowserVersion{}
 	err := c.request("/json/version", &result)
 	return result, err
 }
 
+// getVersion get version over protocol, used when browser has no http endpoint (e.g. pipe transport)
+func (c Browser) getVersion() (BrowserVersion, error) {
+	var version = new(devtool.Version)
+	if err := c.session().call("Browser.getVersion", nil, version); err != nil {
+		return BrowserVersion{}, err
+	}
+	return BrowserVersion{
+		Browser:         version.Product,
+		ProtocolVersion: version.ProtocolVersion,
+		UserAgent:       version.UserAgent,
+		V8Version:       version.JsVersion,
+	}, nil
+}
+
 // GetTargets ...
 func (c Browser) GetTargets() ([]BrowserTarget, error) {
+	if c.url == nil {
+		return c.getTargets()
+	}
 	var result = []BrowserTarget{}
 	err := c.request("/json", &result)
 	return result, err
 }
 
+// getTargets get targets over protocol, used when browser has no http endpoint (e.g. pipe transport)
+func (c Browser) getTargets() ([]BrowserTarget, error) {
+	targets, err := c.session().GetTargets()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]BrowserTarget, len(targets))
+	for n, t := range targets {
+		result[n] = BrowserTarget{ID: t.TargetID, Title: t.Title, Type: t.Type, URL: t.URL}
+	}
+	return result, nil
+}
+
 // Session ...
 func (c Browser) Session() (*Session, error) {
+	return c.SessionContext(context.Background())
+}
+
+// SessionContext same as Session but ctx cancels waiting for page target and attaching to it
+func (c Browser) SessionContext(ctx context.Context) (*Session, error) {
 	tick := time.NewTicker(250 * time.Millisecond)
 	timeout := time.NewTimer(c.deadline)
 	defer tick.Stop()
 	defer timeout.Stop()
 	for {
 		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
 		case <-timeout.C:
 			return nil, ErrNoPageTarget
 		case <-tick.C:
-			targets, err := c.GetTargets()
+			// over protocol, so session lookup is recorded and works with any transport
+			targets, err := c.getTargets()
 			if err != nil {
 				return nil, err
 			}
 			for _, t := range targets {
 				if t.Type == "page" {
-					return NewSession(&Session{ws: c.wsClient}, t.ID)
+					return NewSession(&Session{ws: c.wsClient, ctx: ctx}, t.ID)
 				}
 			}
 		}
@@ -102,131 +167,98 @@ func (c Browser) Session() (*Session, error) {
 }
 
 // Close close browser
+// If browser was connected with Connect or ConnectHTTP only websocket connection is closed
 func (c Browser) Close() error {
+	if c.process == nil {
+		return c.wsClient.Close()
+	}
 	// Close close browser and websocket connection
-	exited := make(chan int)
-	go func() {
-		state, _ := c.cmd.Process.Wait()
-		exited <- state.ExitCode()
-	}()
-	c.wsClient.sendOverProtocol("", "Browser.close", nil)
+	cleanup := &CloseError{}
+	c.wsClient.markClosing()
+	c.wsClient.sendOverProtocol(context.Background(), "", "Browser.close", nil)
 	select {
-	case <-exited:
-		return nil
+	case <-c.process.exited:
+		// browser is gone but renderers or helpers from its group can be still alive,
+		// browser is not reaped yet, so its group can be killed safely
+		_ = c.process.kill()
+		c.process.reap()
+		// killed processes are still in group until they are reaped by init
+		cleanup.OrphansKilled = processGroupAlive(c.process.cmd.Process)
+		c.process.wait()
 	case <-time.After(c.deadline):
-		if err := c.cmd.Process.Kill(); err != nil {
-			return err
+		cleanup.Killed = true
+		if err := c.process.kill(); err != nil {
+			cleanup.add(err)
+		}
+		select {
+		case <-c.process.exited:
+			c.process.wait()
+		case <-time.After(c.deadline):
+			cleanup.add(errors.New("browser process was not reaped after kill"))
 		}
-		return errors.New("browser is not closing gracefully, process was killed")
 	}
+	if c.removeUserDataDir {
+		if err := os.RemoveAll(c.userDataDir); err != nil {
+			cleanup.add(err)
+		} else {
+			cleanup.ProfileRemoved = c.userDataDir
+		}
+	}
+	if cleanup.Killed || cleanup.OrphansKilled || len(cleanup.Errors) > 0 {
+		return cleanup
+	}
+	return nil
 }
 
-// Launch launch a new browser process
-func Launch(ctx context.Context, userFlags ...string) (*Browser, error) {
+// Connect connect to already running browser by its websocket debugger url,
+// for example ws://127.0.0.1:9222/devtools/browser/a9e1dbc5-1c9f-4b6b-8f2a-e1b8e9a9d8c4
+func Connect(ctx context.Context, webSocketURL string) (*Browser, error) {
+	var err error
 	browser := &Browser{deadline: 10 * time.Second}
-	var path string
-	bin := []string{
-		"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
-		"/usr/bin/google-chrome",
-		"headless-shell",
-		"chromium",
-		"chromium-browser",
-		"google-chrome",
-		"google-chrome-stable",
-		"google-chrome-beta",
-		"google-chrome-unstable",
-	}
-	for _, c := range bin {
-		if _, err := exec.LookPath(c); err == nil {
-			path = c
-			break
-		}
+	if browser.url, err = url.Parse(webSocketURL); err != nil {
+		return nil, err
 	}
-
-	userDataDir, err := ioutil.TempDir("", "tmp")
-	if err != nil {
+	if browser.wsClient, err = newWebSocketClient(ctx, webSocketURL); err != nil {
 		return nil, err
 	}
+	return browser, nil
+}
 
-	// https: //github.com/GoogleChrome/chrome-launcher/blob/master/docs/chrome-flags-for-tools.md
-	flags := []string{
-		"about:blank", // open url
-		"--no-first-run",
-		"--no-default-browser-check",
-		"--remote-debugging-port=0",
-		"--hide-scrollbars",
-		"--mute-audio",
-		"--password-store=basic",
-		"--use-mock-keychain",
-		"--enable-automation",
-		"--disable-gpu",
-		"--disable-sync",
-		"--disable-background-networking",
-		"--disable-default-apps",
-		"--disable-extensions",
-		"--disable-browser-side-navigation",
-		"--disable-background-timer-throttling",
-		"--disable-backgrounding-occluded-windows",
-		"--disable-renderer-backgrounding",
-		"--disable-hang-monitor",
-		"--disable-breakpad",
-		"--disable-client-side-phishing-detection",
-		"--disable-component-extensions-with-background-pages",
-		"--disable-ipc-flooding-protection",
-		"--disable-prompt-on-repost",
-		"--metrics-recording-only",
-		"--disable-features=site-per-process,TranslateUI,BlinkGenPropertyTrees",
-		"--enable-features=NetworkService,NetworkServiceInProcess",
-		"--user-data-dir=" + userDataDir,
-	}
-
-	flags = append(flags, userFlags...)
-	if os.Getuid() == 0 {
-		flags = append(flags, "--no-sandbox")
-	}
-
-	browser.cmd = exec.CommandContext(ctx, path, flags...)
-	stderr, err := browser.cmd.StderrPipe()
+// ConnectTransport use already established transport (e.g. ReplayTransport),
+// browser has no http endpoint so GetVersion and GetTargets are requested over protocol
+func ConnectTransport(transport Transport) *Browser {
+	return &Browser{deadline: 10 * time.Second, wsClient: NewClient(transport)}
+}
+
+// ConnectHTTP connect to already running browser by its http endpoint, for example http://127.0.0.1:9222
+// or just 127.0.0.1:9222, websocket urls are not accepted (use Connect).
+// websocket debugger url is resolved with /json/version, host of resolved url is replaced with endpoint's one
+// because browser reports its own listening address which is often unreachable from outside (docker etc.)
+func ConnectHTTP(endpoint string) (*Browser, error) {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
 	if err != nil {
 		return nil, err
 	}
-	defer stderr.Close()
-	if err := browser.cmd.Start(); err != nil {
-		return nil, err
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("%s is not http endpoint, use Connect for websocket url", endpoint)
 	}
-	webSocketURL, err := addrFromStderr(stderr)
-	if err != nil {
-		return nil, err
+	if u.Host == "" {
+		return nil, fmt.Errorf("%s has no host", endpoint)
 	}
-	browser.url, err = url.Parse(webSocketURL)
-	if err != nil {
+	var version = BrowserVersion{}
+	if err = getJSON(httpEndpoint(u)+"/json/version", &version); err != nil {
 		return nil, err
 	}
-	browser.wsClient, err = NewWebSocketClient(webSocketURL)
-	return browser, err
-}
-
-func addrFromStderr(rc io.ReadCloser) (string, error) {
-	defer rc.Close()
-	const prefix = "DevTools listening on"
-	var (
-		url     = ""
-		scanner = bufio.NewScanner(rc)
-		lines   []string
-	)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if s := strings.TrimPrefix(line, prefix); s != line {
-			url = strings.TrimSpace(s)
-			break
-		}
-		lines = append(lines, line)
-	}
-	if err := scanner.Err(); err != nil {
-		return "", err
+	if version.WebSocketDebuggerURL == "" {
+		return nil, fmt.Errorf("%s has no webSocketDebuggerUrl", endpoint)
 	}
-	if url == "" {
-		return "", fmt.Errorf("chrome stopped too early; stderr:\n%s", strings.Join(lines, "\n"))
+	ws, err := url.Parse(version.WebSocketDebuggerURL)
+	if err != nil {
+		return nil, err
 	}
-	return url, nil
+	ws.Host = u.Host
+	return Connect(context.Background(), ws.String())
 }
@@ -0,0 +1,93 @@
+package cdp
+
+import (
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+// BrowserContext isolated (incognito-like) browser context, cookies and storage are not shared with other contexts
+type BrowserContext struct {
+	ID      string
+	browser Browser
+}
+
+// NewContext create new browser context https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createBrowserContext
+func (c Browser) NewContext(opts *devtool.BrowserContextOptions) (*BrowserContext, error) {
+	if opts == nil {
+		opts = &devtool.BrowserContextOptions{}
+	}
+	result := new(devtool.BrowserContext)
+	if err := c.session().call("Target.createBrowserContext", opts, result); err != nil {
+		return nil, err
+	}
+	return &BrowserContext{ID: result.BrowserContextID, browser: c}, nil
+}
+
+// NewPage open new tab in this context and attach to it
+func (bc BrowserContext) NewPage(url string) (*Session, error) {
+	if url == "" {
+		url = blankPage // headless chrome crash when url is empty
+	}
+	session := bc.browser.session()
+	result := new(devtool.CreatedTarget)
+	if err := session.call("Target.createTarget", Map{"url": url, "browserContextId": bc.ID}, result); err != nil {
+		return nil, err
+	}
+	return NewSession(session, result.TargetID)
+}
+
+// Targets returns targets of this context
+func (bc BrowserContext) Targets() ([]*devtool.TargetInfo, error) {
+	all, err := bc.browser.session().GetTargets()
+	if err != nil {
+		return nil, err
+	}
+	var targets []*devtool.TargetInfo
+	for _, t := range all {
+		if t.BrowserContextID == bc.ID {
+			targets = append(targets, t)
+		}
+	}
+	return targets, nil
+}
+
+// Cookies returns all cookies of this context
+func (bc BrowserContext) Cookies() ([]*devtool.Cookie, error) {
+	cookies := new(devtool.GetCookies)
+	if err := bc.browser.session().call("Storage.getCookies", Map{"browserContextId": bc.ID}, cookies); err != nil {
+		return nil, err
+	}
+	return cookies.Cookies, nil
+}
+
+// SetCookies set cookies in this context
+func (bc BrowserContext) SetCookies(cookies ...*devtool.Cookie) error {
+	return bc.browser.session().call("Storage.setCookies", Map{"cookies": cookies, "browserContextId": bc.ID}, nil)
+}
+
+// ClearCookies clear all cookies of this context
+func (bc BrowserContext) ClearCookies() error {
+	return bc.browser.session().call("Storage.clearCookies", Map{"browserContextId": bc.ID}, nil)
+}
+
+// SetPermissions grant permissions to origin in this context, all other permissions are denied
+// empty origin means all origins https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-grantPermissions
+func (bc BrowserContext) SetPermissions(origin string, permissions ...devtool.PermissionType) error {
+	p := Map{
+		"permissions":      permissions,
+		"browserContextId": bc.ID,
+	}
+	if origin != "" {
+		p["origin"] = origin
+	}
+	return bc.browser.session().call("Browser.grantPermissions", p, nil)
+}
+
+// ResetPermissions reset all permission management for this context
+func (bc BrowserContext) ResetPermissions() error {
+	return bc.browser.session().call("Browser.resetPermissions", Map{"browserContextId": bc.ID}, nil)
+}
+
+// Close dispose context and close all its targets
+func (bc BrowserContext) Close() error {
+	return bc.browser.session().call("Target.disposeBrowserContext", Map{"browserContextId": bc.ID}, nil)
+}
@@ -0,0 +1,112 @@
+package cdp
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+// crashState crash of session's target, shared by copies of session
+type crashState struct {
+	mutex    *sync.Mutex
+	incident *crashIncident
+}
+
+// crashIncident done is closed when target crashed, new incident is started after recovery
+type crashIncident struct {
+	done chan struct{}
+	err  *TargetCrashedError
+}
+
+func newCrashState() *crashState {
+	return &crashState{
+		mutex:    &sync.Mutex{},
+		incident: &crashIncident{done: make(chan struct{})},
+	}
+}
+
+// current returns incident in progress and its error, error is nil until target crashed
+func (c *crashState) current() (*crashIncident, *TargetCrashedError) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.incident, c.incident.err
+}
+
+func (c *crashState) set(err *TargetCrashedError) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.incident.err == nil {
+		c.incident.err = err
+		close(c.incident.done)
+	}
+}
+
+func (c *crashState) reset() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.incident.err != nil {
+		c.incident = &crashIncident{done: make(chan struct{})}
+	}
+}
+
+// targetCrashed handles Target.targetCrashed, every session with target discovery receives crashes of all targets
+func (session Session) targetCrashed(params []byte) {
+	event := new(devtool.TargetCrashed)
+	if err := json.Unmarshal(params, event); err != nil {
+		session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: "Target.targetCrashed", Error: err}, params)
+		return
+	}
+	if event.TargetID != session.target {
+		return
+	}
+	err := &TargetCrashedError{TargetID: event.TargetID, Status: event.Status, ErrorCode: event.ErrorCode}
+	session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: "Target.targetCrashed", Error: err}, nil)
+	session.crash.set(err)
+}
+
+// Crashed returns crash of session's target, nil if target is alive or was reloaded after crash
+func (session Session) Crashed() *TargetCrashedError {
+	_, err := session.crash.current()
+	return err
+}
+
+// OnCrash subscribe to crash of session's target, calls in progress fail with TargetCrashedError,
+// session is not closed and target can be reloaded with Reload or replaced with Recreate
+func (session *Session) OnCrash(cb func(*TargetCrashedError)) (unsubscribe func()) {
+	return session.OnTargetTargetCrashed(func(e *protocol.TargetTargetCrashedEvent) {
+		if string(e.TargetID) == session.target {
+			cb(&TargetCrashedError{TargetID: session.target, Status: e.Status, ErrorCode: e.ErrorCode})
+		}
+	}, nil)
+}
+
+// Recreate open new tab with url of session's target in the same browser context and close the target,
+// it is useful when crashed renderer can not be reloaded
+func (session Session) Recreate() (*Session, error) {
+	info, err := (&protocol.TargetGetTargetInfoParams{TargetID: protocol.TargetTargetID(session.target)}).Do(session)
+	if err != nil {
+		return nil, err
+	}
+	url := info.TargetInfo.URL
+	if url == "" {
+		url = blankPage
+	}
+	browser := newSession(session.ws)
+	browser.ctx = session.Context()
+	created, err := (&protocol.TargetCreateTargetParams{
+		URL:              url,
+		BrowserContextID: info.TargetInfo.BrowserContextID,
+	}).Do(browser)
+	if err != nil {
+		return nil, err
+	}
+	recreated, err := NewSession(browser, string(created.TargetID))
+	if err != nil {
+		return nil, err
+	}
+	recreated.deadline = session.deadline
+	_ = browser.call("Target.closeTarget", Map{"targetId": session.target}, nil)
+	return recreated, nil
+}
@@ -0,0 +1,228 @@
+package cdp
+
+import (
+	"container/list"
+	"sync"
+)
+
+// OverflowPolicy what to do with event when subscriber's queue is full
+type OverflowPolicy int
+
+// overflow policies
+const (
+	OverflowBlock OverflowPolicy = iota // wait for subscriber, it delays dispatching of session's events but not other sessions, event is dropped as with OverflowError if session's queue is full
+	OverflowDrop                        // drop event silently
+	OverflowError                       // drop event and report ErrSubscriberOverflow
+)
+
+// SubscribeOptions options of event subscription
+type SubscribeOptions struct {
+	QueueSize int            // size of subscriber's queue, 64 if zero
+	Overflow  OverflowPolicy // OverflowBlock by default
+	OnError   func(error)    // receives subscription errors, such as ErrSubscriberOverflow
+}
+
+const (
+	defaultQueueSize = 64
+	sessionQueueSize = 4096
+)
+
+// eventQueue queue of session's events, client never blocks on publishing into it.
+// When queue is full, blocked subscribers are notified with overflow so session's listener drains it,
+// thus queue is bounded by its limit plus events published while listener wakes up
+type eventQueue struct {
+	mutex    *sync.Mutex
+	events   []*wsBroadcast
+	limit    int
+	ready    chan struct{}
+	overflow chan struct{}
+	closed   chan struct{}
+	once     *sync.Once
+}
+
+func newEventQueue() *eventQueue {
+	return &eventQueue{
+		mutex:    &sync.Mutex{},
+		limit:    sessionQueueSize,
+		ready:    make(chan struct{}, 1),
+		overflow: make(chan struct{}, 1),
+		closed:   make(chan struct{}),
+		once:     &sync.Once{},
+	}
+}
+
+func (q *eventQueue) push(e *wsBroadcast) {
+	q.mutex.Lock()
+	q.events = append(q.events, e)
+	full := len(q.events) >= q.limit
+	q.mutex.Unlock()
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	if full {
+		select {
+		case q.overflow <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (q *eventQueue) isFull() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return len(q.events) >= q.limit
+}
+
+// pop blocks until next event, returns false if queue was closed
+func (q *eventQueue) pop() (*wsBroadcast, bool) {
+	for {
+		q.mutex.Lock()
+		if len(q.events) > 0 {
+			e := q.events[0]
+			q.events[0] = nil
+			q.events = q.events[1:]
+			q.mutex.Unlock()
+			return e, true
+		}
+		q.mutex.Unlock()
+		select {
+		case <-q.ready:
+		case <-q.closed:
+			return nil, false
+		}
+	}
+}
+
+func (q *eventQueue) close() {
+	q.once.Do(func() { close(q.closed) })
+}
+
+// subscriber receives events in its own goroutine from bounded queue
+type subscriber struct {
+	queue    chan *Event
+	callback func(*Event)
+	opts     SubscribeOptions
+	done     chan struct{}
+	once     *sync.Once
+}
+
+func (s *subscriber) run() {
+	for {
+		select {
+		case e := <-s.queue:
+			// both channels can be ready, event must not be delivered after unsubscribe
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			s.callback(e)
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *subscriber) stop() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// deliver puts event into subscriber's queue according to overflow policy, session is session's queue of events
+func (s *subscriber) deliver(e *Event, session *eventQueue) {
+	select {
+	case s.queue <- e:
+		return
+	case <-s.done:
+		return
+	default:
+	}
+	switch s.opts.Overflow {
+	case OverflowBlock:
+		for {
+			select {
+			case s.queue <- e:
+				return
+			case <-s.done:
+				return
+			case <-session.overflow:
+				// signal can be left from queue that was drained since then
+				if session.isFull() {
+					s.overflowed()
+					return
+				}
+			}
+		}
+	case OverflowError:
+		s.overflowed()
+	}
+}
+
+func (s *subscriber) overflowed() {
+	if s.opts.OnError != nil {
+		s.opts.OnError(ErrSubscriberOverflow)
+	}
+}
+
+// Subscribe subscribe to CDP event, callback is called in its own goroutine so it can be slow
+// and can subscribe or unsubscribe, events are delivered in order.
+// Call in progress is not waited by unsubscribe
+func (session *Session) Subscribe(method string, cb func(event *Event)) (unsubscribe func()) {
+	return session.SubscribeWithOptions(method, cb, nil)
+}
+
+// SubscribeWithOptions same as Subscribe with queue size and overflow policy
+func (session *Session) SubscribeWithOptions(method string, cb func(event *Event), opts *SubscribeOptions) (unsubscribe func()) {
+	s := &subscriber{
+		callback: cb,
+		done:     make(chan struct{}),
+		once:     &sync.Once{},
+	}
+	if opts != nil {
+		s.opts = *opts
+	}
+	if s.opts.QueueSize <= 0 {
+		s.opts.QueueSize = defaultQueueSize
+	}
+	s.queue = make(chan *Event, s.opts.QueueSize)
+	go s.run()
+
+	session.eventsMutex.Lock()
+	defer session.eventsMutex.Unlock()
+	if _, has := session.listeners[method]; !has {
+		session.listeners[method] = list.New()
+	}
+	p := session.listeners[method].PushBack(s)
+	return func() {
+		session.eventsMutex.Lock()
+		defer session.eventsMutex.Unlock()
+		session.listeners[method].Remove(p)
+		s.stop()
+	}
+}
+
+// dispatch delivers event to subscribers of its method, lock is not held while delivering
+func (session Session) dispatch(e *Event) {
+	session.eventsMutex.Lock()
+	var subscribers []*subscriber
+	if list, has := session.listeners[e.Method]; has {
+		for p := list.Front(); p != nil; p = p.Next() {
+			subscribers = append(subscribers, p.Value.(*subscriber))
+		}
+	}
+	session.eventsMutex.Unlock()
+	for _, s := range subscribers {
+		s.deliver(e, session.broadcast)
+	}
+}
+
+// stopSubscribers stops goroutines of all subscribers when session is closed
+func (session Session) stopSubscribers() {
+	session.eventsMutex.Lock()
+	defer session.eventsMutex.Unlock()
+	for _, list := range session.listeners {
+		for p := list.Front(); p != nil; p = p.Next() {
+			p.Value.(*subscriber).stop()
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package cdp
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -20,12 +21,12 @@ type Element struct {
 	context     int64
 }
 
-func newElement(s *Session, parent *Element, re *devtool.RemoteObject) *Element {
+func newElement(s *Session, context int64, re *devtool.RemoteObject) *Element {
 	var e = &Element{
 		ID:          re.ObjectID,
 		description: re.Description,
 		session:     s,
-		context:     s.currentContext(),
+		context:     context,
 	}
 	return e
 }
@@ -34,6 +35,13 @@ func (e *Element) call(functionDeclaration string, arg ...interface{}) (*devtool
 	return e.session.callFunctionOn(e.ID, functionDeclaration, true, false, arg...)
 }
 
+// WithContext returns copy of element which calls are cancelled by ctx
+func (e *Element) WithContext(ctx context.Context) *Element {
+	c := *e
+	c.session = e.session.WithContext(ctx)
+	return &c
+}
+
 // Description ...
 func (e *Element) Description() string {
 	return e.description
@@ -79,17 +87,31 @@ func (e *Element) clickablePoint() (x float64, y float64, err error) {
 
 // Click ...
 func (e *Element) Click() error {
-	if err := e.ScrollIntoViewIfNeeded(); err != nil {
-		return err
-	}
-	x, y, err := e.clickablePoint()
+	x, y, err := e.clickTarget()
 	if err != nil {
 		return err
 	}
+	return e.clickAt(x, y)
+}
+
+// clickTarget scrolls element into view and returns point to click, clicks which miss element are prevented
+func (e *Element) clickTarget() (x float64, y float64, err error) {
+	if err = e.ScrollIntoViewIfNeeded(); err != nil {
+		return -1, -1, err
+	}
+	if x, y, err = e.clickablePoint(); err != nil {
+		return -1, -1, err
+	}
 	if _, err = e.call(atomPreventMissClick); err != nil {
-		return err
+		return -1, -1, err
 	}
-	if err = e.session.dispatchMouseEvent(x, y, dispatchMouseEventMoved, "none"); err != nil {
+	return x, y, nil
+}
+
+// clickAt clicks point returned by clickTarget, ErrMissClick is returned if click was prevented
+func (e *Element) clickAt(x, y float64) error {
+	err := e.session.dispatchMouseEvent(x, y, dispatchMouseEventMoved, "none")
+	if err != nil {
 		return err
 	}
 	if err = e.session.dispatchMouseEvent(x, y, dispatchMouseEventPressed, "left"); err != nil {
@@ -105,7 +127,7 @@ func (e *Element) Click() error {
 		}
 		return ErrMissClick
 	}
-	if err == ErrSessionAlreadyClosed || err == ErrStaleElementReference {
+	if errors.Is(err, ErrSessionAlreadyClosed) || errors.Is(err, ErrTargetClosed) || errors.Is(err, ErrStaleElementReference) {
 		return nil
 	}
 	return err
@@ -123,10 +145,29 @@ func (e *Element) GetFrameID() (string, error) {
 	return node.FrameID, nil
 }
 
+// ShadowRoot returns open shadow root of element, it is used to query elements of shadow tree
+func (e *Element) ShadowRoot() (*Element, error) {
+	v, err := e.call(atomShadowRoot)
+	if err != nil {
+		return nil, err
+	}
+	if v.ObjectID == "" {
+		return nil, ErrNoShadowRoot
+	}
+	return newElement(e.session, e.context, v), nil
+}
+
 // IsVisible is element visible (element has area that clickable in viewport)
 func (e *Element) IsVisible() (bool, error) {
 	if _, err := e.session.GetContentQuads(e.ID, false); err != nil {
-		return false, err
+		// element with display: contents (e.g. slot) has no box, it is visible if its content is visible
+		display, styleErr := e.GetComputedStyle("display")
+		if styleErr != nil {
+			return false, styleErr
+		}
+		if display != "contents" {
+			return false, err
+		}
 	}
 	val, err := e.call(atomIsVisible)
 	if err != nil {
@@ -147,6 +188,26 @@ func (e *Element) Hover() error {
 	return e.session.MouseMove(x, y)
 }
 
+// DragTo drags element with mouse to the middle of target, see DragAndDrop
+func (e *Element) DragTo(target *Element) error {
+	if err := e.ScrollIntoViewIfNeeded(); err != nil {
+		return err
+	}
+	if err := target.ScrollIntoViewIfNeeded(); err != nil {
+		return err
+	}
+	// scrolling to target can move element
+	x, y, err := e.clickablePoint()
+	if err != nil {
+		return err
+	}
+	tx, ty, err := target.clickablePoint()
+	if err != nil {
+		return err
+	}
+	return e.session.DragAndDrop(devtool.Point{X: x, Y: y}, devtool.Point{X: tx, Y: ty}, dragSteps)
+}
+
 // Clear ...
 func (e *Element) Clear() error {
 	_, err := e.call(atomClearInput)
@@ -362,10 +423,10 @@ func (e *Element) GetEventListeners() ([]string, error) {
 
 // Query ...
 func (e *Element) Query(selector string) (*Element, error) {
-	return e.session.query(e, selector)
+	return e.session.query(e.context, e, selector)
 }
 
 // QueryAll ...
 func (e *Element) QueryAll(selector string) ([]*Element, error) {
-	return e.session.queryAll(e, selector)
+	return e.session.queryAll(e.context, e, selector)
 }
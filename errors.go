@@ -1,8 +1,11 @@
 package cdp
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
 // NoSuchElementError ..
@@ -16,6 +19,166 @@ func (e NoSuchElementError) Error() string {
 	return fmt.Sprintf("no such element %s", e.selector)
 }
 
+// CloseError describes what was forcibly cleaned up by Browser.Close
+type CloseError struct {
+	Killed         bool    // browser was not closing gracefully and its process group was killed
+	OrphansKilled  bool    // browser exited but some processes of its group were still alive and were killed
+	ProfileRemoved string  // temporary user data dir that was removed
+	Errors         []error // errors happened during clean up
+}
+
+func (e *CloseError) add(err error) {
+	e.Errors = append(e.Errors, err)
+}
+
+func (e *CloseError) Error() string {
+	var msg []string
+	if e.Killed {
+		msg = append(msg, "browser is not closing gracefully, process was killed")
+	}
+	if e.OrphansKilled {
+		msg = append(msg, "orphaned browser processes were killed")
+	}
+	for _, err := range e.Errors {
+		msg = append(msg, err.Error())
+	}
+	return strings.Join(msg, "; ")
+}
+
+// PoolCloseError errors of browsers closed by Pool.Close
+type PoolCloseError struct {
+	Errors []error
+}
+
+func (e *PoolCloseError) Error() string {
+	msg := make([]string, len(e.Errors))
+	for n, err := range e.Errors {
+		msg[n] = err.Error()
+	}
+	return strings.Join(msg, "; ")
+}
+
+// ProtocolError error returned by browser in response to command
+type ProtocolError struct {
+	Code      int         // json-rpc error code, e.g. -32000 for server error
+	Message   string      // error message as is
+	Data      string      // additional details if any
+	Method    string      // command that failed
+	Params    interface{} // params of command that failed
+	SessionID string      // session of command, empty for browser session
+}
+
+func (e *ProtocolError) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.Method, e.Message)
+	if e.Data != "" {
+		msg += " (" + e.Data + ")"
+	}
+	return msg
+}
+
+// Unwrap returns package error matching protocol message, so that errors.Is(err, ErrStaleElementReference) works
+func (e *ProtocolError) Unwrap() error {
+	return classify(e.Code, e.Message)
+}
+
+// well known messages of chrome, matched by prefix
+var protocolMessages = []struct {
+	prefix string
+	err    error
+}{
+	{"Cannot find context with specified id", ErrStaleElementReference},
+	{"Execution context was destroyed", ErrStaleElementReference},
+	{"Could not find node with given id", ErrNodeNotFound},
+	{"No node with given id found", ErrNodeNotFound},
+	{"Node with given id does not belong to the document", ErrNodeNotFound},
+	{"No node found for given backend id", ErrNodeNotFound},
+	{"Target closed", ErrTargetClosed},
+	{"No target with given id found", ErrTargetClosed},
+	{"Session with given id not found", ErrTargetClosed},
+	{"Inspected target navigated or closed", ErrTargetClosed},
+	{"net::ERR_ABORTED", ErrNavigationAborted},
+	{"Navigation was aborted", ErrNavigationAborted},
+}
+
+func classify(code int, message string) error {
+	if code == connectionLostCode {
+		return ErrConnectionClosed
+	}
+	for _, m := range protocolMessages {
+		if strings.HasPrefix(message, m.prefix) {
+			return m.err
+		}
+	}
+	return nil
+}
+
+// TimeoutError response or event was not received in session's timeout
+type TimeoutError struct {
+	Method   string
+	Params   interface{}
+	Deadline time.Duration
+	Err      error // sentinel of specific timeout, e.g. ErrLoadTimeout, nil for response timeout
+}
+
+func (e *TimeoutError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%v %s for %s", e.Err, e.Deadline.String(), e.Method)
+	}
+	return fmt.Sprintf("response timeout was reached %s for %s(%+v)", e.Deadline.String(), e.Method, e.Params)
+}
+
+// Unwrap returns sentinel of specific timeout, so errors.Is(err, ErrLoadTimeout) still works
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout reports error is timeout, as net.Error does
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// Is matches context.DeadlineExceeded, so both session and context timeouts can be checked the same way
+func (e *TimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// LocatorTimeoutError element of locator was not found or was not actionable in locator's timeout
+type LocatorTimeoutError struct {
+	Locator  string
+	Deadline time.Duration
+	Err      error // reason of the last attempt
+}
+
+func (e *LocatorTimeoutError) Error() string {
+	return fmt.Sprintf("locator timeout was reached %s for %s: %v", e.Deadline.String(), e.Locator, e.Err)
+}
+
+// Unwrap returns reason of the last attempt, e.g. NoSuchElementError or ErrElementInvisible
+func (e *LocatorTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout reports error is timeout, as net.Error does
+func (e *LocatorTimeoutError) Timeout() bool {
+	return true
+}
+
+// Is matches context.DeadlineExceeded as TimeoutError does
+func (e *LocatorTimeoutError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// TargetCrashedError renderer of session's target crashed
+type TargetCrashedError struct {
+	TargetID  string
+	Status    string // termination status, e.g. "crashed", "killed", "oom"
+	ErrorCode int64
+}
+
+func (e *TargetCrashedError) Error() string {
+	return fmt.Sprintf("target %s crashed with status %s (error code %d)", e.TargetID, e.Status, e.ErrorCode)
+}
+
 // cdp errors
 var (
 	ErrStaleElementReference  = errors.New("referenced element is no longer attached to the DOM") // cannot find context with specified id
@@ -29,4 +192,14 @@ var (
 	ErrTargetCreatedTimeout   = errors.New("target creation timeout was reached")
 	ErrLoadTimeout            = errors.New("load state timeout was reached")
 	ErrContextDetached        = errors.New("frame was detached")
+	ErrFrameNotFound          = errors.New("matching frame was not found")
+	ErrNoShadowRoot           = errors.New("element has no open shadow root")
+	ErrElementNotStable       = errors.New("element is moving or resizing")
+	ErrElementDisabled        = errors.New("element is disabled")
+	ErrElementObscured        = errors.New("element does not receive pointer events, it is covered by other element")
+	ErrNodeNotFound           = errors.New("node with given id was not found")
+	ErrTargetClosed           = errors.New("target was closed")
+	ErrNavigationAborted      = errors.New("navigation was aborted")
+	ErrPoolClosed             = errors.New("pool was closed")
+	ErrSubscriberOverflow     = errors.New("event was dropped, subscriber's queue is full")
 )
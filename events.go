@@ -0,0 +1,247 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+// registry of event types set by RegisterEventType, events missing here are decoded into generated protocol types
+var eventTypes = struct {
+	mutex *sync.RWMutex
+	types map[string]func() interface{}
+}{
+	mutex: &sync.RWMutex{},
+	types: map[string]func() interface{}{},
+}
+
+// RegisterEventType sets type which params of event are decoded into, newValue must return pointer
+func RegisterEventType(method string, newValue func() interface{}) {
+	eventTypes.mutex.Lock()
+	defer eventTypes.mutex.Unlock()
+	eventTypes.types[method] = newValue
+}
+
+// NewEvent returns pointer to new value of event's registered type, false if event is unknown
+func NewEvent(method string) (interface{}, bool) {
+	eventTypes.mutex.RLock()
+	newValue, has := eventTypes.types[method]
+	eventTypes.mutex.RUnlock()
+	if has {
+		return newValue(), true
+	}
+	return protocol.NewEvent(method)
+}
+
+// SubscribeEvent subscribe to CDP event with params decoded into generated protocol type of event (or type set by RegisterEventType),
+// decode and overflow errors are reported to onError (logged if nil) and do not affect session.
+// Typed subscriptions of protocol events are generated, e.g. OnNetworkResponseReceived
+func (session *Session) SubscribeEvent(method string, cb func(interface{}), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(method, func() interface{} {
+		v, _ := NewEvent(method)
+		return v
+	}, cb, onError)
+}
+
+func (session *Session) subscribeEvent(method string, newValue func() interface{}, cb func(interface{}), onError func(error)) (unsubscribe func()) {
+	if onError == nil {
+		onError = func(err error) {
+			session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: method, Error: err}, nil)
+		}
+	}
+	return session.SubscribeWithOptions(method, func(e *Event) {
+		v := newValue()
+		if v == nil {
+			onError(fmt.Errorf("unknown event %s", e.Method))
+			return
+		}
+		if err := json.Unmarshal(e.Params, v); err != nil {
+			onError(fmt.Errorf("decode %s: %w", e.Method, err))
+			return
+		}
+		cb(v)
+	}, &SubscribeOptions{OnError: onError})
+}
+
+// WaitForEvent runs action and waits for event matching predicate, returns decoded event (see NewEvent).
+// Subscription is made before action is run, so event caused by action is not missed.
+// Nil predicate matches any event, action can be nil. Session's timeout is applied as well as ctx.
+// Typed waiters of protocol events are generated, e.g. WaitForNetworkResponseReceived
+func (session Session) WaitForEvent(ctx context.Context, method string, predicate func(interface{}) bool, action func() error) (interface{}, error) {
+	return session.waitForEvent(ctx, method, func() interface{} {
+		v, _ := NewEvent(method)
+		return v
+	}, predicate, action)
+}
+
+func (session Session) waitForEvent(ctx context.Context, method string, newValue func() interface{}, predicate func(interface{}) bool, action func() error) (interface{}, error) {
+	if ctx == nil {
+		ctx = session.Context()
+	}
+	var (
+		matched = make(chan interface{}, 1)
+		failed  = make(chan error, 1)
+	)
+	unsubscribe := session.subscribeEvent(method, newValue, func(v interface{}) {
+		if predicate == nil || predicate(v) {
+			select {
+			case matched <- v:
+			default:
+			}
+		}
+	}, func(err error) {
+		select {
+		case failed <- err:
+		default:
+		}
+	})
+	defer unsubscribe()
+	if action != nil {
+		if err := action(); err != nil {
+			return nil, err
+		}
+	}
+	select {
+	case v := <-matched:
+		return v, nil
+	case err := <-failed:
+		return nil, err
+	case <-session.closed:
+		return nil, ErrSessionAlreadyClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(session.deadline):
+		return nil, &TimeoutError{Method: method, Deadline: session.deadline}
+	}
+}
+
+// OnExecutionContextCreated subscribe to Runtime.executionContextCreated
+//
+// Deprecated: use OnRuntimeExecutionContextCreated, it passes generated protocol type of event
+func (session *Session) OnExecutionContextCreated(cb func(*devtool.ExecutionContextCreated), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Runtime.executionContextCreated", func() interface{} { return new(devtool.ExecutionContextCreated) }, func(v interface{}) { cb(v.(*devtool.ExecutionContextCreated)) }, onError)
+}
+
+// OnExecutionContextDestroyed subscribe to Runtime.executionContextDestroyed
+//
+// Deprecated: use OnRuntimeExecutionContextDestroyed, it passes generated protocol type of event
+func (session *Session) OnExecutionContextDestroyed(cb func(*devtool.ExecutionContextDestroyed), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Runtime.executionContextDestroyed", func() interface{} { return new(devtool.ExecutionContextDestroyed) }, func(v interface{}) { cb(v.(*devtool.ExecutionContextDestroyed)) }, onError)
+}
+
+// OnConsoleAPICalled subscribe to Runtime.consoleAPICalled
+//
+// Deprecated: use OnRuntimeConsoleAPICalled, it passes generated protocol type of event
+func (session *Session) OnConsoleAPICalled(cb func(*devtool.ConsoleAPICalled), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Runtime.consoleAPICalled", func() interface{} { return new(devtool.ConsoleAPICalled) }, func(v interface{}) { cb(v.(*devtool.ConsoleAPICalled)) }, onError)
+}
+
+// OnLifecycleEvent subscribe to Page.lifecycleEvent
+//
+// Deprecated: use OnPageLifecycleEvent, it passes generated protocol type of event
+func (session *Session) OnLifecycleEvent(cb func(*devtool.LifecycleEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Page.lifecycleEvent", func() interface{} { return new(devtool.LifecycleEvent) }, func(v interface{}) { cb(v.(*devtool.LifecycleEvent)) }, onError)
+}
+
+// OnJavascriptDialogOpening subscribe to Page.javascriptDialogOpening
+//
+// Deprecated: use OnPageJavascriptDialogOpening, it passes generated protocol type of event
+func (session *Session) OnJavascriptDialogOpening(cb func(*devtool.JavascriptDialog), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Page.javascriptDialogOpening", func() interface{} { return new(devtool.JavascriptDialog) }, func(v interface{}) { cb(v.(*devtool.JavascriptDialog)) }, onError)
+}
+
+// OnFrameNavigated subscribe to Page.frameNavigated
+//
+// Deprecated: use OnPageFrameNavigated, it passes generated protocol type of event
+func (session *Session) OnFrameNavigated(cb func(*devtool.FrameNavigated), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Page.frameNavigated", func() interface{} { return new(devtool.FrameNavigated) }, func(v interface{}) { cb(v.(*devtool.FrameNavigated)) }, onError)
+}
+
+// OnFrameDetached subscribe to Page.frameDetached
+//
+// Deprecated: use OnPageFrameDetached, it passes generated protocol type of event
+func (session *Session) OnFrameDetached(cb func(*devtool.FrameDetached), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Page.frameDetached", func() interface{} { return new(devtool.FrameDetached) }, func(v interface{}) { cb(v.(*devtool.FrameDetached)) }, onError)
+}
+
+// OnScreencastFrame subscribe to Page.screencastFrame
+//
+// Deprecated: use OnPageScreencastFrame, it passes generated protocol type of event
+func (session *Session) OnScreencastFrame(cb func(*devtool.ScreencastFrame), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Page.screencastFrame", func() interface{} { return new(devtool.ScreencastFrame) }, func(v interface{}) { cb(v.(*devtool.ScreencastFrame)) }, onError)
+}
+
+// OnRequestWillBeSent subscribe to Network.requestWillBeSent
+//
+// Deprecated: use OnNetworkRequestWillBeSent, it passes generated protocol type of event
+func (session *Session) OnRequestWillBeSent(cb func(*devtool.RequestWillBeSent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Network.requestWillBeSent", func() interface{} { return new(devtool.RequestWillBeSent) }, func(v interface{}) { cb(v.(*devtool.RequestWillBeSent)) }, onError)
+}
+
+// OnResponseReceived subscribe to Network.responseReceived
+//
+// Deprecated: use OnNetworkResponseReceived, it passes generated protocol type of event
+func (session *Session) OnResponseReceived(cb func(*devtool.ResponseReceived), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Network.responseReceived", func() interface{} { return new(devtool.ResponseReceived) }, func(v interface{}) { cb(v.(*devtool.ResponseReceived)) }, onError)
+}
+
+// OnDataReceived subscribe to Network.dataReceived
+//
+// Deprecated: use OnNetworkDataReceived, it passes generated protocol type of event
+func (session *Session) OnDataReceived(cb func(*devtool.DataReceived), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Network.dataReceived", func() interface{} { return new(devtool.DataReceived) }, func(v interface{}) { cb(v.(*devtool.DataReceived)) }, onError)
+}
+
+// OnLoadingFinished subscribe to Network.loadingFinished
+//
+// Deprecated: use OnNetworkLoadingFinished, it passes generated protocol type of event
+func (session *Session) OnLoadingFinished(cb func(*devtool.LoadingFinished), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Network.loadingFinished", func() interface{} { return new(devtool.LoadingFinished) }, func(v interface{}) { cb(v.(*devtool.LoadingFinished)) }, onError)
+}
+
+// OnLoadingFailed subscribe to Network.loadingFailed
+//
+// Deprecated: use OnNetworkLoadingFailed, it passes generated protocol type of event
+func (session *Session) OnLoadingFailed(cb func(*devtool.LoadingFailed), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Network.loadingFailed", func() interface{} { return new(devtool.LoadingFailed) }, func(v interface{}) { cb(v.(*devtool.LoadingFailed)) }, onError)
+}
+
+// OnRequestServedFromCache subscribe to Network.requestServedFromCache
+//
+// Deprecated: use OnNetworkRequestServedFromCache, it passes generated protocol type of event
+func (session *Session) OnRequestServedFromCache(cb func(*devtool.ServedFromCache), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Network.requestServedFromCache", func() interface{} { return new(devtool.ServedFromCache) }, func(v interface{}) { cb(v.(*devtool.ServedFromCache)) }, onError)
+}
+
+// OnRequestPaused subscribe to Fetch.requestPaused, Fetch domain must be enabled
+//
+// Deprecated: use OnFetchRequestPaused, it passes generated protocol type of event
+func (session *Session) OnRequestPaused(cb func(*devtool.RequestPaused), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Fetch.requestPaused", func() interface{} { return new(devtool.RequestPaused) }, func(v interface{}) { cb(v.(*devtool.RequestPaused)) }, onError)
+}
+
+// OnTargetCrashed subscribe to Target.targetCrashed
+//
+// Deprecated: use OnTargetTargetCrashed, it passes generated protocol type of event
+func (session *Session) OnTargetCrashed(cb func(*devtool.TargetCrashed), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Target.targetCrashed", func() interface{} { return new(devtool.TargetCrashed) }, func(v interface{}) { cb(v.(*devtool.TargetCrashed)) }, onError)
+}
+
+// OnTargetDestroyed subscribe to Target.targetDestroyed
+//
+// Deprecated: use OnTargetTargetDestroyed, it passes generated protocol type of event
+func (session *Session) OnTargetDestroyed(cb func(*devtool.TargetDestroyed), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Target.targetDestroyed", func() interface{} { return new(devtool.TargetDestroyed) }, func(v interface{}) { cb(v.(*devtool.TargetDestroyed)) }, onError)
+}
+
+// OnDetachedFromTarget subscribe to Target.detachedFromTarget
+//
+// Deprecated: use OnTargetDetachedFromTarget, it passes generated protocol type of event
+func (session *Session) OnDetachedFromTarget(cb func(*devtool.DetachedFromTarget), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent("Target.detachedFromTarget", func() interface{} { return new(devtool.DetachedFromTarget) }, func(v interface{}) { cb(v.(*devtool.DetachedFromTarget)) }, onError)
+}
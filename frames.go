@@ -0,0 +1,523 @@
+package cdp
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+// frameTree frames of session's target and their execution contexts, it is updated by listener and shared by copies of session
+type frameTree struct {
+	mutex   *sync.Mutex
+	seq     int
+	frames  map[string]*frameInfo
+	changed chan struct{} // closed and replaced on every update
+}
+
+type frameInfo struct {
+	id       string
+	parentID string
+	name     string
+	url      string
+	seq      int              // order of appearance, children are listed in it
+	contexts map[string]int64 // execution contexts by world name, main world has empty name
+}
+
+func newFrameTree() *frameTree {
+	return &frameTree{
+		mutex:   &sync.Mutex{},
+		frames:  map[string]*frameInfo{},
+		changed: make(chan struct{}),
+	}
+}
+
+// frame returns known frame or adds new one, must be called under lock
+func (t *frameTree) frame(frameID string) *frameInfo {
+	f, has := t.frames[frameID]
+	if !has {
+		t.seq++
+		f = &frameInfo{id: frameID, seq: t.seq, contexts: map[string]int64{}}
+		t.frames[frameID] = f
+	}
+	return f
+}
+
+// remove frame and its descendants, must be called under lock
+func (t *frameTree) remove(frameID string) {
+	delete(t.frames, frameID)
+	for id, f := range t.frames {
+		if f.parentID == frameID {
+			t.remove(id)
+		}
+	}
+}
+
+func (t *frameTree) notify() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// update applies frame or execution context event, other events are ignored
+func (t *frameTree) update(method string, params []byte) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	switch method {
+	case "Page.frameAttached":
+		event := new(devtool.FrameAttached)
+		if err := json.Unmarshal(params, event); err != nil {
+			return err
+		}
+		t.frame(event.FrameID).parentID = event.ParentFrameID
+
+	case "Page.frameNavigated":
+		event := new(devtool.FrameNavigated)
+		if err := json.Unmarshal(params, event); err != nil {
+			return err
+		}
+		if event.Frame == nil {
+			return nil
+		}
+		f := t.frame(event.Frame.ID)
+		f.parentID = event.Frame.ParentID
+		f.name = event.Frame.Name
+		f.url = event.Frame.URL
+
+	case "Page.frameDetached":
+		event := new(devtool.FrameDetached)
+		if err := json.Unmarshal(params, event); err != nil {
+			return err
+		}
+		if event.Reason == "swap" {
+			// frame is still attached, but it is rendered by out-of-process frame's session now
+			if f, has := t.frames[event.FrameID]; has {
+				f.contexts = map[string]int64{}
+			}
+		} else {
+			t.remove(event.FrameID)
+		}
+
+	case "Runtime.executionContextCreated":
+		event := new(devtool.ExecutionContextCreated)
+		if err := json.Unmarshal(params, event); err != nil {
+			return err
+		}
+		if event.Context == nil {
+			return nil
+		}
+		frameID, _ := event.Context.AuxData["frameId"].(string)
+		if frameID == "" {
+			return nil // worker's context
+		}
+		world := event.Context.Name
+		if isDefault, _ := event.Context.AuxData["isDefault"].(bool); isDefault {
+			world = ""
+		}
+		t.frame(frameID).contexts[world] = event.Context.ID
+
+	case "Runtime.executionContextDestroyed":
+		event := new(devtool.ExecutionContextDestroyed)
+		if err := json.Unmarshal(params, event); err != nil {
+			return err
+		}
+		for _, f := range t.frames {
+			for world, id := range f.contexts {
+				if id == event.ExecutionContextID {
+					delete(f.contexts, world)
+				}
+			}
+		}
+
+	case "Runtime.executionContextsCleared":
+		for _, f := range t.frames {
+			f.contexts = map[string]int64{}
+		}
+
+	default:
+		return nil
+	}
+	t.notify()
+	return nil
+}
+
+// seed adds frames of tree which are not known yet, events received while tree was requested are newer than it
+func (t *frameTree) seed(tree *devtool.FrameTree) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var walk func(tree *devtool.FrameTree)
+	walk = func(tree *devtool.FrameTree) {
+		if tree == nil || tree.Frame == nil {
+			return
+		}
+		if _, has := t.frames[tree.Frame.ID]; !has {
+			f := t.frame(tree.Frame.ID)
+			f.parentID = tree.Frame.ParentID
+			f.name = tree.Frame.Name
+			f.url = tree.Frame.URL
+		}
+		for _, child := range tree.ChildFrames {
+			walk(child)
+		}
+	}
+	walk(tree)
+	t.notify()
+}
+
+// list returns copies of frames without contexts
+func (t *frameTree) list() []frameInfo {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	list := make([]frameInfo, 0, len(t.frames))
+	for _, f := range t.frames {
+		c := *f
+		c.contexts = nil
+		list = append(list, c)
+	}
+	return list
+}
+
+// context returns execution context of world in frame, zero if it is not created yet
+func (t *frameTree) context(frameID, world string) int64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if f, has := t.frames[frameID]; has {
+		return f.contexts[world]
+	}
+	return 0
+}
+
+// updated returns channel closed on next update of tree
+func (t *frameTree) updated() <-chan struct{} {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.changed
+}
+
+// loadFrameTree seeds frame tree of session, Page domain must be enabled
+func (session Session) loadFrameTree() error {
+	tree, err := session.GetFrameTree()
+	if err != nil {
+		return err
+	}
+	session.frames.seed(tree)
+	return nil
+}
+
+// frameInfos frames of page and its out-of-process frames by id
+func (session Session) frameInfos() map[string]frameInfo {
+	infos := map[string]frameInfo{}
+	for _, f := range session.frames.list() {
+		infos[f.id] = f
+	}
+	for _, s := range session.descendants(targetIFrame) {
+		for _, f := range s.frames.list() {
+			if known, has := infos[f.id]; has {
+				// out-of-process frame's own session knows its actual url and name
+				if f.parentID == "" {
+					f.parentID = known.parentID
+				}
+				f.seq = known.seq
+			}
+			infos[f.id] = f
+		}
+	}
+	return infos
+}
+
+// Frame frame of page, its calls are evaluated in frame's own execution context,
+// so frames can be used concurrently and session's state (see SwitchTo) is not changed
+type Frame struct {
+	page *Session
+	id   string
+}
+
+func (session Session) frame(frameID string) *Frame {
+	session.state = newState()
+	return &Frame{page: &session, id: frameID}
+}
+
+// MainFrame returns main frame of page
+func (session Session) MainFrame() *Frame {
+	return session.frame(session.target)
+}
+
+// FrameByID returns frame of page with id, nil if there is no such frame
+func (session Session) FrameByID(frameID string) *Frame {
+	if _, has := session.frameInfos()[frameID]; !has {
+		return nil
+	}
+	return session.frame(frameID)
+}
+
+// AllFrames returns all frames of page including out-of-process ones, every frame is followed by its children
+func (session Session) AllFrames() []*Frame {
+	infos := session.frameInfos()
+	var roots []frameInfo
+	for _, f := range infos {
+		if _, has := infos[f.parentID]; !has {
+			roots = append(roots, f)
+		}
+	}
+	sortFrames(roots)
+	var (
+		all  []*Frame
+		walk func(f frameInfo)
+	)
+	walk = func(f frameInfo) {
+		all = append(all, session.frame(f.id))
+		for _, child := range childFrames(infos, f.id) {
+			walk(child)
+		}
+	}
+	for _, root := range roots {
+		walk(root)
+	}
+	return all
+}
+
+func childFrames(infos map[string]frameInfo, parentID string) []frameInfo {
+	var children []frameInfo
+	for _, f := range infos {
+		if f.parentID == parentID {
+			children = append(children, f)
+		}
+	}
+	sortFrames(children)
+	return children
+}
+
+func sortFrames(frames []frameInfo) {
+	sort.Slice(frames, func(i, j int) bool { return frames[i].seq < frames[j].seq })
+}
+
+// ID frame's id
+func (f *Frame) ID() string {
+	return f.id
+}
+
+// URL frame's url, empty if frame was detached
+func (f *Frame) URL() string {
+	return f.page.frameInfos()[f.id].url
+}
+
+// Name frame's name attribute
+func (f *Frame) Name() string {
+	return f.page.frameInfos()[f.id].name
+}
+
+// Parent returns parent frame, nil for main frame
+func (f *Frame) Parent() *Frame {
+	info, has := f.page.frameInfos()[f.id]
+	if !has || info.parentID == "" {
+		return nil
+	}
+	return f.page.frame(info.parentID)
+}
+
+// Children returns child frames
+func (f *Frame) Children() []*Frame {
+	var children []*Frame
+	for _, c := range childFrames(f.page.frameInfos(), f.id) {
+		children = append(children, f.page.frame(c.id))
+	}
+	return children
+}
+
+// session which renders frame, calls are sent to own session of out-of-process frame
+func (f *Frame) session() *Session {
+	s := f.page.frameSession(f.id)
+	if s == nil {
+		return f.page
+	}
+	c := *s
+	c.ctx = f.page.ctx
+	c.state = newState()
+	return &c
+}
+
+// lookup returns session and main world execution context of frame, context is zero if it is not created yet
+func (f *Frame) lookup() (*Session, int64, error) {
+	if _, has := f.page.frameInfos()[f.id]; !has {
+		return nil, 0, ErrContextDetached
+	}
+	s := f.session()
+	return s, s.frames.context(f.id, ""), nil
+}
+
+// context returns session and main world execution context of frame, it waits until context is created
+func (f *Frame) context() (*Session, int64, error) {
+	timeout := time.After(f.page.deadline)
+	for {
+		pageChanged := f.page.frames.updated()
+		s, id, err := f.lookup()
+		if err != nil || id != 0 {
+			return s, id, err
+		}
+		select {
+		case <-s.frames.updated():
+		case <-pageChanged:
+		case <-s.closed:
+			return nil, 0, ErrSessionAlreadyClosed
+		case <-s.Context().Done():
+			return nil, 0, s.Context().Err()
+		case <-timeout:
+			return nil, 0, &TimeoutError{Method: "Runtime.executionContextCreated", Deadline: f.page.deadline}
+		}
+	}
+}
+
+// Evaluate evaluate javascript code in main world of frame
+func (f *Frame) Evaluate(code string, async bool, returnByValue bool) (interface{}, error) {
+	s, context, err := f.context()
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.evaluate(code, context, async, returnByValue)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// Query query element in frame by css selector
+func (f *Frame) Query(selector string) (*Element, error) {
+	s, context, err := f.context()
+	if err != nil {
+		return nil, err
+	}
+	return s.query(context, nil, selector)
+}
+
+// QueryAll queryAll elements in frame by css selector
+func (f *Frame) QueryAll(selector string) ([]*Element, error) {
+	s, context, err := f.context()
+	if err != nil {
+		return nil, err
+	}
+	return s.queryAll(context, nil, selector)
+}
+
+// Navigate navigate frame to url and wait for its load event
+func (f *Frame) Navigate(urlStr string) error {
+	s := f.session()
+	loaded := s.lifecycleEvent(f.id, devtool.Load)
+	nav := new(devtool.NavigationResult)
+	p := Map{
+		"url":            urlStr,
+		"transitionType": "typed",
+		"frameId":        f.id,
+	}
+	if err := s.call("Page.navigate", p, nav); err != nil {
+		return err
+	}
+	if nav.ErrorText != "" {
+		return &ProtocolError{Message: nav.ErrorText, Method: "Page.navigate", Params: p, SessionID: s.id}
+	}
+	if nav.LoaderID == "" {
+		return nil
+	}
+	return loaded()
+}
+
+// FrameMatcher describes frame for Session.Frame, all set fields must match
+type FrameMatcher struct {
+	Name      string         // name of frame
+	URL       string         // glob of frame's url, * matches any sequence of characters, ? matches any character
+	URLRegexp *regexp.Regexp // regexp of frame's url
+	Selectors []string       // chain of iframe selectors starting from main frame, every next selector is queried in frame of previous one
+}
+
+// framePollInterval interval of re-querying Selectors of FrameMatcher, DOM changes are not reported by events
+const framePollInterval = 100 * time.Millisecond
+
+// Frame waits until frame matching m appears and its main world context is created
+func (session Session) Frame(m FrameMatcher) (*Frame, error) {
+	var glob *regexp.Regexp
+	if m.URL != "" {
+		glob = globRegexp(m.URL)
+	}
+	timeout := time.After(session.deadline)
+	for {
+		changed := session.frames.updated()
+		f, err := session.matchFrame(m, glob)
+		if err != nil || f != nil {
+			return f, err
+		}
+		select {
+		case <-changed:
+		case <-time.After(framePollInterval):
+		case <-session.closed:
+			return nil, ErrSessionAlreadyClosed
+		case <-session.Context().Done():
+			return nil, session.Context().Err()
+		case <-timeout:
+			return nil, ErrFrameNotFound
+		}
+	}
+}
+
+// matchFrame returns nil if there is no matching frame with created context yet
+func (session Session) matchFrame(m FrameMatcher, glob *regexp.Regexp) (*Frame, error) {
+	candidates := session.AllFrames()
+	if len(m.Selectors) > 0 {
+		f, err := session.frameBySelectors(m.Selectors)
+		if err != nil || f == nil {
+			return nil, err
+		}
+		candidates = []*Frame{f}
+	}
+	infos := session.frameInfos()
+	for _, f := range candidates {
+		info, has := infos[f.id]
+		switch {
+		case !has:
+		case m.Name != "" && info.name != m.Name:
+		case glob != nil && !glob.MatchString(info.url):
+		case m.URLRegexp != nil && !m.URLRegexp.MatchString(info.url):
+		default:
+			if _, context, err := f.lookup(); err == nil && context != 0 {
+				return f, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// frameBySelectors returns nil if some of frames or iframe elements of chain are not ready yet
+func (session Session) frameBySelectors(selectors []string) (*Frame, error) {
+	f := session.MainFrame()
+	for _, selector := range selectors {
+		s, context, err := f.lookup()
+		if err != nil || context == 0 {
+			return nil, nil
+		}
+		e, err := s.query(context, nil, selector)
+		if err != nil {
+			if errors.As(err, &NoSuchElementError{}) || errors.Is(err, ErrStaleElementReference) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		// iframe element is queried on every poll, so it is released at once
+		frameID, err := e.GetFrameID()
+		releaseElements([]*Element{e})
+		if err != nil {
+			return nil, err
+		}
+		f = session.frame(frameID)
+	}
+	return f, nil
+}
+
+// globRegexp converts glob to regexp matching whole string
+func globRegexp(glob string) *regexp.Regexp {
+	pattern := regexp.QuoteMeta(glob)
+	pattern = strings.ReplaceAll(pattern, `\*`, `.*`)
+	pattern = strings.ReplaceAll(pattern, `\?`, `.`)
+	return regexp.MustCompile("^" + pattern + "$")
+}
@@ -1,5 +1,12 @@
 package cdp
 
+import (
+	"time"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
 // Input events
 const (
 	dispatchKeyEventChar       = "char"
@@ -11,11 +18,88 @@ const (
 	dispatchMouseEventReleased = "mouseReleased"
 )
 
+const (
+	// dragInterceptTimeout time to wait for intercepted HTML5 drag after last mouse move
+	dragInterceptTimeout = 100 * time.Millisecond
+	// dragSteps number of mouse moves of Element.DragTo
+	dragSteps = 10
+)
+
 // MouseMove ...
 func (session Input) MouseMove(x, y float64) error {
 	return session.dispatchMouseEvent(x, y, dispatchMouseEventMoved, "none")
 }
 
+// DragAndDrop presses left mouse button at from, moves mouse to `to` in steps and releases it there.
+// HTML5 drag started by page is intercepted and dropped at `to` with Input.dispatchDragEvent,
+// so both mouse-driven and HTML5 drag and drop are supported
+func (session Input) DragAndDrop(from, to devtool.Point, steps int) (err error) {
+	if steps < 1 {
+		steps = 1
+	}
+	if err := (&protocol.InputSetInterceptDragsParams{Enabled: true}).Do(session); err != nil {
+		return err
+	}
+	defer func() { _ = (&protocol.InputSetInterceptDragsParams{Enabled: false}).Do(session) }()
+	intercepted := make(chan *protocol.InputDragData, 1)
+	unsubscribe := session.subscribeEvent(protocol.EventInputDragIntercepted, func() interface{} { return new(protocol.InputDragInterceptedEvent) }, func(v interface{}) {
+		select {
+		case intercepted <- v.(*protocol.InputDragInterceptedEvent).Data:
+		default:
+		}
+	}, nil)
+	defer unsubscribe()
+
+	if err := session.dispatchMouseEvent(from.X, from.Y, dispatchMouseEventMoved, "none"); err != nil {
+		return err
+	}
+	if err := session.dispatchMouseDrag(from, dispatchMouseEventPressed); err != nil {
+		return err
+	}
+	// button is released where mouse is even if drag fails, otherwise it stays pressed for next actions
+	at := from
+	defer func() {
+		if releaseErr := session.dispatchMouseDrag(at, dispatchMouseEventReleased); err == nil {
+			err = releaseErr
+		}
+	}()
+	var data *protocol.InputDragData
+	for n := 1; n <= steps && data == nil; n++ {
+		p := devtool.Point{
+			X: from.X + (to.X-from.X)*float64(n)/float64(steps),
+			Y: from.Y + (to.Y-from.Y)*float64(n)/float64(steps),
+		}
+		if err := session.dispatchMouseDrag(p, dispatchMouseEventMoved); err != nil {
+			return err
+		}
+		at = p
+		select {
+		case data = <-intercepted:
+		default:
+		}
+	}
+	if data == nil {
+		// event of drag started by last move can be received after response
+		select {
+		case data = <-intercepted:
+		case <-time.After(dragInterceptTimeout):
+		}
+	}
+	if data != nil {
+		for _, t := range []protocol.InputDispatchDragEventParamsType{
+			protocol.InputDispatchDragEventParamsTypeDragEnter,
+			protocol.InputDispatchDragEventParamsTypeDragOver,
+			protocol.InputDispatchDragEventParamsTypeDrop,
+		} {
+			if err := (&protocol.InputDispatchDragEventParams{Type: t, X: to.X, Y: to.Y, Data: data}).Do(session); err != nil {
+				return err
+			}
+		}
+	}
+	at = to
+	return nil
+}
+
 // Press ...
 func (session Input) Press(c rune) error {
 	return session.press(keyDefinition{keyCode: int(c), text: string(c)})
@@ -58,3 +142,19 @@ func (session Input) dispatchMouseEvent(x float64, y float64, eventType string,
 		"clickCount": 1,
 	}, nil)
 }
+
+// dispatchMouseDrag dispatches mouse event with left button held, no button is held after release
+func (session Input) dispatchMouseDrag(p devtool.Point, eventType string) error {
+	buttons := 1
+	if eventType == dispatchMouseEventReleased {
+		buttons = 0
+	}
+	return session.call("Input.dispatchMouseEvent", Map{
+		"type":       eventType,
+		"button":     "left",
+		"buttons":    buttons,
+		"x":          p.X,
+		"y":          p.Y,
+		"clickCount": 1,
+	}, nil)
+}
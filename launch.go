@@ -0,0 +1,325 @@
+package cdp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// HeadlessMode mode of browser window
+type HeadlessMode int
+
+// headless modes
+const (
+	Headful     HeadlessMode = iota // browser with window
+	Headless                        // --headless, old headless implementation
+	HeadlessNew                     // --headless=new, headless mode that shares code with headful browser
+)
+
+// LaunchOptions options of new browser process
+type LaunchOptions struct {
+	Binary         string        // path to browser executable, CHROME_PATH env or well known names are looked up if empty
+	Headless       HeadlessMode  // Headful by default
+	WindowWidth    int           // --window-size, used only if both width and height specified
+	WindowHeight   int           // --window-size, used only if both width and height specified
+	UserDataDir    string        // profile directory, new temporary directory is created if empty
+	Flags          []string      // extra flags appended to default ones
+	RemoveFlags    []string      // default flags to omit, matched by name without value (e.g. "--disable-gpu")
+	ProxyServer    string        // --proxy-server
+	Env            []string      // extra environment variables in form "key=value" appended to current process's environment
+	Stderr         io.Writer     // browser's stderr sink, discarded if nil
+	Stdout         io.Writer     // browser's stdout sink, discarded if nil
+	StartupTimeout time.Duration // how long to wait for devtools endpoint, 30 seconds if zero
+	Pipe           bool          // connect with --remote-debugging-pipe instead of websocket, no TCP port is opened (not supported on windows)
+}
+
+// chrome executables to look up if binary is not specified
+var browserBinaries = []string{
+	"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+	"/usr/bin/google-chrome",
+	"headless-shell",
+	"chromium",
+	"chromium-browser",
+	"google-chrome",
+	"google-chrome-stable",
+	"google-chrome-beta",
+	"google-chrome-unstable",
+}
+
+// https: //github.com/GoogleChrome/chrome-launcher/blob/master/docs/chrome-flags-for-tools.md
+var defaultFlags = []string{
+	"about:blank", // open url
+	"--no-first-run",
+	"--no-default-browser-check",
+	"--remote-debugging-port=0",
+	"--hide-scrollbars",
+	"--mute-audio",
+	"--password-store=basic",
+	"--use-mock-keychain",
+	"--enable-automation",
+	"--disable-gpu",
+	"--disable-sync",
+	"--disable-background-networking",
+	"--disable-default-apps",
+	"--disable-extensions",
+	"--disable-browser-side-navigation",
+	"--disable-background-timer-throttling",
+	"--disable-backgrounding-occluded-windows",
+	"--disable-renderer-backgrounding",
+	"--disable-hang-monitor",
+	"--disable-breakpad",
+	"--disable-client-side-phishing-detection",
+	"--disable-component-extensions-with-background-pages",
+	"--disable-ipc-flooding-protection",
+	"--disable-prompt-on-repost",
+	"--metrics-recording-only",
+	"--disable-features=TranslateUI,BlinkGenPropertyTrees",
+	"--enable-features=NetworkService,NetworkServiceInProcess",
+}
+
+// Launch launch a new browser process
+func Launch(ctx context.Context, userFlags ...string) (*Browser, error) {
+	return LaunchWithOptions(ctx, &LaunchOptions{Flags: userFlags})
+}
+
+// LaunchWithOptions launch a new browser process with options
+func LaunchWithOptions(ctx context.Context, opts *LaunchOptions) (*Browser, error) {
+	if opts == nil {
+		opts = &LaunchOptions{}
+	}
+	browser := &Browser{deadline: 10 * time.Second}
+	path, err := opts.binary()
+	if err != nil {
+		return nil, err
+	}
+	browser.userDataDir = opts.UserDataDir
+	if browser.userDataDir == "" {
+		if browser.userDataDir, err = ioutil.TempDir("", "tmp"); err != nil {
+			return nil, err
+		}
+		browser.removeUserDataDir = true
+	}
+	if err = browser.start(ctx, path, opts); err != nil {
+		if browser.removeUserDataDir {
+			_ = os.RemoveAll(browser.userDataDir)
+		}
+		return nil, err
+	}
+	return browser, nil
+}
+
+func (browser *Browser) start(ctx context.Context, path string, opts *LaunchOptions) (err error) {
+	cmd := exec.Command(path, opts.flags(browser.userDataDir)...)
+	cmd.Stdout = opts.Stdout
+	if len(opts.Env) > 0 {
+		cmd.Env = append(os.Environ(), opts.Env...)
+	}
+	if opts.Pipe {
+		cmd.Stderr = opts.Stderr
+		transport, err := browser.pipe(ctx, cmd)
+		if err != nil {
+			return err
+		}
+		browser.wsClient = NewClient(transport)
+		if err = opts.waitForPipe(browser); err != nil {
+			_ = browser.wsClient.Close()
+			_ = browser.process.kill()
+			browser.process.wait()
+		}
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if browser.process, err = startProcess(ctx, cmd); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = browser.process.kill()
+			browser.process.wait()
+		}
+	}()
+	webSocketURL, err := opts.waitForEndpoint(stderr)
+	if err != nil {
+		return err
+	}
+	if browser.url, err = url.Parse(webSocketURL); err != nil {
+		return err
+	}
+	browser.wsClient, err = NewWebSocketClient(webSocketURL)
+	return err
+}
+
+// pipe starts browser with pipes as fd 3 (browser reads) and fd 4 (browser writes)
+func (browser *Browser) pipe(ctx context.Context, cmd *exec.Cmd) (Transport, error) {
+	browserIn, out, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	in, browserOut, err := os.Pipe()
+	if err != nil {
+		_ = browserIn.Close()
+		_ = out.Close()
+		return nil, err
+	}
+	cmd.ExtraFiles = []*os.File{browserIn, browserOut}
+	browser.process, err = startProcess(ctx, cmd)
+	// browser's ends are inherited by child process, otherwise EOF is never reached
+	_ = browserIn.Close()
+	_ = browserOut.Close()
+	if err != nil {
+		_ = in.Close()
+		_ = out.Close()
+		return nil, err
+	}
+	return NewPipeTransport(in, out), nil
+}
+
+func (opts LaunchOptions) binary() (string, error) {
+	if opts.Binary != "" {
+		return opts.Binary, nil
+	}
+	if env := os.Getenv("CHROME_PATH"); env != "" {
+		return env, nil
+	}
+	for _, c := range browserBinaries {
+		if _, err := exec.LookPath(c); err == nil {
+			return c, nil
+		}
+	}
+	return "", errors.New("browser executable not found, specify LaunchOptions.Binary or CHROME_PATH")
+}
+
+func flagName(flag string) string {
+	if i := strings.Index(flag, "="); i != -1 {
+		return flag[:i]
+	}
+	return flag
+}
+
+func (opts LaunchOptions) flags(userDataDir string) []string {
+	flags := append([]string{}, defaultFlags...)
+	if opts.Pipe {
+		for n, f := range flags {
+			if flagName(f) == "--remote-debugging-port" {
+				flags[n] = "--remote-debugging-pipe"
+			}
+		}
+	}
+	switch opts.Headless {
+	case Headless:
+		flags = append(flags, "--headless")
+	case HeadlessNew:
+		flags = append(flags, "--headless=new")
+	}
+	if opts.WindowWidth > 0 && opts.WindowHeight > 0 {
+		flags = append(flags, fmt.Sprintf("--window-size=%d,%d", opts.WindowWidth, opts.WindowHeight))
+	}
+	if opts.ProxyServer != "" {
+		flags = append(flags, "--proxy-server="+opts.ProxyServer)
+	}
+	flags = append(flags, "--user-data-dir="+userDataDir)
+	if os.Getuid() == 0 {
+		flags = append(flags, "--no-sandbox")
+	}
+	removed := make(map[string]bool, len(opts.RemoveFlags))
+	for _, f := range opts.RemoveFlags {
+		removed[flagName(f)] = true
+	}
+	result := flags[:0]
+	for _, f := range flags {
+		if !removed[flagName(f)] {
+			result = append(result, f)
+		}
+	}
+	return append(result, opts.Flags...)
+}
+
+func (opts LaunchOptions) startupTimeout() time.Duration {
+	if opts.StartupTimeout == 0 {
+		return 30 * time.Second
+	}
+	return opts.StartupTimeout
+}
+
+// waitForPipe waits until browser answers over pipe, pipe itself is open before browser is started
+func (opts LaunchOptions) waitForPipe(browser *Browser) error {
+	timeout := opts.startupTimeout()
+	session := browser.session()
+	session.deadline = timeout
+	err := session.call("Browser.getVersion", nil, nil)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("browser does not respond over pipe in %s", timeout.String())
+	}
+	return err
+}
+
+func (opts LaunchOptions) waitForEndpoint(stderr io.ReadCloser) (string, error) {
+	timeout := opts.startupTimeout()
+	type endpoint struct {
+		url string
+		err error
+	}
+	result := make(chan endpoint, 1)
+	go func() {
+		url, err := addrFromStderr(stderr, opts.Stderr)
+		result <- endpoint{url: url, err: err}
+	}()
+	select {
+	case r := <-result:
+		return r.url, r.err
+	case <-time.After(timeout):
+		return "", fmt.Errorf("devtools endpoint is not reported in %s", timeout.String())
+	}
+}
+
+// addrFromStderr reads stderr until devtools url is reported,
+// rest of output is copied to sink if specified, otherwise stderr is closed
+func addrFromStderr(rc io.ReadCloser, sink io.Writer) (string, error) {
+	const prefix = "DevTools listening on"
+	var (
+		url     = ""
+		scanner = bufio.NewScanner(rc)
+		lines   []string
+	)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if sink != nil {
+			_, _ = fmt.Fprintln(sink, line)
+		}
+		if s := strings.TrimPrefix(line, prefix); s != line {
+			url = strings.TrimSpace(s)
+			break
+		}
+		lines = append(lines, line)
+	}
+	if url == "" {
+		defer rc.Close()
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("chrome stopped too early; stderr:\n%s", strings.Join(lines, "\n"))
+	}
+	if sink == nil {
+		_ = rc.Close()
+		return url, nil
+	}
+	// scanner is owned by sink's goroutine from now on
+	go func() {
+		defer rc.Close()
+		for scanner.Scan() {
+			_, _ = fmt.Fprintln(sink, scanner.Text())
+		}
+	}()
+	return url, nil
+}
@@ -0,0 +1,433 @@
+package cdp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// locatorPollInterval interval between attempts of locator's action
+const locatorPollInterval = 100 * time.Millisecond
+
+// LocatorFilter keeps matched elements satisfying all set fields
+type LocatorFilter struct {
+	HasText string // text of element contains it
+	Has     string // element contains element matching selector
+}
+
+type locatorStep struct {
+	selector string
+	nth      *int
+	filter   *LocatorFilter
+}
+
+func (s locatorStep) String() string {
+	switch {
+	case s.nth != nil:
+		return fmt.Sprintf("nth=%d", *s.nth)
+	case s.filter != nil:
+		return fmt.Sprintf("filter(hasText=%q, has=%q)", s.filter.HasText, s.filter.Has)
+	}
+	return s.selector
+}
+
+// Locator finds element by chain of selectors on every attempt of action and waits until element is actionable,
+// so unlike Element it is not broken by re-rendering of page
+type Locator struct {
+	session *Session
+	frame   *Frame // nil if elements are queried in current context of session
+	steps   []locatorStep
+	timeout time.Duration
+}
+
+// Locator returns locator of elements matching selector in current context of session
+func (session Session) Locator(selector string) *Locator {
+	return &Locator{session: &session, steps: []locatorStep{{selector: selector}}, timeout: session.deadline}
+}
+
+// Locator returns locator of elements matching selector in frame
+func (f *Frame) Locator(selector string) *Locator {
+	return &Locator{session: f.page, frame: f, steps: []locatorStep{{selector: selector}}, timeout: f.page.deadline}
+}
+
+func (l *Locator) with(step locatorStep) *Locator {
+	c := *l
+	c.steps = append(append([]locatorStep{}, l.steps...), step)
+	return &c
+}
+
+// Locator returns locator of elements matching selector inside of elements of l
+func (l *Locator) Locator(selector string) *Locator {
+	return l.with(locatorStep{selector: selector})
+}
+
+// Nth returns locator of n-th matched element, negative n counts from the end
+func (l *Locator) Nth(n int) *Locator {
+	return l.with(locatorStep{nth: &n})
+}
+
+// Filter returns locator of matched elements satisfying filter
+func (l *Locator) Filter(filter LocatorFilter) *Locator {
+	return l.with(locatorStep{filter: &filter})
+}
+
+// WithTimeout returns copy of locator which actions wait for actionable element at most timeout, session's timeout is used by default
+func (l *Locator) WithTimeout(timeout time.Duration) *Locator {
+	c := *l
+	c.timeout = timeout
+	return &c
+}
+
+// String describes locator in errors
+func (l *Locator) String() string {
+	steps := make([]string, len(l.steps))
+	for n, s := range l.steps {
+		steps[n] = s.String()
+	}
+	return strings.Join(steps, " >> ")
+}
+
+// noSuchElement turns NoSuchElementError into empty result
+func noSuchElement(elements []*Element, err error) ([]*Element, error) {
+	if errors.As(err, &NoSuchElementError{}) {
+		return nil, nil
+	}
+	return elements, err
+}
+
+// resolve queries all matched elements, result is empty if nothing matches yet
+func (l *Locator) resolve() ([]*Element, error) {
+	s, context := l.session, l.session.currentContext()
+	if l.frame != nil {
+		var err error
+		if s, context, err = l.frame.lookup(); err != nil {
+			return nil, err
+		}
+		if context == 0 {
+			return nil, nil // frame's context is not created yet
+		}
+	}
+	var elements []*Element
+	for n, step := range l.steps {
+		var (
+			next []*Element
+			err  error
+		)
+		switch {
+		case step.nth != nil:
+			i := *step.nth
+			if i < 0 {
+				i += len(elements)
+			}
+			if i >= 0 && i < len(elements) {
+				next = elements[i : i+1]
+			}
+		case step.filter != nil:
+			next, err = filterElements(elements, step.filter)
+		case n == 0:
+			next, err = noSuchElement(s.queryAll(context, nil, step.selector))
+		default:
+			for _, e := range elements {
+				var inner []*Element
+				if inner, err = noSuchElement(e.QueryAll(step.selector)); err != nil {
+					break
+				}
+				next = append(next, inner...)
+			}
+		}
+		releaseElements(elements, next...)
+		if err != nil {
+			releaseElements(next)
+			return nil, err
+		}
+		elements = next
+	}
+	return elements, nil
+}
+
+// releaseElements releases remote objects of elements except of kept ones
+func releaseElements(elements []*Element, keep ...*Element) {
+	kept := make(map[string]bool, len(keep))
+	for _, e := range keep {
+		kept[e.ID] = true
+	}
+	for _, e := range elements {
+		if !kept[e.ID] {
+			_ = e.session.releaseObject(e.ID)
+		}
+	}
+}
+
+func filterElements(elements []*Element, filter *LocatorFilter) ([]*Element, error) {
+	var kept []*Element
+	for _, e := range elements {
+		if filter.HasText != "" {
+			text, err := e.GetText()
+			if err != nil {
+				return nil, err
+			}
+			if !strings.Contains(text, filter.HasText) {
+				continue
+			}
+		}
+		if filter.Has != "" {
+			inner, err := noSuchElement(e.QueryAll(filter.Has))
+			if err != nil {
+				return nil, err
+			}
+			if len(inner) == 0 {
+				continue
+			}
+		}
+		kept = append(kept, e)
+	}
+	return kept, nil
+}
+
+// element returns first matched element
+func (l *Locator) element() (*Element, error) {
+	elements, err := l.resolve()
+	if err != nil {
+		return nil, err
+	}
+	if len(elements) == 0 {
+		return nil, NoSuchElementError{selector: l.String()}
+	}
+	releaseElements(elements[1:])
+	return elements[0], nil
+}
+
+// noEffect errors are returned by action before it changed page, clicks which miss element are prevented
+func noEffect(err error) bool {
+	return errors.Is(err, ErrMissClick) || errors.Is(err, ErrElementInvisible) || errors.Is(err, ErrElementIsOutOfViewport)
+}
+
+// retryable errors are caused by state of page which can change while waiting
+func retryable(err error) bool {
+	if errors.As(err, &NoSuchElementError{}) {
+		return true
+	}
+	for _, e := range []error{
+		ErrStaleElementReference,
+		ErrNodeNotFound,
+		ErrElementInvisible,
+		ErrElementIsOutOfViewport,
+		ErrElementNotStable,
+		ErrElementDisabled,
+		ErrElementObscured,
+		ErrMissClick,
+	} {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// actionability check of element
+type actionCheck func(e *Element) error
+
+func checkVisible(e *Element) error {
+	v, err := e.call(atomIsVisible)
+	if err != nil {
+		return err
+	}
+	if !v.Bool() {
+		return ErrElementInvisible
+	}
+	return nil
+}
+
+func checkStable(e *Element) error {
+	v, err := e.call(atomIsStable)
+	if err != nil {
+		return err
+	}
+	if !v.Bool() {
+		return ErrElementNotStable
+	}
+	return nil
+}
+
+func checkEnabled(e *Element) error {
+	v, err := e.call(atomIsEnabled)
+	if err != nil {
+		return err
+	}
+	if !v.Bool() {
+		return ErrElementDisabled
+	}
+	return nil
+}
+
+func checkReceivesEvents(e *Element) error {
+	if err := e.ScrollIntoViewIfNeeded(); err != nil {
+		return err
+	}
+	v, err := e.call(atomReceivesEvents)
+	if err != nil {
+		return err
+	}
+	if !v.Bool() {
+		return ErrElementObscured
+	}
+	return nil
+}
+
+// act queries element and runs action when element passes checks, it is repeated until action succeeds or locator's timeout is reached;
+// checks must not change page, once action is started it is repeated only if it failed with no effect, so it is never applied twice
+func (l *Locator) act(checks []actionCheck, action func(e *Element) error) error {
+	timeout := time.After(l.timeout)
+	for {
+		started, err := l.attempt(checks, action)
+		if err == nil || !retryable(err) || started && !noEffect(err) {
+			return err
+		}
+		select {
+		case <-timeout:
+			return &LocatorTimeoutError{Locator: l.String(), Deadline: l.timeout, Err: err}
+		case <-time.After(locatorPollInterval):
+		case <-l.session.closed:
+			return ErrSessionAlreadyClosed
+		case <-l.session.Context().Done():
+			return l.session.Context().Err()
+		}
+	}
+}
+
+// attempt reports whether action was started, element of failed attempt is released
+func (l *Locator) attempt(checks []actionCheck, action func(e *Element) error) (started bool, err error) {
+	e, err := l.element()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			releaseElements([]*Element{e})
+		}
+	}()
+	for _, check := range checks {
+		if err = check(e); err != nil {
+			return false, err
+		}
+	}
+	return true, action(e)
+}
+
+// read runs read of element as check, so it is repeated on any retryable error
+func (l *Locator) read(read actionCheck) error {
+	return l.act([]actionCheck{read}, func(*Element) error { return nil })
+}
+
+// Element waits until element is attached and returns it
+func (l *Locator) Element() (e *Element, err error) {
+	err = l.read(func(found *Element) error {
+		e = found
+		return nil
+	})
+	return e, err
+}
+
+// Count returns number of matched elements without waiting
+func (l *Locator) Count() (int, error) {
+	elements, err := l.resolve()
+	releaseElements(elements)
+	return len(elements), err
+}
+
+// IsVisible is matched element visible, it does not wait and reports false if nothing matches
+func (l *Locator) IsVisible() (bool, error) {
+	e, err := l.element()
+	if errors.As(err, &NoSuchElementError{}) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer releaseElements([]*Element{e})
+	if err = checkVisible(e); err == ErrElementInvisible {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Click waits until element is visible, stable, enabled and receives events, and clicks it
+func (l *Locator) Click() error {
+	var x, y float64
+	target := func(e *Element) (err error) {
+		x, y, err = e.clickTarget()
+		return err
+	}
+	return l.act([]actionCheck{checkVisible, checkStable, checkEnabled, checkReceivesEvents, target}, func(e *Element) error {
+		return e.clickAt(x, y)
+	})
+}
+
+// Hover waits until element is visible, stable and receives events, and hovers mouse on it
+func (l *Locator) Hover() error {
+	var x, y float64
+	target := func(e *Element) (err error) {
+		x, y, err = e.clickablePoint()
+		return err
+	}
+	return l.act([]actionCheck{checkVisible, checkStable, checkReceivesEvents, target}, func(e *Element) error {
+		return e.session.MouseMove(x, y)
+	})
+}
+
+// Type waits until element is visible and enabled, and types text into it
+func (l *Locator) Type(text string, delay time.Duration) error {
+	return l.act([]actionCheck{checkVisible, checkEnabled}, func(e *Element) error {
+		return e.Type(text, delay)
+	})
+}
+
+// InsertText waits until element is visible and enabled, and inserts text into it
+func (l *Locator) InsertText(text string) error {
+	return l.act([]actionCheck{checkVisible, checkEnabled}, func(e *Element) error {
+		return e.InsertText(text)
+	})
+}
+
+// Clear waits until element is visible and enabled, and clears it
+func (l *Locator) Clear() error {
+	return l.act([]actionCheck{checkVisible, checkEnabled}, (*Element).Clear)
+}
+
+// Focus waits until element is attached and focuses it
+func (l *Locator) Focus() error {
+	return l.act(nil, (*Element).Focus)
+}
+
+// Select waits until element is visible and enabled, and selects options with values
+func (l *Locator) Select(values ...string) error {
+	return l.act([]actionCheck{checkVisible, checkEnabled}, func(e *Element) error {
+		return e.Select(values...)
+	})
+}
+
+// Checkbox waits until element is visible and enabled, and checks or unchecks it
+func (l *Locator) Checkbox(check bool) error {
+	return l.act([]actionCheck{checkVisible, checkEnabled}, func(e *Element) error {
+		return e.Checkbox(check)
+	})
+}
+
+// GetText waits until element is attached and returns its text
+func (l *Locator) GetText() (text string, err error) {
+	err = l.read(func(e *Element) error {
+		text, err = e.GetText()
+		return err
+	})
+	return text, err
+}
+
+// GetAttr waits until element is attached and returns its attribute
+func (l *Locator) GetAttr(attr string) (value string, err error) {
+	err = l.read(func(e *Element) error {
+		value, err = e.GetAttr(attr)
+		return err
+	})
+	return value, err
+}
@@ -0,0 +1,208 @@
+package cdp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogEntry one protocol log record
+type LogEntry struct {
+	Time      time.Time
+	Level     OutLevel
+	SessionID string        // empty for browser-wide messages
+	Method    string        // protocol method or event name
+	MessageID int64         // protocol message id, zero for events
+	Duration  time.Duration // time between request and its response
+	Direction string        // RecordSend, RecordReceive, RecordEvent or empty for messages that are not protocol traffic
+	Error     error
+	Payload   string // raw protocol message, truncated according to payload limit
+	Message   string // human readable message
+}
+
+// Logger receives log entries, implementation must be safe for concurrent use
+type Logger interface {
+	Log(entry *LogEntry)
+}
+
+type stdLogger struct {
+	out *log.Logger
+}
+
+// NewStdLogger logger that prints entries as text lines to log.Logger
+func NewStdLogger(out *log.Logger) Logger {
+	return &stdLogger{out: out}
+}
+
+func (l *stdLogger) Log(e *LogEntry) {
+	var fields []string
+	if e.Direction != "" {
+		fields = append(fields, e.Direction)
+	}
+	if e.SessionID != "" {
+		fields = append(fields, "session="+e.SessionID)
+	}
+	if e.MessageID != 0 {
+		fields = append(fields, fmt.Sprintf("id=%d", e.MessageID))
+	}
+	if e.Method != "" {
+		fields = append(fields, "method="+e.Method)
+	}
+	if e.Duration != 0 {
+		fields = append(fields, "duration="+e.Duration.String())
+	}
+	if e.Error != nil {
+		fields = append(fields, fmt.Sprintf("error=%q", e.Error.Error()))
+	}
+	if e.Message != "" {
+		fields = append(fields, e.Message)
+	}
+	if e.Payload != "" {
+		fields = append(fields, e.Payload)
+	}
+	l.out.Print(strings.Join(fields, " "))
+}
+
+type jsonLogger struct {
+	mutex *sync.Mutex
+	out   io.Writer
+}
+
+// NewJSONLogger logger that writes entries as JSON lines
+func NewJSONLogger(out io.Writer) Logger {
+	return &jsonLogger{mutex: &sync.Mutex{}, out: out}
+}
+
+func (l *jsonLogger) Log(e *LogEntry) {
+	entry := struct {
+		Time      time.Time       `json:"time"`
+		Level     OutLevel        `json:"level"`
+		SessionID string          `json:"sessionId,omitempty"`
+		Method    string          `json:"method,omitempty"`
+		MessageID int64           `json:"messageId,omitempty"`
+		Duration  string          `json:"duration,omitempty"`
+		Direction string          `json:"direction,omitempty"`
+		Error     string          `json:"error,omitempty"`
+		Payload   json.RawMessage `json:"payload,omitempty"`
+		Message   string          `json:"message,omitempty"`
+	}{
+		Time:      e.Time,
+		Level:     e.Level,
+		SessionID: e.SessionID,
+		Method:    e.Method,
+		MessageID: e.MessageID,
+		Direction: e.Direction,
+		Message:   e.Message,
+	}
+	if e.Duration != 0 {
+		entry.Duration = e.Duration.String()
+	}
+	if e.Error != nil {
+		entry.Error = e.Error.Error()
+	}
+	if e.Payload != "" {
+		if json.Valid([]byte(e.Payload)) {
+			entry.Payload = json.RawMessage(e.Payload)
+		} else {
+			// truncated payload is not a valid json anymore
+			entry.Payload, _ = json.Marshal(e.Payload)
+		}
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	_, _ = l.out.Write(append(b, '\n'))
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Log(*LogEntry) {}
+
+// NopLogger logger that discards everything
+var NopLogger Logger = nopLogger{}
+
+// logConfig logger with client-wide and per session levels
+type logConfig struct {
+	mutex        *sync.RWMutex
+	logger       Logger
+	level        OutLevel
+	levels       map[string]OutLevel
+	payloadLimit int
+}
+
+func newLogConfig() *logConfig {
+	return &logConfig{
+		mutex:  &sync.RWMutex{},
+		logger: NewStdLogger(log.New(os.Stderr, "", log.LstdFlags)),
+		level:  LevelProtocolErrors,
+		levels: map[string]OutLevel{},
+	}
+}
+
+// SetLogger set logger for all sessions of client
+func (w *WSClient) SetLogger(logger Logger) {
+	w.log.mutex.Lock()
+	defer w.log.mutex.Unlock()
+	w.log.logger = logger
+}
+
+// SetLogOutput use standard text logger that writes to writer
+func (w *WSClient) SetLogOutput(writer io.Writer) {
+	w.SetLogger(NewStdLogger(log.New(writer, "", log.LstdFlags)))
+}
+
+// SetLogLevel set default log level, sessions with own level (Session.SetOutLevel) are not affected
+func (w *WSClient) SetLogLevel(level OutLevel) {
+	w.log.mutex.Lock()
+	defer w.log.mutex.Unlock()
+	w.log.level = level
+}
+
+// SetLogPayloadLimit truncate logged payloads to limit bytes (useful for screenshots etc.), 0 means no limit
+func (w *WSClient) SetLogPayloadLimit(limit int) {
+	w.log.mutex.Lock()
+	defer w.log.mutex.Unlock()
+	w.log.payloadLimit = limit
+}
+
+func (w *WSClient) setSessionLogLevel(sessionID string, level OutLevel) {
+	w.log.mutex.Lock()
+	defer w.log.mutex.Unlock()
+	w.log.levels[sessionID] = level
+}
+
+func (w *WSClient) enabled(sessionID string, level OutLevel) bool {
+	w.log.mutex.RLock()
+	defer w.log.mutex.RUnlock()
+	current, has := w.log.levels[sessionID]
+	if !has {
+		current = w.log.level
+	}
+	return level&current == level
+}
+
+func (w *WSClient) logf(entry *LogEntry, payload []byte) {
+	if !w.enabled(entry.SessionID, entry.Level) {
+		return
+	}
+	w.log.mutex.RLock()
+	logger, limit := w.log.logger, w.log.payloadLimit
+	w.log.mutex.RUnlock()
+	if payload != nil {
+		if limit > 0 && len(payload) > limit {
+			entry.Payload = fmt.Sprintf("%s...(%d bytes truncated)", payload[:limit], len(payload)-limit)
+		} else {
+			entry.Payload = string(payload)
+		}
+	}
+	entry.Time = time.Now()
+	logger.Log(entry)
+}
@@ -3,12 +3,12 @@ package cdp
 import (
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"math"
 	"sync"
 	"time"
 
 	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
 )
 
 // Network domain
@@ -32,13 +32,14 @@ type Emulation = Session
 // Page domain
 type Page = Session
 
-func (session Session) lifecycleEvent(eventType devtool.LifecycleEventType) func() error {
+func (session Session) lifecycleEvent(frameID string, eventType devtool.LifecycleEventType) func() error {
 	return session.eventFired("Page.lifecycleEvent", func(e *Event) bool {
 		var lifecycle = new(devtool.LifecycleEvent)
 		if err := json.Unmarshal(e.Params, lifecycle); err != nil {
-			session.exception(err)
+			session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: e.Method, Error: err}, e.Params)
+			return false
 		}
-		return lifecycle.FrameID == session.target && lifecycle.Name == eventType
+		return lifecycle.FrameID == frameID && lifecycle.Name == eventType
 	})
 }
 
@@ -53,15 +54,16 @@ func (session Session) eventFired(method string, filter func(*Event) bool) func(
 		}
 	})
 	return func() error {
-		defer close(c)
 		defer unsubscribe()
 		select {
 		case <-c:
 			return nil
 		case <-session.closed:
 			return ErrSessionAlreadyClosed
+		case <-session.Context().Done():
+			return session.Context().Err()
 		case <-time.After(session.deadline):
-			return ErrLoadTimeout
+			return &TimeoutError{Method: method, Deadline: session.deadline, Err: ErrLoadTimeout}
 		}
 	}
 }
@@ -73,7 +75,7 @@ func (session Session) Navigate(urlStr string, lifecycleEvents ...devtool.Lifecy
 	}
 	// optional load events
 	for _, lc := range lifecycleEvents {
-		loader = append(loader, session.lifecycleEvent(lc))
+		loader = append(loader, session.lifecycleEvent(session.target, lc))
 	}
 	nav := new(devtool.NavigationResult)
 	p := Map{
@@ -85,7 +87,7 @@ func (session Session) Navigate(urlStr string, lifecycleEvents ...devtool.Lifecy
 		return err
 	}
 	if nav.ErrorText != "" {
-		return errors.New(nav.ErrorText)
+		return &ProtocolError{Message: nav.ErrorText, Method: "Page.navigate", Params: p, SessionID: session.id}
 	}
 	if nav.LoaderID == "" {
 		return nil // no navigate need
@@ -104,6 +106,8 @@ func (session Session) Reload() error {
 	if err := session.call("Page.reload", Map{"ignoreCache": true}, nil); err != nil {
 		return err
 	}
+	// reload starts new renderer if target crashed
+	session.crash.reset()
 	session.state.reset()
 	return loader()
 }
@@ -111,20 +115,15 @@ func (session Session) Reload() error {
 // OnTargetCreated subscribe to Target.targetCreated event and return channel with targetID
 func (session Session) OnTargetCreated(before func()) (*Session, error) {
 	var eventFired = make(chan string, 1)
-	unsubscribe := session.Subscribe("Target.targetCreated", func(e *Event) {
-		targetCreated := new(devtool.TargetCreated)
-		if err := json.Unmarshal(e.Params, targetCreated); err != nil {
-			session.exception(err)
-			return
-		}
+	unsubscribe := session.subscribeEvent("Target.targetCreated", func() interface{} { return new(devtool.TargetCreated) }, func(v interface{}) {
+		targetCreated := v.(*devtool.TargetCreated)
 		if targetCreated.TargetInfo.Type == "page" && targetCreated.TargetInfo.OpenerID == session.target {
 			select {
 			case eventFired <- targetCreated.TargetInfo.TargetID:
 			default:
 			}
 		}
-	})
-	defer close(eventFired)
+	}, nil)
 	defer unsubscribe()
 	before()
 	select {
@@ -134,8 +133,10 @@ func (session Session) OnTargetCreated(before func()) (*Session, error) {
 		return nil, err
 	case <-session.closed:
 		return nil, ErrSessionAlreadyClosed
+	case <-session.Context().Done():
+		return nil, session.Context().Err()
 	case <-time.After(session.deadline):
-		return nil, ErrTargetCreatedTimeout
+		return nil, &TimeoutError{Method: "Target.targetCreated", Deadline: session.deadline, Err: ErrTargetCreatedTimeout}
 	}
 }
 
@@ -144,8 +145,12 @@ func (session Session) Main() {
 	session.state.reset()
 }
 
-// SwitchTo switch context to frame
+// SwitchTo switch context to frame, out-of-process frames are switched to their own sessions
 func (session *Session) SwitchTo(frameID string) error {
+	if s := session.frameSession(frameID); s != nil {
+		session.state.setSession(frameID, s.id)
+		return nil
+	}
 	c, err := session.createContext(frameID)
 	if err != nil {
 		return err
@@ -164,21 +169,21 @@ func (session Session) NewTab(url string) (*Session, error) {
 	if url == "" {
 		url = blankPage // headless chrome crash when url is empty
 	}
-	result := Map{}
-	if err := session.call("Target.createTarget", Map{"url": url}, &result); err != nil {
+	result, err := (&protocol.TargetCreateTargetParams{URL: url}).Do(session)
+	if err != nil {
 		return nil, err
 	}
-	return NewSession(&session, result["targetId"].(string))
+	return NewSession(&session, string(result.TargetID))
 }
 
 // Query query element on page by css selector
 func (session Session) Query(selector string) (*Element, error) {
-	return session.query(nil, selector)
+	return session.query(session.currentContext(), nil, selector)
 }
 
 // QueryAll queryAll elements on page by css selector
 func (session Session) QueryAll(selector string) ([]*Element, error) {
-	return session.queryAll(nil, selector)
+	return session.queryAll(session.currentContext(), nil, selector)
 }
 
 // NavigateHistory -1 = Back, +1 = Forward
@@ -230,12 +235,12 @@ func (session Session) CaptureScreenshot(format string, quality int8, clip *devt
 	if clip != nil {
 		p["clip"] = clip
 	}
-	result := Map{}
-	err := session.call("Page.captureScreenshot", p, &result)
+	result := new(protocol.PageCaptureScreenshotResult)
+	err := session.call("Page.captureScreenshot", p, result)
 	if err != nil {
 		return nil, err
 	}
-	return base64.StdEncoding.DecodeString(result["data"].(string))
+	return base64.StdEncoding.DecodeString(result.Data)
 }
 
 // Listen subscribe to listen cdp events with methods name
@@ -243,14 +248,19 @@ func (session Session) CaptureScreenshot(format string, quality int8, clip *devt
 // channel will be closed after unsubscribe func call
 func (session Session) Listen(methods ...string) (chan *Event, func()) {
 	var (
-		wg          = sync.WaitGroup{}
+		mutex       = &sync.RWMutex{}
+		closed      = false
 		queue       = make(chan *Event, 10)
 		interrupt   = make(chan struct{})
 		unsubscribe = make([]func(), len(methods))
 	)
+	// callbacks are asynchronous, so queue is closed only when none of them is sending
 	callback := func(e *Event) {
-		wg.Add(1)
-		defer wg.Done()
+		mutex.RLock()
+		defer mutex.RUnlock()
+		if closed {
+			return
+		}
 		select {
 		case queue <- e:
 		case <-interrupt:
@@ -264,7 +274,9 @@ func (session Session) Listen(methods ...string) (chan *Event, func()) {
 		for _, un := range unsubscribe {
 			un()
 		}
-		wg.Wait()
+		mutex.Lock()
+		defer mutex.Unlock()
+		closed = true
 		close(queue)
 	}
 }
@@ -2,7 +2,6 @@ package cdp
 
 import (
 	"encoding/base64"
-	"encoding/json"
 
 	"github.com/ecwid/cdp/pkg/devtool"
 )
@@ -155,14 +154,9 @@ type Interceptor struct {
 
 // Intercept ...
 func (session Network) Intercept(patterns []*devtool.RequestPattern, fn func(*devtool.RequestPaused, *Interceptor)) func() {
-	unsubscribe := session.Subscribe("Fetch.requestPaused", func(e *Event) {
-		request := new(devtool.RequestPaused)
-		if err := json.Unmarshal(e.Params, request); err != nil {
-			session.exception(err)
-			return
-		}
-		go fn(request, &Interceptor{Network: &session})
-	})
+	unsubscribe := session.subscribeEvent("Fetch.requestPaused", func() interface{} { return new(devtool.RequestPaused) }, func(v interface{}) {
+		go fn(v.(*devtool.RequestPaused), &Interceptor{Network: &session})
+	}, nil)
 	if err := session.fetchEnable(patterns, false); err != nil {
 		session.exception(err)
 	}
@@ -1,9 +1,10 @@
 package cdp
 
 import (
-	"strings"
+	"errors"
 
 	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
 )
 
 const blankPage = "about:blank"
@@ -15,11 +16,14 @@ func (session Page) getNavigationHistory() (*devtool.NavigationHistory, error) {
 }
 
 func (session Page) createIsolatedWorld(frameID, worldName string) (int64, error) {
-	result := Map{}
-	if err := session.call("Page.createIsolatedWorld", Map{"frameId": frameID, "worldName": worldName}, &result); err != nil {
+	result, err := (&protocol.PageCreateIsolatedWorldParams{
+		FrameID:   protocol.PageFrameID(frameID),
+		WorldName: worldName,
+	}).Do(session)
+	if err != nil {
 		return 0, err
 	}
-	return int64(result["executionContextId"].(float64)), nil
+	return int64(result.ExecutionContextID), nil
 }
 
 func (session Page) navigateToHistoryEntry(entryID int64) error {
@@ -54,12 +58,11 @@ func (session Page) activate(targetID string) error {
 
 // AddScriptToEvaluateOnNewDocument https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnNewDocument
 func (session Page) AddScriptToEvaluateOnNewDocument(source string) (string, error) {
-	var result = Map{}
-	err := session.call("Page.addScriptToEvaluateOnNewDocument", Map{"source": source}, &result)
+	result, err := (&protocol.PageAddScriptToEvaluateOnNewDocumentParams{Source: source}).Do(session)
 	if err != nil {
 		return "", err
 	}
-	return result["identifier"].(string), nil
+	return string(result.Identifier), nil
 }
 
 // RemoveScriptToEvaluateOnNewDocument https://chromedevtools.github.io/devtools-protocol/tot/Page#method-removeScriptToEvaluateOnNewDocument
@@ -75,17 +78,11 @@ func (session Page) SetDownloadBehavior(behavior devtool.DownloadBehavior, downl
 	}, nil)
 }
 
-func (session Session) query(parent *Element, selector string) (*Element, error) {
-	selector = strings.ReplaceAll(selector, `"`, `\"`)
-	var (
-		err     error
-		e       *devtool.RemoteObject
-		context = session.currentContext()
-	)
-	if parent == nil {
-		e, err = session.evaluate(`document.querySelector("`+selector+`")`, context, false, false)
-	} else {
-		e, err = parent.call(`function(s){return this.querySelector(s)}`, selector)
+// query element by selector in execution context, context is ignored if parent is set
+func (session Session) query(context int64, parent *Element, selector string) (*Element, error) {
+	e, err := session.selectorCall(context, parent, selector, false)
+	if errors.Is(err, errNoDocument) {
+		return nil, NoSuchElementError{selector: selector, context: context}
 	}
 	if err != nil {
 		return nil, err
@@ -93,38 +90,34 @@ func (session Session) query(parent *Element, selector string) (*Element, error)
 	if e.ObjectID == "" {
 		return nil, NoSuchElementError{selector: selector, context: context}
 	}
-	return newElement(&session, parent, e), nil
+	return newElement(&session, context, e), nil
 }
 
-func (session Session) queryAll(parent *Element, selector string) ([]*Element, error) {
-	selector = strings.ReplaceAll(selector, `"`, `\"`)
-	var (
-		err     error
-		array   *devtool.RemoteObject
-		context = session.currentContext()
-	)
-	if parent == nil {
-		array, err = session.evaluate(`document.querySelectorAll("`+selector+`")`, context, false, false)
-	} else {
-		array, err = parent.call(`function(s){return this.querySelectorAll(s)}`, selector)
+func (session Session) queryAll(context int64, parent *Element, selector string) ([]*Element, error) {
+	array, err := session.selectorCall(context, parent, selector, true)
+	if errors.Is(err, errNoDocument) {
+		return nil, NoSuchElementError{selector: selector, context: context}
 	}
 	if err != nil {
 		return nil, err
 	}
-	if array == nil || array.Description == "NodeList(0)" {
-		_ = session.releaseObject(array.ObjectID)
+	if array.ObjectID == "" {
 		return nil, NoSuchElementError{selector: selector, context: context}
 	}
-	all := make([]*Element, 0)
+	defer func() { _ = session.releaseObject(array.ObjectID) }()
 	descriptor, err := session.getProperties(array.ObjectID)
 	if err != nil {
 		return nil, err
 	}
+	all := make([]*Element, 0)
 	for _, d := range descriptor {
-		if !d.Enumerable {
+		if !d.Enumerable || d.Value == nil || d.Value.ObjectID == "" {
 			continue
 		}
-		all = append(all, newElement(&session, parent, d.Value))
+		all = append(all, newElement(&session, context, d.Value))
+	}
+	if len(all) == 0 {
+		return nil, NoSuchElementError{selector: selector, context: context}
 	}
 	return all, nil
 }
@@ -0,0 +1,57 @@
+package cdp
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sync"
+)
+
+// pipeTransport transport over pipes, browser is started with --remote-debugging-pipe
+// and reads messages from fd 3 and writes to fd 4, every message is terminated by NUL byte
+type pipeTransport struct {
+	writeMutex *sync.Mutex
+	reader     *bufio.Reader
+	in         io.ReadCloser
+	out        io.WriteCloser
+}
+
+// NewPipeTransport creates transport that reads NUL-delimited messages from in and writes them to out
+func NewPipeTransport(in io.ReadCloser, out io.WriteCloser) Transport {
+	return &pipeTransport{
+		writeMutex: &sync.Mutex{},
+		reader:     bufio.NewReader(in),
+		in:         in,
+		out:        out,
+	}
+}
+
+// Send writes message and its terminator separately, so the caller's buffer is never appended to
+func (t *pipeTransport) Send(message []byte) error {
+	t.writeMutex.Lock()
+	defer t.writeMutex.Unlock()
+	if _, err := t.out.Write(message); err != nil {
+		return err
+	}
+	_, err := t.out.Write([]byte{0})
+	return err
+}
+
+func (t *pipeTransport) Receive() ([]byte, error) {
+	message, err := t.reader.ReadBytes(0)
+	if err != nil {
+		if len(bytes.TrimSpace(message)) != 0 && err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return message[:len(message)-1], nil
+}
+
+func (t *pipeTransport) Close() error {
+	err := t.out.Close()
+	if e := t.in.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,342 @@
+// Package cdptest provides in-process fake of devtools endpoint for unit testing without browser
+package cdptest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/gorilla/websocket"
+)
+
+// ErrNoResponse returned by handler means that server does not respond to the message at all
+var ErrNoResponse = errors.New("no response")
+
+// Message incoming protocol message
+type Message struct {
+	ID        int64           `json:"id"`
+	SessionID string          `json:"sessionId,omitempty"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params,omitempty"`
+}
+
+// Decode unmarshal message params into v
+func (m *Message) Decode(v interface{}) error {
+	if len(m.Params) == 0 {
+		return nil
+	}
+	return json.Unmarshal(m.Params, v)
+}
+
+// Error protocol error, handler returns it to respond with error
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Handler handles protocol method, returned result is sent back as response's result
+type Handler func(m *Message) (interface{}, error)
+
+type response struct {
+	ID        int64       `json:"id,omitempty"`
+	SessionID string      `json:"sessionId,omitempty"`
+	Result    interface{} `json:"result,omitempty"`
+	Error     *Error      `json:"error,omitempty"`
+	Method    string      `json:"method,omitempty"`
+	Params    interface{} `json:"params,omitempty"`
+}
+
+type conn struct {
+	*websocket.Conn
+	writeMutex sync.Mutex
+}
+
+func (c *conn) write(v interface{}) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return c.WriteJSON(v)
+}
+
+// Server fake devtools endpoint, it answers target and domain methods with empty results by default
+type Server struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	handlers map[string]Handler
+	conns    map[*conn]struct{}
+	targets  []*devtool.TargetInfo
+	received []*Message
+	seq      int
+	upgrader websocket.Upgrader
+}
+
+// NewServer starts new fake server with one page target
+func NewServer() *Server {
+	s := &Server{
+		handlers: map[string]Handler{},
+		conns:    map[*conn]struct{}{},
+	}
+	s.targets = []*devtool.TargetInfo{s.newTarget("page", blankPage)}
+	s.Handle("Target.getTargets", s.getTargets)
+	s.Handle("Target.attachToTarget", s.attachToTarget)
+	s.Handle("Target.getTargetInfo", s.getTargetInfo)
+	s.Handle("Target.createTarget", s.createTarget)
+	s.Handle("Target.closeTarget", s.closeTarget)
+	s.Handle("Runtime.evaluate", undefined)
+	s.Handle("Runtime.callFunctionOn", undefined)
+	s.Handle("Browser.getVersion", func(*Message) (interface{}, error) {
+		return devtool.Version{ProtocolVersion: "1.3", Product: "cdptest"}, nil
+	})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", s.version)
+	mux.HandleFunc("/json", s.list)
+	mux.HandleFunc("/json/list", s.list)
+	mux.HandleFunc("/devtools/", s.serveWS)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+const blankPage = "about:blank"
+
+// undefined evaluation result
+func undefined(*Message) (interface{}, error) {
+	return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "undefined"}}, nil
+}
+
+// WebSocketURL browser's websocket debugger url
+func (s *Server) WebSocketURL() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http") + "/devtools/browser/cdptest"
+}
+
+// Handle set handler for method, previous one is replaced
+func (s *Server) Handle(method string, h Handler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.handlers[method] = h
+}
+
+// Emit send event to all connected clients
+func (s *Server) Emit(sessionID, method string, params interface{}) error {
+	s.mutex.Lock()
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mutex.Unlock()
+	for _, c := range conns {
+		if err := c.write(response{SessionID: sessionID, Method: method, Params: params}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Received returns received messages with method, all messages if method is empty
+func (s *Server) Received(method string) []*Message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var messages []*Message
+	for _, m := range s.received {
+		if method == "" || m.Method == method {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+// Targets returns current targets
+func (s *Server) Targets() []*devtool.TargetInfo {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]*devtool.TargetInfo{}, s.targets...)
+}
+
+// AddTarget adds target of any type (e.g. "service_worker") without notifying clients
+func (s *Server) AddTarget(targetType, url string) *devtool.TargetInfo {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	t := s.newTarget(targetType, url)
+	s.targets = append(s.targets, t)
+	return t
+}
+
+// Disconnect abnormally closes all client connections
+func (s *Server) Disconnect() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for c := range s.conns {
+		_ = c.Close()
+	}
+}
+
+func (s *Server) newTarget(targetType, url string) *devtool.TargetInfo {
+	s.seq++
+	return &devtool.TargetInfo{
+		TargetID: fmt.Sprintf("%s-%d", targetType, s.seq),
+		Type:     targetType,
+		URL:      url,
+	}
+}
+
+func (s *Server) getTargets(*Message) (interface{}, error) {
+	return devtool.TargetInfos{TargetInfos: s.Targets()}, nil
+}
+
+func (s *Server) getTargetInfo(m *Message) (interface{}, error) {
+	var p struct {
+		TargetID string `json:"targetId"`
+	}
+	if err := m.Decode(&p); err != nil {
+		return nil, err
+	}
+	for _, t := range s.Targets() {
+		if t.TargetID == p.TargetID {
+			return map[string]*devtool.TargetInfo{"targetInfo": t}, nil
+		}
+	}
+	return nil, &Error{Code: -32602, Message: "No target with given id found"}
+}
+
+func (s *Server) attachToTarget(m *Message) (interface{}, error) {
+	var p struct {
+		TargetID string `json:"targetId"`
+	}
+	if err := m.Decode(&p); err != nil {
+		return nil, err
+	}
+	for _, t := range s.Targets() {
+		if t.TargetID == p.TargetID {
+			return map[string]string{"sessionId": SessionID(p.TargetID)}, nil
+		}
+	}
+	return nil, &Error{Code: -32602, Message: "No target with given id found"}
+}
+
+func (s *Server) createTarget(m *Message) (interface{}, error) {
+	var p struct {
+		URL              string `json:"url"`
+		BrowserContextID string `json:"browserContextId"`
+	}
+	if err := m.Decode(&p); err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	t := s.newTarget("page", p.URL)
+	t.BrowserContextID = p.BrowserContextID
+	s.targets = append(s.targets, t)
+	s.mutex.Unlock()
+	return devtool.CreatedTarget{TargetID: t.TargetID}, nil
+}
+
+func (s *Server) closeTarget(m *Message) (interface{}, error) {
+	var p struct {
+		TargetID string `json:"targetId"`
+	}
+	if err := m.Decode(&p); err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	var found bool
+	for n, t := range s.targets {
+		if t.TargetID == p.TargetID {
+			s.targets = append(s.targets[:n], s.targets[n+1:]...)
+			found = true
+			break
+		}
+	}
+	s.mutex.Unlock()
+	if !found {
+		return nil, &Error{Code: -32602, Message: "No target with given id found"}
+	}
+	go func() {
+		_ = s.Emit(SessionID(p.TargetID), "Target.targetDestroyed", devtool.TargetDestroyed{TargetID: p.TargetID})
+		_ = s.Emit("", "Target.detachedFromTarget", devtool.DetachedFromTarget{SessionID: SessionID(p.TargetID)})
+	}()
+	return map[string]bool{"success": true}, nil
+}
+
+// SessionID session id that server gives for attaching to target
+func SessionID(targetID string) string {
+	return "session-" + targetID
+}
+
+func (s *Server) version(w http.ResponseWriter, r *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"Browser":              "cdptest",
+		"Protocol-Version":     "1.3",
+		"webSocketDebuggerUrl": s.WebSocketURL(),
+	})
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	var list []map[string]string
+	for _, t := range s.Targets() {
+		list = append(list, map[string]string{"id": t.TargetID, "type": t.Type, "url": t.URL, "title": t.Title})
+	}
+	_ = json.NewEncoder(w).Encode(list)
+}
+
+func (s *Server) serveWS(w http.ResponseWriter, r *http.Request) {
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &conn{Conn: ws}
+	s.mutex.Lock()
+	s.conns[c] = struct{}{}
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, c)
+		s.mutex.Unlock()
+		_ = c.Close()
+	}()
+	for {
+		m := new(Message)
+		if err := c.ReadJSON(m); err != nil {
+			return
+		}
+		s.mutex.Lock()
+		s.received = append(s.received, m)
+		h, has := s.handlers[m.Method]
+		s.mutex.Unlock()
+		if !has {
+			h = func(*Message) (interface{}, error) { return struct{}{}, nil }
+		}
+		go s.respond(c, m, h)
+	}
+}
+
+func (s *Server) respond(c *conn, m *Message, h Handler) {
+	result, err := h(m)
+	if err == ErrNoResponse {
+		return
+	}
+	resp := response{ID: m.ID, SessionID: m.SessionID, Result: result}
+	if err != nil {
+		e, ok := err.(*Error)
+		if !ok {
+			e = &Error{Code: -32000, Message: err.Error()}
+		}
+		resp.Result, resp.Error = nil, e
+	}
+	if resp.Result == nil && resp.Error == nil {
+		resp.Result = struct{}{}
+	}
+	_ = c.write(resp)
+	if m.Method == "Browser.close" {
+		msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		c.writeMutex.Lock()
+		_ = c.WriteMessage(websocket.CloseMessage, msg)
+		c.writeMutex.Unlock()
+	}
+}
@@ -0,0 +1,27 @@
+package devtool
+
+// PermissionType https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionType
+type PermissionType string
+
+// PermissionType values
+const (
+	PermissionAudioCapture            PermissionType = "audioCapture"
+	PermissionBackgroundSync          PermissionType = "backgroundSync"
+	PermissionClipboardReadWrite      PermissionType = "clipboardReadWrite"
+	PermissionClipboardSanitizedWrite PermissionType = "clipboardSanitizedWrite"
+	PermissionGeolocation             PermissionType = "geolocation"
+	PermissionMidi                    PermissionType = "midi"
+	PermissionNotifications           PermissionType = "notifications"
+	PermissionPaymentHandler          PermissionType = "paymentHandler"
+	PermissionSensors                 PermissionType = "sensors"
+	PermissionVideoCapture            PermissionType = "videoCapture"
+)
+
+// Version https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getVersion
+type Version struct {
+	ProtocolVersion string `json:"protocolVersion"`
+	Product         string `json:"product"`
+	Revision        string `json:"revision"`
+	UserAgent       string `json:"userAgent"`
+	JsVersion       string `json:"jsVersion"`
+}
@@ -127,6 +127,12 @@ type Frame struct {
 	UnreachableURL string `json:"unreachableUrl"`
 }
 
+// FrameAttached https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
+type FrameAttached struct {
+	FrameID       string `json:"frameId"`
+	ParentFrameID string `json:"parentFrameId"`
+}
+
 // FrameNavigated ...
 type FrameNavigated struct {
 	Frame *Frame `json:"frame"`
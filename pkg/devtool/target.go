@@ -33,7 +33,31 @@ type TargetDestroyed struct {
 	TargetID string `json:"targetId"`
 }
 
+// AttachedToTarget https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-attachedToTarget
+type AttachedToTarget struct {
+	SessionID          string      `json:"sessionId"`
+	TargetInfo         *TargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool        `json:"waitingForDebugger"`
+}
+
 // DetachedFromTarget https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-detachedFromTarget
 type DetachedFromTarget struct {
 	SessionID string `json:"sessionId"`
 }
+
+// BrowserContextOptions https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createBrowserContext
+type BrowserContextOptions struct {
+	DisposeOnDetach bool   `json:"disposeOnDetach,omitempty"` // If specified, disposes this context when debugging session disconnects.
+	ProxyServer     string `json:"proxyServer,omitempty"`     // Proxy server, similar to the one passed to --proxy-server
+	ProxyBypassList string `json:"proxyBypassList,omitempty"` // Proxy bypass list, similar to the one passed to --proxy-bypass-list
+}
+
+// BrowserContext https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createBrowserContext
+type BrowserContext struct {
+	BrowserContextID string `json:"browserContextId"`
+}
+
+// CreatedTarget https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createTarget
+type CreatedTarget struct {
+	TargetID string `json:"targetId"`
+}
@@ -0,0 +1,370 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+import "encoding/json"
+
+// Accessibility events
+const (
+	EventAccessibilityLoadComplete = "Accessibility.loadComplete"
+	EventAccessibilityNodesUpdated = "Accessibility.nodesUpdated"
+)
+
+// AccessibilityAXNodeID https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXNodeId
+type AccessibilityAXNodeID string
+
+// AccessibilityAXValueType https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueType
+type AccessibilityAXValueType string
+
+// AccessibilityAXValueType values
+const (
+	AccessibilityAXValueTypeBoolean            AccessibilityAXValueType = "boolean"
+	AccessibilityAXValueTypeTristate           AccessibilityAXValueType = "tristate"
+	AccessibilityAXValueTypeBooleanOrUndefined AccessibilityAXValueType = "booleanOrUndefined"
+	AccessibilityAXValueTypeIdref              AccessibilityAXValueType = "idref"
+	AccessibilityAXValueTypeIdrefList          AccessibilityAXValueType = "idrefList"
+	AccessibilityAXValueTypeInteger            AccessibilityAXValueType = "integer"
+	AccessibilityAXValueTypeNode               AccessibilityAXValueType = "node"
+	AccessibilityAXValueTypeNodeList           AccessibilityAXValueType = "nodeList"
+	AccessibilityAXValueTypeNumber             AccessibilityAXValueType = "number"
+	AccessibilityAXValueTypeString             AccessibilityAXValueType = "string"
+	AccessibilityAXValueTypeComputedString     AccessibilityAXValueType = "computedString"
+	AccessibilityAXValueTypeToken              AccessibilityAXValueType = "token"
+	AccessibilityAXValueTypeTokenList          AccessibilityAXValueType = "tokenList"
+	AccessibilityAXValueTypeDOMRelation        AccessibilityAXValueType = "domRelation"
+	AccessibilityAXValueTypeRole               AccessibilityAXValueType = "role"
+	AccessibilityAXValueTypeInternalRole       AccessibilityAXValueType = "internalRole"
+	AccessibilityAXValueTypeValueUndefined     AccessibilityAXValueType = "valueUndefined"
+)
+
+// AccessibilityAXValueSourceType https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueSourceType
+type AccessibilityAXValueSourceType string
+
+// AccessibilityAXValueSourceType values
+const (
+	AccessibilityAXValueSourceTypeAttribute      AccessibilityAXValueSourceType = "attribute"
+	AccessibilityAXValueSourceTypeImplicit       AccessibilityAXValueSourceType = "implicit"
+	AccessibilityAXValueSourceTypeStyle          AccessibilityAXValueSourceType = "style"
+	AccessibilityAXValueSourceTypeContents       AccessibilityAXValueSourceType = "contents"
+	AccessibilityAXValueSourceTypePlaceholder    AccessibilityAXValueSourceType = "placeholder"
+	AccessibilityAXValueSourceTypeRelatedElement AccessibilityAXValueSourceType = "relatedElement"
+)
+
+// AccessibilityAXValueNativeSourceType https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueNativeSourceType
+type AccessibilityAXValueNativeSourceType string
+
+// AccessibilityAXValueNativeSourceType values
+const (
+	AccessibilityAXValueNativeSourceTypeDescription    AccessibilityAXValueNativeSourceType = "description"
+	AccessibilityAXValueNativeSourceTypeFigcaption     AccessibilityAXValueNativeSourceType = "figcaption"
+	AccessibilityAXValueNativeSourceTypeLabel          AccessibilityAXValueNativeSourceType = "label"
+	AccessibilityAXValueNativeSourceTypeLabelfor       AccessibilityAXValueNativeSourceType = "labelfor"
+	AccessibilityAXValueNativeSourceTypeLabelwrapped   AccessibilityAXValueNativeSourceType = "labelwrapped"
+	AccessibilityAXValueNativeSourceTypeLegend         AccessibilityAXValueNativeSourceType = "legend"
+	AccessibilityAXValueNativeSourceTypeRubyannotation AccessibilityAXValueNativeSourceType = "rubyannotation"
+	AccessibilityAXValueNativeSourceTypeTablecaption   AccessibilityAXValueNativeSourceType = "tablecaption"
+	AccessibilityAXValueNativeSourceTypeTitle          AccessibilityAXValueNativeSourceType = "title"
+	AccessibilityAXValueNativeSourceTypeOther          AccessibilityAXValueNativeSourceType = "other"
+)
+
+// AccessibilityAXValueSource https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValueSource
+type AccessibilityAXValueSource struct {
+	Type              AccessibilityAXValueSourceType       `json:"type"`
+	Value             *AccessibilityAXValue                `json:"value,omitempty"`
+	Attribute         string                               `json:"attribute,omitempty"`
+	AttributeValue    *AccessibilityAXValue                `json:"attributeValue,omitempty"`
+	Superseded        bool                                 `json:"superseded,omitempty"`
+	NativeSource      AccessibilityAXValueNativeSourceType `json:"nativeSource,omitempty"`
+	NativeSourceValue *AccessibilityAXValue                `json:"nativeSourceValue,omitempty"`
+	Invalid           bool                                 `json:"invalid,omitempty"`
+	InvalidReason     string                               `json:"invalidReason,omitempty"`
+}
+
+// AccessibilityAXRelatedNode https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXRelatedNode
+type AccessibilityAXRelatedNode struct {
+	BackendDOMNodeID DOMBackendNodeID `json:"backendDOMNodeId"`
+	Idref            string           `json:"idref,omitempty"`
+	Text             string           `json:"text,omitempty"`
+}
+
+// AccessibilityAXProperty https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXProperty
+type AccessibilityAXProperty struct {
+	Name  AccessibilityAXPropertyName `json:"name"`
+	Value *AccessibilityAXValue       `json:"value"`
+}
+
+// AccessibilityAXValue https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXValue
+type AccessibilityAXValue struct {
+	Type         AccessibilityAXValueType      `json:"type"`
+	Value        json.RawMessage               `json:"value,omitempty"`
+	RelatedNodes []*AccessibilityAXRelatedNode `json:"relatedNodes,omitempty"`
+	Sources      []*AccessibilityAXValueSource `json:"sources,omitempty"`
+}
+
+// AccessibilityAXPropertyName https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXPropertyName
+type AccessibilityAXPropertyName string
+
+// AccessibilityAXPropertyName values
+const (
+	AccessibilityAXPropertyNameActions          AccessibilityAXPropertyName = "actions"
+	AccessibilityAXPropertyNameBusy             AccessibilityAXPropertyName = "busy"
+	AccessibilityAXPropertyNameDisabled         AccessibilityAXPropertyName = "disabled"
+	AccessibilityAXPropertyNameEditable         AccessibilityAXPropertyName = "editable"
+	AccessibilityAXPropertyNameFocusable        AccessibilityAXPropertyName = "focusable"
+	AccessibilityAXPropertyNameFocused          AccessibilityAXPropertyName = "focused"
+	AccessibilityAXPropertyNameHidden           AccessibilityAXPropertyName = "hidden"
+	AccessibilityAXPropertyNameHiddenRoot       AccessibilityAXPropertyName = "hiddenRoot"
+	AccessibilityAXPropertyNameInvalid          AccessibilityAXPropertyName = "invalid"
+	AccessibilityAXPropertyNameKeyshortcuts     AccessibilityAXPropertyName = "keyshortcuts"
+	AccessibilityAXPropertyNameSettable         AccessibilityAXPropertyName = "settable"
+	AccessibilityAXPropertyNameRoledescription  AccessibilityAXPropertyName = "roledescription"
+	AccessibilityAXPropertyNameLive             AccessibilityAXPropertyName = "live"
+	AccessibilityAXPropertyNameAtomic           AccessibilityAXPropertyName = "atomic"
+	AccessibilityAXPropertyNameRelevant         AccessibilityAXPropertyName = "relevant"
+	AccessibilityAXPropertyNameRoot             AccessibilityAXPropertyName = "root"
+	AccessibilityAXPropertyNameAutocomplete     AccessibilityAXPropertyName = "autocomplete"
+	AccessibilityAXPropertyNameHasPopup         AccessibilityAXPropertyName = "hasPopup"
+	AccessibilityAXPropertyNameLevel            AccessibilityAXPropertyName = "level"
+	AccessibilityAXPropertyNameMultiselectable  AccessibilityAXPropertyName = "multiselectable"
+	AccessibilityAXPropertyNameOrientation      AccessibilityAXPropertyName = "orientation"
+	AccessibilityAXPropertyNameMultiline        AccessibilityAXPropertyName = "multiline"
+	AccessibilityAXPropertyNameReadonly         AccessibilityAXPropertyName = "readonly"
+	AccessibilityAXPropertyNameRequired         AccessibilityAXPropertyName = "required"
+	AccessibilityAXPropertyNameValuemin         AccessibilityAXPropertyName = "valuemin"
+	AccessibilityAXPropertyNameValuemax         AccessibilityAXPropertyName = "valuemax"
+	AccessibilityAXPropertyNameValuetext        AccessibilityAXPropertyName = "valuetext"
+	AccessibilityAXPropertyNameChecked          AccessibilityAXPropertyName = "checked"
+	AccessibilityAXPropertyNameExpanded         AccessibilityAXPropertyName = "expanded"
+	AccessibilityAXPropertyNameModal            AccessibilityAXPropertyName = "modal"
+	AccessibilityAXPropertyNamePressed          AccessibilityAXPropertyName = "pressed"
+	AccessibilityAXPropertyNameSelected         AccessibilityAXPropertyName = "selected"
+	AccessibilityAXPropertyNameActivedescendant AccessibilityAXPropertyName = "activedescendant"
+	AccessibilityAXPropertyNameControls         AccessibilityAXPropertyName = "controls"
+	AccessibilityAXPropertyNameDescribedby      AccessibilityAXPropertyName = "describedby"
+	AccessibilityAXPropertyNameDetails          AccessibilityAXPropertyName = "details"
+	AccessibilityAXPropertyNameErrormessage     AccessibilityAXPropertyName = "errormessage"
+	AccessibilityAXPropertyNameFlowto           AccessibilityAXPropertyName = "flowto"
+	AccessibilityAXPropertyNameLabelledby       AccessibilityAXPropertyName = "labelledby"
+	AccessibilityAXPropertyNameOwns             AccessibilityAXPropertyName = "owns"
+	AccessibilityAXPropertyNameURL              AccessibilityAXPropertyName = "url"
+)
+
+// AccessibilityAXNode https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#type-AXNode
+type AccessibilityAXNode struct {
+	NodeID           AccessibilityAXNodeID      `json:"nodeId"`
+	Ignored          bool                       `json:"ignored"`
+	IgnoredReasons   []*AccessibilityAXProperty `json:"ignoredReasons,omitempty"`
+	Role             *AccessibilityAXValue      `json:"role,omitempty"`
+	ChromeRole       *AccessibilityAXValue      `json:"chromeRole,omitempty"`
+	Name             *AccessibilityAXValue      `json:"name,omitempty"`
+	Description      *AccessibilityAXValue      `json:"description,omitempty"`
+	Value            *AccessibilityAXValue      `json:"value,omitempty"`
+	Properties       []*AccessibilityAXProperty `json:"properties,omitempty"`
+	ParentID         AccessibilityAXNodeID      `json:"parentId,omitempty"`
+	ChildIds         []AccessibilityAXNodeID    `json:"childIds,omitempty"`
+	BackendDOMNodeID DOMBackendNodeID           `json:"backendDOMNodeId,omitempty"`
+	FrameID          PageFrameID                `json:"frameId,omitempty"`
+}
+
+// AccessibilityDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-disable
+type AccessibilityDisableParams struct {
+}
+
+// ProtocolMethod returns Accessibility.disable
+func (*AccessibilityDisableParams) ProtocolMethod() string {
+	return "Accessibility.disable"
+}
+
+// Do sends Accessibility.disable
+func (p *AccessibilityDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AccessibilityEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-enable
+type AccessibilityEnableParams struct {
+}
+
+// ProtocolMethod returns Accessibility.enable
+func (*AccessibilityEnableParams) ProtocolMethod() string {
+	return "Accessibility.enable"
+}
+
+// Do sends Accessibility.enable
+func (p *AccessibilityEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AccessibilityGetPartialAXTreeParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getPartialAXTree
+type AccessibilityGetPartialAXTreeParams struct {
+	NodeID         DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID  DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID       RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	FetchRelatives bool                  `json:"fetchRelatives,omitempty"`
+}
+
+// ProtocolMethod returns Accessibility.getPartialAXTree
+func (*AccessibilityGetPartialAXTreeParams) ProtocolMethod() string {
+	return "Accessibility.getPartialAXTree"
+}
+
+// AccessibilityGetPartialAXTreeResult https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getPartialAXTree
+type AccessibilityGetPartialAXTreeResult struct {
+	Nodes []*AccessibilityAXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getPartialAXTree and returns its result
+func (p *AccessibilityGetPartialAXTreeParams) Do(e Executor) (*AccessibilityGetPartialAXTreeResult, error) {
+	var result = new(AccessibilityGetPartialAXTreeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AccessibilityGetFullAXTreeParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getFullAXTree
+type AccessibilityGetFullAXTreeParams struct {
+	Depth   int64       `json:"depth,omitempty"`
+	FrameID PageFrameID `json:"frameId,omitempty"`
+}
+
+// ProtocolMethod returns Accessibility.getFullAXTree
+func (*AccessibilityGetFullAXTreeParams) ProtocolMethod() string {
+	return "Accessibility.getFullAXTree"
+}
+
+// AccessibilityGetFullAXTreeResult https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getFullAXTree
+type AccessibilityGetFullAXTreeResult struct {
+	Nodes []*AccessibilityAXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getFullAXTree and returns its result
+func (p *AccessibilityGetFullAXTreeParams) Do(e Executor) (*AccessibilityGetFullAXTreeResult, error) {
+	var result = new(AccessibilityGetFullAXTreeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AccessibilityGetRootAXNodeParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getRootAXNode
+type AccessibilityGetRootAXNodeParams struct {
+	FrameID PageFrameID `json:"frameId,omitempty"`
+}
+
+// ProtocolMethod returns Accessibility.getRootAXNode
+func (*AccessibilityGetRootAXNodeParams) ProtocolMethod() string {
+	return "Accessibility.getRootAXNode"
+}
+
+// AccessibilityGetRootAXNodeResult https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getRootAXNode
+type AccessibilityGetRootAXNodeResult struct {
+	Node *AccessibilityAXNode `json:"node"`
+}
+
+// Do sends Accessibility.getRootAXNode and returns its result
+func (p *AccessibilityGetRootAXNodeParams) Do(e Executor) (*AccessibilityGetRootAXNodeResult, error) {
+	var result = new(AccessibilityGetRootAXNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AccessibilityGetAXNodeAndAncestorsParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getAXNodeAndAncestors
+type AccessibilityGetAXNodeAndAncestorsParams struct {
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ProtocolMethod returns Accessibility.getAXNodeAndAncestors
+func (*AccessibilityGetAXNodeAndAncestorsParams) ProtocolMethod() string {
+	return "Accessibility.getAXNodeAndAncestors"
+}
+
+// AccessibilityGetAXNodeAndAncestorsResult https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getAXNodeAndAncestors
+type AccessibilityGetAXNodeAndAncestorsResult struct {
+	Nodes []*AccessibilityAXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getAXNodeAndAncestors and returns its result
+func (p *AccessibilityGetAXNodeAndAncestorsParams) Do(e Executor) (*AccessibilityGetAXNodeAndAncestorsResult, error) {
+	var result = new(AccessibilityGetAXNodeAndAncestorsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AccessibilityGetChildAXNodesParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getChildAXNodes
+type AccessibilityGetChildAXNodesParams struct {
+	ID      AccessibilityAXNodeID `json:"id"`
+	FrameID PageFrameID           `json:"frameId,omitempty"`
+}
+
+// ProtocolMethod returns Accessibility.getChildAXNodes
+func (*AccessibilityGetChildAXNodesParams) ProtocolMethod() string {
+	return "Accessibility.getChildAXNodes"
+}
+
+// AccessibilityGetChildAXNodesResult https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-getChildAXNodes
+type AccessibilityGetChildAXNodesResult struct {
+	Nodes []*AccessibilityAXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.getChildAXNodes and returns its result
+func (p *AccessibilityGetChildAXNodesParams) Do(e Executor) (*AccessibilityGetChildAXNodesResult, error) {
+	var result = new(AccessibilityGetChildAXNodesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AccessibilityQueryAXTreeParams https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-queryAXTree
+type AccessibilityQueryAXTreeParams struct {
+	NodeID         DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID  DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID       RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	AccessibleName string                `json:"accessibleName,omitempty"`
+	Role           string                `json:"role,omitempty"`
+}
+
+// ProtocolMethod returns Accessibility.queryAXTree
+func (*AccessibilityQueryAXTreeParams) ProtocolMethod() string {
+	return "Accessibility.queryAXTree"
+}
+
+// AccessibilityQueryAXTreeResult https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#method-queryAXTree
+type AccessibilityQueryAXTreeResult struct {
+	Nodes []*AccessibilityAXNode `json:"nodes"`
+}
+
+// Do sends Accessibility.queryAXTree and returns its result
+func (p *AccessibilityQueryAXTreeParams) Do(e Executor) (*AccessibilityQueryAXTreeResult, error) {
+	var result = new(AccessibilityQueryAXTreeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AccessibilityLoadCompleteEvent https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#event-loadComplete
+type AccessibilityLoadCompleteEvent struct {
+	Root *AccessibilityAXNode `json:"root"`
+}
+
+// ProtocolMethod returns Accessibility.loadComplete
+func (*AccessibilityLoadCompleteEvent) ProtocolMethod() string {
+	return "Accessibility.loadComplete"
+}
+
+// AccessibilityNodesUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Accessibility#event-nodesUpdated
+type AccessibilityNodesUpdatedEvent struct {
+	Nodes []*AccessibilityAXNode `json:"nodes"`
+}
+
+// ProtocolMethod returns Accessibility.nodesUpdated
+func (*AccessibilityNodesUpdatedEvent) ProtocolMethod() string {
+	return "Accessibility.nodesUpdated"
+}
@@ -0,0 +1,289 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Animation events
+const (
+	EventAnimationAnimationCanceled = "Animation.animationCanceled"
+	EventAnimationAnimationCreated  = "Animation.animationCreated"
+	EventAnimationAnimationStarted  = "Animation.animationStarted"
+	EventAnimationAnimationUpdated  = "Animation.animationUpdated"
+)
+
+// AnimationAnimation https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-Animation
+type AnimationAnimation struct {
+	ID                   string                         `json:"id"`
+	Name                 string                         `json:"name"`
+	PausedState          bool                           `json:"pausedState"`
+	PlayState            string                         `json:"playState"`
+	PlaybackRate         float64                        `json:"playbackRate"`
+	StartTime            float64                        `json:"startTime"`
+	CurrentTime          float64                        `json:"currentTime"`
+	Type                 AnimationAnimationType         `json:"type"`
+	Source               *AnimationAnimationEffect      `json:"source,omitempty"`
+	CSSID                string                         `json:"cssId,omitempty"`
+	ViewOrScrollTimeline *AnimationViewOrScrollTimeline `json:"viewOrScrollTimeline,omitempty"`
+}
+
+// AnimationViewOrScrollTimeline https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-ViewOrScrollTimeline
+type AnimationViewOrScrollTimeline struct {
+	SourceNodeID  DOMBackendNodeID     `json:"sourceNodeId,omitempty"`
+	StartOffset   float64              `json:"startOffset,omitempty"`
+	EndOffset     float64              `json:"endOffset,omitempty"`
+	SubjectNodeID DOMBackendNodeID     `json:"subjectNodeId,omitempty"`
+	Axis          DOMScrollOrientation `json:"axis"`
+}
+
+// AnimationAnimationEffect https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-AnimationEffect
+type AnimationAnimationEffect struct {
+	Delay          float64                 `json:"delay"`
+	EndDelay       float64                 `json:"endDelay"`
+	IterationStart float64                 `json:"iterationStart"`
+	Iterations     float64                 `json:"iterations"`
+	Duration       float64                 `json:"duration"`
+	Direction      string                  `json:"direction"`
+	Fill           string                  `json:"fill"`
+	BackendNodeID  DOMBackendNodeID        `json:"backendNodeId,omitempty"`
+	KeyframesRule  *AnimationKeyframesRule `json:"keyframesRule,omitempty"`
+	Easing         string                  `json:"easing"`
+}
+
+// AnimationKeyframesRule https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-KeyframesRule
+type AnimationKeyframesRule struct {
+	Name      string                    `json:"name,omitempty"`
+	Keyframes []*AnimationKeyframeStyle `json:"keyframes"`
+}
+
+// AnimationKeyframeStyle https://chromedevtools.github.io/devtools-protocol/tot/Animation#type-KeyframeStyle
+type AnimationKeyframeStyle struct {
+	Offset string `json:"offset"`
+	Easing string `json:"easing"`
+}
+
+// AnimationDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-disable
+type AnimationDisableParams struct {
+}
+
+// ProtocolMethod returns Animation.disable
+func (*AnimationDisableParams) ProtocolMethod() string {
+	return "Animation.disable"
+}
+
+// Do sends Animation.disable
+func (p *AnimationDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-enable
+type AnimationEnableParams struct {
+}
+
+// ProtocolMethod returns Animation.enable
+func (*AnimationEnableParams) ProtocolMethod() string {
+	return "Animation.enable"
+}
+
+// Do sends Animation.enable
+func (p *AnimationEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationGetCurrentTimeParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-getCurrentTime
+type AnimationGetCurrentTimeParams struct {
+	ID string `json:"id"`
+}
+
+// ProtocolMethod returns Animation.getCurrentTime
+func (*AnimationGetCurrentTimeParams) ProtocolMethod() string {
+	return "Animation.getCurrentTime"
+}
+
+// AnimationGetCurrentTimeResult https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-getCurrentTime
+type AnimationGetCurrentTimeResult struct {
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// Do sends Animation.getCurrentTime and returns its result
+func (p *AnimationGetCurrentTimeParams) Do(e Executor) (*AnimationGetCurrentTimeResult, error) {
+	var result = new(AnimationGetCurrentTimeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AnimationGetPlaybackRateParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-getPlaybackRate
+type AnimationGetPlaybackRateParams struct {
+}
+
+// ProtocolMethod returns Animation.getPlaybackRate
+func (*AnimationGetPlaybackRateParams) ProtocolMethod() string {
+	return "Animation.getPlaybackRate"
+}
+
+// AnimationGetPlaybackRateResult https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-getPlaybackRate
+type AnimationGetPlaybackRateResult struct {
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// Do sends Animation.getPlaybackRate and returns its result
+func (p *AnimationGetPlaybackRateParams) Do(e Executor) (*AnimationGetPlaybackRateResult, error) {
+	var result = new(AnimationGetPlaybackRateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AnimationReleaseAnimationsParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-releaseAnimations
+type AnimationReleaseAnimationsParams struct {
+	Animations []string `json:"animations"`
+}
+
+// ProtocolMethod returns Animation.releaseAnimations
+func (*AnimationReleaseAnimationsParams) ProtocolMethod() string {
+	return "Animation.releaseAnimations"
+}
+
+// Do sends Animation.releaseAnimations
+func (p *AnimationReleaseAnimationsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationResolveAnimationParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-resolveAnimation
+type AnimationResolveAnimationParams struct {
+	AnimationID string `json:"animationId"`
+}
+
+// ProtocolMethod returns Animation.resolveAnimation
+func (*AnimationResolveAnimationParams) ProtocolMethod() string {
+	return "Animation.resolveAnimation"
+}
+
+// AnimationResolveAnimationResult https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-resolveAnimation
+type AnimationResolveAnimationResult struct {
+	RemoteObject *RuntimeRemoteObject `json:"remoteObject"`
+}
+
+// Do sends Animation.resolveAnimation and returns its result
+func (p *AnimationResolveAnimationParams) Do(e Executor) (*AnimationResolveAnimationResult, error) {
+	var result = new(AnimationResolveAnimationResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AnimationSeekAnimationsParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-seekAnimations
+type AnimationSeekAnimationsParams struct {
+	Animations  []string `json:"animations"`
+	CurrentTime float64  `json:"currentTime"`
+}
+
+// ProtocolMethod returns Animation.seekAnimations
+func (*AnimationSeekAnimationsParams) ProtocolMethod() string {
+	return "Animation.seekAnimations"
+}
+
+// Do sends Animation.seekAnimations
+func (p *AnimationSeekAnimationsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationSetPausedParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-setPaused
+type AnimationSetPausedParams struct {
+	Animations []string `json:"animations"`
+	Paused     bool     `json:"paused"`
+}
+
+// ProtocolMethod returns Animation.setPaused
+func (*AnimationSetPausedParams) ProtocolMethod() string {
+	return "Animation.setPaused"
+}
+
+// Do sends Animation.setPaused
+func (p *AnimationSetPausedParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationSetPlaybackRateParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-setPlaybackRate
+type AnimationSetPlaybackRateParams struct {
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// ProtocolMethod returns Animation.setPlaybackRate
+func (*AnimationSetPlaybackRateParams) ProtocolMethod() string {
+	return "Animation.setPlaybackRate"
+}
+
+// Do sends Animation.setPlaybackRate
+func (p *AnimationSetPlaybackRateParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationSetTimingParams https://chromedevtools.github.io/devtools-protocol/tot/Animation#method-setTiming
+type AnimationSetTimingParams struct {
+	AnimationID string  `json:"animationId"`
+	Duration    float64 `json:"duration"`
+	Delay       float64 `json:"delay"`
+}
+
+// ProtocolMethod returns Animation.setTiming
+func (*AnimationSetTimingParams) ProtocolMethod() string {
+	return "Animation.setTiming"
+}
+
+// Do sends Animation.setTiming
+func (p *AnimationSetTimingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AnimationAnimationCanceledEvent https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationCanceled
+type AnimationAnimationCanceledEvent struct {
+	ID string `json:"id"`
+}
+
+// ProtocolMethod returns Animation.animationCanceled
+func (*AnimationAnimationCanceledEvent) ProtocolMethod() string {
+	return "Animation.animationCanceled"
+}
+
+// AnimationAnimationCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationCreated
+type AnimationAnimationCreatedEvent struct {
+	ID string `json:"id"`
+}
+
+// ProtocolMethod returns Animation.animationCreated
+func (*AnimationAnimationCreatedEvent) ProtocolMethod() string {
+	return "Animation.animationCreated"
+}
+
+// AnimationAnimationStartedEvent https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationStarted
+type AnimationAnimationStartedEvent struct {
+	Animation *AnimationAnimation `json:"animation"`
+}
+
+// ProtocolMethod returns Animation.animationStarted
+func (*AnimationAnimationStartedEvent) ProtocolMethod() string {
+	return "Animation.animationStarted"
+}
+
+// AnimationAnimationUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Animation#event-animationUpdated
+type AnimationAnimationUpdatedEvent struct {
+	Animation *AnimationAnimation `json:"animation"`
+}
+
+// ProtocolMethod returns Animation.animationUpdated
+func (*AnimationAnimationUpdatedEvent) ProtocolMethod() string {
+	return "Animation.animationUpdated"
+}
+
+// AnimationAnimationType enum
+type AnimationAnimationType string
+
+// AnimationAnimationType values
+const (
+	AnimationAnimationTypeCSSTransition AnimationAnimationType = "CSSTransition"
+	AnimationAnimationTypeCSSAnimation  AnimationAnimationType = "CSSAnimation"
+	AnimationAnimationTypeWebAnimation  AnimationAnimationType = "WebAnimation"
+)
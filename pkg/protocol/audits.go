@@ -0,0 +1,844 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Audits events
+const (
+	EventAuditsIssueAdded = "Audits.issueAdded"
+)
+
+// AuditsAffectedCookie https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedCookie
+type AuditsAffectedCookie struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Domain string `json:"domain"`
+}
+
+// AuditsAffectedRequest https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedRequest
+type AuditsAffectedRequest struct {
+	RequestID NetworkRequestID `json:"requestId,omitempty"`
+	URL       string           `json:"url"`
+}
+
+// AuditsAffectedFrame https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AffectedFrame
+type AuditsAffectedFrame struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// AuditsCookieExclusionReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieExclusionReason
+type AuditsCookieExclusionReason string
+
+// AuditsCookieExclusionReason values
+const (
+	AuditsCookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax        AuditsCookieExclusionReason = "ExcludeSameSiteUnspecifiedTreatedAsLax"
+	AuditsCookieExclusionReasonExcludeSameSiteNoneInsecure                   AuditsCookieExclusionReason = "ExcludeSameSiteNoneInsecure"
+	AuditsCookieExclusionReasonExcludeSameSiteLax                            AuditsCookieExclusionReason = "ExcludeSameSiteLax"
+	AuditsCookieExclusionReasonExcludeSameSiteStrict                         AuditsCookieExclusionReason = "ExcludeSameSiteStrict"
+	AuditsCookieExclusionReasonExcludeInvalidSameParty                       AuditsCookieExclusionReason = "ExcludeInvalidSameParty"
+	AuditsCookieExclusionReasonExcludeSamePartyCrossPartyContext             AuditsCookieExclusionReason = "ExcludeSamePartyCrossPartyContext"
+	AuditsCookieExclusionReasonExcludeDomainNonASCII                         AuditsCookieExclusionReason = "ExcludeDomainNonASCII"
+	AuditsCookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet AuditsCookieExclusionReason = "ExcludeThirdPartyCookieBlockedInFirstPartySet"
+	AuditsCookieExclusionReasonExcludeThirdPartyPhaseout                     AuditsCookieExclusionReason = "ExcludeThirdPartyPhaseout"
+	AuditsCookieExclusionReasonExcludePortMismatch                           AuditsCookieExclusionReason = "ExcludePortMismatch"
+	AuditsCookieExclusionReasonExcludeSchemeMismatch                         AuditsCookieExclusionReason = "ExcludeSchemeMismatch"
+)
+
+// AuditsCookieWarningReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieWarningReason
+type AuditsCookieWarningReason string
+
+// AuditsCookieWarningReason values
+const (
+	AuditsCookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext        AuditsCookieWarningReason = "WarnSameSiteUnspecifiedCrossSiteContext"
+	AuditsCookieWarningReasonWarnSameSiteNoneInsecure                       AuditsCookieWarningReason = "WarnSameSiteNoneInsecure"
+	AuditsCookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe          AuditsCookieWarningReason = "WarnSameSiteUnspecifiedLaxAllowUnsafe"
+	AuditsCookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict           AuditsCookieWarningReason = "WarnSameSiteStrictLaxDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict         AuditsCookieWarningReason = "WarnSameSiteStrictCrossDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteStrictCrossDowngradeLax            AuditsCookieWarningReason = "WarnSameSiteStrictCrossDowngradeLax"
+	AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict            AuditsCookieWarningReason = "WarnSameSiteLaxCrossDowngradeStrict"
+	AuditsCookieWarningReasonWarnSameSiteLaxCrossDowngradeLax               AuditsCookieWarningReason = "WarnSameSiteLaxCrossDowngradeLax"
+	AuditsCookieWarningReasonWarnAttributeValueExceedsMaxSize               AuditsCookieWarningReason = "WarnAttributeValueExceedsMaxSize"
+	AuditsCookieWarningReasonWarnDomainNonASCII                             AuditsCookieWarningReason = "WarnDomainNonASCII"
+	AuditsCookieWarningReasonWarnThirdPartyPhaseout                         AuditsCookieWarningReason = "WarnThirdPartyPhaseout"
+	AuditsCookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion AuditsCookieWarningReason = "WarnCrossSiteRedirectDowngradeChangesInclusion"
+	AuditsCookieWarningReasonWarnDeprecationTrialMetadata                   AuditsCookieWarningReason = "WarnDeprecationTrialMetadata"
+	AuditsCookieWarningReasonWarnThirdPartyCookieHeuristic                  AuditsCookieWarningReason = "WarnThirdPartyCookieHeuristic"
+)
+
+// AuditsCookieOperation https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieOperation
+type AuditsCookieOperation string
+
+// AuditsCookieOperation values
+const (
+	AuditsCookieOperationSetCookie  AuditsCookieOperation = "SetCookie"
+	AuditsCookieOperationReadCookie AuditsCookieOperation = "ReadCookie"
+)
+
+// AuditsInsightType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InsightType
+type AuditsInsightType string
+
+// AuditsInsightType values
+const (
+	AuditsInsightTypeGitHubResource AuditsInsightType = "GitHubResource"
+	AuditsInsightTypeGracePeriod    AuditsInsightType = "GracePeriod"
+	AuditsInsightTypeHeuristics     AuditsInsightType = "Heuristics"
+)
+
+// AuditsCookieIssueInsight https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieIssueInsight
+type AuditsCookieIssueInsight struct {
+	Type          AuditsInsightType `json:"type"`
+	TableEntryURL string            `json:"tableEntryUrl,omitempty"`
+}
+
+// AuditsCookieIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieIssueDetails
+type AuditsCookieIssueDetails struct {
+	Cookie                 *AuditsAffectedCookie         `json:"cookie,omitempty"`
+	RawCookieLine          string                        `json:"rawCookieLine,omitempty"`
+	CookieWarningReasons   []AuditsCookieWarningReason   `json:"cookieWarningReasons"`
+	CookieExclusionReasons []AuditsCookieExclusionReason `json:"cookieExclusionReasons"`
+	Operation              AuditsCookieOperation         `json:"operation"`
+	SiteForCookies         string                        `json:"siteForCookies,omitempty"`
+	CookieURL              string                        `json:"cookieUrl,omitempty"`
+	Request                *AuditsAffectedRequest        `json:"request,omitempty"`
+	Insight                *AuditsCookieIssueInsight     `json:"insight,omitempty"`
+}
+
+// AuditsMixedContentResolutionStatus https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentResolutionStatus
+type AuditsMixedContentResolutionStatus string
+
+// AuditsMixedContentResolutionStatus values
+const (
+	AuditsMixedContentResolutionStatusMixedContentBlocked               AuditsMixedContentResolutionStatus = "MixedContentBlocked"
+	AuditsMixedContentResolutionStatusMixedContentAutomaticallyUpgraded AuditsMixedContentResolutionStatus = "MixedContentAutomaticallyUpgraded"
+	AuditsMixedContentResolutionStatusMixedContentWarning               AuditsMixedContentResolutionStatus = "MixedContentWarning"
+)
+
+// AuditsMixedContentResourceType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentResourceType
+type AuditsMixedContentResourceType string
+
+// AuditsMixedContentResourceType values
+const (
+	AuditsMixedContentResourceTypeAttributionSrc   AuditsMixedContentResourceType = "AttributionSrc"
+	AuditsMixedContentResourceTypeAudio            AuditsMixedContentResourceType = "Audio"
+	AuditsMixedContentResourceTypeBeacon           AuditsMixedContentResourceType = "Beacon"
+	AuditsMixedContentResourceTypeCSPReport        AuditsMixedContentResourceType = "CSPReport"
+	AuditsMixedContentResourceTypeDownload         AuditsMixedContentResourceType = "Download"
+	AuditsMixedContentResourceTypeEventSource      AuditsMixedContentResourceType = "EventSource"
+	AuditsMixedContentResourceTypeFavicon          AuditsMixedContentResourceType = "Favicon"
+	AuditsMixedContentResourceTypeFont             AuditsMixedContentResourceType = "Font"
+	AuditsMixedContentResourceTypeForm             AuditsMixedContentResourceType = "Form"
+	AuditsMixedContentResourceTypeFrame            AuditsMixedContentResourceType = "Frame"
+	AuditsMixedContentResourceTypeImage            AuditsMixedContentResourceType = "Image"
+	AuditsMixedContentResourceTypeImport           AuditsMixedContentResourceType = "Import"
+	AuditsMixedContentResourceTypeJSON             AuditsMixedContentResourceType = "JSON"
+	AuditsMixedContentResourceTypeManifest         AuditsMixedContentResourceType = "Manifest"
+	AuditsMixedContentResourceTypePing             AuditsMixedContentResourceType = "Ping"
+	AuditsMixedContentResourceTypePluginData       AuditsMixedContentResourceType = "PluginData"
+	AuditsMixedContentResourceTypePluginResource   AuditsMixedContentResourceType = "PluginResource"
+	AuditsMixedContentResourceTypePrefetch         AuditsMixedContentResourceType = "Prefetch"
+	AuditsMixedContentResourceTypeResource         AuditsMixedContentResourceType = "Resource"
+	AuditsMixedContentResourceTypeScript           AuditsMixedContentResourceType = "Script"
+	AuditsMixedContentResourceTypeServiceWorker    AuditsMixedContentResourceType = "ServiceWorker"
+	AuditsMixedContentResourceTypeSharedWorker     AuditsMixedContentResourceType = "SharedWorker"
+	AuditsMixedContentResourceTypeSpeculationRules AuditsMixedContentResourceType = "SpeculationRules"
+	AuditsMixedContentResourceTypeStylesheet       AuditsMixedContentResourceType = "Stylesheet"
+	AuditsMixedContentResourceTypeTrack            AuditsMixedContentResourceType = "Track"
+	AuditsMixedContentResourceTypeVideo            AuditsMixedContentResourceType = "Video"
+	AuditsMixedContentResourceTypeWorker           AuditsMixedContentResourceType = "Worker"
+	AuditsMixedContentResourceTypeXMLHTTPRequest   AuditsMixedContentResourceType = "XMLHttpRequest"
+	AuditsMixedContentResourceTypeXSLT             AuditsMixedContentResourceType = "XSLT"
+)
+
+// AuditsMixedContentIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-MixedContentIssueDetails
+type AuditsMixedContentIssueDetails struct {
+	ResourceType     AuditsMixedContentResourceType     `json:"resourceType,omitempty"`
+	ResolutionStatus AuditsMixedContentResolutionStatus `json:"resolutionStatus"`
+	InsecureURL      string                             `json:"insecureURL"`
+	MainResourceURL  string                             `json:"mainResourceURL"`
+	Request          *AuditsAffectedRequest             `json:"request,omitempty"`
+	Frame            *AuditsAffectedFrame               `json:"frame,omitempty"`
+}
+
+// AuditsBlockedByResponseReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BlockedByResponseReason
+type AuditsBlockedByResponseReason string
+
+// AuditsBlockedByResponseReason values
+const (
+	AuditsBlockedByResponseReasonCoepFrameResourceNeedsCoepHeader                        AuditsBlockedByResponseReason = "CoepFrameResourceNeedsCoepHeader"
+	AuditsBlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage             AuditsBlockedByResponseReason = "CoopSandboxedIFrameCannotNavigateToCoopPage"
+	AuditsBlockedByResponseReasonCorpNotSameOrigin                                       AuditsBlockedByResponseReason = "CorpNotSameOrigin"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoep"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByDip"
+	AuditsBlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip AuditsBlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip"
+	AuditsBlockedByResponseReasonCorpNotSameSite                                         AuditsBlockedByResponseReason = "CorpNotSameSite"
+	AuditsBlockedByResponseReasonSRIMessageSignatureMismatch                             AuditsBlockedByResponseReason = "SRIMessageSignatureMismatch"
+)
+
+// AuditsBlockedByResponseIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BlockedByResponseIssueDetails
+type AuditsBlockedByResponseIssueDetails struct {
+	Request      *AuditsAffectedRequest        `json:"request"`
+	ParentFrame  *AuditsAffectedFrame          `json:"parentFrame,omitempty"`
+	BlockedFrame *AuditsAffectedFrame          `json:"blockedFrame,omitempty"`
+	Reason       AuditsBlockedByResponseReason `json:"reason"`
+}
+
+// AuditsHeavyAdResolutionStatus https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdResolutionStatus
+type AuditsHeavyAdResolutionStatus string
+
+// AuditsHeavyAdResolutionStatus values
+const (
+	AuditsHeavyAdResolutionStatusHeavyAdBlocked AuditsHeavyAdResolutionStatus = "HeavyAdBlocked"
+	AuditsHeavyAdResolutionStatusHeavyAdWarning AuditsHeavyAdResolutionStatus = "HeavyAdWarning"
+)
+
+// AuditsHeavyAdReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdReason
+type AuditsHeavyAdReason string
+
+// AuditsHeavyAdReason values
+const (
+	AuditsHeavyAdReasonNetworkTotalLimit AuditsHeavyAdReason = "NetworkTotalLimit"
+	AuditsHeavyAdReasonCPUTotalLimit     AuditsHeavyAdReason = "CpuTotalLimit"
+	AuditsHeavyAdReasonCPUPeakLimit      AuditsHeavyAdReason = "CpuPeakLimit"
+)
+
+// AuditsHeavyAdIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-HeavyAdIssueDetails
+type AuditsHeavyAdIssueDetails struct {
+	Resolution AuditsHeavyAdResolutionStatus `json:"resolution"`
+	Reason     AuditsHeavyAdReason           `json:"reason"`
+	Frame      *AuditsAffectedFrame          `json:"frame"`
+}
+
+// AuditsContentSecurityPolicyViolationType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ContentSecurityPolicyViolationType
+type AuditsContentSecurityPolicyViolationType string
+
+// AuditsContentSecurityPolicyViolationType values
+const (
+	AuditsContentSecurityPolicyViolationTypeKInlineViolation             AuditsContentSecurityPolicyViolationType = "kInlineViolation"
+	AuditsContentSecurityPolicyViolationTypeKEvalViolation               AuditsContentSecurityPolicyViolationType = "kEvalViolation"
+	AuditsContentSecurityPolicyViolationTypeKURLViolation                AuditsContentSecurityPolicyViolationType = "kURLViolation"
+	AuditsContentSecurityPolicyViolationTypeKSRIViolation                AuditsContentSecurityPolicyViolationType = "kSRIViolation"
+	AuditsContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation   AuditsContentSecurityPolicyViolationType = "kTrustedTypesSinkViolation"
+	AuditsContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation AuditsContentSecurityPolicyViolationType = "kTrustedTypesPolicyViolation"
+	AuditsContentSecurityPolicyViolationTypeKWasmEvalViolation           AuditsContentSecurityPolicyViolationType = "kWasmEvalViolation"
+)
+
+// AuditsSourceCodeLocation https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SourceCodeLocation
+type AuditsSourceCodeLocation struct {
+	ScriptID     RuntimeScriptID `json:"scriptId,omitempty"`
+	URL          string          `json:"url"`
+	LineNumber   int64           `json:"lineNumber"`
+	ColumnNumber int64           `json:"columnNumber"`
+}
+
+// AuditsContentSecurityPolicyIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ContentSecurityPolicyIssueDetails
+type AuditsContentSecurityPolicyIssueDetails struct {
+	BlockedURL                         string                                   `json:"blockedURL,omitempty"`
+	ViolatedDirective                  string                                   `json:"violatedDirective"`
+	IsReportOnly                       bool                                     `json:"isReportOnly"`
+	ContentSecurityPolicyViolationType AuditsContentSecurityPolicyViolationType `json:"contentSecurityPolicyViolationType"`
+	FrameAncestor                      *AuditsAffectedFrame                     `json:"frameAncestor,omitempty"`
+	SourceCodeLocation                 *AuditsSourceCodeLocation                `json:"sourceCodeLocation,omitempty"`
+	ViolatingNodeID                    DOMBackendNodeID                         `json:"violatingNodeId,omitempty"`
+}
+
+// AuditsSharedArrayBufferIssueType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedArrayBufferIssueType
+type AuditsSharedArrayBufferIssueType string
+
+// AuditsSharedArrayBufferIssueType values
+const (
+	AuditsSharedArrayBufferIssueTypeTransferIssue AuditsSharedArrayBufferIssueType = "TransferIssue"
+	AuditsSharedArrayBufferIssueTypeCreationIssue AuditsSharedArrayBufferIssueType = "CreationIssue"
+)
+
+// AuditsSharedArrayBufferIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedArrayBufferIssueDetails
+type AuditsSharedArrayBufferIssueDetails struct {
+	SourceCodeLocation *AuditsSourceCodeLocation        `json:"sourceCodeLocation"`
+	IsWarning          bool                             `json:"isWarning"`
+	Type               AuditsSharedArrayBufferIssueType `json:"type"`
+}
+
+// AuditsLowTextContrastIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-LowTextContrastIssueDetails
+type AuditsLowTextContrastIssueDetails struct {
+	ViolatingNodeID       DOMBackendNodeID `json:"violatingNodeId"`
+	ViolatingNodeSelector string           `json:"violatingNodeSelector"`
+	ContrastRatio         float64          `json:"contrastRatio"`
+	ThresholdAA           float64          `json:"thresholdAA"`
+	ThresholdAAA          float64          `json:"thresholdAAA"`
+	FontSize              string           `json:"fontSize"`
+	FontWeight            string           `json:"fontWeight"`
+}
+
+// AuditsCorsIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CorsIssueDetails
+type AuditsCorsIssueDetails struct {
+	CorsErrorStatus        *NetworkCorsErrorStatus     `json:"corsErrorStatus"`
+	IsWarning              bool                        `json:"isWarning"`
+	Request                *AuditsAffectedRequest      `json:"request"`
+	Location               *AuditsSourceCodeLocation   `json:"location,omitempty"`
+	InitiatorOrigin        string                      `json:"initiatorOrigin,omitempty"`
+	ResourceIPAddressSpace NetworkIPAddressSpace       `json:"resourceIPAddressSpace,omitempty"`
+	ClientSecurityState    *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`
+}
+
+// AuditsAttributionReportingIssueType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AttributionReportingIssueType
+type AuditsAttributionReportingIssueType string
+
+// AuditsAttributionReportingIssueType values
+const (
+	AuditsAttributionReportingIssueTypePermissionPolicyDisabled                             AuditsAttributionReportingIssueType = "PermissionPolicyDisabled"
+	AuditsAttributionReportingIssueTypeUntrustworthyReportingOrigin                         AuditsAttributionReportingIssueType = "UntrustworthyReportingOrigin"
+	AuditsAttributionReportingIssueTypeInsecureContext                                      AuditsAttributionReportingIssueType = "InsecureContext"
+	AuditsAttributionReportingIssueTypeInvalidHeader                                        AuditsAttributionReportingIssueType = "InvalidHeader"
+	AuditsAttributionReportingIssueTypeInvalidRegisterTriggerHeader                         AuditsAttributionReportingIssueType = "InvalidRegisterTriggerHeader"
+	AuditsAttributionReportingIssueTypeSourceAndTriggerHeaders                              AuditsAttributionReportingIssueType = "SourceAndTriggerHeaders"
+	AuditsAttributionReportingIssueTypeSourceIgnored                                        AuditsAttributionReportingIssueType = "SourceIgnored"
+	AuditsAttributionReportingIssueTypeTriggerIgnored                                       AuditsAttributionReportingIssueType = "TriggerIgnored"
+	AuditsAttributionReportingIssueTypeOsSourceIgnored                                      AuditsAttributionReportingIssueType = "OsSourceIgnored"
+	AuditsAttributionReportingIssueTypeOsTriggerIgnored                                     AuditsAttributionReportingIssueType = "OsTriggerIgnored"
+	AuditsAttributionReportingIssueTypeInvalidRegisterOsSourceHeader                        AuditsAttributionReportingIssueType = "InvalidRegisterOsSourceHeader"
+	AuditsAttributionReportingIssueTypeInvalidRegisterOsTriggerHeader                       AuditsAttributionReportingIssueType = "InvalidRegisterOsTriggerHeader"
+	AuditsAttributionReportingIssueTypeWebAndOsHeaders                                      AuditsAttributionReportingIssueType = "WebAndOsHeaders"
+	AuditsAttributionReportingIssueTypeNoWebOrOsSupport                                     AuditsAttributionReportingIssueType = "NoWebOrOsSupport"
+	AuditsAttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation AuditsAttributionReportingIssueType = "NavigationRegistrationWithoutTransientUserActivation"
+	AuditsAttributionReportingIssueTypeInvalidInfoHeader                                    AuditsAttributionReportingIssueType = "InvalidInfoHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterSourceHeader                               AuditsAttributionReportingIssueType = "NoRegisterSourceHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterTriggerHeader                              AuditsAttributionReportingIssueType = "NoRegisterTriggerHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterOsSourceHeader                             AuditsAttributionReportingIssueType = "NoRegisterOsSourceHeader"
+	AuditsAttributionReportingIssueTypeNoRegisterOsTriggerHeader                            AuditsAttributionReportingIssueType = "NoRegisterOsTriggerHeader"
+	AuditsAttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet          AuditsAttributionReportingIssueType = "NavigationRegistrationUniqueScopeAlreadySet"
+)
+
+// AuditsSharedDictionaryError https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedDictionaryError
+type AuditsSharedDictionaryError string
+
+// AuditsSharedDictionaryError values
+const (
+	AuditsSharedDictionaryErrorUseErrorCrossOriginNoCorsRequest          AuditsSharedDictionaryError = "UseErrorCrossOriginNoCorsRequest"
+	AuditsSharedDictionaryErrorUseErrorDictionaryLoadFailure             AuditsSharedDictionaryError = "UseErrorDictionaryLoadFailure"
+	AuditsSharedDictionaryErrorUseErrorMatchingDictionaryNotUsed         AuditsSharedDictionaryError = "UseErrorMatchingDictionaryNotUsed"
+	AuditsSharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader AuditsSharedDictionaryError = "UseErrorUnexpectedContentDictionaryHeader"
+	AuditsSharedDictionaryErrorWriteErrorCossOriginNoCorsRequest         AuditsSharedDictionaryError = "WriteErrorCossOriginNoCorsRequest"
+	AuditsSharedDictionaryErrorWriteErrorDisallowedBySettings            AuditsSharedDictionaryError = "WriteErrorDisallowedBySettings"
+	AuditsSharedDictionaryErrorWriteErrorExpiredResponse                 AuditsSharedDictionaryError = "WriteErrorExpiredResponse"
+	AuditsSharedDictionaryErrorWriteErrorFeatureDisabled                 AuditsSharedDictionaryError = "WriteErrorFeatureDisabled"
+	AuditsSharedDictionaryErrorWriteErrorInsufficientResources           AuditsSharedDictionaryError = "WriteErrorInsufficientResources"
+	AuditsSharedDictionaryErrorWriteErrorInvalidMatchField               AuditsSharedDictionaryError = "WriteErrorInvalidMatchField"
+	AuditsSharedDictionaryErrorWriteErrorInvalidStructuredHeader         AuditsSharedDictionaryError = "WriteErrorInvalidStructuredHeader"
+	AuditsSharedDictionaryErrorWriteErrorNavigationRequest               AuditsSharedDictionaryError = "WriteErrorNavigationRequest"
+	AuditsSharedDictionaryErrorWriteErrorNoMatchField                    AuditsSharedDictionaryError = "WriteErrorNoMatchField"
+	AuditsSharedDictionaryErrorWriteErrorNonListMatchDestField           AuditsSharedDictionaryError = "WriteErrorNonListMatchDestField"
+	AuditsSharedDictionaryErrorWriteErrorNonSecureContext                AuditsSharedDictionaryError = "WriteErrorNonSecureContext"
+	AuditsSharedDictionaryErrorWriteErrorNonStringIDField                AuditsSharedDictionaryError = "WriteErrorNonStringIdField"
+	AuditsSharedDictionaryErrorWriteErrorNonStringInMatchDestList        AuditsSharedDictionaryError = "WriteErrorNonStringInMatchDestList"
+	AuditsSharedDictionaryErrorWriteErrorNonStringMatchField             AuditsSharedDictionaryError = "WriteErrorNonStringMatchField"
+	AuditsSharedDictionaryErrorWriteErrorNonTokenTypeField               AuditsSharedDictionaryError = "WriteErrorNonTokenTypeField"
+	AuditsSharedDictionaryErrorWriteErrorRequestAborted                  AuditsSharedDictionaryError = "WriteErrorRequestAborted"
+	AuditsSharedDictionaryErrorWriteErrorShuttingDown                    AuditsSharedDictionaryError = "WriteErrorShuttingDown"
+	AuditsSharedDictionaryErrorWriteErrorTooLongIDField                  AuditsSharedDictionaryError = "WriteErrorTooLongIdField"
+	AuditsSharedDictionaryErrorWriteErrorUnsupportedType                 AuditsSharedDictionaryError = "WriteErrorUnsupportedType"
+)
+
+// AuditsSRIMessageSignatureError https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SRIMessageSignatureError
+type AuditsSRIMessageSignatureError string
+
+// AuditsSRIMessageSignatureError values
+const (
+	AuditsSRIMessageSignatureErrorMissingSignatureHeader                               AuditsSRIMessageSignatureError = "MissingSignatureHeader"
+	AuditsSRIMessageSignatureErrorMissingSignatureInputHeader                          AuditsSRIMessageSignatureError = "MissingSignatureInputHeader"
+	AuditsSRIMessageSignatureErrorInvalidSignatureHeader                               AuditsSRIMessageSignatureError = "InvalidSignatureHeader"
+	AuditsSRIMessageSignatureErrorInvalidSignatureInputHeader                          AuditsSRIMessageSignatureError = "InvalidSignatureInputHeader"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence                AuditsSRIMessageSignatureError = "SignatureHeaderValueIsNotByteSequence"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsParameterized                  AuditsSRIMessageSignatureError = "SignatureHeaderValueIsParameterized"
+	AuditsSRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength                AuditsSRIMessageSignatureError = "SignatureHeaderValueIsIncorrectLength"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingLabel                     AuditsSRIMessageSignatureError = "SignatureInputHeaderMissingLabel"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList                AuditsSRIMessageSignatureError = "SignatureInputHeaderValueNotInnerList"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents           AuditsSRIMessageSignatureError = "SignatureInputHeaderValueMissingComponents"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType             AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidComponentType"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName             AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidComponentName"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter  AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidHeaderComponentParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidDerivedComponentParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderKeyIDLength                      AuditsSRIMessageSignatureError = "SignatureInputHeaderKeyIdLength"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderInvalidParameter                 AuditsSRIMessageSignatureError = "SignatureInputHeaderInvalidParameter"
+	AuditsSRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters        AuditsSRIMessageSignatureError = "SignatureInputHeaderMissingRequiredParameters"
+	AuditsSRIMessageSignatureErrorValidationFailedSignatureExpired                     AuditsSRIMessageSignatureError = "ValidationFailedSignatureExpired"
+	AuditsSRIMessageSignatureErrorValidationFailedInvalidLength                        AuditsSRIMessageSignatureError = "ValidationFailedInvalidLength"
+	AuditsSRIMessageSignatureErrorValidationFailedSignatureMismatch                    AuditsSRIMessageSignatureError = "ValidationFailedSignatureMismatch"
+	AuditsSRIMessageSignatureErrorValidationFailedIntegrityMismatch                    AuditsSRIMessageSignatureError = "ValidationFailedIntegrityMismatch"
+)
+
+// AuditsUnencodedDigestError https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UnencodedDigestError
+type AuditsUnencodedDigestError string
+
+// AuditsUnencodedDigestError values
+const (
+	AuditsUnencodedDigestErrorMalformedDictionary   AuditsUnencodedDigestError = "MalformedDictionary"
+	AuditsUnencodedDigestErrorUnknownAlgorithm      AuditsUnencodedDigestError = "UnknownAlgorithm"
+	AuditsUnencodedDigestErrorIncorrectDigestType   AuditsUnencodedDigestError = "IncorrectDigestType"
+	AuditsUnencodedDigestErrorIncorrectDigestLength AuditsUnencodedDigestError = "IncorrectDigestLength"
+)
+
+// AuditsAttributionReportingIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-AttributionReportingIssueDetails
+type AuditsAttributionReportingIssueDetails struct {
+	ViolationType    AuditsAttributionReportingIssueType `json:"violationType"`
+	Request          *AuditsAffectedRequest              `json:"request,omitempty"`
+	ViolatingNodeID  DOMBackendNodeID                    `json:"violatingNodeId,omitempty"`
+	InvalidParameter string                              `json:"invalidParameter,omitempty"`
+}
+
+// AuditsQuirksModeIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-QuirksModeIssueDetails
+type AuditsQuirksModeIssueDetails struct {
+	IsLimitedQuirksMode bool             `json:"isLimitedQuirksMode"`
+	DocumentNodeID      DOMBackendNodeID `json:"documentNodeId"`
+	URL                 string           `json:"url"`
+	FrameID             PageFrameID      `json:"frameId"`
+	LoaderID            NetworkLoaderID  `json:"loaderId"`
+}
+
+// AuditsNavigatorUserAgentIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-NavigatorUserAgentIssueDetails
+//
+// Deprecated: deprecated in protocol
+type AuditsNavigatorUserAgentIssueDetails struct {
+	URL      string                    `json:"url"`
+	Location *AuditsSourceCodeLocation `json:"location,omitempty"`
+}
+
+// AuditsSharedDictionaryIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SharedDictionaryIssueDetails
+type AuditsSharedDictionaryIssueDetails struct {
+	SharedDictionaryError AuditsSharedDictionaryError `json:"sharedDictionaryError"`
+	Request               *AuditsAffectedRequest      `json:"request"`
+}
+
+// AuditsSRIMessageSignatureIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-SRIMessageSignatureIssueDetails
+type AuditsSRIMessageSignatureIssueDetails struct {
+	Error               AuditsSRIMessageSignatureError `json:"error"`
+	SignatureBase       string                         `json:"signatureBase"`
+	IntegrityAssertions []string                       `json:"integrityAssertions"`
+	Request             *AuditsAffectedRequest         `json:"request"`
+}
+
+// AuditsUnencodedDigestIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UnencodedDigestIssueDetails
+type AuditsUnencodedDigestIssueDetails struct {
+	Error   AuditsUnencodedDigestError `json:"error"`
+	Request *AuditsAffectedRequest     `json:"request"`
+}
+
+// AuditsGenericIssueErrorType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-GenericIssueErrorType
+type AuditsGenericIssueErrorType string
+
+// AuditsGenericIssueErrorType values
+const (
+	AuditsGenericIssueErrorTypeFormLabelForNameError                                      AuditsGenericIssueErrorType = "FormLabelForNameError"
+	AuditsGenericIssueErrorTypeFormDuplicateIDForInputError                               AuditsGenericIssueErrorType = "FormDuplicateIdForInputError"
+	AuditsGenericIssueErrorTypeFormInputWithNoLabelError                                  AuditsGenericIssueErrorType = "FormInputWithNoLabelError"
+	AuditsGenericIssueErrorTypeFormAutocompleteAttributeEmptyError                        AuditsGenericIssueErrorType = "FormAutocompleteAttributeEmptyError"
+	AuditsGenericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError                  AuditsGenericIssueErrorType = "FormEmptyIdAndNameAttributesForInputError"
+	AuditsGenericIssueErrorTypeFormAriaLabelledByToNonExistingID                          AuditsGenericIssueErrorType = "FormAriaLabelledByToNonExistingId"
+	AuditsGenericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError AuditsGenericIssueErrorType = "FormInputAssignedAutocompleteValueToIdOrNameAttributeError"
+	AuditsGenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput                       AuditsGenericIssueErrorType = "FormLabelHasNeitherForNorNestedInput"
+	AuditsGenericIssueErrorTypeFormLabelForMatchesNonExistingIDError                      AuditsGenericIssueErrorType = "FormLabelForMatchesNonExistingIdError"
+	AuditsGenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError     AuditsGenericIssueErrorType = "FormInputHasWrongButWellIntendedAutocompleteValueError"
+	AuditsGenericIssueErrorTypeResponseWasBlockedByORB                                    AuditsGenericIssueErrorType = "ResponseWasBlockedByORB"
+)
+
+// AuditsGenericIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-GenericIssueDetails
+type AuditsGenericIssueDetails struct {
+	ErrorType              AuditsGenericIssueErrorType `json:"errorType"`
+	FrameID                PageFrameID                 `json:"frameId,omitempty"`
+	ViolatingNodeID        DOMBackendNodeID            `json:"violatingNodeId,omitempty"`
+	ViolatingNodeAttribute string                      `json:"violatingNodeAttribute,omitempty"`
+	Request                *AuditsAffectedRequest      `json:"request,omitempty"`
+}
+
+// AuditsDeprecationIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-DeprecationIssueDetails
+type AuditsDeprecationIssueDetails struct {
+	AffectedFrame      *AuditsAffectedFrame      `json:"affectedFrame,omitempty"`
+	SourceCodeLocation *AuditsSourceCodeLocation `json:"sourceCodeLocation"`
+	Type               string                    `json:"type"`
+}
+
+// AuditsBounceTrackingIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-BounceTrackingIssueDetails
+type AuditsBounceTrackingIssueDetails struct {
+	TrackingSites []string `json:"trackingSites"`
+}
+
+// AuditsCookieDeprecationMetadataIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-CookieDeprecationMetadataIssueDetails
+type AuditsCookieDeprecationMetadataIssueDetails struct {
+	AllowedSites     []string              `json:"allowedSites"`
+	OptOutPercentage float64               `json:"optOutPercentage"`
+	IsOptOutTopLevel bool                  `json:"isOptOutTopLevel"`
+	Operation        AuditsCookieOperation `json:"operation"`
+}
+
+// AuditsClientHintIssueReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ClientHintIssueReason
+type AuditsClientHintIssueReason string
+
+// AuditsClientHintIssueReason values
+const (
+	AuditsClientHintIssueReasonMetaTagAllowListInvalidOrigin AuditsClientHintIssueReason = "MetaTagAllowListInvalidOrigin"
+	AuditsClientHintIssueReasonMetaTagModifiedHTML           AuditsClientHintIssueReason = "MetaTagModifiedHTML"
+)
+
+// AuditsFederatedAuthRequestIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthRequestIssueDetails
+type AuditsFederatedAuthRequestIssueDetails struct {
+	FederatedAuthRequestIssueReason AuditsFederatedAuthRequestIssueReason `json:"federatedAuthRequestIssueReason"`
+}
+
+// AuditsFederatedAuthRequestIssueReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthRequestIssueReason
+type AuditsFederatedAuthRequestIssueReason string
+
+// AuditsFederatedAuthRequestIssueReason values
+const (
+	AuditsFederatedAuthRequestIssueReasonShouldEmbargo                    AuditsFederatedAuthRequestIssueReason = "ShouldEmbargo"
+	AuditsFederatedAuthRequestIssueReasonTooManyRequests                  AuditsFederatedAuthRequestIssueReason = "TooManyRequests"
+	AuditsFederatedAuthRequestIssueReasonWellKnownHTTPNotFound            AuditsFederatedAuthRequestIssueReason = "WellKnownHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonWellKnownNoResponse              AuditsFederatedAuthRequestIssueReason = "WellKnownNoResponse"
+	AuditsFederatedAuthRequestIssueReasonWellKnownInvalidResponse         AuditsFederatedAuthRequestIssueReason = "WellKnownInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonWellKnownListEmpty               AuditsFederatedAuthRequestIssueReason = "WellKnownListEmpty"
+	AuditsFederatedAuthRequestIssueReasonWellKnownInvalidContentType      AuditsFederatedAuthRequestIssueReason = "WellKnownInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonConfigNotInWellKnown             AuditsFederatedAuthRequestIssueReason = "ConfigNotInWellKnown"
+	AuditsFederatedAuthRequestIssueReasonWellKnownTooBig                  AuditsFederatedAuthRequestIssueReason = "WellKnownTooBig"
+	AuditsFederatedAuthRequestIssueReasonConfigHTTPNotFound               AuditsFederatedAuthRequestIssueReason = "ConfigHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonConfigNoResponse                 AuditsFederatedAuthRequestIssueReason = "ConfigNoResponse"
+	AuditsFederatedAuthRequestIssueReasonConfigInvalidResponse            AuditsFederatedAuthRequestIssueReason = "ConfigInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonConfigInvalidContentType         AuditsFederatedAuthRequestIssueReason = "ConfigInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataHTTPNotFound       AuditsFederatedAuthRequestIssueReason = "ClientMetadataHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataNoResponse         AuditsFederatedAuthRequestIssueReason = "ClientMetadataNoResponse"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidResponse    AuditsFederatedAuthRequestIssueReason = "ClientMetadataInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonClientMetadataInvalidContentType AuditsFederatedAuthRequestIssueReason = "ClientMetadataInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy     AuditsFederatedAuthRequestIssueReason = "IdpNotPotentiallyTrustworthy"
+	AuditsFederatedAuthRequestIssueReasonDisabledInSettings               AuditsFederatedAuthRequestIssueReason = "DisabledInSettings"
+	AuditsFederatedAuthRequestIssueReasonDisabledInFlags                  AuditsFederatedAuthRequestIssueReason = "DisabledInFlags"
+	AuditsFederatedAuthRequestIssueReasonErrorFetchingSignin              AuditsFederatedAuthRequestIssueReason = "ErrorFetchingSignin"
+	AuditsFederatedAuthRequestIssueReasonInvalidSigninResponse            AuditsFederatedAuthRequestIssueReason = "InvalidSigninResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsHTTPNotFound             AuditsFederatedAuthRequestIssueReason = "AccountsHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonAccountsNoResponse               AuditsFederatedAuthRequestIssueReason = "AccountsNoResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsInvalidResponse          AuditsFederatedAuthRequestIssueReason = "AccountsInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonAccountsListEmpty                AuditsFederatedAuthRequestIssueReason = "AccountsListEmpty"
+	AuditsFederatedAuthRequestIssueReasonAccountsInvalidContentType       AuditsFederatedAuthRequestIssueReason = "AccountsInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonIDTokenHTTPNotFound              AuditsFederatedAuthRequestIssueReason = "IdTokenHttpNotFound"
+	AuditsFederatedAuthRequestIssueReasonIDTokenNoResponse                AuditsFederatedAuthRequestIssueReason = "IdTokenNoResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenInvalidResponse           AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenIdpErrorResponse          AuditsFederatedAuthRequestIssueReason = "IdTokenIdpErrorResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse AuditsFederatedAuthRequestIssueReason = "IdTokenCrossSiteIdpErrorResponse"
+	AuditsFederatedAuthRequestIssueReasonIDTokenInvalidRequest            AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidRequest"
+	AuditsFederatedAuthRequestIssueReasonIDTokenInvalidContentType        AuditsFederatedAuthRequestIssueReason = "IdTokenInvalidContentType"
+	AuditsFederatedAuthRequestIssueReasonErrorIDToken                     AuditsFederatedAuthRequestIssueReason = "ErrorIdToken"
+	AuditsFederatedAuthRequestIssueReasonCanceled                         AuditsFederatedAuthRequestIssueReason = "Canceled"
+	AuditsFederatedAuthRequestIssueReasonRpPageNotVisible                 AuditsFederatedAuthRequestIssueReason = "RpPageNotVisible"
+	AuditsFederatedAuthRequestIssueReasonSilentMediationFailure           AuditsFederatedAuthRequestIssueReason = "SilentMediationFailure"
+	AuditsFederatedAuthRequestIssueReasonThirdPartyCookiesBlocked         AuditsFederatedAuthRequestIssueReason = "ThirdPartyCookiesBlocked"
+	AuditsFederatedAuthRequestIssueReasonNotSignedInWithIdp               AuditsFederatedAuthRequestIssueReason = "NotSignedInWithIdp"
+	AuditsFederatedAuthRequestIssueReasonMissingTransientUserActivation   AuditsFederatedAuthRequestIssueReason = "MissingTransientUserActivation"
+	AuditsFederatedAuthRequestIssueReasonReplacedByActiveMode             AuditsFederatedAuthRequestIssueReason = "ReplacedByActiveMode"
+	AuditsFederatedAuthRequestIssueReasonInvalidFieldsSpecified           AuditsFederatedAuthRequestIssueReason = "InvalidFieldsSpecified"
+	AuditsFederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque       AuditsFederatedAuthRequestIssueReason = "RelyingPartyOriginIsOpaque"
+	AuditsFederatedAuthRequestIssueReasonTypeNotMatching                  AuditsFederatedAuthRequestIssueReason = "TypeNotMatching"
+	AuditsFederatedAuthRequestIssueReasonUIDismissedNoEmbargo             AuditsFederatedAuthRequestIssueReason = "UiDismissedNoEmbargo"
+	AuditsFederatedAuthRequestIssueReasonCorsError                        AuditsFederatedAuthRequestIssueReason = "CorsError"
+	AuditsFederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform AuditsFederatedAuthRequestIssueReason = "SuppressedBySegmentationPlatform"
+)
+
+// AuditsFederatedAuthUserInfoRequestIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthUserInfoRequestIssueDetails
+type AuditsFederatedAuthUserInfoRequestIssueDetails struct {
+	FederatedAuthUserInfoRequestIssueReason AuditsFederatedAuthUserInfoRequestIssueReason `json:"federatedAuthUserInfoRequestIssueReason"`
+}
+
+// AuditsFederatedAuthUserInfoRequestIssueReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FederatedAuthUserInfoRequestIssueReason
+type AuditsFederatedAuthUserInfoRequestIssueReason string
+
+// AuditsFederatedAuthUserInfoRequestIssueReason values
+const (
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotSameOrigin                      AuditsFederatedAuthUserInfoRequestIssueReason = "NotSameOrigin"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotIframe                          AuditsFederatedAuthUserInfoRequestIssueReason = "NotIframe"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy          AuditsFederatedAuthUserInfoRequestIssueReason = "NotPotentiallyTrustworthy"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoAPIPermission                    AuditsFederatedAuthUserInfoRequestIssueReason = "NoApiPermission"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp                 AuditsFederatedAuthUserInfoRequestIssueReason = "NotSignedInWithIdp"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission         AuditsFederatedAuthUserInfoRequestIssueReason = "NoAccountSharingPermission"
+	AuditsFederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown           AuditsFederatedAuthUserInfoRequestIssueReason = "InvalidConfigOrWellKnown"
+	AuditsFederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse            AuditsFederatedAuthUserInfoRequestIssueReason = "InvalidAccountsResponse"
+	AuditsFederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts AuditsFederatedAuthUserInfoRequestIssueReason = "NoReturningUserFromFetchedAccounts"
+)
+
+// AuditsClientHintIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ClientHintIssueDetails
+type AuditsClientHintIssueDetails struct {
+	SourceCodeLocation    *AuditsSourceCodeLocation   `json:"sourceCodeLocation"`
+	ClientHintIssueReason AuditsClientHintIssueReason `json:"clientHintIssueReason"`
+}
+
+// AuditsFailedRequestInfo https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-FailedRequestInfo
+type AuditsFailedRequestInfo struct {
+	URL            string           `json:"url"`
+	FailureMessage string           `json:"failureMessage"`
+	RequestID      NetworkRequestID `json:"requestId,omitempty"`
+}
+
+// AuditsPartitioningBlobURLInfo https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PartitioningBlobURLInfo
+type AuditsPartitioningBlobURLInfo string
+
+// AuditsPartitioningBlobURLInfo values
+const (
+	AuditsPartitioningBlobURLInfoBlockedCrossPartitionFetching AuditsPartitioningBlobURLInfo = "BlockedCrossPartitionFetching"
+	AuditsPartitioningBlobURLInfoEnforceNoopenerForNavigation  AuditsPartitioningBlobURLInfo = "EnforceNoopenerForNavigation"
+)
+
+// AuditsPartitioningBlobURLIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PartitioningBlobURLIssueDetails
+type AuditsPartitioningBlobURLIssueDetails struct {
+	URL                     string                        `json:"url"`
+	PartitioningBlobURLInfo AuditsPartitioningBlobURLInfo `json:"partitioningBlobURLInfo"`
+}
+
+// AuditsElementAccessibilityIssueReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ElementAccessibilityIssueReason
+type AuditsElementAccessibilityIssueReason string
+
+// AuditsElementAccessibilityIssueReason values
+const (
+	AuditsElementAccessibilityIssueReasonDisallowedSelectChild               AuditsElementAccessibilityIssueReason = "DisallowedSelectChild"
+	AuditsElementAccessibilityIssueReasonDisallowedOptGroupChild             AuditsElementAccessibilityIssueReason = "DisallowedOptGroupChild"
+	AuditsElementAccessibilityIssueReasonNonPhrasingContentOptionChild       AuditsElementAccessibilityIssueReason = "NonPhrasingContentOptionChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentOptionChild       AuditsElementAccessibilityIssueReason = "InteractiveContentOptionChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentLegendChild       AuditsElementAccessibilityIssueReason = "InteractiveContentLegendChild"
+	AuditsElementAccessibilityIssueReasonInteractiveContentSummaryDescendant AuditsElementAccessibilityIssueReason = "InteractiveContentSummaryDescendant"
+)
+
+// AuditsElementAccessibilityIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-ElementAccessibilityIssueDetails
+type AuditsElementAccessibilityIssueDetails struct {
+	NodeID                          DOMBackendNodeID                      `json:"nodeId"`
+	ElementAccessibilityIssueReason AuditsElementAccessibilityIssueReason `json:"elementAccessibilityIssueReason"`
+	HasDisallowedAttributes         bool                                  `json:"hasDisallowedAttributes"`
+}
+
+// AuditsStyleSheetLoadingIssueReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-StyleSheetLoadingIssueReason
+type AuditsStyleSheetLoadingIssueReason string
+
+// AuditsStyleSheetLoadingIssueReason values
+const (
+	AuditsStyleSheetLoadingIssueReasonLateImportRule AuditsStyleSheetLoadingIssueReason = "LateImportRule"
+	AuditsStyleSheetLoadingIssueReasonRequestFailed  AuditsStyleSheetLoadingIssueReason = "RequestFailed"
+)
+
+// AuditsStylesheetLoadingIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-StylesheetLoadingIssueDetails
+type AuditsStylesheetLoadingIssueDetails struct {
+	SourceCodeLocation           *AuditsSourceCodeLocation          `json:"sourceCodeLocation"`
+	StyleSheetLoadingIssueReason AuditsStyleSheetLoadingIssueReason `json:"styleSheetLoadingIssueReason"`
+	FailedRequestInfo            *AuditsFailedRequestInfo           `json:"failedRequestInfo,omitempty"`
+}
+
+// AuditsPropertyRuleIssueReason https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PropertyRuleIssueReason
+type AuditsPropertyRuleIssueReason string
+
+// AuditsPropertyRuleIssueReason values
+const (
+	AuditsPropertyRuleIssueReasonInvalidSyntax       AuditsPropertyRuleIssueReason = "InvalidSyntax"
+	AuditsPropertyRuleIssueReasonInvalidInitialValue AuditsPropertyRuleIssueReason = "InvalidInitialValue"
+	AuditsPropertyRuleIssueReasonInvalidInherits     AuditsPropertyRuleIssueReason = "InvalidInherits"
+	AuditsPropertyRuleIssueReasonInvalidName         AuditsPropertyRuleIssueReason = "InvalidName"
+)
+
+// AuditsPropertyRuleIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-PropertyRuleIssueDetails
+type AuditsPropertyRuleIssueDetails struct {
+	SourceCodeLocation      *AuditsSourceCodeLocation     `json:"sourceCodeLocation"`
+	PropertyRuleIssueReason AuditsPropertyRuleIssueReason `json:"propertyRuleIssueReason"`
+	PropertyValue           string                        `json:"propertyValue,omitempty"`
+}
+
+// AuditsUserReidentificationIssueType https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UserReidentificationIssueType
+type AuditsUserReidentificationIssueType string
+
+// AuditsUserReidentificationIssueType values
+const (
+	AuditsUserReidentificationIssueTypeBlockedFrameNavigation AuditsUserReidentificationIssueType = "BlockedFrameNavigation"
+	AuditsUserReidentificationIssueTypeBlockedSubresource     AuditsUserReidentificationIssueType = "BlockedSubresource"
+)
+
+// AuditsUserReidentificationIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-UserReidentificationIssueDetails
+type AuditsUserReidentificationIssueDetails struct {
+	Type    AuditsUserReidentificationIssueType `json:"type"`
+	Request *AuditsAffectedRequest              `json:"request,omitempty"`
+}
+
+// AuditsInspectorIssueCode https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssueCode
+type AuditsInspectorIssueCode string
+
+// AuditsInspectorIssueCode values
+const (
+	AuditsInspectorIssueCodeCookieIssue                       AuditsInspectorIssueCode = "CookieIssue"
+	AuditsInspectorIssueCodeMixedContentIssue                 AuditsInspectorIssueCode = "MixedContentIssue"
+	AuditsInspectorIssueCodeBlockedByResponseIssue            AuditsInspectorIssueCode = "BlockedByResponseIssue"
+	AuditsInspectorIssueCodeHeavyAdIssue                      AuditsInspectorIssueCode = "HeavyAdIssue"
+	AuditsInspectorIssueCodeContentSecurityPolicyIssue        AuditsInspectorIssueCode = "ContentSecurityPolicyIssue"
+	AuditsInspectorIssueCodeSharedArrayBufferIssue            AuditsInspectorIssueCode = "SharedArrayBufferIssue"
+	AuditsInspectorIssueCodeLowTextContrastIssue              AuditsInspectorIssueCode = "LowTextContrastIssue"
+	AuditsInspectorIssueCodeCorsIssue                         AuditsInspectorIssueCode = "CorsIssue"
+	AuditsInspectorIssueCodeAttributionReportingIssue         AuditsInspectorIssueCode = "AttributionReportingIssue"
+	AuditsInspectorIssueCodeQuirksModeIssue                   AuditsInspectorIssueCode = "QuirksModeIssue"
+	AuditsInspectorIssueCodePartitioningBlobURLIssue          AuditsInspectorIssueCode = "PartitioningBlobURLIssue"
+	AuditsInspectorIssueCodeNavigatorUserAgentIssue           AuditsInspectorIssueCode = "NavigatorUserAgentIssue"
+	AuditsInspectorIssueCodeGenericIssue                      AuditsInspectorIssueCode = "GenericIssue"
+	AuditsInspectorIssueCodeDeprecationIssue                  AuditsInspectorIssueCode = "DeprecationIssue"
+	AuditsInspectorIssueCodeClientHintIssue                   AuditsInspectorIssueCode = "ClientHintIssue"
+	AuditsInspectorIssueCodeFederatedAuthRequestIssue         AuditsInspectorIssueCode = "FederatedAuthRequestIssue"
+	AuditsInspectorIssueCodeBounceTrackingIssue               AuditsInspectorIssueCode = "BounceTrackingIssue"
+	AuditsInspectorIssueCodeCookieDeprecationMetadataIssue    AuditsInspectorIssueCode = "CookieDeprecationMetadataIssue"
+	AuditsInspectorIssueCodeStylesheetLoadingIssue            AuditsInspectorIssueCode = "StylesheetLoadingIssue"
+	AuditsInspectorIssueCodeFederatedAuthUserInfoRequestIssue AuditsInspectorIssueCode = "FederatedAuthUserInfoRequestIssue"
+	AuditsInspectorIssueCodePropertyRuleIssue                 AuditsInspectorIssueCode = "PropertyRuleIssue"
+	AuditsInspectorIssueCodeSharedDictionaryIssue             AuditsInspectorIssueCode = "SharedDictionaryIssue"
+	AuditsInspectorIssueCodeElementAccessibilityIssue         AuditsInspectorIssueCode = "ElementAccessibilityIssue"
+	AuditsInspectorIssueCodeSRIMessageSignatureIssue          AuditsInspectorIssueCode = "SRIMessageSignatureIssue"
+	AuditsInspectorIssueCodeUnencodedDigestIssue              AuditsInspectorIssueCode = "UnencodedDigestIssue"
+	AuditsInspectorIssueCodeUserReidentificationIssue         AuditsInspectorIssueCode = "UserReidentificationIssue"
+)
+
+// AuditsInspectorIssueDetails https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssueDetails
+type AuditsInspectorIssueDetails struct {
+	CookieIssueDetails                *AuditsCookieIssueDetails                `json:"cookieIssueDetails,omitempty"`
+	MixedContentIssueDetails          *AuditsMixedContentIssueDetails          `json:"mixedContentIssueDetails,omitempty"`
+	BlockedByResponseIssueDetails     *AuditsBlockedByResponseIssueDetails     `json:"blockedByResponseIssueDetails,omitempty"`
+	HeavyAdIssueDetails               *AuditsHeavyAdIssueDetails               `json:"heavyAdIssueDetails,omitempty"`
+	ContentSecurityPolicyIssueDetails *AuditsContentSecurityPolicyIssueDetails `json:"contentSecurityPolicyIssueDetails,omitempty"`
+	SharedArrayBufferIssueDetails     *AuditsSharedArrayBufferIssueDetails     `json:"sharedArrayBufferIssueDetails,omitempty"`
+	LowTextContrastIssueDetails       *AuditsLowTextContrastIssueDetails       `json:"lowTextContrastIssueDetails,omitempty"`
+	CorsIssueDetails                  *AuditsCorsIssueDetails                  `json:"corsIssueDetails,omitempty"`
+	AttributionReportingIssueDetails  *AuditsAttributionReportingIssueDetails  `json:"attributionReportingIssueDetails,omitempty"`
+	QuirksModeIssueDetails            *AuditsQuirksModeIssueDetails            `json:"quirksModeIssueDetails,omitempty"`
+	PartitioningBlobURLIssueDetails   *AuditsPartitioningBlobURLIssueDetails   `json:"partitioningBlobURLIssueDetails,omitempty"`
+	// Deprecated: deprecated in protocol
+	NavigatorUserAgentIssueDetails           *AuditsNavigatorUserAgentIssueDetails           `json:"navigatorUserAgentIssueDetails,omitempty"`
+	GenericIssueDetails                      *AuditsGenericIssueDetails                      `json:"genericIssueDetails,omitempty"`
+	DeprecationIssueDetails                  *AuditsDeprecationIssueDetails                  `json:"deprecationIssueDetails,omitempty"`
+	ClientHintIssueDetails                   *AuditsClientHintIssueDetails                   `json:"clientHintIssueDetails,omitempty"`
+	FederatedAuthRequestIssueDetails         *AuditsFederatedAuthRequestIssueDetails         `json:"federatedAuthRequestIssueDetails,omitempty"`
+	BounceTrackingIssueDetails               *AuditsBounceTrackingIssueDetails               `json:"bounceTrackingIssueDetails,omitempty"`
+	CookieDeprecationMetadataIssueDetails    *AuditsCookieDeprecationMetadataIssueDetails    `json:"cookieDeprecationMetadataIssueDetails,omitempty"`
+	StylesheetLoadingIssueDetails            *AuditsStylesheetLoadingIssueDetails            `json:"stylesheetLoadingIssueDetails,omitempty"`
+	PropertyRuleIssueDetails                 *AuditsPropertyRuleIssueDetails                 `json:"propertyRuleIssueDetails,omitempty"`
+	FederatedAuthUserInfoRequestIssueDetails *AuditsFederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"`
+	SharedDictionaryIssueDetails             *AuditsSharedDictionaryIssueDetails             `json:"sharedDictionaryIssueDetails,omitempty"`
+	ElementAccessibilityIssueDetails         *AuditsElementAccessibilityIssueDetails         `json:"elementAccessibilityIssueDetails,omitempty"`
+	SriMessageSignatureIssueDetails          *AuditsSRIMessageSignatureIssueDetails          `json:"sriMessageSignatureIssueDetails,omitempty"`
+	UnencodedDigestIssueDetails              *AuditsUnencodedDigestIssueDetails              `json:"unencodedDigestIssueDetails,omitempty"`
+	UserReidentificationIssueDetails         *AuditsUserReidentificationIssueDetails         `json:"userReidentificationIssueDetails,omitempty"`
+}
+
+// AuditsIssueID https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-IssueId
+type AuditsIssueID string
+
+// AuditsInspectorIssue https://chromedevtools.github.io/devtools-protocol/tot/Audits#type-InspectorIssue
+type AuditsInspectorIssue struct {
+	Code    AuditsInspectorIssueCode     `json:"code"`
+	Details *AuditsInspectorIssueDetails `json:"details"`
+	IssueID AuditsIssueID                `json:"issueId,omitempty"`
+}
+
+// AuditsGetEncodedResponseParams https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-getEncodedResponse
+type AuditsGetEncodedResponseParams struct {
+	RequestID NetworkRequestID                       `json:"requestId"`
+	Encoding  AuditsGetEncodedResponseParamsEncoding `json:"encoding"`
+	Quality   float64                                `json:"quality,omitempty"`
+	SizeOnly  bool                                   `json:"sizeOnly,omitempty"`
+}
+
+// ProtocolMethod returns Audits.getEncodedResponse
+func (*AuditsGetEncodedResponseParams) ProtocolMethod() string {
+	return "Audits.getEncodedResponse"
+}
+
+// AuditsGetEncodedResponseResult https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-getEncodedResponse
+type AuditsGetEncodedResponseResult struct {
+	Body         string `json:"body,omitempty"`
+	OriginalSize int64  `json:"originalSize"`
+	EncodedSize  int64  `json:"encodedSize"`
+}
+
+// Do sends Audits.getEncodedResponse and returns its result
+func (p *AuditsGetEncodedResponseParams) Do(e Executor) (*AuditsGetEncodedResponseResult, error) {
+	var result = new(AuditsGetEncodedResponseResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AuditsDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-disable
+type AuditsDisableParams struct {
+}
+
+// ProtocolMethod returns Audits.disable
+func (*AuditsDisableParams) ProtocolMethod() string {
+	return "Audits.disable"
+}
+
+// Do sends Audits.disable
+func (p *AuditsDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AuditsEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-enable
+type AuditsEnableParams struct {
+}
+
+// ProtocolMethod returns Audits.enable
+func (*AuditsEnableParams) ProtocolMethod() string {
+	return "Audits.enable"
+}
+
+// Do sends Audits.enable
+func (p *AuditsEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AuditsCheckContrastParams https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-checkContrast
+type AuditsCheckContrastParams struct {
+	ReportAAA bool `json:"reportAAA,omitempty"`
+}
+
+// ProtocolMethod returns Audits.checkContrast
+func (*AuditsCheckContrastParams) ProtocolMethod() string {
+	return "Audits.checkContrast"
+}
+
+// Do sends Audits.checkContrast
+func (p *AuditsCheckContrastParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AuditsCheckFormsIssuesParams https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-checkFormsIssues
+type AuditsCheckFormsIssuesParams struct {
+}
+
+// ProtocolMethod returns Audits.checkFormsIssues
+func (*AuditsCheckFormsIssuesParams) ProtocolMethod() string {
+	return "Audits.checkFormsIssues"
+}
+
+// AuditsCheckFormsIssuesResult https://chromedevtools.github.io/devtools-protocol/tot/Audits#method-checkFormsIssues
+type AuditsCheckFormsIssuesResult struct {
+	FormIssues []*AuditsGenericIssueDetails `json:"formIssues"`
+}
+
+// Do sends Audits.checkFormsIssues and returns its result
+func (p *AuditsCheckFormsIssuesParams) Do(e Executor) (*AuditsCheckFormsIssuesResult, error) {
+	var result = new(AuditsCheckFormsIssuesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AuditsIssueAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/Audits#event-issueAdded
+type AuditsIssueAddedEvent struct {
+	Issue *AuditsInspectorIssue `json:"issue"`
+}
+
+// ProtocolMethod returns Audits.issueAdded
+func (*AuditsIssueAddedEvent) ProtocolMethod() string {
+	return "Audits.issueAdded"
+}
+
+// AuditsGetEncodedResponseParamsEncoding enum
+type AuditsGetEncodedResponseParamsEncoding string
+
+// AuditsGetEncodedResponseParamsEncoding values
+const (
+	AuditsGetEncodedResponseParamsEncodingWebp AuditsGetEncodedResponseParamsEncoding = "webp"
+	AuditsGetEncodedResponseParamsEncodingJpeg AuditsGetEncodedResponseParamsEncoding = "jpeg"
+	AuditsGetEncodedResponseParamsEncodingPng  AuditsGetEncodedResponseParamsEncoding = "png"
+)
@@ -0,0 +1,130 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Autofill events
+const (
+	EventAutofillAddressFormFilled = "Autofill.addressFormFilled"
+)
+
+// AutofillCreditCard https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-CreditCard
+type AutofillCreditCard struct {
+	Number      string `json:"number"`
+	Name        string `json:"name"`
+	ExpiryMonth string `json:"expiryMonth"`
+	ExpiryYear  string `json:"expiryYear"`
+	Cvc         string `json:"cvc"`
+}
+
+// AutofillAddressField https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressField
+type AutofillAddressField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AutofillAddressFields https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressFields
+type AutofillAddressFields struct {
+	Fields []*AutofillAddressField `json:"fields"`
+}
+
+// AutofillAddress https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-Address
+type AutofillAddress struct {
+	Fields []*AutofillAddressField `json:"fields"`
+}
+
+// AutofillAddressUI https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-AddressUI
+type AutofillAddressUI struct {
+	AddressFields []*AutofillAddressFields `json:"addressFields"`
+}
+
+// AutofillFillingStrategy https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-FillingStrategy
+type AutofillFillingStrategy string
+
+// AutofillFillingStrategy values
+const (
+	AutofillFillingStrategyAutocompleteAttribute AutofillFillingStrategy = "autocompleteAttribute"
+	AutofillFillingStrategyAutofillInferred      AutofillFillingStrategy = "autofillInferred"
+)
+
+// AutofillFilledField https://chromedevtools.github.io/devtools-protocol/tot/Autofill#type-FilledField
+type AutofillFilledField struct {
+	HTMLType        string                  `json:"htmlType"`
+	ID              string                  `json:"id"`
+	Name            string                  `json:"name"`
+	Value           string                  `json:"value"`
+	AutofillType    string                  `json:"autofillType"`
+	FillingStrategy AutofillFillingStrategy `json:"fillingStrategy"`
+	FrameID         PageFrameID             `json:"frameId"`
+	FieldID         DOMBackendNodeID        `json:"fieldId"`
+}
+
+// AutofillTriggerParams https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-trigger
+type AutofillTriggerParams struct {
+	FieldID DOMBackendNodeID    `json:"fieldId"`
+	FrameID PageFrameID         `json:"frameId,omitempty"`
+	Card    *AutofillCreditCard `json:"card"`
+}
+
+// ProtocolMethod returns Autofill.trigger
+func (*AutofillTriggerParams) ProtocolMethod() string {
+	return "Autofill.trigger"
+}
+
+// Do sends Autofill.trigger
+func (p *AutofillTriggerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AutofillSetAddressesParams https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-setAddresses
+type AutofillSetAddressesParams struct {
+	Addresses []*AutofillAddress `json:"addresses"`
+}
+
+// ProtocolMethod returns Autofill.setAddresses
+func (*AutofillSetAddressesParams) ProtocolMethod() string {
+	return "Autofill.setAddresses"
+}
+
+// Do sends Autofill.setAddresses
+func (p *AutofillSetAddressesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AutofillDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-disable
+type AutofillDisableParams struct {
+}
+
+// ProtocolMethod returns Autofill.disable
+func (*AutofillDisableParams) ProtocolMethod() string {
+	return "Autofill.disable"
+}
+
+// Do sends Autofill.disable
+func (p *AutofillDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AutofillEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Autofill#method-enable
+type AutofillEnableParams struct {
+}
+
+// ProtocolMethod returns Autofill.enable
+func (*AutofillEnableParams) ProtocolMethod() string {
+	return "Autofill.enable"
+}
+
+// Do sends Autofill.enable
+func (p *AutofillEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// AutofillAddressFormFilledEvent https://chromedevtools.github.io/devtools-protocol/tot/Autofill#event-addressFormFilled
+type AutofillAddressFormFilledEvent struct {
+	FilledFields []*AutofillFilledField `json:"filledFields"`
+	AddressUI    *AutofillAddressUI     `json:"addressUi"`
+}
+
+// ProtocolMethod returns Autofill.addressFormFilled
+func (*AutofillAddressFormFilledEvent) ProtocolMethod() string {
+	return "Autofill.addressFormFilled"
+}
@@ -0,0 +1,122 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// BackgroundService events
+const (
+	EventBackgroundServiceRecordingStateChanged          = "BackgroundService.recordingStateChanged"
+	EventBackgroundServiceBackgroundServiceEventReceived = "BackgroundService.backgroundServiceEventReceived"
+)
+
+// BackgroundServiceServiceName https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-ServiceName
+type BackgroundServiceServiceName string
+
+// BackgroundServiceServiceName values
+const (
+	BackgroundServiceServiceNameBackgroundFetch        BackgroundServiceServiceName = "backgroundFetch"
+	BackgroundServiceServiceNameBackgroundSync         BackgroundServiceServiceName = "backgroundSync"
+	BackgroundServiceServiceNamePushMessaging          BackgroundServiceServiceName = "pushMessaging"
+	BackgroundServiceServiceNameNotifications          BackgroundServiceServiceName = "notifications"
+	BackgroundServiceServiceNamePaymentHandler         BackgroundServiceServiceName = "paymentHandler"
+	BackgroundServiceServiceNamePeriodicBackgroundSync BackgroundServiceServiceName = "periodicBackgroundSync"
+)
+
+// BackgroundServiceEventMetadata https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-EventMetadata
+type BackgroundServiceEventMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BackgroundServiceBackgroundServiceEvent https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#type-BackgroundServiceEvent
+type BackgroundServiceBackgroundServiceEvent struct {
+	Timestamp                   NetworkTimeSinceEpoch             `json:"timestamp"`
+	Origin                      string                            `json:"origin"`
+	ServiceWorkerRegistrationID ServiceWorkerRegistrationID       `json:"serviceWorkerRegistrationId"`
+	Service                     BackgroundServiceServiceName      `json:"service"`
+	EventName                   string                            `json:"eventName"`
+	InstanceID                  string                            `json:"instanceId"`
+	EventMetadata               []*BackgroundServiceEventMetadata `json:"eventMetadata"`
+	StorageKey                  string                            `json:"storageKey"`
+}
+
+// BackgroundServiceStartObservingParams https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-startObserving
+type BackgroundServiceStartObservingParams struct {
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// ProtocolMethod returns BackgroundService.startObserving
+func (*BackgroundServiceStartObservingParams) ProtocolMethod() string {
+	return "BackgroundService.startObserving"
+}
+
+// Do sends BackgroundService.startObserving
+func (p *BackgroundServiceStartObservingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BackgroundServiceStopObservingParams https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-stopObserving
+type BackgroundServiceStopObservingParams struct {
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// ProtocolMethod returns BackgroundService.stopObserving
+func (*BackgroundServiceStopObservingParams) ProtocolMethod() string {
+	return "BackgroundService.stopObserving"
+}
+
+// Do sends BackgroundService.stopObserving
+func (p *BackgroundServiceStopObservingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BackgroundServiceSetRecordingParams https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-setRecording
+type BackgroundServiceSetRecordingParams struct {
+	ShouldRecord bool                         `json:"shouldRecord"`
+	Service      BackgroundServiceServiceName `json:"service"`
+}
+
+// ProtocolMethod returns BackgroundService.setRecording
+func (*BackgroundServiceSetRecordingParams) ProtocolMethod() string {
+	return "BackgroundService.setRecording"
+}
+
+// Do sends BackgroundService.setRecording
+func (p *BackgroundServiceSetRecordingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BackgroundServiceClearEventsParams https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#method-clearEvents
+type BackgroundServiceClearEventsParams struct {
+	Service BackgroundServiceServiceName `json:"service"`
+}
+
+// ProtocolMethod returns BackgroundService.clearEvents
+func (*BackgroundServiceClearEventsParams) ProtocolMethod() string {
+	return "BackgroundService.clearEvents"
+}
+
+// Do sends BackgroundService.clearEvents
+func (p *BackgroundServiceClearEventsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BackgroundServiceRecordingStateChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#event-recordingStateChanged
+type BackgroundServiceRecordingStateChangedEvent struct {
+	IsRecording bool                         `json:"isRecording"`
+	Service     BackgroundServiceServiceName `json:"service"`
+}
+
+// ProtocolMethod returns BackgroundService.recordingStateChanged
+func (*BackgroundServiceRecordingStateChangedEvent) ProtocolMethod() string {
+	return "BackgroundService.recordingStateChanged"
+}
+
+// BackgroundServiceBackgroundServiceEventReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService#event-backgroundServiceEventReceived
+type BackgroundServiceBackgroundServiceEventReceivedEvent struct {
+	BackgroundServiceEvent *BackgroundServiceBackgroundServiceEvent `json:"backgroundServiceEvent"`
+}
+
+// ProtocolMethod returns BackgroundService.backgroundServiceEventReceived
+func (*BackgroundServiceBackgroundServiceEventReceivedEvent) ProtocolMethod() string {
+	return "BackgroundService.backgroundServiceEventReceived"
+}
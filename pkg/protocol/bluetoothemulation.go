@@ -0,0 +1,396 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// BluetoothEmulation events
+const (
+	EventBluetoothEmulationGattOperationReceived           = "BluetoothEmulation.gattOperationReceived"
+	EventBluetoothEmulationCharacteristicOperationReceived = "BluetoothEmulation.characteristicOperationReceived"
+	EventBluetoothEmulationDescriptorOperationReceived     = "BluetoothEmulation.descriptorOperationReceived"
+)
+
+// BluetoothEmulationCentralState https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CentralState
+type BluetoothEmulationCentralState string
+
+// BluetoothEmulationCentralState values
+const (
+	BluetoothEmulationCentralStateAbsent     BluetoothEmulationCentralState = "absent"
+	BluetoothEmulationCentralStatePoweredOff BluetoothEmulationCentralState = "powered-off"
+	BluetoothEmulationCentralStatePoweredOn  BluetoothEmulationCentralState = "powered-on"
+)
+
+// BluetoothEmulationGATTOperationType https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-GATTOperationType
+type BluetoothEmulationGATTOperationType string
+
+// BluetoothEmulationGATTOperationType values
+const (
+	BluetoothEmulationGATTOperationTypeConnection BluetoothEmulationGATTOperationType = "connection"
+	BluetoothEmulationGATTOperationTypeDiscovery  BluetoothEmulationGATTOperationType = "discovery"
+)
+
+// BluetoothEmulationCharacteristicWriteType https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicWriteType
+type BluetoothEmulationCharacteristicWriteType string
+
+// BluetoothEmulationCharacteristicWriteType values
+const (
+	BluetoothEmulationCharacteristicWriteTypeWriteDefaultDeprecated BluetoothEmulationCharacteristicWriteType = "write-default-deprecated"
+	BluetoothEmulationCharacteristicWriteTypeWriteWithResponse      BluetoothEmulationCharacteristicWriteType = "write-with-response"
+	BluetoothEmulationCharacteristicWriteTypeWriteWithoutResponse   BluetoothEmulationCharacteristicWriteType = "write-without-response"
+)
+
+// BluetoothEmulationCharacteristicOperationType https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicOperationType
+type BluetoothEmulationCharacteristicOperationType string
+
+// BluetoothEmulationCharacteristicOperationType values
+const (
+	BluetoothEmulationCharacteristicOperationTypeRead                         BluetoothEmulationCharacteristicOperationType = "read"
+	BluetoothEmulationCharacteristicOperationTypeWrite                        BluetoothEmulationCharacteristicOperationType = "write"
+	BluetoothEmulationCharacteristicOperationTypeSubscribeToNotifications     BluetoothEmulationCharacteristicOperationType = "subscribe-to-notifications"
+	BluetoothEmulationCharacteristicOperationTypeUnsubscribeFromNotifications BluetoothEmulationCharacteristicOperationType = "unsubscribe-from-notifications"
+)
+
+// BluetoothEmulationDescriptorOperationType https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-DescriptorOperationType
+type BluetoothEmulationDescriptorOperationType string
+
+// BluetoothEmulationDescriptorOperationType values
+const (
+	BluetoothEmulationDescriptorOperationTypeRead  BluetoothEmulationDescriptorOperationType = "read"
+	BluetoothEmulationDescriptorOperationTypeWrite BluetoothEmulationDescriptorOperationType = "write"
+)
+
+// BluetoothEmulationManufacturerData https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ManufacturerData
+type BluetoothEmulationManufacturerData struct {
+	Key  int64  `json:"key"`
+	Data string `json:"data"`
+}
+
+// BluetoothEmulationScanRecord https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ScanRecord
+type BluetoothEmulationScanRecord struct {
+	Name             string                                `json:"name,omitempty"`
+	Uuids            []string                              `json:"uuids,omitempty"`
+	Appearance       int64                                 `json:"appearance,omitempty"`
+	TxPower          int64                                 `json:"txPower,omitempty"`
+	ManufacturerData []*BluetoothEmulationManufacturerData `json:"manufacturerData,omitempty"`
+}
+
+// BluetoothEmulationScanEntry https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-ScanEntry
+type BluetoothEmulationScanEntry struct {
+	DeviceAddress string                        `json:"deviceAddress"`
+	Rssi          int64                         `json:"rssi"`
+	ScanRecord    *BluetoothEmulationScanRecord `json:"scanRecord"`
+}
+
+// BluetoothEmulationCharacteristicProperties https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#type-CharacteristicProperties
+type BluetoothEmulationCharacteristicProperties struct {
+	Broadcast                 bool `json:"broadcast,omitempty"`
+	Read                      bool `json:"read,omitempty"`
+	WriteWithoutResponse      bool `json:"writeWithoutResponse,omitempty"`
+	Write                     bool `json:"write,omitempty"`
+	Notify                    bool `json:"notify,omitempty"`
+	Indicate                  bool `json:"indicate,omitempty"`
+	AuthenticatedSignedWrites bool `json:"authenticatedSignedWrites,omitempty"`
+	ExtendedProperties        bool `json:"extendedProperties,omitempty"`
+}
+
+// BluetoothEmulationEnableParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-enable
+type BluetoothEmulationEnableParams struct {
+	State       BluetoothEmulationCentralState `json:"state"`
+	LeSupported bool                           `json:"leSupported"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.enable
+func (*BluetoothEmulationEnableParams) ProtocolMethod() string {
+	return "BluetoothEmulation.enable"
+}
+
+// Do sends BluetoothEmulation.enable
+func (p *BluetoothEmulationEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSetSimulatedCentralStateParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-setSimulatedCentralState
+type BluetoothEmulationSetSimulatedCentralStateParams struct {
+	State BluetoothEmulationCentralState `json:"state"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.setSimulatedCentralState
+func (*BluetoothEmulationSetSimulatedCentralStateParams) ProtocolMethod() string {
+	return "BluetoothEmulation.setSimulatedCentralState"
+}
+
+// Do sends BluetoothEmulation.setSimulatedCentralState
+func (p *BluetoothEmulationSetSimulatedCentralStateParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationDisableParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-disable
+type BluetoothEmulationDisableParams struct {
+}
+
+// ProtocolMethod returns BluetoothEmulation.disable
+func (*BluetoothEmulationDisableParams) ProtocolMethod() string {
+	return "BluetoothEmulation.disable"
+}
+
+// Do sends BluetoothEmulation.disable
+func (p *BluetoothEmulationDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSimulatePreconnectedPeripheralParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulatePreconnectedPeripheral
+type BluetoothEmulationSimulatePreconnectedPeripheralParams struct {
+	Address           string                                `json:"address"`
+	Name              string                                `json:"name"`
+	ManufacturerData  []*BluetoothEmulationManufacturerData `json:"manufacturerData"`
+	KnownServiceUuids []string                              `json:"knownServiceUuids"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.simulatePreconnectedPeripheral
+func (*BluetoothEmulationSimulatePreconnectedPeripheralParams) ProtocolMethod() string {
+	return "BluetoothEmulation.simulatePreconnectedPeripheral"
+}
+
+// Do sends BluetoothEmulation.simulatePreconnectedPeripheral
+func (p *BluetoothEmulationSimulatePreconnectedPeripheralParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSimulateAdvertisementParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateAdvertisement
+type BluetoothEmulationSimulateAdvertisementParams struct {
+	Entry *BluetoothEmulationScanEntry `json:"entry"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.simulateAdvertisement
+func (*BluetoothEmulationSimulateAdvertisementParams) ProtocolMethod() string {
+	return "BluetoothEmulation.simulateAdvertisement"
+}
+
+// Do sends BluetoothEmulation.simulateAdvertisement
+func (p *BluetoothEmulationSimulateAdvertisementParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSimulateGATTOperationResponseParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateGATTOperationResponse
+type BluetoothEmulationSimulateGATTOperationResponseParams struct {
+	Address string                              `json:"address"`
+	Type    BluetoothEmulationGATTOperationType `json:"type"`
+	Code    int64                               `json:"code"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.simulateGATTOperationResponse
+func (*BluetoothEmulationSimulateGATTOperationResponseParams) ProtocolMethod() string {
+	return "BluetoothEmulation.simulateGATTOperationResponse"
+}
+
+// Do sends BluetoothEmulation.simulateGATTOperationResponse
+func (p *BluetoothEmulationSimulateGATTOperationResponseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSimulateCharacteristicOperationResponseParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateCharacteristicOperationResponse
+type BluetoothEmulationSimulateCharacteristicOperationResponseParams struct {
+	CharacteristicID string                                        `json:"characteristicId"`
+	Type             BluetoothEmulationCharacteristicOperationType `json:"type"`
+	Code             int64                                         `json:"code"`
+	Data             string                                        `json:"data,omitempty"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.simulateCharacteristicOperationResponse
+func (*BluetoothEmulationSimulateCharacteristicOperationResponseParams) ProtocolMethod() string {
+	return "BluetoothEmulation.simulateCharacteristicOperationResponse"
+}
+
+// Do sends BluetoothEmulation.simulateCharacteristicOperationResponse
+func (p *BluetoothEmulationSimulateCharacteristicOperationResponseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSimulateDescriptorOperationResponseParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateDescriptorOperationResponse
+type BluetoothEmulationSimulateDescriptorOperationResponseParams struct {
+	DescriptorID string                                    `json:"descriptorId"`
+	Type         BluetoothEmulationDescriptorOperationType `json:"type"`
+	Code         int64                                     `json:"code"`
+	Data         string                                    `json:"data,omitempty"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.simulateDescriptorOperationResponse
+func (*BluetoothEmulationSimulateDescriptorOperationResponseParams) ProtocolMethod() string {
+	return "BluetoothEmulation.simulateDescriptorOperationResponse"
+}
+
+// Do sends BluetoothEmulation.simulateDescriptorOperationResponse
+func (p *BluetoothEmulationSimulateDescriptorOperationResponseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationAddServiceParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addService
+type BluetoothEmulationAddServiceParams struct {
+	Address     string `json:"address"`
+	ServiceUuid string `json:"serviceUuid"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.addService
+func (*BluetoothEmulationAddServiceParams) ProtocolMethod() string {
+	return "BluetoothEmulation.addService"
+}
+
+// BluetoothEmulationAddServiceResult https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addService
+type BluetoothEmulationAddServiceResult struct {
+	ServiceID string `json:"serviceId"`
+}
+
+// Do sends BluetoothEmulation.addService and returns its result
+func (p *BluetoothEmulationAddServiceParams) Do(e Executor) (*BluetoothEmulationAddServiceResult, error) {
+	var result = new(BluetoothEmulationAddServiceResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BluetoothEmulationRemoveServiceParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-removeService
+type BluetoothEmulationRemoveServiceParams struct {
+	ServiceID string `json:"serviceId"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.removeService
+func (*BluetoothEmulationRemoveServiceParams) ProtocolMethod() string {
+	return "BluetoothEmulation.removeService"
+}
+
+// Do sends BluetoothEmulation.removeService
+func (p *BluetoothEmulationRemoveServiceParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationAddCharacteristicParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addCharacteristic
+type BluetoothEmulationAddCharacteristicParams struct {
+	ServiceID          string                                      `json:"serviceId"`
+	CharacteristicUuid string                                      `json:"characteristicUuid"`
+	Properties         *BluetoothEmulationCharacteristicProperties `json:"properties"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.addCharacteristic
+func (*BluetoothEmulationAddCharacteristicParams) ProtocolMethod() string {
+	return "BluetoothEmulation.addCharacteristic"
+}
+
+// BluetoothEmulationAddCharacteristicResult https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addCharacteristic
+type BluetoothEmulationAddCharacteristicResult struct {
+	CharacteristicID string `json:"characteristicId"`
+}
+
+// Do sends BluetoothEmulation.addCharacteristic and returns its result
+func (p *BluetoothEmulationAddCharacteristicParams) Do(e Executor) (*BluetoothEmulationAddCharacteristicResult, error) {
+	var result = new(BluetoothEmulationAddCharacteristicResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BluetoothEmulationRemoveCharacteristicParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-removeCharacteristic
+type BluetoothEmulationRemoveCharacteristicParams struct {
+	CharacteristicID string `json:"characteristicId"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.removeCharacteristic
+func (*BluetoothEmulationRemoveCharacteristicParams) ProtocolMethod() string {
+	return "BluetoothEmulation.removeCharacteristic"
+}
+
+// Do sends BluetoothEmulation.removeCharacteristic
+func (p *BluetoothEmulationRemoveCharacteristicParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationAddDescriptorParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addDescriptor
+type BluetoothEmulationAddDescriptorParams struct {
+	CharacteristicID string `json:"characteristicId"`
+	DescriptorUuid   string `json:"descriptorUuid"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.addDescriptor
+func (*BluetoothEmulationAddDescriptorParams) ProtocolMethod() string {
+	return "BluetoothEmulation.addDescriptor"
+}
+
+// BluetoothEmulationAddDescriptorResult https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-addDescriptor
+type BluetoothEmulationAddDescriptorResult struct {
+	DescriptorID string `json:"descriptorId"`
+}
+
+// Do sends BluetoothEmulation.addDescriptor and returns its result
+func (p *BluetoothEmulationAddDescriptorParams) Do(e Executor) (*BluetoothEmulationAddDescriptorResult, error) {
+	var result = new(BluetoothEmulationAddDescriptorResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BluetoothEmulationRemoveDescriptorParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-removeDescriptor
+type BluetoothEmulationRemoveDescriptorParams struct {
+	DescriptorID string `json:"descriptorId"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.removeDescriptor
+func (*BluetoothEmulationRemoveDescriptorParams) ProtocolMethod() string {
+	return "BluetoothEmulation.removeDescriptor"
+}
+
+// Do sends BluetoothEmulation.removeDescriptor
+func (p *BluetoothEmulationRemoveDescriptorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationSimulateGATTDisconnectionParams https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#method-simulateGATTDisconnection
+type BluetoothEmulationSimulateGATTDisconnectionParams struct {
+	Address string `json:"address"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.simulateGATTDisconnection
+func (*BluetoothEmulationSimulateGATTDisconnectionParams) ProtocolMethod() string {
+	return "BluetoothEmulation.simulateGATTDisconnection"
+}
+
+// Do sends BluetoothEmulation.simulateGATTDisconnection
+func (p *BluetoothEmulationSimulateGATTDisconnectionParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BluetoothEmulationGattOperationReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#event-gattOperationReceived
+type BluetoothEmulationGattOperationReceivedEvent struct {
+	Address string                              `json:"address"`
+	Type    BluetoothEmulationGATTOperationType `json:"type"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.gattOperationReceived
+func (*BluetoothEmulationGattOperationReceivedEvent) ProtocolMethod() string {
+	return "BluetoothEmulation.gattOperationReceived"
+}
+
+// BluetoothEmulationCharacteristicOperationReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#event-characteristicOperationReceived
+type BluetoothEmulationCharacteristicOperationReceivedEvent struct {
+	CharacteristicID string                                        `json:"characteristicId"`
+	Type             BluetoothEmulationCharacteristicOperationType `json:"type"`
+	Data             string                                        `json:"data,omitempty"`
+	WriteType        BluetoothEmulationCharacteristicWriteType     `json:"writeType,omitempty"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.characteristicOperationReceived
+func (*BluetoothEmulationCharacteristicOperationReceivedEvent) ProtocolMethod() string {
+	return "BluetoothEmulation.characteristicOperationReceived"
+}
+
+// BluetoothEmulationDescriptorOperationReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation#event-descriptorOperationReceived
+type BluetoothEmulationDescriptorOperationReceivedEvent struct {
+	DescriptorID string                                    `json:"descriptorId"`
+	Type         BluetoothEmulationDescriptorOperationType `json:"type"`
+	Data         string                                    `json:"data,omitempty"`
+}
+
+// ProtocolMethod returns BluetoothEmulation.descriptorOperationReceived
+func (*BluetoothEmulationDescriptorOperationReceivedEvent) ProtocolMethod() string {
+	return "BluetoothEmulation.descriptorOperationReceived"
+}
@@ -0,0 +1,553 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Browser events
+const (
+	EventBrowserDownloadWillBegin = "Browser.downloadWillBegin"
+	EventBrowserDownloadProgress  = "Browser.downloadProgress"
+)
+
+// BrowserBrowserContextID https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-BrowserContextID
+type BrowserBrowserContextID string
+
+// BrowserWindowID https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-WindowID
+type BrowserWindowID int64
+
+// BrowserWindowState https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-WindowState
+type BrowserWindowState string
+
+// BrowserWindowState values
+const (
+	BrowserWindowStateNormal     BrowserWindowState = "normal"
+	BrowserWindowStateMinimized  BrowserWindowState = "minimized"
+	BrowserWindowStateMaximized  BrowserWindowState = "maximized"
+	BrowserWindowStateFullscreen BrowserWindowState = "fullscreen"
+)
+
+// BrowserBounds https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Bounds
+type BrowserBounds struct {
+	Left        int64              `json:"left,omitempty"`
+	Top         int64              `json:"top,omitempty"`
+	Width       int64              `json:"width,omitempty"`
+	Height      int64              `json:"height,omitempty"`
+	WindowState BrowserWindowState `json:"windowState,omitempty"`
+}
+
+// BrowserPermissionType https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionType
+type BrowserPermissionType string
+
+// BrowserPermissionType values
+const (
+	BrowserPermissionTypeAr                       BrowserPermissionType = "ar"
+	BrowserPermissionTypeAudioCapture             BrowserPermissionType = "audioCapture"
+	BrowserPermissionTypeAutomaticFullscreen      BrowserPermissionType = "automaticFullscreen"
+	BrowserPermissionTypeBackgroundFetch          BrowserPermissionType = "backgroundFetch"
+	BrowserPermissionTypeBackgroundSync           BrowserPermissionType = "backgroundSync"
+	BrowserPermissionTypeCameraPanTiltZoom        BrowserPermissionType = "cameraPanTiltZoom"
+	BrowserPermissionTypeCapturedSurfaceControl   BrowserPermissionType = "capturedSurfaceControl"
+	BrowserPermissionTypeClipboardReadWrite       BrowserPermissionType = "clipboardReadWrite"
+	BrowserPermissionTypeClipboardSanitizedWrite  BrowserPermissionType = "clipboardSanitizedWrite"
+	BrowserPermissionTypeDisplayCapture           BrowserPermissionType = "displayCapture"
+	BrowserPermissionTypeDurableStorage           BrowserPermissionType = "durableStorage"
+	BrowserPermissionTypeGeolocation              BrowserPermissionType = "geolocation"
+	BrowserPermissionTypeHandTracking             BrowserPermissionType = "handTracking"
+	BrowserPermissionTypeIdleDetection            BrowserPermissionType = "idleDetection"
+	BrowserPermissionTypeKeyboardLock             BrowserPermissionType = "keyboardLock"
+	BrowserPermissionTypeLocalFonts               BrowserPermissionType = "localFonts"
+	BrowserPermissionTypeLocalNetworkAccess       BrowserPermissionType = "localNetworkAccess"
+	BrowserPermissionTypeMidi                     BrowserPermissionType = "midi"
+	BrowserPermissionTypeMidiSysex                BrowserPermissionType = "midiSysex"
+	BrowserPermissionTypeNfc                      BrowserPermissionType = "nfc"
+	BrowserPermissionTypeNotifications            BrowserPermissionType = "notifications"
+	BrowserPermissionTypePaymentHandler           BrowserPermissionType = "paymentHandler"
+	BrowserPermissionTypePeriodicBackgroundSync   BrowserPermissionType = "periodicBackgroundSync"
+	BrowserPermissionTypePointerLock              BrowserPermissionType = "pointerLock"
+	BrowserPermissionTypeProtectedMediaIdentifier BrowserPermissionType = "protectedMediaIdentifier"
+	BrowserPermissionTypeSensors                  BrowserPermissionType = "sensors"
+	BrowserPermissionTypeSmartCard                BrowserPermissionType = "smartCard"
+	BrowserPermissionTypeSpeakerSelection         BrowserPermissionType = "speakerSelection"
+	BrowserPermissionTypeStorageAccess            BrowserPermissionType = "storageAccess"
+	BrowserPermissionTypeTopLevelStorageAccess    BrowserPermissionType = "topLevelStorageAccess"
+	BrowserPermissionTypeVideoCapture             BrowserPermissionType = "videoCapture"
+	BrowserPermissionTypeVr                       BrowserPermissionType = "vr"
+	BrowserPermissionTypeWakeLockScreen           BrowserPermissionType = "wakeLockScreen"
+	BrowserPermissionTypeWakeLockSystem           BrowserPermissionType = "wakeLockSystem"
+	BrowserPermissionTypeWebAppInstallation       BrowserPermissionType = "webAppInstallation"
+	BrowserPermissionTypeWebPrinting              BrowserPermissionType = "webPrinting"
+	BrowserPermissionTypeWindowManagement         BrowserPermissionType = "windowManagement"
+)
+
+// BrowserPermissionSetting https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionSetting
+type BrowserPermissionSetting string
+
+// BrowserPermissionSetting values
+const (
+	BrowserPermissionSettingGranted BrowserPermissionSetting = "granted"
+	BrowserPermissionSettingDenied  BrowserPermissionSetting = "denied"
+	BrowserPermissionSettingPrompt  BrowserPermissionSetting = "prompt"
+)
+
+// BrowserPermissionDescriptor https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PermissionDescriptor
+type BrowserPermissionDescriptor struct {
+	Name                     string `json:"name"`
+	Sysex                    bool   `json:"sysex,omitempty"`
+	UserVisibleOnly          bool   `json:"userVisibleOnly,omitempty"`
+	AllowWithoutSanitization bool   `json:"allowWithoutSanitization,omitempty"`
+	AllowWithoutGesture      bool   `json:"allowWithoutGesture,omitempty"`
+	PanTiltZoom              bool   `json:"panTiltZoom,omitempty"`
+}
+
+// BrowserBrowserCommandID https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-BrowserCommandId
+type BrowserBrowserCommandID string
+
+// BrowserBrowserCommandID values
+const (
+	BrowserBrowserCommandIDOpenTabSearch  BrowserBrowserCommandID = "openTabSearch"
+	BrowserBrowserCommandIDCloseTabSearch BrowserBrowserCommandID = "closeTabSearch"
+	BrowserBrowserCommandIDOpenGlic       BrowserBrowserCommandID = "openGlic"
+)
+
+// BrowserBucket https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Bucket
+type BrowserBucket struct {
+	Low   int64 `json:"low"`
+	High  int64 `json:"high"`
+	Count int64 `json:"count"`
+}
+
+// BrowserHistogram https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-Histogram
+type BrowserHistogram struct {
+	Name    string           `json:"name"`
+	Sum     int64            `json:"sum"`
+	Count   int64            `json:"count"`
+	Buckets []*BrowserBucket `json:"buckets"`
+}
+
+// BrowserPrivacySandboxAPI https://chromedevtools.github.io/devtools-protocol/tot/Browser#type-PrivacySandboxAPI
+type BrowserPrivacySandboxAPI string
+
+// BrowserPrivacySandboxAPI values
+const (
+	BrowserPrivacySandboxAPIBiddingAndAuctionServices BrowserPrivacySandboxAPI = "BiddingAndAuctionServices"
+	BrowserPrivacySandboxAPITrustedKeyValue           BrowserPrivacySandboxAPI = "TrustedKeyValue"
+)
+
+// BrowserSetPermissionParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setPermission
+type BrowserSetPermissionParams struct {
+	Permission       *BrowserPermissionDescriptor `json:"permission"`
+	Setting          BrowserPermissionSetting     `json:"setting"`
+	Origin           string                       `json:"origin,omitempty"`
+	BrowserContextID BrowserBrowserContextID      `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Browser.setPermission
+func (*BrowserSetPermissionParams) ProtocolMethod() string {
+	return "Browser.setPermission"
+}
+
+// Do sends Browser.setPermission
+func (p *BrowserSetPermissionParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserGrantPermissionsParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-grantPermissions
+type BrowserGrantPermissionsParams struct {
+	Permissions      []BrowserPermissionType `json:"permissions"`
+	Origin           string                  `json:"origin,omitempty"`
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Browser.grantPermissions
+func (*BrowserGrantPermissionsParams) ProtocolMethod() string {
+	return "Browser.grantPermissions"
+}
+
+// Do sends Browser.grantPermissions
+func (p *BrowserGrantPermissionsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserResetPermissionsParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-resetPermissions
+type BrowserResetPermissionsParams struct {
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Browser.resetPermissions
+func (*BrowserResetPermissionsParams) ProtocolMethod() string {
+	return "Browser.resetPermissions"
+}
+
+// Do sends Browser.resetPermissions
+func (p *BrowserResetPermissionsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserSetDownloadBehaviorParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setDownloadBehavior
+type BrowserSetDownloadBehaviorParams struct {
+	Behavior         BrowserSetDownloadBehaviorParamsBehavior `json:"behavior"`
+	BrowserContextID BrowserBrowserContextID                  `json:"browserContextId,omitempty"`
+	DownloadPath     string                                   `json:"downloadPath,omitempty"`
+	EventsEnabled    bool                                     `json:"eventsEnabled,omitempty"`
+}
+
+// ProtocolMethod returns Browser.setDownloadBehavior
+func (*BrowserSetDownloadBehaviorParams) ProtocolMethod() string {
+	return "Browser.setDownloadBehavior"
+}
+
+// Do sends Browser.setDownloadBehavior
+func (p *BrowserSetDownloadBehaviorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserCancelDownloadParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-cancelDownload
+type BrowserCancelDownloadParams struct {
+	Guid             string                  `json:"guid"`
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Browser.cancelDownload
+func (*BrowserCancelDownloadParams) ProtocolMethod() string {
+	return "Browser.cancelDownload"
+}
+
+// Do sends Browser.cancelDownload
+func (p *BrowserCancelDownloadParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserCloseParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-close
+type BrowserCloseParams struct {
+}
+
+// ProtocolMethod returns Browser.close
+func (*BrowserCloseParams) ProtocolMethod() string {
+	return "Browser.close"
+}
+
+// Do sends Browser.close
+func (p *BrowserCloseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserCrashParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-crash
+type BrowserCrashParams struct {
+}
+
+// ProtocolMethod returns Browser.crash
+func (*BrowserCrashParams) ProtocolMethod() string {
+	return "Browser.crash"
+}
+
+// Do sends Browser.crash
+func (p *BrowserCrashParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserCrashGPUProcessParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-crashGpuProcess
+type BrowserCrashGPUProcessParams struct {
+}
+
+// ProtocolMethod returns Browser.crashGpuProcess
+func (*BrowserCrashGPUProcessParams) ProtocolMethod() string {
+	return "Browser.crashGpuProcess"
+}
+
+// Do sends Browser.crashGpuProcess
+func (p *BrowserCrashGPUProcessParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserGetVersionParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getVersion
+type BrowserGetVersionParams struct {
+}
+
+// ProtocolMethod returns Browser.getVersion
+func (*BrowserGetVersionParams) ProtocolMethod() string {
+	return "Browser.getVersion"
+}
+
+// BrowserGetVersionResult https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getVersion
+type BrowserGetVersionResult struct {
+	ProtocolVersion string `json:"protocolVersion"`
+	Product         string `json:"product"`
+	Revision        string `json:"revision"`
+	UserAgent       string `json:"userAgent"`
+	JSVersion       string `json:"jsVersion"`
+}
+
+// Do sends Browser.getVersion and returns its result
+func (p *BrowserGetVersionParams) Do(e Executor) (*BrowserGetVersionResult, error) {
+	var result = new(BrowserGetVersionResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BrowserGetBrowserCommandLineParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getBrowserCommandLine
+type BrowserGetBrowserCommandLineParams struct {
+}
+
+// ProtocolMethod returns Browser.getBrowserCommandLine
+func (*BrowserGetBrowserCommandLineParams) ProtocolMethod() string {
+	return "Browser.getBrowserCommandLine"
+}
+
+// BrowserGetBrowserCommandLineResult https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getBrowserCommandLine
+type BrowserGetBrowserCommandLineResult struct {
+	Arguments []string `json:"arguments"`
+}
+
+// Do sends Browser.getBrowserCommandLine and returns its result
+func (p *BrowserGetBrowserCommandLineParams) Do(e Executor) (*BrowserGetBrowserCommandLineResult, error) {
+	var result = new(BrowserGetBrowserCommandLineResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BrowserGetHistogramsParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getHistograms
+type BrowserGetHistogramsParams struct {
+	Query string `json:"query,omitempty"`
+	Delta bool   `json:"delta,omitempty"`
+}
+
+// ProtocolMethod returns Browser.getHistograms
+func (*BrowserGetHistogramsParams) ProtocolMethod() string {
+	return "Browser.getHistograms"
+}
+
+// BrowserGetHistogramsResult https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getHistograms
+type BrowserGetHistogramsResult struct {
+	Histograms []*BrowserHistogram `json:"histograms"`
+}
+
+// Do sends Browser.getHistograms and returns its result
+func (p *BrowserGetHistogramsParams) Do(e Executor) (*BrowserGetHistogramsResult, error) {
+	var result = new(BrowserGetHistogramsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BrowserGetHistogramParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getHistogram
+type BrowserGetHistogramParams struct {
+	Name  string `json:"name"`
+	Delta bool   `json:"delta,omitempty"`
+}
+
+// ProtocolMethod returns Browser.getHistogram
+func (*BrowserGetHistogramParams) ProtocolMethod() string {
+	return "Browser.getHistogram"
+}
+
+// BrowserGetHistogramResult https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getHistogram
+type BrowserGetHistogramResult struct {
+	Histogram *BrowserHistogram `json:"histogram"`
+}
+
+// Do sends Browser.getHistogram and returns its result
+func (p *BrowserGetHistogramParams) Do(e Executor) (*BrowserGetHistogramResult, error) {
+	var result = new(BrowserGetHistogramResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BrowserGetWindowBoundsParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getWindowBounds
+type BrowserGetWindowBoundsParams struct {
+	WindowID BrowserWindowID `json:"windowId"`
+}
+
+// ProtocolMethod returns Browser.getWindowBounds
+func (*BrowserGetWindowBoundsParams) ProtocolMethod() string {
+	return "Browser.getWindowBounds"
+}
+
+// BrowserGetWindowBoundsResult https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getWindowBounds
+type BrowserGetWindowBoundsResult struct {
+	Bounds *BrowserBounds `json:"bounds"`
+}
+
+// Do sends Browser.getWindowBounds and returns its result
+func (p *BrowserGetWindowBoundsParams) Do(e Executor) (*BrowserGetWindowBoundsResult, error) {
+	var result = new(BrowserGetWindowBoundsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BrowserGetWindowForTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getWindowForTarget
+type BrowserGetWindowForTargetParams struct {
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// ProtocolMethod returns Browser.getWindowForTarget
+func (*BrowserGetWindowForTargetParams) ProtocolMethod() string {
+	return "Browser.getWindowForTarget"
+}
+
+// BrowserGetWindowForTargetResult https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-getWindowForTarget
+type BrowserGetWindowForTargetResult struct {
+	WindowID BrowserWindowID `json:"windowId"`
+	Bounds   *BrowserBounds  `json:"bounds"`
+}
+
+// Do sends Browser.getWindowForTarget and returns its result
+func (p *BrowserGetWindowForTargetParams) Do(e Executor) (*BrowserGetWindowForTargetResult, error) {
+	var result = new(BrowserGetWindowForTargetResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BrowserSetWindowBoundsParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setWindowBounds
+type BrowserSetWindowBoundsParams struct {
+	WindowID BrowserWindowID `json:"windowId"`
+	Bounds   *BrowserBounds  `json:"bounds"`
+}
+
+// ProtocolMethod returns Browser.setWindowBounds
+func (*BrowserSetWindowBoundsParams) ProtocolMethod() string {
+	return "Browser.setWindowBounds"
+}
+
+// Do sends Browser.setWindowBounds
+func (p *BrowserSetWindowBoundsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserSetContentsSizeParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setContentsSize
+type BrowserSetContentsSizeParams struct {
+	WindowID BrowserWindowID `json:"windowId"`
+	Width    int64           `json:"width,omitempty"`
+	Height   int64           `json:"height,omitempty"`
+}
+
+// ProtocolMethod returns Browser.setContentsSize
+func (*BrowserSetContentsSizeParams) ProtocolMethod() string {
+	return "Browser.setContentsSize"
+}
+
+// Do sends Browser.setContentsSize
+func (p *BrowserSetContentsSizeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserSetDockTileParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-setDockTile
+type BrowserSetDockTileParams struct {
+	BadgeLabel string `json:"badgeLabel,omitempty"`
+	Image      string `json:"image,omitempty"`
+}
+
+// ProtocolMethod returns Browser.setDockTile
+func (*BrowserSetDockTileParams) ProtocolMethod() string {
+	return "Browser.setDockTile"
+}
+
+// Do sends Browser.setDockTile
+func (p *BrowserSetDockTileParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserExecuteBrowserCommandParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-executeBrowserCommand
+type BrowserExecuteBrowserCommandParams struct {
+	CommandID BrowserBrowserCommandID `json:"commandId"`
+}
+
+// ProtocolMethod returns Browser.executeBrowserCommand
+func (*BrowserExecuteBrowserCommandParams) ProtocolMethod() string {
+	return "Browser.executeBrowserCommand"
+}
+
+// Do sends Browser.executeBrowserCommand
+func (p *BrowserExecuteBrowserCommandParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserAddPrivacySandboxEnrollmentOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-addPrivacySandboxEnrollmentOverride
+type BrowserAddPrivacySandboxEnrollmentOverrideParams struct {
+	URL string `json:"url"`
+}
+
+// ProtocolMethod returns Browser.addPrivacySandboxEnrollmentOverride
+func (*BrowserAddPrivacySandboxEnrollmentOverrideParams) ProtocolMethod() string {
+	return "Browser.addPrivacySandboxEnrollmentOverride"
+}
+
+// Do sends Browser.addPrivacySandboxEnrollmentOverride
+func (p *BrowserAddPrivacySandboxEnrollmentOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserAddPrivacySandboxCoordinatorKeyConfigParams https://chromedevtools.github.io/devtools-protocol/tot/Browser#method-addPrivacySandboxCoordinatorKeyConfig
+type BrowserAddPrivacySandboxCoordinatorKeyConfigParams struct {
+	API               BrowserPrivacySandboxAPI `json:"api"`
+	CoordinatorOrigin string                   `json:"coordinatorOrigin"`
+	KeyConfig         string                   `json:"keyConfig"`
+	BrowserContextID  BrowserBrowserContextID  `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Browser.addPrivacySandboxCoordinatorKeyConfig
+func (*BrowserAddPrivacySandboxCoordinatorKeyConfigParams) ProtocolMethod() string {
+	return "Browser.addPrivacySandboxCoordinatorKeyConfig"
+}
+
+// Do sends Browser.addPrivacySandboxCoordinatorKeyConfig
+func (p *BrowserAddPrivacySandboxCoordinatorKeyConfigParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// BrowserDownloadWillBeginEvent https://chromedevtools.github.io/devtools-protocol/tot/Browser#event-downloadWillBegin
+type BrowserDownloadWillBeginEvent struct {
+	FrameID           PageFrameID `json:"frameId"`
+	Guid              string      `json:"guid"`
+	URL               string      `json:"url"`
+	SuggestedFilename string      `json:"suggestedFilename"`
+}
+
+// ProtocolMethod returns Browser.downloadWillBegin
+func (*BrowserDownloadWillBeginEvent) ProtocolMethod() string {
+	return "Browser.downloadWillBegin"
+}
+
+// BrowserDownloadProgressEvent https://chromedevtools.github.io/devtools-protocol/tot/Browser#event-downloadProgress
+type BrowserDownloadProgressEvent struct {
+	Guid          string                            `json:"guid"`
+	TotalBytes    float64                           `json:"totalBytes"`
+	ReceivedBytes float64                           `json:"receivedBytes"`
+	State         BrowserDownloadProgressEventState `json:"state"`
+	FilePath      string                            `json:"filePath,omitempty"`
+}
+
+// ProtocolMethod returns Browser.downloadProgress
+func (*BrowserDownloadProgressEvent) ProtocolMethod() string {
+	return "Browser.downloadProgress"
+}
+
+// BrowserSetDownloadBehaviorParamsBehavior enum
+type BrowserSetDownloadBehaviorParamsBehavior string
+
+// BrowserSetDownloadBehaviorParamsBehavior values
+const (
+	BrowserSetDownloadBehaviorParamsBehaviorDeny         BrowserSetDownloadBehaviorParamsBehavior = "deny"
+	BrowserSetDownloadBehaviorParamsBehaviorAllow        BrowserSetDownloadBehaviorParamsBehavior = "allow"
+	BrowserSetDownloadBehaviorParamsBehaviorAllowAndName BrowserSetDownloadBehaviorParamsBehavior = "allowAndName"
+	BrowserSetDownloadBehaviorParamsBehaviorDefault      BrowserSetDownloadBehaviorParamsBehavior = "default"
+)
+
+// BrowserDownloadProgressEventState enum
+type BrowserDownloadProgressEventState string
+
+// BrowserDownloadProgressEventState values
+const (
+	BrowserDownloadProgressEventStateInProgress BrowserDownloadProgressEventState = "inProgress"
+	BrowserDownloadProgressEventStateCompleted  BrowserDownloadProgressEventState = "completed"
+	BrowserDownloadProgressEventStateCanceled   BrowserDownloadProgressEventState = "canceled"
+)
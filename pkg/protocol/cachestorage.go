@@ -0,0 +1,162 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// CacheStorageCacheID https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CacheId
+type CacheStorageCacheID string
+
+// CacheStorageCachedResponseType https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CachedResponseType
+type CacheStorageCachedResponseType string
+
+// CacheStorageCachedResponseType values
+const (
+	CacheStorageCachedResponseTypeBasic          CacheStorageCachedResponseType = "basic"
+	CacheStorageCachedResponseTypeCors           CacheStorageCachedResponseType = "cors"
+	CacheStorageCachedResponseTypeDefault        CacheStorageCachedResponseType = "default"
+	CacheStorageCachedResponseTypeError          CacheStorageCachedResponseType = "error"
+	CacheStorageCachedResponseTypeOpaqueResponse CacheStorageCachedResponseType = "opaqueResponse"
+	CacheStorageCachedResponseTypeOpaqueRedirect CacheStorageCachedResponseType = "opaqueRedirect"
+)
+
+// CacheStorageDataEntry https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-DataEntry
+type CacheStorageDataEntry struct {
+	RequestURL         string                         `json:"requestURL"`
+	RequestMethod      string                         `json:"requestMethod"`
+	RequestHeaders     []*CacheStorageHeader          `json:"requestHeaders"`
+	ResponseTime       float64                        `json:"responseTime"`
+	ResponseStatus     int64                          `json:"responseStatus"`
+	ResponseStatusText string                         `json:"responseStatusText"`
+	ResponseType       CacheStorageCachedResponseType `json:"responseType"`
+	ResponseHeaders    []*CacheStorageHeader          `json:"responseHeaders"`
+}
+
+// CacheStorageCache https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-Cache
+type CacheStorageCache struct {
+	CacheID        CacheStorageCacheID   `json:"cacheId"`
+	SecurityOrigin string                `json:"securityOrigin"`
+	StorageKey     string                `json:"storageKey"`
+	StorageBucket  *StorageStorageBucket `json:"storageBucket,omitempty"`
+	CacheName      string                `json:"cacheName"`
+}
+
+// CacheStorageHeader https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-Header
+type CacheStorageHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CacheStorageCachedResponse https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#type-CachedResponse
+type CacheStorageCachedResponse struct {
+	Body string `json:"body"`
+}
+
+// CacheStorageDeleteCacheParams https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-deleteCache
+type CacheStorageDeleteCacheParams struct {
+	CacheID CacheStorageCacheID `json:"cacheId"`
+}
+
+// ProtocolMethod returns CacheStorage.deleteCache
+func (*CacheStorageDeleteCacheParams) ProtocolMethod() string {
+	return "CacheStorage.deleteCache"
+}
+
+// Do sends CacheStorage.deleteCache
+func (p *CacheStorageDeleteCacheParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CacheStorageDeleteEntryParams https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-deleteEntry
+type CacheStorageDeleteEntryParams struct {
+	CacheID CacheStorageCacheID `json:"cacheId"`
+	Request string              `json:"request"`
+}
+
+// ProtocolMethod returns CacheStorage.deleteEntry
+func (*CacheStorageDeleteEntryParams) ProtocolMethod() string {
+	return "CacheStorage.deleteEntry"
+}
+
+// Do sends CacheStorage.deleteEntry
+func (p *CacheStorageDeleteEntryParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CacheStorageRequestCacheNamesParams https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestCacheNames
+type CacheStorageRequestCacheNamesParams struct {
+	SecurityOrigin string                `json:"securityOrigin,omitempty"`
+	StorageKey     string                `json:"storageKey,omitempty"`
+	StorageBucket  *StorageStorageBucket `json:"storageBucket,omitempty"`
+}
+
+// ProtocolMethod returns CacheStorage.requestCacheNames
+func (*CacheStorageRequestCacheNamesParams) ProtocolMethod() string {
+	return "CacheStorage.requestCacheNames"
+}
+
+// CacheStorageRequestCacheNamesResult https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestCacheNames
+type CacheStorageRequestCacheNamesResult struct {
+	Caches []*CacheStorageCache `json:"caches"`
+}
+
+// Do sends CacheStorage.requestCacheNames and returns its result
+func (p *CacheStorageRequestCacheNamesParams) Do(e Executor) (*CacheStorageRequestCacheNamesResult, error) {
+	var result = new(CacheStorageRequestCacheNamesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CacheStorageRequestCachedResponseParams https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestCachedResponse
+type CacheStorageRequestCachedResponseParams struct {
+	CacheID        CacheStorageCacheID   `json:"cacheId"`
+	RequestURL     string                `json:"requestURL"`
+	RequestHeaders []*CacheStorageHeader `json:"requestHeaders"`
+}
+
+// ProtocolMethod returns CacheStorage.requestCachedResponse
+func (*CacheStorageRequestCachedResponseParams) ProtocolMethod() string {
+	return "CacheStorage.requestCachedResponse"
+}
+
+// CacheStorageRequestCachedResponseResult https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestCachedResponse
+type CacheStorageRequestCachedResponseResult struct {
+	Response *CacheStorageCachedResponse `json:"response"`
+}
+
+// Do sends CacheStorage.requestCachedResponse and returns its result
+func (p *CacheStorageRequestCachedResponseParams) Do(e Executor) (*CacheStorageRequestCachedResponseResult, error) {
+	var result = new(CacheStorageRequestCachedResponseResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CacheStorageRequestEntriesParams https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestEntries
+type CacheStorageRequestEntriesParams struct {
+	CacheID    CacheStorageCacheID `json:"cacheId"`
+	SkipCount  int64               `json:"skipCount,omitempty"`
+	PageSize   int64               `json:"pageSize,omitempty"`
+	PathFilter string              `json:"pathFilter,omitempty"`
+}
+
+// ProtocolMethod returns CacheStorage.requestEntries
+func (*CacheStorageRequestEntriesParams) ProtocolMethod() string {
+	return "CacheStorage.requestEntries"
+}
+
+// CacheStorageRequestEntriesResult https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage#method-requestEntries
+type CacheStorageRequestEntriesResult struct {
+	CacheDataEntries []*CacheStorageDataEntry `json:"cacheDataEntries"`
+	ReturnCount      float64                  `json:"returnCount"`
+}
+
+// Do sends CacheStorage.requestEntries and returns its result
+func (p *CacheStorageRequestEntriesParams) Do(e Executor) (*CacheStorageRequestEntriesResult, error) {
+	var result = new(CacheStorageRequestEntriesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,125 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Cast events
+const (
+	EventCastSinksUpdated = "Cast.sinksUpdated"
+	EventCastIssueUpdated = "Cast.issueUpdated"
+)
+
+// CastSink https://chromedevtools.github.io/devtools-protocol/tot/Cast#type-Sink
+type CastSink struct {
+	Name    string `json:"name"`
+	ID      string `json:"id"`
+	Session string `json:"session,omitempty"`
+}
+
+// CastEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-enable
+type CastEnableParams struct {
+	PresentationURL string `json:"presentationUrl,omitempty"`
+}
+
+// ProtocolMethod returns Cast.enable
+func (*CastEnableParams) ProtocolMethod() string {
+	return "Cast.enable"
+}
+
+// Do sends Cast.enable
+func (p *CastEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CastDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-disable
+type CastDisableParams struct {
+}
+
+// ProtocolMethod returns Cast.disable
+func (*CastDisableParams) ProtocolMethod() string {
+	return "Cast.disable"
+}
+
+// Do sends Cast.disable
+func (p *CastDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CastSetSinkToUseParams https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-setSinkToUse
+type CastSetSinkToUseParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// ProtocolMethod returns Cast.setSinkToUse
+func (*CastSetSinkToUseParams) ProtocolMethod() string {
+	return "Cast.setSinkToUse"
+}
+
+// Do sends Cast.setSinkToUse
+func (p *CastSetSinkToUseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CastStartDesktopMirroringParams https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-startDesktopMirroring
+type CastStartDesktopMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// ProtocolMethod returns Cast.startDesktopMirroring
+func (*CastStartDesktopMirroringParams) ProtocolMethod() string {
+	return "Cast.startDesktopMirroring"
+}
+
+// Do sends Cast.startDesktopMirroring
+func (p *CastStartDesktopMirroringParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CastStartTabMirroringParams https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-startTabMirroring
+type CastStartTabMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// ProtocolMethod returns Cast.startTabMirroring
+func (*CastStartTabMirroringParams) ProtocolMethod() string {
+	return "Cast.startTabMirroring"
+}
+
+// Do sends Cast.startTabMirroring
+func (p *CastStartTabMirroringParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CastStopCastingParams https://chromedevtools.github.io/devtools-protocol/tot/Cast#method-stopCasting
+type CastStopCastingParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// ProtocolMethod returns Cast.stopCasting
+func (*CastStopCastingParams) ProtocolMethod() string {
+	return "Cast.stopCasting"
+}
+
+// Do sends Cast.stopCasting
+func (p *CastStopCastingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CastSinksUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Cast#event-sinksUpdated
+type CastSinksUpdatedEvent struct {
+	Sinks []*CastSink `json:"sinks"`
+}
+
+// ProtocolMethod returns Cast.sinksUpdated
+func (*CastSinksUpdatedEvent) ProtocolMethod() string {
+	return "Cast.sinksUpdated"
+}
+
+// CastIssueUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Cast#event-issueUpdated
+type CastIssueUpdatedEvent struct {
+	IssueMessage string `json:"issueMessage"`
+}
+
+// ProtocolMethod returns Cast.issueUpdated
+func (*CastIssueUpdatedEvent) ProtocolMethod() string {
+	return "Cast.issueUpdated"
+}
@@ -0,0 +1,100 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Console events
+const (
+	EventConsoleMessageAdded = "Console.messageAdded"
+)
+
+// ConsoleConsoleMessage https://chromedevtools.github.io/devtools-protocol/tot/Console#type-ConsoleMessage
+type ConsoleConsoleMessage struct {
+	Source ConsoleConsoleMessageSource `json:"source"`
+	Level  ConsoleConsoleMessageLevel  `json:"level"`
+	Text   string                      `json:"text"`
+	URL    string                      `json:"url,omitempty"`
+	Line   int64                       `json:"line,omitempty"`
+	Column int64                       `json:"column,omitempty"`
+}
+
+// ConsoleClearMessagesParams https://chromedevtools.github.io/devtools-protocol/tot/Console#method-clearMessages
+type ConsoleClearMessagesParams struct {
+}
+
+// ProtocolMethod returns Console.clearMessages
+func (*ConsoleClearMessagesParams) ProtocolMethod() string {
+	return "Console.clearMessages"
+}
+
+// Do sends Console.clearMessages
+func (p *ConsoleClearMessagesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ConsoleDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Console#method-disable
+type ConsoleDisableParams struct {
+}
+
+// ProtocolMethod returns Console.disable
+func (*ConsoleDisableParams) ProtocolMethod() string {
+	return "Console.disable"
+}
+
+// Do sends Console.disable
+func (p *ConsoleDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ConsoleEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Console#method-enable
+type ConsoleEnableParams struct {
+}
+
+// ProtocolMethod returns Console.enable
+func (*ConsoleEnableParams) ProtocolMethod() string {
+	return "Console.enable"
+}
+
+// Do sends Console.enable
+func (p *ConsoleEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ConsoleMessageAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/Console#event-messageAdded
+type ConsoleMessageAddedEvent struct {
+	Message *ConsoleConsoleMessage `json:"message"`
+}
+
+// ProtocolMethod returns Console.messageAdded
+func (*ConsoleMessageAddedEvent) ProtocolMethod() string {
+	return "Console.messageAdded"
+}
+
+// ConsoleConsoleMessageSource enum
+type ConsoleConsoleMessageSource string
+
+// ConsoleConsoleMessageSource values
+const (
+	ConsoleConsoleMessageSourceXML         ConsoleConsoleMessageSource = "xml"
+	ConsoleConsoleMessageSourceJavascript  ConsoleConsoleMessageSource = "javascript"
+	ConsoleConsoleMessageSourceNetwork     ConsoleConsoleMessageSource = "network"
+	ConsoleConsoleMessageSourceConsoleAPI  ConsoleConsoleMessageSource = "console-api"
+	ConsoleConsoleMessageSourceStorage     ConsoleConsoleMessageSource = "storage"
+	ConsoleConsoleMessageSourceAppcache    ConsoleConsoleMessageSource = "appcache"
+	ConsoleConsoleMessageSourceRendering   ConsoleConsoleMessageSource = "rendering"
+	ConsoleConsoleMessageSourceSecurity    ConsoleConsoleMessageSource = "security"
+	ConsoleConsoleMessageSourceOther       ConsoleConsoleMessageSource = "other"
+	ConsoleConsoleMessageSourceDeprecation ConsoleConsoleMessageSource = "deprecation"
+	ConsoleConsoleMessageSourceWorker      ConsoleConsoleMessageSource = "worker"
+)
+
+// ConsoleConsoleMessageLevel enum
+type ConsoleConsoleMessageLevel string
+
+// ConsoleConsoleMessageLevel values
+const (
+	ConsoleConsoleMessageLevelLog     ConsoleConsoleMessageLevel = "log"
+	ConsoleConsoleMessageLevelWarning ConsoleConsoleMessageLevel = "warning"
+	ConsoleConsoleMessageLevelError   ConsoleConsoleMessageLevel = "error"
+	ConsoleConsoleMessageLevelDebug   ConsoleConsoleMessageLevel = "debug"
+	ConsoleConsoleMessageLevelInfo    ConsoleConsoleMessageLevel = "info"
+)
@@ -0,0 +1,1298 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// CSS events
+const (
+	EventCSSFontsUpdated            = "CSS.fontsUpdated"
+	EventCSSMediaQueryResultChanged = "CSS.mediaQueryResultChanged"
+	EventCSSStyleSheetAdded         = "CSS.styleSheetAdded"
+	EventCSSStyleSheetChanged       = "CSS.styleSheetChanged"
+	EventCSSStyleSheetRemoved       = "CSS.styleSheetRemoved"
+	EventCSSComputedStyleUpdated    = "CSS.computedStyleUpdated"
+)
+
+// CSSStyleSheetID https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleSheetId
+type CSSStyleSheetID string
+
+// CSSStyleSheetOrigin https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleSheetOrigin
+type CSSStyleSheetOrigin string
+
+// CSSStyleSheetOrigin values
+const (
+	CSSStyleSheetOriginInjected  CSSStyleSheetOrigin = "injected"
+	CSSStyleSheetOriginUserAgent CSSStyleSheetOrigin = "user-agent"
+	CSSStyleSheetOriginInspector CSSStyleSheetOrigin = "inspector"
+	CSSStyleSheetOriginRegular   CSSStyleSheetOrigin = "regular"
+)
+
+// CSSPseudoElementMatches https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-PseudoElementMatches
+type CSSPseudoElementMatches struct {
+	PseudoType       DOMPseudoType   `json:"pseudoType"`
+	PseudoIdentifier string          `json:"pseudoIdentifier,omitempty"`
+	Matches          []*CSSRuleMatch `json:"matches"`
+}
+
+// CSSCSSAnimationStyle https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSAnimationStyle
+type CSSCSSAnimationStyle struct {
+	Name  string       `json:"name,omitempty"`
+	Style *CSSCSSStyle `json:"style"`
+}
+
+// CSSInheritedStyleEntry https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedStyleEntry
+type CSSInheritedStyleEntry struct {
+	InlineStyle     *CSSCSSStyle    `json:"inlineStyle,omitempty"`
+	MatchedCSSRules []*CSSRuleMatch `json:"matchedCSSRules"`
+}
+
+// CSSInheritedAnimatedStyleEntry https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedAnimatedStyleEntry
+type CSSInheritedAnimatedStyleEntry struct {
+	AnimationStyles  []*CSSCSSAnimationStyle `json:"animationStyles,omitempty"`
+	TransitionsStyle *CSSCSSStyle            `json:"transitionsStyle,omitempty"`
+}
+
+// CSSInheritedPseudoElementMatches https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-InheritedPseudoElementMatches
+type CSSInheritedPseudoElementMatches struct {
+	PseudoElements []*CSSPseudoElementMatches `json:"pseudoElements"`
+}
+
+// CSSRuleMatch https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-RuleMatch
+type CSSRuleMatch struct {
+	Rule              *CSSCSSRule `json:"rule"`
+	MatchingSelectors []int64     `json:"matchingSelectors"`
+}
+
+// CSSValue https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-Value
+type CSSValue struct {
+	Text        string          `json:"text"`
+	Range       *CSSSourceRange `json:"range,omitempty"`
+	Specificity *CSSSpecificity `json:"specificity,omitempty"`
+}
+
+// CSSSpecificity https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-Specificity
+type CSSSpecificity struct {
+	A int64 `json:"a"`
+	B int64 `json:"b"`
+	C int64 `json:"c"`
+}
+
+// CSSSelectorList https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-SelectorList
+type CSSSelectorList struct {
+	Selectors []*CSSValue `json:"selectors"`
+	Text      string      `json:"text"`
+}
+
+// CSSCSSStyleSheetHeader https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStyleSheetHeader
+type CSSCSSStyleSheetHeader struct {
+	StyleSheetID  CSSStyleSheetID     `json:"styleSheetId"`
+	FrameID       PageFrameID         `json:"frameId"`
+	SourceURL     string              `json:"sourceURL"`
+	SourceMapURL  string              `json:"sourceMapURL,omitempty"`
+	Origin        CSSStyleSheetOrigin `json:"origin"`
+	Title         string              `json:"title"`
+	OwnerNode     DOMBackendNodeID    `json:"ownerNode,omitempty"`
+	Disabled      bool                `json:"disabled"`
+	HasSourceURL  bool                `json:"hasSourceURL,omitempty"`
+	IsInline      bool                `json:"isInline"`
+	IsMutable     bool                `json:"isMutable"`
+	IsConstructed bool                `json:"isConstructed"`
+	StartLine     float64             `json:"startLine"`
+	StartColumn   float64             `json:"startColumn"`
+	Length        float64             `json:"length"`
+	EndLine       float64             `json:"endLine"`
+	EndColumn     float64             `json:"endColumn"`
+	LoadingFailed bool                `json:"loadingFailed,omitempty"`
+}
+
+// CSSCSSRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSRule
+type CSSCSSRule struct {
+	StyleSheetID     CSSStyleSheetID         `json:"styleSheetId,omitempty"`
+	SelectorList     *CSSSelectorList        `json:"selectorList"`
+	NestingSelectors []string                `json:"nestingSelectors,omitempty"`
+	Origin           CSSStyleSheetOrigin     `json:"origin"`
+	Style            *CSSCSSStyle            `json:"style"`
+	Media            []*CSSCSSMedia          `json:"media,omitempty"`
+	ContainerQueries []*CSSCSSContainerQuery `json:"containerQueries,omitempty"`
+	Supports         []*CSSCSSSupports       `json:"supports,omitempty"`
+	Layers           []*CSSCSSLayer          `json:"layers,omitempty"`
+	Scopes           []*CSSCSSScope          `json:"scopes,omitempty"`
+	RuleTypes        []CSSCSSRuleType        `json:"ruleTypes,omitempty"`
+	StartingStyles   []*CSSCSSStartingStyle  `json:"startingStyles,omitempty"`
+}
+
+// CSSCSSRuleType https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSRuleType
+type CSSCSSRuleType string
+
+// CSSCSSRuleType values
+const (
+	CSSCSSRuleTypeMediaRule         CSSCSSRuleType = "MediaRule"
+	CSSCSSRuleTypeSupportsRule      CSSCSSRuleType = "SupportsRule"
+	CSSCSSRuleTypeContainerRule     CSSCSSRuleType = "ContainerRule"
+	CSSCSSRuleTypeLayerRule         CSSCSSRuleType = "LayerRule"
+	CSSCSSRuleTypeScopeRule         CSSCSSRuleType = "ScopeRule"
+	CSSCSSRuleTypeStyleRule         CSSCSSRuleType = "StyleRule"
+	CSSCSSRuleTypeStartingStyleRule CSSCSSRuleType = "StartingStyleRule"
+)
+
+// CSSRuleUsage https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-RuleUsage
+type CSSRuleUsage struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	StartOffset  float64         `json:"startOffset"`
+	EndOffset    float64         `json:"endOffset"`
+	Used         bool            `json:"used"`
+}
+
+// CSSSourceRange https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-SourceRange
+type CSSSourceRange struct {
+	StartLine   int64 `json:"startLine"`
+	StartColumn int64 `json:"startColumn"`
+	EndLine     int64 `json:"endLine"`
+	EndColumn   int64 `json:"endColumn"`
+}
+
+// CSSShorthandEntry https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-ShorthandEntry
+type CSSShorthandEntry struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	Important bool   `json:"important,omitempty"`
+}
+
+// CSSCSSComputedStyleProperty https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSComputedStyleProperty
+type CSSCSSComputedStyleProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CSSCSSStyle https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStyle
+type CSSCSSStyle struct {
+	StyleSheetID     CSSStyleSheetID      `json:"styleSheetId,omitempty"`
+	CSSProperties    []*CSSCSSProperty    `json:"cssProperties"`
+	ShorthandEntries []*CSSShorthandEntry `json:"shorthandEntries"`
+	CSSText          string               `json:"cssText,omitempty"`
+	Range            *CSSSourceRange      `json:"range,omitempty"`
+}
+
+// CSSCSSProperty https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSProperty
+type CSSCSSProperty struct {
+	Name               string            `json:"name"`
+	Value              string            `json:"value"`
+	Important          bool              `json:"important,omitempty"`
+	Implicit           bool              `json:"implicit,omitempty"`
+	Text               string            `json:"text,omitempty"`
+	ParsedOk           bool              `json:"parsedOk,omitempty"`
+	Disabled           bool              `json:"disabled,omitempty"`
+	Range              *CSSSourceRange   `json:"range,omitempty"`
+	LonghandProperties []*CSSCSSProperty `json:"longhandProperties,omitempty"`
+}
+
+// CSSCSSMedia https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSMedia
+type CSSCSSMedia struct {
+	Text         string            `json:"text"`
+	Source       CSSCSSMediaSource `json:"source"`
+	SourceURL    string            `json:"sourceURL,omitempty"`
+	Range        *CSSSourceRange   `json:"range,omitempty"`
+	StyleSheetID CSSStyleSheetID   `json:"styleSheetId,omitempty"`
+	MediaList    []*CSSMediaQuery  `json:"mediaList,omitempty"`
+}
+
+// CSSMediaQuery https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-MediaQuery
+type CSSMediaQuery struct {
+	Expressions []*CSSMediaQueryExpression `json:"expressions"`
+	Active      bool                       `json:"active"`
+}
+
+// CSSMediaQueryExpression https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-MediaQueryExpression
+type CSSMediaQueryExpression struct {
+	Value          float64         `json:"value"`
+	Unit           string          `json:"unit"`
+	Feature        string          `json:"feature"`
+	ValueRange     *CSSSourceRange `json:"valueRange,omitempty"`
+	ComputedLength float64         `json:"computedLength,omitempty"`
+}
+
+// CSSCSSContainerQuery https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSContainerQuery
+type CSSCSSContainerQuery struct {
+	Text               string          `json:"text"`
+	Range              *CSSSourceRange `json:"range,omitempty"`
+	StyleSheetID       CSSStyleSheetID `json:"styleSheetId,omitempty"`
+	Name               string          `json:"name,omitempty"`
+	PhysicalAxes       DOMPhysicalAxes `json:"physicalAxes,omitempty"`
+	LogicalAxes        DOMLogicalAxes  `json:"logicalAxes,omitempty"`
+	QueriesScrollState bool            `json:"queriesScrollState,omitempty"`
+	QueriesAnchored    bool            `json:"queriesAnchored,omitempty"`
+}
+
+// CSSCSSSupports https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSSupports
+type CSSCSSSupports struct {
+	Text         string          `json:"text"`
+	Active       bool            `json:"active"`
+	Range        *CSSSourceRange `json:"range,omitempty"`
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSScope https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSScope
+type CSSCSSScope struct {
+	Text         string          `json:"text"`
+	Range        *CSSSourceRange `json:"range,omitempty"`
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSLayer https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSLayer
+type CSSCSSLayer struct {
+	Text         string          `json:"text"`
+	Range        *CSSSourceRange `json:"range,omitempty"`
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSStartingStyle https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSStartingStyle
+type CSSCSSStartingStyle struct {
+	Range        *CSSSourceRange `json:"range,omitempty"`
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+// CSSCSSLayerData https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSLayerData
+type CSSCSSLayerData struct {
+	Name      string             `json:"name"`
+	SubLayers []*CSSCSSLayerData `json:"subLayers,omitempty"`
+	Order     float64            `json:"order"`
+}
+
+// CSSPlatformFontUsage https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-PlatformFontUsage
+type CSSPlatformFontUsage struct {
+	FamilyName     string  `json:"familyName"`
+	PostScriptName string  `json:"postScriptName"`
+	IsCustomFont   bool    `json:"isCustomFont"`
+	GlyphCount     float64 `json:"glyphCount"`
+}
+
+// CSSFontVariationAxis https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-FontVariationAxis
+type CSSFontVariationAxis struct {
+	Tag          string  `json:"tag"`
+	Name         string  `json:"name"`
+	MinValue     float64 `json:"minValue"`
+	MaxValue     float64 `json:"maxValue"`
+	DefaultValue float64 `json:"defaultValue"`
+}
+
+// CSSFontFace https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-FontFace
+type CSSFontFace struct {
+	FontFamily         string                  `json:"fontFamily"`
+	FontStyle          string                  `json:"fontStyle"`
+	FontVariant        string                  `json:"fontVariant"`
+	FontWeight         string                  `json:"fontWeight"`
+	FontStretch        string                  `json:"fontStretch"`
+	FontDisplay        string                  `json:"fontDisplay"`
+	UnicodeRange       string                  `json:"unicodeRange"`
+	Src                string                  `json:"src"`
+	PlatformFontFamily string                  `json:"platformFontFamily"`
+	FontVariationAxes  []*CSSFontVariationAxis `json:"fontVariationAxes,omitempty"`
+}
+
+// CSSCSSTryRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSTryRule
+type CSSCSSTryRule struct {
+	StyleSheetID CSSStyleSheetID     `json:"styleSheetId,omitempty"`
+	Origin       CSSStyleSheetOrigin `json:"origin"`
+	Style        *CSSCSSStyle        `json:"style"`
+}
+
+// CSSCSSPositionTryRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPositionTryRule
+type CSSCSSPositionTryRule struct {
+	Name         *CSSValue           `json:"name"`
+	StyleSheetID CSSStyleSheetID     `json:"styleSheetId,omitempty"`
+	Origin       CSSStyleSheetOrigin `json:"origin"`
+	Style        *CSSCSSStyle        `json:"style"`
+	Active       bool                `json:"active"`
+}
+
+// CSSCSSKeyframesRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSKeyframesRule
+type CSSCSSKeyframesRule struct {
+	AnimationName *CSSValue             `json:"animationName"`
+	Keyframes     []*CSSCSSKeyframeRule `json:"keyframes"`
+}
+
+// CSSCSSPropertyRegistration https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPropertyRegistration
+type CSSCSSPropertyRegistration struct {
+	PropertyName string    `json:"propertyName"`
+	InitialValue *CSSValue `json:"initialValue,omitempty"`
+	Inherits     bool      `json:"inherits"`
+	Syntax       string    `json:"syntax"`
+}
+
+// CSSCSSFontPaletteValuesRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFontPaletteValuesRule
+type CSSCSSFontPaletteValuesRule struct {
+	StyleSheetID    CSSStyleSheetID     `json:"styleSheetId,omitempty"`
+	Origin          CSSStyleSheetOrigin `json:"origin"`
+	FontPaletteName *CSSValue           `json:"fontPaletteName"`
+	Style           *CSSCSSStyle        `json:"style"`
+}
+
+// CSSCSSPropertyRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSPropertyRule
+type CSSCSSPropertyRule struct {
+	StyleSheetID CSSStyleSheetID     `json:"styleSheetId,omitempty"`
+	Origin       CSSStyleSheetOrigin `json:"origin"`
+	PropertyName *CSSValue           `json:"propertyName"`
+	Style        *CSSCSSStyle        `json:"style"`
+}
+
+// CSSCSSFunctionParameter https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionParameter
+type CSSCSSFunctionParameter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// CSSCSSFunctionConditionNode https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionConditionNode
+type CSSCSSFunctionConditionNode struct {
+	Media            *CSSCSSMedia          `json:"media,omitempty"`
+	ContainerQueries *CSSCSSContainerQuery `json:"containerQueries,omitempty"`
+	Supports         *CSSCSSSupports       `json:"supports,omitempty"`
+	Children         []*CSSCSSFunctionNode `json:"children"`
+	ConditionText    string                `json:"conditionText"`
+}
+
+// CSSCSSFunctionNode https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionNode
+type CSSCSSFunctionNode struct {
+	Condition *CSSCSSFunctionConditionNode `json:"condition,omitempty"`
+	Style     *CSSCSSStyle                 `json:"style,omitempty"`
+}
+
+// CSSCSSFunctionRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSFunctionRule
+type CSSCSSFunctionRule struct {
+	Name         *CSSValue                  `json:"name"`
+	StyleSheetID CSSStyleSheetID            `json:"styleSheetId,omitempty"`
+	Origin       CSSStyleSheetOrigin        `json:"origin"`
+	Parameters   []*CSSCSSFunctionParameter `json:"parameters"`
+	Children     []*CSSCSSFunctionNode      `json:"children"`
+}
+
+// CSSCSSKeyframeRule https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-CSSKeyframeRule
+type CSSCSSKeyframeRule struct {
+	StyleSheetID CSSStyleSheetID     `json:"styleSheetId,omitempty"`
+	Origin       CSSStyleSheetOrigin `json:"origin"`
+	KeyText      *CSSValue           `json:"keyText"`
+	Style        *CSSCSSStyle        `json:"style"`
+}
+
+// CSSStyleDeclarationEdit https://chromedevtools.github.io/devtools-protocol/tot/CSS#type-StyleDeclarationEdit
+type CSSStyleDeclarationEdit struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	Text         string          `json:"text"`
+}
+
+// CSSAddRuleParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-addRule
+type CSSAddRuleParams struct {
+	StyleSheetID                    CSSStyleSheetID `json:"styleSheetId"`
+	RuleText                        string          `json:"ruleText"`
+	Location                        *CSSSourceRange `json:"location"`
+	NodeForPropertySyntaxValidation DOMNodeID       `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// ProtocolMethod returns CSS.addRule
+func (*CSSAddRuleParams) ProtocolMethod() string {
+	return "CSS.addRule"
+}
+
+// CSSAddRuleResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-addRule
+type CSSAddRuleResult struct {
+	Rule *CSSCSSRule `json:"rule"`
+}
+
+// Do sends CSS.addRule and returns its result
+func (p *CSSAddRuleParams) Do(e Executor) (*CSSAddRuleResult, error) {
+	var result = new(CSSAddRuleResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSCollectClassNamesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-collectClassNames
+type CSSCollectClassNamesParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+}
+
+// ProtocolMethod returns CSS.collectClassNames
+func (*CSSCollectClassNamesParams) ProtocolMethod() string {
+	return "CSS.collectClassNames"
+}
+
+// CSSCollectClassNamesResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-collectClassNames
+type CSSCollectClassNamesResult struct {
+	ClassNames []string `json:"classNames"`
+}
+
+// Do sends CSS.collectClassNames and returns its result
+func (p *CSSCollectClassNamesParams) Do(e Executor) (*CSSCollectClassNamesResult, error) {
+	var result = new(CSSCollectClassNamesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSCreateStyleSheetParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-createStyleSheet
+type CSSCreateStyleSheetParams struct {
+	FrameID PageFrameID `json:"frameId"`
+	Force   bool        `json:"force,omitempty"`
+}
+
+// ProtocolMethod returns CSS.createStyleSheet
+func (*CSSCreateStyleSheetParams) ProtocolMethod() string {
+	return "CSS.createStyleSheet"
+}
+
+// CSSCreateStyleSheetResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-createStyleSheet
+type CSSCreateStyleSheetResult struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+}
+
+// Do sends CSS.createStyleSheet and returns its result
+func (p *CSSCreateStyleSheetParams) Do(e Executor) (*CSSCreateStyleSheetResult, error) {
+	var result = new(CSSCreateStyleSheetResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSDisableParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-disable
+type CSSDisableParams struct {
+}
+
+// ProtocolMethod returns CSS.disable
+func (*CSSDisableParams) ProtocolMethod() string {
+	return "CSS.disable"
+}
+
+// Do sends CSS.disable
+func (p *CSSDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSEnableParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-enable
+type CSSEnableParams struct {
+}
+
+// ProtocolMethod returns CSS.enable
+func (*CSSEnableParams) ProtocolMethod() string {
+	return "CSS.enable"
+}
+
+// Do sends CSS.enable
+func (p *CSSEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSForcePseudoStateParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-forcePseudoState
+type CSSForcePseudoStateParams struct {
+	NodeID              DOMNodeID `json:"nodeId"`
+	ForcedPseudoClasses []string  `json:"forcedPseudoClasses"`
+}
+
+// ProtocolMethod returns CSS.forcePseudoState
+func (*CSSForcePseudoStateParams) ProtocolMethod() string {
+	return "CSS.forcePseudoState"
+}
+
+// Do sends CSS.forcePseudoState
+func (p *CSSForcePseudoStateParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSForceStartingStyleParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-forceStartingStyle
+type CSSForceStartingStyleParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Forced bool      `json:"forced"`
+}
+
+// ProtocolMethod returns CSS.forceStartingStyle
+func (*CSSForceStartingStyleParams) ProtocolMethod() string {
+	return "CSS.forceStartingStyle"
+}
+
+// Do sends CSS.forceStartingStyle
+func (p *CSSForceStartingStyleParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSGetBackgroundColorsParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getBackgroundColors
+type CSSGetBackgroundColorsParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getBackgroundColors
+func (*CSSGetBackgroundColorsParams) ProtocolMethod() string {
+	return "CSS.getBackgroundColors"
+}
+
+// CSSGetBackgroundColorsResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getBackgroundColors
+type CSSGetBackgroundColorsResult struct {
+	BackgroundColors   []string `json:"backgroundColors,omitempty"`
+	ComputedFontSize   string   `json:"computedFontSize,omitempty"`
+	ComputedFontWeight string   `json:"computedFontWeight,omitempty"`
+}
+
+// Do sends CSS.getBackgroundColors and returns its result
+func (p *CSSGetBackgroundColorsParams) Do(e Executor) (*CSSGetBackgroundColorsResult, error) {
+	var result = new(CSSGetBackgroundColorsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetComputedStyleForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getComputedStyleForNode
+type CSSGetComputedStyleForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getComputedStyleForNode
+func (*CSSGetComputedStyleForNodeParams) ProtocolMethod() string {
+	return "CSS.getComputedStyleForNode"
+}
+
+// CSSGetComputedStyleForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getComputedStyleForNode
+type CSSGetComputedStyleForNodeResult struct {
+	ComputedStyle []*CSSCSSComputedStyleProperty `json:"computedStyle"`
+}
+
+// Do sends CSS.getComputedStyleForNode and returns its result
+func (p *CSSGetComputedStyleForNodeParams) Do(e Executor) (*CSSGetComputedStyleForNodeResult, error) {
+	var result = new(CSSGetComputedStyleForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSResolveValuesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-resolveValues
+type CSSResolveValuesParams struct {
+	Values           []string      `json:"values"`
+	NodeID           DOMNodeID     `json:"nodeId"`
+	PropertyName     string        `json:"propertyName,omitempty"`
+	PseudoType       DOMPseudoType `json:"pseudoType,omitempty"`
+	PseudoIdentifier string        `json:"pseudoIdentifier,omitempty"`
+}
+
+// ProtocolMethod returns CSS.resolveValues
+func (*CSSResolveValuesParams) ProtocolMethod() string {
+	return "CSS.resolveValues"
+}
+
+// CSSResolveValuesResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-resolveValues
+type CSSResolveValuesResult struct {
+	Results []string `json:"results"`
+}
+
+// Do sends CSS.resolveValues and returns its result
+func (p *CSSResolveValuesParams) Do(e Executor) (*CSSResolveValuesResult, error) {
+	var result = new(CSSResolveValuesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetLonghandPropertiesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLonghandProperties
+type CSSGetLonghandPropertiesParams struct {
+	ShorthandName string `json:"shorthandName"`
+	Value         string `json:"value"`
+}
+
+// ProtocolMethod returns CSS.getLonghandProperties
+func (*CSSGetLonghandPropertiesParams) ProtocolMethod() string {
+	return "CSS.getLonghandProperties"
+}
+
+// CSSGetLonghandPropertiesResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLonghandProperties
+type CSSGetLonghandPropertiesResult struct {
+	LonghandProperties []*CSSCSSProperty `json:"longhandProperties"`
+}
+
+// Do sends CSS.getLonghandProperties and returns its result
+func (p *CSSGetLonghandPropertiesParams) Do(e Executor) (*CSSGetLonghandPropertiesResult, error) {
+	var result = new(CSSGetLonghandPropertiesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetInlineStylesForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getInlineStylesForNode
+type CSSGetInlineStylesForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getInlineStylesForNode
+func (*CSSGetInlineStylesForNodeParams) ProtocolMethod() string {
+	return "CSS.getInlineStylesForNode"
+}
+
+// CSSGetInlineStylesForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getInlineStylesForNode
+type CSSGetInlineStylesForNodeResult struct {
+	InlineStyle     *CSSCSSStyle `json:"inlineStyle,omitempty"`
+	AttributesStyle *CSSCSSStyle `json:"attributesStyle,omitempty"`
+}
+
+// Do sends CSS.getInlineStylesForNode and returns its result
+func (p *CSSGetInlineStylesForNodeParams) Do(e Executor) (*CSSGetInlineStylesForNodeResult, error) {
+	var result = new(CSSGetInlineStylesForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetAnimatedStylesForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getAnimatedStylesForNode
+type CSSGetAnimatedStylesForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getAnimatedStylesForNode
+func (*CSSGetAnimatedStylesForNodeParams) ProtocolMethod() string {
+	return "CSS.getAnimatedStylesForNode"
+}
+
+// CSSGetAnimatedStylesForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getAnimatedStylesForNode
+type CSSGetAnimatedStylesForNodeResult struct {
+	AnimationStyles  []*CSSCSSAnimationStyle           `json:"animationStyles,omitempty"`
+	TransitionsStyle *CSSCSSStyle                      `json:"transitionsStyle,omitempty"`
+	Inherited        []*CSSInheritedAnimatedStyleEntry `json:"inherited,omitempty"`
+}
+
+// Do sends CSS.getAnimatedStylesForNode and returns its result
+func (p *CSSGetAnimatedStylesForNodeParams) Do(e Executor) (*CSSGetAnimatedStylesForNodeResult, error) {
+	var result = new(CSSGetAnimatedStylesForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetMatchedStylesForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getMatchedStylesForNode
+type CSSGetMatchedStylesForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getMatchedStylesForNode
+func (*CSSGetMatchedStylesForNodeParams) ProtocolMethod() string {
+	return "CSS.getMatchedStylesForNode"
+}
+
+// CSSGetMatchedStylesForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getMatchedStylesForNode
+type CSSGetMatchedStylesForNodeResult struct {
+	InlineStyle                 *CSSCSSStyle                        `json:"inlineStyle,omitempty"`
+	AttributesStyle             *CSSCSSStyle                        `json:"attributesStyle,omitempty"`
+	MatchedCSSRules             []*CSSRuleMatch                     `json:"matchedCSSRules,omitempty"`
+	PseudoElements              []*CSSPseudoElementMatches          `json:"pseudoElements,omitempty"`
+	Inherited                   []*CSSInheritedStyleEntry           `json:"inherited,omitempty"`
+	InheritedPseudoElements     []*CSSInheritedPseudoElementMatches `json:"inheritedPseudoElements,omitempty"`
+	CSSKeyframesRules           []*CSSCSSKeyframesRule              `json:"cssKeyframesRules,omitempty"`
+	CSSPositionTryRules         []*CSSCSSPositionTryRule            `json:"cssPositionTryRules,omitempty"`
+	ActivePositionFallbackIndex int64                               `json:"activePositionFallbackIndex,omitempty"`
+	CSSPropertyRules            []*CSSCSSPropertyRule               `json:"cssPropertyRules,omitempty"`
+	CSSPropertyRegistrations    []*CSSCSSPropertyRegistration       `json:"cssPropertyRegistrations,omitempty"`
+	CSSFontPaletteValuesRule    *CSSCSSFontPaletteValuesRule        `json:"cssFontPaletteValuesRule,omitempty"`
+	ParentLayoutNodeID          DOMNodeID                           `json:"parentLayoutNodeId,omitempty"`
+	CSSFunctionRules            []*CSSCSSFunctionRule               `json:"cssFunctionRules,omitempty"`
+}
+
+// Do sends CSS.getMatchedStylesForNode and returns its result
+func (p *CSSGetMatchedStylesForNodeParams) Do(e Executor) (*CSSGetMatchedStylesForNodeResult, error) {
+	var result = new(CSSGetMatchedStylesForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetEnvironmentVariablesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getEnvironmentVariables
+type CSSGetEnvironmentVariablesParams struct {
+}
+
+// ProtocolMethod returns CSS.getEnvironmentVariables
+func (*CSSGetEnvironmentVariablesParams) ProtocolMethod() string {
+	return "CSS.getEnvironmentVariables"
+}
+
+// CSSGetEnvironmentVariablesResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getEnvironmentVariables
+type CSSGetEnvironmentVariablesResult struct {
+	EnvironmentVariables map[string]interface{} `json:"environmentVariables"`
+}
+
+// Do sends CSS.getEnvironmentVariables and returns its result
+func (p *CSSGetEnvironmentVariablesParams) Do(e Executor) (*CSSGetEnvironmentVariablesResult, error) {
+	var result = new(CSSGetEnvironmentVariablesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetMediaQueriesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getMediaQueries
+type CSSGetMediaQueriesParams struct {
+}
+
+// ProtocolMethod returns CSS.getMediaQueries
+func (*CSSGetMediaQueriesParams) ProtocolMethod() string {
+	return "CSS.getMediaQueries"
+}
+
+// CSSGetMediaQueriesResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getMediaQueries
+type CSSGetMediaQueriesResult struct {
+	Medias []*CSSCSSMedia `json:"medias"`
+}
+
+// Do sends CSS.getMediaQueries and returns its result
+func (p *CSSGetMediaQueriesParams) Do(e Executor) (*CSSGetMediaQueriesResult, error) {
+	var result = new(CSSGetMediaQueriesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetPlatformFontsForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getPlatformFontsForNode
+type CSSGetPlatformFontsForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getPlatformFontsForNode
+func (*CSSGetPlatformFontsForNodeParams) ProtocolMethod() string {
+	return "CSS.getPlatformFontsForNode"
+}
+
+// CSSGetPlatformFontsForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getPlatformFontsForNode
+type CSSGetPlatformFontsForNodeResult struct {
+	Fonts []*CSSPlatformFontUsage `json:"fonts"`
+}
+
+// Do sends CSS.getPlatformFontsForNode and returns its result
+func (p *CSSGetPlatformFontsForNodeParams) Do(e Executor) (*CSSGetPlatformFontsForNodeResult, error) {
+	var result = new(CSSGetPlatformFontsForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetStyleSheetTextParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getStyleSheetText
+type CSSGetStyleSheetTextParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+}
+
+// ProtocolMethod returns CSS.getStyleSheetText
+func (*CSSGetStyleSheetTextParams) ProtocolMethod() string {
+	return "CSS.getStyleSheetText"
+}
+
+// CSSGetStyleSheetTextResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getStyleSheetText
+type CSSGetStyleSheetTextResult struct {
+	Text string `json:"text"`
+}
+
+// Do sends CSS.getStyleSheetText and returns its result
+func (p *CSSGetStyleSheetTextParams) Do(e Executor) (*CSSGetStyleSheetTextResult, error) {
+	var result = new(CSSGetStyleSheetTextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetLayersForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLayersForNode
+type CSSGetLayersForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.getLayersForNode
+func (*CSSGetLayersForNodeParams) ProtocolMethod() string {
+	return "CSS.getLayersForNode"
+}
+
+// CSSGetLayersForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLayersForNode
+type CSSGetLayersForNodeResult struct {
+	RootLayer *CSSCSSLayerData `json:"rootLayer"`
+}
+
+// Do sends CSS.getLayersForNode and returns its result
+func (p *CSSGetLayersForNodeParams) Do(e Executor) (*CSSGetLayersForNodeResult, error) {
+	var result = new(CSSGetLayersForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSGetLocationForSelectorParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLocationForSelector
+type CSSGetLocationForSelectorParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	SelectorText string          `json:"selectorText"`
+}
+
+// ProtocolMethod returns CSS.getLocationForSelector
+func (*CSSGetLocationForSelectorParams) ProtocolMethod() string {
+	return "CSS.getLocationForSelector"
+}
+
+// CSSGetLocationForSelectorResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-getLocationForSelector
+type CSSGetLocationForSelectorResult struct {
+	Ranges []*CSSSourceRange `json:"ranges"`
+}
+
+// Do sends CSS.getLocationForSelector and returns its result
+func (p *CSSGetLocationForSelectorParams) Do(e Executor) (*CSSGetLocationForSelectorResult, error) {
+	var result = new(CSSGetLocationForSelectorResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSTrackComputedStyleUpdatesForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-trackComputedStyleUpdatesForNode
+type CSSTrackComputedStyleUpdatesForNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId,omitempty"`
+}
+
+// ProtocolMethod returns CSS.trackComputedStyleUpdatesForNode
+func (*CSSTrackComputedStyleUpdatesForNodeParams) ProtocolMethod() string {
+	return "CSS.trackComputedStyleUpdatesForNode"
+}
+
+// Do sends CSS.trackComputedStyleUpdatesForNode
+func (p *CSSTrackComputedStyleUpdatesForNodeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSTrackComputedStyleUpdatesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-trackComputedStyleUpdates
+type CSSTrackComputedStyleUpdatesParams struct {
+	PropertiesToTrack []*CSSCSSComputedStyleProperty `json:"propertiesToTrack"`
+}
+
+// ProtocolMethod returns CSS.trackComputedStyleUpdates
+func (*CSSTrackComputedStyleUpdatesParams) ProtocolMethod() string {
+	return "CSS.trackComputedStyleUpdates"
+}
+
+// Do sends CSS.trackComputedStyleUpdates
+func (p *CSSTrackComputedStyleUpdatesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSTakeComputedStyleUpdatesParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-takeComputedStyleUpdates
+type CSSTakeComputedStyleUpdatesParams struct {
+}
+
+// ProtocolMethod returns CSS.takeComputedStyleUpdates
+func (*CSSTakeComputedStyleUpdatesParams) ProtocolMethod() string {
+	return "CSS.takeComputedStyleUpdates"
+}
+
+// CSSTakeComputedStyleUpdatesResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-takeComputedStyleUpdates
+type CSSTakeComputedStyleUpdatesResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends CSS.takeComputedStyleUpdates and returns its result
+func (p *CSSTakeComputedStyleUpdatesParams) Do(e Executor) (*CSSTakeComputedStyleUpdatesResult, error) {
+	var result = new(CSSTakeComputedStyleUpdatesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetEffectivePropertyValueForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setEffectivePropertyValueForNode
+type CSSSetEffectivePropertyValueForNodeParams struct {
+	NodeID       DOMNodeID `json:"nodeId"`
+	PropertyName string    `json:"propertyName"`
+	Value        string    `json:"value"`
+}
+
+// ProtocolMethod returns CSS.setEffectivePropertyValueForNode
+func (*CSSSetEffectivePropertyValueForNodeParams) ProtocolMethod() string {
+	return "CSS.setEffectivePropertyValueForNode"
+}
+
+// Do sends CSS.setEffectivePropertyValueForNode
+func (p *CSSSetEffectivePropertyValueForNodeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSSetPropertyRulePropertyNameParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setPropertyRulePropertyName
+type CSSSetPropertyRulePropertyNameParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	PropertyName string          `json:"propertyName"`
+}
+
+// ProtocolMethod returns CSS.setPropertyRulePropertyName
+func (*CSSSetPropertyRulePropertyNameParams) ProtocolMethod() string {
+	return "CSS.setPropertyRulePropertyName"
+}
+
+// CSSSetPropertyRulePropertyNameResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setPropertyRulePropertyName
+type CSSSetPropertyRulePropertyNameResult struct {
+	PropertyName *CSSValue `json:"propertyName"`
+}
+
+// Do sends CSS.setPropertyRulePropertyName and returns its result
+func (p *CSSSetPropertyRulePropertyNameParams) Do(e Executor) (*CSSSetPropertyRulePropertyNameResult, error) {
+	var result = new(CSSSetPropertyRulePropertyNameResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetKeyframeKeyParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setKeyframeKey
+type CSSSetKeyframeKeyParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	KeyText      string          `json:"keyText"`
+}
+
+// ProtocolMethod returns CSS.setKeyframeKey
+func (*CSSSetKeyframeKeyParams) ProtocolMethod() string {
+	return "CSS.setKeyframeKey"
+}
+
+// CSSSetKeyframeKeyResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setKeyframeKey
+type CSSSetKeyframeKeyResult struct {
+	KeyText *CSSValue `json:"keyText"`
+}
+
+// Do sends CSS.setKeyframeKey and returns its result
+func (p *CSSSetKeyframeKeyParams) Do(e Executor) (*CSSSetKeyframeKeyResult, error) {
+	var result = new(CSSSetKeyframeKeyResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetMediaTextParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setMediaText
+type CSSSetMediaTextParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	Text         string          `json:"text"`
+}
+
+// ProtocolMethod returns CSS.setMediaText
+func (*CSSSetMediaTextParams) ProtocolMethod() string {
+	return "CSS.setMediaText"
+}
+
+// CSSSetMediaTextResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setMediaText
+type CSSSetMediaTextResult struct {
+	Media *CSSCSSMedia `json:"media"`
+}
+
+// Do sends CSS.setMediaText and returns its result
+func (p *CSSSetMediaTextParams) Do(e Executor) (*CSSSetMediaTextResult, error) {
+	var result = new(CSSSetMediaTextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetContainerQueryTextParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setContainerQueryText
+type CSSSetContainerQueryTextParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	Text         string          `json:"text"`
+}
+
+// ProtocolMethod returns CSS.setContainerQueryText
+func (*CSSSetContainerQueryTextParams) ProtocolMethod() string {
+	return "CSS.setContainerQueryText"
+}
+
+// CSSSetContainerQueryTextResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setContainerQueryText
+type CSSSetContainerQueryTextResult struct {
+	ContainerQuery *CSSCSSContainerQuery `json:"containerQuery"`
+}
+
+// Do sends CSS.setContainerQueryText and returns its result
+func (p *CSSSetContainerQueryTextParams) Do(e Executor) (*CSSSetContainerQueryTextResult, error) {
+	var result = new(CSSSetContainerQueryTextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetSupportsTextParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setSupportsText
+type CSSSetSupportsTextParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	Text         string          `json:"text"`
+}
+
+// ProtocolMethod returns CSS.setSupportsText
+func (*CSSSetSupportsTextParams) ProtocolMethod() string {
+	return "CSS.setSupportsText"
+}
+
+// CSSSetSupportsTextResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setSupportsText
+type CSSSetSupportsTextResult struct {
+	Supports *CSSCSSSupports `json:"supports"`
+}
+
+// Do sends CSS.setSupportsText and returns its result
+func (p *CSSSetSupportsTextParams) Do(e Executor) (*CSSSetSupportsTextResult, error) {
+	var result = new(CSSSetSupportsTextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetScopeTextParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setScopeText
+type CSSSetScopeTextParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	Text         string          `json:"text"`
+}
+
+// ProtocolMethod returns CSS.setScopeText
+func (*CSSSetScopeTextParams) ProtocolMethod() string {
+	return "CSS.setScopeText"
+}
+
+// CSSSetScopeTextResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setScopeText
+type CSSSetScopeTextResult struct {
+	Scope *CSSCSSScope `json:"scope"`
+}
+
+// Do sends CSS.setScopeText and returns its result
+func (p *CSSSetScopeTextParams) Do(e Executor) (*CSSSetScopeTextResult, error) {
+	var result = new(CSSSetScopeTextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetRuleSelectorParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setRuleSelector
+type CSSSetRuleSelectorParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Range        *CSSSourceRange `json:"range"`
+	Selector     string          `json:"selector"`
+}
+
+// ProtocolMethod returns CSS.setRuleSelector
+func (*CSSSetRuleSelectorParams) ProtocolMethod() string {
+	return "CSS.setRuleSelector"
+}
+
+// CSSSetRuleSelectorResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setRuleSelector
+type CSSSetRuleSelectorResult struct {
+	SelectorList *CSSSelectorList `json:"selectorList"`
+}
+
+// Do sends CSS.setRuleSelector and returns its result
+func (p *CSSSetRuleSelectorParams) Do(e Executor) (*CSSSetRuleSelectorResult, error) {
+	var result = new(CSSSetRuleSelectorResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetStyleSheetTextParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setStyleSheetText
+type CSSSetStyleSheetTextParams struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+	Text         string          `json:"text"`
+}
+
+// ProtocolMethod returns CSS.setStyleSheetText
+func (*CSSSetStyleSheetTextParams) ProtocolMethod() string {
+	return "CSS.setStyleSheetText"
+}
+
+// CSSSetStyleSheetTextResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setStyleSheetText
+type CSSSetStyleSheetTextResult struct {
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
+}
+
+// Do sends CSS.setStyleSheetText and returns its result
+func (p *CSSSetStyleSheetTextParams) Do(e Executor) (*CSSSetStyleSheetTextResult, error) {
+	var result = new(CSSSetStyleSheetTextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetStyleTextsParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setStyleTexts
+type CSSSetStyleTextsParams struct {
+	Edits                           []*CSSStyleDeclarationEdit `json:"edits"`
+	NodeForPropertySyntaxValidation DOMNodeID                  `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// ProtocolMethod returns CSS.setStyleTexts
+func (*CSSSetStyleTextsParams) ProtocolMethod() string {
+	return "CSS.setStyleTexts"
+}
+
+// CSSSetStyleTextsResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setStyleTexts
+type CSSSetStyleTextsResult struct {
+	Styles []*CSSCSSStyle `json:"styles"`
+}
+
+// Do sends CSS.setStyleTexts and returns its result
+func (p *CSSSetStyleTextsParams) Do(e Executor) (*CSSSetStyleTextsResult, error) {
+	var result = new(CSSSetStyleTextsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSStartRuleUsageTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-startRuleUsageTracking
+type CSSStartRuleUsageTrackingParams struct {
+}
+
+// ProtocolMethod returns CSS.startRuleUsageTracking
+func (*CSSStartRuleUsageTrackingParams) ProtocolMethod() string {
+	return "CSS.startRuleUsageTracking"
+}
+
+// Do sends CSS.startRuleUsageTracking
+func (p *CSSStartRuleUsageTrackingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSStopRuleUsageTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-stopRuleUsageTracking
+type CSSStopRuleUsageTrackingParams struct {
+}
+
+// ProtocolMethod returns CSS.stopRuleUsageTracking
+func (*CSSStopRuleUsageTrackingParams) ProtocolMethod() string {
+	return "CSS.stopRuleUsageTracking"
+}
+
+// CSSStopRuleUsageTrackingResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-stopRuleUsageTracking
+type CSSStopRuleUsageTrackingResult struct {
+	RuleUsage []*CSSRuleUsage `json:"ruleUsage"`
+}
+
+// Do sends CSS.stopRuleUsageTracking and returns its result
+func (p *CSSStopRuleUsageTrackingParams) Do(e Executor) (*CSSStopRuleUsageTrackingResult, error) {
+	var result = new(CSSStopRuleUsageTrackingResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSTakeCoverageDeltaParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-takeCoverageDelta
+type CSSTakeCoverageDeltaParams struct {
+}
+
+// ProtocolMethod returns CSS.takeCoverageDelta
+func (*CSSTakeCoverageDeltaParams) ProtocolMethod() string {
+	return "CSS.takeCoverageDelta"
+}
+
+// CSSTakeCoverageDeltaResult https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-takeCoverageDelta
+type CSSTakeCoverageDeltaResult struct {
+	Coverage  []*CSSRuleUsage `json:"coverage"`
+	Timestamp float64         `json:"timestamp"`
+}
+
+// Do sends CSS.takeCoverageDelta and returns its result
+func (p *CSSTakeCoverageDeltaParams) Do(e Executor) (*CSSTakeCoverageDeltaResult, error) {
+	var result = new(CSSTakeCoverageDeltaResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CSSSetLocalFontsEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/CSS#method-setLocalFontsEnabled
+type CSSSetLocalFontsEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns CSS.setLocalFontsEnabled
+func (*CSSSetLocalFontsEnabledParams) ProtocolMethod() string {
+	return "CSS.setLocalFontsEnabled"
+}
+
+// Do sends CSS.setLocalFontsEnabled
+func (p *CSSSetLocalFontsEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// CSSFontsUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-fontsUpdated
+type CSSFontsUpdatedEvent struct {
+	Font *CSSFontFace `json:"font,omitempty"`
+}
+
+// ProtocolMethod returns CSS.fontsUpdated
+func (*CSSFontsUpdatedEvent) ProtocolMethod() string {
+	return "CSS.fontsUpdated"
+}
+
+// CSSMediaQueryResultChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-mediaQueryResultChanged
+type CSSMediaQueryResultChangedEvent struct {
+}
+
+// ProtocolMethod returns CSS.mediaQueryResultChanged
+func (*CSSMediaQueryResultChangedEvent) ProtocolMethod() string {
+	return "CSS.mediaQueryResultChanged"
+}
+
+// CSSStyleSheetAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-styleSheetAdded
+type CSSStyleSheetAddedEvent struct {
+	Header *CSSCSSStyleSheetHeader `json:"header"`
+}
+
+// ProtocolMethod returns CSS.styleSheetAdded
+func (*CSSStyleSheetAddedEvent) ProtocolMethod() string {
+	return "CSS.styleSheetAdded"
+}
+
+// CSSStyleSheetChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-styleSheetChanged
+type CSSStyleSheetChangedEvent struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+}
+
+// ProtocolMethod returns CSS.styleSheetChanged
+func (*CSSStyleSheetChangedEvent) ProtocolMethod() string {
+	return "CSS.styleSheetChanged"
+}
+
+// CSSStyleSheetRemovedEvent https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-styleSheetRemoved
+type CSSStyleSheetRemovedEvent struct {
+	StyleSheetID CSSStyleSheetID `json:"styleSheetId"`
+}
+
+// ProtocolMethod returns CSS.styleSheetRemoved
+func (*CSSStyleSheetRemovedEvent) ProtocolMethod() string {
+	return "CSS.styleSheetRemoved"
+}
+
+// CSSComputedStyleUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/CSS#event-computedStyleUpdated
+type CSSComputedStyleUpdatedEvent struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns CSS.computedStyleUpdated
+func (*CSSComputedStyleUpdatedEvent) ProtocolMethod() string {
+	return "CSS.computedStyleUpdated"
+}
+
+// CSSCSSMediaSource enum
+type CSSCSSMediaSource string
+
+// CSSCSSMediaSource values
+const (
+	CSSCSSMediaSourceMediaRule   CSSCSSMediaSource = "mediaRule"
+	CSSCSSMediaSourceImportRule  CSSCSSMediaSource = "importRule"
+	CSSCSSMediaSourceLinkedSheet CSSCSSMediaSource = "linkedSheet"
+	CSSCSSMediaSourceInlineSheet CSSCSSMediaSource = "inlineSheet"
+)
@@ -0,0 +1,989 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Debugger events
+const (
+	EventDebuggerBreakpointResolved  = "Debugger.breakpointResolved"
+	EventDebuggerPaused              = "Debugger.paused"
+	EventDebuggerResumed             = "Debugger.resumed"
+	EventDebuggerScriptFailedToParse = "Debugger.scriptFailedToParse"
+	EventDebuggerScriptParsed        = "Debugger.scriptParsed"
+)
+
+// DebuggerBreakpointID https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-BreakpointId
+type DebuggerBreakpointID string
+
+// DebuggerCallFrameID https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-CallFrameId
+type DebuggerCallFrameID string
+
+// DebuggerLocation https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-Location
+type DebuggerLocation struct {
+	ScriptID     RuntimeScriptID `json:"scriptId"`
+	LineNumber   int64           `json:"lineNumber"`
+	ColumnNumber int64           `json:"columnNumber,omitempty"`
+}
+
+// DebuggerScriptPosition https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ScriptPosition
+type DebuggerScriptPosition struct {
+	LineNumber   int64 `json:"lineNumber"`
+	ColumnNumber int64 `json:"columnNumber"`
+}
+
+// DebuggerLocationRange https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-LocationRange
+type DebuggerLocationRange struct {
+	ScriptID RuntimeScriptID         `json:"scriptId"`
+	Start    *DebuggerScriptPosition `json:"start"`
+	End      *DebuggerScriptPosition `json:"end"`
+}
+
+// DebuggerCallFrame https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-CallFrame
+type DebuggerCallFrame struct {
+	CallFrameID      DebuggerCallFrameID `json:"callFrameId"`
+	FunctionName     string              `json:"functionName"`
+	FunctionLocation *DebuggerLocation   `json:"functionLocation,omitempty"`
+	Location         *DebuggerLocation   `json:"location"`
+	// Deprecated: deprecated in protocol
+	URL            string               `json:"url"`
+	ScopeChain     []*DebuggerScope     `json:"scopeChain"`
+	This           *RuntimeRemoteObject `json:"this"`
+	ReturnValue    *RuntimeRemoteObject `json:"returnValue,omitempty"`
+	CanBeRestarted bool                 `json:"canBeRestarted,omitempty"`
+}
+
+// DebuggerScope https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-Scope
+type DebuggerScope struct {
+	Type          DebuggerScopeType    `json:"type"`
+	Object        *RuntimeRemoteObject `json:"object"`
+	Name          string               `json:"name,omitempty"`
+	StartLocation *DebuggerLocation    `json:"startLocation,omitempty"`
+	EndLocation   *DebuggerLocation    `json:"endLocation,omitempty"`
+}
+
+// DebuggerSearchMatch https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-SearchMatch
+type DebuggerSearchMatch struct {
+	LineNumber  float64 `json:"lineNumber"`
+	LineContent string  `json:"lineContent"`
+}
+
+// DebuggerBreakLocation https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-BreakLocation
+type DebuggerBreakLocation struct {
+	ScriptID     RuntimeScriptID           `json:"scriptId"`
+	LineNumber   int64                     `json:"lineNumber"`
+	ColumnNumber int64                     `json:"columnNumber,omitempty"`
+	Type         DebuggerBreakLocationType `json:"type,omitempty"`
+}
+
+// DebuggerWasmDisassemblyChunk https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-WasmDisassemblyChunk
+type DebuggerWasmDisassemblyChunk struct {
+	Lines           []string `json:"lines"`
+	BytecodeOffsets []int64  `json:"bytecodeOffsets"`
+}
+
+// DebuggerScriptLanguage https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ScriptLanguage
+type DebuggerScriptLanguage string
+
+// DebuggerScriptLanguage values
+const (
+	DebuggerScriptLanguageJavaScript  DebuggerScriptLanguage = "JavaScript"
+	DebuggerScriptLanguageWebAssembly DebuggerScriptLanguage = "WebAssembly"
+)
+
+// DebuggerDebugSymbols https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-DebugSymbols
+type DebuggerDebugSymbols struct {
+	Type        DebuggerDebugSymbolsType `json:"type"`
+	ExternalURL string                   `json:"externalURL,omitempty"`
+}
+
+// DebuggerResolvedBreakpoint https://chromedevtools.github.io/devtools-protocol/tot/Debugger#type-ResolvedBreakpoint
+type DebuggerResolvedBreakpoint struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	Location     *DebuggerLocation    `json:"location"`
+}
+
+// DebuggerContinueToLocationParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-continueToLocation
+type DebuggerContinueToLocationParams struct {
+	Location         *DebuggerLocation                                `json:"location"`
+	TargetCallFrames DebuggerContinueToLocationParamsTargetCallFrames `json:"targetCallFrames,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.continueToLocation
+func (*DebuggerContinueToLocationParams) ProtocolMethod() string {
+	return "Debugger.continueToLocation"
+}
+
+// Do sends Debugger.continueToLocation
+func (p *DebuggerContinueToLocationParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-disable
+type DebuggerDisableParams struct {
+}
+
+// ProtocolMethod returns Debugger.disable
+func (*DebuggerDisableParams) ProtocolMethod() string {
+	return "Debugger.disable"
+}
+
+// Do sends Debugger.disable
+func (p *DebuggerDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-enable
+type DebuggerEnableParams struct {
+	MaxScriptsCacheSize float64 `json:"maxScriptsCacheSize,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.enable
+func (*DebuggerEnableParams) ProtocolMethod() string {
+	return "Debugger.enable"
+}
+
+// DebuggerEnableResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-enable
+type DebuggerEnableResult struct {
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// Do sends Debugger.enable and returns its result
+func (p *DebuggerEnableParams) Do(e Executor) (*DebuggerEnableResult, error) {
+	var result = new(DebuggerEnableResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerEvaluateOnCallFrameParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-evaluateOnCallFrame
+type DebuggerEvaluateOnCallFrameParams struct {
+	CallFrameID           DebuggerCallFrameID `json:"callFrameId"`
+	Expression            string              `json:"expression"`
+	ObjectGroup           string              `json:"objectGroup,omitempty"`
+	IncludeCommandLineAPI bool                `json:"includeCommandLineAPI,omitempty"`
+	Silent                bool                `json:"silent,omitempty"`
+	ReturnByValue         bool                `json:"returnByValue,omitempty"`
+	GeneratePreview       bool                `json:"generatePreview,omitempty"`
+	ThrowOnSideEffect     bool                `json:"throwOnSideEffect,omitempty"`
+	Timeout               RuntimeTimeDelta    `json:"timeout,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.evaluateOnCallFrame
+func (*DebuggerEvaluateOnCallFrameParams) ProtocolMethod() string {
+	return "Debugger.evaluateOnCallFrame"
+}
+
+// DebuggerEvaluateOnCallFrameResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-evaluateOnCallFrame
+type DebuggerEvaluateOnCallFrameResult struct {
+	Result           *RuntimeRemoteObject     `json:"result"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Debugger.evaluateOnCallFrame and returns its result
+func (p *DebuggerEvaluateOnCallFrameParams) Do(e Executor) (*DebuggerEvaluateOnCallFrameResult, error) {
+	var result = new(DebuggerEvaluateOnCallFrameResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerGetPossibleBreakpointsParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getPossibleBreakpoints
+type DebuggerGetPossibleBreakpointsParams struct {
+	Start              *DebuggerLocation `json:"start"`
+	End                *DebuggerLocation `json:"end,omitempty"`
+	RestrictToFunction bool              `json:"restrictToFunction,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.getPossibleBreakpoints
+func (*DebuggerGetPossibleBreakpointsParams) ProtocolMethod() string {
+	return "Debugger.getPossibleBreakpoints"
+}
+
+// DebuggerGetPossibleBreakpointsResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getPossibleBreakpoints
+type DebuggerGetPossibleBreakpointsResult struct {
+	Locations []*DebuggerBreakLocation `json:"locations"`
+}
+
+// Do sends Debugger.getPossibleBreakpoints and returns its result
+func (p *DebuggerGetPossibleBreakpointsParams) Do(e Executor) (*DebuggerGetPossibleBreakpointsResult, error) {
+	var result = new(DebuggerGetPossibleBreakpointsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerGetScriptSourceParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getScriptSource
+type DebuggerGetScriptSourceParams struct {
+	ScriptID RuntimeScriptID `json:"scriptId"`
+}
+
+// ProtocolMethod returns Debugger.getScriptSource
+func (*DebuggerGetScriptSourceParams) ProtocolMethod() string {
+	return "Debugger.getScriptSource"
+}
+
+// DebuggerGetScriptSourceResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getScriptSource
+type DebuggerGetScriptSourceResult struct {
+	ScriptSource string `json:"scriptSource"`
+	Bytecode     string `json:"bytecode,omitempty"`
+}
+
+// Do sends Debugger.getScriptSource and returns its result
+func (p *DebuggerGetScriptSourceParams) Do(e Executor) (*DebuggerGetScriptSourceResult, error) {
+	var result = new(DebuggerGetScriptSourceResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerDisassembleWasmModuleParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-disassembleWasmModule
+type DebuggerDisassembleWasmModuleParams struct {
+	ScriptID RuntimeScriptID `json:"scriptId"`
+}
+
+// ProtocolMethod returns Debugger.disassembleWasmModule
+func (*DebuggerDisassembleWasmModuleParams) ProtocolMethod() string {
+	return "Debugger.disassembleWasmModule"
+}
+
+// DebuggerDisassembleWasmModuleResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-disassembleWasmModule
+type DebuggerDisassembleWasmModuleResult struct {
+	StreamID            string                        `json:"streamId,omitempty"`
+	TotalNumberOfLines  int64                         `json:"totalNumberOfLines"`
+	FunctionBodyOffsets []int64                       `json:"functionBodyOffsets"`
+	Chunk               *DebuggerWasmDisassemblyChunk `json:"chunk"`
+}
+
+// Do sends Debugger.disassembleWasmModule and returns its result
+func (p *DebuggerDisassembleWasmModuleParams) Do(e Executor) (*DebuggerDisassembleWasmModuleResult, error) {
+	var result = new(DebuggerDisassembleWasmModuleResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerNextWasmDisassemblyChunkParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-nextWasmDisassemblyChunk
+type DebuggerNextWasmDisassemblyChunkParams struct {
+	StreamID string `json:"streamId"`
+}
+
+// ProtocolMethod returns Debugger.nextWasmDisassemblyChunk
+func (*DebuggerNextWasmDisassemblyChunkParams) ProtocolMethod() string {
+	return "Debugger.nextWasmDisassemblyChunk"
+}
+
+// DebuggerNextWasmDisassemblyChunkResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-nextWasmDisassemblyChunk
+type DebuggerNextWasmDisassemblyChunkResult struct {
+	Chunk *DebuggerWasmDisassemblyChunk `json:"chunk"`
+}
+
+// Do sends Debugger.nextWasmDisassemblyChunk and returns its result
+func (p *DebuggerNextWasmDisassemblyChunkParams) Do(e Executor) (*DebuggerNextWasmDisassemblyChunkResult, error) {
+	var result = new(DebuggerNextWasmDisassemblyChunkResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerGetWasmBytecodeParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getWasmBytecode
+//
+// Deprecated: deprecated in protocol
+type DebuggerGetWasmBytecodeParams struct {
+	ScriptID RuntimeScriptID `json:"scriptId"`
+}
+
+// ProtocolMethod returns Debugger.getWasmBytecode
+func (*DebuggerGetWasmBytecodeParams) ProtocolMethod() string {
+	return "Debugger.getWasmBytecode"
+}
+
+// DebuggerGetWasmBytecodeResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getWasmBytecode
+//
+// Deprecated: deprecated in protocol
+type DebuggerGetWasmBytecodeResult struct {
+	Bytecode string `json:"bytecode"`
+}
+
+// Do sends Debugger.getWasmBytecode and returns its result
+func (p *DebuggerGetWasmBytecodeParams) Do(e Executor) (*DebuggerGetWasmBytecodeResult, error) {
+	var result = new(DebuggerGetWasmBytecodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerGetStackTraceParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getStackTrace
+type DebuggerGetStackTraceParams struct {
+	StackTraceID *RuntimeStackTraceID `json:"stackTraceId"`
+}
+
+// ProtocolMethod returns Debugger.getStackTrace
+func (*DebuggerGetStackTraceParams) ProtocolMethod() string {
+	return "Debugger.getStackTrace"
+}
+
+// DebuggerGetStackTraceResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-getStackTrace
+type DebuggerGetStackTraceResult struct {
+	StackTrace *RuntimeStackTrace `json:"stackTrace"`
+}
+
+// Do sends Debugger.getStackTrace and returns its result
+func (p *DebuggerGetStackTraceParams) Do(e Executor) (*DebuggerGetStackTraceResult, error) {
+	var result = new(DebuggerGetStackTraceResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerPauseParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-pause
+type DebuggerPauseParams struct {
+}
+
+// ProtocolMethod returns Debugger.pause
+func (*DebuggerPauseParams) ProtocolMethod() string {
+	return "Debugger.pause"
+}
+
+// Do sends Debugger.pause
+func (p *DebuggerPauseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerPauseOnAsyncCallParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-pauseOnAsyncCall
+//
+// Deprecated: deprecated in protocol
+type DebuggerPauseOnAsyncCallParams struct {
+	ParentStackTraceID *RuntimeStackTraceID `json:"parentStackTraceId"`
+}
+
+// ProtocolMethod returns Debugger.pauseOnAsyncCall
+func (*DebuggerPauseOnAsyncCallParams) ProtocolMethod() string {
+	return "Debugger.pauseOnAsyncCall"
+}
+
+// Do sends Debugger.pauseOnAsyncCall
+func (p *DebuggerPauseOnAsyncCallParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerRemoveBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-removeBreakpoint
+type DebuggerRemoveBreakpointParams struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+}
+
+// ProtocolMethod returns Debugger.removeBreakpoint
+func (*DebuggerRemoveBreakpointParams) ProtocolMethod() string {
+	return "Debugger.removeBreakpoint"
+}
+
+// Do sends Debugger.removeBreakpoint
+func (p *DebuggerRemoveBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerRestartFrameParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-restartFrame
+type DebuggerRestartFrameParams struct {
+	CallFrameID DebuggerCallFrameID            `json:"callFrameId"`
+	Mode        DebuggerRestartFrameParamsMode `json:"mode,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.restartFrame
+func (*DebuggerRestartFrameParams) ProtocolMethod() string {
+	return "Debugger.restartFrame"
+}
+
+// DebuggerRestartFrameResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-restartFrame
+type DebuggerRestartFrameResult struct {
+	// Deprecated: deprecated in protocol
+	CallFrames []*DebuggerCallFrame `json:"callFrames"`
+	// Deprecated: deprecated in protocol
+	AsyncStackTrace *RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Deprecated: deprecated in protocol
+	AsyncStackTraceID *RuntimeStackTraceID `json:"asyncStackTraceId,omitempty"`
+}
+
+// Do sends Debugger.restartFrame and returns its result
+func (p *DebuggerRestartFrameParams) Do(e Executor) (*DebuggerRestartFrameResult, error) {
+	var result = new(DebuggerRestartFrameResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerResumeParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-resume
+type DebuggerResumeParams struct {
+	TerminateOnResume bool `json:"terminateOnResume,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.resume
+func (*DebuggerResumeParams) ProtocolMethod() string {
+	return "Debugger.resume"
+}
+
+// Do sends Debugger.resume
+func (p *DebuggerResumeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSearchInContentParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-searchInContent
+type DebuggerSearchInContentParams struct {
+	ScriptID      RuntimeScriptID `json:"scriptId"`
+	Query         string          `json:"query"`
+	CaseSensitive bool            `json:"caseSensitive,omitempty"`
+	IsRegex       bool            `json:"isRegex,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.searchInContent
+func (*DebuggerSearchInContentParams) ProtocolMethod() string {
+	return "Debugger.searchInContent"
+}
+
+// DebuggerSearchInContentResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-searchInContent
+type DebuggerSearchInContentResult struct {
+	Result []*DebuggerSearchMatch `json:"result"`
+}
+
+// Do sends Debugger.searchInContent and returns its result
+func (p *DebuggerSearchInContentParams) Do(e Executor) (*DebuggerSearchInContentResult, error) {
+	var result = new(DebuggerSearchInContentResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerSetAsyncCallStackDepthParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setAsyncCallStackDepth
+type DebuggerSetAsyncCallStackDepthParams struct {
+	MaxDepth int64 `json:"maxDepth"`
+}
+
+// ProtocolMethod returns Debugger.setAsyncCallStackDepth
+func (*DebuggerSetAsyncCallStackDepthParams) ProtocolMethod() string {
+	return "Debugger.setAsyncCallStackDepth"
+}
+
+// Do sends Debugger.setAsyncCallStackDepth
+func (p *DebuggerSetAsyncCallStackDepthParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetBlackboxExecutionContextsParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBlackboxExecutionContexts
+type DebuggerSetBlackboxExecutionContextsParams struct {
+	UniqueIds []string `json:"uniqueIds"`
+}
+
+// ProtocolMethod returns Debugger.setBlackboxExecutionContexts
+func (*DebuggerSetBlackboxExecutionContextsParams) ProtocolMethod() string {
+	return "Debugger.setBlackboxExecutionContexts"
+}
+
+// Do sends Debugger.setBlackboxExecutionContexts
+func (p *DebuggerSetBlackboxExecutionContextsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetBlackboxPatternsParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBlackboxPatterns
+type DebuggerSetBlackboxPatternsParams struct {
+	Patterns      []string `json:"patterns"`
+	SkipAnonymous bool     `json:"skipAnonymous,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.setBlackboxPatterns
+func (*DebuggerSetBlackboxPatternsParams) ProtocolMethod() string {
+	return "Debugger.setBlackboxPatterns"
+}
+
+// Do sends Debugger.setBlackboxPatterns
+func (p *DebuggerSetBlackboxPatternsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetBlackboxedRangesParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBlackboxedRanges
+type DebuggerSetBlackboxedRangesParams struct {
+	ScriptID  RuntimeScriptID           `json:"scriptId"`
+	Positions []*DebuggerScriptPosition `json:"positions"`
+}
+
+// ProtocolMethod returns Debugger.setBlackboxedRanges
+func (*DebuggerSetBlackboxedRangesParams) ProtocolMethod() string {
+	return "Debugger.setBlackboxedRanges"
+}
+
+// Do sends Debugger.setBlackboxedRanges
+func (p *DebuggerSetBlackboxedRangesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpoint
+type DebuggerSetBreakpointParams struct {
+	Location  *DebuggerLocation `json:"location"`
+	Condition string            `json:"condition,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.setBreakpoint
+func (*DebuggerSetBreakpointParams) ProtocolMethod() string {
+	return "Debugger.setBreakpoint"
+}
+
+// DebuggerSetBreakpointResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpoint
+type DebuggerSetBreakpointResult struct {
+	BreakpointID   DebuggerBreakpointID `json:"breakpointId"`
+	ActualLocation *DebuggerLocation    `json:"actualLocation"`
+}
+
+// Do sends Debugger.setBreakpoint and returns its result
+func (p *DebuggerSetBreakpointParams) Do(e Executor) (*DebuggerSetBreakpointResult, error) {
+	var result = new(DebuggerSetBreakpointResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerSetInstrumentationBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setInstrumentationBreakpoint
+type DebuggerSetInstrumentationBreakpointParams struct {
+	Instrumentation DebuggerSetInstrumentationBreakpointParamsInstrumentation `json:"instrumentation"`
+}
+
+// ProtocolMethod returns Debugger.setInstrumentationBreakpoint
+func (*DebuggerSetInstrumentationBreakpointParams) ProtocolMethod() string {
+	return "Debugger.setInstrumentationBreakpoint"
+}
+
+// DebuggerSetInstrumentationBreakpointResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setInstrumentationBreakpoint
+type DebuggerSetInstrumentationBreakpointResult struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+}
+
+// Do sends Debugger.setInstrumentationBreakpoint and returns its result
+func (p *DebuggerSetInstrumentationBreakpointParams) Do(e Executor) (*DebuggerSetInstrumentationBreakpointResult, error) {
+	var result = new(DebuggerSetInstrumentationBreakpointResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerSetBreakpointByURLParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointByUrl
+type DebuggerSetBreakpointByURLParams struct {
+	LineNumber   int64  `json:"lineNumber"`
+	URL          string `json:"url,omitempty"`
+	URLRegex     string `json:"urlRegex,omitempty"`
+	ScriptHash   string `json:"scriptHash,omitempty"`
+	ColumnNumber int64  `json:"columnNumber,omitempty"`
+	Condition    string `json:"condition,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.setBreakpointByUrl
+func (*DebuggerSetBreakpointByURLParams) ProtocolMethod() string {
+	return "Debugger.setBreakpointByUrl"
+}
+
+// DebuggerSetBreakpointByURLResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointByUrl
+type DebuggerSetBreakpointByURLResult struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	Locations    []*DebuggerLocation  `json:"locations"`
+}
+
+// Do sends Debugger.setBreakpointByUrl and returns its result
+func (p *DebuggerSetBreakpointByURLParams) Do(e Executor) (*DebuggerSetBreakpointByURLResult, error) {
+	var result = new(DebuggerSetBreakpointByURLResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerSetBreakpointOnFunctionCallParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointOnFunctionCall
+type DebuggerSetBreakpointOnFunctionCallParams struct {
+	ObjectID  RuntimeRemoteObjectID `json:"objectId"`
+	Condition string                `json:"condition,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.setBreakpointOnFunctionCall
+func (*DebuggerSetBreakpointOnFunctionCallParams) ProtocolMethod() string {
+	return "Debugger.setBreakpointOnFunctionCall"
+}
+
+// DebuggerSetBreakpointOnFunctionCallResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointOnFunctionCall
+type DebuggerSetBreakpointOnFunctionCallResult struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+}
+
+// Do sends Debugger.setBreakpointOnFunctionCall and returns its result
+func (p *DebuggerSetBreakpointOnFunctionCallParams) Do(e Executor) (*DebuggerSetBreakpointOnFunctionCallResult, error) {
+	var result = new(DebuggerSetBreakpointOnFunctionCallResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerSetBreakpointsActiveParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setBreakpointsActive
+type DebuggerSetBreakpointsActiveParams struct {
+	Active bool `json:"active"`
+}
+
+// ProtocolMethod returns Debugger.setBreakpointsActive
+func (*DebuggerSetBreakpointsActiveParams) ProtocolMethod() string {
+	return "Debugger.setBreakpointsActive"
+}
+
+// Do sends Debugger.setBreakpointsActive
+func (p *DebuggerSetBreakpointsActiveParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetPauseOnExceptionsParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setPauseOnExceptions
+type DebuggerSetPauseOnExceptionsParams struct {
+	State DebuggerSetPauseOnExceptionsParamsState `json:"state"`
+}
+
+// ProtocolMethod returns Debugger.setPauseOnExceptions
+func (*DebuggerSetPauseOnExceptionsParams) ProtocolMethod() string {
+	return "Debugger.setPauseOnExceptions"
+}
+
+// Do sends Debugger.setPauseOnExceptions
+func (p *DebuggerSetPauseOnExceptionsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetReturnValueParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setReturnValue
+type DebuggerSetReturnValueParams struct {
+	NewValue *RuntimeCallArgument `json:"newValue"`
+}
+
+// ProtocolMethod returns Debugger.setReturnValue
+func (*DebuggerSetReturnValueParams) ProtocolMethod() string {
+	return "Debugger.setReturnValue"
+}
+
+// Do sends Debugger.setReturnValue
+func (p *DebuggerSetReturnValueParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetScriptSourceParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setScriptSource
+type DebuggerSetScriptSourceParams struct {
+	ScriptID             RuntimeScriptID `json:"scriptId"`
+	ScriptSource         string          `json:"scriptSource"`
+	DryRun               bool            `json:"dryRun,omitempty"`
+	AllowTopFrameEditing bool            `json:"allowTopFrameEditing,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.setScriptSource
+func (*DebuggerSetScriptSourceParams) ProtocolMethod() string {
+	return "Debugger.setScriptSource"
+}
+
+// DebuggerSetScriptSourceResult https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setScriptSource
+type DebuggerSetScriptSourceResult struct {
+	// Deprecated: deprecated in protocol
+	CallFrames []*DebuggerCallFrame `json:"callFrames,omitempty"`
+	// Deprecated: deprecated in protocol
+	StackChanged bool `json:"stackChanged,omitempty"`
+	// Deprecated: deprecated in protocol
+	AsyncStackTrace *RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+	// Deprecated: deprecated in protocol
+	AsyncStackTraceID *RuntimeStackTraceID                `json:"asyncStackTraceId,omitempty"`
+	Status            DebuggerSetScriptSourceResultStatus `json:"status"`
+	ExceptionDetails  *RuntimeExceptionDetails            `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Debugger.setScriptSource and returns its result
+func (p *DebuggerSetScriptSourceParams) Do(e Executor) (*DebuggerSetScriptSourceResult, error) {
+	var result = new(DebuggerSetScriptSourceResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DebuggerSetSkipAllPausesParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setSkipAllPauses
+type DebuggerSetSkipAllPausesParams struct {
+	Skip bool `json:"skip"`
+}
+
+// ProtocolMethod returns Debugger.setSkipAllPauses
+func (*DebuggerSetSkipAllPausesParams) ProtocolMethod() string {
+	return "Debugger.setSkipAllPauses"
+}
+
+// Do sends Debugger.setSkipAllPauses
+func (p *DebuggerSetSkipAllPausesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerSetVariableValueParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-setVariableValue
+type DebuggerSetVariableValueParams struct {
+	ScopeNumber  int64                `json:"scopeNumber"`
+	VariableName string               `json:"variableName"`
+	NewValue     *RuntimeCallArgument `json:"newValue"`
+	CallFrameID  DebuggerCallFrameID  `json:"callFrameId"`
+}
+
+// ProtocolMethod returns Debugger.setVariableValue
+func (*DebuggerSetVariableValueParams) ProtocolMethod() string {
+	return "Debugger.setVariableValue"
+}
+
+// Do sends Debugger.setVariableValue
+func (p *DebuggerSetVariableValueParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerStepIntoParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-stepInto
+type DebuggerStepIntoParams struct {
+	BreakOnAsyncCall bool                     `json:"breakOnAsyncCall,omitempty"`
+	SkipList         []*DebuggerLocationRange `json:"skipList,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.stepInto
+func (*DebuggerStepIntoParams) ProtocolMethod() string {
+	return "Debugger.stepInto"
+}
+
+// Do sends Debugger.stepInto
+func (p *DebuggerStepIntoParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerStepOutParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-stepOut
+type DebuggerStepOutParams struct {
+}
+
+// ProtocolMethod returns Debugger.stepOut
+func (*DebuggerStepOutParams) ProtocolMethod() string {
+	return "Debugger.stepOut"
+}
+
+// Do sends Debugger.stepOut
+func (p *DebuggerStepOutParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerStepOverParams https://chromedevtools.github.io/devtools-protocol/tot/Debugger#method-stepOver
+type DebuggerStepOverParams struct {
+	SkipList []*DebuggerLocationRange `json:"skipList,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.stepOver
+func (*DebuggerStepOverParams) ProtocolMethod() string {
+	return "Debugger.stepOver"
+}
+
+// Do sends Debugger.stepOver
+func (p *DebuggerStepOverParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DebuggerBreakpointResolvedEvent https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-breakpointResolved
+//
+// Deprecated: deprecated in protocol
+type DebuggerBreakpointResolvedEvent struct {
+	BreakpointID DebuggerBreakpointID `json:"breakpointId"`
+	Location     *DebuggerLocation    `json:"location"`
+}
+
+// ProtocolMethod returns Debugger.breakpointResolved
+func (*DebuggerBreakpointResolvedEvent) ProtocolMethod() string {
+	return "Debugger.breakpointResolved"
+}
+
+// DebuggerPausedEvent https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-paused
+type DebuggerPausedEvent struct {
+	CallFrames        []*DebuggerCallFrame      `json:"callFrames"`
+	Reason            DebuggerPausedEventReason `json:"reason"`
+	Data              map[string]interface{}    `json:"data,omitempty"`
+	HitBreakpoints    []string                  `json:"hitBreakpoints,omitempty"`
+	AsyncStackTrace   *RuntimeStackTrace        `json:"asyncStackTrace,omitempty"`
+	AsyncStackTraceID *RuntimeStackTraceID      `json:"asyncStackTraceId,omitempty"`
+	// Deprecated: deprecated in protocol
+	AsyncCallStackTraceID *RuntimeStackTraceID `json:"asyncCallStackTraceId,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.paused
+func (*DebuggerPausedEvent) ProtocolMethod() string {
+	return "Debugger.paused"
+}
+
+// DebuggerResumedEvent https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-resumed
+type DebuggerResumedEvent struct {
+}
+
+// ProtocolMethod returns Debugger.resumed
+func (*DebuggerResumedEvent) ProtocolMethod() string {
+	return "Debugger.resumed"
+}
+
+// DebuggerScriptFailedToParseEvent https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-scriptFailedToParse
+type DebuggerScriptFailedToParseEvent struct {
+	ScriptID                RuntimeScriptID           `json:"scriptId"`
+	URL                     string                    `json:"url"`
+	StartLine               int64                     `json:"startLine"`
+	StartColumn             int64                     `json:"startColumn"`
+	EndLine                 int64                     `json:"endLine"`
+	EndColumn               int64                     `json:"endColumn"`
+	ExecutionContextID      RuntimeExecutionContextID `json:"executionContextId"`
+	Hash                    string                    `json:"hash"`
+	BuildID                 string                    `json:"buildId"`
+	ExecutionContextAuxData map[string]interface{}    `json:"executionContextAuxData,omitempty"`
+	SourceMapURL            string                    `json:"sourceMapURL,omitempty"`
+	HasSourceURL            bool                      `json:"hasSourceURL,omitempty"`
+	IsModule                bool                      `json:"isModule,omitempty"`
+	Length                  int64                     `json:"length,omitempty"`
+	StackTrace              *RuntimeStackTrace        `json:"stackTrace,omitempty"`
+	CodeOffset              int64                     `json:"codeOffset,omitempty"`
+	ScriptLanguage          DebuggerScriptLanguage    `json:"scriptLanguage,omitempty"`
+	EmbedderName            string                    `json:"embedderName,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.scriptFailedToParse
+func (*DebuggerScriptFailedToParseEvent) ProtocolMethod() string {
+	return "Debugger.scriptFailedToParse"
+}
+
+// DebuggerScriptParsedEvent https://chromedevtools.github.io/devtools-protocol/tot/Debugger#event-scriptParsed
+type DebuggerScriptParsedEvent struct {
+	ScriptID                RuntimeScriptID               `json:"scriptId"`
+	URL                     string                        `json:"url"`
+	StartLine               int64                         `json:"startLine"`
+	StartColumn             int64                         `json:"startColumn"`
+	EndLine                 int64                         `json:"endLine"`
+	EndColumn               int64                         `json:"endColumn"`
+	ExecutionContextID      RuntimeExecutionContextID     `json:"executionContextId"`
+	Hash                    string                        `json:"hash"`
+	BuildID                 string                        `json:"buildId"`
+	ExecutionContextAuxData map[string]interface{}        `json:"executionContextAuxData,omitempty"`
+	IsLiveEdit              bool                          `json:"isLiveEdit,omitempty"`
+	SourceMapURL            string                        `json:"sourceMapURL,omitempty"`
+	HasSourceURL            bool                          `json:"hasSourceURL,omitempty"`
+	IsModule                bool                          `json:"isModule,omitempty"`
+	Length                  int64                         `json:"length,omitempty"`
+	StackTrace              *RuntimeStackTrace            `json:"stackTrace,omitempty"`
+	CodeOffset              int64                         `json:"codeOffset,omitempty"`
+	ScriptLanguage          DebuggerScriptLanguage        `json:"scriptLanguage,omitempty"`
+	DebugSymbols            []*DebuggerDebugSymbols       `json:"debugSymbols,omitempty"`
+	EmbedderName            string                        `json:"embedderName,omitempty"`
+	ResolvedBreakpoints     []*DebuggerResolvedBreakpoint `json:"resolvedBreakpoints,omitempty"`
+}
+
+// ProtocolMethod returns Debugger.scriptParsed
+func (*DebuggerScriptParsedEvent) ProtocolMethod() string {
+	return "Debugger.scriptParsed"
+}
+
+// DebuggerScopeType enum
+type DebuggerScopeType string
+
+// DebuggerScopeType values
+const (
+	DebuggerScopeTypeGlobal              DebuggerScopeType = "global"
+	DebuggerScopeTypeLocal               DebuggerScopeType = "local"
+	DebuggerScopeTypeWith                DebuggerScopeType = "with"
+	DebuggerScopeTypeClosure             DebuggerScopeType = "closure"
+	DebuggerScopeTypeCatch               DebuggerScopeType = "catch"
+	DebuggerScopeTypeBlock               DebuggerScopeType = "block"
+	DebuggerScopeTypeScript              DebuggerScopeType = "script"
+	DebuggerScopeTypeEval                DebuggerScopeType = "eval"
+	DebuggerScopeTypeModule              DebuggerScopeType = "module"
+	DebuggerScopeTypeWasmExpressionStack DebuggerScopeType = "wasm-expression-stack"
+)
+
+// DebuggerBreakLocationType enum
+type DebuggerBreakLocationType string
+
+// DebuggerBreakLocationType values
+const (
+	DebuggerBreakLocationTypeDebuggerStatement DebuggerBreakLocationType = "debuggerStatement"
+	DebuggerBreakLocationTypeCall              DebuggerBreakLocationType = "call"
+	DebuggerBreakLocationTypeReturn            DebuggerBreakLocationType = "return"
+)
+
+// DebuggerDebugSymbolsType enum
+type DebuggerDebugSymbolsType string
+
+// DebuggerDebugSymbolsType values
+const (
+	DebuggerDebugSymbolsTypeSourceMap     DebuggerDebugSymbolsType = "SourceMap"
+	DebuggerDebugSymbolsTypeEmbeddedDWARF DebuggerDebugSymbolsType = "EmbeddedDWARF"
+	DebuggerDebugSymbolsTypeExternalDWARF DebuggerDebugSymbolsType = "ExternalDWARF"
+)
+
+// DebuggerContinueToLocationParamsTargetCallFrames enum
+type DebuggerContinueToLocationParamsTargetCallFrames string
+
+// DebuggerContinueToLocationParamsTargetCallFrames values
+const (
+	DebuggerContinueToLocationParamsTargetCallFramesAny     DebuggerContinueToLocationParamsTargetCallFrames = "any"
+	DebuggerContinueToLocationParamsTargetCallFramesCurrent DebuggerContinueToLocationParamsTargetCallFrames = "current"
+)
+
+// DebuggerRestartFrameParamsMode enum
+type DebuggerRestartFrameParamsMode string
+
+// DebuggerRestartFrameParamsMode values
+const (
+	DebuggerRestartFrameParamsModeStepInto DebuggerRestartFrameParamsMode = "StepInto"
+)
+
+// DebuggerSetInstrumentationBreakpointParamsInstrumentation enum
+type DebuggerSetInstrumentationBreakpointParamsInstrumentation string
+
+// DebuggerSetInstrumentationBreakpointParamsInstrumentation values
+const (
+	DebuggerSetInstrumentationBreakpointParamsInstrumentationBeforeScriptExecution              DebuggerSetInstrumentationBreakpointParamsInstrumentation = "beforeScriptExecution"
+	DebuggerSetInstrumentationBreakpointParamsInstrumentationBeforeScriptWithSourceMapExecution DebuggerSetInstrumentationBreakpointParamsInstrumentation = "beforeScriptWithSourceMapExecution"
+)
+
+// DebuggerSetPauseOnExceptionsParamsState enum
+type DebuggerSetPauseOnExceptionsParamsState string
+
+// DebuggerSetPauseOnExceptionsParamsState values
+const (
+	DebuggerSetPauseOnExceptionsParamsStateNone     DebuggerSetPauseOnExceptionsParamsState = "none"
+	DebuggerSetPauseOnExceptionsParamsStateCaught   DebuggerSetPauseOnExceptionsParamsState = "caught"
+	DebuggerSetPauseOnExceptionsParamsStateUncaught DebuggerSetPauseOnExceptionsParamsState = "uncaught"
+	DebuggerSetPauseOnExceptionsParamsStateAll      DebuggerSetPauseOnExceptionsParamsState = "all"
+)
+
+// DebuggerSetScriptSourceResultStatus enum
+type DebuggerSetScriptSourceResultStatus string
+
+// DebuggerSetScriptSourceResultStatus values
+const (
+	DebuggerSetScriptSourceResultStatusOk                              DebuggerSetScriptSourceResultStatus = "Ok"
+	DebuggerSetScriptSourceResultStatusCompileError                    DebuggerSetScriptSourceResultStatus = "CompileError"
+	DebuggerSetScriptSourceResultStatusBlockedByActiveGenerator        DebuggerSetScriptSourceResultStatus = "BlockedByActiveGenerator"
+	DebuggerSetScriptSourceResultStatusBlockedByActiveFunction         DebuggerSetScriptSourceResultStatus = "BlockedByActiveFunction"
+	DebuggerSetScriptSourceResultStatusBlockedByTopLevelEsModuleChange DebuggerSetScriptSourceResultStatus = "BlockedByTopLevelEsModuleChange"
+)
+
+// DebuggerPausedEventReason enum
+type DebuggerPausedEventReason string
+
+// DebuggerPausedEventReason values
+const (
+	DebuggerPausedEventReasonAmbiguous        DebuggerPausedEventReason = "ambiguous"
+	DebuggerPausedEventReasonAssert           DebuggerPausedEventReason = "assert"
+	DebuggerPausedEventReasonCSPViolation     DebuggerPausedEventReason = "CSPViolation"
+	DebuggerPausedEventReasonDebugCommand     DebuggerPausedEventReason = "debugCommand"
+	DebuggerPausedEventReasonDOM              DebuggerPausedEventReason = "DOM"
+	DebuggerPausedEventReasonEventListener    DebuggerPausedEventReason = "EventListener"
+	DebuggerPausedEventReasonException        DebuggerPausedEventReason = "exception"
+	DebuggerPausedEventReasonInstrumentation  DebuggerPausedEventReason = "instrumentation"
+	DebuggerPausedEventReasonOOM              DebuggerPausedEventReason = "OOM"
+	DebuggerPausedEventReasonOther            DebuggerPausedEventReason = "other"
+	DebuggerPausedEventReasonPromiseRejection DebuggerPausedEventReason = "promiseRejection"
+	DebuggerPausedEventReasonXHR              DebuggerPausedEventReason = "XHR"
+	DebuggerPausedEventReasonStep             DebuggerPausedEventReason = "step"
+)
@@ -0,0 +1,90 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// DeviceAccess events
+const (
+	EventDeviceAccessDeviceRequestPrompted = "DeviceAccess.deviceRequestPrompted"
+)
+
+// DeviceAccessRequestID https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-RequestId
+type DeviceAccessRequestID string
+
+// DeviceAccessDeviceID https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-DeviceId
+type DeviceAccessDeviceID string
+
+// DeviceAccessPromptDevice https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#type-PromptDevice
+type DeviceAccessPromptDevice struct {
+	ID   DeviceAccessDeviceID `json:"id"`
+	Name string               `json:"name"`
+}
+
+// DeviceAccessEnableParams https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-enable
+type DeviceAccessEnableParams struct {
+}
+
+// ProtocolMethod returns DeviceAccess.enable
+func (*DeviceAccessEnableParams) ProtocolMethod() string {
+	return "DeviceAccess.enable"
+}
+
+// Do sends DeviceAccess.enable
+func (p *DeviceAccessEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DeviceAccessDisableParams https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-disable
+type DeviceAccessDisableParams struct {
+}
+
+// ProtocolMethod returns DeviceAccess.disable
+func (*DeviceAccessDisableParams) ProtocolMethod() string {
+	return "DeviceAccess.disable"
+}
+
+// Do sends DeviceAccess.disable
+func (p *DeviceAccessDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DeviceAccessSelectPromptParams https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-selectPrompt
+type DeviceAccessSelectPromptParams struct {
+	ID       DeviceAccessRequestID `json:"id"`
+	DeviceID DeviceAccessDeviceID  `json:"deviceId"`
+}
+
+// ProtocolMethod returns DeviceAccess.selectPrompt
+func (*DeviceAccessSelectPromptParams) ProtocolMethod() string {
+	return "DeviceAccess.selectPrompt"
+}
+
+// Do sends DeviceAccess.selectPrompt
+func (p *DeviceAccessSelectPromptParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DeviceAccessCancelPromptParams https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#method-cancelPrompt
+type DeviceAccessCancelPromptParams struct {
+	ID DeviceAccessRequestID `json:"id"`
+}
+
+// ProtocolMethod returns DeviceAccess.cancelPrompt
+func (*DeviceAccessCancelPromptParams) ProtocolMethod() string {
+	return "DeviceAccess.cancelPrompt"
+}
+
+// Do sends DeviceAccess.cancelPrompt
+func (p *DeviceAccessCancelPromptParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DeviceAccessDeviceRequestPromptedEvent https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess#event-deviceRequestPrompted
+type DeviceAccessDeviceRequestPromptedEvent struct {
+	ID      DeviceAccessRequestID       `json:"id"`
+	Devices []*DeviceAccessPromptDevice `json:"devices"`
+}
+
+// ProtocolMethod returns DeviceAccess.deviceRequestPrompted
+func (*DeviceAccessDeviceRequestPromptedEvent) ProtocolMethod() string {
+	return "DeviceAccess.deviceRequestPrompted"
+}
@@ -0,0 +1,34 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// DeviceOrientationClearDeviceOrientationOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation#method-clearDeviceOrientationOverride
+type DeviceOrientationClearDeviceOrientationOverrideParams struct {
+}
+
+// ProtocolMethod returns DeviceOrientation.clearDeviceOrientationOverride
+func (*DeviceOrientationClearDeviceOrientationOverrideParams) ProtocolMethod() string {
+	return "DeviceOrientation.clearDeviceOrientationOverride"
+}
+
+// Do sends DeviceOrientation.clearDeviceOrientationOverride
+func (p *DeviceOrientationClearDeviceOrientationOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DeviceOrientationSetDeviceOrientationOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation#method-setDeviceOrientationOverride
+type DeviceOrientationSetDeviceOrientationOverrideParams struct {
+	Alpha float64 `json:"alpha"`
+	Beta  float64 `json:"beta"`
+	Gamma float64 `json:"gamma"`
+}
+
+// ProtocolMethod returns DeviceOrientation.setDeviceOrientationOverride
+func (*DeviceOrientationSetDeviceOrientationOverrideParams) ProtocolMethod() string {
+	return "DeviceOrientation.setDeviceOrientationOverride"
+}
+
+// Do sends DeviceOrientation.setDeviceOrientationOverride
+func (p *DeviceOrientationSetDeviceOrientationOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
@@ -0,0 +1,1506 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+import "encoding/json"
+
+// DOM events
+const (
+	EventDOMAttributeModified       = "DOM.attributeModified"
+	EventDOMAttributeRemoved        = "DOM.attributeRemoved"
+	EventDOMCharacterDataModified   = "DOM.characterDataModified"
+	EventDOMChildNodeCountUpdated   = "DOM.childNodeCountUpdated"
+	EventDOMChildNodeInserted       = "DOM.childNodeInserted"
+	EventDOMChildNodeRemoved        = "DOM.childNodeRemoved"
+	EventDOMDistributedNodesUpdated = "DOM.distributedNodesUpdated"
+	EventDOMDocumentUpdated         = "DOM.documentUpdated"
+	EventDOMInlineStyleInvalidated  = "DOM.inlineStyleInvalidated"
+	EventDOMPseudoElementAdded      = "DOM.pseudoElementAdded"
+	EventDOMTopLayerElementsUpdated = "DOM.topLayerElementsUpdated"
+	EventDOMScrollableFlagUpdated   = "DOM.scrollableFlagUpdated"
+	EventDOMPseudoElementRemoved    = "DOM.pseudoElementRemoved"
+	EventDOMSetChildNodes           = "DOM.setChildNodes"
+	EventDOMShadowRootPopped        = "DOM.shadowRootPopped"
+	EventDOMShadowRootPushed        = "DOM.shadowRootPushed"
+)
+
+// DOMNodeID https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-NodeId
+type DOMNodeID int64
+
+// DOMBackendNodeID https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BackendNodeId
+type DOMBackendNodeID int64
+
+// DOMBackendNode https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BackendNode
+type DOMBackendNode struct {
+	NodeType      int64            `json:"nodeType"`
+	NodeName      string           `json:"nodeName"`
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+}
+
+// DOMPseudoType https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-PseudoType
+type DOMPseudoType string
+
+// DOMPseudoType values
+const (
+	DOMPseudoTypeFirstLine                   DOMPseudoType = "first-line"
+	DOMPseudoTypeFirstLetter                 DOMPseudoType = "first-letter"
+	DOMPseudoTypeCheckmark                   DOMPseudoType = "checkmark"
+	DOMPseudoTypeBefore                      DOMPseudoType = "before"
+	DOMPseudoTypeAfter                       DOMPseudoType = "after"
+	DOMPseudoTypePickerIcon                  DOMPseudoType = "picker-icon"
+	DOMPseudoTypeMarker                      DOMPseudoType = "marker"
+	DOMPseudoTypeBackdrop                    DOMPseudoType = "backdrop"
+	DOMPseudoTypeColumn                      DOMPseudoType = "column"
+	DOMPseudoTypeSelection                   DOMPseudoType = "selection"
+	DOMPseudoTypeSearchText                  DOMPseudoType = "search-text"
+	DOMPseudoTypeTargetText                  DOMPseudoType = "target-text"
+	DOMPseudoTypeSpellingError               DOMPseudoType = "spelling-error"
+	DOMPseudoTypeGrammarError                DOMPseudoType = "grammar-error"
+	DOMPseudoTypeHighlight                   DOMPseudoType = "highlight"
+	DOMPseudoTypeFirstLineInherited          DOMPseudoType = "first-line-inherited"
+	DOMPseudoTypeScrollMarker                DOMPseudoType = "scroll-marker"
+	DOMPseudoTypeScrollMarkerGroup           DOMPseudoType = "scroll-marker-group"
+	DOMPseudoTypeScrollButton                DOMPseudoType = "scroll-button"
+	DOMPseudoTypeScrollbar                   DOMPseudoType = "scrollbar"
+	DOMPseudoTypeScrollbarThumb              DOMPseudoType = "scrollbar-thumb"
+	DOMPseudoTypeScrollbarButton             DOMPseudoType = "scrollbar-button"
+	DOMPseudoTypeScrollbarTrack              DOMPseudoType = "scrollbar-track"
+	DOMPseudoTypeScrollbarTrackPiece         DOMPseudoType = "scrollbar-track-piece"
+	DOMPseudoTypeScrollbarCorner             DOMPseudoType = "scrollbar-corner"
+	DOMPseudoTypeResizer                     DOMPseudoType = "resizer"
+	DOMPseudoTypeInputListButton             DOMPseudoType = "input-list-button"
+	DOMPseudoTypeViewTransition              DOMPseudoType = "view-transition"
+	DOMPseudoTypeViewTransitionGroup         DOMPseudoType = "view-transition-group"
+	DOMPseudoTypeViewTransitionImagePair     DOMPseudoType = "view-transition-image-pair"
+	DOMPseudoTypeViewTransitionGroupChildren DOMPseudoType = "view-transition-group-children"
+	DOMPseudoTypeViewTransitionOld           DOMPseudoType = "view-transition-old"
+	DOMPseudoTypeViewTransitionNew           DOMPseudoType = "view-transition-new"
+	DOMPseudoTypePlaceholder                 DOMPseudoType = "placeholder"
+	DOMPseudoTypeFileSelectorButton          DOMPseudoType = "file-selector-button"
+	DOMPseudoTypeDetailsContent              DOMPseudoType = "details-content"
+	DOMPseudoTypePicker                      DOMPseudoType = "picker"
+	DOMPseudoTypePermissionIcon              DOMPseudoType = "permission-icon"
+)
+
+// DOMShadowRootType https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ShadowRootType
+type DOMShadowRootType string
+
+// DOMShadowRootType values
+const (
+	DOMShadowRootTypeUserAgent DOMShadowRootType = "user-agent"
+	DOMShadowRootTypeOpen      DOMShadowRootType = "open"
+	DOMShadowRootTypeClosed    DOMShadowRootType = "closed"
+)
+
+// DOMCompatibilityMode https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-CompatibilityMode
+type DOMCompatibilityMode string
+
+// DOMCompatibilityMode values
+const (
+	DOMCompatibilityModeQuirksMode        DOMCompatibilityMode = "QuirksMode"
+	DOMCompatibilityModeLimitedQuirksMode DOMCompatibilityMode = "LimitedQuirksMode"
+	DOMCompatibilityModeNoQuirksMode      DOMCompatibilityMode = "NoQuirksMode"
+)
+
+// DOMPhysicalAxes https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-PhysicalAxes
+type DOMPhysicalAxes string
+
+// DOMPhysicalAxes values
+const (
+	DOMPhysicalAxesHorizontal DOMPhysicalAxes = "Horizontal"
+	DOMPhysicalAxesVertical   DOMPhysicalAxes = "Vertical"
+	DOMPhysicalAxesBoth       DOMPhysicalAxes = "Both"
+)
+
+// DOMLogicalAxes https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-LogicalAxes
+type DOMLogicalAxes string
+
+// DOMLogicalAxes values
+const (
+	DOMLogicalAxesInline DOMLogicalAxes = "Inline"
+	DOMLogicalAxesBlock  DOMLogicalAxes = "Block"
+	DOMLogicalAxesBoth   DOMLogicalAxes = "Both"
+)
+
+// DOMScrollOrientation https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ScrollOrientation
+type DOMScrollOrientation string
+
+// DOMScrollOrientation values
+const (
+	DOMScrollOrientationHorizontal DOMScrollOrientation = "horizontal"
+	DOMScrollOrientationVertical   DOMScrollOrientation = "vertical"
+)
+
+// DOMNode https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Node
+type DOMNode struct {
+	NodeID           DOMNodeID         `json:"nodeId"`
+	ParentID         DOMNodeID         `json:"parentId,omitempty"`
+	BackendNodeID    DOMBackendNodeID  `json:"backendNodeId"`
+	NodeType         int64             `json:"nodeType"`
+	NodeName         string            `json:"nodeName"`
+	LocalName        string            `json:"localName"`
+	NodeValue        string            `json:"nodeValue"`
+	ChildNodeCount   int64             `json:"childNodeCount,omitempty"`
+	Children         []*DOMNode        `json:"children,omitempty"`
+	Attributes       []string          `json:"attributes,omitempty"`
+	DocumentURL      string            `json:"documentURL,omitempty"`
+	BaseURL          string            `json:"baseURL,omitempty"`
+	PublicID         string            `json:"publicId,omitempty"`
+	SystemID         string            `json:"systemId,omitempty"`
+	InternalSubset   string            `json:"internalSubset,omitempty"`
+	XMLVersion       string            `json:"xmlVersion,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Value            string            `json:"value,omitempty"`
+	PseudoType       DOMPseudoType     `json:"pseudoType,omitempty"`
+	PseudoIdentifier string            `json:"pseudoIdentifier,omitempty"`
+	ShadowRootType   DOMShadowRootType `json:"shadowRootType,omitempty"`
+	FrameID          PageFrameID       `json:"frameId,omitempty"`
+	ContentDocument  *DOMNode          `json:"contentDocument,omitempty"`
+	ShadowRoots      []*DOMNode        `json:"shadowRoots,omitempty"`
+	TemplateContent  *DOMNode          `json:"templateContent,omitempty"`
+	PseudoElements   []*DOMNode        `json:"pseudoElements,omitempty"`
+	// Deprecated: deprecated in protocol
+	ImportedDocument  *DOMNode             `json:"importedDocument,omitempty"`
+	DistributedNodes  []*DOMBackendNode    `json:"distributedNodes,omitempty"`
+	IsSVG             bool                 `json:"isSVG,omitempty"`
+	CompatibilityMode DOMCompatibilityMode `json:"compatibilityMode,omitempty"`
+	AssignedSlot      *DOMBackendNode      `json:"assignedSlot,omitempty"`
+	IsScrollable      bool                 `json:"isScrollable,omitempty"`
+}
+
+// DOMDetachedElementInfo https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-DetachedElementInfo
+type DOMDetachedElementInfo struct {
+	TreeNode        *DOMNode    `json:"treeNode"`
+	RetainedNodeIds []DOMNodeID `json:"retainedNodeIds"`
+}
+
+// DOMRGBA https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-RGBA
+type DOMRGBA struct {
+	R int64   `json:"r"`
+	G int64   `json:"g"`
+	B int64   `json:"b"`
+	A float64 `json:"a,omitempty"`
+}
+
+// DOMQuad https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Quad
+type DOMQuad []float64
+
+// DOMBoxModel https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-BoxModel
+type DOMBoxModel struct {
+	Content      DOMQuad              `json:"content"`
+	Padding      DOMQuad              `json:"padding"`
+	Border       DOMQuad              `json:"border"`
+	Margin       DOMQuad              `json:"margin"`
+	Width        int64                `json:"width"`
+	Height       int64                `json:"height"`
+	ShapeOutside *DOMShapeOutsideInfo `json:"shapeOutside,omitempty"`
+}
+
+// DOMShapeOutsideInfo https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-ShapeOutsideInfo
+type DOMShapeOutsideInfo struct {
+	Bounds      DOMQuad           `json:"bounds"`
+	Shape       []json.RawMessage `json:"shape"`
+	MarginShape []json.RawMessage `json:"marginShape"`
+}
+
+// DOMRect https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-Rect
+type DOMRect struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// DOMCSSComputedStyleProperty https://chromedevtools.github.io/devtools-protocol/tot/DOM#type-CSSComputedStyleProperty
+type DOMCSSComputedStyleProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DOMCollectClassNamesFromSubtreeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-collectClassNamesFromSubtree
+type DOMCollectClassNamesFromSubtreeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.collectClassNamesFromSubtree
+func (*DOMCollectClassNamesFromSubtreeParams) ProtocolMethod() string {
+	return "DOM.collectClassNamesFromSubtree"
+}
+
+// DOMCollectClassNamesFromSubtreeResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-collectClassNamesFromSubtree
+type DOMCollectClassNamesFromSubtreeResult struct {
+	ClassNames []string `json:"classNames"`
+}
+
+// Do sends DOM.collectClassNamesFromSubtree and returns its result
+func (p *DOMCollectClassNamesFromSubtreeParams) Do(e Executor) (*DOMCollectClassNamesFromSubtreeResult, error) {
+	var result = new(DOMCollectClassNamesFromSubtreeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMCopyToParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-copyTo
+type DOMCopyToParams struct {
+	NodeID             DOMNodeID `json:"nodeId"`
+	TargetNodeID       DOMNodeID `json:"targetNodeId"`
+	InsertBeforeNodeID DOMNodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.copyTo
+func (*DOMCopyToParams) ProtocolMethod() string {
+	return "DOM.copyTo"
+}
+
+// DOMCopyToResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-copyTo
+type DOMCopyToResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.copyTo and returns its result
+func (p *DOMCopyToParams) Do(e Executor) (*DOMCopyToResult, error) {
+	var result = new(DOMCopyToResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMDescribeNodeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-describeNode
+type DOMDescribeNodeParams struct {
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	Depth         int64                 `json:"depth,omitempty"`
+	Pierce        bool                  `json:"pierce,omitempty"`
+}
+
+// ProtocolMethod returns DOM.describeNode
+func (*DOMDescribeNodeParams) ProtocolMethod() string {
+	return "DOM.describeNode"
+}
+
+// DOMDescribeNodeResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-describeNode
+type DOMDescribeNodeResult struct {
+	Node *DOMNode `json:"node"`
+}
+
+// Do sends DOM.describeNode and returns its result
+func (p *DOMDescribeNodeParams) Do(e Executor) (*DOMDescribeNodeResult, error) {
+	var result = new(DOMDescribeNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMScrollIntoViewIfNeededParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-scrollIntoViewIfNeeded
+type DOMScrollIntoViewIfNeededParams struct {
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	Rect          *DOMRect              `json:"rect,omitempty"`
+}
+
+// ProtocolMethod returns DOM.scrollIntoViewIfNeeded
+func (*DOMScrollIntoViewIfNeededParams) ProtocolMethod() string {
+	return "DOM.scrollIntoViewIfNeeded"
+}
+
+// Do sends DOM.scrollIntoViewIfNeeded
+func (p *DOMScrollIntoViewIfNeededParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDisableParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-disable
+type DOMDisableParams struct {
+}
+
+// ProtocolMethod returns DOM.disable
+func (*DOMDisableParams) ProtocolMethod() string {
+	return "DOM.disable"
+}
+
+// Do sends DOM.disable
+func (p *DOMDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDiscardSearchResultsParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-discardSearchResults
+type DOMDiscardSearchResultsParams struct {
+	SearchID string `json:"searchId"`
+}
+
+// ProtocolMethod returns DOM.discardSearchResults
+func (*DOMDiscardSearchResultsParams) ProtocolMethod() string {
+	return "DOM.discardSearchResults"
+}
+
+// Do sends DOM.discardSearchResults
+func (p *DOMDiscardSearchResultsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMEnableParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-enable
+type DOMEnableParams struct {
+	IncludeWhitespace DOMEnableParamsIncludeWhitespace `json:"includeWhitespace,omitempty"`
+}
+
+// ProtocolMethod returns DOM.enable
+func (*DOMEnableParams) ProtocolMethod() string {
+	return "DOM.enable"
+}
+
+// Do sends DOM.enable
+func (p *DOMEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMFocusParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-focus
+type DOMFocusParams struct {
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.focus
+func (*DOMFocusParams) ProtocolMethod() string {
+	return "DOM.focus"
+}
+
+// Do sends DOM.focus
+func (p *DOMFocusParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMGetAttributesParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getAttributes
+type DOMGetAttributesParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.getAttributes
+func (*DOMGetAttributesParams) ProtocolMethod() string {
+	return "DOM.getAttributes"
+}
+
+// DOMGetAttributesResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getAttributes
+type DOMGetAttributesResult struct {
+	Attributes []string `json:"attributes"`
+}
+
+// Do sends DOM.getAttributes and returns its result
+func (p *DOMGetAttributesParams) Do(e Executor) (*DOMGetAttributesResult, error) {
+	var result = new(DOMGetAttributesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetBoxModelParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getBoxModel
+type DOMGetBoxModelParams struct {
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getBoxModel
+func (*DOMGetBoxModelParams) ProtocolMethod() string {
+	return "DOM.getBoxModel"
+}
+
+// DOMGetBoxModelResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getBoxModel
+type DOMGetBoxModelResult struct {
+	Model *DOMBoxModel `json:"model"`
+}
+
+// Do sends DOM.getBoxModel and returns its result
+func (p *DOMGetBoxModelParams) Do(e Executor) (*DOMGetBoxModelResult, error) {
+	var result = new(DOMGetBoxModelResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetContentQuadsParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getContentQuads
+type DOMGetContentQuadsParams struct {
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getContentQuads
+func (*DOMGetContentQuadsParams) ProtocolMethod() string {
+	return "DOM.getContentQuads"
+}
+
+// DOMGetContentQuadsResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getContentQuads
+type DOMGetContentQuadsResult struct {
+	Quads []DOMQuad `json:"quads"`
+}
+
+// Do sends DOM.getContentQuads and returns its result
+func (p *DOMGetContentQuadsParams) Do(e Executor) (*DOMGetContentQuadsResult, error) {
+	var result = new(DOMGetContentQuadsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetDocumentParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getDocument
+type DOMGetDocumentParams struct {
+	Depth  int64 `json:"depth,omitempty"`
+	Pierce bool  `json:"pierce,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getDocument
+func (*DOMGetDocumentParams) ProtocolMethod() string {
+	return "DOM.getDocument"
+}
+
+// DOMGetDocumentResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getDocument
+type DOMGetDocumentResult struct {
+	Root *DOMNode `json:"root"`
+}
+
+// Do sends DOM.getDocument and returns its result
+func (p *DOMGetDocumentParams) Do(e Executor) (*DOMGetDocumentResult, error) {
+	var result = new(DOMGetDocumentResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetFlattenedDocumentParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFlattenedDocument
+//
+// Deprecated: deprecated in protocol
+type DOMGetFlattenedDocumentParams struct {
+	Depth  int64 `json:"depth,omitempty"`
+	Pierce bool  `json:"pierce,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getFlattenedDocument
+func (*DOMGetFlattenedDocumentParams) ProtocolMethod() string {
+	return "DOM.getFlattenedDocument"
+}
+
+// DOMGetFlattenedDocumentResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFlattenedDocument
+//
+// Deprecated: deprecated in protocol
+type DOMGetFlattenedDocumentResult struct {
+	Nodes []*DOMNode `json:"nodes"`
+}
+
+// Do sends DOM.getFlattenedDocument and returns its result
+func (p *DOMGetFlattenedDocumentParams) Do(e Executor) (*DOMGetFlattenedDocumentResult, error) {
+	var result = new(DOMGetFlattenedDocumentResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetNodesForSubtreeByStyleParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodesForSubtreeByStyle
+type DOMGetNodesForSubtreeByStyleParams struct {
+	NodeID         DOMNodeID                      `json:"nodeId"`
+	ComputedStyles []*DOMCSSComputedStyleProperty `json:"computedStyles"`
+	Pierce         bool                           `json:"pierce,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getNodesForSubtreeByStyle
+func (*DOMGetNodesForSubtreeByStyleParams) ProtocolMethod() string {
+	return "DOM.getNodesForSubtreeByStyle"
+}
+
+// DOMGetNodesForSubtreeByStyleResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodesForSubtreeByStyle
+type DOMGetNodesForSubtreeByStyleResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getNodesForSubtreeByStyle and returns its result
+func (p *DOMGetNodesForSubtreeByStyleParams) Do(e Executor) (*DOMGetNodesForSubtreeByStyleResult, error) {
+	var result = new(DOMGetNodesForSubtreeByStyleResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetNodeForLocationParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodeForLocation
+type DOMGetNodeForLocationParams struct {
+	X                         int64 `json:"x"`
+	Y                         int64 `json:"y"`
+	IncludeUserAgentShadowDOM bool  `json:"includeUserAgentShadowDOM,omitempty"`
+	IgnorePointerEventsNone   bool  `json:"ignorePointerEventsNone,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getNodeForLocation
+func (*DOMGetNodeForLocationParams) ProtocolMethod() string {
+	return "DOM.getNodeForLocation"
+}
+
+// DOMGetNodeForLocationResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodeForLocation
+type DOMGetNodeForLocationResult struct {
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+	FrameID       PageFrameID      `json:"frameId"`
+	NodeID        DOMNodeID        `json:"nodeId,omitempty"`
+}
+
+// Do sends DOM.getNodeForLocation and returns its result
+func (p *DOMGetNodeForLocationParams) Do(e Executor) (*DOMGetNodeForLocationResult, error) {
+	var result = new(DOMGetNodeForLocationResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetOuterHTMLParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getOuterHTML
+type DOMGetOuterHTMLParams struct {
+	NodeID           DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID    DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID         RuntimeRemoteObjectID `json:"objectId,omitempty"`
+	IncludeShadowDOM bool                  `json:"includeShadowDOM,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getOuterHTML
+func (*DOMGetOuterHTMLParams) ProtocolMethod() string {
+	return "DOM.getOuterHTML"
+}
+
+// DOMGetOuterHTMLResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getOuterHTML
+type DOMGetOuterHTMLResult struct {
+	OuterHTML string `json:"outerHTML"`
+}
+
+// Do sends DOM.getOuterHTML and returns its result
+func (p *DOMGetOuterHTMLParams) Do(e Executor) (*DOMGetOuterHTMLResult, error) {
+	var result = new(DOMGetOuterHTMLResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetRelayoutBoundaryParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getRelayoutBoundary
+type DOMGetRelayoutBoundaryParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.getRelayoutBoundary
+func (*DOMGetRelayoutBoundaryParams) ProtocolMethod() string {
+	return "DOM.getRelayoutBoundary"
+}
+
+// DOMGetRelayoutBoundaryResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getRelayoutBoundary
+type DOMGetRelayoutBoundaryResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.getRelayoutBoundary and returns its result
+func (p *DOMGetRelayoutBoundaryParams) Do(e Executor) (*DOMGetRelayoutBoundaryResult, error) {
+	var result = new(DOMGetRelayoutBoundaryResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetSearchResultsParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getSearchResults
+type DOMGetSearchResultsParams struct {
+	SearchID  string `json:"searchId"`
+	FromIndex int64  `json:"fromIndex"`
+	ToIndex   int64  `json:"toIndex"`
+}
+
+// ProtocolMethod returns DOM.getSearchResults
+func (*DOMGetSearchResultsParams) ProtocolMethod() string {
+	return "DOM.getSearchResults"
+}
+
+// DOMGetSearchResultsResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getSearchResults
+type DOMGetSearchResultsResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getSearchResults and returns its result
+func (p *DOMGetSearchResultsParams) Do(e Executor) (*DOMGetSearchResultsResult, error) {
+	var result = new(DOMGetSearchResultsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMMarkUndoableStateParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-markUndoableState
+type DOMMarkUndoableStateParams struct {
+}
+
+// ProtocolMethod returns DOM.markUndoableState
+func (*DOMMarkUndoableStateParams) ProtocolMethod() string {
+	return "DOM.markUndoableState"
+}
+
+// Do sends DOM.markUndoableState
+func (p *DOMMarkUndoableStateParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMMoveToParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-moveTo
+type DOMMoveToParams struct {
+	NodeID             DOMNodeID `json:"nodeId"`
+	TargetNodeID       DOMNodeID `json:"targetNodeId"`
+	InsertBeforeNodeID DOMNodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.moveTo
+func (*DOMMoveToParams) ProtocolMethod() string {
+	return "DOM.moveTo"
+}
+
+// DOMMoveToResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-moveTo
+type DOMMoveToResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.moveTo and returns its result
+func (p *DOMMoveToParams) Do(e Executor) (*DOMMoveToResult, error) {
+	var result = new(DOMMoveToResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMPerformSearchParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-performSearch
+type DOMPerformSearchParams struct {
+	Query                     string `json:"query"`
+	IncludeUserAgentShadowDOM bool   `json:"includeUserAgentShadowDOM,omitempty"`
+}
+
+// ProtocolMethod returns DOM.performSearch
+func (*DOMPerformSearchParams) ProtocolMethod() string {
+	return "DOM.performSearch"
+}
+
+// DOMPerformSearchResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-performSearch
+type DOMPerformSearchResult struct {
+	SearchID    string `json:"searchId"`
+	ResultCount int64  `json:"resultCount"`
+}
+
+// Do sends DOM.performSearch and returns its result
+func (p *DOMPerformSearchParams) Do(e Executor) (*DOMPerformSearchResult, error) {
+	var result = new(DOMPerformSearchResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMPushNodeByPathToFrontendParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-pushNodeByPathToFrontend
+type DOMPushNodeByPathToFrontendParams struct {
+	Path string `json:"path"`
+}
+
+// ProtocolMethod returns DOM.pushNodeByPathToFrontend
+func (*DOMPushNodeByPathToFrontendParams) ProtocolMethod() string {
+	return "DOM.pushNodeByPathToFrontend"
+}
+
+// DOMPushNodeByPathToFrontendResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-pushNodeByPathToFrontend
+type DOMPushNodeByPathToFrontendResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.pushNodeByPathToFrontend and returns its result
+func (p *DOMPushNodeByPathToFrontendParams) Do(e Executor) (*DOMPushNodeByPathToFrontendResult, error) {
+	var result = new(DOMPushNodeByPathToFrontendResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMPushNodesByBackendIdsToFrontendParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-pushNodesByBackendIdsToFrontend
+type DOMPushNodesByBackendIdsToFrontendParams struct {
+	BackendNodeIds []DOMBackendNodeID `json:"backendNodeIds"`
+}
+
+// ProtocolMethod returns DOM.pushNodesByBackendIdsToFrontend
+func (*DOMPushNodesByBackendIdsToFrontendParams) ProtocolMethod() string {
+	return "DOM.pushNodesByBackendIdsToFrontend"
+}
+
+// DOMPushNodesByBackendIdsToFrontendResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-pushNodesByBackendIdsToFrontend
+type DOMPushNodesByBackendIdsToFrontendResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.pushNodesByBackendIdsToFrontend and returns its result
+func (p *DOMPushNodesByBackendIdsToFrontendParams) Do(e Executor) (*DOMPushNodesByBackendIdsToFrontendResult, error) {
+	var result = new(DOMPushNodesByBackendIdsToFrontendResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMQuerySelectorParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-querySelector
+type DOMQuerySelectorParams struct {
+	NodeID   DOMNodeID `json:"nodeId"`
+	Selector string    `json:"selector"`
+}
+
+// ProtocolMethod returns DOM.querySelector
+func (*DOMQuerySelectorParams) ProtocolMethod() string {
+	return "DOM.querySelector"
+}
+
+// DOMQuerySelectorResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-querySelector
+type DOMQuerySelectorResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.querySelector and returns its result
+func (p *DOMQuerySelectorParams) Do(e Executor) (*DOMQuerySelectorResult, error) {
+	var result = new(DOMQuerySelectorResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMQuerySelectorAllParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-querySelectorAll
+type DOMQuerySelectorAllParams struct {
+	NodeID   DOMNodeID `json:"nodeId"`
+	Selector string    `json:"selector"`
+}
+
+// ProtocolMethod returns DOM.querySelectorAll
+func (*DOMQuerySelectorAllParams) ProtocolMethod() string {
+	return "DOM.querySelectorAll"
+}
+
+// DOMQuerySelectorAllResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-querySelectorAll
+type DOMQuerySelectorAllResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.querySelectorAll and returns its result
+func (p *DOMQuerySelectorAllParams) Do(e Executor) (*DOMQuerySelectorAllResult, error) {
+	var result = new(DOMQuerySelectorAllResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetTopLayerElementsParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getTopLayerElements
+type DOMGetTopLayerElementsParams struct {
+}
+
+// ProtocolMethod returns DOM.getTopLayerElements
+func (*DOMGetTopLayerElementsParams) ProtocolMethod() string {
+	return "DOM.getTopLayerElements"
+}
+
+// DOMGetTopLayerElementsResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getTopLayerElements
+type DOMGetTopLayerElementsResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getTopLayerElements and returns its result
+func (p *DOMGetTopLayerElementsParams) Do(e Executor) (*DOMGetTopLayerElementsResult, error) {
+	var result = new(DOMGetTopLayerElementsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetElementByRelationParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getElementByRelation
+type DOMGetElementByRelationParams struct {
+	NodeID   DOMNodeID                             `json:"nodeId"`
+	Relation DOMGetElementByRelationParamsRelation `json:"relation"`
+}
+
+// ProtocolMethod returns DOM.getElementByRelation
+func (*DOMGetElementByRelationParams) ProtocolMethod() string {
+	return "DOM.getElementByRelation"
+}
+
+// DOMGetElementByRelationResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getElementByRelation
+type DOMGetElementByRelationResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.getElementByRelation and returns its result
+func (p *DOMGetElementByRelationParams) Do(e Executor) (*DOMGetElementByRelationResult, error) {
+	var result = new(DOMGetElementByRelationResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMRedoParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-redo
+type DOMRedoParams struct {
+}
+
+// ProtocolMethod returns DOM.redo
+func (*DOMRedoParams) ProtocolMethod() string {
+	return "DOM.redo"
+}
+
+// Do sends DOM.redo
+func (p *DOMRedoParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMRemoveAttributeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-removeAttribute
+type DOMRemoveAttributeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Name   string    `json:"name"`
+}
+
+// ProtocolMethod returns DOM.removeAttribute
+func (*DOMRemoveAttributeParams) ProtocolMethod() string {
+	return "DOM.removeAttribute"
+}
+
+// Do sends DOM.removeAttribute
+func (p *DOMRemoveAttributeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMRemoveNodeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-removeNode
+type DOMRemoveNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.removeNode
+func (*DOMRemoveNodeParams) ProtocolMethod() string {
+	return "DOM.removeNode"
+}
+
+// Do sends DOM.removeNode
+func (p *DOMRemoveNodeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMRequestChildNodesParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-requestChildNodes
+type DOMRequestChildNodesParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Depth  int64     `json:"depth,omitempty"`
+	Pierce bool      `json:"pierce,omitempty"`
+}
+
+// ProtocolMethod returns DOM.requestChildNodes
+func (*DOMRequestChildNodesParams) ProtocolMethod() string {
+	return "DOM.requestChildNodes"
+}
+
+// Do sends DOM.requestChildNodes
+func (p *DOMRequestChildNodesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMRequestNodeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-requestNode
+type DOMRequestNodeParams struct {
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// ProtocolMethod returns DOM.requestNode
+func (*DOMRequestNodeParams) ProtocolMethod() string {
+	return "DOM.requestNode"
+}
+
+// DOMRequestNodeResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-requestNode
+type DOMRequestNodeResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.requestNode and returns its result
+func (p *DOMRequestNodeParams) Do(e Executor) (*DOMRequestNodeResult, error) {
+	var result = new(DOMRequestNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMResolveNodeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-resolveNode
+type DOMResolveNodeParams struct {
+	NodeID             DOMNodeID                 `json:"nodeId,omitempty"`
+	BackendNodeID      DOMBackendNodeID          `json:"backendNodeId,omitempty"`
+	ObjectGroup        string                    `json:"objectGroup,omitempty"`
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.resolveNode
+func (*DOMResolveNodeParams) ProtocolMethod() string {
+	return "DOM.resolveNode"
+}
+
+// DOMResolveNodeResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-resolveNode
+type DOMResolveNodeResult struct {
+	Object *RuntimeRemoteObject `json:"object"`
+}
+
+// Do sends DOM.resolveNode and returns its result
+func (p *DOMResolveNodeParams) Do(e Executor) (*DOMResolveNodeResult, error) {
+	var result = new(DOMResolveNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMSetAttributeValueParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setAttributeValue
+type DOMSetAttributeValueParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Name   string    `json:"name"`
+	Value  string    `json:"value"`
+}
+
+// ProtocolMethod returns DOM.setAttributeValue
+func (*DOMSetAttributeValueParams) ProtocolMethod() string {
+	return "DOM.setAttributeValue"
+}
+
+// Do sends DOM.setAttributeValue
+func (p *DOMSetAttributeValueParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSetAttributesAsTextParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setAttributesAsText
+type DOMSetAttributesAsTextParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Text   string    `json:"text"`
+	Name   string    `json:"name,omitempty"`
+}
+
+// ProtocolMethod returns DOM.setAttributesAsText
+func (*DOMSetAttributesAsTextParams) ProtocolMethod() string {
+	return "DOM.setAttributesAsText"
+}
+
+// Do sends DOM.setAttributesAsText
+func (p *DOMSetAttributesAsTextParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSetFileInputFilesParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setFileInputFiles
+type DOMSetFileInputFilesParams struct {
+	Files         []string              `json:"files"`
+	NodeID        DOMNodeID             `json:"nodeId,omitempty"`
+	BackendNodeID DOMBackendNodeID      `json:"backendNodeId,omitempty"`
+	ObjectID      RuntimeRemoteObjectID `json:"objectId,omitempty"`
+}
+
+// ProtocolMethod returns DOM.setFileInputFiles
+func (*DOMSetFileInputFilesParams) ProtocolMethod() string {
+	return "DOM.setFileInputFiles"
+}
+
+// Do sends DOM.setFileInputFiles
+func (p *DOMSetFileInputFilesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSetNodeStackTracesEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeStackTracesEnabled
+type DOMSetNodeStackTracesEnabledParams struct {
+	Enable bool `json:"enable"`
+}
+
+// ProtocolMethod returns DOM.setNodeStackTracesEnabled
+func (*DOMSetNodeStackTracesEnabledParams) ProtocolMethod() string {
+	return "DOM.setNodeStackTracesEnabled"
+}
+
+// Do sends DOM.setNodeStackTracesEnabled
+func (p *DOMSetNodeStackTracesEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMGetNodeStackTracesParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodeStackTraces
+type DOMGetNodeStackTracesParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.getNodeStackTraces
+func (*DOMGetNodeStackTracesParams) ProtocolMethod() string {
+	return "DOM.getNodeStackTraces"
+}
+
+// DOMGetNodeStackTracesResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getNodeStackTraces
+type DOMGetNodeStackTracesResult struct {
+	Creation *RuntimeStackTrace `json:"creation,omitempty"`
+}
+
+// Do sends DOM.getNodeStackTraces and returns its result
+func (p *DOMGetNodeStackTracesParams) Do(e Executor) (*DOMGetNodeStackTracesResult, error) {
+	var result = new(DOMGetNodeStackTracesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetFileInfoParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFileInfo
+type DOMGetFileInfoParams struct {
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// ProtocolMethod returns DOM.getFileInfo
+func (*DOMGetFileInfoParams) ProtocolMethod() string {
+	return "DOM.getFileInfo"
+}
+
+// DOMGetFileInfoResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFileInfo
+type DOMGetFileInfoResult struct {
+	Path string `json:"path"`
+}
+
+// Do sends DOM.getFileInfo and returns its result
+func (p *DOMGetFileInfoParams) Do(e Executor) (*DOMGetFileInfoResult, error) {
+	var result = new(DOMGetFileInfoResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetDetachedDOMNodesParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getDetachedDomNodes
+type DOMGetDetachedDOMNodesParams struct {
+}
+
+// ProtocolMethod returns DOM.getDetachedDomNodes
+func (*DOMGetDetachedDOMNodesParams) ProtocolMethod() string {
+	return "DOM.getDetachedDomNodes"
+}
+
+// DOMGetDetachedDOMNodesResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getDetachedDomNodes
+type DOMGetDetachedDOMNodesResult struct {
+	DetachedNodes []*DOMDetachedElementInfo `json:"detachedNodes"`
+}
+
+// Do sends DOM.getDetachedDomNodes and returns its result
+func (p *DOMGetDetachedDOMNodesParams) Do(e Executor) (*DOMGetDetachedDOMNodesResult, error) {
+	var result = new(DOMGetDetachedDOMNodesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMSetInspectedNodeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setInspectedNode
+type DOMSetInspectedNodeParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.setInspectedNode
+func (*DOMSetInspectedNodeParams) ProtocolMethod() string {
+	return "DOM.setInspectedNode"
+}
+
+// Do sends DOM.setInspectedNode
+func (p *DOMSetInspectedNodeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSetNodeNameParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeName
+type DOMSetNodeNameParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Name   string    `json:"name"`
+}
+
+// ProtocolMethod returns DOM.setNodeName
+func (*DOMSetNodeNameParams) ProtocolMethod() string {
+	return "DOM.setNodeName"
+}
+
+// DOMSetNodeNameResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeName
+type DOMSetNodeNameResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.setNodeName and returns its result
+func (p *DOMSetNodeNameParams) Do(e Executor) (*DOMSetNodeNameResult, error) {
+	var result = new(DOMSetNodeNameResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMSetNodeValueParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setNodeValue
+type DOMSetNodeValueParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Value  string    `json:"value"`
+}
+
+// ProtocolMethod returns DOM.setNodeValue
+func (*DOMSetNodeValueParams) ProtocolMethod() string {
+	return "DOM.setNodeValue"
+}
+
+// Do sends DOM.setNodeValue
+func (p *DOMSetNodeValueParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSetOuterHTMLParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-setOuterHTML
+type DOMSetOuterHTMLParams struct {
+	NodeID    DOMNodeID `json:"nodeId"`
+	OuterHTML string    `json:"outerHTML"`
+}
+
+// ProtocolMethod returns DOM.setOuterHTML
+func (*DOMSetOuterHTMLParams) ProtocolMethod() string {
+	return "DOM.setOuterHTML"
+}
+
+// Do sends DOM.setOuterHTML
+func (p *DOMSetOuterHTMLParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMUndoParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-undo
+type DOMUndoParams struct {
+}
+
+// ProtocolMethod returns DOM.undo
+func (*DOMUndoParams) ProtocolMethod() string {
+	return "DOM.undo"
+}
+
+// Do sends DOM.undo
+func (p *DOMUndoParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMGetFrameOwnerParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFrameOwner
+type DOMGetFrameOwnerParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns DOM.getFrameOwner
+func (*DOMGetFrameOwnerParams) ProtocolMethod() string {
+	return "DOM.getFrameOwner"
+}
+
+// DOMGetFrameOwnerResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getFrameOwner
+type DOMGetFrameOwnerResult struct {
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+	NodeID        DOMNodeID        `json:"nodeId,omitempty"`
+}
+
+// Do sends DOM.getFrameOwner and returns its result
+func (p *DOMGetFrameOwnerParams) Do(e Executor) (*DOMGetFrameOwnerResult, error) {
+	var result = new(DOMGetFrameOwnerResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetContainerForNodeParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getContainerForNode
+type DOMGetContainerForNodeParams struct {
+	NodeID             DOMNodeID       `json:"nodeId"`
+	ContainerName      string          `json:"containerName,omitempty"`
+	PhysicalAxes       DOMPhysicalAxes `json:"physicalAxes,omitempty"`
+	LogicalAxes        DOMLogicalAxes  `json:"logicalAxes,omitempty"`
+	QueriesScrollState bool            `json:"queriesScrollState,omitempty"`
+	QueriesAnchored    bool            `json:"queriesAnchored,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getContainerForNode
+func (*DOMGetContainerForNodeParams) ProtocolMethod() string {
+	return "DOM.getContainerForNode"
+}
+
+// DOMGetContainerForNodeResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getContainerForNode
+type DOMGetContainerForNodeResult struct {
+	NodeID DOMNodeID `json:"nodeId,omitempty"`
+}
+
+// Do sends DOM.getContainerForNode and returns its result
+func (p *DOMGetContainerForNodeParams) Do(e Executor) (*DOMGetContainerForNodeResult, error) {
+	var result = new(DOMGetContainerForNodeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetQueryingDescendantsForContainerParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getQueryingDescendantsForContainer
+type DOMGetQueryingDescendantsForContainerParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.getQueryingDescendantsForContainer
+func (*DOMGetQueryingDescendantsForContainerParams) ProtocolMethod() string {
+	return "DOM.getQueryingDescendantsForContainer"
+}
+
+// DOMGetQueryingDescendantsForContainerResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getQueryingDescendantsForContainer
+type DOMGetQueryingDescendantsForContainerResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.getQueryingDescendantsForContainer and returns its result
+func (p *DOMGetQueryingDescendantsForContainerParams) Do(e Executor) (*DOMGetQueryingDescendantsForContainerResult, error) {
+	var result = new(DOMGetQueryingDescendantsForContainerResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMGetAnchorElementParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getAnchorElement
+type DOMGetAnchorElementParams struct {
+	NodeID          DOMNodeID `json:"nodeId"`
+	AnchorSpecifier string    `json:"anchorSpecifier,omitempty"`
+}
+
+// ProtocolMethod returns DOM.getAnchorElement
+func (*DOMGetAnchorElementParams) ProtocolMethod() string {
+	return "DOM.getAnchorElement"
+}
+
+// DOMGetAnchorElementResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-getAnchorElement
+type DOMGetAnchorElementResult struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// Do sends DOM.getAnchorElement and returns its result
+func (p *DOMGetAnchorElementParams) Do(e Executor) (*DOMGetAnchorElementResult, error) {
+	var result = new(DOMGetAnchorElementResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMForceShowPopoverParams https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-forceShowPopover
+type DOMForceShowPopoverParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Enable bool      `json:"enable"`
+}
+
+// ProtocolMethod returns DOM.forceShowPopover
+func (*DOMForceShowPopoverParams) ProtocolMethod() string {
+	return "DOM.forceShowPopover"
+}
+
+// DOMForceShowPopoverResult https://chromedevtools.github.io/devtools-protocol/tot/DOM#method-forceShowPopover
+type DOMForceShowPopoverResult struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// Do sends DOM.forceShowPopover and returns its result
+func (p *DOMForceShowPopoverParams) Do(e Executor) (*DOMForceShowPopoverResult, error) {
+	var result = new(DOMForceShowPopoverResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMAttributeModifiedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-attributeModified
+type DOMAttributeModifiedEvent struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Name   string    `json:"name"`
+	Value  string    `json:"value"`
+}
+
+// ProtocolMethod returns DOM.attributeModified
+func (*DOMAttributeModifiedEvent) ProtocolMethod() string {
+	return "DOM.attributeModified"
+}
+
+// DOMAttributeRemovedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-attributeRemoved
+type DOMAttributeRemovedEvent struct {
+	NodeID DOMNodeID `json:"nodeId"`
+	Name   string    `json:"name"`
+}
+
+// ProtocolMethod returns DOM.attributeRemoved
+func (*DOMAttributeRemovedEvent) ProtocolMethod() string {
+	return "DOM.attributeRemoved"
+}
+
+// DOMCharacterDataModifiedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-characterDataModified
+type DOMCharacterDataModifiedEvent struct {
+	NodeID        DOMNodeID `json:"nodeId"`
+	CharacterData string    `json:"characterData"`
+}
+
+// ProtocolMethod returns DOM.characterDataModified
+func (*DOMCharacterDataModifiedEvent) ProtocolMethod() string {
+	return "DOM.characterDataModified"
+}
+
+// DOMChildNodeCountUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-childNodeCountUpdated
+type DOMChildNodeCountUpdatedEvent struct {
+	NodeID         DOMNodeID `json:"nodeId"`
+	ChildNodeCount int64     `json:"childNodeCount"`
+}
+
+// ProtocolMethod returns DOM.childNodeCountUpdated
+func (*DOMChildNodeCountUpdatedEvent) ProtocolMethod() string {
+	return "DOM.childNodeCountUpdated"
+}
+
+// DOMChildNodeInsertedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-childNodeInserted
+type DOMChildNodeInsertedEvent struct {
+	ParentNodeID   DOMNodeID `json:"parentNodeId"`
+	PreviousNodeID DOMNodeID `json:"previousNodeId"`
+	Node           *DOMNode  `json:"node"`
+}
+
+// ProtocolMethod returns DOM.childNodeInserted
+func (*DOMChildNodeInsertedEvent) ProtocolMethod() string {
+	return "DOM.childNodeInserted"
+}
+
+// DOMChildNodeRemovedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-childNodeRemoved
+type DOMChildNodeRemovedEvent struct {
+	ParentNodeID DOMNodeID `json:"parentNodeId"`
+	NodeID       DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns DOM.childNodeRemoved
+func (*DOMChildNodeRemovedEvent) ProtocolMethod() string {
+	return "DOM.childNodeRemoved"
+}
+
+// DOMDistributedNodesUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-distributedNodesUpdated
+type DOMDistributedNodesUpdatedEvent struct {
+	InsertionPointID DOMNodeID         `json:"insertionPointId"`
+	DistributedNodes []*DOMBackendNode `json:"distributedNodes"`
+}
+
+// ProtocolMethod returns DOM.distributedNodesUpdated
+func (*DOMDistributedNodesUpdatedEvent) ProtocolMethod() string {
+	return "DOM.distributedNodesUpdated"
+}
+
+// DOMDocumentUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-documentUpdated
+type DOMDocumentUpdatedEvent struct {
+}
+
+// ProtocolMethod returns DOM.documentUpdated
+func (*DOMDocumentUpdatedEvent) ProtocolMethod() string {
+	return "DOM.documentUpdated"
+}
+
+// DOMInlineStyleInvalidatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-inlineStyleInvalidated
+type DOMInlineStyleInvalidatedEvent struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// ProtocolMethod returns DOM.inlineStyleInvalidated
+func (*DOMInlineStyleInvalidatedEvent) ProtocolMethod() string {
+	return "DOM.inlineStyleInvalidated"
+}
+
+// DOMPseudoElementAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-pseudoElementAdded
+type DOMPseudoElementAddedEvent struct {
+	ParentID      DOMNodeID `json:"parentId"`
+	PseudoElement *DOMNode  `json:"pseudoElement"`
+}
+
+// ProtocolMethod returns DOM.pseudoElementAdded
+func (*DOMPseudoElementAddedEvent) ProtocolMethod() string {
+	return "DOM.pseudoElementAdded"
+}
+
+// DOMTopLayerElementsUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-topLayerElementsUpdated
+type DOMTopLayerElementsUpdatedEvent struct {
+}
+
+// ProtocolMethod returns DOM.topLayerElementsUpdated
+func (*DOMTopLayerElementsUpdatedEvent) ProtocolMethod() string {
+	return "DOM.topLayerElementsUpdated"
+}
+
+// DOMScrollableFlagUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-scrollableFlagUpdated
+type DOMScrollableFlagUpdatedEvent struct {
+	NodeID       DOMNodeID `json:"nodeId"`
+	IsScrollable bool      `json:"isScrollable"`
+}
+
+// ProtocolMethod returns DOM.scrollableFlagUpdated
+func (*DOMScrollableFlagUpdatedEvent) ProtocolMethod() string {
+	return "DOM.scrollableFlagUpdated"
+}
+
+// DOMPseudoElementRemovedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-pseudoElementRemoved
+type DOMPseudoElementRemovedEvent struct {
+	ParentID        DOMNodeID `json:"parentId"`
+	PseudoElementID DOMNodeID `json:"pseudoElementId"`
+}
+
+// ProtocolMethod returns DOM.pseudoElementRemoved
+func (*DOMPseudoElementRemovedEvent) ProtocolMethod() string {
+	return "DOM.pseudoElementRemoved"
+}
+
+// DOMSetChildNodesEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-setChildNodes
+type DOMSetChildNodesEvent struct {
+	ParentID DOMNodeID  `json:"parentId"`
+	Nodes    []*DOMNode `json:"nodes"`
+}
+
+// ProtocolMethod returns DOM.setChildNodes
+func (*DOMSetChildNodesEvent) ProtocolMethod() string {
+	return "DOM.setChildNodes"
+}
+
+// DOMShadowRootPoppedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-shadowRootPopped
+type DOMShadowRootPoppedEvent struct {
+	HostID DOMNodeID `json:"hostId"`
+	RootID DOMNodeID `json:"rootId"`
+}
+
+// ProtocolMethod returns DOM.shadowRootPopped
+func (*DOMShadowRootPoppedEvent) ProtocolMethod() string {
+	return "DOM.shadowRootPopped"
+}
+
+// DOMShadowRootPushedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOM#event-shadowRootPushed
+type DOMShadowRootPushedEvent struct {
+	HostID DOMNodeID `json:"hostId"`
+	Root   *DOMNode  `json:"root"`
+}
+
+// ProtocolMethod returns DOM.shadowRootPushed
+func (*DOMShadowRootPushedEvent) ProtocolMethod() string {
+	return "DOM.shadowRootPushed"
+}
+
+// DOMEnableParamsIncludeWhitespace enum
+type DOMEnableParamsIncludeWhitespace string
+
+// DOMEnableParamsIncludeWhitespace values
+const (
+	DOMEnableParamsIncludeWhitespaceNone DOMEnableParamsIncludeWhitespace = "none"
+	DOMEnableParamsIncludeWhitespaceAll  DOMEnableParamsIncludeWhitespace = "all"
+)
+
+// DOMGetElementByRelationParamsRelation enum
+type DOMGetElementByRelationParamsRelation string
+
+// DOMGetElementByRelationParamsRelation values
+const (
+	DOMGetElementByRelationParamsRelationPopoverTarget  DOMGetElementByRelationParamsRelation = "PopoverTarget"
+	DOMGetElementByRelationParamsRelationInterestTarget DOMGetElementByRelationParamsRelation = "InterestTarget"
+	DOMGetElementByRelationParamsRelationCommandFor     DOMGetElementByRelationParamsRelation = "CommandFor"
+)
@@ -0,0 +1,171 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// DOMDebuggerDOMBreakpointType https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-DOMBreakpointType
+type DOMDebuggerDOMBreakpointType string
+
+// DOMDebuggerDOMBreakpointType values
+const (
+	DOMDebuggerDOMBreakpointTypeSubtreeModified   DOMDebuggerDOMBreakpointType = "subtree-modified"
+	DOMDebuggerDOMBreakpointTypeAttributeModified DOMDebuggerDOMBreakpointType = "attribute-modified"
+	DOMDebuggerDOMBreakpointTypeNodeRemoved       DOMDebuggerDOMBreakpointType = "node-removed"
+)
+
+// DOMDebuggerCSPViolationType https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-CSPViolationType
+type DOMDebuggerCSPViolationType string
+
+// DOMDebuggerCSPViolationType values
+const (
+	DOMDebuggerCSPViolationTypeTrustedtypeSinkViolation   DOMDebuggerCSPViolationType = "trustedtype-sink-violation"
+	DOMDebuggerCSPViolationTypeTrustedtypePolicyViolation DOMDebuggerCSPViolationType = "trustedtype-policy-violation"
+)
+
+// DOMDebuggerEventListener https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#type-EventListener
+type DOMDebuggerEventListener struct {
+	Type            string               `json:"type"`
+	UseCapture      bool                 `json:"useCapture"`
+	Passive         bool                 `json:"passive"`
+	Once            bool                 `json:"once"`
+	ScriptID        RuntimeScriptID      `json:"scriptId"`
+	LineNumber      int64                `json:"lineNumber"`
+	ColumnNumber    int64                `json:"columnNumber"`
+	Handler         *RuntimeRemoteObject `json:"handler,omitempty"`
+	OriginalHandler *RuntimeRemoteObject `json:"originalHandler,omitempty"`
+	BackendNodeID   DOMBackendNodeID     `json:"backendNodeId,omitempty"`
+}
+
+// DOMDebuggerGetEventListenersParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-getEventListeners
+type DOMDebuggerGetEventListenersParams struct {
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+	Depth    int64                 `json:"depth,omitempty"`
+	Pierce   bool                  `json:"pierce,omitempty"`
+}
+
+// ProtocolMethod returns DOMDebugger.getEventListeners
+func (*DOMDebuggerGetEventListenersParams) ProtocolMethod() string {
+	return "DOMDebugger.getEventListeners"
+}
+
+// DOMDebuggerGetEventListenersResult https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-getEventListeners
+type DOMDebuggerGetEventListenersResult struct {
+	Listeners []*DOMDebuggerEventListener `json:"listeners"`
+}
+
+// Do sends DOMDebugger.getEventListeners and returns its result
+func (p *DOMDebuggerGetEventListenersParams) Do(e Executor) (*DOMDebuggerGetEventListenersResult, error) {
+	var result = new(DOMDebuggerGetEventListenersResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMDebuggerRemoveDOMBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeDOMBreakpoint
+type DOMDebuggerRemoveDOMBreakpointParams struct {
+	NodeID DOMNodeID                    `json:"nodeId"`
+	Type   DOMDebuggerDOMBreakpointType `json:"type"`
+}
+
+// ProtocolMethod returns DOMDebugger.removeDOMBreakpoint
+func (*DOMDebuggerRemoveDOMBreakpointParams) ProtocolMethod() string {
+	return "DOMDebugger.removeDOMBreakpoint"
+}
+
+// Do sends DOMDebugger.removeDOMBreakpoint
+func (p *DOMDebuggerRemoveDOMBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDebuggerRemoveEventListenerBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeEventListenerBreakpoint
+type DOMDebuggerRemoveEventListenerBreakpointParams struct {
+	EventName  string `json:"eventName"`
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// ProtocolMethod returns DOMDebugger.removeEventListenerBreakpoint
+func (*DOMDebuggerRemoveEventListenerBreakpointParams) ProtocolMethod() string {
+	return "DOMDebugger.removeEventListenerBreakpoint"
+}
+
+// Do sends DOMDebugger.removeEventListenerBreakpoint
+func (p *DOMDebuggerRemoveEventListenerBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDebuggerRemoveXHRBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-removeXHRBreakpoint
+type DOMDebuggerRemoveXHRBreakpointParams struct {
+	URL string `json:"url"`
+}
+
+// ProtocolMethod returns DOMDebugger.removeXHRBreakpoint
+func (*DOMDebuggerRemoveXHRBreakpointParams) ProtocolMethod() string {
+	return "DOMDebugger.removeXHRBreakpoint"
+}
+
+// Do sends DOMDebugger.removeXHRBreakpoint
+func (p *DOMDebuggerRemoveXHRBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDebuggerSetBreakOnCSPViolationParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setBreakOnCSPViolation
+type DOMDebuggerSetBreakOnCSPViolationParams struct {
+	ViolationTypes []DOMDebuggerCSPViolationType `json:"violationTypes"`
+}
+
+// ProtocolMethod returns DOMDebugger.setBreakOnCSPViolation
+func (*DOMDebuggerSetBreakOnCSPViolationParams) ProtocolMethod() string {
+	return "DOMDebugger.setBreakOnCSPViolation"
+}
+
+// Do sends DOMDebugger.setBreakOnCSPViolation
+func (p *DOMDebuggerSetBreakOnCSPViolationParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDebuggerSetDOMBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setDOMBreakpoint
+type DOMDebuggerSetDOMBreakpointParams struct {
+	NodeID DOMNodeID                    `json:"nodeId"`
+	Type   DOMDebuggerDOMBreakpointType `json:"type"`
+}
+
+// ProtocolMethod returns DOMDebugger.setDOMBreakpoint
+func (*DOMDebuggerSetDOMBreakpointParams) ProtocolMethod() string {
+	return "DOMDebugger.setDOMBreakpoint"
+}
+
+// Do sends DOMDebugger.setDOMBreakpoint
+func (p *DOMDebuggerSetDOMBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDebuggerSetEventListenerBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setEventListenerBreakpoint
+type DOMDebuggerSetEventListenerBreakpointParams struct {
+	EventName  string `json:"eventName"`
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// ProtocolMethod returns DOMDebugger.setEventListenerBreakpoint
+func (*DOMDebuggerSetEventListenerBreakpointParams) ProtocolMethod() string {
+	return "DOMDebugger.setEventListenerBreakpoint"
+}
+
+// Do sends DOMDebugger.setEventListenerBreakpoint
+func (p *DOMDebuggerSetEventListenerBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMDebuggerSetXHRBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger#method-setXHRBreakpoint
+type DOMDebuggerSetXHRBreakpointParams struct {
+	URL string `json:"url"`
+}
+
+// ProtocolMethod returns DOMDebugger.setXHRBreakpoint
+func (*DOMDebuggerSetXHRBreakpointParams) ProtocolMethod() string {
+	return "DOMDebugger.setXHRBreakpoint"
+}
+
+// Do sends DOMDebugger.setXHRBreakpoint
+func (p *DOMDebuggerSetXHRBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
@@ -0,0 +1,243 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// DOMSnapshotDOMNode https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-DOMNode
+type DOMSnapshotDOMNode struct {
+	NodeType             int64                       `json:"nodeType"`
+	NodeName             string                      `json:"nodeName"`
+	NodeValue            string                      `json:"nodeValue"`
+	TextValue            string                      `json:"textValue,omitempty"`
+	InputValue           string                      `json:"inputValue,omitempty"`
+	InputChecked         bool                        `json:"inputChecked,omitempty"`
+	OptionSelected       bool                        `json:"optionSelected,omitempty"`
+	BackendNodeID        DOMBackendNodeID            `json:"backendNodeId"`
+	ChildNodeIndexes     []int64                     `json:"childNodeIndexes,omitempty"`
+	Attributes           []*DOMSnapshotNameValue     `json:"attributes,omitempty"`
+	PseudoElementIndexes []int64                     `json:"pseudoElementIndexes,omitempty"`
+	LayoutNodeIndex      int64                       `json:"layoutNodeIndex,omitempty"`
+	DocumentURL          string                      `json:"documentURL,omitempty"`
+	BaseURL              string                      `json:"baseURL,omitempty"`
+	ContentLanguage      string                      `json:"contentLanguage,omitempty"`
+	DocumentEncoding     string                      `json:"documentEncoding,omitempty"`
+	PublicID             string                      `json:"publicId,omitempty"`
+	SystemID             string                      `json:"systemId,omitempty"`
+	FrameID              PageFrameID                 `json:"frameId,omitempty"`
+	ContentDocumentIndex int64                       `json:"contentDocumentIndex,omitempty"`
+	PseudoType           DOMPseudoType               `json:"pseudoType,omitempty"`
+	ShadowRootType       DOMShadowRootType           `json:"shadowRootType,omitempty"`
+	IsClickable          bool                        `json:"isClickable,omitempty"`
+	EventListeners       []*DOMDebuggerEventListener `json:"eventListeners,omitempty"`
+	CurrentSourceURL     string                      `json:"currentSourceURL,omitempty"`
+	OriginURL            string                      `json:"originURL,omitempty"`
+	ScrollOffsetX        float64                     `json:"scrollOffsetX,omitempty"`
+	ScrollOffsetY        float64                     `json:"scrollOffsetY,omitempty"`
+}
+
+// DOMSnapshotInlineTextBox https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-InlineTextBox
+type DOMSnapshotInlineTextBox struct {
+	BoundingBox         *DOMRect `json:"boundingBox"`
+	StartCharacterIndex int64    `json:"startCharacterIndex"`
+	NumCharacters       int64    `json:"numCharacters"`
+}
+
+// DOMSnapshotLayoutTreeNode https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-LayoutTreeNode
+type DOMSnapshotLayoutTreeNode struct {
+	DOMNodeIndex      int64                       `json:"domNodeIndex"`
+	BoundingBox       *DOMRect                    `json:"boundingBox"`
+	LayoutText        string                      `json:"layoutText,omitempty"`
+	InlineTextNodes   []*DOMSnapshotInlineTextBox `json:"inlineTextNodes,omitempty"`
+	StyleIndex        int64                       `json:"styleIndex,omitempty"`
+	PaintOrder        int64                       `json:"paintOrder,omitempty"`
+	IsStackingContext bool                        `json:"isStackingContext,omitempty"`
+}
+
+// DOMSnapshotComputedStyle https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-ComputedStyle
+type DOMSnapshotComputedStyle struct {
+	Properties []*DOMSnapshotNameValue `json:"properties"`
+}
+
+// DOMSnapshotNameValue https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-NameValue
+type DOMSnapshotNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// DOMSnapshotStringIndex https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-StringIndex
+type DOMSnapshotStringIndex int64
+
+// DOMSnapshotArrayOfStrings https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-ArrayOfStrings
+type DOMSnapshotArrayOfStrings []DOMSnapshotStringIndex
+
+// DOMSnapshotRareStringData https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareStringData
+type DOMSnapshotRareStringData struct {
+	Index []int64                  `json:"index"`
+	Value []DOMSnapshotStringIndex `json:"value"`
+}
+
+// DOMSnapshotRareBooleanData https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareBooleanData
+type DOMSnapshotRareBooleanData struct {
+	Index []int64 `json:"index"`
+}
+
+// DOMSnapshotRareIntegerData https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-RareIntegerData
+type DOMSnapshotRareIntegerData struct {
+	Index []int64 `json:"index"`
+	Value []int64 `json:"value"`
+}
+
+// DOMSnapshotRectangle https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-Rectangle
+type DOMSnapshotRectangle []float64
+
+// DOMSnapshotDocumentSnapshot https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-DocumentSnapshot
+type DOMSnapshotDocumentSnapshot struct {
+	DocumentURL     DOMSnapshotStringIndex         `json:"documentURL"`
+	Title           DOMSnapshotStringIndex         `json:"title"`
+	BaseURL         DOMSnapshotStringIndex         `json:"baseURL"`
+	ContentLanguage DOMSnapshotStringIndex         `json:"contentLanguage"`
+	EncodingName    DOMSnapshotStringIndex         `json:"encodingName"`
+	PublicID        DOMSnapshotStringIndex         `json:"publicId"`
+	SystemID        DOMSnapshotStringIndex         `json:"systemId"`
+	FrameID         DOMSnapshotStringIndex         `json:"frameId"`
+	Nodes           *DOMSnapshotNodeTreeSnapshot   `json:"nodes"`
+	Layout          *DOMSnapshotLayoutTreeSnapshot `json:"layout"`
+	TextBoxes       *DOMSnapshotTextBoxSnapshot    `json:"textBoxes"`
+	ScrollOffsetX   float64                        `json:"scrollOffsetX,omitempty"`
+	ScrollOffsetY   float64                        `json:"scrollOffsetY,omitempty"`
+	ContentWidth    float64                        `json:"contentWidth,omitempty"`
+	ContentHeight   float64                        `json:"contentHeight,omitempty"`
+}
+
+// DOMSnapshotNodeTreeSnapshot https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-NodeTreeSnapshot
+type DOMSnapshotNodeTreeSnapshot struct {
+	ParentIndex          []int64                     `json:"parentIndex,omitempty"`
+	NodeType             []int64                     `json:"nodeType,omitempty"`
+	ShadowRootType       *DOMSnapshotRareStringData  `json:"shadowRootType,omitempty"`
+	NodeName             []DOMSnapshotStringIndex    `json:"nodeName,omitempty"`
+	NodeValue            []DOMSnapshotStringIndex    `json:"nodeValue,omitempty"`
+	BackendNodeID        []DOMBackendNodeID          `json:"backendNodeId,omitempty"`
+	Attributes           []DOMSnapshotArrayOfStrings `json:"attributes,omitempty"`
+	TextValue            *DOMSnapshotRareStringData  `json:"textValue,omitempty"`
+	InputValue           *DOMSnapshotRareStringData  `json:"inputValue,omitempty"`
+	InputChecked         *DOMSnapshotRareBooleanData `json:"inputChecked,omitempty"`
+	OptionSelected       *DOMSnapshotRareBooleanData `json:"optionSelected,omitempty"`
+	ContentDocumentIndex *DOMSnapshotRareIntegerData `json:"contentDocumentIndex,omitempty"`
+	PseudoType           *DOMSnapshotRareStringData  `json:"pseudoType,omitempty"`
+	PseudoIdentifier     *DOMSnapshotRareStringData  `json:"pseudoIdentifier,omitempty"`
+	IsClickable          *DOMSnapshotRareBooleanData `json:"isClickable,omitempty"`
+	CurrentSourceURL     *DOMSnapshotRareStringData  `json:"currentSourceURL,omitempty"`
+	OriginURL            *DOMSnapshotRareStringData  `json:"originURL,omitempty"`
+}
+
+// DOMSnapshotLayoutTreeSnapshot https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-LayoutTreeSnapshot
+type DOMSnapshotLayoutTreeSnapshot struct {
+	NodeIndex               []int64                     `json:"nodeIndex"`
+	Styles                  []DOMSnapshotArrayOfStrings `json:"styles"`
+	Bounds                  []DOMSnapshotRectangle      `json:"bounds"`
+	Text                    []DOMSnapshotStringIndex    `json:"text"`
+	StackingContexts        *DOMSnapshotRareBooleanData `json:"stackingContexts"`
+	PaintOrders             []int64                     `json:"paintOrders,omitempty"`
+	OffsetRects             []DOMSnapshotRectangle      `json:"offsetRects,omitempty"`
+	ScrollRects             []DOMSnapshotRectangle      `json:"scrollRects,omitempty"`
+	ClientRects             []DOMSnapshotRectangle      `json:"clientRects,omitempty"`
+	BlendedBackgroundColors []DOMSnapshotStringIndex    `json:"blendedBackgroundColors,omitempty"`
+	TextColorOpacities      []float64                   `json:"textColorOpacities,omitempty"`
+}
+
+// DOMSnapshotTextBoxSnapshot https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#type-TextBoxSnapshot
+type DOMSnapshotTextBoxSnapshot struct {
+	LayoutIndex []int64                `json:"layoutIndex"`
+	Bounds      []DOMSnapshotRectangle `json:"bounds"`
+	Start       []int64                `json:"start"`
+	Length      []int64                `json:"length"`
+}
+
+// DOMSnapshotDisableParams https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-disable
+type DOMSnapshotDisableParams struct {
+}
+
+// ProtocolMethod returns DOMSnapshot.disable
+func (*DOMSnapshotDisableParams) ProtocolMethod() string {
+	return "DOMSnapshot.disable"
+}
+
+// Do sends DOMSnapshot.disable
+func (p *DOMSnapshotDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSnapshotEnableParams https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-enable
+type DOMSnapshotEnableParams struct {
+}
+
+// ProtocolMethod returns DOMSnapshot.enable
+func (*DOMSnapshotEnableParams) ProtocolMethod() string {
+	return "DOMSnapshot.enable"
+}
+
+// Do sends DOMSnapshot.enable
+func (p *DOMSnapshotEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMSnapshotGetSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-getSnapshot
+//
+// Deprecated: deprecated in protocol
+type DOMSnapshotGetSnapshotParams struct {
+	ComputedStyleWhitelist     []string `json:"computedStyleWhitelist"`
+	IncludeEventListeners      bool     `json:"includeEventListeners,omitempty"`
+	IncludePaintOrder          bool     `json:"includePaintOrder,omitempty"`
+	IncludeUserAgentShadowTree bool     `json:"includeUserAgentShadowTree,omitempty"`
+}
+
+// ProtocolMethod returns DOMSnapshot.getSnapshot
+func (*DOMSnapshotGetSnapshotParams) ProtocolMethod() string {
+	return "DOMSnapshot.getSnapshot"
+}
+
+// DOMSnapshotGetSnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-getSnapshot
+//
+// Deprecated: deprecated in protocol
+type DOMSnapshotGetSnapshotResult struct {
+	DOMNodes        []*DOMSnapshotDOMNode        `json:"domNodes"`
+	LayoutTreeNodes []*DOMSnapshotLayoutTreeNode `json:"layoutTreeNodes"`
+	ComputedStyles  []*DOMSnapshotComputedStyle  `json:"computedStyles"`
+}
+
+// Do sends DOMSnapshot.getSnapshot and returns its result
+func (p *DOMSnapshotGetSnapshotParams) Do(e Executor) (*DOMSnapshotGetSnapshotResult, error) {
+	var result = new(DOMSnapshotGetSnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMSnapshotCaptureSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-captureSnapshot
+type DOMSnapshotCaptureSnapshotParams struct {
+	ComputedStyles                 []string `json:"computedStyles"`
+	IncludePaintOrder              bool     `json:"includePaintOrder,omitempty"`
+	IncludeDOMRects                bool     `json:"includeDOMRects,omitempty"`
+	IncludeBlendedBackgroundColors bool     `json:"includeBlendedBackgroundColors,omitempty"`
+	IncludeTextColorOpacities      bool     `json:"includeTextColorOpacities,omitempty"`
+}
+
+// ProtocolMethod returns DOMSnapshot.captureSnapshot
+func (*DOMSnapshotCaptureSnapshotParams) ProtocolMethod() string {
+	return "DOMSnapshot.captureSnapshot"
+}
+
+// DOMSnapshotCaptureSnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-captureSnapshot
+type DOMSnapshotCaptureSnapshotResult struct {
+	Documents []*DOMSnapshotDocumentSnapshot `json:"documents"`
+	Strings   []string                       `json:"strings"`
+}
+
+// Do sends DOMSnapshot.captureSnapshot and returns its result
+func (p *DOMSnapshotCaptureSnapshotParams) Do(e Executor) (*DOMSnapshotCaptureSnapshotResult, error) {
+	var result = new(DOMSnapshotCaptureSnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,170 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// DOMStorage events
+const (
+	EventDOMStorageDOMStorageItemAdded    = "DOMStorage.domStorageItemAdded"
+	EventDOMStorageDOMStorageItemRemoved  = "DOMStorage.domStorageItemRemoved"
+	EventDOMStorageDOMStorageItemUpdated  = "DOMStorage.domStorageItemUpdated"
+	EventDOMStorageDOMStorageItemsCleared = "DOMStorage.domStorageItemsCleared"
+)
+
+// DOMStorageSerializedStorageKey https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-SerializedStorageKey
+type DOMStorageSerializedStorageKey string
+
+// DOMStorageStorageID https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-StorageId
+type DOMStorageStorageID struct {
+	SecurityOrigin string                         `json:"securityOrigin,omitempty"`
+	StorageKey     DOMStorageSerializedStorageKey `json:"storageKey,omitempty"`
+	IsLocalStorage bool                           `json:"isLocalStorage"`
+}
+
+// DOMStorageItem https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#type-Item
+type DOMStorageItem []string
+
+// DOMStorageClearParams https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-clear
+type DOMStorageClearParams struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+}
+
+// ProtocolMethod returns DOMStorage.clear
+func (*DOMStorageClearParams) ProtocolMethod() string {
+	return "DOMStorage.clear"
+}
+
+// Do sends DOMStorage.clear
+func (p *DOMStorageClearParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMStorageDisableParams https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-disable
+type DOMStorageDisableParams struct {
+}
+
+// ProtocolMethod returns DOMStorage.disable
+func (*DOMStorageDisableParams) ProtocolMethod() string {
+	return "DOMStorage.disable"
+}
+
+// Do sends DOMStorage.disable
+func (p *DOMStorageDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMStorageEnableParams https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-enable
+type DOMStorageEnableParams struct {
+}
+
+// ProtocolMethod returns DOMStorage.enable
+func (*DOMStorageEnableParams) ProtocolMethod() string {
+	return "DOMStorage.enable"
+}
+
+// Do sends DOMStorage.enable
+func (p *DOMStorageEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMStorageGetDOMStorageItemsParams https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-getDOMStorageItems
+type DOMStorageGetDOMStorageItemsParams struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+}
+
+// ProtocolMethod returns DOMStorage.getDOMStorageItems
+func (*DOMStorageGetDOMStorageItemsParams) ProtocolMethod() string {
+	return "DOMStorage.getDOMStorageItems"
+}
+
+// DOMStorageGetDOMStorageItemsResult https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-getDOMStorageItems
+type DOMStorageGetDOMStorageItemsResult struct {
+	Entries []DOMStorageItem `json:"entries"`
+}
+
+// Do sends DOMStorage.getDOMStorageItems and returns its result
+func (p *DOMStorageGetDOMStorageItemsParams) Do(e Executor) (*DOMStorageGetDOMStorageItemsResult, error) {
+	var result = new(DOMStorageGetDOMStorageItemsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DOMStorageRemoveDOMStorageItemParams https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-removeDOMStorageItem
+type DOMStorageRemoveDOMStorageItemParams struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+	Key       string               `json:"key"`
+}
+
+// ProtocolMethod returns DOMStorage.removeDOMStorageItem
+func (*DOMStorageRemoveDOMStorageItemParams) ProtocolMethod() string {
+	return "DOMStorage.removeDOMStorageItem"
+}
+
+// Do sends DOMStorage.removeDOMStorageItem
+func (p *DOMStorageRemoveDOMStorageItemParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMStorageSetDOMStorageItemParams https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#method-setDOMStorageItem
+type DOMStorageSetDOMStorageItemParams struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+	Key       string               `json:"key"`
+	Value     string               `json:"value"`
+}
+
+// ProtocolMethod returns DOMStorage.setDOMStorageItem
+func (*DOMStorageSetDOMStorageItemParams) ProtocolMethod() string {
+	return "DOMStorage.setDOMStorageItem"
+}
+
+// Do sends DOMStorage.setDOMStorageItem
+func (p *DOMStorageSetDOMStorageItemParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// DOMStorageDOMStorageItemAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemAdded
+type DOMStorageDOMStorageItemAddedEvent struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+	Key       string               `json:"key"`
+	NewValue  string               `json:"newValue"`
+}
+
+// ProtocolMethod returns DOMStorage.domStorageItemAdded
+func (*DOMStorageDOMStorageItemAddedEvent) ProtocolMethod() string {
+	return "DOMStorage.domStorageItemAdded"
+}
+
+// DOMStorageDOMStorageItemRemovedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemRemoved
+type DOMStorageDOMStorageItemRemovedEvent struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+	Key       string               `json:"key"`
+}
+
+// ProtocolMethod returns DOMStorage.domStorageItemRemoved
+func (*DOMStorageDOMStorageItemRemovedEvent) ProtocolMethod() string {
+	return "DOMStorage.domStorageItemRemoved"
+}
+
+// DOMStorageDOMStorageItemUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemUpdated
+type DOMStorageDOMStorageItemUpdatedEvent struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+	Key       string               `json:"key"`
+	OldValue  string               `json:"oldValue"`
+	NewValue  string               `json:"newValue"`
+}
+
+// ProtocolMethod returns DOMStorage.domStorageItemUpdated
+func (*DOMStorageDOMStorageItemUpdatedEvent) ProtocolMethod() string {
+	return "DOMStorage.domStorageItemUpdated"
+}
+
+// DOMStorageDOMStorageItemsClearedEvent https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage#event-domStorageItemsCleared
+type DOMStorageDOMStorageItemsClearedEvent struct {
+	StorageID *DOMStorageStorageID `json:"storageId"`
+}
+
+// ProtocolMethod returns DOMStorage.domStorageItemsCleared
+func (*DOMStorageDOMStorageItemsClearedEvent) ProtocolMethod() string {
+	return "DOMStorage.domStorageItemsCleared"
+}
@@ -0,0 +1,932 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Emulation events
+const (
+	EventEmulationVirtualTimeBudgetExpired = "Emulation.virtualTimeBudgetExpired"
+)
+
+// EmulationSafeAreaInsets https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SafeAreaInsets
+type EmulationSafeAreaInsets struct {
+	Top       int64 `json:"top,omitempty"`
+	TopMax    int64 `json:"topMax,omitempty"`
+	Left      int64 `json:"left,omitempty"`
+	LeftMax   int64 `json:"leftMax,omitempty"`
+	Bottom    int64 `json:"bottom,omitempty"`
+	BottomMax int64 `json:"bottomMax,omitempty"`
+	Right     int64 `json:"right,omitempty"`
+	RightMax  int64 `json:"rightMax,omitempty"`
+}
+
+// EmulationScreenOrientation https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-ScreenOrientation
+type EmulationScreenOrientation struct {
+	Type  EmulationScreenOrientationType `json:"type"`
+	Angle int64                          `json:"angle"`
+}
+
+// EmulationDisplayFeature https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DisplayFeature
+type EmulationDisplayFeature struct {
+	Orientation EmulationDisplayFeatureOrientation `json:"orientation"`
+	Offset      int64                              `json:"offset"`
+	MaskLength  int64                              `json:"maskLength"`
+}
+
+// EmulationDevicePosture https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DevicePosture
+type EmulationDevicePosture struct {
+	Type EmulationDevicePostureType `json:"type"`
+}
+
+// EmulationMediaFeature https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-MediaFeature
+type EmulationMediaFeature struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// EmulationVirtualTimePolicy https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-VirtualTimePolicy
+type EmulationVirtualTimePolicy string
+
+// EmulationVirtualTimePolicy values
+const (
+	EmulationVirtualTimePolicyAdvance                      EmulationVirtualTimePolicy = "advance"
+	EmulationVirtualTimePolicyPause                        EmulationVirtualTimePolicy = "pause"
+	EmulationVirtualTimePolicyPauseIfNetworkFetchesPending EmulationVirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// EmulationUserAgentBrandVersion https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-UserAgentBrandVersion
+type EmulationUserAgentBrandVersion struct {
+	Brand   string `json:"brand"`
+	Version string `json:"version"`
+}
+
+// EmulationUserAgentMetadata https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-UserAgentMetadata
+type EmulationUserAgentMetadata struct {
+	Brands          []*EmulationUserAgentBrandVersion `json:"brands,omitempty"`
+	FullVersionList []*EmulationUserAgentBrandVersion `json:"fullVersionList,omitempty"`
+	// Deprecated: deprecated in protocol
+	FullVersion     string   `json:"fullVersion,omitempty"`
+	Platform        string   `json:"platform"`
+	PlatformVersion string   `json:"platformVersion"`
+	Architecture    string   `json:"architecture"`
+	Model           string   `json:"model"`
+	Mobile          bool     `json:"mobile"`
+	Bitness         string   `json:"bitness,omitempty"`
+	Wow64           bool     `json:"wow64,omitempty"`
+	FormFactors     []string `json:"formFactors,omitempty"`
+}
+
+// EmulationSensorType https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorType
+type EmulationSensorType string
+
+// EmulationSensorType values
+const (
+	EmulationSensorTypeAbsoluteOrientation EmulationSensorType = "absolute-orientation"
+	EmulationSensorTypeAccelerometer       EmulationSensorType = "accelerometer"
+	EmulationSensorTypeAmbientLight        EmulationSensorType = "ambient-light"
+	EmulationSensorTypeGravity             EmulationSensorType = "gravity"
+	EmulationSensorTypeGyroscope           EmulationSensorType = "gyroscope"
+	EmulationSensorTypeLinearAcceleration  EmulationSensorType = "linear-acceleration"
+	EmulationSensorTypeMagnetometer        EmulationSensorType = "magnetometer"
+	EmulationSensorTypeRelativeOrientation EmulationSensorType = "relative-orientation"
+)
+
+// EmulationSensorMetadata https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorMetadata
+type EmulationSensorMetadata struct {
+	Available        bool    `json:"available,omitempty"`
+	MinimumFrequency float64 `json:"minimumFrequency,omitempty"`
+	MaximumFrequency float64 `json:"maximumFrequency,omitempty"`
+}
+
+// EmulationSensorReadingSingle https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingSingle
+type EmulationSensorReadingSingle struct {
+	Value float64 `json:"value"`
+}
+
+// EmulationSensorReadingXYZ https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingXYZ
+type EmulationSensorReadingXYZ struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// EmulationSensorReadingQuaternion https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReadingQuaternion
+type EmulationSensorReadingQuaternion struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+	W float64 `json:"w"`
+}
+
+// EmulationSensorReading https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-SensorReading
+type EmulationSensorReading struct {
+	Single     *EmulationSensorReadingSingle     `json:"single,omitempty"`
+	Xyz        *EmulationSensorReadingXYZ        `json:"xyz,omitempty"`
+	Quaternion *EmulationSensorReadingQuaternion `json:"quaternion,omitempty"`
+}
+
+// EmulationPressureSource https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureSource
+type EmulationPressureSource string
+
+// EmulationPressureSource values
+const (
+	EmulationPressureSourceCPU EmulationPressureSource = "cpu"
+)
+
+// EmulationPressureState https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureState
+type EmulationPressureState string
+
+// EmulationPressureState values
+const (
+	EmulationPressureStateNominal  EmulationPressureState = "nominal"
+	EmulationPressureStateFair     EmulationPressureState = "fair"
+	EmulationPressureStateSerious  EmulationPressureState = "serious"
+	EmulationPressureStateCritical EmulationPressureState = "critical"
+)
+
+// EmulationPressureMetadata https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-PressureMetadata
+type EmulationPressureMetadata struct {
+	Available bool `json:"available,omitempty"`
+}
+
+// EmulationDisabledImageType https://chromedevtools.github.io/devtools-protocol/tot/Emulation#type-DisabledImageType
+type EmulationDisabledImageType string
+
+// EmulationDisabledImageType values
+const (
+	EmulationDisabledImageTypeAvif EmulationDisabledImageType = "avif"
+	EmulationDisabledImageTypeWebp EmulationDisabledImageType = "webp"
+)
+
+// EmulationCanEmulateParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-canEmulate
+//
+// Deprecated: deprecated in protocol
+type EmulationCanEmulateParams struct {
+}
+
+// ProtocolMethod returns Emulation.canEmulate
+func (*EmulationCanEmulateParams) ProtocolMethod() string {
+	return "Emulation.canEmulate"
+}
+
+// EmulationCanEmulateResult https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-canEmulate
+//
+// Deprecated: deprecated in protocol
+type EmulationCanEmulateResult struct {
+	Result bool `json:"result"`
+}
+
+// Do sends Emulation.canEmulate and returns its result
+func (p *EmulationCanEmulateParams) Do(e Executor) (*EmulationCanEmulateResult, error) {
+	var result = new(EmulationCanEmulateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EmulationClearDeviceMetricsOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearDeviceMetricsOverride
+type EmulationClearDeviceMetricsOverrideParams struct {
+}
+
+// ProtocolMethod returns Emulation.clearDeviceMetricsOverride
+func (*EmulationClearDeviceMetricsOverrideParams) ProtocolMethod() string {
+	return "Emulation.clearDeviceMetricsOverride"
+}
+
+// Do sends Emulation.clearDeviceMetricsOverride
+func (p *EmulationClearDeviceMetricsOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationClearGeolocationOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearGeolocationOverride
+type EmulationClearGeolocationOverrideParams struct {
+}
+
+// ProtocolMethod returns Emulation.clearGeolocationOverride
+func (*EmulationClearGeolocationOverrideParams) ProtocolMethod() string {
+	return "Emulation.clearGeolocationOverride"
+}
+
+// Do sends Emulation.clearGeolocationOverride
+func (p *EmulationClearGeolocationOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationResetPageScaleFactorParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-resetPageScaleFactor
+type EmulationResetPageScaleFactorParams struct {
+}
+
+// ProtocolMethod returns Emulation.resetPageScaleFactor
+func (*EmulationResetPageScaleFactorParams) ProtocolMethod() string {
+	return "Emulation.resetPageScaleFactor"
+}
+
+// Do sends Emulation.resetPageScaleFactor
+func (p *EmulationResetPageScaleFactorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetFocusEmulationEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setFocusEmulationEnabled
+type EmulationSetFocusEmulationEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Emulation.setFocusEmulationEnabled
+func (*EmulationSetFocusEmulationEnabledParams) ProtocolMethod() string {
+	return "Emulation.setFocusEmulationEnabled"
+}
+
+// Do sends Emulation.setFocusEmulationEnabled
+func (p *EmulationSetFocusEmulationEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetAutoDarkModeOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setAutoDarkModeOverride
+type EmulationSetAutoDarkModeOverrideParams struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setAutoDarkModeOverride
+func (*EmulationSetAutoDarkModeOverrideParams) ProtocolMethod() string {
+	return "Emulation.setAutoDarkModeOverride"
+}
+
+// Do sends Emulation.setAutoDarkModeOverride
+func (p *EmulationSetAutoDarkModeOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetCPUThrottlingRateParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setCPUThrottlingRate
+type EmulationSetCPUThrottlingRateParams struct {
+	Rate float64 `json:"rate"`
+}
+
+// ProtocolMethod returns Emulation.setCPUThrottlingRate
+func (*EmulationSetCPUThrottlingRateParams) ProtocolMethod() string {
+	return "Emulation.setCPUThrottlingRate"
+}
+
+// Do sends Emulation.setCPUThrottlingRate
+func (p *EmulationSetCPUThrottlingRateParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDefaultBackgroundColorOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDefaultBackgroundColorOverride
+type EmulationSetDefaultBackgroundColorOverrideParams struct {
+	Color *DOMRGBA `json:"color,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setDefaultBackgroundColorOverride
+func (*EmulationSetDefaultBackgroundColorOverrideParams) ProtocolMethod() string {
+	return "Emulation.setDefaultBackgroundColorOverride"
+}
+
+// Do sends Emulation.setDefaultBackgroundColorOverride
+func (p *EmulationSetDefaultBackgroundColorOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetSafeAreaInsetsOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSafeAreaInsetsOverride
+type EmulationSetSafeAreaInsetsOverrideParams struct {
+	Insets *EmulationSafeAreaInsets `json:"insets"`
+}
+
+// ProtocolMethod returns Emulation.setSafeAreaInsetsOverride
+func (*EmulationSetSafeAreaInsetsOverrideParams) ProtocolMethod() string {
+	return "Emulation.setSafeAreaInsetsOverride"
+}
+
+// Do sends Emulation.setSafeAreaInsetsOverride
+func (p *EmulationSetSafeAreaInsetsOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDeviceMetricsOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDeviceMetricsOverride
+type EmulationSetDeviceMetricsOverrideParams struct {
+	Width              int64                       `json:"width"`
+	Height             int64                       `json:"height"`
+	DeviceScaleFactor  float64                     `json:"deviceScaleFactor"`
+	Mobile             bool                        `json:"mobile"`
+	Scale              float64                     `json:"scale,omitempty"`
+	ScreenWidth        int64                       `json:"screenWidth,omitempty"`
+	ScreenHeight       int64                       `json:"screenHeight,omitempty"`
+	PositionX          int64                       `json:"positionX,omitempty"`
+	PositionY          int64                       `json:"positionY,omitempty"`
+	DontSetVisibleSize bool                        `json:"dontSetVisibleSize,omitempty"`
+	ScreenOrientation  *EmulationScreenOrientation `json:"screenOrientation,omitempty"`
+	Viewport           *PageViewport               `json:"viewport,omitempty"`
+	// Deprecated: deprecated in protocol
+	DisplayFeature *EmulationDisplayFeature `json:"displayFeature,omitempty"`
+	// Deprecated: deprecated in protocol
+	DevicePosture *EmulationDevicePosture `json:"devicePosture,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setDeviceMetricsOverride
+func (*EmulationSetDeviceMetricsOverrideParams) ProtocolMethod() string {
+	return "Emulation.setDeviceMetricsOverride"
+}
+
+// Do sends Emulation.setDeviceMetricsOverride
+func (p *EmulationSetDeviceMetricsOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDevicePostureOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDevicePostureOverride
+type EmulationSetDevicePostureOverrideParams struct {
+	Posture *EmulationDevicePosture `json:"posture"`
+}
+
+// ProtocolMethod returns Emulation.setDevicePostureOverride
+func (*EmulationSetDevicePostureOverrideParams) ProtocolMethod() string {
+	return "Emulation.setDevicePostureOverride"
+}
+
+// Do sends Emulation.setDevicePostureOverride
+func (p *EmulationSetDevicePostureOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationClearDevicePostureOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearDevicePostureOverride
+type EmulationClearDevicePostureOverrideParams struct {
+}
+
+// ProtocolMethod returns Emulation.clearDevicePostureOverride
+func (*EmulationClearDevicePostureOverrideParams) ProtocolMethod() string {
+	return "Emulation.clearDevicePostureOverride"
+}
+
+// Do sends Emulation.clearDevicePostureOverride
+func (p *EmulationClearDevicePostureOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDisplayFeaturesOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDisplayFeaturesOverride
+type EmulationSetDisplayFeaturesOverrideParams struct {
+	Features []*EmulationDisplayFeature `json:"features"`
+}
+
+// ProtocolMethod returns Emulation.setDisplayFeaturesOverride
+func (*EmulationSetDisplayFeaturesOverrideParams) ProtocolMethod() string {
+	return "Emulation.setDisplayFeaturesOverride"
+}
+
+// Do sends Emulation.setDisplayFeaturesOverride
+func (p *EmulationSetDisplayFeaturesOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationClearDisplayFeaturesOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearDisplayFeaturesOverride
+type EmulationClearDisplayFeaturesOverrideParams struct {
+}
+
+// ProtocolMethod returns Emulation.clearDisplayFeaturesOverride
+func (*EmulationClearDisplayFeaturesOverrideParams) ProtocolMethod() string {
+	return "Emulation.clearDisplayFeaturesOverride"
+}
+
+// Do sends Emulation.clearDisplayFeaturesOverride
+func (p *EmulationClearDisplayFeaturesOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetScrollbarsHiddenParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setScrollbarsHidden
+type EmulationSetScrollbarsHiddenParams struct {
+	Hidden bool `json:"hidden"`
+}
+
+// ProtocolMethod returns Emulation.setScrollbarsHidden
+func (*EmulationSetScrollbarsHiddenParams) ProtocolMethod() string {
+	return "Emulation.setScrollbarsHidden"
+}
+
+// Do sends Emulation.setScrollbarsHidden
+func (p *EmulationSetScrollbarsHiddenParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDocumentCookieDisabledParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDocumentCookieDisabled
+type EmulationSetDocumentCookieDisabledParams struct {
+	Disabled bool `json:"disabled"`
+}
+
+// ProtocolMethod returns Emulation.setDocumentCookieDisabled
+func (*EmulationSetDocumentCookieDisabledParams) ProtocolMethod() string {
+	return "Emulation.setDocumentCookieDisabled"
+}
+
+// Do sends Emulation.setDocumentCookieDisabled
+func (p *EmulationSetDocumentCookieDisabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetEmitTouchEventsForMouseParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmitTouchEventsForMouse
+type EmulationSetEmitTouchEventsForMouseParams struct {
+	Enabled       bool                                                   `json:"enabled"`
+	Configuration EmulationSetEmitTouchEventsForMouseParamsConfiguration `json:"configuration,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setEmitTouchEventsForMouse
+func (*EmulationSetEmitTouchEventsForMouseParams) ProtocolMethod() string {
+	return "Emulation.setEmitTouchEventsForMouse"
+}
+
+// Do sends Emulation.setEmitTouchEventsForMouse
+func (p *EmulationSetEmitTouchEventsForMouseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetEmulatedMediaParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmulatedMedia
+type EmulationSetEmulatedMediaParams struct {
+	Media    string                   `json:"media,omitempty"`
+	Features []*EmulationMediaFeature `json:"features,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setEmulatedMedia
+func (*EmulationSetEmulatedMediaParams) ProtocolMethod() string {
+	return "Emulation.setEmulatedMedia"
+}
+
+// Do sends Emulation.setEmulatedMedia
+func (p *EmulationSetEmulatedMediaParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetEmulatedVisionDeficiencyParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmulatedVisionDeficiency
+type EmulationSetEmulatedVisionDeficiencyParams struct {
+	Type EmulationSetEmulatedVisionDeficiencyParamsType `json:"type"`
+}
+
+// ProtocolMethod returns Emulation.setEmulatedVisionDeficiency
+func (*EmulationSetEmulatedVisionDeficiencyParams) ProtocolMethod() string {
+	return "Emulation.setEmulatedVisionDeficiency"
+}
+
+// Do sends Emulation.setEmulatedVisionDeficiency
+func (p *EmulationSetEmulatedVisionDeficiencyParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetEmulatedOSTextScaleParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setEmulatedOSTextScale
+type EmulationSetEmulatedOSTextScaleParams struct {
+	Scale float64 `json:"scale,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setEmulatedOSTextScale
+func (*EmulationSetEmulatedOSTextScaleParams) ProtocolMethod() string {
+	return "Emulation.setEmulatedOSTextScale"
+}
+
+// Do sends Emulation.setEmulatedOSTextScale
+func (p *EmulationSetEmulatedOSTextScaleParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetGeolocationOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setGeolocationOverride
+type EmulationSetGeolocationOverrideParams struct {
+	Latitude         float64 `json:"latitude,omitempty"`
+	Longitude        float64 `json:"longitude,omitempty"`
+	Accuracy         float64 `json:"accuracy,omitempty"`
+	Altitude         float64 `json:"altitude,omitempty"`
+	AltitudeAccuracy float64 `json:"altitudeAccuracy,omitempty"`
+	Heading          float64 `json:"heading,omitempty"`
+	Speed            float64 `json:"speed,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setGeolocationOverride
+func (*EmulationSetGeolocationOverrideParams) ProtocolMethod() string {
+	return "Emulation.setGeolocationOverride"
+}
+
+// Do sends Emulation.setGeolocationOverride
+func (p *EmulationSetGeolocationOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationGetOverriddenSensorInformationParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-getOverriddenSensorInformation
+type EmulationGetOverriddenSensorInformationParams struct {
+	Type EmulationSensorType `json:"type"`
+}
+
+// ProtocolMethod returns Emulation.getOverriddenSensorInformation
+func (*EmulationGetOverriddenSensorInformationParams) ProtocolMethod() string {
+	return "Emulation.getOverriddenSensorInformation"
+}
+
+// EmulationGetOverriddenSensorInformationResult https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-getOverriddenSensorInformation
+type EmulationGetOverriddenSensorInformationResult struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"`
+}
+
+// Do sends Emulation.getOverriddenSensorInformation and returns its result
+func (p *EmulationGetOverriddenSensorInformationParams) Do(e Executor) (*EmulationGetOverriddenSensorInformationResult, error) {
+	var result = new(EmulationGetOverriddenSensorInformationResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EmulationSetSensorOverrideEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSensorOverrideEnabled
+type EmulationSetSensorOverrideEnabledParams struct {
+	Enabled  bool                     `json:"enabled"`
+	Type     EmulationSensorType      `json:"type"`
+	Metadata *EmulationSensorMetadata `json:"metadata,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setSensorOverrideEnabled
+func (*EmulationSetSensorOverrideEnabledParams) ProtocolMethod() string {
+	return "Emulation.setSensorOverrideEnabled"
+}
+
+// Do sends Emulation.setSensorOverrideEnabled
+func (p *EmulationSetSensorOverrideEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetSensorOverrideReadingsParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSensorOverrideReadings
+type EmulationSetSensorOverrideReadingsParams struct {
+	Type    EmulationSensorType     `json:"type"`
+	Reading *EmulationSensorReading `json:"reading"`
+}
+
+// ProtocolMethod returns Emulation.setSensorOverrideReadings
+func (*EmulationSetSensorOverrideReadingsParams) ProtocolMethod() string {
+	return "Emulation.setSensorOverrideReadings"
+}
+
+// Do sends Emulation.setSensorOverrideReadings
+func (p *EmulationSetSensorOverrideReadingsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetPressureSourceOverrideEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPressureSourceOverrideEnabled
+type EmulationSetPressureSourceOverrideEnabledParams struct {
+	Enabled  bool                       `json:"enabled"`
+	Source   EmulationPressureSource    `json:"source"`
+	Metadata *EmulationPressureMetadata `json:"metadata,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setPressureSourceOverrideEnabled
+func (*EmulationSetPressureSourceOverrideEnabledParams) ProtocolMethod() string {
+	return "Emulation.setPressureSourceOverrideEnabled"
+}
+
+// Do sends Emulation.setPressureSourceOverrideEnabled
+func (p *EmulationSetPressureSourceOverrideEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetPressureStateOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPressureStateOverride
+type EmulationSetPressureStateOverrideParams struct {
+	Source EmulationPressureSource `json:"source"`
+	State  EmulationPressureState  `json:"state"`
+}
+
+// ProtocolMethod returns Emulation.setPressureStateOverride
+func (*EmulationSetPressureStateOverrideParams) ProtocolMethod() string {
+	return "Emulation.setPressureStateOverride"
+}
+
+// Do sends Emulation.setPressureStateOverride
+func (p *EmulationSetPressureStateOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetPressureDataOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPressureDataOverride
+type EmulationSetPressureDataOverrideParams struct {
+	Source                  EmulationPressureSource `json:"source"`
+	State                   EmulationPressureState  `json:"state"`
+	OwnContributionEstimate float64                 `json:"ownContributionEstimate,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setPressureDataOverride
+func (*EmulationSetPressureDataOverrideParams) ProtocolMethod() string {
+	return "Emulation.setPressureDataOverride"
+}
+
+// Do sends Emulation.setPressureDataOverride
+func (p *EmulationSetPressureDataOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetIdleOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setIdleOverride
+type EmulationSetIdleOverrideParams struct {
+	IsUserActive     bool `json:"isUserActive"`
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+// ProtocolMethod returns Emulation.setIdleOverride
+func (*EmulationSetIdleOverrideParams) ProtocolMethod() string {
+	return "Emulation.setIdleOverride"
+}
+
+// Do sends Emulation.setIdleOverride
+func (p *EmulationSetIdleOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationClearIdleOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-clearIdleOverride
+type EmulationClearIdleOverrideParams struct {
+}
+
+// ProtocolMethod returns Emulation.clearIdleOverride
+func (*EmulationClearIdleOverrideParams) ProtocolMethod() string {
+	return "Emulation.clearIdleOverride"
+}
+
+// Do sends Emulation.clearIdleOverride
+func (p *EmulationClearIdleOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetNavigatorOverridesParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setNavigatorOverrides
+//
+// Deprecated: deprecated in protocol
+type EmulationSetNavigatorOverridesParams struct {
+	Platform string `json:"platform"`
+}
+
+// ProtocolMethod returns Emulation.setNavigatorOverrides
+func (*EmulationSetNavigatorOverridesParams) ProtocolMethod() string {
+	return "Emulation.setNavigatorOverrides"
+}
+
+// Do sends Emulation.setNavigatorOverrides
+func (p *EmulationSetNavigatorOverridesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetPageScaleFactorParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setPageScaleFactor
+type EmulationSetPageScaleFactorParams struct {
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+}
+
+// ProtocolMethod returns Emulation.setPageScaleFactor
+func (*EmulationSetPageScaleFactorParams) ProtocolMethod() string {
+	return "Emulation.setPageScaleFactor"
+}
+
+// Do sends Emulation.setPageScaleFactor
+func (p *EmulationSetPageScaleFactorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetScriptExecutionDisabledParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setScriptExecutionDisabled
+type EmulationSetScriptExecutionDisabledParams struct {
+	Value bool `json:"value"`
+}
+
+// ProtocolMethod returns Emulation.setScriptExecutionDisabled
+func (*EmulationSetScriptExecutionDisabledParams) ProtocolMethod() string {
+	return "Emulation.setScriptExecutionDisabled"
+}
+
+// Do sends Emulation.setScriptExecutionDisabled
+func (p *EmulationSetScriptExecutionDisabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetTouchEmulationEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setTouchEmulationEnabled
+type EmulationSetTouchEmulationEnabledParams struct {
+	Enabled        bool  `json:"enabled"`
+	MaxTouchPoints int64 `json:"maxTouchPoints,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setTouchEmulationEnabled
+func (*EmulationSetTouchEmulationEnabledParams) ProtocolMethod() string {
+	return "Emulation.setTouchEmulationEnabled"
+}
+
+// Do sends Emulation.setTouchEmulationEnabled
+func (p *EmulationSetTouchEmulationEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetVirtualTimePolicyParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setVirtualTimePolicy
+type EmulationSetVirtualTimePolicyParams struct {
+	Policy                            EmulationVirtualTimePolicy `json:"policy"`
+	Budget                            float64                    `json:"budget,omitempty"`
+	MaxVirtualTimeTaskStarvationCount int64                      `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
+	InitialVirtualTime                NetworkTimeSinceEpoch      `json:"initialVirtualTime,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setVirtualTimePolicy
+func (*EmulationSetVirtualTimePolicyParams) ProtocolMethod() string {
+	return "Emulation.setVirtualTimePolicy"
+}
+
+// EmulationSetVirtualTimePolicyResult https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setVirtualTimePolicy
+type EmulationSetVirtualTimePolicyResult struct {
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"`
+}
+
+// Do sends Emulation.setVirtualTimePolicy and returns its result
+func (p *EmulationSetVirtualTimePolicyParams) Do(e Executor) (*EmulationSetVirtualTimePolicyResult, error) {
+	var result = new(EmulationSetVirtualTimePolicyResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EmulationSetLocaleOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setLocaleOverride
+type EmulationSetLocaleOverrideParams struct {
+	Locale string `json:"locale,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setLocaleOverride
+func (*EmulationSetLocaleOverrideParams) ProtocolMethod() string {
+	return "Emulation.setLocaleOverride"
+}
+
+// Do sends Emulation.setLocaleOverride
+func (p *EmulationSetLocaleOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetTimezoneOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setTimezoneOverride
+type EmulationSetTimezoneOverrideParams struct {
+	TimezoneID string `json:"timezoneId"`
+}
+
+// ProtocolMethod returns Emulation.setTimezoneOverride
+func (*EmulationSetTimezoneOverrideParams) ProtocolMethod() string {
+	return "Emulation.setTimezoneOverride"
+}
+
+// Do sends Emulation.setTimezoneOverride
+func (p *EmulationSetTimezoneOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetVisibleSizeParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setVisibleSize
+//
+// Deprecated: deprecated in protocol
+type EmulationSetVisibleSizeParams struct {
+	Width  int64 `json:"width"`
+	Height int64 `json:"height"`
+}
+
+// ProtocolMethod returns Emulation.setVisibleSize
+func (*EmulationSetVisibleSizeParams) ProtocolMethod() string {
+	return "Emulation.setVisibleSize"
+}
+
+// Do sends Emulation.setVisibleSize
+func (p *EmulationSetVisibleSizeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDisabledImageTypesParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDisabledImageTypes
+type EmulationSetDisabledImageTypesParams struct {
+	ImageTypes []EmulationDisabledImageType `json:"imageTypes"`
+}
+
+// ProtocolMethod returns Emulation.setDisabledImageTypes
+func (*EmulationSetDisabledImageTypesParams) ProtocolMethod() string {
+	return "Emulation.setDisabledImageTypes"
+}
+
+// Do sends Emulation.setDisabledImageTypes
+func (p *EmulationSetDisabledImageTypesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetDataSaverOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setDataSaverOverride
+type EmulationSetDataSaverOverrideParams struct {
+	DataSaverEnabled bool `json:"dataSaverEnabled,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setDataSaverOverride
+func (*EmulationSetDataSaverOverrideParams) ProtocolMethod() string {
+	return "Emulation.setDataSaverOverride"
+}
+
+// Do sends Emulation.setDataSaverOverride
+func (p *EmulationSetDataSaverOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetHardwareConcurrencyOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setHardwareConcurrencyOverride
+type EmulationSetHardwareConcurrencyOverrideParams struct {
+	HardwareConcurrency int64 `json:"hardwareConcurrency"`
+}
+
+// ProtocolMethod returns Emulation.setHardwareConcurrencyOverride
+func (*EmulationSetHardwareConcurrencyOverrideParams) ProtocolMethod() string {
+	return "Emulation.setHardwareConcurrencyOverride"
+}
+
+// Do sends Emulation.setHardwareConcurrencyOverride
+func (p *EmulationSetHardwareConcurrencyOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetUserAgentOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setUserAgentOverride
+type EmulationSetUserAgentOverrideParams struct {
+	UserAgent         string                      `json:"userAgent"`
+	AcceptLanguage    string                      `json:"acceptLanguage,omitempty"`
+	Platform          string                      `json:"platform,omitempty"`
+	UserAgentMetadata *EmulationUserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// ProtocolMethod returns Emulation.setUserAgentOverride
+func (*EmulationSetUserAgentOverrideParams) ProtocolMethod() string {
+	return "Emulation.setUserAgentOverride"
+}
+
+// Do sends Emulation.setUserAgentOverride
+func (p *EmulationSetUserAgentOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetAutomationOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setAutomationOverride
+type EmulationSetAutomationOverrideParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Emulation.setAutomationOverride
+func (*EmulationSetAutomationOverrideParams) ProtocolMethod() string {
+	return "Emulation.setAutomationOverride"
+}
+
+// Do sends Emulation.setAutomationOverride
+func (p *EmulationSetAutomationOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationSetSmallViewportHeightDifferenceOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Emulation#method-setSmallViewportHeightDifferenceOverride
+type EmulationSetSmallViewportHeightDifferenceOverrideParams struct {
+	Difference int64 `json:"difference"`
+}
+
+// ProtocolMethod returns Emulation.setSmallViewportHeightDifferenceOverride
+func (*EmulationSetSmallViewportHeightDifferenceOverrideParams) ProtocolMethod() string {
+	return "Emulation.setSmallViewportHeightDifferenceOverride"
+}
+
+// Do sends Emulation.setSmallViewportHeightDifferenceOverride
+func (p *EmulationSetSmallViewportHeightDifferenceOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EmulationVirtualTimeBudgetExpiredEvent https://chromedevtools.github.io/devtools-protocol/tot/Emulation#event-virtualTimeBudgetExpired
+type EmulationVirtualTimeBudgetExpiredEvent struct {
+}
+
+// ProtocolMethod returns Emulation.virtualTimeBudgetExpired
+func (*EmulationVirtualTimeBudgetExpiredEvent) ProtocolMethod() string {
+	return "Emulation.virtualTimeBudgetExpired"
+}
+
+// EmulationScreenOrientationType enum
+type EmulationScreenOrientationType string
+
+// EmulationScreenOrientationType values
+const (
+	EmulationScreenOrientationTypePortraitPrimary    EmulationScreenOrientationType = "portraitPrimary"
+	EmulationScreenOrientationTypePortraitSecondary  EmulationScreenOrientationType = "portraitSecondary"
+	EmulationScreenOrientationTypeLandscapePrimary   EmulationScreenOrientationType = "landscapePrimary"
+	EmulationScreenOrientationTypeLandscapeSecondary EmulationScreenOrientationType = "landscapeSecondary"
+)
+
+// EmulationDisplayFeatureOrientation enum
+type EmulationDisplayFeatureOrientation string
+
+// EmulationDisplayFeatureOrientation values
+const (
+	EmulationDisplayFeatureOrientationVertical   EmulationDisplayFeatureOrientation = "vertical"
+	EmulationDisplayFeatureOrientationHorizontal EmulationDisplayFeatureOrientation = "horizontal"
+)
+
+// EmulationDevicePostureType enum
+type EmulationDevicePostureType string
+
+// EmulationDevicePostureType values
+const (
+	EmulationDevicePostureTypeContinuous EmulationDevicePostureType = "continuous"
+	EmulationDevicePostureTypeFolded     EmulationDevicePostureType = "folded"
+)
+
+// EmulationSetEmitTouchEventsForMouseParamsConfiguration enum
+type EmulationSetEmitTouchEventsForMouseParamsConfiguration string
+
+// EmulationSetEmitTouchEventsForMouseParamsConfiguration values
+const (
+	EmulationSetEmitTouchEventsForMouseParamsConfigurationMobile  EmulationSetEmitTouchEventsForMouseParamsConfiguration = "mobile"
+	EmulationSetEmitTouchEventsForMouseParamsConfigurationDesktop EmulationSetEmitTouchEventsForMouseParamsConfiguration = "desktop"
+)
+
+// EmulationSetEmulatedVisionDeficiencyParamsType enum
+type EmulationSetEmulatedVisionDeficiencyParamsType string
+
+// EmulationSetEmulatedVisionDeficiencyParamsType values
+const (
+	EmulationSetEmulatedVisionDeficiencyParamsTypeNone            EmulationSetEmulatedVisionDeficiencyParamsType = "none"
+	EmulationSetEmulatedVisionDeficiencyParamsTypeBlurredVision   EmulationSetEmulatedVisionDeficiencyParamsType = "blurredVision"
+	EmulationSetEmulatedVisionDeficiencyParamsTypeReducedContrast EmulationSetEmulatedVisionDeficiencyParamsType = "reducedContrast"
+	EmulationSetEmulatedVisionDeficiencyParamsTypeAchromatopsia   EmulationSetEmulatedVisionDeficiencyParamsType = "achromatopsia"
+	EmulationSetEmulatedVisionDeficiencyParamsTypeDeuteranopia    EmulationSetEmulatedVisionDeficiencyParamsType = "deuteranopia"
+	EmulationSetEmulatedVisionDeficiencyParamsTypeProtanopia      EmulationSetEmulatedVisionDeficiencyParamsType = "protanopia"
+	EmulationSetEmulatedVisionDeficiencyParamsTypeTritanopia      EmulationSetEmulatedVisionDeficiencyParamsType = "tritanopia"
+)
@@ -0,0 +1,222 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+var eventTypes = map[string]func() interface{}{
+	EventAccessibilityLoadComplete:                             func() interface{} { return new(AccessibilityLoadCompleteEvent) },
+	EventAccessibilityNodesUpdated:                             func() interface{} { return new(AccessibilityNodesUpdatedEvent) },
+	EventAnimationAnimationCanceled:                            func() interface{} { return new(AnimationAnimationCanceledEvent) },
+	EventAnimationAnimationCreated:                             func() interface{} { return new(AnimationAnimationCreatedEvent) },
+	EventAnimationAnimationStarted:                             func() interface{} { return new(AnimationAnimationStartedEvent) },
+	EventAnimationAnimationUpdated:                             func() interface{} { return new(AnimationAnimationUpdatedEvent) },
+	EventAuditsIssueAdded:                                      func() interface{} { return new(AuditsIssueAddedEvent) },
+	EventAutofillAddressFormFilled:                             func() interface{} { return new(AutofillAddressFormFilledEvent) },
+	EventBackgroundServiceRecordingStateChanged:                func() interface{} { return new(BackgroundServiceRecordingStateChangedEvent) },
+	EventBackgroundServiceBackgroundServiceEventReceived:       func() interface{} { return new(BackgroundServiceBackgroundServiceEventReceivedEvent) },
+	EventBluetoothEmulationGattOperationReceived:               func() interface{} { return new(BluetoothEmulationGattOperationReceivedEvent) },
+	EventBluetoothEmulationCharacteristicOperationReceived:     func() interface{} { return new(BluetoothEmulationCharacteristicOperationReceivedEvent) },
+	EventBluetoothEmulationDescriptorOperationReceived:         func() interface{} { return new(BluetoothEmulationDescriptorOperationReceivedEvent) },
+	EventBrowserDownloadWillBegin:                              func() interface{} { return new(BrowserDownloadWillBeginEvent) },
+	EventBrowserDownloadProgress:                               func() interface{} { return new(BrowserDownloadProgressEvent) },
+	EventCSSFontsUpdated:                                       func() interface{} { return new(CSSFontsUpdatedEvent) },
+	EventCSSMediaQueryResultChanged:                            func() interface{} { return new(CSSMediaQueryResultChangedEvent) },
+	EventCSSStyleSheetAdded:                                    func() interface{} { return new(CSSStyleSheetAddedEvent) },
+	EventCSSStyleSheetChanged:                                  func() interface{} { return new(CSSStyleSheetChangedEvent) },
+	EventCSSStyleSheetRemoved:                                  func() interface{} { return new(CSSStyleSheetRemovedEvent) },
+	EventCSSComputedStyleUpdated:                               func() interface{} { return new(CSSComputedStyleUpdatedEvent) },
+	EventCastSinksUpdated:                                      func() interface{} { return new(CastSinksUpdatedEvent) },
+	EventCastIssueUpdated:                                      func() interface{} { return new(CastIssueUpdatedEvent) },
+	EventConsoleMessageAdded:                                   func() interface{} { return new(ConsoleMessageAddedEvent) },
+	EventDOMAttributeModified:                                  func() interface{} { return new(DOMAttributeModifiedEvent) },
+	EventDOMAttributeRemoved:                                   func() interface{} { return new(DOMAttributeRemovedEvent) },
+	EventDOMCharacterDataModified:                              func() interface{} { return new(DOMCharacterDataModifiedEvent) },
+	EventDOMChildNodeCountUpdated:                              func() interface{} { return new(DOMChildNodeCountUpdatedEvent) },
+	EventDOMChildNodeInserted:                                  func() interface{} { return new(DOMChildNodeInsertedEvent) },
+	EventDOMChildNodeRemoved:                                   func() interface{} { return new(DOMChildNodeRemovedEvent) },
+	EventDOMDistributedNodesUpdated:                            func() interface{} { return new(DOMDistributedNodesUpdatedEvent) },
+	EventDOMDocumentUpdated:                                    func() interface{} { return new(DOMDocumentUpdatedEvent) },
+	EventDOMInlineStyleInvalidated:                             func() interface{} { return new(DOMInlineStyleInvalidatedEvent) },
+	EventDOMPseudoElementAdded:                                 func() interface{} { return new(DOMPseudoElementAddedEvent) },
+	EventDOMTopLayerElementsUpdated:                            func() interface{} { return new(DOMTopLayerElementsUpdatedEvent) },
+	EventDOMScrollableFlagUpdated:                              func() interface{} { return new(DOMScrollableFlagUpdatedEvent) },
+	EventDOMPseudoElementRemoved:                               func() interface{} { return new(DOMPseudoElementRemovedEvent) },
+	EventDOMSetChildNodes:                                      func() interface{} { return new(DOMSetChildNodesEvent) },
+	EventDOMShadowRootPopped:                                   func() interface{} { return new(DOMShadowRootPoppedEvent) },
+	EventDOMShadowRootPushed:                                   func() interface{} { return new(DOMShadowRootPushedEvent) },
+	EventDOMStorageDOMStorageItemAdded:                         func() interface{} { return new(DOMStorageDOMStorageItemAddedEvent) },
+	EventDOMStorageDOMStorageItemRemoved:                       func() interface{} { return new(DOMStorageDOMStorageItemRemovedEvent) },
+	EventDOMStorageDOMStorageItemUpdated:                       func() interface{} { return new(DOMStorageDOMStorageItemUpdatedEvent) },
+	EventDOMStorageDOMStorageItemsCleared:                      func() interface{} { return new(DOMStorageDOMStorageItemsClearedEvent) },
+	EventDebuggerBreakpointResolved:                            func() interface{} { return new(DebuggerBreakpointResolvedEvent) },
+	EventDebuggerPaused:                                        func() interface{} { return new(DebuggerPausedEvent) },
+	EventDebuggerResumed:                                       func() interface{} { return new(DebuggerResumedEvent) },
+	EventDebuggerScriptFailedToParse:                           func() interface{} { return new(DebuggerScriptFailedToParseEvent) },
+	EventDebuggerScriptParsed:                                  func() interface{} { return new(DebuggerScriptParsedEvent) },
+	EventDeviceAccessDeviceRequestPrompted:                     func() interface{} { return new(DeviceAccessDeviceRequestPromptedEvent) },
+	EventEmulationVirtualTimeBudgetExpired:                     func() interface{} { return new(EmulationVirtualTimeBudgetExpiredEvent) },
+	EventFedCmDialogShown:                                      func() interface{} { return new(FedCmDialogShownEvent) },
+	EventFedCmDialogClosed:                                     func() interface{} { return new(FedCmDialogClosedEvent) },
+	EventFetchRequestPaused:                                    func() interface{} { return new(FetchRequestPausedEvent) },
+	EventFetchAuthRequired:                                     func() interface{} { return new(FetchAuthRequiredEvent) },
+	EventHeapProfilerAddHeapSnapshotChunk:                      func() interface{} { return new(HeapProfilerAddHeapSnapshotChunkEvent) },
+	EventHeapProfilerHeapStatsUpdate:                           func() interface{} { return new(HeapProfilerHeapStatsUpdateEvent) },
+	EventHeapProfilerLastSeenObjectID:                          func() interface{} { return new(HeapProfilerLastSeenObjectIDEvent) },
+	EventHeapProfilerReportHeapSnapshotProgress:                func() interface{} { return new(HeapProfilerReportHeapSnapshotProgressEvent) },
+	EventHeapProfilerResetProfiles:                             func() interface{} { return new(HeapProfilerResetProfilesEvent) },
+	EventInputDragIntercepted:                                  func() interface{} { return new(InputDragInterceptedEvent) },
+	EventInspectorDetached:                                     func() interface{} { return new(InspectorDetachedEvent) },
+	EventInspectorTargetCrashed:                                func() interface{} { return new(InspectorTargetCrashedEvent) },
+	EventInspectorTargetReloadedAfterCrash:                     func() interface{} { return new(InspectorTargetReloadedAfterCrashEvent) },
+	EventLayerTreeLayerPainted:                                 func() interface{} { return new(LayerTreeLayerPaintedEvent) },
+	EventLayerTreeLayerTreeDidChange:                           func() interface{} { return new(LayerTreeLayerTreeDidChangeEvent) },
+	EventLogEntryAdded:                                         func() interface{} { return new(LogEntryAddedEvent) },
+	EventMediaPlayerPropertiesChanged:                          func() interface{} { return new(MediaPlayerPropertiesChangedEvent) },
+	EventMediaPlayerEventsAdded:                                func() interface{} { return new(MediaPlayerEventsAddedEvent) },
+	EventMediaPlayerMessagesLogged:                             func() interface{} { return new(MediaPlayerMessagesLoggedEvent) },
+	EventMediaPlayerErrorsRaised:                               func() interface{} { return new(MediaPlayerErrorsRaisedEvent) },
+	EventMediaPlayersCreated:                                   func() interface{} { return new(MediaPlayersCreatedEvent) },
+	EventNetworkDataReceived:                                   func() interface{} { return new(NetworkDataReceivedEvent) },
+	EventNetworkEventSourceMessageReceived:                     func() interface{} { return new(NetworkEventSourceMessageReceivedEvent) },
+	EventNetworkLoadingFailed:                                  func() interface{} { return new(NetworkLoadingFailedEvent) },
+	EventNetworkLoadingFinished:                                func() interface{} { return new(NetworkLoadingFinishedEvent) },
+	EventNetworkRequestIntercepted:                             func() interface{} { return new(NetworkRequestInterceptedEvent) },
+	EventNetworkRequestServedFromCache:                         func() interface{} { return new(NetworkRequestServedFromCacheEvent) },
+	EventNetworkRequestWillBeSent:                              func() interface{} { return new(NetworkRequestWillBeSentEvent) },
+	EventNetworkResourceChangedPriority:                        func() interface{} { return new(NetworkResourceChangedPriorityEvent) },
+	EventNetworkSignedExchangeReceived:                         func() interface{} { return new(NetworkSignedExchangeReceivedEvent) },
+	EventNetworkResponseReceived:                               func() interface{} { return new(NetworkResponseReceivedEvent) },
+	EventNetworkWebSocketClosed:                                func() interface{} { return new(NetworkWebSocketClosedEvent) },
+	EventNetworkWebSocketCreated:                               func() interface{} { return new(NetworkWebSocketCreatedEvent) },
+	EventNetworkWebSocketFrameError:                            func() interface{} { return new(NetworkWebSocketFrameErrorEvent) },
+	EventNetworkWebSocketFrameReceived:                         func() interface{} { return new(NetworkWebSocketFrameReceivedEvent) },
+	EventNetworkWebSocketFrameSent:                             func() interface{} { return new(NetworkWebSocketFrameSentEvent) },
+	EventNetworkWebSocketHandshakeResponseReceived:             func() interface{} { return new(NetworkWebSocketHandshakeResponseReceivedEvent) },
+	EventNetworkWebSocketWillSendHandshakeRequest:              func() interface{} { return new(NetworkWebSocketWillSendHandshakeRequestEvent) },
+	EventNetworkWebTransportCreated:                            func() interface{} { return new(NetworkWebTransportCreatedEvent) },
+	EventNetworkWebTransportConnectionEstablished:              func() interface{} { return new(NetworkWebTransportConnectionEstablishedEvent) },
+	EventNetworkWebTransportClosed:                             func() interface{} { return new(NetworkWebTransportClosedEvent) },
+	EventNetworkDirectTCPSocketCreated:                         func() interface{} { return new(NetworkDirectTCPSocketCreatedEvent) },
+	EventNetworkDirectTCPSocketOpened:                          func() interface{} { return new(NetworkDirectTCPSocketOpenedEvent) },
+	EventNetworkDirectTCPSocketAborted:                         func() interface{} { return new(NetworkDirectTCPSocketAbortedEvent) },
+	EventNetworkDirectTCPSocketClosed:                          func() interface{} { return new(NetworkDirectTCPSocketClosedEvent) },
+	EventNetworkDirectTCPSocketChunkSent:                       func() interface{} { return new(NetworkDirectTCPSocketChunkSentEvent) },
+	EventNetworkDirectTCPSocketChunkReceived:                   func() interface{} { return new(NetworkDirectTCPSocketChunkReceivedEvent) },
+	EventNetworkDirectUDPSocketCreated:                         func() interface{} { return new(NetworkDirectUDPSocketCreatedEvent) },
+	EventNetworkDirectUDPSocketOpened:                          func() interface{} { return new(NetworkDirectUDPSocketOpenedEvent) },
+	EventNetworkDirectUDPSocketAborted:                         func() interface{} { return new(NetworkDirectUDPSocketAbortedEvent) },
+	EventNetworkDirectUDPSocketClosed:                          func() interface{} { return new(NetworkDirectUDPSocketClosedEvent) },
+	EventNetworkDirectUDPSocketChunkSent:                       func() interface{} { return new(NetworkDirectUDPSocketChunkSentEvent) },
+	EventNetworkDirectUDPSocketChunkReceived:                   func() interface{} { return new(NetworkDirectUDPSocketChunkReceivedEvent) },
+	EventNetworkRequestWillBeSentExtraInfo:                     func() interface{} { return new(NetworkRequestWillBeSentExtraInfoEvent) },
+	EventNetworkResponseReceivedExtraInfo:                      func() interface{} { return new(NetworkResponseReceivedExtraInfoEvent) },
+	EventNetworkResponseReceivedEarlyHints:                     func() interface{} { return new(NetworkResponseReceivedEarlyHintsEvent) },
+	EventNetworkTrustTokenOperationDone:                        func() interface{} { return new(NetworkTrustTokenOperationDoneEvent) },
+	EventNetworkPolicyUpdated:                                  func() interface{} { return new(NetworkPolicyUpdatedEvent) },
+	EventNetworkSubresourceWebBundleMetadataReceived:           func() interface{} { return new(NetworkSubresourceWebBundleMetadataReceivedEvent) },
+	EventNetworkSubresourceWebBundleMetadataError:              func() interface{} { return new(NetworkSubresourceWebBundleMetadataErrorEvent) },
+	EventNetworkSubresourceWebBundleInnerResponseParsed:        func() interface{} { return new(NetworkSubresourceWebBundleInnerResponseParsedEvent) },
+	EventNetworkSubresourceWebBundleInnerResponseError:         func() interface{} { return new(NetworkSubresourceWebBundleInnerResponseErrorEvent) },
+	EventNetworkReportingAPIReportAdded:                        func() interface{} { return new(NetworkReportingAPIReportAddedEvent) },
+	EventNetworkReportingAPIReportUpdated:                      func() interface{} { return new(NetworkReportingAPIReportUpdatedEvent) },
+	EventNetworkReportingAPIEndpointsChangedForOrigin:          func() interface{} { return new(NetworkReportingAPIEndpointsChangedForOriginEvent) },
+	EventOverlayInspectNodeRequested:                           func() interface{} { return new(OverlayInspectNodeRequestedEvent) },
+	EventOverlayNodeHighlightRequested:                         func() interface{} { return new(OverlayNodeHighlightRequestedEvent) },
+	EventOverlayScreenshotRequested:                            func() interface{} { return new(OverlayScreenshotRequestedEvent) },
+	EventOverlayInspectModeCanceled:                            func() interface{} { return new(OverlayInspectModeCanceledEvent) },
+	EventPageDOMContentEventFired:                              func() interface{} { return new(PageDOMContentEventFiredEvent) },
+	EventPageFileChooserOpened:                                 func() interface{} { return new(PageFileChooserOpenedEvent) },
+	EventPageFrameAttached:                                     func() interface{} { return new(PageFrameAttachedEvent) },
+	EventPageFrameClearedScheduledNavigation:                   func() interface{} { return new(PageFrameClearedScheduledNavigationEvent) },
+	EventPageFrameDetached:                                     func() interface{} { return new(PageFrameDetachedEvent) },
+	EventPageFrameSubtreeWillBeDetached:                        func() interface{} { return new(PageFrameSubtreeWillBeDetachedEvent) },
+	EventPageFrameNavigated:                                    func() interface{} { return new(PageFrameNavigatedEvent) },
+	EventPageDocumentOpened:                                    func() interface{} { return new(PageDocumentOpenedEvent) },
+	EventPageFrameResized:                                      func() interface{} { return new(PageFrameResizedEvent) },
+	EventPageFrameStartedNavigating:                            func() interface{} { return new(PageFrameStartedNavigatingEvent) },
+	EventPageFrameRequestedNavigation:                          func() interface{} { return new(PageFrameRequestedNavigationEvent) },
+	EventPageFrameScheduledNavigation:                          func() interface{} { return new(PageFrameScheduledNavigationEvent) },
+	EventPageFrameStartedLoading:                               func() interface{} { return new(PageFrameStartedLoadingEvent) },
+	EventPageFrameStoppedLoading:                               func() interface{} { return new(PageFrameStoppedLoadingEvent) },
+	EventPageDownloadWillBegin:                                 func() interface{} { return new(PageDownloadWillBeginEvent) },
+	EventPageDownloadProgress:                                  func() interface{} { return new(PageDownloadProgressEvent) },
+	EventPageInterstitialHidden:                                func() interface{} { return new(PageInterstitialHiddenEvent) },
+	EventPageInterstitialShown:                                 func() interface{} { return new(PageInterstitialShownEvent) },
+	EventPageJavascriptDialogClosed:                            func() interface{} { return new(PageJavascriptDialogClosedEvent) },
+	EventPageJavascriptDialogOpening:                           func() interface{} { return new(PageJavascriptDialogOpeningEvent) },
+	EventPageLifecycleEvent:                                    func() interface{} { return new(PageLifecycleEventEvent) },
+	EventPageBackForwardCacheNotUsed:                           func() interface{} { return new(PageBackForwardCacheNotUsedEvent) },
+	EventPageLoadEventFired:                                    func() interface{} { return new(PageLoadEventFiredEvent) },
+	EventPageNavigatedWithinDocument:                           func() interface{} { return new(PageNavigatedWithinDocumentEvent) },
+	EventPageScreencastFrame:                                   func() interface{} { return new(PageScreencastFrameEvent) },
+	EventPageScreencastVisibilityChanged:                       func() interface{} { return new(PageScreencastVisibilityChangedEvent) },
+	EventPageWindowOpen:                                        func() interface{} { return new(PageWindowOpenEvent) },
+	EventPageCompilationCacheProduced:                          func() interface{} { return new(PageCompilationCacheProducedEvent) },
+	EventPerformanceMetrics:                                    func() interface{} { return new(PerformanceMetricsEvent) },
+	EventPerformanceTimelineTimelineEventAdded:                 func() interface{} { return new(PerformanceTimelineTimelineEventAddedEvent) },
+	EventPreloadRuleSetUpdated:                                 func() interface{} { return new(PreloadRuleSetUpdatedEvent) },
+	EventPreloadRuleSetRemoved:                                 func() interface{} { return new(PreloadRuleSetRemovedEvent) },
+	EventPreloadPreloadEnabledStateUpdated:                     func() interface{} { return new(PreloadPreloadEnabledStateUpdatedEvent) },
+	EventPreloadPrefetchStatusUpdated:                          func() interface{} { return new(PreloadPrefetchStatusUpdatedEvent) },
+	EventPreloadPrerenderStatusUpdated:                         func() interface{} { return new(PreloadPrerenderStatusUpdatedEvent) },
+	EventPreloadPreloadingAttemptSourcesUpdated:                func() interface{} { return new(PreloadPreloadingAttemptSourcesUpdatedEvent) },
+	EventProfilerConsoleProfileFinished:                        func() interface{} { return new(ProfilerConsoleProfileFinishedEvent) },
+	EventProfilerConsoleProfileStarted:                         func() interface{} { return new(ProfilerConsoleProfileStartedEvent) },
+	EventProfilerPreciseCoverageDeltaUpdate:                    func() interface{} { return new(ProfilerPreciseCoverageDeltaUpdateEvent) },
+	EventRuntimeBindingCalled:                                  func() interface{} { return new(RuntimeBindingCalledEvent) },
+	EventRuntimeConsoleAPICalled:                               func() interface{} { return new(RuntimeConsoleAPICalledEvent) },
+	EventRuntimeExceptionRevoked:                               func() interface{} { return new(RuntimeExceptionRevokedEvent) },
+	EventRuntimeExceptionThrown:                                func() interface{} { return new(RuntimeExceptionThrownEvent) },
+	EventRuntimeExecutionContextCreated:                        func() interface{} { return new(RuntimeExecutionContextCreatedEvent) },
+	EventRuntimeExecutionContextDestroyed:                      func() interface{} { return new(RuntimeExecutionContextDestroyedEvent) },
+	EventRuntimeExecutionContextsCleared:                       func() interface{} { return new(RuntimeExecutionContextsClearedEvent) },
+	EventRuntimeInspectRequested:                               func() interface{} { return new(RuntimeInspectRequestedEvent) },
+	EventSecurityCertificateError:                              func() interface{} { return new(SecurityCertificateErrorEvent) },
+	EventSecurityVisibleSecurityStateChanged:                   func() interface{} { return new(SecurityVisibleSecurityStateChangedEvent) },
+	EventSecuritySecurityStateChanged:                          func() interface{} { return new(SecuritySecurityStateChangedEvent) },
+	EventServiceWorkerWorkerErrorReported:                      func() interface{} { return new(ServiceWorkerWorkerErrorReportedEvent) },
+	EventServiceWorkerWorkerRegistrationUpdated:                func() interface{} { return new(ServiceWorkerWorkerRegistrationUpdatedEvent) },
+	EventServiceWorkerWorkerVersionUpdated:                     func() interface{} { return new(ServiceWorkerWorkerVersionUpdatedEvent) },
+	EventStorageCacheStorageContentUpdated:                     func() interface{} { return new(StorageCacheStorageContentUpdatedEvent) },
+	EventStorageCacheStorageListUpdated:                        func() interface{} { return new(StorageCacheStorageListUpdatedEvent) },
+	EventStorageIndexedDBContentUpdated:                        func() interface{} { return new(StorageIndexedDBContentUpdatedEvent) },
+	EventStorageIndexedDBListUpdated:                           func() interface{} { return new(StorageIndexedDBListUpdatedEvent) },
+	EventStorageInterestGroupAccessed:                          func() interface{} { return new(StorageInterestGroupAccessedEvent) },
+	EventStorageInterestGroupAuctionEventOccurred:              func() interface{} { return new(StorageInterestGroupAuctionEventOccurredEvent) },
+	EventStorageInterestGroupAuctionNetworkRequestCreated:      func() interface{} { return new(StorageInterestGroupAuctionNetworkRequestCreatedEvent) },
+	EventStorageSharedStorageAccessed:                          func() interface{} { return new(StorageSharedStorageAccessedEvent) },
+	EventStorageSharedStorageWorkletOperationExecutionFinished: func() interface{} { return new(StorageSharedStorageWorkletOperationExecutionFinishedEvent) },
+	EventStorageStorageBucketCreatedOrUpdated:                  func() interface{} { return new(StorageStorageBucketCreatedOrUpdatedEvent) },
+	EventStorageStorageBucketDeleted:                           func() interface{} { return new(StorageStorageBucketDeletedEvent) },
+	EventStorageAttributionReportingSourceRegistered:           func() interface{} { return new(StorageAttributionReportingSourceRegisteredEvent) },
+	EventStorageAttributionReportingTriggerRegistered:          func() interface{} { return new(StorageAttributionReportingTriggerRegisteredEvent) },
+	EventStorageAttributionReportingReportSent:                 func() interface{} { return new(StorageAttributionReportingReportSentEvent) },
+	EventStorageAttributionReportingVerboseDebugReportSent:     func() interface{} { return new(StorageAttributionReportingVerboseDebugReportSentEvent) },
+	EventTargetAttachedToTarget:                                func() interface{} { return new(TargetAttachedToTargetEvent) },
+	EventTargetDetachedFromTarget:                              func() interface{} { return new(TargetDetachedFromTargetEvent) },
+	EventTargetReceivedMessageFromTarget:                       func() interface{} { return new(TargetReceivedMessageFromTargetEvent) },
+	EventTargetTargetCreated:                                   func() interface{} { return new(TargetTargetCreatedEvent) },
+	EventTargetTargetDestroyed:                                 func() interface{} { return new(TargetTargetDestroyedEvent) },
+	EventTargetTargetCrashed:                                   func() interface{} { return new(TargetTargetCrashedEvent) },
+	EventTargetTargetInfoChanged:                               func() interface{} { return new(TargetTargetInfoChangedEvent) },
+	EventTetheringAccepted:                                     func() interface{} { return new(TetheringAcceptedEvent) },
+	EventTracingBufferUsage:                                    func() interface{} { return new(TracingBufferUsageEvent) },
+	EventTracingDataCollected:                                  func() interface{} { return new(TracingDataCollectedEvent) },
+	EventTracingTracingComplete:                                func() interface{} { return new(TracingTracingCompleteEvent) },
+	EventWebAudioContextCreated:                                func() interface{} { return new(WebAudioContextCreatedEvent) },
+	EventWebAudioContextWillBeDestroyed:                        func() interface{} { return new(WebAudioContextWillBeDestroyedEvent) },
+	EventWebAudioContextChanged:                                func() interface{} { return new(WebAudioContextChangedEvent) },
+	EventWebAudioAudioListenerCreated:                          func() interface{} { return new(WebAudioAudioListenerCreatedEvent) },
+	EventWebAudioAudioListenerWillBeDestroyed:                  func() interface{} { return new(WebAudioAudioListenerWillBeDestroyedEvent) },
+	EventWebAudioAudioNodeCreated:                              func() interface{} { return new(WebAudioAudioNodeCreatedEvent) },
+	EventWebAudioAudioNodeWillBeDestroyed:                      func() interface{} { return new(WebAudioAudioNodeWillBeDestroyedEvent) },
+	EventWebAudioAudioParamCreated:                             func() interface{} { return new(WebAudioAudioParamCreatedEvent) },
+	EventWebAudioAudioParamWillBeDestroyed:                     func() interface{} { return new(WebAudioAudioParamWillBeDestroyedEvent) },
+	EventWebAudioNodesConnected:                                func() interface{} { return new(WebAudioNodesConnectedEvent) },
+	EventWebAudioNodesDisconnected:                             func() interface{} { return new(WebAudioNodesDisconnectedEvent) },
+	EventWebAudioNodeParamConnected:                            func() interface{} { return new(WebAudioNodeParamConnectedEvent) },
+	EventWebAudioNodeParamDisconnected:                         func() interface{} { return new(WebAudioNodeParamDisconnectedEvent) },
+	EventWebAuthnCredentialAdded:                               func() interface{} { return new(WebAuthnCredentialAddedEvent) },
+	EventWebAuthnCredentialDeleted:                             func() interface{} { return new(WebAuthnCredentialDeletedEvent) },
+	EventWebAuthnCredentialUpdated:                             func() interface{} { return new(WebAuthnCredentialUpdatedEvent) },
+	EventWebAuthnCredentialAsserted:                            func() interface{} { return new(WebAuthnCredentialAssertedEvent) },
+}
@@ -0,0 +1,47 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// EventBreakpointsSetInstrumentationBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints#method-setInstrumentationBreakpoint
+type EventBreakpointsSetInstrumentationBreakpointParams struct {
+	EventName string `json:"eventName"`
+}
+
+// ProtocolMethod returns EventBreakpoints.setInstrumentationBreakpoint
+func (*EventBreakpointsSetInstrumentationBreakpointParams) ProtocolMethod() string {
+	return "EventBreakpoints.setInstrumentationBreakpoint"
+}
+
+// Do sends EventBreakpoints.setInstrumentationBreakpoint
+func (p *EventBreakpointsSetInstrumentationBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EventBreakpointsRemoveInstrumentationBreakpointParams https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints#method-removeInstrumentationBreakpoint
+type EventBreakpointsRemoveInstrumentationBreakpointParams struct {
+	EventName string `json:"eventName"`
+}
+
+// ProtocolMethod returns EventBreakpoints.removeInstrumentationBreakpoint
+func (*EventBreakpointsRemoveInstrumentationBreakpointParams) ProtocolMethod() string {
+	return "EventBreakpoints.removeInstrumentationBreakpoint"
+}
+
+// Do sends EventBreakpoints.removeInstrumentationBreakpoint
+func (p *EventBreakpointsRemoveInstrumentationBreakpointParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// EventBreakpointsDisableParams https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints#method-disable
+type EventBreakpointsDisableParams struct {
+}
+
+// ProtocolMethod returns EventBreakpoints.disable
+func (*EventBreakpointsDisableParams) ProtocolMethod() string {
+	return "EventBreakpoints.disable"
+}
+
+// Do sends EventBreakpoints.disable
+func (p *EventBreakpointsDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
@@ -0,0 +1,129 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// ExtensionsStorageArea https://chromedevtools.github.io/devtools-protocol/tot/Extensions#type-StorageArea
+type ExtensionsStorageArea string
+
+// ExtensionsStorageArea values
+const (
+	ExtensionsStorageAreaSession ExtensionsStorageArea = "session"
+	ExtensionsStorageAreaLocal   ExtensionsStorageArea = "local"
+	ExtensionsStorageAreaSync    ExtensionsStorageArea = "sync"
+	ExtensionsStorageAreaManaged ExtensionsStorageArea = "managed"
+)
+
+// ExtensionsLoadUnpackedParams https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-loadUnpacked
+type ExtensionsLoadUnpackedParams struct {
+	Path string `json:"path"`
+}
+
+// ProtocolMethod returns Extensions.loadUnpacked
+func (*ExtensionsLoadUnpackedParams) ProtocolMethod() string {
+	return "Extensions.loadUnpacked"
+}
+
+// ExtensionsLoadUnpackedResult https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-loadUnpacked
+type ExtensionsLoadUnpackedResult struct {
+	ID string `json:"id"`
+}
+
+// Do sends Extensions.loadUnpacked and returns its result
+func (p *ExtensionsLoadUnpackedParams) Do(e Executor) (*ExtensionsLoadUnpackedResult, error) {
+	var result = new(ExtensionsLoadUnpackedResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExtensionsUninstallParams https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-uninstall
+type ExtensionsUninstallParams struct {
+	ID string `json:"id"`
+}
+
+// ProtocolMethod returns Extensions.uninstall
+func (*ExtensionsUninstallParams) ProtocolMethod() string {
+	return "Extensions.uninstall"
+}
+
+// Do sends Extensions.uninstall
+func (p *ExtensionsUninstallParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ExtensionsGetStorageItemsParams https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-getStorageItems
+type ExtensionsGetStorageItemsParams struct {
+	ID          string                `json:"id"`
+	StorageArea ExtensionsStorageArea `json:"storageArea"`
+	Keys        []string              `json:"keys,omitempty"`
+}
+
+// ProtocolMethod returns Extensions.getStorageItems
+func (*ExtensionsGetStorageItemsParams) ProtocolMethod() string {
+	return "Extensions.getStorageItems"
+}
+
+// ExtensionsGetStorageItemsResult https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-getStorageItems
+type ExtensionsGetStorageItemsResult struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// Do sends Extensions.getStorageItems and returns its result
+func (p *ExtensionsGetStorageItemsParams) Do(e Executor) (*ExtensionsGetStorageItemsResult, error) {
+	var result = new(ExtensionsGetStorageItemsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExtensionsRemoveStorageItemsParams https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-removeStorageItems
+type ExtensionsRemoveStorageItemsParams struct {
+	ID          string                `json:"id"`
+	StorageArea ExtensionsStorageArea `json:"storageArea"`
+	Keys        []string              `json:"keys"`
+}
+
+// ProtocolMethod returns Extensions.removeStorageItems
+func (*ExtensionsRemoveStorageItemsParams) ProtocolMethod() string {
+	return "Extensions.removeStorageItems"
+}
+
+// Do sends Extensions.removeStorageItems
+func (p *ExtensionsRemoveStorageItemsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ExtensionsClearStorageItemsParams https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-clearStorageItems
+type ExtensionsClearStorageItemsParams struct {
+	ID          string                `json:"id"`
+	StorageArea ExtensionsStorageArea `json:"storageArea"`
+}
+
+// ProtocolMethod returns Extensions.clearStorageItems
+func (*ExtensionsClearStorageItemsParams) ProtocolMethod() string {
+	return "Extensions.clearStorageItems"
+}
+
+// Do sends Extensions.clearStorageItems
+func (p *ExtensionsClearStorageItemsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ExtensionsSetStorageItemsParams https://chromedevtools.github.io/devtools-protocol/tot/Extensions#method-setStorageItems
+type ExtensionsSetStorageItemsParams struct {
+	ID          string                 `json:"id"`
+	StorageArea ExtensionsStorageArea  `json:"storageArea"`
+	Values      map[string]interface{} `json:"values"`
+}
+
+// ProtocolMethod returns Extensions.setStorageItems
+func (*ExtensionsSetStorageItemsParams) ProtocolMethod() string {
+	return "Extensions.setStorageItems"
+}
+
+// Do sends Extensions.setStorageItems
+func (p *ExtensionsSetStorageItemsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
@@ -0,0 +1,194 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// FedCm events
+const (
+	EventFedCmDialogShown  = "FedCm.dialogShown"
+	EventFedCmDialogClosed = "FedCm.dialogClosed"
+)
+
+// FedCmLoginState https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-LoginState
+type FedCmLoginState string
+
+// FedCmLoginState values
+const (
+	FedCmLoginStateSignIn FedCmLoginState = "SignIn"
+	FedCmLoginStateSignUp FedCmLoginState = "SignUp"
+)
+
+// FedCmDialogType https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-DialogType
+type FedCmDialogType string
+
+// FedCmDialogType values
+const (
+	FedCmDialogTypeAccountChooser  FedCmDialogType = "AccountChooser"
+	FedCmDialogTypeAutoReauthn     FedCmDialogType = "AutoReauthn"
+	FedCmDialogTypeConfirmIdpLogin FedCmDialogType = "ConfirmIdpLogin"
+	FedCmDialogTypeError           FedCmDialogType = "Error"
+)
+
+// FedCmDialogButton https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-DialogButton
+type FedCmDialogButton string
+
+// FedCmDialogButton values
+const (
+	FedCmDialogButtonConfirmIdpLoginContinue FedCmDialogButton = "ConfirmIdpLoginContinue"
+	FedCmDialogButtonErrorGotIt              FedCmDialogButton = "ErrorGotIt"
+	FedCmDialogButtonErrorMoreDetails        FedCmDialogButton = "ErrorMoreDetails"
+)
+
+// FedCmAccountURLType https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-AccountUrlType
+type FedCmAccountURLType string
+
+// FedCmAccountURLType values
+const (
+	FedCmAccountURLTypeTermsOfService FedCmAccountURLType = "TermsOfService"
+	FedCmAccountURLTypePrivacyPolicy  FedCmAccountURLType = "PrivacyPolicy"
+)
+
+// FedCmAccount https://chromedevtools.github.io/devtools-protocol/tot/FedCm#type-Account
+type FedCmAccount struct {
+	AccountID         string          `json:"accountId"`
+	Email             string          `json:"email"`
+	Name              string          `json:"name"`
+	GivenName         string          `json:"givenName"`
+	PictureURL        string          `json:"pictureUrl"`
+	IdpConfigURL      string          `json:"idpConfigUrl"`
+	IdpLoginURL       string          `json:"idpLoginUrl"`
+	LoginState        FedCmLoginState `json:"loginState"`
+	TermsOfServiceURL string          `json:"termsOfServiceUrl,omitempty"`
+	PrivacyPolicyURL  string          `json:"privacyPolicyUrl,omitempty"`
+}
+
+// FedCmEnableParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-enable
+type FedCmEnableParams struct {
+	DisableRejectionDelay bool `json:"disableRejectionDelay,omitempty"`
+}
+
+// ProtocolMethod returns FedCm.enable
+func (*FedCmEnableParams) ProtocolMethod() string {
+	return "FedCm.enable"
+}
+
+// Do sends FedCm.enable
+func (p *FedCmEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmDisableParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-disable
+type FedCmDisableParams struct {
+}
+
+// ProtocolMethod returns FedCm.disable
+func (*FedCmDisableParams) ProtocolMethod() string {
+	return "FedCm.disable"
+}
+
+// Do sends FedCm.disable
+func (p *FedCmDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmSelectAccountParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-selectAccount
+type FedCmSelectAccountParams struct {
+	DialogID     string `json:"dialogId"`
+	AccountIndex int64  `json:"accountIndex"`
+}
+
+// ProtocolMethod returns FedCm.selectAccount
+func (*FedCmSelectAccountParams) ProtocolMethod() string {
+	return "FedCm.selectAccount"
+}
+
+// Do sends FedCm.selectAccount
+func (p *FedCmSelectAccountParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmClickDialogButtonParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-clickDialogButton
+type FedCmClickDialogButtonParams struct {
+	DialogID     string            `json:"dialogId"`
+	DialogButton FedCmDialogButton `json:"dialogButton"`
+}
+
+// ProtocolMethod returns FedCm.clickDialogButton
+func (*FedCmClickDialogButtonParams) ProtocolMethod() string {
+	return "FedCm.clickDialogButton"
+}
+
+// Do sends FedCm.clickDialogButton
+func (p *FedCmClickDialogButtonParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmOpenURLParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-openUrl
+type FedCmOpenURLParams struct {
+	DialogID       string              `json:"dialogId"`
+	AccountIndex   int64               `json:"accountIndex"`
+	AccountURLType FedCmAccountURLType `json:"accountUrlType"`
+}
+
+// ProtocolMethod returns FedCm.openUrl
+func (*FedCmOpenURLParams) ProtocolMethod() string {
+	return "FedCm.openUrl"
+}
+
+// Do sends FedCm.openUrl
+func (p *FedCmOpenURLParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmDismissDialogParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-dismissDialog
+type FedCmDismissDialogParams struct {
+	DialogID        string `json:"dialogId"`
+	TriggerCooldown bool   `json:"triggerCooldown,omitempty"`
+}
+
+// ProtocolMethod returns FedCm.dismissDialog
+func (*FedCmDismissDialogParams) ProtocolMethod() string {
+	return "FedCm.dismissDialog"
+}
+
+// Do sends FedCm.dismissDialog
+func (p *FedCmDismissDialogParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmResetCooldownParams https://chromedevtools.github.io/devtools-protocol/tot/FedCm#method-resetCooldown
+type FedCmResetCooldownParams struct {
+}
+
+// ProtocolMethod returns FedCm.resetCooldown
+func (*FedCmResetCooldownParams) ProtocolMethod() string {
+	return "FedCm.resetCooldown"
+}
+
+// Do sends FedCm.resetCooldown
+func (p *FedCmResetCooldownParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FedCmDialogShownEvent https://chromedevtools.github.io/devtools-protocol/tot/FedCm#event-dialogShown
+type FedCmDialogShownEvent struct {
+	DialogID   string          `json:"dialogId"`
+	DialogType FedCmDialogType `json:"dialogType"`
+	Accounts   []*FedCmAccount `json:"accounts"`
+	Title      string          `json:"title"`
+	Subtitle   string          `json:"subtitle,omitempty"`
+}
+
+// ProtocolMethod returns FedCm.dialogShown
+func (*FedCmDialogShownEvent) ProtocolMethod() string {
+	return "FedCm.dialogShown"
+}
+
+// FedCmDialogClosedEvent https://chromedevtools.github.io/devtools-protocol/tot/FedCm#event-dialogClosed
+type FedCmDialogClosedEvent struct {
+	DialogID string `json:"dialogId"`
+}
+
+// ProtocolMethod returns FedCm.dialogClosed
+func (*FedCmDialogClosedEvent) ProtocolMethod() string {
+	return "FedCm.dialogClosed"
+}
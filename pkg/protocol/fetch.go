@@ -0,0 +1,271 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Fetch events
+const (
+	EventFetchRequestPaused = "Fetch.requestPaused"
+	EventFetchAuthRequired  = "Fetch.authRequired"
+)
+
+// FetchRequestID https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestId
+type FetchRequestID string
+
+// FetchRequestStage https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestStage
+type FetchRequestStage string
+
+// FetchRequestStage values
+const (
+	FetchRequestStageRequest  FetchRequestStage = "Request"
+	FetchRequestStageResponse FetchRequestStage = "Response"
+)
+
+// FetchRequestPattern https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-RequestPattern
+type FetchRequestPattern struct {
+	URLPattern   string              `json:"urlPattern,omitempty"`
+	ResourceType NetworkResourceType `json:"resourceType,omitempty"`
+	RequestStage FetchRequestStage   `json:"requestStage,omitempty"`
+}
+
+// FetchHeaderEntry https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-HeaderEntry
+type FetchHeaderEntry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// FetchAuthChallenge https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-AuthChallenge
+type FetchAuthChallenge struct {
+	Source FetchAuthChallengeSource `json:"source,omitempty"`
+	Origin string                   `json:"origin"`
+	Scheme string                   `json:"scheme"`
+	Realm  string                   `json:"realm"`
+}
+
+// FetchAuthChallengeResponse https://chromedevtools.github.io/devtools-protocol/tot/Fetch#type-AuthChallengeResponse
+type FetchAuthChallengeResponse struct {
+	Response FetchAuthChallengeResponseResponse `json:"response"`
+	Username string                             `json:"username,omitempty"`
+	Password string                             `json:"password,omitempty"`
+}
+
+// FetchDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-disable
+type FetchDisableParams struct {
+}
+
+// ProtocolMethod returns Fetch.disable
+func (*FetchDisableParams) ProtocolMethod() string {
+	return "Fetch.disable"
+}
+
+// Do sends Fetch.disable
+func (p *FetchDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-enable
+type FetchEnableParams struct {
+	Patterns           []*FetchRequestPattern `json:"patterns,omitempty"`
+	HandleAuthRequests bool                   `json:"handleAuthRequests,omitempty"`
+}
+
+// ProtocolMethod returns Fetch.enable
+func (*FetchEnableParams) ProtocolMethod() string {
+	return "Fetch.enable"
+}
+
+// Do sends Fetch.enable
+func (p *FetchEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchFailRequestParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-failRequest
+type FetchFailRequestParams struct {
+	RequestID   FetchRequestID     `json:"requestId"`
+	ErrorReason NetworkErrorReason `json:"errorReason"`
+}
+
+// ProtocolMethod returns Fetch.failRequest
+func (*FetchFailRequestParams) ProtocolMethod() string {
+	return "Fetch.failRequest"
+}
+
+// Do sends Fetch.failRequest
+func (p *FetchFailRequestParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchFulfillRequestParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-fulfillRequest
+type FetchFulfillRequestParams struct {
+	RequestID             FetchRequestID      `json:"requestId"`
+	ResponseCode          int64               `json:"responseCode"`
+	ResponseHeaders       []*FetchHeaderEntry `json:"responseHeaders,omitempty"`
+	BinaryResponseHeaders string              `json:"binaryResponseHeaders,omitempty"`
+	Body                  string              `json:"body,omitempty"`
+	ResponsePhrase        string              `json:"responsePhrase,omitempty"`
+}
+
+// ProtocolMethod returns Fetch.fulfillRequest
+func (*FetchFulfillRequestParams) ProtocolMethod() string {
+	return "Fetch.fulfillRequest"
+}
+
+// Do sends Fetch.fulfillRequest
+func (p *FetchFulfillRequestParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchContinueRequestParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-continueRequest
+type FetchContinueRequestParams struct {
+	RequestID         FetchRequestID      `json:"requestId"`
+	URL               string              `json:"url,omitempty"`
+	Method            string              `json:"method,omitempty"`
+	PostData          string              `json:"postData,omitempty"`
+	Headers           []*FetchHeaderEntry `json:"headers,omitempty"`
+	InterceptResponse bool                `json:"interceptResponse,omitempty"`
+}
+
+// ProtocolMethod returns Fetch.continueRequest
+func (*FetchContinueRequestParams) ProtocolMethod() string {
+	return "Fetch.continueRequest"
+}
+
+// Do sends Fetch.continueRequest
+func (p *FetchContinueRequestParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchContinueWithAuthParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-continueWithAuth
+type FetchContinueWithAuthParams struct {
+	RequestID             FetchRequestID              `json:"requestId"`
+	AuthChallengeResponse *FetchAuthChallengeResponse `json:"authChallengeResponse"`
+}
+
+// ProtocolMethod returns Fetch.continueWithAuth
+func (*FetchContinueWithAuthParams) ProtocolMethod() string {
+	return "Fetch.continueWithAuth"
+}
+
+// Do sends Fetch.continueWithAuth
+func (p *FetchContinueWithAuthParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchContinueResponseParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-continueResponse
+type FetchContinueResponseParams struct {
+	RequestID             FetchRequestID      `json:"requestId"`
+	ResponseCode          int64               `json:"responseCode,omitempty"`
+	ResponsePhrase        string              `json:"responsePhrase,omitempty"`
+	ResponseHeaders       []*FetchHeaderEntry `json:"responseHeaders,omitempty"`
+	BinaryResponseHeaders string              `json:"binaryResponseHeaders,omitempty"`
+}
+
+// ProtocolMethod returns Fetch.continueResponse
+func (*FetchContinueResponseParams) ProtocolMethod() string {
+	return "Fetch.continueResponse"
+}
+
+// Do sends Fetch.continueResponse
+func (p *FetchContinueResponseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// FetchGetResponseBodyParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-getResponseBody
+type FetchGetResponseBodyParams struct {
+	RequestID FetchRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Fetch.getResponseBody
+func (*FetchGetResponseBodyParams) ProtocolMethod() string {
+	return "Fetch.getResponseBody"
+}
+
+// FetchGetResponseBodyResult https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-getResponseBody
+type FetchGetResponseBodyResult struct {
+	Body          string `json:"body"`
+	Base64Encoded bool   `json:"base64Encoded"`
+}
+
+// Do sends Fetch.getResponseBody and returns its result
+func (p *FetchGetResponseBodyParams) Do(e Executor) (*FetchGetResponseBodyResult, error) {
+	var result = new(FetchGetResponseBodyResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FetchTakeResponseBodyAsStreamParams https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-takeResponseBodyAsStream
+type FetchTakeResponseBodyAsStreamParams struct {
+	RequestID FetchRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Fetch.takeResponseBodyAsStream
+func (*FetchTakeResponseBodyAsStreamParams) ProtocolMethod() string {
+	return "Fetch.takeResponseBodyAsStream"
+}
+
+// FetchTakeResponseBodyAsStreamResult https://chromedevtools.github.io/devtools-protocol/tot/Fetch#method-takeResponseBodyAsStream
+type FetchTakeResponseBodyAsStreamResult struct {
+	Stream IOStreamHandle `json:"stream"`
+}
+
+// Do sends Fetch.takeResponseBodyAsStream and returns its result
+func (p *FetchTakeResponseBodyAsStreamParams) Do(e Executor) (*FetchTakeResponseBodyAsStreamResult, error) {
+	var result = new(FetchTakeResponseBodyAsStreamResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FetchRequestPausedEvent https://chromedevtools.github.io/devtools-protocol/tot/Fetch#event-requestPaused
+type FetchRequestPausedEvent struct {
+	RequestID           FetchRequestID      `json:"requestId"`
+	Request             *NetworkRequest     `json:"request"`
+	FrameID             PageFrameID         `json:"frameId"`
+	ResourceType        NetworkResourceType `json:"resourceType"`
+	ResponseErrorReason NetworkErrorReason  `json:"responseErrorReason,omitempty"`
+	ResponseStatusCode  int64               `json:"responseStatusCode,omitempty"`
+	ResponseStatusText  string              `json:"responseStatusText,omitempty"`
+	ResponseHeaders     []*FetchHeaderEntry `json:"responseHeaders,omitempty"`
+	NetworkID           NetworkRequestID    `json:"networkId,omitempty"`
+	RedirectedRequestID FetchRequestID      `json:"redirectedRequestId,omitempty"`
+}
+
+// ProtocolMethod returns Fetch.requestPaused
+func (*FetchRequestPausedEvent) ProtocolMethod() string {
+	return "Fetch.requestPaused"
+}
+
+// FetchAuthRequiredEvent https://chromedevtools.github.io/devtools-protocol/tot/Fetch#event-authRequired
+type FetchAuthRequiredEvent struct {
+	RequestID     FetchRequestID      `json:"requestId"`
+	Request       *NetworkRequest     `json:"request"`
+	FrameID       PageFrameID         `json:"frameId"`
+	ResourceType  NetworkResourceType `json:"resourceType"`
+	AuthChallenge *FetchAuthChallenge `json:"authChallenge"`
+}
+
+// ProtocolMethod returns Fetch.authRequired
+func (*FetchAuthRequiredEvent) ProtocolMethod() string {
+	return "Fetch.authRequired"
+}
+
+// FetchAuthChallengeSource enum
+type FetchAuthChallengeSource string
+
+// FetchAuthChallengeSource values
+const (
+	FetchAuthChallengeSourceServer FetchAuthChallengeSource = "Server"
+	FetchAuthChallengeSourceProxy  FetchAuthChallengeSource = "Proxy"
+)
+
+// FetchAuthChallengeResponseResponse enum
+type FetchAuthChallengeResponseResponse string
+
+// FetchAuthChallengeResponseResponse values
+const (
+	FetchAuthChallengeResponseResponseDefault            FetchAuthChallengeResponseResponse = "Default"
+	FetchAuthChallengeResponseResponseCancelAuth         FetchAuthChallengeResponseResponse = "CancelAuth"
+	FetchAuthChallengeResponseResponseProvideCredentials FetchAuthChallengeResponseResponse = "ProvideCredentials"
+)
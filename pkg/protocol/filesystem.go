@@ -0,0 +1,49 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// FileSystemFile https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-File
+type FileSystemFile struct {
+	Name         string                `json:"name"`
+	LastModified NetworkTimeSinceEpoch `json:"lastModified"`
+	Size         float64               `json:"size"`
+	Type         string                `json:"type"`
+}
+
+// FileSystemDirectory https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-Directory
+type FileSystemDirectory struct {
+	Name              string            `json:"name"`
+	NestedDirectories []string          `json:"nestedDirectories"`
+	NestedFiles       []*FileSystemFile `json:"nestedFiles"`
+}
+
+// FileSystemBucketFileSystemLocator https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#type-BucketFileSystemLocator
+type FileSystemBucketFileSystemLocator struct {
+	StorageKey     StorageSerializedStorageKey `json:"storageKey"`
+	BucketName     string                      `json:"bucketName,omitempty"`
+	PathComponents []string                    `json:"pathComponents"`
+}
+
+// FileSystemGetDirectoryParams https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#method-getDirectory
+type FileSystemGetDirectoryParams struct {
+	BucketFileSystemLocator *FileSystemBucketFileSystemLocator `json:"bucketFileSystemLocator"`
+}
+
+// ProtocolMethod returns FileSystem.getDirectory
+func (*FileSystemGetDirectoryParams) ProtocolMethod() string {
+	return "FileSystem.getDirectory"
+}
+
+// FileSystemGetDirectoryResult https://chromedevtools.github.io/devtools-protocol/tot/FileSystem#method-getDirectory
+type FileSystemGetDirectoryResult struct {
+	Directory *FileSystemDirectory `json:"directory"`
+}
+
+// Do sends FileSystem.getDirectory and returns its result
+func (p *FileSystemGetDirectoryParams) Do(e Executor) (*FileSystemGetDirectoryResult, error) {
+	var result = new(FileSystemGetDirectoryResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
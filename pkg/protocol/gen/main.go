@@ -0,0 +1,377 @@
+// Command gen generates typed bindings of devtools protocol from vendored json definitions
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// definitions are taken from devtools-protocol npm package
+var sources = []string{
+	"gen/json/browser_protocol.json",
+	"gen/json/js_protocol.json",
+}
+
+const docURL = "https://chromedevtools.github.io/devtools-protocol/tot/"
+
+type protocol struct {
+	Domains []*domain `json:"domains"`
+}
+
+type domain struct {
+	Domain     string     `json:"domain"`
+	Deprecated bool       `json:"deprecated"`
+	Types      []*typeDef `json:"types"`
+	Commands   []*command `json:"commands"`
+	Events     []*command `json:"events"`
+}
+
+type typeDef struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Type       string     `json:"type"`
+	Ref        string     `json:"$ref"`
+	Items      *typeDef   `json:"items"`
+	Enum       []string   `json:"enum"`
+	Properties []*typeDef `json:"properties"`
+	Optional   bool       `json:"optional"`
+	Deprecated bool       `json:"deprecated"`
+	Redirect   string     `json:"redirect"`
+	Parameters []*typeDef `json:"parameters"`
+	Returns    []*typeDef `json:"returns"`
+}
+
+type command = typeDef
+
+// initialisms are written in upper case as golint suggests
+var initialisms = map[string]string{
+	"Id": "ID", "Url": "URL", "Uri": "URI", "Json": "JSON", "Html": "HTML", "Css": "CSS",
+	"Http": "HTTP", "Https": "HTTPS", "Api": "API", "Js": "JS", "Ip": "IP", "Xml": "XML",
+	"Dom": "DOM", "Ui": "UI", "Cpu": "CPU", "Gpu": "GPU", "Ttl": "TTL", "Sql": "SQL", "Pdf": "PDF",
+}
+
+// split splits identifier into words by case and non-alphanumeric separators
+func split(s string) []string {
+	var (
+		words []string
+		word  []rune
+		runes = []rune(s)
+	)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(word) > 0:
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// goName converts protocol identifier to exported go identifier
+func goName(s string) string {
+	var b strings.Builder
+	for _, w := range split(s) {
+		w = strings.ToUpper(w[:1]) + w[1:]
+		if i, ok := initialisms[w]; ok {
+			w = i
+		}
+		b.WriteString(w)
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "V" + name
+	}
+	return name
+}
+
+type generator struct {
+	types map[string]*typeDef // by Domain.ID
+	names map[string]string   // generated names to check collisions
+	buf   *bytes.Buffer
+	enums *bytes.Buffer // inline enums, written after domain's declarations
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g.buf, format, args...)
+}
+
+func (g *generator) declare(name, origin string) {
+	if prev, has := g.names[name]; has {
+		log.Fatalf("name %s of %s collides with %s", name, origin, prev)
+	}
+	g.names[name] = origin
+}
+
+func (g *generator) doc(name, link string, deprecated bool) {
+	g.printf("\n// %s %s\n", name, docURL+link)
+	if deprecated {
+		g.printf("//\n// Deprecated: deprecated in protocol\n")
+	}
+}
+
+func (g *generator) ref(d string, ref string) (string, *typeDef) {
+	if !strings.Contains(ref, ".") {
+		ref = d + "." + ref
+	}
+	t, has := g.types[ref]
+	if !has {
+		log.Fatalf("unknown type %s", ref)
+	}
+	parts := strings.SplitN(ref, ".", 2)
+	return parts[0] + goName(parts[1]), t
+}
+
+// goType returns go type of property, owner is a name for inline enums
+func (g *generator) goType(d string, p *typeDef, owner string) string {
+	if p.Ref != "" {
+		name, t := g.ref(d, p.Ref)
+		if t.Type == "object" && len(t.Properties) > 0 {
+			return "*" + name
+		}
+		return name
+	}
+	switch p.Type {
+	case "string":
+		if len(p.Enum) > 0 {
+			g.enum(owner, p.Enum, "")
+			return owner
+		}
+		return "string"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "any":
+		return "json.RawMessage"
+	case "object":
+		return "map[string]interface{}"
+	case "array":
+		return "[]" + g.goType(d, p.Items, owner)
+	}
+	log.Fatalf("unknown type %s of %s", p.Type, owner)
+	return ""
+}
+
+func (g *generator) enum(name string, values []string, link string) {
+	g.declare(name, "enum "+name)
+	w := g.buf
+	if link != "" {
+		g.doc(name, link, false)
+	} else {
+		w = g.enums
+		fmt.Fprintf(w, "\n// %s enum\n", name)
+	}
+	fmt.Fprintf(w, "type %s string\n\n// %s values\nconst (\n", name, name)
+	for _, v := range values {
+		c := name + goName(v)
+		g.declare(c, "enum value "+v)
+		fmt.Fprintf(w, "%s %s = %q\n", c, name, v)
+	}
+	fmt.Fprintf(w, ")\n")
+}
+
+func (g *generator) fields(d string, owner string, props []*typeDef) {
+	for _, p := range props {
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		field := goName(p.Name)
+		if p.Deprecated {
+			g.printf("// Deprecated: deprecated in protocol\n")
+		}
+		g.printf("%s %s `json:\"%s\"`\n", field, g.goType(d, p, owner+field), tag)
+	}
+}
+
+func (g *generator) typeDef(d string, t *typeDef) {
+	name := d + goName(t.ID)
+	link := d + "#type-" + t.ID
+	switch {
+	case t.Type == "string" && len(t.Enum) > 0:
+		g.enum(name, t.Enum, link)
+		return
+	case t.Type == "object" && len(t.Properties) > 0:
+		g.structure(d, name, link, t.Deprecated, t.Properties)
+		return
+	}
+	g.declare(name, d+"."+t.ID)
+	typ := g.goType(d, t, name)
+	g.doc(name, link, t.Deprecated)
+	g.printf("type %s %s\n", name, typ)
+}
+
+func (g *generator) structure(d, name, link string, deprecated bool, props []*typeDef) {
+	g.declare(name, link)
+	g.doc(name, link, deprecated)
+	g.printf("type %s struct {\n", name)
+	g.fields(d, name, props)
+	g.printf("}\n")
+}
+
+func (g *generator) command(d string, c *command) {
+	method := d + "." + c.Name
+	base := d + goName(c.Name)
+	params := base + "Params"
+	link := d + "#method-" + c.Name
+	g.structure(d, params, link, c.Deprecated, c.Parameters)
+	g.printf("\n// ProtocolMethod returns %s\nfunc (*%s) ProtocolMethod() string {\nreturn %q\n}\n", method, params, method)
+	if len(c.Returns) == 0 {
+		g.printf("\n// Do sends %s\nfunc (p *%s) Do(e Executor) error {\nreturn e.Call(p.ProtocolMethod(), p, nil)\n}\n", method, params)
+		return
+	}
+	result := base + "Result"
+	g.structure(d, result, link, c.Deprecated, c.Returns)
+	g.printf("\n// Do sends %s and returns its result\nfunc (p *%s) Do(e Executor) (*%s, error) {\nvar result = new(%s)\nif err := e.Call(p.ProtocolMethod(), p, result); err != nil {\nreturn nil, err\n}\nreturn result, nil\n}\n",
+		method, params, result, result)
+}
+
+func (g *generator) event(d string, e *command) {
+	method := d + "." + e.Name
+	name := d + goName(e.Name) + "Event"
+	g.structure(d, name, d+"#event-"+e.Name, e.Deprecated, e.Parameters)
+	g.printf("\n// ProtocolMethod returns %s\nfunc (*%s) ProtocolMethod() string {\nreturn %q\n}\n", method, name, method)
+}
+
+func (g *generator) domain(d *domain) []byte {
+	g.buf = new(bytes.Buffer)
+	g.enums = new(bytes.Buffer)
+	if len(d.Events) > 0 {
+		g.printf("\n// %s events\nconst (\n", d.Domain)
+		for _, e := range d.Events {
+			g.printf("Event%s%s = %q\n", d.Domain, goName(e.Name), d.Domain+"."+e.Name)
+		}
+		g.printf(")\n")
+	}
+	for _, t := range d.Types {
+		g.typeDef(d.Domain, t)
+	}
+	for _, c := range d.Commands {
+		if c.Redirect == "" {
+			g.command(d.Domain, c)
+		}
+	}
+	for _, e := range d.Events {
+		g.event(d.Domain, e)
+	}
+	g.buf.Write(g.enums.Bytes())
+	header := "// Code generated by gen; DO NOT EDIT.\n\npackage protocol\n"
+	if bytes.Contains(g.buf.Bytes(), []byte("json.RawMessage")) {
+		header += "\nimport \"encoding/json\"\n"
+	}
+	src, err := format.Source(append([]byte(header), g.buf.Bytes()...))
+	if err != nil {
+		log.Fatalf("format %s: %v", d.Domain, err)
+	}
+	return src
+}
+
+// eventTypes generates registry of event types by method
+func (g *generator) eventTypes(domains []*domain) []byte {
+	g.buf = new(bytes.Buffer)
+	g.printf("// Code generated by gen; DO NOT EDIT.\n\npackage protocol\n\n")
+	g.printf("var eventTypes = map[string]func() interface{}{\n")
+	for _, d := range domains {
+		for _, e := range d.Events {
+			g.printf("Event%s%s: func() interface{} { return new(%s%sEvent) },\n", d.Domain, goName(e.Name), d.Domain, goName(e.Name))
+		}
+	}
+	g.printf("}\n")
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Fatalf("format event types: %v", err)
+	}
+	return src
+}
+
+// sessionEvents generates typed subscriptions and waiters of events as methods of cdp.Session,
+// they can't be in protocol package because cdp imports it
+func (g *generator) sessionEvents(domains []*domain) []byte {
+	g.buf = new(bytes.Buffer)
+	g.printf("// Code generated by gen; DO NOT EDIT.\n\npackage cdp\n\n")
+	g.printf("import (\n\"context\"\n\n\"github.com/ecwid/cdp/pkg/protocol\"\n)\n")
+	for _, d := range domains {
+		for _, e := range d.Events {
+			name := d.Domain + goName(e.Name)
+			method := "protocol.Event" + name
+			typ := "protocol." + name + "Event"
+			newValue := fmt.Sprintf("func() interface{} { return new(%s) }", typ)
+			deprecated := ""
+			if e.Deprecated {
+				deprecated = "//\n// Deprecated: deprecated in protocol\n"
+			}
+			g.printf("\n// On%s subscribe to %s.%s\n%s", name, d.Domain, e.Name, deprecated)
+			g.printf("func (session *Session) On%s(cb func(*%s), onError func(error)) (unsubscribe func()) {\n", name, typ)
+			g.printf("return session.subscribeEvent(%s, %s, func(v interface{}) { cb(v.(*%s)) }, onError)\n}\n", method, newValue, typ)
+			g.printf("\n// WaitFor%s waits for %s.%s matching predicate, see WaitForEvent\n%s", name, d.Domain, e.Name, deprecated)
+			g.printf("func (session Session) WaitFor%s(ctx context.Context, predicate func(*%s) bool, action func() error) (*%s, error) {\n", name, typ, typ)
+			g.printf("v, err := session.waitForEvent(ctx, %s, %s, func(v interface{}) bool { return predicate == nil || predicate(v.(*%s)) }, action)\n", method, newValue, typ)
+			g.printf("if err != nil {\nreturn nil, err\n}\nreturn v.(*%s), nil\n}\n", typ)
+		}
+	}
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Fatalf("format session events: %v", err)
+	}
+	return src
+}
+
+func main() {
+	log.SetFlags(0)
+	var domains []*domain
+	for _, s := range sources {
+		b, err := ioutil.ReadFile(s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var p protocol
+		if err = json.Unmarshal(b, &p); err != nil {
+			log.Fatalf("%s: %v", s, err)
+		}
+		domains = append(domains, p.Domains...)
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Domain < domains[j].Domain })
+	g := &generator{types: map[string]*typeDef{}, names: map[string]string{}}
+	for _, d := range domains {
+		for _, t := range d.Types {
+			g.types[d.Domain+"."+t.ID] = t
+		}
+	}
+	for _, d := range domains {
+		file := strings.ToLower(d.Domain) + ".go"
+		if err := ioutil.WriteFile(filepath.Join(".", file), g.domain(d), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile("event_types.go", g.eventTypes(domains), 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join("..", "..", "protocol_events.go"), g.sessionEvents(domains), 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("generated %d domains", len(domains))
+}
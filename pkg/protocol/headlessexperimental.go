@@ -0,0 +1,80 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// HeadlessExperimentalScreenshotParams https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#type-ScreenshotParams
+type HeadlessExperimentalScreenshotParams struct {
+	Format           HeadlessExperimentalScreenshotParamsFormat `json:"format,omitempty"`
+	Quality          int64                                      `json:"quality,omitempty"`
+	OptimizeForSpeed bool                                       `json:"optimizeForSpeed,omitempty"`
+}
+
+// HeadlessExperimentalBeginFrameParams https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-beginFrame
+type HeadlessExperimentalBeginFrameParams struct {
+	FrameTimeTicks   float64                               `json:"frameTimeTicks,omitempty"`
+	Interval         float64                               `json:"interval,omitempty"`
+	NoDisplayUpdates bool                                  `json:"noDisplayUpdates,omitempty"`
+	Screenshot       *HeadlessExperimentalScreenshotParams `json:"screenshot,omitempty"`
+}
+
+// ProtocolMethod returns HeadlessExperimental.beginFrame
+func (*HeadlessExperimentalBeginFrameParams) ProtocolMethod() string {
+	return "HeadlessExperimental.beginFrame"
+}
+
+// HeadlessExperimentalBeginFrameResult https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-beginFrame
+type HeadlessExperimentalBeginFrameResult struct {
+	HasDamage      bool   `json:"hasDamage"`
+	ScreenshotData string `json:"screenshotData,omitempty"`
+}
+
+// Do sends HeadlessExperimental.beginFrame and returns its result
+func (p *HeadlessExperimentalBeginFrameParams) Do(e Executor) (*HeadlessExperimentalBeginFrameResult, error) {
+	var result = new(HeadlessExperimentalBeginFrameResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HeadlessExperimentalDisableParams https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-disable
+//
+// Deprecated: deprecated in protocol
+type HeadlessExperimentalDisableParams struct {
+}
+
+// ProtocolMethod returns HeadlessExperimental.disable
+func (*HeadlessExperimentalDisableParams) ProtocolMethod() string {
+	return "HeadlessExperimental.disable"
+}
+
+// Do sends HeadlessExperimental.disable
+func (p *HeadlessExperimentalDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeadlessExperimentalEnableParams https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental#method-enable
+//
+// Deprecated: deprecated in protocol
+type HeadlessExperimentalEnableParams struct {
+}
+
+// ProtocolMethod returns HeadlessExperimental.enable
+func (*HeadlessExperimentalEnableParams) ProtocolMethod() string {
+	return "HeadlessExperimental.enable"
+}
+
+// Do sends HeadlessExperimental.enable
+func (p *HeadlessExperimentalEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeadlessExperimentalScreenshotParamsFormat enum
+type HeadlessExperimentalScreenshotParamsFormat string
+
+// HeadlessExperimentalScreenshotParamsFormat values
+const (
+	HeadlessExperimentalScreenshotParamsFormatJpeg HeadlessExperimentalScreenshotParamsFormat = "jpeg"
+	HeadlessExperimentalScreenshotParamsFormatPng  HeadlessExperimentalScreenshotParamsFormat = "png"
+	HeadlessExperimentalScreenshotParamsFormatWebp HeadlessExperimentalScreenshotParamsFormat = "webp"
+)
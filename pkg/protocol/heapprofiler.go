@@ -0,0 +1,310 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// HeapProfiler events
+const (
+	EventHeapProfilerAddHeapSnapshotChunk       = "HeapProfiler.addHeapSnapshotChunk"
+	EventHeapProfilerHeapStatsUpdate            = "HeapProfiler.heapStatsUpdate"
+	EventHeapProfilerLastSeenObjectID           = "HeapProfiler.lastSeenObjectId"
+	EventHeapProfilerReportHeapSnapshotProgress = "HeapProfiler.reportHeapSnapshotProgress"
+	EventHeapProfilerResetProfiles              = "HeapProfiler.resetProfiles"
+)
+
+// HeapProfilerHeapSnapshotObjectID https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-HeapSnapshotObjectId
+type HeapProfilerHeapSnapshotObjectID string
+
+// HeapProfilerSamplingHeapProfileNode https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfileNode
+type HeapProfilerSamplingHeapProfileNode struct {
+	CallFrame *RuntimeCallFrame                      `json:"callFrame"`
+	SelfSize  float64                                `json:"selfSize"`
+	ID        int64                                  `json:"id"`
+	Children  []*HeapProfilerSamplingHeapProfileNode `json:"children"`
+}
+
+// HeapProfilerSamplingHeapProfileSample https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfileSample
+type HeapProfilerSamplingHeapProfileSample struct {
+	Size    float64 `json:"size"`
+	NodeID  int64   `json:"nodeId"`
+	Ordinal float64 `json:"ordinal"`
+}
+
+// HeapProfilerSamplingHeapProfile https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#type-SamplingHeapProfile
+type HeapProfilerSamplingHeapProfile struct {
+	Head    *HeapProfilerSamplingHeapProfileNode     `json:"head"`
+	Samples []*HeapProfilerSamplingHeapProfileSample `json:"samples"`
+}
+
+// HeapProfilerAddInspectedHeapObjectParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-addInspectedHeapObject
+type HeapProfilerAddInspectedHeapObjectParams struct {
+	HeapObjectID HeapProfilerHeapSnapshotObjectID `json:"heapObjectId"`
+}
+
+// ProtocolMethod returns HeapProfiler.addInspectedHeapObject
+func (*HeapProfilerAddInspectedHeapObjectParams) ProtocolMethod() string {
+	return "HeapProfiler.addInspectedHeapObject"
+}
+
+// Do sends HeapProfiler.addInspectedHeapObject
+func (p *HeapProfilerAddInspectedHeapObjectParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerCollectGarbageParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-collectGarbage
+type HeapProfilerCollectGarbageParams struct {
+}
+
+// ProtocolMethod returns HeapProfiler.collectGarbage
+func (*HeapProfilerCollectGarbageParams) ProtocolMethod() string {
+	return "HeapProfiler.collectGarbage"
+}
+
+// Do sends HeapProfiler.collectGarbage
+func (p *HeapProfilerCollectGarbageParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerDisableParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-disable
+type HeapProfilerDisableParams struct {
+}
+
+// ProtocolMethod returns HeapProfiler.disable
+func (*HeapProfilerDisableParams) ProtocolMethod() string {
+	return "HeapProfiler.disable"
+}
+
+// Do sends HeapProfiler.disable
+func (p *HeapProfilerDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerEnableParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-enable
+type HeapProfilerEnableParams struct {
+}
+
+// ProtocolMethod returns HeapProfiler.enable
+func (*HeapProfilerEnableParams) ProtocolMethod() string {
+	return "HeapProfiler.enable"
+}
+
+// Do sends HeapProfiler.enable
+func (p *HeapProfilerEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerGetHeapObjectIDParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getHeapObjectId
+type HeapProfilerGetHeapObjectIDParams struct {
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// ProtocolMethod returns HeapProfiler.getHeapObjectId
+func (*HeapProfilerGetHeapObjectIDParams) ProtocolMethod() string {
+	return "HeapProfiler.getHeapObjectId"
+}
+
+// HeapProfilerGetHeapObjectIDResult https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getHeapObjectId
+type HeapProfilerGetHeapObjectIDResult struct {
+	HeapSnapshotObjectID HeapProfilerHeapSnapshotObjectID `json:"heapSnapshotObjectId"`
+}
+
+// Do sends HeapProfiler.getHeapObjectId and returns its result
+func (p *HeapProfilerGetHeapObjectIDParams) Do(e Executor) (*HeapProfilerGetHeapObjectIDResult, error) {
+	var result = new(HeapProfilerGetHeapObjectIDResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HeapProfilerGetObjectByHeapObjectIDParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getObjectByHeapObjectId
+type HeapProfilerGetObjectByHeapObjectIDParams struct {
+	ObjectID    HeapProfilerHeapSnapshotObjectID `json:"objectId"`
+	ObjectGroup string                           `json:"objectGroup,omitempty"`
+}
+
+// ProtocolMethod returns HeapProfiler.getObjectByHeapObjectId
+func (*HeapProfilerGetObjectByHeapObjectIDParams) ProtocolMethod() string {
+	return "HeapProfiler.getObjectByHeapObjectId"
+}
+
+// HeapProfilerGetObjectByHeapObjectIDResult https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getObjectByHeapObjectId
+type HeapProfilerGetObjectByHeapObjectIDResult struct {
+	Result *RuntimeRemoteObject `json:"result"`
+}
+
+// Do sends HeapProfiler.getObjectByHeapObjectId and returns its result
+func (p *HeapProfilerGetObjectByHeapObjectIDParams) Do(e Executor) (*HeapProfilerGetObjectByHeapObjectIDResult, error) {
+	var result = new(HeapProfilerGetObjectByHeapObjectIDResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HeapProfilerGetSamplingProfileParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getSamplingProfile
+type HeapProfilerGetSamplingProfileParams struct {
+}
+
+// ProtocolMethod returns HeapProfiler.getSamplingProfile
+func (*HeapProfilerGetSamplingProfileParams) ProtocolMethod() string {
+	return "HeapProfiler.getSamplingProfile"
+}
+
+// HeapProfilerGetSamplingProfileResult https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-getSamplingProfile
+type HeapProfilerGetSamplingProfileResult struct {
+	Profile *HeapProfilerSamplingHeapProfile `json:"profile"`
+}
+
+// Do sends HeapProfiler.getSamplingProfile and returns its result
+func (p *HeapProfilerGetSamplingProfileParams) Do(e Executor) (*HeapProfilerGetSamplingProfileResult, error) {
+	var result = new(HeapProfilerGetSamplingProfileResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HeapProfilerStartSamplingParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-startSampling
+type HeapProfilerStartSamplingParams struct {
+	SamplingInterval                 float64 `json:"samplingInterval,omitempty"`
+	IncludeObjectsCollectedByMajorGC bool    `json:"includeObjectsCollectedByMajorGC,omitempty"`
+	IncludeObjectsCollectedByMinorGC bool    `json:"includeObjectsCollectedByMinorGC,omitempty"`
+}
+
+// ProtocolMethod returns HeapProfiler.startSampling
+func (*HeapProfilerStartSamplingParams) ProtocolMethod() string {
+	return "HeapProfiler.startSampling"
+}
+
+// Do sends HeapProfiler.startSampling
+func (p *HeapProfilerStartSamplingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerStartTrackingHeapObjectsParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-startTrackingHeapObjects
+type HeapProfilerStartTrackingHeapObjectsParams struct {
+	TrackAllocations bool `json:"trackAllocations,omitempty"`
+}
+
+// ProtocolMethod returns HeapProfiler.startTrackingHeapObjects
+func (*HeapProfilerStartTrackingHeapObjectsParams) ProtocolMethod() string {
+	return "HeapProfiler.startTrackingHeapObjects"
+}
+
+// Do sends HeapProfiler.startTrackingHeapObjects
+func (p *HeapProfilerStartTrackingHeapObjectsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerStopSamplingParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-stopSampling
+type HeapProfilerStopSamplingParams struct {
+}
+
+// ProtocolMethod returns HeapProfiler.stopSampling
+func (*HeapProfilerStopSamplingParams) ProtocolMethod() string {
+	return "HeapProfiler.stopSampling"
+}
+
+// HeapProfilerStopSamplingResult https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-stopSampling
+type HeapProfilerStopSamplingResult struct {
+	Profile *HeapProfilerSamplingHeapProfile `json:"profile"`
+}
+
+// Do sends HeapProfiler.stopSampling and returns its result
+func (p *HeapProfilerStopSamplingParams) Do(e Executor) (*HeapProfilerStopSamplingResult, error) {
+	var result = new(HeapProfilerStopSamplingResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HeapProfilerStopTrackingHeapObjectsParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-stopTrackingHeapObjects
+type HeapProfilerStopTrackingHeapObjectsParams struct {
+	ReportProgress bool `json:"reportProgress,omitempty"`
+	// Deprecated: deprecated in protocol
+	TreatGlobalObjectsAsRoots bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	CaptureNumericValue       bool `json:"captureNumericValue,omitempty"`
+	ExposeInternals           bool `json:"exposeInternals,omitempty"`
+}
+
+// ProtocolMethod returns HeapProfiler.stopTrackingHeapObjects
+func (*HeapProfilerStopTrackingHeapObjectsParams) ProtocolMethod() string {
+	return "HeapProfiler.stopTrackingHeapObjects"
+}
+
+// Do sends HeapProfiler.stopTrackingHeapObjects
+func (p *HeapProfilerStopTrackingHeapObjectsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerTakeHeapSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#method-takeHeapSnapshot
+type HeapProfilerTakeHeapSnapshotParams struct {
+	ReportProgress bool `json:"reportProgress,omitempty"`
+	// Deprecated: deprecated in protocol
+	TreatGlobalObjectsAsRoots bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	CaptureNumericValue       bool `json:"captureNumericValue,omitempty"`
+	ExposeInternals           bool `json:"exposeInternals,omitempty"`
+}
+
+// ProtocolMethod returns HeapProfiler.takeHeapSnapshot
+func (*HeapProfilerTakeHeapSnapshotParams) ProtocolMethod() string {
+	return "HeapProfiler.takeHeapSnapshot"
+}
+
+// Do sends HeapProfiler.takeHeapSnapshot
+func (p *HeapProfilerTakeHeapSnapshotParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// HeapProfilerAddHeapSnapshotChunkEvent https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-addHeapSnapshotChunk
+type HeapProfilerAddHeapSnapshotChunkEvent struct {
+	Chunk string `json:"chunk"`
+}
+
+// ProtocolMethod returns HeapProfiler.addHeapSnapshotChunk
+func (*HeapProfilerAddHeapSnapshotChunkEvent) ProtocolMethod() string {
+	return "HeapProfiler.addHeapSnapshotChunk"
+}
+
+// HeapProfilerHeapStatsUpdateEvent https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-heapStatsUpdate
+type HeapProfilerHeapStatsUpdateEvent struct {
+	StatsUpdate []int64 `json:"statsUpdate"`
+}
+
+// ProtocolMethod returns HeapProfiler.heapStatsUpdate
+func (*HeapProfilerHeapStatsUpdateEvent) ProtocolMethod() string {
+	return "HeapProfiler.heapStatsUpdate"
+}
+
+// HeapProfilerLastSeenObjectIDEvent https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-lastSeenObjectId
+type HeapProfilerLastSeenObjectIDEvent struct {
+	LastSeenObjectID int64   `json:"lastSeenObjectId"`
+	Timestamp        float64 `json:"timestamp"`
+}
+
+// ProtocolMethod returns HeapProfiler.lastSeenObjectId
+func (*HeapProfilerLastSeenObjectIDEvent) ProtocolMethod() string {
+	return "HeapProfiler.lastSeenObjectId"
+}
+
+// HeapProfilerReportHeapSnapshotProgressEvent https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-reportHeapSnapshotProgress
+type HeapProfilerReportHeapSnapshotProgressEvent struct {
+	Done     int64 `json:"done"`
+	Total    int64 `json:"total"`
+	Finished bool  `json:"finished,omitempty"`
+}
+
+// ProtocolMethod returns HeapProfiler.reportHeapSnapshotProgress
+func (*HeapProfilerReportHeapSnapshotProgressEvent) ProtocolMethod() string {
+	return "HeapProfiler.reportHeapSnapshotProgress"
+}
+
+// HeapProfilerResetProfilesEvent https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler#event-resetProfiles
+type HeapProfilerResetProfilesEvent struct {
+}
+
+// ProtocolMethod returns HeapProfiler.resetProfiles
+func (*HeapProfilerResetProfilesEvent) ProtocolMethod() string {
+	return "HeapProfiler.resetProfiles"
+}
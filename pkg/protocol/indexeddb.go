@@ -0,0 +1,278 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// IndexedDBDatabaseWithObjectStores https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-DatabaseWithObjectStores
+type IndexedDBDatabaseWithObjectStores struct {
+	Name         string                  `json:"name"`
+	Version      float64                 `json:"version"`
+	ObjectStores []*IndexedDBObjectStore `json:"objectStores"`
+}
+
+// IndexedDBObjectStore https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-ObjectStore
+type IndexedDBObjectStore struct {
+	Name          string                       `json:"name"`
+	KeyPath       *IndexedDBKeyPath            `json:"keyPath"`
+	AutoIncrement bool                         `json:"autoIncrement"`
+	Indexes       []*IndexedDBObjectStoreIndex `json:"indexes"`
+}
+
+// IndexedDBObjectStoreIndex https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-ObjectStoreIndex
+type IndexedDBObjectStoreIndex struct {
+	Name       string            `json:"name"`
+	KeyPath    *IndexedDBKeyPath `json:"keyPath"`
+	Unique     bool              `json:"unique"`
+	MultiEntry bool              `json:"multiEntry"`
+}
+
+// IndexedDBKey https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-Key
+type IndexedDBKey struct {
+	Type   IndexedDBKeyType `json:"type"`
+	Number float64          `json:"number,omitempty"`
+	String string           `json:"string,omitempty"`
+	Date   float64          `json:"date,omitempty"`
+	Array  []*IndexedDBKey  `json:"array,omitempty"`
+}
+
+// IndexedDBKeyRange https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-KeyRange
+type IndexedDBKeyRange struct {
+	Lower     *IndexedDBKey `json:"lower,omitempty"`
+	Upper     *IndexedDBKey `json:"upper,omitempty"`
+	LowerOpen bool          `json:"lowerOpen"`
+	UpperOpen bool          `json:"upperOpen"`
+}
+
+// IndexedDBDataEntry https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-DataEntry
+type IndexedDBDataEntry struct {
+	Key        *RuntimeRemoteObject `json:"key"`
+	PrimaryKey *RuntimeRemoteObject `json:"primaryKey"`
+	Value      *RuntimeRemoteObject `json:"value"`
+}
+
+// IndexedDBKeyPath https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#type-KeyPath
+type IndexedDBKeyPath struct {
+	Type   IndexedDBKeyPathType `json:"type"`
+	String string               `json:"string,omitempty"`
+	Array  []string             `json:"array,omitempty"`
+}
+
+// IndexedDBClearObjectStoreParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-clearObjectStore
+type IndexedDBClearObjectStoreParams struct {
+	SecurityOrigin  string                `json:"securityOrigin,omitempty"`
+	StorageKey      string                `json:"storageKey,omitempty"`
+	StorageBucket   *StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                `json:"databaseName"`
+	ObjectStoreName string                `json:"objectStoreName"`
+}
+
+// ProtocolMethod returns IndexedDB.clearObjectStore
+func (*IndexedDBClearObjectStoreParams) ProtocolMethod() string {
+	return "IndexedDB.clearObjectStore"
+}
+
+// Do sends IndexedDB.clearObjectStore
+func (p *IndexedDBClearObjectStoreParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// IndexedDBDeleteDatabaseParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-deleteDatabase
+type IndexedDBDeleteDatabaseParams struct {
+	SecurityOrigin string                `json:"securityOrigin,omitempty"`
+	StorageKey     string                `json:"storageKey,omitempty"`
+	StorageBucket  *StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName   string                `json:"databaseName"`
+}
+
+// ProtocolMethod returns IndexedDB.deleteDatabase
+func (*IndexedDBDeleteDatabaseParams) ProtocolMethod() string {
+	return "IndexedDB.deleteDatabase"
+}
+
+// Do sends IndexedDB.deleteDatabase
+func (p *IndexedDBDeleteDatabaseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// IndexedDBDeleteObjectStoreEntriesParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-deleteObjectStoreEntries
+type IndexedDBDeleteObjectStoreEntriesParams struct {
+	SecurityOrigin  string                `json:"securityOrigin,omitempty"`
+	StorageKey      string                `json:"storageKey,omitempty"`
+	StorageBucket   *StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                `json:"databaseName"`
+	ObjectStoreName string                `json:"objectStoreName"`
+	KeyRange        *IndexedDBKeyRange    `json:"keyRange"`
+}
+
+// ProtocolMethod returns IndexedDB.deleteObjectStoreEntries
+func (*IndexedDBDeleteObjectStoreEntriesParams) ProtocolMethod() string {
+	return "IndexedDB.deleteObjectStoreEntries"
+}
+
+// Do sends IndexedDB.deleteObjectStoreEntries
+func (p *IndexedDBDeleteObjectStoreEntriesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// IndexedDBDisableParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-disable
+type IndexedDBDisableParams struct {
+}
+
+// ProtocolMethod returns IndexedDB.disable
+func (*IndexedDBDisableParams) ProtocolMethod() string {
+	return "IndexedDB.disable"
+}
+
+// Do sends IndexedDB.disable
+func (p *IndexedDBDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// IndexedDBEnableParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-enable
+type IndexedDBEnableParams struct {
+}
+
+// ProtocolMethod returns IndexedDB.enable
+func (*IndexedDBEnableParams) ProtocolMethod() string {
+	return "IndexedDB.enable"
+}
+
+// Do sends IndexedDB.enable
+func (p *IndexedDBEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// IndexedDBRequestDataParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestData
+type IndexedDBRequestDataParams struct {
+	SecurityOrigin  string                `json:"securityOrigin,omitempty"`
+	StorageKey      string                `json:"storageKey,omitempty"`
+	StorageBucket   *StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                `json:"databaseName"`
+	ObjectStoreName string                `json:"objectStoreName"`
+	IndexName       string                `json:"indexName"`
+	SkipCount       int64                 `json:"skipCount"`
+	PageSize        int64                 `json:"pageSize"`
+	KeyRange        *IndexedDBKeyRange    `json:"keyRange,omitempty"`
+}
+
+// ProtocolMethod returns IndexedDB.requestData
+func (*IndexedDBRequestDataParams) ProtocolMethod() string {
+	return "IndexedDB.requestData"
+}
+
+// IndexedDBRequestDataResult https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestData
+type IndexedDBRequestDataResult struct {
+	ObjectStoreDataEntries []*IndexedDBDataEntry `json:"objectStoreDataEntries"`
+	HasMore                bool                  `json:"hasMore"`
+}
+
+// Do sends IndexedDB.requestData and returns its result
+func (p *IndexedDBRequestDataParams) Do(e Executor) (*IndexedDBRequestDataResult, error) {
+	var result = new(IndexedDBRequestDataResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IndexedDBGetMetadataParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-getMetadata
+type IndexedDBGetMetadataParams struct {
+	SecurityOrigin  string                `json:"securityOrigin,omitempty"`
+	StorageKey      string                `json:"storageKey,omitempty"`
+	StorageBucket   *StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                `json:"databaseName"`
+	ObjectStoreName string                `json:"objectStoreName"`
+}
+
+// ProtocolMethod returns IndexedDB.getMetadata
+func (*IndexedDBGetMetadataParams) ProtocolMethod() string {
+	return "IndexedDB.getMetadata"
+}
+
+// IndexedDBGetMetadataResult https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-getMetadata
+type IndexedDBGetMetadataResult struct {
+	EntriesCount      float64 `json:"entriesCount"`
+	KeyGeneratorValue float64 `json:"keyGeneratorValue"`
+}
+
+// Do sends IndexedDB.getMetadata and returns its result
+func (p *IndexedDBGetMetadataParams) Do(e Executor) (*IndexedDBGetMetadataResult, error) {
+	var result = new(IndexedDBGetMetadataResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IndexedDBRequestDatabaseParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestDatabase
+type IndexedDBRequestDatabaseParams struct {
+	SecurityOrigin string                `json:"securityOrigin,omitempty"`
+	StorageKey     string                `json:"storageKey,omitempty"`
+	StorageBucket  *StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName   string                `json:"databaseName"`
+}
+
+// ProtocolMethod returns IndexedDB.requestDatabase
+func (*IndexedDBRequestDatabaseParams) ProtocolMethod() string {
+	return "IndexedDB.requestDatabase"
+}
+
+// IndexedDBRequestDatabaseResult https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestDatabase
+type IndexedDBRequestDatabaseResult struct {
+	DatabaseWithObjectStores *IndexedDBDatabaseWithObjectStores `json:"databaseWithObjectStores"`
+}
+
+// Do sends IndexedDB.requestDatabase and returns its result
+func (p *IndexedDBRequestDatabaseParams) Do(e Executor) (*IndexedDBRequestDatabaseResult, error) {
+	var result = new(IndexedDBRequestDatabaseResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IndexedDBRequestDatabaseNamesParams https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestDatabaseNames
+type IndexedDBRequestDatabaseNamesParams struct {
+	SecurityOrigin string                `json:"securityOrigin,omitempty"`
+	StorageKey     string                `json:"storageKey,omitempty"`
+	StorageBucket  *StorageStorageBucket `json:"storageBucket,omitempty"`
+}
+
+// ProtocolMethod returns IndexedDB.requestDatabaseNames
+func (*IndexedDBRequestDatabaseNamesParams) ProtocolMethod() string {
+	return "IndexedDB.requestDatabaseNames"
+}
+
+// IndexedDBRequestDatabaseNamesResult https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB#method-requestDatabaseNames
+type IndexedDBRequestDatabaseNamesResult struct {
+	DatabaseNames []string `json:"databaseNames"`
+}
+
+// Do sends IndexedDB.requestDatabaseNames and returns its result
+func (p *IndexedDBRequestDatabaseNamesParams) Do(e Executor) (*IndexedDBRequestDatabaseNamesResult, error) {
+	var result = new(IndexedDBRequestDatabaseNamesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IndexedDBKeyType enum
+type IndexedDBKeyType string
+
+// IndexedDBKeyType values
+const (
+	IndexedDBKeyTypeNumber IndexedDBKeyType = "number"
+	IndexedDBKeyTypeString IndexedDBKeyType = "string"
+	IndexedDBKeyTypeDate   IndexedDBKeyType = "date"
+	IndexedDBKeyTypeArray  IndexedDBKeyType = "array"
+)
+
+// IndexedDBKeyPathType enum
+type IndexedDBKeyPathType string
+
+// IndexedDBKeyPathType values
+const (
+	IndexedDBKeyPathTypeNull   IndexedDBKeyPathType = "null"
+	IndexedDBKeyPathTypeString IndexedDBKeyPathType = "string"
+	IndexedDBKeyPathTypeArray  IndexedDBKeyPathType = "array"
+)
@@ -0,0 +1,399 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Input events
+const (
+	EventInputDragIntercepted = "Input.dragIntercepted"
+)
+
+// InputTouchPoint https://chromedevtools.github.io/devtools-protocol/tot/Input#type-TouchPoint
+type InputTouchPoint struct {
+	X                  float64 `json:"x"`
+	Y                  float64 `json:"y"`
+	RadiusX            float64 `json:"radiusX,omitempty"`
+	RadiusY            float64 `json:"radiusY,omitempty"`
+	RotationAngle      float64 `json:"rotationAngle,omitempty"`
+	Force              float64 `json:"force,omitempty"`
+	TangentialPressure float64 `json:"tangentialPressure,omitempty"`
+	TiltX              float64 `json:"tiltX,omitempty"`
+	TiltY              float64 `json:"tiltY,omitempty"`
+	Twist              int64   `json:"twist,omitempty"`
+	ID                 float64 `json:"id,omitempty"`
+}
+
+// InputGestureSourceType https://chromedevtools.github.io/devtools-protocol/tot/Input#type-GestureSourceType
+type InputGestureSourceType string
+
+// InputGestureSourceType values
+const (
+	InputGestureSourceTypeDefault InputGestureSourceType = "default"
+	InputGestureSourceTypeTouch   InputGestureSourceType = "touch"
+	InputGestureSourceTypeMouse   InputGestureSourceType = "mouse"
+)
+
+// InputMouseButton https://chromedevtools.github.io/devtools-protocol/tot/Input#type-MouseButton
+type InputMouseButton string
+
+// InputMouseButton values
+const (
+	InputMouseButtonNone    InputMouseButton = "none"
+	InputMouseButtonLeft    InputMouseButton = "left"
+	InputMouseButtonMiddle  InputMouseButton = "middle"
+	InputMouseButtonRight   InputMouseButton = "right"
+	InputMouseButtonBack    InputMouseButton = "back"
+	InputMouseButtonForward InputMouseButton = "forward"
+)
+
+// InputTimeSinceEpoch https://chromedevtools.github.io/devtools-protocol/tot/Input#type-TimeSinceEpoch
+type InputTimeSinceEpoch float64
+
+// InputDragDataItem https://chromedevtools.github.io/devtools-protocol/tot/Input#type-DragDataItem
+type InputDragDataItem struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
+	Title    string `json:"title,omitempty"`
+	BaseURL  string `json:"baseURL,omitempty"`
+}
+
+// InputDragData https://chromedevtools.github.io/devtools-protocol/tot/Input#type-DragData
+type InputDragData struct {
+	Items              []*InputDragDataItem `json:"items"`
+	Files              []string             `json:"files,omitempty"`
+	DragOperationsMask int64                `json:"dragOperationsMask"`
+}
+
+// InputDispatchDragEventParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchDragEvent
+type InputDispatchDragEventParams struct {
+	Type      InputDispatchDragEventParamsType `json:"type"`
+	X         float64                          `json:"x"`
+	Y         float64                          `json:"y"`
+	Data      *InputDragData                   `json:"data"`
+	Modifiers int64                            `json:"modifiers,omitempty"`
+}
+
+// ProtocolMethod returns Input.dispatchDragEvent
+func (*InputDispatchDragEventParams) ProtocolMethod() string {
+	return "Input.dispatchDragEvent"
+}
+
+// Do sends Input.dispatchDragEvent
+func (p *InputDispatchDragEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputDispatchKeyEventParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchKeyEvent
+type InputDispatchKeyEventParams struct {
+	Type                  InputDispatchKeyEventParamsType `json:"type"`
+	Modifiers             int64                           `json:"modifiers,omitempty"`
+	Timestamp             InputTimeSinceEpoch             `json:"timestamp,omitempty"`
+	Text                  string                          `json:"text,omitempty"`
+	UnmodifiedText        string                          `json:"unmodifiedText,omitempty"`
+	KeyIdentifier         string                          `json:"keyIdentifier,omitempty"`
+	Code                  string                          `json:"code,omitempty"`
+	Key                   string                          `json:"key,omitempty"`
+	WindowsVirtualKeyCode int64                           `json:"windowsVirtualKeyCode,omitempty"`
+	NativeVirtualKeyCode  int64                           `json:"nativeVirtualKeyCode,omitempty"`
+	AutoRepeat            bool                            `json:"autoRepeat,omitempty"`
+	IsKeypad              bool                            `json:"isKeypad,omitempty"`
+	IsSystemKey           bool                            `json:"isSystemKey,omitempty"`
+	Location              int64                           `json:"location,omitempty"`
+	Commands              []string                        `json:"commands,omitempty"`
+}
+
+// ProtocolMethod returns Input.dispatchKeyEvent
+func (*InputDispatchKeyEventParams) ProtocolMethod() string {
+	return "Input.dispatchKeyEvent"
+}
+
+// Do sends Input.dispatchKeyEvent
+func (p *InputDispatchKeyEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputInsertTextParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-insertText
+type InputInsertTextParams struct {
+	Text string `json:"text"`
+}
+
+// ProtocolMethod returns Input.insertText
+func (*InputInsertTextParams) ProtocolMethod() string {
+	return "Input.insertText"
+}
+
+// Do sends Input.insertText
+func (p *InputInsertTextParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputImeSetCompositionParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-imeSetComposition
+type InputImeSetCompositionParams struct {
+	Text             string `json:"text"`
+	SelectionStart   int64  `json:"selectionStart"`
+	SelectionEnd     int64  `json:"selectionEnd"`
+	ReplacementStart int64  `json:"replacementStart,omitempty"`
+	ReplacementEnd   int64  `json:"replacementEnd,omitempty"`
+}
+
+// ProtocolMethod returns Input.imeSetComposition
+func (*InputImeSetCompositionParams) ProtocolMethod() string {
+	return "Input.imeSetComposition"
+}
+
+// Do sends Input.imeSetComposition
+func (p *InputImeSetCompositionParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputDispatchMouseEventParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchMouseEvent
+type InputDispatchMouseEventParams struct {
+	Type               InputDispatchMouseEventParamsType        `json:"type"`
+	X                  float64                                  `json:"x"`
+	Y                  float64                                  `json:"y"`
+	Modifiers          int64                                    `json:"modifiers,omitempty"`
+	Timestamp          InputTimeSinceEpoch                      `json:"timestamp,omitempty"`
+	Button             InputMouseButton                         `json:"button,omitempty"`
+	Buttons            int64                                    `json:"buttons,omitempty"`
+	ClickCount         int64                                    `json:"clickCount,omitempty"`
+	Force              float64                                  `json:"force,omitempty"`
+	TangentialPressure float64                                  `json:"tangentialPressure,omitempty"`
+	TiltX              float64                                  `json:"tiltX,omitempty"`
+	TiltY              float64                                  `json:"tiltY,omitempty"`
+	Twist              int64                                    `json:"twist,omitempty"`
+	DeltaX             float64                                  `json:"deltaX,omitempty"`
+	DeltaY             float64                                  `json:"deltaY,omitempty"`
+	PointerType        InputDispatchMouseEventParamsPointerType `json:"pointerType,omitempty"`
+}
+
+// ProtocolMethod returns Input.dispatchMouseEvent
+func (*InputDispatchMouseEventParams) ProtocolMethod() string {
+	return "Input.dispatchMouseEvent"
+}
+
+// Do sends Input.dispatchMouseEvent
+func (p *InputDispatchMouseEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputDispatchTouchEventParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-dispatchTouchEvent
+type InputDispatchTouchEventParams struct {
+	Type        InputDispatchTouchEventParamsType `json:"type"`
+	TouchPoints []*InputTouchPoint                `json:"touchPoints"`
+	Modifiers   int64                             `json:"modifiers,omitempty"`
+	Timestamp   InputTimeSinceEpoch               `json:"timestamp,omitempty"`
+}
+
+// ProtocolMethod returns Input.dispatchTouchEvent
+func (*InputDispatchTouchEventParams) ProtocolMethod() string {
+	return "Input.dispatchTouchEvent"
+}
+
+// Do sends Input.dispatchTouchEvent
+func (p *InputDispatchTouchEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputCancelDraggingParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-cancelDragging
+type InputCancelDraggingParams struct {
+}
+
+// ProtocolMethod returns Input.cancelDragging
+func (*InputCancelDraggingParams) ProtocolMethod() string {
+	return "Input.cancelDragging"
+}
+
+// Do sends Input.cancelDragging
+func (p *InputCancelDraggingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputEmulateTouchFromMouseEventParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-emulateTouchFromMouseEvent
+type InputEmulateTouchFromMouseEventParams struct {
+	Type       InputEmulateTouchFromMouseEventParamsType `json:"type"`
+	X          int64                                     `json:"x"`
+	Y          int64                                     `json:"y"`
+	Button     InputMouseButton                          `json:"button"`
+	Timestamp  InputTimeSinceEpoch                       `json:"timestamp,omitempty"`
+	DeltaX     float64                                   `json:"deltaX,omitempty"`
+	DeltaY     float64                                   `json:"deltaY,omitempty"`
+	Modifiers  int64                                     `json:"modifiers,omitempty"`
+	ClickCount int64                                     `json:"clickCount,omitempty"`
+}
+
+// ProtocolMethod returns Input.emulateTouchFromMouseEvent
+func (*InputEmulateTouchFromMouseEventParams) ProtocolMethod() string {
+	return "Input.emulateTouchFromMouseEvent"
+}
+
+// Do sends Input.emulateTouchFromMouseEvent
+func (p *InputEmulateTouchFromMouseEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputSetIgnoreInputEventsParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-setIgnoreInputEvents
+type InputSetIgnoreInputEventsParams struct {
+	Ignore bool `json:"ignore"`
+}
+
+// ProtocolMethod returns Input.setIgnoreInputEvents
+func (*InputSetIgnoreInputEventsParams) ProtocolMethod() string {
+	return "Input.setIgnoreInputEvents"
+}
+
+// Do sends Input.setIgnoreInputEvents
+func (p *InputSetIgnoreInputEventsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputSetInterceptDragsParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-setInterceptDrags
+type InputSetInterceptDragsParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Input.setInterceptDrags
+func (*InputSetInterceptDragsParams) ProtocolMethod() string {
+	return "Input.setInterceptDrags"
+}
+
+// Do sends Input.setInterceptDrags
+func (p *InputSetInterceptDragsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputSynthesizePinchGestureParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-synthesizePinchGesture
+type InputSynthesizePinchGestureParams struct {
+	X                 float64                `json:"x"`
+	Y                 float64                `json:"y"`
+	ScaleFactor       float64                `json:"scaleFactor"`
+	RelativeSpeed     int64                  `json:"relativeSpeed,omitempty"`
+	GestureSourceType InputGestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// ProtocolMethod returns Input.synthesizePinchGesture
+func (*InputSynthesizePinchGestureParams) ProtocolMethod() string {
+	return "Input.synthesizePinchGesture"
+}
+
+// Do sends Input.synthesizePinchGesture
+func (p *InputSynthesizePinchGestureParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputSynthesizeScrollGestureParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-synthesizeScrollGesture
+type InputSynthesizeScrollGestureParams struct {
+	X                     float64                `json:"x"`
+	Y                     float64                `json:"y"`
+	XDistance             float64                `json:"xDistance,omitempty"`
+	YDistance             float64                `json:"yDistance,omitempty"`
+	XOverscroll           float64                `json:"xOverscroll,omitempty"`
+	YOverscroll           float64                `json:"yOverscroll,omitempty"`
+	PreventFling          bool                   `json:"preventFling,omitempty"`
+	Speed                 int64                  `json:"speed,omitempty"`
+	GestureSourceType     InputGestureSourceType `json:"gestureSourceType,omitempty"`
+	RepeatCount           int64                  `json:"repeatCount,omitempty"`
+	RepeatDelayMs         int64                  `json:"repeatDelayMs,omitempty"`
+	InteractionMarkerName string                 `json:"interactionMarkerName,omitempty"`
+}
+
+// ProtocolMethod returns Input.synthesizeScrollGesture
+func (*InputSynthesizeScrollGestureParams) ProtocolMethod() string {
+	return "Input.synthesizeScrollGesture"
+}
+
+// Do sends Input.synthesizeScrollGesture
+func (p *InputSynthesizeScrollGestureParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputSynthesizeTapGestureParams https://chromedevtools.github.io/devtools-protocol/tot/Input#method-synthesizeTapGesture
+type InputSynthesizeTapGestureParams struct {
+	X                 float64                `json:"x"`
+	Y                 float64                `json:"y"`
+	Duration          int64                  `json:"duration,omitempty"`
+	TapCount          int64                  `json:"tapCount,omitempty"`
+	GestureSourceType InputGestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// ProtocolMethod returns Input.synthesizeTapGesture
+func (*InputSynthesizeTapGestureParams) ProtocolMethod() string {
+	return "Input.synthesizeTapGesture"
+}
+
+// Do sends Input.synthesizeTapGesture
+func (p *InputSynthesizeTapGestureParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InputDragInterceptedEvent https://chromedevtools.github.io/devtools-protocol/tot/Input#event-dragIntercepted
+type InputDragInterceptedEvent struct {
+	Data *InputDragData `json:"data"`
+}
+
+// ProtocolMethod returns Input.dragIntercepted
+func (*InputDragInterceptedEvent) ProtocolMethod() string {
+	return "Input.dragIntercepted"
+}
+
+// InputDispatchDragEventParamsType enum
+type InputDispatchDragEventParamsType string
+
+// InputDispatchDragEventParamsType values
+const (
+	InputDispatchDragEventParamsTypeDragEnter  InputDispatchDragEventParamsType = "dragEnter"
+	InputDispatchDragEventParamsTypeDragOver   InputDispatchDragEventParamsType = "dragOver"
+	InputDispatchDragEventParamsTypeDrop       InputDispatchDragEventParamsType = "drop"
+	InputDispatchDragEventParamsTypeDragCancel InputDispatchDragEventParamsType = "dragCancel"
+)
+
+// InputDispatchKeyEventParamsType enum
+type InputDispatchKeyEventParamsType string
+
+// InputDispatchKeyEventParamsType values
+const (
+	InputDispatchKeyEventParamsTypeKeyDown    InputDispatchKeyEventParamsType = "keyDown"
+	InputDispatchKeyEventParamsTypeKeyUp      InputDispatchKeyEventParamsType = "keyUp"
+	InputDispatchKeyEventParamsTypeRawKeyDown InputDispatchKeyEventParamsType = "rawKeyDown"
+	InputDispatchKeyEventParamsTypeChar       InputDispatchKeyEventParamsType = "char"
+)
+
+// InputDispatchMouseEventParamsType enum
+type InputDispatchMouseEventParamsType string
+
+// InputDispatchMouseEventParamsType values
+const (
+	InputDispatchMouseEventParamsTypeMousePressed  InputDispatchMouseEventParamsType = "mousePressed"
+	InputDispatchMouseEventParamsTypeMouseReleased InputDispatchMouseEventParamsType = "mouseReleased"
+	InputDispatchMouseEventParamsTypeMouseMoved    InputDispatchMouseEventParamsType = "mouseMoved"
+	InputDispatchMouseEventParamsTypeMouseWheel    InputDispatchMouseEventParamsType = "mouseWheel"
+)
+
+// InputDispatchMouseEventParamsPointerType enum
+type InputDispatchMouseEventParamsPointerType string
+
+// InputDispatchMouseEventParamsPointerType values
+const (
+	InputDispatchMouseEventParamsPointerTypeMouse InputDispatchMouseEventParamsPointerType = "mouse"
+	InputDispatchMouseEventParamsPointerTypePen   InputDispatchMouseEventParamsPointerType = "pen"
+)
+
+// InputDispatchTouchEventParamsType enum
+type InputDispatchTouchEventParamsType string
+
+// InputDispatchTouchEventParamsType values
+const (
+	InputDispatchTouchEventParamsTypeTouchStart  InputDispatchTouchEventParamsType = "touchStart"
+	InputDispatchTouchEventParamsTypeTouchEnd    InputDispatchTouchEventParamsType = "touchEnd"
+	InputDispatchTouchEventParamsTypeTouchMove   InputDispatchTouchEventParamsType = "touchMove"
+	InputDispatchTouchEventParamsTypeTouchCancel InputDispatchTouchEventParamsType = "touchCancel"
+)
+
+// InputEmulateTouchFromMouseEventParamsType enum
+type InputEmulateTouchFromMouseEventParamsType string
+
+// InputEmulateTouchFromMouseEventParamsType values
+const (
+	InputEmulateTouchFromMouseEventParamsTypeMousePressed  InputEmulateTouchFromMouseEventParamsType = "mousePressed"
+	InputEmulateTouchFromMouseEventParamsTypeMouseReleased InputEmulateTouchFromMouseEventParamsType = "mouseReleased"
+	InputEmulateTouchFromMouseEventParamsTypeMouseMoved    InputEmulateTouchFromMouseEventParamsType = "mouseMoved"
+	InputEmulateTouchFromMouseEventParamsTypeMouseWheel    InputEmulateTouchFromMouseEventParamsType = "mouseWheel"
+)
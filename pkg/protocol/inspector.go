@@ -0,0 +1,66 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Inspector events
+const (
+	EventInspectorDetached                 = "Inspector.detached"
+	EventInspectorTargetCrashed            = "Inspector.targetCrashed"
+	EventInspectorTargetReloadedAfterCrash = "Inspector.targetReloadedAfterCrash"
+)
+
+// InspectorDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Inspector#method-disable
+type InspectorDisableParams struct {
+}
+
+// ProtocolMethod returns Inspector.disable
+func (*InspectorDisableParams) ProtocolMethod() string {
+	return "Inspector.disable"
+}
+
+// Do sends Inspector.disable
+func (p *InspectorDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InspectorEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Inspector#method-enable
+type InspectorEnableParams struct {
+}
+
+// ProtocolMethod returns Inspector.enable
+func (*InspectorEnableParams) ProtocolMethod() string {
+	return "Inspector.enable"
+}
+
+// Do sends Inspector.enable
+func (p *InspectorEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// InspectorDetachedEvent https://chromedevtools.github.io/devtools-protocol/tot/Inspector#event-detached
+type InspectorDetachedEvent struct {
+	Reason string `json:"reason"`
+}
+
+// ProtocolMethod returns Inspector.detached
+func (*InspectorDetachedEvent) ProtocolMethod() string {
+	return "Inspector.detached"
+}
+
+// InspectorTargetCrashedEvent https://chromedevtools.github.io/devtools-protocol/tot/Inspector#event-targetCrashed
+type InspectorTargetCrashedEvent struct {
+}
+
+// ProtocolMethod returns Inspector.targetCrashed
+func (*InspectorTargetCrashedEvent) ProtocolMethod() string {
+	return "Inspector.targetCrashed"
+}
+
+// InspectorTargetReloadedAfterCrashEvent https://chromedevtools.github.io/devtools-protocol/tot/Inspector#event-targetReloadedAfterCrash
+type InspectorTargetReloadedAfterCrashEvent struct {
+}
+
+// ProtocolMethod returns Inspector.targetReloadedAfterCrash
+func (*InspectorTargetReloadedAfterCrashEvent) ProtocolMethod() string {
+	return "Inspector.targetReloadedAfterCrash"
+}
@@ -0,0 +1,73 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// IOStreamHandle https://chromedevtools.github.io/devtools-protocol/tot/IO#type-StreamHandle
+type IOStreamHandle string
+
+// IOCloseParams https://chromedevtools.github.io/devtools-protocol/tot/IO#method-close
+type IOCloseParams struct {
+	Handle IOStreamHandle `json:"handle"`
+}
+
+// ProtocolMethod returns IO.close
+func (*IOCloseParams) ProtocolMethod() string {
+	return "IO.close"
+}
+
+// Do sends IO.close
+func (p *IOCloseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// IOReadParams https://chromedevtools.github.io/devtools-protocol/tot/IO#method-read
+type IOReadParams struct {
+	Handle IOStreamHandle `json:"handle"`
+	Offset int64          `json:"offset,omitempty"`
+	Size   int64          `json:"size,omitempty"`
+}
+
+// ProtocolMethod returns IO.read
+func (*IOReadParams) ProtocolMethod() string {
+	return "IO.read"
+}
+
+// IOReadResult https://chromedevtools.github.io/devtools-protocol/tot/IO#method-read
+type IOReadResult struct {
+	Base64Encoded bool   `json:"base64Encoded,omitempty"`
+	Data          string `json:"data"`
+	Eof           bool   `json:"eof"`
+}
+
+// Do sends IO.read and returns its result
+func (p *IOReadParams) Do(e Executor) (*IOReadResult, error) {
+	var result = new(IOReadResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IOResolveBlobParams https://chromedevtools.github.io/devtools-protocol/tot/IO#method-resolveBlob
+type IOResolveBlobParams struct {
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// ProtocolMethod returns IO.resolveBlob
+func (*IOResolveBlobParams) ProtocolMethod() string {
+	return "IO.resolveBlob"
+}
+
+// IOResolveBlobResult https://chromedevtools.github.io/devtools-protocol/tot/IO#method-resolveBlob
+type IOResolveBlobResult struct {
+	Uuid string `json:"uuid"`
+}
+
+// Do sends IO.resolveBlob and returns its result
+func (p *IOResolveBlobParams) Do(e Executor) (*IOResolveBlobResult, error) {
+	var result = new(IOResolveBlobResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,284 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// LayerTree events
+const (
+	EventLayerTreeLayerPainted       = "LayerTree.layerPainted"
+	EventLayerTreeLayerTreeDidChange = "LayerTree.layerTreeDidChange"
+)
+
+// LayerTreeLayerID https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-LayerId
+type LayerTreeLayerID string
+
+// LayerTreeSnapshotID https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-SnapshotId
+type LayerTreeSnapshotID string
+
+// LayerTreeScrollRect https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-ScrollRect
+type LayerTreeScrollRect struct {
+	Rect *DOMRect                `json:"rect"`
+	Type LayerTreeScrollRectType `json:"type"`
+}
+
+// LayerTreeStickyPositionConstraint https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-StickyPositionConstraint
+type LayerTreeStickyPositionConstraint struct {
+	StickyBoxRect                       *DOMRect         `json:"stickyBoxRect"`
+	ContainingBlockRect                 *DOMRect         `json:"containingBlockRect"`
+	NearestLayerShiftingStickyBox       LayerTreeLayerID `json:"nearestLayerShiftingStickyBox,omitempty"`
+	NearestLayerShiftingContainingBlock LayerTreeLayerID `json:"nearestLayerShiftingContainingBlock,omitempty"`
+}
+
+// LayerTreePictureTile https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-PictureTile
+type LayerTreePictureTile struct {
+	X       float64 `json:"x"`
+	Y       float64 `json:"y"`
+	Picture string  `json:"picture"`
+}
+
+// LayerTreeLayer https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-Layer
+type LayerTreeLayer struct {
+	LayerID                  LayerTreeLayerID                   `json:"layerId"`
+	ParentLayerID            LayerTreeLayerID                   `json:"parentLayerId,omitempty"`
+	BackendNodeID            DOMBackendNodeID                   `json:"backendNodeId,omitempty"`
+	OffsetX                  float64                            `json:"offsetX"`
+	OffsetY                  float64                            `json:"offsetY"`
+	Width                    float64                            `json:"width"`
+	Height                   float64                            `json:"height"`
+	Transform                []float64                          `json:"transform,omitempty"`
+	AnchorX                  float64                            `json:"anchorX,omitempty"`
+	AnchorY                  float64                            `json:"anchorY,omitempty"`
+	AnchorZ                  float64                            `json:"anchorZ,omitempty"`
+	PaintCount               int64                              `json:"paintCount"`
+	DrawsContent             bool                               `json:"drawsContent"`
+	Invisible                bool                               `json:"invisible,omitempty"`
+	ScrollRects              []*LayerTreeScrollRect             `json:"scrollRects,omitempty"`
+	StickyPositionConstraint *LayerTreeStickyPositionConstraint `json:"stickyPositionConstraint,omitempty"`
+}
+
+// LayerTreePaintProfile https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#type-PaintProfile
+type LayerTreePaintProfile []float64
+
+// LayerTreeCompositingReasonsParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-compositingReasons
+type LayerTreeCompositingReasonsParams struct {
+	LayerID LayerTreeLayerID `json:"layerId"`
+}
+
+// ProtocolMethod returns LayerTree.compositingReasons
+func (*LayerTreeCompositingReasonsParams) ProtocolMethod() string {
+	return "LayerTree.compositingReasons"
+}
+
+// LayerTreeCompositingReasonsResult https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-compositingReasons
+type LayerTreeCompositingReasonsResult struct {
+	CompositingReasons   []string `json:"compositingReasons"`
+	CompositingReasonIds []string `json:"compositingReasonIds"`
+}
+
+// Do sends LayerTree.compositingReasons and returns its result
+func (p *LayerTreeCompositingReasonsParams) Do(e Executor) (*LayerTreeCompositingReasonsResult, error) {
+	var result = new(LayerTreeCompositingReasonsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LayerTreeDisableParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-disable
+type LayerTreeDisableParams struct {
+}
+
+// ProtocolMethod returns LayerTree.disable
+func (*LayerTreeDisableParams) ProtocolMethod() string {
+	return "LayerTree.disable"
+}
+
+// Do sends LayerTree.disable
+func (p *LayerTreeDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LayerTreeEnableParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-enable
+type LayerTreeEnableParams struct {
+}
+
+// ProtocolMethod returns LayerTree.enable
+func (*LayerTreeEnableParams) ProtocolMethod() string {
+	return "LayerTree.enable"
+}
+
+// Do sends LayerTree.enable
+func (p *LayerTreeEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LayerTreeLoadSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-loadSnapshot
+type LayerTreeLoadSnapshotParams struct {
+	Tiles []*LayerTreePictureTile `json:"tiles"`
+}
+
+// ProtocolMethod returns LayerTree.loadSnapshot
+func (*LayerTreeLoadSnapshotParams) ProtocolMethod() string {
+	return "LayerTree.loadSnapshot"
+}
+
+// LayerTreeLoadSnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-loadSnapshot
+type LayerTreeLoadSnapshotResult struct {
+	SnapshotID LayerTreeSnapshotID `json:"snapshotId"`
+}
+
+// Do sends LayerTree.loadSnapshot and returns its result
+func (p *LayerTreeLoadSnapshotParams) Do(e Executor) (*LayerTreeLoadSnapshotResult, error) {
+	var result = new(LayerTreeLoadSnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LayerTreeMakeSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-makeSnapshot
+type LayerTreeMakeSnapshotParams struct {
+	LayerID LayerTreeLayerID `json:"layerId"`
+}
+
+// ProtocolMethod returns LayerTree.makeSnapshot
+func (*LayerTreeMakeSnapshotParams) ProtocolMethod() string {
+	return "LayerTree.makeSnapshot"
+}
+
+// LayerTreeMakeSnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-makeSnapshot
+type LayerTreeMakeSnapshotResult struct {
+	SnapshotID LayerTreeSnapshotID `json:"snapshotId"`
+}
+
+// Do sends LayerTree.makeSnapshot and returns its result
+func (p *LayerTreeMakeSnapshotParams) Do(e Executor) (*LayerTreeMakeSnapshotResult, error) {
+	var result = new(LayerTreeMakeSnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LayerTreeProfileSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-profileSnapshot
+type LayerTreeProfileSnapshotParams struct {
+	SnapshotID     LayerTreeSnapshotID `json:"snapshotId"`
+	MinRepeatCount int64               `json:"minRepeatCount,omitempty"`
+	MinDuration    float64             `json:"minDuration,omitempty"`
+	ClipRect       *DOMRect            `json:"clipRect,omitempty"`
+}
+
+// ProtocolMethod returns LayerTree.profileSnapshot
+func (*LayerTreeProfileSnapshotParams) ProtocolMethod() string {
+	return "LayerTree.profileSnapshot"
+}
+
+// LayerTreeProfileSnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-profileSnapshot
+type LayerTreeProfileSnapshotResult struct {
+	Timings []LayerTreePaintProfile `json:"timings"`
+}
+
+// Do sends LayerTree.profileSnapshot and returns its result
+func (p *LayerTreeProfileSnapshotParams) Do(e Executor) (*LayerTreeProfileSnapshotResult, error) {
+	var result = new(LayerTreeProfileSnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LayerTreeReleaseSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-releaseSnapshot
+type LayerTreeReleaseSnapshotParams struct {
+	SnapshotID LayerTreeSnapshotID `json:"snapshotId"`
+}
+
+// ProtocolMethod returns LayerTree.releaseSnapshot
+func (*LayerTreeReleaseSnapshotParams) ProtocolMethod() string {
+	return "LayerTree.releaseSnapshot"
+}
+
+// Do sends LayerTree.releaseSnapshot
+func (p *LayerTreeReleaseSnapshotParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LayerTreeReplaySnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-replaySnapshot
+type LayerTreeReplaySnapshotParams struct {
+	SnapshotID LayerTreeSnapshotID `json:"snapshotId"`
+	FromStep   int64               `json:"fromStep,omitempty"`
+	ToStep     int64               `json:"toStep,omitempty"`
+	Scale      float64             `json:"scale,omitempty"`
+}
+
+// ProtocolMethod returns LayerTree.replaySnapshot
+func (*LayerTreeReplaySnapshotParams) ProtocolMethod() string {
+	return "LayerTree.replaySnapshot"
+}
+
+// LayerTreeReplaySnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-replaySnapshot
+type LayerTreeReplaySnapshotResult struct {
+	DataURL string `json:"dataURL"`
+}
+
+// Do sends LayerTree.replaySnapshot and returns its result
+func (p *LayerTreeReplaySnapshotParams) Do(e Executor) (*LayerTreeReplaySnapshotResult, error) {
+	var result = new(LayerTreeReplaySnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LayerTreeSnapshotCommandLogParams https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-snapshotCommandLog
+type LayerTreeSnapshotCommandLogParams struct {
+	SnapshotID LayerTreeSnapshotID `json:"snapshotId"`
+}
+
+// ProtocolMethod returns LayerTree.snapshotCommandLog
+func (*LayerTreeSnapshotCommandLogParams) ProtocolMethod() string {
+	return "LayerTree.snapshotCommandLog"
+}
+
+// LayerTreeSnapshotCommandLogResult https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#method-snapshotCommandLog
+type LayerTreeSnapshotCommandLogResult struct {
+	CommandLog []map[string]interface{} `json:"commandLog"`
+}
+
+// Do sends LayerTree.snapshotCommandLog and returns its result
+func (p *LayerTreeSnapshotCommandLogParams) Do(e Executor) (*LayerTreeSnapshotCommandLogResult, error) {
+	var result = new(LayerTreeSnapshotCommandLogResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LayerTreeLayerPaintedEvent https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#event-layerPainted
+type LayerTreeLayerPaintedEvent struct {
+	LayerID LayerTreeLayerID `json:"layerId"`
+	Clip    *DOMRect         `json:"clip"`
+}
+
+// ProtocolMethod returns LayerTree.layerPainted
+func (*LayerTreeLayerPaintedEvent) ProtocolMethod() string {
+	return "LayerTree.layerPainted"
+}
+
+// LayerTreeLayerTreeDidChangeEvent https://chromedevtools.github.io/devtools-protocol/tot/LayerTree#event-layerTreeDidChange
+type LayerTreeLayerTreeDidChangeEvent struct {
+	Layers []*LayerTreeLayer `json:"layers,omitempty"`
+}
+
+// ProtocolMethod returns LayerTree.layerTreeDidChange
+func (*LayerTreeLayerTreeDidChangeEvent) ProtocolMethod() string {
+	return "LayerTree.layerTreeDidChange"
+}
+
+// LayerTreeScrollRectType enum
+type LayerTreeScrollRectType string
+
+// LayerTreeScrollRectType values
+const (
+	LayerTreeScrollRectTypeRepaintsOnScroll  LayerTreeScrollRectType = "RepaintsOnScroll"
+	LayerTreeScrollRectTypeTouchEventHandler LayerTreeScrollRectType = "TouchEventHandler"
+	LayerTreeScrollRectTypeWheelEventHandler LayerTreeScrollRectType = "WheelEventHandler"
+)
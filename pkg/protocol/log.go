@@ -0,0 +1,163 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Log events
+const (
+	EventLogEntryAdded = "Log.entryAdded"
+)
+
+// LogLogEntry https://chromedevtools.github.io/devtools-protocol/tot/Log#type-LogEntry
+type LogLogEntry struct {
+	Source           LogLogEntrySource      `json:"source"`
+	Level            LogLogEntryLevel       `json:"level"`
+	Text             string                 `json:"text"`
+	Category         LogLogEntryCategory    `json:"category,omitempty"`
+	Timestamp        RuntimeTimestamp       `json:"timestamp"`
+	URL              string                 `json:"url,omitempty"`
+	LineNumber       int64                  `json:"lineNumber,omitempty"`
+	StackTrace       *RuntimeStackTrace     `json:"stackTrace,omitempty"`
+	NetworkRequestID NetworkRequestID       `json:"networkRequestId,omitempty"`
+	WorkerID         string                 `json:"workerId,omitempty"`
+	Args             []*RuntimeRemoteObject `json:"args,omitempty"`
+}
+
+// LogViolationSetting https://chromedevtools.github.io/devtools-protocol/tot/Log#type-ViolationSetting
+type LogViolationSetting struct {
+	Name      LogViolationSettingName `json:"name"`
+	Threshold float64                 `json:"threshold"`
+}
+
+// LogClearParams https://chromedevtools.github.io/devtools-protocol/tot/Log#method-clear
+type LogClearParams struct {
+}
+
+// ProtocolMethod returns Log.clear
+func (*LogClearParams) ProtocolMethod() string {
+	return "Log.clear"
+}
+
+// Do sends Log.clear
+func (p *LogClearParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LogDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Log#method-disable
+type LogDisableParams struct {
+}
+
+// ProtocolMethod returns Log.disable
+func (*LogDisableParams) ProtocolMethod() string {
+	return "Log.disable"
+}
+
+// Do sends Log.disable
+func (p *LogDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LogEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Log#method-enable
+type LogEnableParams struct {
+}
+
+// ProtocolMethod returns Log.enable
+func (*LogEnableParams) ProtocolMethod() string {
+	return "Log.enable"
+}
+
+// Do sends Log.enable
+func (p *LogEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LogStartViolationsReportParams https://chromedevtools.github.io/devtools-protocol/tot/Log#method-startViolationsReport
+type LogStartViolationsReportParams struct {
+	Config []*LogViolationSetting `json:"config"`
+}
+
+// ProtocolMethod returns Log.startViolationsReport
+func (*LogStartViolationsReportParams) ProtocolMethod() string {
+	return "Log.startViolationsReport"
+}
+
+// Do sends Log.startViolationsReport
+func (p *LogStartViolationsReportParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LogStopViolationsReportParams https://chromedevtools.github.io/devtools-protocol/tot/Log#method-stopViolationsReport
+type LogStopViolationsReportParams struct {
+}
+
+// ProtocolMethod returns Log.stopViolationsReport
+func (*LogStopViolationsReportParams) ProtocolMethod() string {
+	return "Log.stopViolationsReport"
+}
+
+// Do sends Log.stopViolationsReport
+func (p *LogStopViolationsReportParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// LogEntryAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/Log#event-entryAdded
+type LogEntryAddedEvent struct {
+	Entry *LogLogEntry `json:"entry"`
+}
+
+// ProtocolMethod returns Log.entryAdded
+func (*LogEntryAddedEvent) ProtocolMethod() string {
+	return "Log.entryAdded"
+}
+
+// LogLogEntrySource enum
+type LogLogEntrySource string
+
+// LogLogEntrySource values
+const (
+	LogLogEntrySourceXML            LogLogEntrySource = "xml"
+	LogLogEntrySourceJavascript     LogLogEntrySource = "javascript"
+	LogLogEntrySourceNetwork        LogLogEntrySource = "network"
+	LogLogEntrySourceStorage        LogLogEntrySource = "storage"
+	LogLogEntrySourceAppcache       LogLogEntrySource = "appcache"
+	LogLogEntrySourceRendering      LogLogEntrySource = "rendering"
+	LogLogEntrySourceSecurity       LogLogEntrySource = "security"
+	LogLogEntrySourceDeprecation    LogLogEntrySource = "deprecation"
+	LogLogEntrySourceWorker         LogLogEntrySource = "worker"
+	LogLogEntrySourceViolation      LogLogEntrySource = "violation"
+	LogLogEntrySourceIntervention   LogLogEntrySource = "intervention"
+	LogLogEntrySourceRecommendation LogLogEntrySource = "recommendation"
+	LogLogEntrySourceOther          LogLogEntrySource = "other"
+)
+
+// LogLogEntryLevel enum
+type LogLogEntryLevel string
+
+// LogLogEntryLevel values
+const (
+	LogLogEntryLevelVerbose LogLogEntryLevel = "verbose"
+	LogLogEntryLevelInfo    LogLogEntryLevel = "info"
+	LogLogEntryLevelWarning LogLogEntryLevel = "warning"
+	LogLogEntryLevelError   LogLogEntryLevel = "error"
+)
+
+// LogLogEntryCategory enum
+type LogLogEntryCategory string
+
+// LogLogEntryCategory values
+const (
+	LogLogEntryCategoryCors LogLogEntryCategory = "cors"
+)
+
+// LogViolationSettingName enum
+type LogViolationSettingName string
+
+// LogViolationSettingName values
+const (
+	LogViolationSettingNameLongTask          LogViolationSettingName = "longTask"
+	LogViolationSettingNameLongLayout        LogViolationSettingName = "longLayout"
+	LogViolationSettingNameBlockedEvent      LogViolationSettingName = "blockedEvent"
+	LogViolationSettingNameBlockedParser     LogViolationSettingName = "blockedParser"
+	LogViolationSettingNameDiscouragedAPIUse LogViolationSettingName = "discouragedAPIUse"
+	LogViolationSettingNameHandler           LogViolationSettingName = "handler"
+	LogViolationSettingNameRecurringHandler  LogViolationSettingName = "recurringHandler"
+)
@@ -0,0 +1,144 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Media events
+const (
+	EventMediaPlayerPropertiesChanged = "Media.playerPropertiesChanged"
+	EventMediaPlayerEventsAdded       = "Media.playerEventsAdded"
+	EventMediaPlayerMessagesLogged    = "Media.playerMessagesLogged"
+	EventMediaPlayerErrorsRaised      = "Media.playerErrorsRaised"
+	EventMediaPlayersCreated          = "Media.playersCreated"
+)
+
+// MediaPlayerID https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerId
+type MediaPlayerID string
+
+// MediaTimestamp https://chromedevtools.github.io/devtools-protocol/tot/Media#type-Timestamp
+type MediaTimestamp float64
+
+// MediaPlayerMessage https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerMessage
+type MediaPlayerMessage struct {
+	Level   MediaPlayerMessageLevel `json:"level"`
+	Message string                  `json:"message"`
+}
+
+// MediaPlayerProperty https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerProperty
+type MediaPlayerProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MediaPlayerEvent https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerEvent
+type MediaPlayerEvent struct {
+	Timestamp MediaTimestamp `json:"timestamp"`
+	Value     string         `json:"value"`
+}
+
+// MediaPlayerErrorSourceLocation https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerErrorSourceLocation
+type MediaPlayerErrorSourceLocation struct {
+	File string `json:"file"`
+	Line int64  `json:"line"`
+}
+
+// MediaPlayerError https://chromedevtools.github.io/devtools-protocol/tot/Media#type-PlayerError
+type MediaPlayerError struct {
+	ErrorType string                            `json:"errorType"`
+	Code      int64                             `json:"code"`
+	Stack     []*MediaPlayerErrorSourceLocation `json:"stack"`
+	Cause     []*MediaPlayerError               `json:"cause"`
+	Data      map[string]interface{}            `json:"data"`
+}
+
+// MediaEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Media#method-enable
+type MediaEnableParams struct {
+}
+
+// ProtocolMethod returns Media.enable
+func (*MediaEnableParams) ProtocolMethod() string {
+	return "Media.enable"
+}
+
+// Do sends Media.enable
+func (p *MediaEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MediaDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Media#method-disable
+type MediaDisableParams struct {
+}
+
+// ProtocolMethod returns Media.disable
+func (*MediaDisableParams) ProtocolMethod() string {
+	return "Media.disable"
+}
+
+// Do sends Media.disable
+func (p *MediaDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MediaPlayerPropertiesChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerPropertiesChanged
+type MediaPlayerPropertiesChangedEvent struct {
+	PlayerID   MediaPlayerID          `json:"playerId"`
+	Properties []*MediaPlayerProperty `json:"properties"`
+}
+
+// ProtocolMethod returns Media.playerPropertiesChanged
+func (*MediaPlayerPropertiesChangedEvent) ProtocolMethod() string {
+	return "Media.playerPropertiesChanged"
+}
+
+// MediaPlayerEventsAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerEventsAdded
+type MediaPlayerEventsAddedEvent struct {
+	PlayerID MediaPlayerID       `json:"playerId"`
+	Events   []*MediaPlayerEvent `json:"events"`
+}
+
+// ProtocolMethod returns Media.playerEventsAdded
+func (*MediaPlayerEventsAddedEvent) ProtocolMethod() string {
+	return "Media.playerEventsAdded"
+}
+
+// MediaPlayerMessagesLoggedEvent https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerMessagesLogged
+type MediaPlayerMessagesLoggedEvent struct {
+	PlayerID MediaPlayerID         `json:"playerId"`
+	Messages []*MediaPlayerMessage `json:"messages"`
+}
+
+// ProtocolMethod returns Media.playerMessagesLogged
+func (*MediaPlayerMessagesLoggedEvent) ProtocolMethod() string {
+	return "Media.playerMessagesLogged"
+}
+
+// MediaPlayerErrorsRaisedEvent https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playerErrorsRaised
+type MediaPlayerErrorsRaisedEvent struct {
+	PlayerID MediaPlayerID       `json:"playerId"`
+	Errors   []*MediaPlayerError `json:"errors"`
+}
+
+// ProtocolMethod returns Media.playerErrorsRaised
+func (*MediaPlayerErrorsRaisedEvent) ProtocolMethod() string {
+	return "Media.playerErrorsRaised"
+}
+
+// MediaPlayersCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Media#event-playersCreated
+type MediaPlayersCreatedEvent struct {
+	Players []MediaPlayerID `json:"players"`
+}
+
+// ProtocolMethod returns Media.playersCreated
+func (*MediaPlayersCreatedEvent) ProtocolMethod() string {
+	return "Media.playersCreated"
+}
+
+// MediaPlayerMessageLevel enum
+type MediaPlayerMessageLevel string
+
+// MediaPlayerMessageLevel values
+const (
+	MediaPlayerMessageLevelError   MediaPlayerMessageLevel = "error"
+	MediaPlayerMessageLevelWarning MediaPlayerMessageLevel = "warning"
+	MediaPlayerMessageLevelInfo    MediaPlayerMessageLevel = "info"
+	MediaPlayerMessageLevelDebug   MediaPlayerMessageLevel = "debug"
+)
@@ -0,0 +1,244 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// MemoryPressureLevel https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-PressureLevel
+type MemoryPressureLevel string
+
+// MemoryPressureLevel values
+const (
+	MemoryPressureLevelModerate MemoryPressureLevel = "moderate"
+	MemoryPressureLevelCritical MemoryPressureLevel = "critical"
+)
+
+// MemorySamplingProfileNode https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-SamplingProfileNode
+type MemorySamplingProfileNode struct {
+	Size  float64  `json:"size"`
+	Total float64  `json:"total"`
+	Stack []string `json:"stack"`
+}
+
+// MemorySamplingProfile https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-SamplingProfile
+type MemorySamplingProfile struct {
+	Samples []*MemorySamplingProfileNode `json:"samples"`
+	Modules []*MemoryModule              `json:"modules"`
+}
+
+// MemoryModule https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-Module
+type MemoryModule struct {
+	Name        string  `json:"name"`
+	Uuid        string  `json:"uuid"`
+	BaseAddress string  `json:"baseAddress"`
+	Size        float64 `json:"size"`
+}
+
+// MemoryDOMCounter https://chromedevtools.github.io/devtools-protocol/tot/Memory#type-DOMCounter
+type MemoryDOMCounter struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// MemoryGetDOMCountersParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getDOMCounters
+type MemoryGetDOMCountersParams struct {
+}
+
+// ProtocolMethod returns Memory.getDOMCounters
+func (*MemoryGetDOMCountersParams) ProtocolMethod() string {
+	return "Memory.getDOMCounters"
+}
+
+// MemoryGetDOMCountersResult https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getDOMCounters
+type MemoryGetDOMCountersResult struct {
+	Documents        int64 `json:"documents"`
+	Nodes            int64 `json:"nodes"`
+	JSEventListeners int64 `json:"jsEventListeners"`
+}
+
+// Do sends Memory.getDOMCounters and returns its result
+func (p *MemoryGetDOMCountersParams) Do(e Executor) (*MemoryGetDOMCountersResult, error) {
+	var result = new(MemoryGetDOMCountersResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MemoryGetDOMCountersForLeakDetectionParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getDOMCountersForLeakDetection
+type MemoryGetDOMCountersForLeakDetectionParams struct {
+}
+
+// ProtocolMethod returns Memory.getDOMCountersForLeakDetection
+func (*MemoryGetDOMCountersForLeakDetectionParams) ProtocolMethod() string {
+	return "Memory.getDOMCountersForLeakDetection"
+}
+
+// MemoryGetDOMCountersForLeakDetectionResult https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getDOMCountersForLeakDetection
+type MemoryGetDOMCountersForLeakDetectionResult struct {
+	Counters []*MemoryDOMCounter `json:"counters"`
+}
+
+// Do sends Memory.getDOMCountersForLeakDetection and returns its result
+func (p *MemoryGetDOMCountersForLeakDetectionParams) Do(e Executor) (*MemoryGetDOMCountersForLeakDetectionResult, error) {
+	var result = new(MemoryGetDOMCountersForLeakDetectionResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MemoryPrepareForLeakDetectionParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-prepareForLeakDetection
+type MemoryPrepareForLeakDetectionParams struct {
+}
+
+// ProtocolMethod returns Memory.prepareForLeakDetection
+func (*MemoryPrepareForLeakDetectionParams) ProtocolMethod() string {
+	return "Memory.prepareForLeakDetection"
+}
+
+// Do sends Memory.prepareForLeakDetection
+func (p *MemoryPrepareForLeakDetectionParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MemoryForciblyPurgeJavaScriptMemoryParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-forciblyPurgeJavaScriptMemory
+type MemoryForciblyPurgeJavaScriptMemoryParams struct {
+}
+
+// ProtocolMethod returns Memory.forciblyPurgeJavaScriptMemory
+func (*MemoryForciblyPurgeJavaScriptMemoryParams) ProtocolMethod() string {
+	return "Memory.forciblyPurgeJavaScriptMemory"
+}
+
+// Do sends Memory.forciblyPurgeJavaScriptMemory
+func (p *MemoryForciblyPurgeJavaScriptMemoryParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MemorySetPressureNotificationsSuppressedParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-setPressureNotificationsSuppressed
+type MemorySetPressureNotificationsSuppressedParams struct {
+	Suppressed bool `json:"suppressed"`
+}
+
+// ProtocolMethod returns Memory.setPressureNotificationsSuppressed
+func (*MemorySetPressureNotificationsSuppressedParams) ProtocolMethod() string {
+	return "Memory.setPressureNotificationsSuppressed"
+}
+
+// Do sends Memory.setPressureNotificationsSuppressed
+func (p *MemorySetPressureNotificationsSuppressedParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MemorySimulatePressureNotificationParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-simulatePressureNotification
+type MemorySimulatePressureNotificationParams struct {
+	Level MemoryPressureLevel `json:"level"`
+}
+
+// ProtocolMethod returns Memory.simulatePressureNotification
+func (*MemorySimulatePressureNotificationParams) ProtocolMethod() string {
+	return "Memory.simulatePressureNotification"
+}
+
+// Do sends Memory.simulatePressureNotification
+func (p *MemorySimulatePressureNotificationParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MemoryStartSamplingParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-startSampling
+type MemoryStartSamplingParams struct {
+	SamplingInterval   int64 `json:"samplingInterval,omitempty"`
+	SuppressRandomness bool  `json:"suppressRandomness,omitempty"`
+}
+
+// ProtocolMethod returns Memory.startSampling
+func (*MemoryStartSamplingParams) ProtocolMethod() string {
+	return "Memory.startSampling"
+}
+
+// Do sends Memory.startSampling
+func (p *MemoryStartSamplingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MemoryStopSamplingParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-stopSampling
+type MemoryStopSamplingParams struct {
+}
+
+// ProtocolMethod returns Memory.stopSampling
+func (*MemoryStopSamplingParams) ProtocolMethod() string {
+	return "Memory.stopSampling"
+}
+
+// Do sends Memory.stopSampling
+func (p *MemoryStopSamplingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// MemoryGetAllTimeSamplingProfileParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getAllTimeSamplingProfile
+type MemoryGetAllTimeSamplingProfileParams struct {
+}
+
+// ProtocolMethod returns Memory.getAllTimeSamplingProfile
+func (*MemoryGetAllTimeSamplingProfileParams) ProtocolMethod() string {
+	return "Memory.getAllTimeSamplingProfile"
+}
+
+// MemoryGetAllTimeSamplingProfileResult https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getAllTimeSamplingProfile
+type MemoryGetAllTimeSamplingProfileResult struct {
+	Profile *MemorySamplingProfile `json:"profile"`
+}
+
+// Do sends Memory.getAllTimeSamplingProfile and returns its result
+func (p *MemoryGetAllTimeSamplingProfileParams) Do(e Executor) (*MemoryGetAllTimeSamplingProfileResult, error) {
+	var result = new(MemoryGetAllTimeSamplingProfileResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MemoryGetBrowserSamplingProfileParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getBrowserSamplingProfile
+type MemoryGetBrowserSamplingProfileParams struct {
+}
+
+// ProtocolMethod returns Memory.getBrowserSamplingProfile
+func (*MemoryGetBrowserSamplingProfileParams) ProtocolMethod() string {
+	return "Memory.getBrowserSamplingProfile"
+}
+
+// MemoryGetBrowserSamplingProfileResult https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getBrowserSamplingProfile
+type MemoryGetBrowserSamplingProfileResult struct {
+	Profile *MemorySamplingProfile `json:"profile"`
+}
+
+// Do sends Memory.getBrowserSamplingProfile and returns its result
+func (p *MemoryGetBrowserSamplingProfileParams) Do(e Executor) (*MemoryGetBrowserSamplingProfileResult, error) {
+	var result = new(MemoryGetBrowserSamplingProfileResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MemoryGetSamplingProfileParams https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getSamplingProfile
+type MemoryGetSamplingProfileParams struct {
+}
+
+// ProtocolMethod returns Memory.getSamplingProfile
+func (*MemoryGetSamplingProfileParams) ProtocolMethod() string {
+	return "Memory.getSamplingProfile"
+}
+
+// MemoryGetSamplingProfileResult https://chromedevtools.github.io/devtools-protocol/tot/Memory#method-getSamplingProfile
+type MemoryGetSamplingProfileResult struct {
+	Profile *MemorySamplingProfile `json:"profile"`
+}
+
+// Do sends Memory.getSamplingProfile and returns its result
+func (p *MemoryGetSamplingProfileParams) Do(e Executor) (*MemoryGetSamplingProfileResult, error) {
+	var result = new(MemoryGetSamplingProfileResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
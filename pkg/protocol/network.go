@@ -0,0 +1,2230 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Network events
+const (
+	EventNetworkDataReceived                            = "Network.dataReceived"
+	EventNetworkEventSourceMessageReceived              = "Network.eventSourceMessageReceived"
+	EventNetworkLoadingFailed                           = "Network.loadingFailed"
+	EventNetworkLoadingFinished                         = "Network.loadingFinished"
+	EventNetworkRequestIntercepted                      = "Network.requestIntercepted"
+	EventNetworkRequestServedFromCache                  = "Network.requestServedFromCache"
+	EventNetworkRequestWillBeSent                       = "Network.requestWillBeSent"
+	EventNetworkResourceChangedPriority                 = "Network.resourceChangedPriority"
+	EventNetworkSignedExchangeReceived                  = "Network.signedExchangeReceived"
+	EventNetworkResponseReceived                        = "Network.responseReceived"
+	EventNetworkWebSocketClosed                         = "Network.webSocketClosed"
+	EventNetworkWebSocketCreated                        = "Network.webSocketCreated"
+	EventNetworkWebSocketFrameError                     = "Network.webSocketFrameError"
+	EventNetworkWebSocketFrameReceived                  = "Network.webSocketFrameReceived"
+	EventNetworkWebSocketFrameSent                      = "Network.webSocketFrameSent"
+	EventNetworkWebSocketHandshakeResponseReceived      = "Network.webSocketHandshakeResponseReceived"
+	EventNetworkWebSocketWillSendHandshakeRequest       = "Network.webSocketWillSendHandshakeRequest"
+	EventNetworkWebTransportCreated                     = "Network.webTransportCreated"
+	EventNetworkWebTransportConnectionEstablished       = "Network.webTransportConnectionEstablished"
+	EventNetworkWebTransportClosed                      = "Network.webTransportClosed"
+	EventNetworkDirectTCPSocketCreated                  = "Network.directTCPSocketCreated"
+	EventNetworkDirectTCPSocketOpened                   = "Network.directTCPSocketOpened"
+	EventNetworkDirectTCPSocketAborted                  = "Network.directTCPSocketAborted"
+	EventNetworkDirectTCPSocketClosed                   = "Network.directTCPSocketClosed"
+	EventNetworkDirectTCPSocketChunkSent                = "Network.directTCPSocketChunkSent"
+	EventNetworkDirectTCPSocketChunkReceived            = "Network.directTCPSocketChunkReceived"
+	EventNetworkDirectUDPSocketCreated                  = "Network.directUDPSocketCreated"
+	EventNetworkDirectUDPSocketOpened                   = "Network.directUDPSocketOpened"
+	EventNetworkDirectUDPSocketAborted                  = "Network.directUDPSocketAborted"
+	EventNetworkDirectUDPSocketClosed                   = "Network.directUDPSocketClosed"
+	EventNetworkDirectUDPSocketChunkSent                = "Network.directUDPSocketChunkSent"
+	EventNetworkDirectUDPSocketChunkReceived            = "Network.directUDPSocketChunkReceived"
+	EventNetworkRequestWillBeSentExtraInfo              = "Network.requestWillBeSentExtraInfo"
+	EventNetworkResponseReceivedExtraInfo               = "Network.responseReceivedExtraInfo"
+	EventNetworkResponseReceivedEarlyHints              = "Network.responseReceivedEarlyHints"
+	EventNetworkTrustTokenOperationDone                 = "Network.trustTokenOperationDone"
+	EventNetworkPolicyUpdated                           = "Network.policyUpdated"
+	EventNetworkSubresourceWebBundleMetadataReceived    = "Network.subresourceWebBundleMetadataReceived"
+	EventNetworkSubresourceWebBundleMetadataError       = "Network.subresourceWebBundleMetadataError"
+	EventNetworkSubresourceWebBundleInnerResponseParsed = "Network.subresourceWebBundleInnerResponseParsed"
+	EventNetworkSubresourceWebBundleInnerResponseError  = "Network.subresourceWebBundleInnerResponseError"
+	EventNetworkReportingAPIReportAdded                 = "Network.reportingApiReportAdded"
+	EventNetworkReportingAPIReportUpdated               = "Network.reportingApiReportUpdated"
+	EventNetworkReportingAPIEndpointsChangedForOrigin   = "Network.reportingApiEndpointsChangedForOrigin"
+)
+
+// NetworkResourceType https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourceType
+type NetworkResourceType string
+
+// NetworkResourceType values
+const (
+	NetworkResourceTypeDocument           NetworkResourceType = "Document"
+	NetworkResourceTypeStylesheet         NetworkResourceType = "Stylesheet"
+	NetworkResourceTypeImage              NetworkResourceType = "Image"
+	NetworkResourceTypeMedia              NetworkResourceType = "Media"
+	NetworkResourceTypeFont               NetworkResourceType = "Font"
+	NetworkResourceTypeScript             NetworkResourceType = "Script"
+	NetworkResourceTypeTextTrack          NetworkResourceType = "TextTrack"
+	NetworkResourceTypeXHR                NetworkResourceType = "XHR"
+	NetworkResourceTypeFetch              NetworkResourceType = "Fetch"
+	NetworkResourceTypePrefetch           NetworkResourceType = "Prefetch"
+	NetworkResourceTypeEventSource        NetworkResourceType = "EventSource"
+	NetworkResourceTypeWebSocket          NetworkResourceType = "WebSocket"
+	NetworkResourceTypeManifest           NetworkResourceType = "Manifest"
+	NetworkResourceTypeSignedExchange     NetworkResourceType = "SignedExchange"
+	NetworkResourceTypePing               NetworkResourceType = "Ping"
+	NetworkResourceTypeCSPViolationReport NetworkResourceType = "CSPViolationReport"
+	NetworkResourceTypePreflight          NetworkResourceType = "Preflight"
+	NetworkResourceTypeFedCM              NetworkResourceType = "FedCM"
+	NetworkResourceTypeOther              NetworkResourceType = "Other"
+)
+
+// NetworkLoaderID https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoaderId
+type NetworkLoaderID string
+
+// NetworkRequestID https://chromedevtools.github.io/devtools-protocol/tot/Network#type-RequestId
+type NetworkRequestID string
+
+// NetworkInterceptionID https://chromedevtools.github.io/devtools-protocol/tot/Network#type-InterceptionId
+type NetworkInterceptionID string
+
+// NetworkErrorReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ErrorReason
+type NetworkErrorReason string
+
+// NetworkErrorReason values
+const (
+	NetworkErrorReasonFailed               NetworkErrorReason = "Failed"
+	NetworkErrorReasonAborted              NetworkErrorReason = "Aborted"
+	NetworkErrorReasonTimedOut             NetworkErrorReason = "TimedOut"
+	NetworkErrorReasonAccessDenied         NetworkErrorReason = "AccessDenied"
+	NetworkErrorReasonConnectionClosed     NetworkErrorReason = "ConnectionClosed"
+	NetworkErrorReasonConnectionReset      NetworkErrorReason = "ConnectionReset"
+	NetworkErrorReasonConnectionRefused    NetworkErrorReason = "ConnectionRefused"
+	NetworkErrorReasonConnectionAborted    NetworkErrorReason = "ConnectionAborted"
+	NetworkErrorReasonConnectionFailed     NetworkErrorReason = "ConnectionFailed"
+	NetworkErrorReasonNameNotResolved      NetworkErrorReason = "NameNotResolved"
+	NetworkErrorReasonInternetDisconnected NetworkErrorReason = "InternetDisconnected"
+	NetworkErrorReasonAddressUnreachable   NetworkErrorReason = "AddressUnreachable"
+	NetworkErrorReasonBlockedByClient      NetworkErrorReason = "BlockedByClient"
+	NetworkErrorReasonBlockedByResponse    NetworkErrorReason = "BlockedByResponse"
+)
+
+// NetworkTimeSinceEpoch https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TimeSinceEpoch
+type NetworkTimeSinceEpoch float64
+
+// NetworkMonotonicTime https://chromedevtools.github.io/devtools-protocol/tot/Network#type-MonotonicTime
+type NetworkMonotonicTime float64
+
+// NetworkHeaders https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Headers
+type NetworkHeaders map[string]interface{}
+
+// NetworkConnectionType https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ConnectionType
+type NetworkConnectionType string
+
+// NetworkConnectionType values
+const (
+	NetworkConnectionTypeNone       NetworkConnectionType = "none"
+	NetworkConnectionTypeCellular2g NetworkConnectionType = "cellular2g"
+	NetworkConnectionTypeCellular3g NetworkConnectionType = "cellular3g"
+	NetworkConnectionTypeCellular4g NetworkConnectionType = "cellular4g"
+	NetworkConnectionTypeBluetooth  NetworkConnectionType = "bluetooth"
+	NetworkConnectionTypeEthernet   NetworkConnectionType = "ethernet"
+	NetworkConnectionTypeWifi       NetworkConnectionType = "wifi"
+	NetworkConnectionTypeWimax      NetworkConnectionType = "wimax"
+	NetworkConnectionTypeOther      NetworkConnectionType = "other"
+)
+
+// NetworkCookieSameSite https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieSameSite
+type NetworkCookieSameSite string
+
+// NetworkCookieSameSite values
+const (
+	NetworkCookieSameSiteStrict NetworkCookieSameSite = "Strict"
+	NetworkCookieSameSiteLax    NetworkCookieSameSite = "Lax"
+	NetworkCookieSameSiteNone   NetworkCookieSameSite = "None"
+)
+
+// NetworkCookiePriority https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookiePriority
+type NetworkCookiePriority string
+
+// NetworkCookiePriority values
+const (
+	NetworkCookiePriorityLow    NetworkCookiePriority = "Low"
+	NetworkCookiePriorityMedium NetworkCookiePriority = "Medium"
+	NetworkCookiePriorityHigh   NetworkCookiePriority = "High"
+)
+
+// NetworkCookieSourceScheme https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieSourceScheme
+type NetworkCookieSourceScheme string
+
+// NetworkCookieSourceScheme values
+const (
+	NetworkCookieSourceSchemeUnset     NetworkCookieSourceScheme = "Unset"
+	NetworkCookieSourceSchemeNonSecure NetworkCookieSourceScheme = "NonSecure"
+	NetworkCookieSourceSchemeSecure    NetworkCookieSourceScheme = "Secure"
+)
+
+// NetworkResourceTiming https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourceTiming
+type NetworkResourceTiming struct {
+	RequestTime                 float64 `json:"requestTime"`
+	ProxyStart                  float64 `json:"proxyStart"`
+	ProxyEnd                    float64 `json:"proxyEnd"`
+	DnsStart                    float64 `json:"dnsStart"`
+	DnsEnd                      float64 `json:"dnsEnd"`
+	ConnectStart                float64 `json:"connectStart"`
+	ConnectEnd                  float64 `json:"connectEnd"`
+	SslStart                    float64 `json:"sslStart"`
+	SslEnd                      float64 `json:"sslEnd"`
+	WorkerStart                 float64 `json:"workerStart"`
+	WorkerReady                 float64 `json:"workerReady"`
+	WorkerFetchStart            float64 `json:"workerFetchStart"`
+	WorkerRespondWithSettled    float64 `json:"workerRespondWithSettled"`
+	WorkerRouterEvaluationStart float64 `json:"workerRouterEvaluationStart,omitempty"`
+	WorkerCacheLookupStart      float64 `json:"workerCacheLookupStart,omitempty"`
+	SendStart                   float64 `json:"sendStart"`
+	SendEnd                     float64 `json:"sendEnd"`
+	PushStart                   float64 `json:"pushStart"`
+	PushEnd                     float64 `json:"pushEnd"`
+	ReceiveHeadersStart         float64 `json:"receiveHeadersStart"`
+	ReceiveHeadersEnd           float64 `json:"receiveHeadersEnd"`
+}
+
+// NetworkResourcePriority https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ResourcePriority
+type NetworkResourcePriority string
+
+// NetworkResourcePriority values
+const (
+	NetworkResourcePriorityVeryLow  NetworkResourcePriority = "VeryLow"
+	NetworkResourcePriorityLow      NetworkResourcePriority = "Low"
+	NetworkResourcePriorityMedium   NetworkResourcePriority = "Medium"
+	NetworkResourcePriorityHigh     NetworkResourcePriority = "High"
+	NetworkResourcePriorityVeryHigh NetworkResourcePriority = "VeryHigh"
+)
+
+// NetworkPostDataEntry https://chromedevtools.github.io/devtools-protocol/tot/Network#type-PostDataEntry
+type NetworkPostDataEntry struct {
+	Bytes string `json:"bytes,omitempty"`
+}
+
+// NetworkRequest https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Request
+type NetworkRequest struct {
+	URL         string         `json:"url"`
+	URLFragment string         `json:"urlFragment,omitempty"`
+	Method      string         `json:"method"`
+	Headers     NetworkHeaders `json:"headers"`
+	// Deprecated: deprecated in protocol
+	PostData         string                       `json:"postData,omitempty"`
+	HasPostData      bool                         `json:"hasPostData,omitempty"`
+	PostDataEntries  []*NetworkPostDataEntry      `json:"postDataEntries,omitempty"`
+	MixedContentType SecurityMixedContentType     `json:"mixedContentType,omitempty"`
+	InitialPriority  NetworkResourcePriority      `json:"initialPriority"`
+	ReferrerPolicy   NetworkRequestReferrerPolicy `json:"referrerPolicy"`
+	IsLinkPreload    bool                         `json:"isLinkPreload,omitempty"`
+	TrustTokenParams *NetworkTrustTokenParams     `json:"trustTokenParams,omitempty"`
+	IsSameSite       bool                         `json:"isSameSite,omitempty"`
+}
+
+// NetworkSignedCertificateTimestamp https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedCertificateTimestamp
+type NetworkSignedCertificateTimestamp struct {
+	Status             string  `json:"status"`
+	Origin             string  `json:"origin"`
+	LogDescription     string  `json:"logDescription"`
+	LogID              string  `json:"logId"`
+	Timestamp          float64 `json:"timestamp"`
+	HashAlgorithm      string  `json:"hashAlgorithm"`
+	SignatureAlgorithm string  `json:"signatureAlgorithm"`
+	SignatureData      string  `json:"signatureData"`
+}
+
+// NetworkSecurityDetails https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SecurityDetails
+type NetworkSecurityDetails struct {
+	Protocol                          string                                   `json:"protocol"`
+	KeyExchange                       string                                   `json:"keyExchange"`
+	KeyExchangeGroup                  string                                   `json:"keyExchangeGroup,omitempty"`
+	Cipher                            string                                   `json:"cipher"`
+	Mac                               string                                   `json:"mac,omitempty"`
+	CertificateID                     SecurityCertificateID                    `json:"certificateId"`
+	SubjectName                       string                                   `json:"subjectName"`
+	SanList                           []string                                 `json:"sanList"`
+	Issuer                            string                                   `json:"issuer"`
+	ValidFrom                         NetworkTimeSinceEpoch                    `json:"validFrom"`
+	ValidTo                           NetworkTimeSinceEpoch                    `json:"validTo"`
+	SignedCertificateTimestampList    []*NetworkSignedCertificateTimestamp     `json:"signedCertificateTimestampList"`
+	CertificateTransparencyCompliance NetworkCertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`
+	ServerSignatureAlgorithm          int64                                    `json:"serverSignatureAlgorithm,omitempty"`
+	EncryptedClientHello              bool                                     `json:"encryptedClientHello"`
+}
+
+// NetworkCertificateTransparencyCompliance https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CertificateTransparencyCompliance
+type NetworkCertificateTransparencyCompliance string
+
+// NetworkCertificateTransparencyCompliance values
+const (
+	NetworkCertificateTransparencyComplianceUnknown      NetworkCertificateTransparencyCompliance = "unknown"
+	NetworkCertificateTransparencyComplianceNotCompliant NetworkCertificateTransparencyCompliance = "not-compliant"
+	NetworkCertificateTransparencyComplianceCompliant    NetworkCertificateTransparencyCompliance = "compliant"
+)
+
+// NetworkBlockedReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-BlockedReason
+type NetworkBlockedReason string
+
+// NetworkBlockedReason values
+const (
+	NetworkBlockedReasonOther                                                   NetworkBlockedReason = "other"
+	NetworkBlockedReasonCsp                                                     NetworkBlockedReason = "csp"
+	NetworkBlockedReasonMixedContent                                            NetworkBlockedReason = "mixed-content"
+	NetworkBlockedReasonOrigin                                                  NetworkBlockedReason = "origin"
+	NetworkBlockedReasonInspector                                               NetworkBlockedReason = "inspector"
+	NetworkBlockedReasonIntegrity                                               NetworkBlockedReason = "integrity"
+	NetworkBlockedReasonSubresourceFilter                                       NetworkBlockedReason = "subresource-filter"
+	NetworkBlockedReasonContentType                                             NetworkBlockedReason = "content-type"
+	NetworkBlockedReasonCoepFrameResourceNeedsCoepHeader                        NetworkBlockedReason = "coep-frame-resource-needs-coep-header"
+	NetworkBlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             NetworkBlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	NetworkBlockedReasonCorpNotSameOrigin                                       NetworkBlockedReason = "corp-not-same-origin"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	NetworkBlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip NetworkBlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	NetworkBlockedReasonCorpNotSameSite                                         NetworkBlockedReason = "corp-not-same-site"
+	NetworkBlockedReasonSriMessageSignatureMismatch                             NetworkBlockedReason = "sri-message-signature-mismatch"
+)
+
+// NetworkCorsError https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CorsError
+type NetworkCorsError string
+
+// NetworkCorsError values
+const (
+	NetworkCorsErrorDisallowedByMode                          NetworkCorsError = "DisallowedByMode"
+	NetworkCorsErrorInvalidResponse                           NetworkCorsError = "InvalidResponse"
+	NetworkCorsErrorWildcardOriginNotAllowed                  NetworkCorsError = "WildcardOriginNotAllowed"
+	NetworkCorsErrorMissingAllowOriginHeader                  NetworkCorsError = "MissingAllowOriginHeader"
+	NetworkCorsErrorMultipleAllowOriginValues                 NetworkCorsError = "MultipleAllowOriginValues"
+	NetworkCorsErrorInvalidAllowOriginValue                   NetworkCorsError = "InvalidAllowOriginValue"
+	NetworkCorsErrorAllowOriginMismatch                       NetworkCorsError = "AllowOriginMismatch"
+	NetworkCorsErrorInvalidAllowCredentials                   NetworkCorsError = "InvalidAllowCredentials"
+	NetworkCorsErrorCorsDisabledScheme                        NetworkCorsError = "CorsDisabledScheme"
+	NetworkCorsErrorPreflightInvalidStatus                    NetworkCorsError = "PreflightInvalidStatus"
+	NetworkCorsErrorPreflightDisallowedRedirect               NetworkCorsError = "PreflightDisallowedRedirect"
+	NetworkCorsErrorPreflightWildcardOriginNotAllowed         NetworkCorsError = "PreflightWildcardOriginNotAllowed"
+	NetworkCorsErrorPreflightMissingAllowOriginHeader         NetworkCorsError = "PreflightMissingAllowOriginHeader"
+	NetworkCorsErrorPreflightMultipleAllowOriginValues        NetworkCorsError = "PreflightMultipleAllowOriginValues"
+	NetworkCorsErrorPreflightInvalidAllowOriginValue          NetworkCorsError = "PreflightInvalidAllowOriginValue"
+	NetworkCorsErrorPreflightAllowOriginMismatch              NetworkCorsError = "PreflightAllowOriginMismatch"
+	NetworkCorsErrorPreflightInvalidAllowCredentials          NetworkCorsError = "PreflightInvalidAllowCredentials"
+	NetworkCorsErrorPreflightMissingAllowExternal             NetworkCorsError = "PreflightMissingAllowExternal"
+	NetworkCorsErrorPreflightInvalidAllowExternal             NetworkCorsError = "PreflightInvalidAllowExternal"
+	NetworkCorsErrorPreflightMissingAllowPrivateNetwork       NetworkCorsError = "PreflightMissingAllowPrivateNetwork"
+	NetworkCorsErrorPreflightInvalidAllowPrivateNetwork       NetworkCorsError = "PreflightInvalidAllowPrivateNetwork"
+	NetworkCorsErrorInvalidAllowMethodsPreflightResponse      NetworkCorsError = "InvalidAllowMethodsPreflightResponse"
+	NetworkCorsErrorInvalidAllowHeadersPreflightResponse      NetworkCorsError = "InvalidAllowHeadersPreflightResponse"
+	NetworkCorsErrorMethodDisallowedByPreflightResponse       NetworkCorsError = "MethodDisallowedByPreflightResponse"
+	NetworkCorsErrorHeaderDisallowedByPreflightResponse       NetworkCorsError = "HeaderDisallowedByPreflightResponse"
+	NetworkCorsErrorRedirectContainsCredentials               NetworkCorsError = "RedirectContainsCredentials"
+	NetworkCorsErrorInsecurePrivateNetwork                    NetworkCorsError = "InsecurePrivateNetwork"
+	NetworkCorsErrorInvalidPrivateNetworkAccess               NetworkCorsError = "InvalidPrivateNetworkAccess"
+	NetworkCorsErrorUnexpectedPrivateNetworkAccess            NetworkCorsError = "UnexpectedPrivateNetworkAccess"
+	NetworkCorsErrorNoCorsRedirectModeNotFollow               NetworkCorsError = "NoCorsRedirectModeNotFollow"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessID    NetworkCorsError = "PreflightMissingPrivateNetworkAccessId"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessName  NetworkCorsError = "PreflightMissingPrivateNetworkAccessName"
+	NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable NetworkCorsError = "PrivateNetworkAccessPermissionUnavailable"
+	NetworkCorsErrorPrivateNetworkAccessPermissionDenied      NetworkCorsError = "PrivateNetworkAccessPermissionDenied"
+	NetworkCorsErrorLocalNetworkAccessPermissionDenied        NetworkCorsError = "LocalNetworkAccessPermissionDenied"
+)
+
+// NetworkCorsErrorStatus https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CorsErrorStatus
+type NetworkCorsErrorStatus struct {
+	CorsError       NetworkCorsError `json:"corsError"`
+	FailedParameter string           `json:"failedParameter"`
+}
+
+// NetworkServiceWorkerResponseSource https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerResponseSource
+type NetworkServiceWorkerResponseSource string
+
+// NetworkServiceWorkerResponseSource values
+const (
+	NetworkServiceWorkerResponseSourceCacheStorage NetworkServiceWorkerResponseSource = "cache-storage"
+	NetworkServiceWorkerResponseSourceHTTPCache    NetworkServiceWorkerResponseSource = "http-cache"
+	NetworkServiceWorkerResponseSourceFallbackCode NetworkServiceWorkerResponseSource = "fallback-code"
+	NetworkServiceWorkerResponseSourceNetwork      NetworkServiceWorkerResponseSource = "network"
+)
+
+// NetworkTrustTokenParams https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TrustTokenParams
+type NetworkTrustTokenParams struct {
+	Operation     NetworkTrustTokenOperationType       `json:"operation"`
+	RefreshPolicy NetworkTrustTokenParamsRefreshPolicy `json:"refreshPolicy"`
+	Issuers       []string                             `json:"issuers,omitempty"`
+}
+
+// NetworkTrustTokenOperationType https://chromedevtools.github.io/devtools-protocol/tot/Network#type-TrustTokenOperationType
+type NetworkTrustTokenOperationType string
+
+// NetworkTrustTokenOperationType values
+const (
+	NetworkTrustTokenOperationTypeIssuance   NetworkTrustTokenOperationType = "Issuance"
+	NetworkTrustTokenOperationTypeRedemption NetworkTrustTokenOperationType = "Redemption"
+	NetworkTrustTokenOperationTypeSigning    NetworkTrustTokenOperationType = "Signing"
+)
+
+// NetworkAlternateProtocolUsage https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AlternateProtocolUsage
+type NetworkAlternateProtocolUsage string
+
+// NetworkAlternateProtocolUsage values
+const (
+	NetworkAlternateProtocolUsageAlternativeJobWonWithoutRace NetworkAlternateProtocolUsage = "alternativeJobWonWithoutRace"
+	NetworkAlternateProtocolUsageAlternativeJobWonRace        NetworkAlternateProtocolUsage = "alternativeJobWonRace"
+	NetworkAlternateProtocolUsageMainJobWonRace               NetworkAlternateProtocolUsage = "mainJobWonRace"
+	NetworkAlternateProtocolUsageMappingMissing               NetworkAlternateProtocolUsage = "mappingMissing"
+	NetworkAlternateProtocolUsageBroken                       NetworkAlternateProtocolUsage = "broken"
+	NetworkAlternateProtocolUsageDnsAlpnH3JobWonWithoutRace   NetworkAlternateProtocolUsage = "dnsAlpnH3JobWonWithoutRace"
+	NetworkAlternateProtocolUsageDnsAlpnH3JobWonRace          NetworkAlternateProtocolUsage = "dnsAlpnH3JobWonRace"
+	NetworkAlternateProtocolUsageUnspecifiedReason            NetworkAlternateProtocolUsage = "unspecifiedReason"
+)
+
+// NetworkServiceWorkerRouterSource https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerRouterSource
+type NetworkServiceWorkerRouterSource string
+
+// NetworkServiceWorkerRouterSource values
+const (
+	NetworkServiceWorkerRouterSourceNetwork                    NetworkServiceWorkerRouterSource = "network"
+	NetworkServiceWorkerRouterSourceCache                      NetworkServiceWorkerRouterSource = "cache"
+	NetworkServiceWorkerRouterSourceFetchEvent                 NetworkServiceWorkerRouterSource = "fetch-event"
+	NetworkServiceWorkerRouterSourceRaceNetworkAndFetchHandler NetworkServiceWorkerRouterSource = "race-network-and-fetch-handler"
+	NetworkServiceWorkerRouterSourceRaceNetworkAndCache        NetworkServiceWorkerRouterSource = "race-network-and-cache"
+)
+
+// NetworkServiceWorkerRouterInfo https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ServiceWorkerRouterInfo
+type NetworkServiceWorkerRouterInfo struct {
+	RuleIDMatched     int64                            `json:"ruleIdMatched,omitempty"`
+	MatchedSourceType NetworkServiceWorkerRouterSource `json:"matchedSourceType,omitempty"`
+	ActualSourceType  NetworkServiceWorkerRouterSource `json:"actualSourceType,omitempty"`
+}
+
+// NetworkResponse https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Response
+type NetworkResponse struct {
+	URL        string         `json:"url"`
+	Status     int64          `json:"status"`
+	StatusText string         `json:"statusText"`
+	Headers    NetworkHeaders `json:"headers"`
+	// Deprecated: deprecated in protocol
+	HeadersText    string         `json:"headersText,omitempty"`
+	MimeType       string         `json:"mimeType"`
+	Charset        string         `json:"charset"`
+	RequestHeaders NetworkHeaders `json:"requestHeaders,omitempty"`
+	// Deprecated: deprecated in protocol
+	RequestHeadersText          string                             `json:"requestHeadersText,omitempty"`
+	ConnectionReused            bool                               `json:"connectionReused"`
+	ConnectionID                float64                            `json:"connectionId"`
+	RemoteIPAddress             string                             `json:"remoteIPAddress,omitempty"`
+	RemotePort                  int64                              `json:"remotePort,omitempty"`
+	FromDiskCache               bool                               `json:"fromDiskCache,omitempty"`
+	FromServiceWorker           bool                               `json:"fromServiceWorker,omitempty"`
+	FromPrefetchCache           bool                               `json:"fromPrefetchCache,omitempty"`
+	FromEarlyHints              bool                               `json:"fromEarlyHints,omitempty"`
+	ServiceWorkerRouterInfo     *NetworkServiceWorkerRouterInfo    `json:"serviceWorkerRouterInfo,omitempty"`
+	EncodedDataLength           float64                            `json:"encodedDataLength"`
+	Timing                      *NetworkResourceTiming             `json:"timing,omitempty"`
+	ServiceWorkerResponseSource NetworkServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"`
+	ResponseTime                NetworkTimeSinceEpoch              `json:"responseTime,omitempty"`
+	CacheStorageCacheName       string                             `json:"cacheStorageCacheName,omitempty"`
+	Protocol                    string                             `json:"protocol,omitempty"`
+	AlternateProtocolUsage      NetworkAlternateProtocolUsage      `json:"alternateProtocolUsage,omitempty"`
+	SecurityState               SecuritySecurityState              `json:"securityState"`
+	SecurityDetails             *NetworkSecurityDetails            `json:"securityDetails,omitempty"`
+	IsIPProtectionUsed          bool                               `json:"isIpProtectionUsed,omitempty"`
+}
+
+// NetworkWebSocketRequest https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketRequest
+type NetworkWebSocketRequest struct {
+	Headers NetworkHeaders `json:"headers"`
+}
+
+// NetworkWebSocketResponse https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketResponse
+type NetworkWebSocketResponse struct {
+	Status             int64          `json:"status"`
+	StatusText         string         `json:"statusText"`
+	Headers            NetworkHeaders `json:"headers"`
+	HeadersText        string         `json:"headersText,omitempty"`
+	RequestHeaders     NetworkHeaders `json:"requestHeaders,omitempty"`
+	RequestHeadersText string         `json:"requestHeadersText,omitempty"`
+}
+
+// NetworkWebSocketFrame https://chromedevtools.github.io/devtools-protocol/tot/Network#type-WebSocketFrame
+type NetworkWebSocketFrame struct {
+	Opcode      float64 `json:"opcode"`
+	Mask        bool    `json:"mask"`
+	PayloadData string  `json:"payloadData"`
+}
+
+// NetworkCachedResource https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CachedResource
+type NetworkCachedResource struct {
+	URL      string              `json:"url"`
+	Type     NetworkResourceType `json:"type"`
+	Response *NetworkResponse    `json:"response,omitempty"`
+	BodySize float64             `json:"bodySize"`
+}
+
+// NetworkInitiator https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Initiator
+type NetworkInitiator struct {
+	Type         NetworkInitiatorType `json:"type"`
+	Stack        *RuntimeStackTrace   `json:"stack,omitempty"`
+	URL          string               `json:"url,omitempty"`
+	LineNumber   float64              `json:"lineNumber,omitempty"`
+	ColumnNumber float64              `json:"columnNumber,omitempty"`
+	RequestID    NetworkRequestID     `json:"requestId,omitempty"`
+}
+
+// NetworkCookiePartitionKey https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookiePartitionKey
+type NetworkCookiePartitionKey struct {
+	TopLevelSite         string `json:"topLevelSite"`
+	HasCrossSiteAncestor bool   `json:"hasCrossSiteAncestor"`
+}
+
+// NetworkCookie https://chromedevtools.github.io/devtools-protocol/tot/Network#type-Cookie
+type NetworkCookie struct {
+	Name     string                `json:"name"`
+	Value    string                `json:"value"`
+	Domain   string                `json:"domain"`
+	Path     string                `json:"path"`
+	Expires  float64               `json:"expires"`
+	Size     int64                 `json:"size"`
+	HTTPOnly bool                  `json:"httpOnly"`
+	Secure   bool                  `json:"secure"`
+	Session  bool                  `json:"session"`
+	SameSite NetworkCookieSameSite `json:"sameSite,omitempty"`
+	Priority NetworkCookiePriority `json:"priority"`
+	// Deprecated: deprecated in protocol
+	SameParty          bool                       `json:"sameParty"`
+	SourceScheme       NetworkCookieSourceScheme  `json:"sourceScheme"`
+	SourcePort         int64                      `json:"sourcePort"`
+	PartitionKey       *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+	PartitionKeyOpaque bool                       `json:"partitionKeyOpaque,omitempty"`
+}
+
+// NetworkSetCookieBlockedReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SetCookieBlockedReason
+type NetworkSetCookieBlockedReason string
+
+// NetworkSetCookieBlockedReason values
+const (
+	NetworkSetCookieBlockedReasonSecureOnly                               NetworkSetCookieBlockedReason = "SecureOnly"
+	NetworkSetCookieBlockedReasonSameSiteStrict                           NetworkSetCookieBlockedReason = "SameSiteStrict"
+	NetworkSetCookieBlockedReasonSameSiteLax                              NetworkSetCookieBlockedReason = "SameSiteLax"
+	NetworkSetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          NetworkSetCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	NetworkSetCookieBlockedReasonSameSiteNoneInsecure                     NetworkSetCookieBlockedReason = "SameSiteNoneInsecure"
+	NetworkSetCookieBlockedReasonUserPreferences                          NetworkSetCookieBlockedReason = "UserPreferences"
+	NetworkSetCookieBlockedReasonThirdPartyPhaseout                       NetworkSetCookieBlockedReason = "ThirdPartyPhaseout"
+	NetworkSetCookieBlockedReasonThirdPartyBlockedInFirstPartySet         NetworkSetCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	NetworkSetCookieBlockedReasonSyntaxError                              NetworkSetCookieBlockedReason = "SyntaxError"
+	NetworkSetCookieBlockedReasonSchemeNotSupported                       NetworkSetCookieBlockedReason = "SchemeNotSupported"
+	NetworkSetCookieBlockedReasonOverwriteSecure                          NetworkSetCookieBlockedReason = "OverwriteSecure"
+	NetworkSetCookieBlockedReasonInvalidDomain                            NetworkSetCookieBlockedReason = "InvalidDomain"
+	NetworkSetCookieBlockedReasonInvalidPrefix                            NetworkSetCookieBlockedReason = "InvalidPrefix"
+	NetworkSetCookieBlockedReasonUnknownError                             NetworkSetCookieBlockedReason = "UnknownError"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteStrict                  NetworkSetCookieBlockedReason = "SchemefulSameSiteStrict"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteLax                     NetworkSetCookieBlockedReason = "SchemefulSameSiteLax"
+	NetworkSetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax NetworkSetCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	NetworkSetCookieBlockedReasonSamePartyFromCrossPartyContext           NetworkSetCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	NetworkSetCookieBlockedReasonSamePartyConflictsWithOtherAttributes    NetworkSetCookieBlockedReason = "SamePartyConflictsWithOtherAttributes"
+	NetworkSetCookieBlockedReasonNameValuePairExceedsMaxSize              NetworkSetCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	NetworkSetCookieBlockedReasonDisallowedCharacter                      NetworkSetCookieBlockedReason = "DisallowedCharacter"
+	NetworkSetCookieBlockedReasonNoCookieContent                          NetworkSetCookieBlockedReason = "NoCookieContent"
+)
+
+// NetworkCookieBlockedReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieBlockedReason
+type NetworkCookieBlockedReason string
+
+// NetworkCookieBlockedReason values
+const (
+	NetworkCookieBlockedReasonSecureOnly                               NetworkCookieBlockedReason = "SecureOnly"
+	NetworkCookieBlockedReasonNotOnPath                                NetworkCookieBlockedReason = "NotOnPath"
+	NetworkCookieBlockedReasonDomainMismatch                           NetworkCookieBlockedReason = "DomainMismatch"
+	NetworkCookieBlockedReasonSameSiteStrict                           NetworkCookieBlockedReason = "SameSiteStrict"
+	NetworkCookieBlockedReasonSameSiteLax                              NetworkCookieBlockedReason = "SameSiteLax"
+	NetworkCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          NetworkCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	NetworkCookieBlockedReasonSameSiteNoneInsecure                     NetworkCookieBlockedReason = "SameSiteNoneInsecure"
+	NetworkCookieBlockedReasonUserPreferences                          NetworkCookieBlockedReason = "UserPreferences"
+	NetworkCookieBlockedReasonThirdPartyPhaseout                       NetworkCookieBlockedReason = "ThirdPartyPhaseout"
+	NetworkCookieBlockedReasonThirdPartyBlockedInFirstPartySet         NetworkCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	NetworkCookieBlockedReasonUnknownError                             NetworkCookieBlockedReason = "UnknownError"
+	NetworkCookieBlockedReasonSchemefulSameSiteStrict                  NetworkCookieBlockedReason = "SchemefulSameSiteStrict"
+	NetworkCookieBlockedReasonSchemefulSameSiteLax                     NetworkCookieBlockedReason = "SchemefulSameSiteLax"
+	NetworkCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax NetworkCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	NetworkCookieBlockedReasonSamePartyFromCrossPartyContext           NetworkCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	NetworkCookieBlockedReasonNameValuePairExceedsMaxSize              NetworkCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	NetworkCookieBlockedReasonPortMismatch                             NetworkCookieBlockedReason = "PortMismatch"
+	NetworkCookieBlockedReasonSchemeMismatch                           NetworkCookieBlockedReason = "SchemeMismatch"
+	NetworkCookieBlockedReasonAnonymousContext                         NetworkCookieBlockedReason = "AnonymousContext"
+)
+
+// NetworkCookieExemptionReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieExemptionReason
+type NetworkCookieExemptionReason string
+
+// NetworkCookieExemptionReason values
+const (
+	NetworkCookieExemptionReasonNone                         NetworkCookieExemptionReason = "None"
+	NetworkCookieExemptionReasonUserSetting                  NetworkCookieExemptionReason = "UserSetting"
+	NetworkCookieExemptionReasonTPCDMetadata                 NetworkCookieExemptionReason = "TPCDMetadata"
+	NetworkCookieExemptionReasonTPCDDeprecationTrial         NetworkCookieExemptionReason = "TPCDDeprecationTrial"
+	NetworkCookieExemptionReasonTopLevelTPCDDeprecationTrial NetworkCookieExemptionReason = "TopLevelTPCDDeprecationTrial"
+	NetworkCookieExemptionReasonTPCDHeuristics               NetworkCookieExemptionReason = "TPCDHeuristics"
+	NetworkCookieExemptionReasonEnterprisePolicy             NetworkCookieExemptionReason = "EnterprisePolicy"
+	NetworkCookieExemptionReasonStorageAccess                NetworkCookieExemptionReason = "StorageAccess"
+	NetworkCookieExemptionReasonTopLevelStorageAccess        NetworkCookieExemptionReason = "TopLevelStorageAccess"
+	NetworkCookieExemptionReasonScheme                       NetworkCookieExemptionReason = "Scheme"
+	NetworkCookieExemptionReasonSameSiteNoneCookiesInSandbox NetworkCookieExemptionReason = "SameSiteNoneCookiesInSandbox"
+)
+
+// NetworkBlockedSetCookieWithReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-BlockedSetCookieWithReason
+type NetworkBlockedSetCookieWithReason struct {
+	BlockedReasons []NetworkSetCookieBlockedReason `json:"blockedReasons"`
+	CookieLine     string                          `json:"cookieLine"`
+	Cookie         *NetworkCookie                  `json:"cookie,omitempty"`
+}
+
+// NetworkExemptedSetCookieWithReason https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ExemptedSetCookieWithReason
+type NetworkExemptedSetCookieWithReason struct {
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason"`
+	CookieLine      string                       `json:"cookieLine"`
+	Cookie          *NetworkCookie               `json:"cookie"`
+}
+
+// NetworkAssociatedCookie https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AssociatedCookie
+type NetworkAssociatedCookie struct {
+	Cookie          *NetworkCookie               `json:"cookie"`
+	BlockedReasons  []NetworkCookieBlockedReason `json:"blockedReasons"`
+	ExemptionReason NetworkCookieExemptionReason `json:"exemptionReason,omitempty"`
+}
+
+// NetworkCookieParam https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CookieParam
+type NetworkCookieParam struct {
+	Name         string                     `json:"name"`
+	Value        string                     `json:"value"`
+	URL          string                     `json:"url,omitempty"`
+	Domain       string                     `json:"domain,omitempty"`
+	Path         string                     `json:"path,omitempty"`
+	Secure       bool                       `json:"secure,omitempty"`
+	HTTPOnly     bool                       `json:"httpOnly,omitempty"`
+	SameSite     NetworkCookieSameSite      `json:"sameSite,omitempty"`
+	Expires      NetworkTimeSinceEpoch      `json:"expires,omitempty"`
+	Priority     NetworkCookiePriority      `json:"priority,omitempty"`
+	SameParty    bool                       `json:"sameParty,omitempty"`
+	SourceScheme NetworkCookieSourceScheme  `json:"sourceScheme,omitempty"`
+	SourcePort   int64                      `json:"sourcePort,omitempty"`
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// NetworkAuthChallenge https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AuthChallenge
+type NetworkAuthChallenge struct {
+	Source NetworkAuthChallengeSource `json:"source,omitempty"`
+	Origin string                     `json:"origin"`
+	Scheme string                     `json:"scheme"`
+	Realm  string                     `json:"realm"`
+}
+
+// NetworkAuthChallengeResponse https://chromedevtools.github.io/devtools-protocol/tot/Network#type-AuthChallengeResponse
+type NetworkAuthChallengeResponse struct {
+	Response NetworkAuthChallengeResponseResponse `json:"response"`
+	Username string                               `json:"username,omitempty"`
+	Password string                               `json:"password,omitempty"`
+}
+
+// NetworkInterceptionStage https://chromedevtools.github.io/devtools-protocol/tot/Network#type-InterceptionStage
+type NetworkInterceptionStage string
+
+// NetworkInterceptionStage values
+const (
+	NetworkInterceptionStageRequest         NetworkInterceptionStage = "Request"
+	NetworkInterceptionStageHeadersReceived NetworkInterceptionStage = "HeadersReceived"
+)
+
+// NetworkRequestPattern https://chromedevtools.github.io/devtools-protocol/tot/Network#type-RequestPattern
+type NetworkRequestPattern struct {
+	URLPattern        string                   `json:"urlPattern,omitempty"`
+	ResourceType      NetworkResourceType      `json:"resourceType,omitempty"`
+	InterceptionStage NetworkInterceptionStage `json:"interceptionStage,omitempty"`
+}
+
+// NetworkSignedExchangeSignature https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeSignature
+type NetworkSignedExchangeSignature struct {
+	Label        string   `json:"label"`
+	Signature    string   `json:"signature"`
+	Integrity    string   `json:"integrity"`
+	CertURL      string   `json:"certUrl,omitempty"`
+	CertSha256   string   `json:"certSha256,omitempty"`
+	ValidityURL  string   `json:"validityUrl"`
+	Date         int64    `json:"date"`
+	Expires      int64    `json:"expires"`
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// NetworkSignedExchangeHeader https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeHeader
+type NetworkSignedExchangeHeader struct {
+	RequestURL      string                            `json:"requestUrl"`
+	ResponseCode    int64                             `json:"responseCode"`
+	ResponseHeaders NetworkHeaders                    `json:"responseHeaders"`
+	Signatures      []*NetworkSignedExchangeSignature `json:"signatures"`
+	HeaderIntegrity string                            `json:"headerIntegrity"`
+}
+
+// NetworkSignedExchangeErrorField https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeErrorField
+type NetworkSignedExchangeErrorField string
+
+// NetworkSignedExchangeErrorField values
+const (
+	NetworkSignedExchangeErrorFieldSignatureSig         NetworkSignedExchangeErrorField = "signatureSig"
+	NetworkSignedExchangeErrorFieldSignatureIntegrity   NetworkSignedExchangeErrorField = "signatureIntegrity"
+	NetworkSignedExchangeErrorFieldSignatureCertURL     NetworkSignedExchangeErrorField = "signatureCertUrl"
+	NetworkSignedExchangeErrorFieldSignatureCertSha256  NetworkSignedExchangeErrorField = "signatureCertSha256"
+	NetworkSignedExchangeErrorFieldSignatureValidityURL NetworkSignedExchangeErrorField = "signatureValidityUrl"
+	NetworkSignedExchangeErrorFieldSignatureTimestamps  NetworkSignedExchangeErrorField = "signatureTimestamps"
+)
+
+// NetworkSignedExchangeError https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeError
+type NetworkSignedExchangeError struct {
+	Message        string                          `json:"message"`
+	SignatureIndex int64                           `json:"signatureIndex,omitempty"`
+	ErrorField     NetworkSignedExchangeErrorField `json:"errorField,omitempty"`
+}
+
+// NetworkSignedExchangeInfo https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SignedExchangeInfo
+type NetworkSignedExchangeInfo struct {
+	OuterResponse   *NetworkResponse              `json:"outerResponse"`
+	HasExtraInfo    bool                          `json:"hasExtraInfo"`
+	Header          *NetworkSignedExchangeHeader  `json:"header,omitempty"`
+	SecurityDetails *NetworkSecurityDetails       `json:"securityDetails,omitempty"`
+	Errors          []*NetworkSignedExchangeError `json:"errors,omitempty"`
+}
+
+// NetworkContentEncoding https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentEncoding
+type NetworkContentEncoding string
+
+// NetworkContentEncoding values
+const (
+	NetworkContentEncodingDeflate NetworkContentEncoding = "deflate"
+	NetworkContentEncodingGzip    NetworkContentEncoding = "gzip"
+	NetworkContentEncodingBr      NetworkContentEncoding = "br"
+	NetworkContentEncodingZstd    NetworkContentEncoding = "zstd"
+)
+
+// NetworkDirectSocketDnsQueryType https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectSocketDnsQueryType
+type NetworkDirectSocketDnsQueryType string
+
+// NetworkDirectSocketDnsQueryType values
+const (
+	NetworkDirectSocketDnsQueryTypeIpv4 NetworkDirectSocketDnsQueryType = "ipv4"
+	NetworkDirectSocketDnsQueryTypeIpv6 NetworkDirectSocketDnsQueryType = "ipv6"
+)
+
+// NetworkDirectTCPSocketOptions https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectTCPSocketOptions
+type NetworkDirectTCPSocketOptions struct {
+	NoDelay           bool                            `json:"noDelay"`
+	KeepAliveDelay    float64                         `json:"keepAliveDelay,omitempty"`
+	SendBufferSize    float64                         `json:"sendBufferSize,omitempty"`
+	ReceiveBufferSize float64                         `json:"receiveBufferSize,omitempty"`
+	DnsQueryType      NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+}
+
+// NetworkDirectUDPSocketOptions https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectUDPSocketOptions
+type NetworkDirectUDPSocketOptions struct {
+	RemoteAddr        string                          `json:"remoteAddr,omitempty"`
+	RemotePort        int64                           `json:"remotePort,omitempty"`
+	LocalAddr         string                          `json:"localAddr,omitempty"`
+	LocalPort         int64                           `json:"localPort,omitempty"`
+	DnsQueryType      NetworkDirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+	SendBufferSize    float64                         `json:"sendBufferSize,omitempty"`
+	ReceiveBufferSize float64                         `json:"receiveBufferSize,omitempty"`
+}
+
+// NetworkDirectUDPMessage https://chromedevtools.github.io/devtools-protocol/tot/Network#type-DirectUDPMessage
+type NetworkDirectUDPMessage struct {
+	Data       string `json:"data"`
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+	RemotePort int64  `json:"remotePort,omitempty"`
+}
+
+// NetworkPrivateNetworkRequestPolicy https://chromedevtools.github.io/devtools-protocol/tot/Network#type-PrivateNetworkRequestPolicy
+type NetworkPrivateNetworkRequestPolicy string
+
+// NetworkPrivateNetworkRequestPolicy values
+const (
+	NetworkPrivateNetworkRequestPolicyAllow                          NetworkPrivateNetworkRequestPolicy = "Allow"
+	NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate NetworkPrivateNetworkRequestPolicy = "BlockFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  NetworkPrivateNetworkRequestPolicy = "WarnFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyPreflightBlock                 NetworkPrivateNetworkRequestPolicy = "PreflightBlock"
+	NetworkPrivateNetworkRequestPolicyPreflightWarn                  NetworkPrivateNetworkRequestPolicy = "PreflightWarn"
+	NetworkPrivateNetworkRequestPolicyPermissionBlock                NetworkPrivateNetworkRequestPolicy = "PermissionBlock"
+	NetworkPrivateNetworkRequestPolicyPermissionWarn                 NetworkPrivateNetworkRequestPolicy = "PermissionWarn"
+)
+
+// NetworkIPAddressSpace https://chromedevtools.github.io/devtools-protocol/tot/Network#type-IPAddressSpace
+type NetworkIPAddressSpace string
+
+// NetworkIPAddressSpace values
+const (
+	NetworkIPAddressSpaceLoopback NetworkIPAddressSpace = "Loopback"
+	NetworkIPAddressSpaceLocal    NetworkIPAddressSpace = "Local"
+	NetworkIPAddressSpacePublic   NetworkIPAddressSpace = "Public"
+	NetworkIPAddressSpaceUnknown  NetworkIPAddressSpace = "Unknown"
+)
+
+// NetworkConnectTiming https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ConnectTiming
+type NetworkConnectTiming struct {
+	RequestTime float64 `json:"requestTime"`
+}
+
+// NetworkClientSecurityState https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ClientSecurityState
+type NetworkClientSecurityState struct {
+	InitiatorIsSecureContext    bool                               `json:"initiatorIsSecureContext"`
+	InitiatorIPAddressSpace     NetworkIPAddressSpace              `json:"initiatorIPAddressSpace"`
+	PrivateNetworkRequestPolicy NetworkPrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"`
+}
+
+// NetworkCrossOriginOpenerPolicyValue https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginOpenerPolicyValue
+type NetworkCrossOriginOpenerPolicyValue string
+
+// NetworkCrossOriginOpenerPolicyValue values
+const (
+	NetworkCrossOriginOpenerPolicyValueSameOrigin                 NetworkCrossOriginOpenerPolicyValue = "SameOrigin"
+	NetworkCrossOriginOpenerPolicyValueSameOriginAllowPopups      NetworkCrossOriginOpenerPolicyValue = "SameOriginAllowPopups"
+	NetworkCrossOriginOpenerPolicyValueRestrictProperties         NetworkCrossOriginOpenerPolicyValue = "RestrictProperties"
+	NetworkCrossOriginOpenerPolicyValueUnsafeNone                 NetworkCrossOriginOpenerPolicyValue = "UnsafeNone"
+	NetworkCrossOriginOpenerPolicyValueSameOriginPlusCoep         NetworkCrossOriginOpenerPolicyValue = "SameOriginPlusCoep"
+	NetworkCrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep NetworkCrossOriginOpenerPolicyValue = "RestrictPropertiesPlusCoep"
+	NetworkCrossOriginOpenerPolicyValueNoopenerAllowPopups        NetworkCrossOriginOpenerPolicyValue = "NoopenerAllowPopups"
+)
+
+// NetworkCrossOriginOpenerPolicyStatus https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginOpenerPolicyStatus
+type NetworkCrossOriginOpenerPolicyStatus struct {
+	Value                       NetworkCrossOriginOpenerPolicyValue `json:"value"`
+	ReportOnlyValue             NetworkCrossOriginOpenerPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                              `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                              `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// NetworkCrossOriginEmbedderPolicyValue https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginEmbedderPolicyValue
+type NetworkCrossOriginEmbedderPolicyValue string
+
+// NetworkCrossOriginEmbedderPolicyValue values
+const (
+	NetworkCrossOriginEmbedderPolicyValueNone           NetworkCrossOriginEmbedderPolicyValue = "None"
+	NetworkCrossOriginEmbedderPolicyValueCredentialless NetworkCrossOriginEmbedderPolicyValue = "Credentialless"
+	NetworkCrossOriginEmbedderPolicyValueRequireCorp    NetworkCrossOriginEmbedderPolicyValue = "RequireCorp"
+)
+
+// NetworkCrossOriginEmbedderPolicyStatus https://chromedevtools.github.io/devtools-protocol/tot/Network#type-CrossOriginEmbedderPolicyStatus
+type NetworkCrossOriginEmbedderPolicyStatus struct {
+	Value                       NetworkCrossOriginEmbedderPolicyValue `json:"value"`
+	ReportOnlyValue             NetworkCrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           string                                `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint string                                `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// NetworkContentSecurityPolicySource https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentSecurityPolicySource
+type NetworkContentSecurityPolicySource string
+
+// NetworkContentSecurityPolicySource values
+const (
+	NetworkContentSecurityPolicySourceHTTP NetworkContentSecurityPolicySource = "HTTP"
+	NetworkContentSecurityPolicySourceMeta NetworkContentSecurityPolicySource = "Meta"
+)
+
+// NetworkContentSecurityPolicyStatus https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ContentSecurityPolicyStatus
+type NetworkContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                             `json:"effectiveDirectives"`
+	IsEnforced          bool                               `json:"isEnforced"`
+	Source              NetworkContentSecurityPolicySource `json:"source"`
+}
+
+// NetworkSecurityIsolationStatus https://chromedevtools.github.io/devtools-protocol/tot/Network#type-SecurityIsolationStatus
+type NetworkSecurityIsolationStatus struct {
+	Coop *NetworkCrossOriginOpenerPolicyStatus   `json:"coop,omitempty"`
+	Coep *NetworkCrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+	Csp  []*NetworkContentSecurityPolicyStatus   `json:"csp,omitempty"`
+}
+
+// NetworkReportStatus https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportStatus
+type NetworkReportStatus string
+
+// NetworkReportStatus values
+const (
+	NetworkReportStatusQueued           NetworkReportStatus = "Queued"
+	NetworkReportStatusPending          NetworkReportStatus = "Pending"
+	NetworkReportStatusMarkedForRemoval NetworkReportStatus = "MarkedForRemoval"
+	NetworkReportStatusSuccess          NetworkReportStatus = "Success"
+)
+
+// NetworkReportID https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportId
+type NetworkReportID string
+
+// NetworkReportingAPIReport https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportingApiReport
+type NetworkReportingAPIReport struct {
+	ID                NetworkReportID        `json:"id"`
+	InitiatorURL      string                 `json:"initiatorUrl"`
+	Destination       string                 `json:"destination"`
+	Type              string                 `json:"type"`
+	Timestamp         NetworkTimeSinceEpoch  `json:"timestamp"`
+	Depth             int64                  `json:"depth"`
+	CompletedAttempts int64                  `json:"completedAttempts"`
+	Body              map[string]interface{} `json:"body"`
+	Status            NetworkReportStatus    `json:"status"`
+}
+
+// NetworkReportingAPIEndpoint https://chromedevtools.github.io/devtools-protocol/tot/Network#type-ReportingApiEndpoint
+type NetworkReportingAPIEndpoint struct {
+	URL       string `json:"url"`
+	GroupName string `json:"groupName"`
+}
+
+// NetworkLoadNetworkResourcePageResult https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoadNetworkResourcePageResult
+type NetworkLoadNetworkResourcePageResult struct {
+	Success        bool           `json:"success"`
+	NetError       float64        `json:"netError,omitempty"`
+	NetErrorName   string         `json:"netErrorName,omitempty"`
+	HTTPStatusCode float64        `json:"httpStatusCode,omitempty"`
+	Stream         IOStreamHandle `json:"stream,omitempty"`
+	Headers        NetworkHeaders `json:"headers,omitempty"`
+}
+
+// NetworkLoadNetworkResourceOptions https://chromedevtools.github.io/devtools-protocol/tot/Network#type-LoadNetworkResourceOptions
+type NetworkLoadNetworkResourceOptions struct {
+	DisableCache       bool `json:"disableCache"`
+	IncludeCredentials bool `json:"includeCredentials"`
+}
+
+// NetworkSetAcceptedEncodingsParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setAcceptedEncodings
+type NetworkSetAcceptedEncodingsParams struct {
+	Encodings []NetworkContentEncoding `json:"encodings"`
+}
+
+// ProtocolMethod returns Network.setAcceptedEncodings
+func (*NetworkSetAcceptedEncodingsParams) ProtocolMethod() string {
+	return "Network.setAcceptedEncodings"
+}
+
+// Do sends Network.setAcceptedEncodings
+func (p *NetworkSetAcceptedEncodingsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkClearAcceptedEncodingsOverrideParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-clearAcceptedEncodingsOverride
+type NetworkClearAcceptedEncodingsOverrideParams struct {
+}
+
+// ProtocolMethod returns Network.clearAcceptedEncodingsOverride
+func (*NetworkClearAcceptedEncodingsOverrideParams) ProtocolMethod() string {
+	return "Network.clearAcceptedEncodingsOverride"
+}
+
+// Do sends Network.clearAcceptedEncodingsOverride
+func (p *NetworkClearAcceptedEncodingsOverrideParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkCanClearBrowserCacheParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canClearBrowserCache
+//
+// Deprecated: deprecated in protocol
+type NetworkCanClearBrowserCacheParams struct {
+}
+
+// ProtocolMethod returns Network.canClearBrowserCache
+func (*NetworkCanClearBrowserCacheParams) ProtocolMethod() string {
+	return "Network.canClearBrowserCache"
+}
+
+// NetworkCanClearBrowserCacheResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canClearBrowserCache
+//
+// Deprecated: deprecated in protocol
+type NetworkCanClearBrowserCacheResult struct {
+	Result bool `json:"result"`
+}
+
+// Do sends Network.canClearBrowserCache and returns its result
+func (p *NetworkCanClearBrowserCacheParams) Do(e Executor) (*NetworkCanClearBrowserCacheResult, error) {
+	var result = new(NetworkCanClearBrowserCacheResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkCanClearBrowserCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canClearBrowserCookies
+//
+// Deprecated: deprecated in protocol
+type NetworkCanClearBrowserCookiesParams struct {
+}
+
+// ProtocolMethod returns Network.canClearBrowserCookies
+func (*NetworkCanClearBrowserCookiesParams) ProtocolMethod() string {
+	return "Network.canClearBrowserCookies"
+}
+
+// NetworkCanClearBrowserCookiesResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canClearBrowserCookies
+//
+// Deprecated: deprecated in protocol
+type NetworkCanClearBrowserCookiesResult struct {
+	Result bool `json:"result"`
+}
+
+// Do sends Network.canClearBrowserCookies and returns its result
+func (p *NetworkCanClearBrowserCookiesParams) Do(e Executor) (*NetworkCanClearBrowserCookiesResult, error) {
+	var result = new(NetworkCanClearBrowserCookiesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkCanEmulateNetworkConditionsParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canEmulateNetworkConditions
+//
+// Deprecated: deprecated in protocol
+type NetworkCanEmulateNetworkConditionsParams struct {
+}
+
+// ProtocolMethod returns Network.canEmulateNetworkConditions
+func (*NetworkCanEmulateNetworkConditionsParams) ProtocolMethod() string {
+	return "Network.canEmulateNetworkConditions"
+}
+
+// NetworkCanEmulateNetworkConditionsResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-canEmulateNetworkConditions
+//
+// Deprecated: deprecated in protocol
+type NetworkCanEmulateNetworkConditionsResult struct {
+	Result bool `json:"result"`
+}
+
+// Do sends Network.canEmulateNetworkConditions and returns its result
+func (p *NetworkCanEmulateNetworkConditionsParams) Do(e Executor) (*NetworkCanEmulateNetworkConditionsResult, error) {
+	var result = new(NetworkCanEmulateNetworkConditionsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkClearBrowserCacheParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-clearBrowserCache
+type NetworkClearBrowserCacheParams struct {
+}
+
+// ProtocolMethod returns Network.clearBrowserCache
+func (*NetworkClearBrowserCacheParams) ProtocolMethod() string {
+	return "Network.clearBrowserCache"
+}
+
+// Do sends Network.clearBrowserCache
+func (p *NetworkClearBrowserCacheParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkClearBrowserCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-clearBrowserCookies
+type NetworkClearBrowserCookiesParams struct {
+}
+
+// ProtocolMethod returns Network.clearBrowserCookies
+func (*NetworkClearBrowserCookiesParams) ProtocolMethod() string {
+	return "Network.clearBrowserCookies"
+}
+
+// Do sends Network.clearBrowserCookies
+func (p *NetworkClearBrowserCookiesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkContinueInterceptedRequestParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-continueInterceptedRequest
+//
+// Deprecated: deprecated in protocol
+type NetworkContinueInterceptedRequestParams struct {
+	InterceptionID        NetworkInterceptionID         `json:"interceptionId"`
+	ErrorReason           NetworkErrorReason            `json:"errorReason,omitempty"`
+	RawResponse           string                        `json:"rawResponse,omitempty"`
+	URL                   string                        `json:"url,omitempty"`
+	Method                string                        `json:"method,omitempty"`
+	PostData              string                        `json:"postData,omitempty"`
+	Headers               NetworkHeaders                `json:"headers,omitempty"`
+	AuthChallengeResponse *NetworkAuthChallengeResponse `json:"authChallengeResponse,omitempty"`
+}
+
+// ProtocolMethod returns Network.continueInterceptedRequest
+func (*NetworkContinueInterceptedRequestParams) ProtocolMethod() string {
+	return "Network.continueInterceptedRequest"
+}
+
+// Do sends Network.continueInterceptedRequest
+func (p *NetworkContinueInterceptedRequestParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkDeleteCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-deleteCookies
+type NetworkDeleteCookiesParams struct {
+	Name         string                     `json:"name"`
+	URL          string                     `json:"url,omitempty"`
+	Domain       string                     `json:"domain,omitempty"`
+	Path         string                     `json:"path,omitempty"`
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// ProtocolMethod returns Network.deleteCookies
+func (*NetworkDeleteCookiesParams) ProtocolMethod() string {
+	return "Network.deleteCookies"
+}
+
+// Do sends Network.deleteCookies
+func (p *NetworkDeleteCookiesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-disable
+type NetworkDisableParams struct {
+}
+
+// ProtocolMethod returns Network.disable
+func (*NetworkDisableParams) ProtocolMethod() string {
+	return "Network.disable"
+}
+
+// Do sends Network.disable
+func (p *NetworkDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkEmulateNetworkConditionsParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-emulateNetworkConditions
+type NetworkEmulateNetworkConditionsParams struct {
+	Offline            bool                  `json:"offline"`
+	Latency            float64               `json:"latency"`
+	DownloadThroughput float64               `json:"downloadThroughput"`
+	UploadThroughput   float64               `json:"uploadThroughput"`
+	ConnectionType     NetworkConnectionType `json:"connectionType,omitempty"`
+	PacketLoss         float64               `json:"packetLoss,omitempty"`
+	PacketQueueLength  int64                 `json:"packetQueueLength,omitempty"`
+	PacketReordering   bool                  `json:"packetReordering,omitempty"`
+}
+
+// ProtocolMethod returns Network.emulateNetworkConditions
+func (*NetworkEmulateNetworkConditionsParams) ProtocolMethod() string {
+	return "Network.emulateNetworkConditions"
+}
+
+// Do sends Network.emulateNetworkConditions
+func (p *NetworkEmulateNetworkConditionsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-enable
+type NetworkEnableParams struct {
+	MaxTotalBufferSize        int64 `json:"maxTotalBufferSize,omitempty"`
+	MaxResourceBufferSize     int64 `json:"maxResourceBufferSize,omitempty"`
+	MaxPostDataSize           int64 `json:"maxPostDataSize,omitempty"`
+	ReportDirectSocketTraffic bool  `json:"reportDirectSocketTraffic,omitempty"`
+}
+
+// ProtocolMethod returns Network.enable
+func (*NetworkEnableParams) ProtocolMethod() string {
+	return "Network.enable"
+}
+
+// Do sends Network.enable
+func (p *NetworkEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkGetAllCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getAllCookies
+//
+// Deprecated: deprecated in protocol
+type NetworkGetAllCookiesParams struct {
+}
+
+// ProtocolMethod returns Network.getAllCookies
+func (*NetworkGetAllCookiesParams) ProtocolMethod() string {
+	return "Network.getAllCookies"
+}
+
+// NetworkGetAllCookiesResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getAllCookies
+//
+// Deprecated: deprecated in protocol
+type NetworkGetAllCookiesResult struct {
+	Cookies []*NetworkCookie `json:"cookies"`
+}
+
+// Do sends Network.getAllCookies and returns its result
+func (p *NetworkGetAllCookiesParams) Do(e Executor) (*NetworkGetAllCookiesResult, error) {
+	var result = new(NetworkGetAllCookiesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkGetCertificateParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getCertificate
+type NetworkGetCertificateParams struct {
+	Origin string `json:"origin"`
+}
+
+// ProtocolMethod returns Network.getCertificate
+func (*NetworkGetCertificateParams) ProtocolMethod() string {
+	return "Network.getCertificate"
+}
+
+// NetworkGetCertificateResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getCertificate
+type NetworkGetCertificateResult struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// Do sends Network.getCertificate and returns its result
+func (p *NetworkGetCertificateParams) Do(e Executor) (*NetworkGetCertificateResult, error) {
+	var result = new(NetworkGetCertificateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkGetCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getCookies
+type NetworkGetCookiesParams struct {
+	Urls []string `json:"urls,omitempty"`
+}
+
+// ProtocolMethod returns Network.getCookies
+func (*NetworkGetCookiesParams) ProtocolMethod() string {
+	return "Network.getCookies"
+}
+
+// NetworkGetCookiesResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getCookies
+type NetworkGetCookiesResult struct {
+	Cookies []*NetworkCookie `json:"cookies"`
+}
+
+// Do sends Network.getCookies and returns its result
+func (p *NetworkGetCookiesParams) Do(e Executor) (*NetworkGetCookiesResult, error) {
+	var result = new(NetworkGetCookiesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkGetResponseBodyParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getResponseBody
+type NetworkGetResponseBodyParams struct {
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Network.getResponseBody
+func (*NetworkGetResponseBodyParams) ProtocolMethod() string {
+	return "Network.getResponseBody"
+}
+
+// NetworkGetResponseBodyResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getResponseBody
+type NetworkGetResponseBodyResult struct {
+	Body          string `json:"body"`
+	Base64Encoded bool   `json:"base64Encoded"`
+}
+
+// Do sends Network.getResponseBody and returns its result
+func (p *NetworkGetResponseBodyParams) Do(e Executor) (*NetworkGetResponseBodyResult, error) {
+	var result = new(NetworkGetResponseBodyResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkGetRequestPostDataParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getRequestPostData
+type NetworkGetRequestPostDataParams struct {
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Network.getRequestPostData
+func (*NetworkGetRequestPostDataParams) ProtocolMethod() string {
+	return "Network.getRequestPostData"
+}
+
+// NetworkGetRequestPostDataResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getRequestPostData
+type NetworkGetRequestPostDataResult struct {
+	PostData string `json:"postData"`
+}
+
+// Do sends Network.getRequestPostData and returns its result
+func (p *NetworkGetRequestPostDataParams) Do(e Executor) (*NetworkGetRequestPostDataResult, error) {
+	var result = new(NetworkGetRequestPostDataResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkGetResponseBodyForInterceptionParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getResponseBodyForInterception
+type NetworkGetResponseBodyForInterceptionParams struct {
+	InterceptionID NetworkInterceptionID `json:"interceptionId"`
+}
+
+// ProtocolMethod returns Network.getResponseBodyForInterception
+func (*NetworkGetResponseBodyForInterceptionParams) ProtocolMethod() string {
+	return "Network.getResponseBodyForInterception"
+}
+
+// NetworkGetResponseBodyForInterceptionResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getResponseBodyForInterception
+type NetworkGetResponseBodyForInterceptionResult struct {
+	Body          string `json:"body"`
+	Base64Encoded bool   `json:"base64Encoded"`
+}
+
+// Do sends Network.getResponseBodyForInterception and returns its result
+func (p *NetworkGetResponseBodyForInterceptionParams) Do(e Executor) (*NetworkGetResponseBodyForInterceptionResult, error) {
+	var result = new(NetworkGetResponseBodyForInterceptionResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkTakeResponseBodyForInterceptionAsStreamParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-takeResponseBodyForInterceptionAsStream
+type NetworkTakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionID NetworkInterceptionID `json:"interceptionId"`
+}
+
+// ProtocolMethod returns Network.takeResponseBodyForInterceptionAsStream
+func (*NetworkTakeResponseBodyForInterceptionAsStreamParams) ProtocolMethod() string {
+	return "Network.takeResponseBodyForInterceptionAsStream"
+}
+
+// NetworkTakeResponseBodyForInterceptionAsStreamResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-takeResponseBodyForInterceptionAsStream
+type NetworkTakeResponseBodyForInterceptionAsStreamResult struct {
+	Stream IOStreamHandle `json:"stream"`
+}
+
+// Do sends Network.takeResponseBodyForInterceptionAsStream and returns its result
+func (p *NetworkTakeResponseBodyForInterceptionAsStreamParams) Do(e Executor) (*NetworkTakeResponseBodyForInterceptionAsStreamResult, error) {
+	var result = new(NetworkTakeResponseBodyForInterceptionAsStreamResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkReplayXHRParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-replayXHR
+type NetworkReplayXHRParams struct {
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Network.replayXHR
+func (*NetworkReplayXHRParams) ProtocolMethod() string {
+	return "Network.replayXHR"
+}
+
+// Do sends Network.replayXHR
+func (p *NetworkReplayXHRParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSearchInResponseBodyParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-searchInResponseBody
+type NetworkSearchInResponseBodyParams struct {
+	RequestID     NetworkRequestID `json:"requestId"`
+	Query         string           `json:"query"`
+	CaseSensitive bool             `json:"caseSensitive,omitempty"`
+	IsRegex       bool             `json:"isRegex,omitempty"`
+}
+
+// ProtocolMethod returns Network.searchInResponseBody
+func (*NetworkSearchInResponseBodyParams) ProtocolMethod() string {
+	return "Network.searchInResponseBody"
+}
+
+// NetworkSearchInResponseBodyResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-searchInResponseBody
+type NetworkSearchInResponseBodyResult struct {
+	Result []*DebuggerSearchMatch `json:"result"`
+}
+
+// Do sends Network.searchInResponseBody and returns its result
+func (p *NetworkSearchInResponseBodyParams) Do(e Executor) (*NetworkSearchInResponseBodyResult, error) {
+	var result = new(NetworkSearchInResponseBodyResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkSetBlockedURLsParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setBlockedURLs
+type NetworkSetBlockedURLsParams struct {
+	Urls []string `json:"urls"`
+}
+
+// ProtocolMethod returns Network.setBlockedURLs
+func (*NetworkSetBlockedURLsParams) ProtocolMethod() string {
+	return "Network.setBlockedURLs"
+}
+
+// Do sends Network.setBlockedURLs
+func (p *NetworkSetBlockedURLsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSetBypassServiceWorkerParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setBypassServiceWorker
+type NetworkSetBypassServiceWorkerParams struct {
+	Bypass bool `json:"bypass"`
+}
+
+// ProtocolMethod returns Network.setBypassServiceWorker
+func (*NetworkSetBypassServiceWorkerParams) ProtocolMethod() string {
+	return "Network.setBypassServiceWorker"
+}
+
+// Do sends Network.setBypassServiceWorker
+func (p *NetworkSetBypassServiceWorkerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSetCacheDisabledParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCacheDisabled
+type NetworkSetCacheDisabledParams struct {
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+// ProtocolMethod returns Network.setCacheDisabled
+func (*NetworkSetCacheDisabledParams) ProtocolMethod() string {
+	return "Network.setCacheDisabled"
+}
+
+// Do sends Network.setCacheDisabled
+func (p *NetworkSetCacheDisabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSetCookieParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookie
+type NetworkSetCookieParams struct {
+	Name         string                     `json:"name"`
+	Value        string                     `json:"value"`
+	URL          string                     `json:"url,omitempty"`
+	Domain       string                     `json:"domain,omitempty"`
+	Path         string                     `json:"path,omitempty"`
+	Secure       bool                       `json:"secure,omitempty"`
+	HTTPOnly     bool                       `json:"httpOnly,omitempty"`
+	SameSite     NetworkCookieSameSite      `json:"sameSite,omitempty"`
+	Expires      NetworkTimeSinceEpoch      `json:"expires,omitempty"`
+	Priority     NetworkCookiePriority      `json:"priority,omitempty"`
+	SameParty    bool                       `json:"sameParty,omitempty"`
+	SourceScheme NetworkCookieSourceScheme  `json:"sourceScheme,omitempty"`
+	SourcePort   int64                      `json:"sourcePort,omitempty"`
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// ProtocolMethod returns Network.setCookie
+func (*NetworkSetCookieParams) ProtocolMethod() string {
+	return "Network.setCookie"
+}
+
+// NetworkSetCookieResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookie
+type NetworkSetCookieResult struct {
+	// Deprecated: deprecated in protocol
+	Success bool `json:"success"`
+}
+
+// Do sends Network.setCookie and returns its result
+func (p *NetworkSetCookieParams) Do(e Executor) (*NetworkSetCookieResult, error) {
+	var result = new(NetworkSetCookieResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkSetCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookies
+type NetworkSetCookiesParams struct {
+	Cookies []*NetworkCookieParam `json:"cookies"`
+}
+
+// ProtocolMethod returns Network.setCookies
+func (*NetworkSetCookiesParams) ProtocolMethod() string {
+	return "Network.setCookies"
+}
+
+// Do sends Network.setCookies
+func (p *NetworkSetCookiesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSetExtraHTTPHeadersParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setExtraHTTPHeaders
+type NetworkSetExtraHTTPHeadersParams struct {
+	Headers NetworkHeaders `json:"headers"`
+}
+
+// ProtocolMethod returns Network.setExtraHTTPHeaders
+func (*NetworkSetExtraHTTPHeadersParams) ProtocolMethod() string {
+	return "Network.setExtraHTTPHeaders"
+}
+
+// Do sends Network.setExtraHTTPHeaders
+func (p *NetworkSetExtraHTTPHeadersParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSetAttachDebugStackParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setAttachDebugStack
+type NetworkSetAttachDebugStackParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Network.setAttachDebugStack
+func (*NetworkSetAttachDebugStackParams) ProtocolMethod() string {
+	return "Network.setAttachDebugStack"
+}
+
+// Do sends Network.setAttachDebugStack
+func (p *NetworkSetAttachDebugStackParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkSetRequestInterceptionParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setRequestInterception
+//
+// Deprecated: deprecated in protocol
+type NetworkSetRequestInterceptionParams struct {
+	Patterns []*NetworkRequestPattern `json:"patterns"`
+}
+
+// ProtocolMethod returns Network.setRequestInterception
+func (*NetworkSetRequestInterceptionParams) ProtocolMethod() string {
+	return "Network.setRequestInterception"
+}
+
+// Do sends Network.setRequestInterception
+func (p *NetworkSetRequestInterceptionParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkStreamResourceContentParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-streamResourceContent
+type NetworkStreamResourceContentParams struct {
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Network.streamResourceContent
+func (*NetworkStreamResourceContentParams) ProtocolMethod() string {
+	return "Network.streamResourceContent"
+}
+
+// NetworkStreamResourceContentResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-streamResourceContent
+type NetworkStreamResourceContentResult struct {
+	BufferedData string `json:"bufferedData"`
+}
+
+// Do sends Network.streamResourceContent and returns its result
+func (p *NetworkStreamResourceContentParams) Do(e Executor) (*NetworkStreamResourceContentResult, error) {
+	var result = new(NetworkStreamResourceContentResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkGetSecurityIsolationStatusParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getSecurityIsolationStatus
+type NetworkGetSecurityIsolationStatusParams struct {
+	FrameID PageFrameID `json:"frameId,omitempty"`
+}
+
+// ProtocolMethod returns Network.getSecurityIsolationStatus
+func (*NetworkGetSecurityIsolationStatusParams) ProtocolMethod() string {
+	return "Network.getSecurityIsolationStatus"
+}
+
+// NetworkGetSecurityIsolationStatusResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-getSecurityIsolationStatus
+type NetworkGetSecurityIsolationStatusResult struct {
+	Status *NetworkSecurityIsolationStatus `json:"status"`
+}
+
+// Do sends Network.getSecurityIsolationStatus and returns its result
+func (p *NetworkGetSecurityIsolationStatusParams) Do(e Executor) (*NetworkGetSecurityIsolationStatusResult, error) {
+	var result = new(NetworkGetSecurityIsolationStatusResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkEnableReportingAPIParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-enableReportingApi
+type NetworkEnableReportingAPIParams struct {
+	Enable bool `json:"enable"`
+}
+
+// ProtocolMethod returns Network.enableReportingApi
+func (*NetworkEnableReportingAPIParams) ProtocolMethod() string {
+	return "Network.enableReportingApi"
+}
+
+// Do sends Network.enableReportingApi
+func (p *NetworkEnableReportingAPIParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkLoadNetworkResourceParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-loadNetworkResource
+type NetworkLoadNetworkResourceParams struct {
+	FrameID PageFrameID                        `json:"frameId,omitempty"`
+	URL     string                             `json:"url"`
+	Options *NetworkLoadNetworkResourceOptions `json:"options"`
+}
+
+// ProtocolMethod returns Network.loadNetworkResource
+func (*NetworkLoadNetworkResourceParams) ProtocolMethod() string {
+	return "Network.loadNetworkResource"
+}
+
+// NetworkLoadNetworkResourceResult https://chromedevtools.github.io/devtools-protocol/tot/Network#method-loadNetworkResource
+type NetworkLoadNetworkResourceResult struct {
+	Resource *NetworkLoadNetworkResourcePageResult `json:"resource"`
+}
+
+// Do sends Network.loadNetworkResource and returns its result
+func (p *NetworkLoadNetworkResourceParams) Do(e Executor) (*NetworkLoadNetworkResourceResult, error) {
+	var result = new(NetworkLoadNetworkResourceResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NetworkSetCookieControlsParams https://chromedevtools.github.io/devtools-protocol/tot/Network#method-setCookieControls
+type NetworkSetCookieControlsParams struct {
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+	DisableThirdPartyCookieMetadata   bool `json:"disableThirdPartyCookieMetadata"`
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+// ProtocolMethod returns Network.setCookieControls
+func (*NetworkSetCookieControlsParams) ProtocolMethod() string {
+	return "Network.setCookieControls"
+}
+
+// Do sends Network.setCookieControls
+func (p *NetworkSetCookieControlsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// NetworkDataReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-dataReceived
+type NetworkDataReceivedEvent struct {
+	RequestID         NetworkRequestID     `json:"requestId"`
+	Timestamp         NetworkMonotonicTime `json:"timestamp"`
+	DataLength        int64                `json:"dataLength"`
+	EncodedDataLength int64                `json:"encodedDataLength"`
+	Data              string               `json:"data,omitempty"`
+}
+
+// ProtocolMethod returns Network.dataReceived
+func (*NetworkDataReceivedEvent) ProtocolMethod() string {
+	return "Network.dataReceived"
+}
+
+// NetworkEventSourceMessageReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-eventSourceMessageReceived
+type NetworkEventSourceMessageReceivedEvent struct {
+	RequestID NetworkRequestID     `json:"requestId"`
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+	EventName string               `json:"eventName"`
+	EventID   string               `json:"eventId"`
+	Data      string               `json:"data"`
+}
+
+// ProtocolMethod returns Network.eventSourceMessageReceived
+func (*NetworkEventSourceMessageReceivedEvent) ProtocolMethod() string {
+	return "Network.eventSourceMessageReceived"
+}
+
+// NetworkLoadingFailedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-loadingFailed
+type NetworkLoadingFailedEvent struct {
+	RequestID       NetworkRequestID        `json:"requestId"`
+	Timestamp       NetworkMonotonicTime    `json:"timestamp"`
+	Type            NetworkResourceType     `json:"type"`
+	ErrorText       string                  `json:"errorText"`
+	Canceled        bool                    `json:"canceled,omitempty"`
+	BlockedReason   NetworkBlockedReason    `json:"blockedReason,omitempty"`
+	CorsErrorStatus *NetworkCorsErrorStatus `json:"corsErrorStatus,omitempty"`
+}
+
+// ProtocolMethod returns Network.loadingFailed
+func (*NetworkLoadingFailedEvent) ProtocolMethod() string {
+	return "Network.loadingFailed"
+}
+
+// NetworkLoadingFinishedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-loadingFinished
+type NetworkLoadingFinishedEvent struct {
+	RequestID         NetworkRequestID     `json:"requestId"`
+	Timestamp         NetworkMonotonicTime `json:"timestamp"`
+	EncodedDataLength float64              `json:"encodedDataLength"`
+}
+
+// ProtocolMethod returns Network.loadingFinished
+func (*NetworkLoadingFinishedEvent) ProtocolMethod() string {
+	return "Network.loadingFinished"
+}
+
+// NetworkRequestInterceptedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestIntercepted
+//
+// Deprecated: deprecated in protocol
+type NetworkRequestInterceptedEvent struct {
+	InterceptionID      NetworkInterceptionID `json:"interceptionId"`
+	Request             *NetworkRequest       `json:"request"`
+	FrameID             PageFrameID           `json:"frameId"`
+	ResourceType        NetworkResourceType   `json:"resourceType"`
+	IsNavigationRequest bool                  `json:"isNavigationRequest"`
+	IsDownload          bool                  `json:"isDownload,omitempty"`
+	RedirectURL         string                `json:"redirectUrl,omitempty"`
+	AuthChallenge       *NetworkAuthChallenge `json:"authChallenge,omitempty"`
+	ResponseErrorReason NetworkErrorReason    `json:"responseErrorReason,omitempty"`
+	ResponseStatusCode  int64                 `json:"responseStatusCode,omitempty"`
+	ResponseHeaders     NetworkHeaders        `json:"responseHeaders,omitempty"`
+	RequestID           NetworkRequestID      `json:"requestId,omitempty"`
+}
+
+// ProtocolMethod returns Network.requestIntercepted
+func (*NetworkRequestInterceptedEvent) ProtocolMethod() string {
+	return "Network.requestIntercepted"
+}
+
+// NetworkRequestServedFromCacheEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestServedFromCache
+type NetworkRequestServedFromCacheEvent struct {
+	RequestID NetworkRequestID `json:"requestId"`
+}
+
+// ProtocolMethod returns Network.requestServedFromCache
+func (*NetworkRequestServedFromCacheEvent) ProtocolMethod() string {
+	return "Network.requestServedFromCache"
+}
+
+// NetworkRequestWillBeSentEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestWillBeSent
+type NetworkRequestWillBeSentEvent struct {
+	RequestID            NetworkRequestID      `json:"requestId"`
+	LoaderID             NetworkLoaderID       `json:"loaderId"`
+	DocumentURL          string                `json:"documentURL"`
+	Request              *NetworkRequest       `json:"request"`
+	Timestamp            NetworkMonotonicTime  `json:"timestamp"`
+	WallTime             NetworkTimeSinceEpoch `json:"wallTime"`
+	Initiator            *NetworkInitiator     `json:"initiator"`
+	RedirectHasExtraInfo bool                  `json:"redirectHasExtraInfo"`
+	RedirectResponse     *NetworkResponse      `json:"redirectResponse,omitempty"`
+	Type                 NetworkResourceType   `json:"type,omitempty"`
+	FrameID              PageFrameID           `json:"frameId,omitempty"`
+	HasUserGesture       bool                  `json:"hasUserGesture,omitempty"`
+}
+
+// ProtocolMethod returns Network.requestWillBeSent
+func (*NetworkRequestWillBeSentEvent) ProtocolMethod() string {
+	return "Network.requestWillBeSent"
+}
+
+// NetworkResourceChangedPriorityEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-resourceChangedPriority
+type NetworkResourceChangedPriorityEvent struct {
+	RequestID   NetworkRequestID        `json:"requestId"`
+	NewPriority NetworkResourcePriority `json:"newPriority"`
+	Timestamp   NetworkMonotonicTime    `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.resourceChangedPriority
+func (*NetworkResourceChangedPriorityEvent) ProtocolMethod() string {
+	return "Network.resourceChangedPriority"
+}
+
+// NetworkSignedExchangeReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-signedExchangeReceived
+type NetworkSignedExchangeReceivedEvent struct {
+	RequestID NetworkRequestID           `json:"requestId"`
+	Info      *NetworkSignedExchangeInfo `json:"info"`
+}
+
+// ProtocolMethod returns Network.signedExchangeReceived
+func (*NetworkSignedExchangeReceivedEvent) ProtocolMethod() string {
+	return "Network.signedExchangeReceived"
+}
+
+// NetworkResponseReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-responseReceived
+type NetworkResponseReceivedEvent struct {
+	RequestID    NetworkRequestID     `json:"requestId"`
+	LoaderID     NetworkLoaderID      `json:"loaderId"`
+	Timestamp    NetworkMonotonicTime `json:"timestamp"`
+	Type         NetworkResourceType  `json:"type"`
+	Response     *NetworkResponse     `json:"response"`
+	HasExtraInfo bool                 `json:"hasExtraInfo"`
+	FrameID      PageFrameID          `json:"frameId,omitempty"`
+}
+
+// ProtocolMethod returns Network.responseReceived
+func (*NetworkResponseReceivedEvent) ProtocolMethod() string {
+	return "Network.responseReceived"
+}
+
+// NetworkWebSocketClosedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketClosed
+type NetworkWebSocketClosedEvent struct {
+	RequestID NetworkRequestID     `json:"requestId"`
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.webSocketClosed
+func (*NetworkWebSocketClosedEvent) ProtocolMethod() string {
+	return "Network.webSocketClosed"
+}
+
+// NetworkWebSocketCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketCreated
+type NetworkWebSocketCreatedEvent struct {
+	RequestID NetworkRequestID  `json:"requestId"`
+	URL       string            `json:"url"`
+	Initiator *NetworkInitiator `json:"initiator,omitempty"`
+}
+
+// ProtocolMethod returns Network.webSocketCreated
+func (*NetworkWebSocketCreatedEvent) ProtocolMethod() string {
+	return "Network.webSocketCreated"
+}
+
+// NetworkWebSocketFrameErrorEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketFrameError
+type NetworkWebSocketFrameErrorEvent struct {
+	RequestID    NetworkRequestID     `json:"requestId"`
+	Timestamp    NetworkMonotonicTime `json:"timestamp"`
+	ErrorMessage string               `json:"errorMessage"`
+}
+
+// ProtocolMethod returns Network.webSocketFrameError
+func (*NetworkWebSocketFrameErrorEvent) ProtocolMethod() string {
+	return "Network.webSocketFrameError"
+}
+
+// NetworkWebSocketFrameReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketFrameReceived
+type NetworkWebSocketFrameReceivedEvent struct {
+	RequestID NetworkRequestID       `json:"requestId"`
+	Timestamp NetworkMonotonicTime   `json:"timestamp"`
+	Response  *NetworkWebSocketFrame `json:"response"`
+}
+
+// ProtocolMethod returns Network.webSocketFrameReceived
+func (*NetworkWebSocketFrameReceivedEvent) ProtocolMethod() string {
+	return "Network.webSocketFrameReceived"
+}
+
+// NetworkWebSocketFrameSentEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketFrameSent
+type NetworkWebSocketFrameSentEvent struct {
+	RequestID NetworkRequestID       `json:"requestId"`
+	Timestamp NetworkMonotonicTime   `json:"timestamp"`
+	Response  *NetworkWebSocketFrame `json:"response"`
+}
+
+// ProtocolMethod returns Network.webSocketFrameSent
+func (*NetworkWebSocketFrameSentEvent) ProtocolMethod() string {
+	return "Network.webSocketFrameSent"
+}
+
+// NetworkWebSocketHandshakeResponseReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketHandshakeResponseReceived
+type NetworkWebSocketHandshakeResponseReceivedEvent struct {
+	RequestID NetworkRequestID          `json:"requestId"`
+	Timestamp NetworkMonotonicTime      `json:"timestamp"`
+	Response  *NetworkWebSocketResponse `json:"response"`
+}
+
+// ProtocolMethod returns Network.webSocketHandshakeResponseReceived
+func (*NetworkWebSocketHandshakeResponseReceivedEvent) ProtocolMethod() string {
+	return "Network.webSocketHandshakeResponseReceived"
+}
+
+// NetworkWebSocketWillSendHandshakeRequestEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webSocketWillSendHandshakeRequest
+type NetworkWebSocketWillSendHandshakeRequestEvent struct {
+	RequestID NetworkRequestID         `json:"requestId"`
+	Timestamp NetworkMonotonicTime     `json:"timestamp"`
+	WallTime  NetworkTimeSinceEpoch    `json:"wallTime"`
+	Request   *NetworkWebSocketRequest `json:"request"`
+}
+
+// ProtocolMethod returns Network.webSocketWillSendHandshakeRequest
+func (*NetworkWebSocketWillSendHandshakeRequestEvent) ProtocolMethod() string {
+	return "Network.webSocketWillSendHandshakeRequest"
+}
+
+// NetworkWebTransportCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webTransportCreated
+type NetworkWebTransportCreatedEvent struct {
+	TransportID NetworkRequestID     `json:"transportId"`
+	URL         string               `json:"url"`
+	Timestamp   NetworkMonotonicTime `json:"timestamp"`
+	Initiator   *NetworkInitiator    `json:"initiator,omitempty"`
+}
+
+// ProtocolMethod returns Network.webTransportCreated
+func (*NetworkWebTransportCreatedEvent) ProtocolMethod() string {
+	return "Network.webTransportCreated"
+}
+
+// NetworkWebTransportConnectionEstablishedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webTransportConnectionEstablished
+type NetworkWebTransportConnectionEstablishedEvent struct {
+	TransportID NetworkRequestID     `json:"transportId"`
+	Timestamp   NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.webTransportConnectionEstablished
+func (*NetworkWebTransportConnectionEstablishedEvent) ProtocolMethod() string {
+	return "Network.webTransportConnectionEstablished"
+}
+
+// NetworkWebTransportClosedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-webTransportClosed
+type NetworkWebTransportClosedEvent struct {
+	TransportID NetworkRequestID     `json:"transportId"`
+	Timestamp   NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.webTransportClosed
+func (*NetworkWebTransportClosedEvent) ProtocolMethod() string {
+	return "Network.webTransportClosed"
+}
+
+// NetworkDirectTCPSocketCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketCreated
+type NetworkDirectTCPSocketCreatedEvent struct {
+	Identifier NetworkRequestID               `json:"identifier"`
+	RemoteAddr string                         `json:"remoteAddr"`
+	RemotePort int64                          `json:"remotePort"`
+	Options    *NetworkDirectTCPSocketOptions `json:"options"`
+	Timestamp  NetworkMonotonicTime           `json:"timestamp"`
+	Initiator  *NetworkInitiator              `json:"initiator,omitempty"`
+}
+
+// ProtocolMethod returns Network.directTCPSocketCreated
+func (*NetworkDirectTCPSocketCreatedEvent) ProtocolMethod() string {
+	return "Network.directTCPSocketCreated"
+}
+
+// NetworkDirectTCPSocketOpenedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketOpened
+type NetworkDirectTCPSocketOpenedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	RemoteAddr string               `json:"remoteAddr"`
+	RemotePort int64                `json:"remotePort"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+	LocalAddr  string               `json:"localAddr,omitempty"`
+	LocalPort  int64                `json:"localPort,omitempty"`
+}
+
+// ProtocolMethod returns Network.directTCPSocketOpened
+func (*NetworkDirectTCPSocketOpenedEvent) ProtocolMethod() string {
+	return "Network.directTCPSocketOpened"
+}
+
+// NetworkDirectTCPSocketAbortedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketAborted
+type NetworkDirectTCPSocketAbortedEvent struct {
+	Identifier   NetworkRequestID     `json:"identifier"`
+	ErrorMessage string               `json:"errorMessage"`
+	Timestamp    NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directTCPSocketAborted
+func (*NetworkDirectTCPSocketAbortedEvent) ProtocolMethod() string {
+	return "Network.directTCPSocketAborted"
+}
+
+// NetworkDirectTCPSocketClosedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketClosed
+type NetworkDirectTCPSocketClosedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directTCPSocketClosed
+func (*NetworkDirectTCPSocketClosedEvent) ProtocolMethod() string {
+	return "Network.directTCPSocketClosed"
+}
+
+// NetworkDirectTCPSocketChunkSentEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketChunkSent
+type NetworkDirectTCPSocketChunkSentEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Data       string               `json:"data"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directTCPSocketChunkSent
+func (*NetworkDirectTCPSocketChunkSentEvent) ProtocolMethod() string {
+	return "Network.directTCPSocketChunkSent"
+}
+
+// NetworkDirectTCPSocketChunkReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directTCPSocketChunkReceived
+type NetworkDirectTCPSocketChunkReceivedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Data       string               `json:"data"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directTCPSocketChunkReceived
+func (*NetworkDirectTCPSocketChunkReceivedEvent) ProtocolMethod() string {
+	return "Network.directTCPSocketChunkReceived"
+}
+
+// NetworkDirectUDPSocketCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketCreated
+type NetworkDirectUDPSocketCreatedEvent struct {
+	Identifier NetworkRequestID               `json:"identifier"`
+	Options    *NetworkDirectUDPSocketOptions `json:"options"`
+	Timestamp  NetworkMonotonicTime           `json:"timestamp"`
+	Initiator  *NetworkInitiator              `json:"initiator,omitempty"`
+}
+
+// ProtocolMethod returns Network.directUDPSocketCreated
+func (*NetworkDirectUDPSocketCreatedEvent) ProtocolMethod() string {
+	return "Network.directUDPSocketCreated"
+}
+
+// NetworkDirectUDPSocketOpenedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketOpened
+type NetworkDirectUDPSocketOpenedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	LocalAddr  string               `json:"localAddr"`
+	LocalPort  int64                `json:"localPort"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+	RemoteAddr string               `json:"remoteAddr,omitempty"`
+	RemotePort int64                `json:"remotePort,omitempty"`
+}
+
+// ProtocolMethod returns Network.directUDPSocketOpened
+func (*NetworkDirectUDPSocketOpenedEvent) ProtocolMethod() string {
+	return "Network.directUDPSocketOpened"
+}
+
+// NetworkDirectUDPSocketAbortedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketAborted
+type NetworkDirectUDPSocketAbortedEvent struct {
+	Identifier   NetworkRequestID     `json:"identifier"`
+	ErrorMessage string               `json:"errorMessage"`
+	Timestamp    NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directUDPSocketAborted
+func (*NetworkDirectUDPSocketAbortedEvent) ProtocolMethod() string {
+	return "Network.directUDPSocketAborted"
+}
+
+// NetworkDirectUDPSocketClosedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketClosed
+type NetworkDirectUDPSocketClosedEvent struct {
+	Identifier NetworkRequestID     `json:"identifier"`
+	Timestamp  NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directUDPSocketClosed
+func (*NetworkDirectUDPSocketClosedEvent) ProtocolMethod() string {
+	return "Network.directUDPSocketClosed"
+}
+
+// NetworkDirectUDPSocketChunkSentEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketChunkSent
+type NetworkDirectUDPSocketChunkSentEvent struct {
+	Identifier NetworkRequestID         `json:"identifier"`
+	Message    *NetworkDirectUDPMessage `json:"message"`
+	Timestamp  NetworkMonotonicTime     `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directUDPSocketChunkSent
+func (*NetworkDirectUDPSocketChunkSentEvent) ProtocolMethod() string {
+	return "Network.directUDPSocketChunkSent"
+}
+
+// NetworkDirectUDPSocketChunkReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-directUDPSocketChunkReceived
+type NetworkDirectUDPSocketChunkReceivedEvent struct {
+	Identifier NetworkRequestID         `json:"identifier"`
+	Message    *NetworkDirectUDPMessage `json:"message"`
+	Timestamp  NetworkMonotonicTime     `json:"timestamp"`
+}
+
+// ProtocolMethod returns Network.directUDPSocketChunkReceived
+func (*NetworkDirectUDPSocketChunkReceivedEvent) ProtocolMethod() string {
+	return "Network.directUDPSocketChunkReceived"
+}
+
+// NetworkRequestWillBeSentExtraInfoEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-requestWillBeSentExtraInfo
+type NetworkRequestWillBeSentExtraInfoEvent struct {
+	RequestID                     NetworkRequestID            `json:"requestId"`
+	AssociatedCookies             []*NetworkAssociatedCookie  `json:"associatedCookies"`
+	Headers                       NetworkHeaders              `json:"headers"`
+	ConnectTiming                 *NetworkConnectTiming       `json:"connectTiming"`
+	ClientSecurityState           *NetworkClientSecurityState `json:"clientSecurityState,omitempty"`
+	SiteHasCookieInOtherPartition bool                        `json:"siteHasCookieInOtherPartition,omitempty"`
+}
+
+// ProtocolMethod returns Network.requestWillBeSentExtraInfo
+func (*NetworkRequestWillBeSentExtraInfoEvent) ProtocolMethod() string {
+	return "Network.requestWillBeSentExtraInfo"
+}
+
+// NetworkResponseReceivedExtraInfoEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-responseReceivedExtraInfo
+type NetworkResponseReceivedExtraInfoEvent struct {
+	RequestID                NetworkRequestID                      `json:"requestId"`
+	BlockedCookies           []*NetworkBlockedSetCookieWithReason  `json:"blockedCookies"`
+	Headers                  NetworkHeaders                        `json:"headers"`
+	ResourceIPAddressSpace   NetworkIPAddressSpace                 `json:"resourceIPAddressSpace"`
+	StatusCode               int64                                 `json:"statusCode"`
+	HeadersText              string                                `json:"headersText,omitempty"`
+	CookiePartitionKey       *NetworkCookiePartitionKey            `json:"cookiePartitionKey,omitempty"`
+	CookiePartitionKeyOpaque bool                                  `json:"cookiePartitionKeyOpaque,omitempty"`
+	ExemptedCookies          []*NetworkExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+}
+
+// ProtocolMethod returns Network.responseReceivedExtraInfo
+func (*NetworkResponseReceivedExtraInfoEvent) ProtocolMethod() string {
+	return "Network.responseReceivedExtraInfo"
+}
+
+// NetworkResponseReceivedEarlyHintsEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-responseReceivedEarlyHints
+type NetworkResponseReceivedEarlyHintsEvent struct {
+	RequestID NetworkRequestID `json:"requestId"`
+	Headers   NetworkHeaders   `json:"headers"`
+}
+
+// ProtocolMethod returns Network.responseReceivedEarlyHints
+func (*NetworkResponseReceivedEarlyHintsEvent) ProtocolMethod() string {
+	return "Network.responseReceivedEarlyHints"
+}
+
+// NetworkTrustTokenOperationDoneEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-trustTokenOperationDone
+type NetworkTrustTokenOperationDoneEvent struct {
+	Status           NetworkTrustTokenOperationDoneEventStatus `json:"status"`
+	Type             NetworkTrustTokenOperationType            `json:"type"`
+	RequestID        NetworkRequestID                          `json:"requestId"`
+	TopLevelOrigin   string                                    `json:"topLevelOrigin,omitempty"`
+	IssuerOrigin     string                                    `json:"issuerOrigin,omitempty"`
+	IssuedTokenCount int64                                     `json:"issuedTokenCount,omitempty"`
+}
+
+// ProtocolMethod returns Network.trustTokenOperationDone
+func (*NetworkTrustTokenOperationDoneEvent) ProtocolMethod() string {
+	return "Network.trustTokenOperationDone"
+}
+
+// NetworkPolicyUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-policyUpdated
+type NetworkPolicyUpdatedEvent struct {
+}
+
+// ProtocolMethod returns Network.policyUpdated
+func (*NetworkPolicyUpdatedEvent) ProtocolMethod() string {
+	return "Network.policyUpdated"
+}
+
+// NetworkSubresourceWebBundleMetadataReceivedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleMetadataReceived
+type NetworkSubresourceWebBundleMetadataReceivedEvent struct {
+	RequestID NetworkRequestID `json:"requestId"`
+	Urls      []string         `json:"urls"`
+}
+
+// ProtocolMethod returns Network.subresourceWebBundleMetadataReceived
+func (*NetworkSubresourceWebBundleMetadataReceivedEvent) ProtocolMethod() string {
+	return "Network.subresourceWebBundleMetadataReceived"
+}
+
+// NetworkSubresourceWebBundleMetadataErrorEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleMetadataError
+type NetworkSubresourceWebBundleMetadataErrorEvent struct {
+	RequestID    NetworkRequestID `json:"requestId"`
+	ErrorMessage string           `json:"errorMessage"`
+}
+
+// ProtocolMethod returns Network.subresourceWebBundleMetadataError
+func (*NetworkSubresourceWebBundleMetadataErrorEvent) ProtocolMethod() string {
+	return "Network.subresourceWebBundleMetadataError"
+}
+
+// NetworkSubresourceWebBundleInnerResponseParsedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleInnerResponseParsed
+type NetworkSubresourceWebBundleInnerResponseParsedEvent struct {
+	InnerRequestID  NetworkRequestID `json:"innerRequestId"`
+	InnerRequestURL string           `json:"innerRequestURL"`
+	BundleRequestID NetworkRequestID `json:"bundleRequestId,omitempty"`
+}
+
+// ProtocolMethod returns Network.subresourceWebBundleInnerResponseParsed
+func (*NetworkSubresourceWebBundleInnerResponseParsedEvent) ProtocolMethod() string {
+	return "Network.subresourceWebBundleInnerResponseParsed"
+}
+
+// NetworkSubresourceWebBundleInnerResponseErrorEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-subresourceWebBundleInnerResponseError
+type NetworkSubresourceWebBundleInnerResponseErrorEvent struct {
+	InnerRequestID  NetworkRequestID `json:"innerRequestId"`
+	InnerRequestURL string           `json:"innerRequestURL"`
+	ErrorMessage    string           `json:"errorMessage"`
+	BundleRequestID NetworkRequestID `json:"bundleRequestId,omitempty"`
+}
+
+// ProtocolMethod returns Network.subresourceWebBundleInnerResponseError
+func (*NetworkSubresourceWebBundleInnerResponseErrorEvent) ProtocolMethod() string {
+	return "Network.subresourceWebBundleInnerResponseError"
+}
+
+// NetworkReportingAPIReportAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-reportingApiReportAdded
+type NetworkReportingAPIReportAddedEvent struct {
+	Report *NetworkReportingAPIReport `json:"report"`
+}
+
+// ProtocolMethod returns Network.reportingApiReportAdded
+func (*NetworkReportingAPIReportAddedEvent) ProtocolMethod() string {
+	return "Network.reportingApiReportAdded"
+}
+
+// NetworkReportingAPIReportUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-reportingApiReportUpdated
+type NetworkReportingAPIReportUpdatedEvent struct {
+	Report *NetworkReportingAPIReport `json:"report"`
+}
+
+// ProtocolMethod returns Network.reportingApiReportUpdated
+func (*NetworkReportingAPIReportUpdatedEvent) ProtocolMethod() string {
+	return "Network.reportingApiReportUpdated"
+}
+
+// NetworkReportingAPIEndpointsChangedForOriginEvent https://chromedevtools.github.io/devtools-protocol/tot/Network#event-reportingApiEndpointsChangedForOrigin
+type NetworkReportingAPIEndpointsChangedForOriginEvent struct {
+	Origin    string                         `json:"origin"`
+	Endpoints []*NetworkReportingAPIEndpoint `json:"endpoints"`
+}
+
+// ProtocolMethod returns Network.reportingApiEndpointsChangedForOrigin
+func (*NetworkReportingAPIEndpointsChangedForOriginEvent) ProtocolMethod() string {
+	return "Network.reportingApiEndpointsChangedForOrigin"
+}
+
+// NetworkRequestReferrerPolicy enum
+type NetworkRequestReferrerPolicy string
+
+// NetworkRequestReferrerPolicy values
+const (
+	NetworkRequestReferrerPolicyUnsafeURL                   NetworkRequestReferrerPolicy = "unsafe-url"
+	NetworkRequestReferrerPolicyNoReferrerWhenDowngrade     NetworkRequestReferrerPolicy = "no-referrer-when-downgrade"
+	NetworkRequestReferrerPolicyNoReferrer                  NetworkRequestReferrerPolicy = "no-referrer"
+	NetworkRequestReferrerPolicyOrigin                      NetworkRequestReferrerPolicy = "origin"
+	NetworkRequestReferrerPolicyOriginWhenCrossOrigin       NetworkRequestReferrerPolicy = "origin-when-cross-origin"
+	NetworkRequestReferrerPolicySameOrigin                  NetworkRequestReferrerPolicy = "same-origin"
+	NetworkRequestReferrerPolicyStrictOrigin                NetworkRequestReferrerPolicy = "strict-origin"
+	NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin NetworkRequestReferrerPolicy = "strict-origin-when-cross-origin"
+)
+
+// NetworkTrustTokenParamsRefreshPolicy enum
+type NetworkTrustTokenParamsRefreshPolicy string
+
+// NetworkTrustTokenParamsRefreshPolicy values
+const (
+	NetworkTrustTokenParamsRefreshPolicyUseCached NetworkTrustTokenParamsRefreshPolicy = "UseCached"
+	NetworkTrustTokenParamsRefreshPolicyRefresh   NetworkTrustTokenParamsRefreshPolicy = "Refresh"
+)
+
+// NetworkInitiatorType enum
+type NetworkInitiatorType string
+
+// NetworkInitiatorType values
+const (
+	NetworkInitiatorTypeParser         NetworkInitiatorType = "parser"
+	NetworkInitiatorTypeScript         NetworkInitiatorType = "script"
+	NetworkInitiatorTypePreload        NetworkInitiatorType = "preload"
+	NetworkInitiatorTypeSignedExchange NetworkInitiatorType = "SignedExchange"
+	NetworkInitiatorTypePreflight      NetworkInitiatorType = "preflight"
+	NetworkInitiatorTypeOther          NetworkInitiatorType = "other"
+)
+
+// NetworkAuthChallengeSource enum
+type NetworkAuthChallengeSource string
+
+// NetworkAuthChallengeSource values
+const (
+	NetworkAuthChallengeSourceServer NetworkAuthChallengeSource = "Server"
+	NetworkAuthChallengeSourceProxy  NetworkAuthChallengeSource = "Proxy"
+)
+
+// NetworkAuthChallengeResponseResponse enum
+type NetworkAuthChallengeResponseResponse string
+
+// NetworkAuthChallengeResponseResponse values
+const (
+	NetworkAuthChallengeResponseResponseDefault            NetworkAuthChallengeResponseResponse = "Default"
+	NetworkAuthChallengeResponseResponseCancelAuth         NetworkAuthChallengeResponseResponse = "CancelAuth"
+	NetworkAuthChallengeResponseResponseProvideCredentials NetworkAuthChallengeResponseResponse = "ProvideCredentials"
+)
+
+// NetworkTrustTokenOperationDoneEventStatus enum
+type NetworkTrustTokenOperationDoneEventStatus string
+
+// NetworkTrustTokenOperationDoneEventStatus values
+const (
+	NetworkTrustTokenOperationDoneEventStatusOk                 NetworkTrustTokenOperationDoneEventStatus = "Ok"
+	NetworkTrustTokenOperationDoneEventStatusInvalidArgument    NetworkTrustTokenOperationDoneEventStatus = "InvalidArgument"
+	NetworkTrustTokenOperationDoneEventStatusMissingIssuerKeys  NetworkTrustTokenOperationDoneEventStatus = "MissingIssuerKeys"
+	NetworkTrustTokenOperationDoneEventStatusFailedPrecondition NetworkTrustTokenOperationDoneEventStatus = "FailedPrecondition"
+	NetworkTrustTokenOperationDoneEventStatusResourceExhausted  NetworkTrustTokenOperationDoneEventStatus = "ResourceExhausted"
+	NetworkTrustTokenOperationDoneEventStatusAlreadyExists      NetworkTrustTokenOperationDoneEventStatus = "AlreadyExists"
+	NetworkTrustTokenOperationDoneEventStatusResourceLimited    NetworkTrustTokenOperationDoneEventStatus = "ResourceLimited"
+	NetworkTrustTokenOperationDoneEventStatusUnauthorized       NetworkTrustTokenOperationDoneEventStatus = "Unauthorized"
+	NetworkTrustTokenOperationDoneEventStatusBadResponse        NetworkTrustTokenOperationDoneEventStatus = "BadResponse"
+	NetworkTrustTokenOperationDoneEventStatusInternalError      NetworkTrustTokenOperationDoneEventStatus = "InternalError"
+	NetworkTrustTokenOperationDoneEventStatusUnknownError       NetworkTrustTokenOperationDoneEventStatus = "UnknownError"
+	NetworkTrustTokenOperationDoneEventStatusFulfilledLocally   NetworkTrustTokenOperationDoneEventStatus = "FulfilledLocally"
+	NetworkTrustTokenOperationDoneEventStatusSiteIssuerLimit    NetworkTrustTokenOperationDoneEventStatus = "SiteIssuerLimit"
+)
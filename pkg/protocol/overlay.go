@@ -0,0 +1,728 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Overlay events
+const (
+	EventOverlayInspectNodeRequested   = "Overlay.inspectNodeRequested"
+	EventOverlayNodeHighlightRequested = "Overlay.nodeHighlightRequested"
+	EventOverlayScreenshotRequested    = "Overlay.screenshotRequested"
+	EventOverlayInspectModeCanceled    = "Overlay.inspectModeCanceled"
+)
+
+// OverlaySourceOrderConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-SourceOrderConfig
+type OverlaySourceOrderConfig struct {
+	ParentOutlineColor *DOMRGBA `json:"parentOutlineColor"`
+	ChildOutlineColor  *DOMRGBA `json:"childOutlineColor"`
+}
+
+// OverlayGridHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-GridHighlightConfig
+type OverlayGridHighlightConfig struct {
+	ShowGridExtensionLines  bool     `json:"showGridExtensionLines,omitempty"`
+	ShowPositiveLineNumbers bool     `json:"showPositiveLineNumbers,omitempty"`
+	ShowNegativeLineNumbers bool     `json:"showNegativeLineNumbers,omitempty"`
+	ShowAreaNames           bool     `json:"showAreaNames,omitempty"`
+	ShowLineNames           bool     `json:"showLineNames,omitempty"`
+	ShowTrackSizes          bool     `json:"showTrackSizes,omitempty"`
+	GridBorderColor         *DOMRGBA `json:"gridBorderColor,omitempty"`
+	// Deprecated: deprecated in protocol
+	CellBorderColor *DOMRGBA `json:"cellBorderColor,omitempty"`
+	RowLineColor    *DOMRGBA `json:"rowLineColor,omitempty"`
+	ColumnLineColor *DOMRGBA `json:"columnLineColor,omitempty"`
+	GridBorderDash  bool     `json:"gridBorderDash,omitempty"`
+	// Deprecated: deprecated in protocol
+	CellBorderDash      bool     `json:"cellBorderDash,omitempty"`
+	RowLineDash         bool     `json:"rowLineDash,omitempty"`
+	ColumnLineDash      bool     `json:"columnLineDash,omitempty"`
+	RowGapColor         *DOMRGBA `json:"rowGapColor,omitempty"`
+	RowHatchColor       *DOMRGBA `json:"rowHatchColor,omitempty"`
+	ColumnGapColor      *DOMRGBA `json:"columnGapColor,omitempty"`
+	ColumnHatchColor    *DOMRGBA `json:"columnHatchColor,omitempty"`
+	AreaBorderColor     *DOMRGBA `json:"areaBorderColor,omitempty"`
+	GridBackgroundColor *DOMRGBA `json:"gridBackgroundColor,omitempty"`
+}
+
+// OverlayFlexContainerHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexContainerHighlightConfig
+type OverlayFlexContainerHighlightConfig struct {
+	ContainerBorder       *OverlayLineStyle `json:"containerBorder,omitempty"`
+	LineSeparator         *OverlayLineStyle `json:"lineSeparator,omitempty"`
+	ItemSeparator         *OverlayLineStyle `json:"itemSeparator,omitempty"`
+	MainDistributedSpace  *OverlayBoxStyle  `json:"mainDistributedSpace,omitempty"`
+	CrossDistributedSpace *OverlayBoxStyle  `json:"crossDistributedSpace,omitempty"`
+	RowGapSpace           *OverlayBoxStyle  `json:"rowGapSpace,omitempty"`
+	ColumnGapSpace        *OverlayBoxStyle  `json:"columnGapSpace,omitempty"`
+	CrossAlignment        *OverlayLineStyle `json:"crossAlignment,omitempty"`
+}
+
+// OverlayFlexItemHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexItemHighlightConfig
+type OverlayFlexItemHighlightConfig struct {
+	BaseSizeBox      *OverlayBoxStyle  `json:"baseSizeBox,omitempty"`
+	BaseSizeBorder   *OverlayLineStyle `json:"baseSizeBorder,omitempty"`
+	FlexibilityArrow *OverlayLineStyle `json:"flexibilityArrow,omitempty"`
+}
+
+// OverlayLineStyle https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-LineStyle
+type OverlayLineStyle struct {
+	Color   *DOMRGBA                `json:"color,omitempty"`
+	Pattern OverlayLineStylePattern `json:"pattern,omitempty"`
+}
+
+// OverlayBoxStyle https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-BoxStyle
+type OverlayBoxStyle struct {
+	FillColor  *DOMRGBA `json:"fillColor,omitempty"`
+	HatchColor *DOMRGBA `json:"hatchColor,omitempty"`
+}
+
+// OverlayContrastAlgorithm https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContrastAlgorithm
+type OverlayContrastAlgorithm string
+
+// OverlayContrastAlgorithm values
+const (
+	OverlayContrastAlgorithmAa   OverlayContrastAlgorithm = "aa"
+	OverlayContrastAlgorithmAaa  OverlayContrastAlgorithm = "aaa"
+	OverlayContrastAlgorithmApca OverlayContrastAlgorithm = "apca"
+)
+
+// OverlayHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-HighlightConfig
+type OverlayHighlightConfig struct {
+	ShowInfo                               bool                                           `json:"showInfo,omitempty"`
+	ShowStyles                             bool                                           `json:"showStyles,omitempty"`
+	ShowRulers                             bool                                           `json:"showRulers,omitempty"`
+	ShowAccessibilityInfo                  bool                                           `json:"showAccessibilityInfo,omitempty"`
+	ShowExtensionLines                     bool                                           `json:"showExtensionLines,omitempty"`
+	ContentColor                           *DOMRGBA                                       `json:"contentColor,omitempty"`
+	PaddingColor                           *DOMRGBA                                       `json:"paddingColor,omitempty"`
+	BorderColor                            *DOMRGBA                                       `json:"borderColor,omitempty"`
+	MarginColor                            *DOMRGBA                                       `json:"marginColor,omitempty"`
+	EventTargetColor                       *DOMRGBA                                       `json:"eventTargetColor,omitempty"`
+	ShapeColor                             *DOMRGBA                                       `json:"shapeColor,omitempty"`
+	ShapeMarginColor                       *DOMRGBA                                       `json:"shapeMarginColor,omitempty"`
+	CSSGridColor                           *DOMRGBA                                       `json:"cssGridColor,omitempty"`
+	ColorFormat                            OverlayColorFormat                             `json:"colorFormat,omitempty"`
+	GridHighlightConfig                    *OverlayGridHighlightConfig                    `json:"gridHighlightConfig,omitempty"`
+	FlexContainerHighlightConfig           *OverlayFlexContainerHighlightConfig           `json:"flexContainerHighlightConfig,omitempty"`
+	FlexItemHighlightConfig                *OverlayFlexItemHighlightConfig                `json:"flexItemHighlightConfig,omitempty"`
+	ContrastAlgorithm                      OverlayContrastAlgorithm                       `json:"contrastAlgorithm,omitempty"`
+	ContainerQueryContainerHighlightConfig *OverlayContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig,omitempty"`
+}
+
+// OverlayColorFormat https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ColorFormat
+type OverlayColorFormat string
+
+// OverlayColorFormat values
+const (
+	OverlayColorFormatRgb OverlayColorFormat = "rgb"
+	OverlayColorFormatHsl OverlayColorFormat = "hsl"
+	OverlayColorFormatHwb OverlayColorFormat = "hwb"
+	OverlayColorFormatHex OverlayColorFormat = "hex"
+)
+
+// OverlayGridNodeHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-GridNodeHighlightConfig
+type OverlayGridNodeHighlightConfig struct {
+	GridHighlightConfig *OverlayGridHighlightConfig `json:"gridHighlightConfig"`
+	NodeID              DOMNodeID                   `json:"nodeId"`
+}
+
+// OverlayFlexNodeHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-FlexNodeHighlightConfig
+type OverlayFlexNodeHighlightConfig struct {
+	FlexContainerHighlightConfig *OverlayFlexContainerHighlightConfig `json:"flexContainerHighlightConfig"`
+	NodeID                       DOMNodeID                            `json:"nodeId"`
+}
+
+// OverlayScrollSnapContainerHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ScrollSnapContainerHighlightConfig
+type OverlayScrollSnapContainerHighlightConfig struct {
+	SnapportBorder     *OverlayLineStyle `json:"snapportBorder,omitempty"`
+	SnapAreaBorder     *OverlayLineStyle `json:"snapAreaBorder,omitempty"`
+	ScrollMarginColor  *DOMRGBA          `json:"scrollMarginColor,omitempty"`
+	ScrollPaddingColor *DOMRGBA          `json:"scrollPaddingColor,omitempty"`
+}
+
+// OverlayScrollSnapHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ScrollSnapHighlightConfig
+type OverlayScrollSnapHighlightConfig struct {
+	ScrollSnapContainerHighlightConfig *OverlayScrollSnapContainerHighlightConfig `json:"scrollSnapContainerHighlightConfig"`
+	NodeID                             DOMNodeID                                  `json:"nodeId"`
+}
+
+// OverlayHingeConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-HingeConfig
+type OverlayHingeConfig struct {
+	Rect         *DOMRect `json:"rect"`
+	ContentColor *DOMRGBA `json:"contentColor,omitempty"`
+	OutlineColor *DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// OverlayWindowControlsOverlayConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-WindowControlsOverlayConfig
+type OverlayWindowControlsOverlayConfig struct {
+	ShowCSS          bool   `json:"showCSS"`
+	SelectedPlatform string `json:"selectedPlatform"`
+	ThemeColor       string `json:"themeColor"`
+}
+
+// OverlayContainerQueryHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContainerQueryHighlightConfig
+type OverlayContainerQueryHighlightConfig struct {
+	ContainerQueryContainerHighlightConfig *OverlayContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig"`
+	NodeID                                 DOMNodeID                                      `json:"nodeId"`
+}
+
+// OverlayContainerQueryContainerHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-ContainerQueryContainerHighlightConfig
+type OverlayContainerQueryContainerHighlightConfig struct {
+	ContainerBorder  *OverlayLineStyle `json:"containerBorder,omitempty"`
+	DescendantBorder *OverlayLineStyle `json:"descendantBorder,omitempty"`
+}
+
+// OverlayIsolatedElementHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-IsolatedElementHighlightConfig
+type OverlayIsolatedElementHighlightConfig struct {
+	IsolationModeHighlightConfig *OverlayIsolationModeHighlightConfig `json:"isolationModeHighlightConfig"`
+	NodeID                       DOMNodeID                            `json:"nodeId"`
+}
+
+// OverlayIsolationModeHighlightConfig https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-IsolationModeHighlightConfig
+type OverlayIsolationModeHighlightConfig struct {
+	ResizerColor       *DOMRGBA `json:"resizerColor,omitempty"`
+	ResizerHandleColor *DOMRGBA `json:"resizerHandleColor,omitempty"`
+	MaskColor          *DOMRGBA `json:"maskColor,omitempty"`
+}
+
+// OverlayInspectMode https://chromedevtools.github.io/devtools-protocol/tot/Overlay#type-InspectMode
+type OverlayInspectMode string
+
+// OverlayInspectMode values
+const (
+	OverlayInspectModeSearchForNode         OverlayInspectMode = "searchForNode"
+	OverlayInspectModeSearchForUAShadowDOM  OverlayInspectMode = "searchForUAShadowDOM"
+	OverlayInspectModeCaptureAreaScreenshot OverlayInspectMode = "captureAreaScreenshot"
+	OverlayInspectModeNone                  OverlayInspectMode = "none"
+)
+
+// OverlayDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-disable
+type OverlayDisableParams struct {
+}
+
+// ProtocolMethod returns Overlay.disable
+func (*OverlayDisableParams) ProtocolMethod() string {
+	return "Overlay.disable"
+}
+
+// Do sends Overlay.disable
+func (p *OverlayDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-enable
+type OverlayEnableParams struct {
+}
+
+// ProtocolMethod returns Overlay.enable
+func (*OverlayEnableParams) ProtocolMethod() string {
+	return "Overlay.enable"
+}
+
+// Do sends Overlay.enable
+func (p *OverlayEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayGetHighlightObjectForTestParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getHighlightObjectForTest
+type OverlayGetHighlightObjectForTestParams struct {
+	NodeID                DOMNodeID          `json:"nodeId"`
+	IncludeDistance       bool               `json:"includeDistance,omitempty"`
+	IncludeStyle          bool               `json:"includeStyle,omitempty"`
+	ColorFormat           OverlayColorFormat `json:"colorFormat,omitempty"`
+	ShowAccessibilityInfo bool               `json:"showAccessibilityInfo,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.getHighlightObjectForTest
+func (*OverlayGetHighlightObjectForTestParams) ProtocolMethod() string {
+	return "Overlay.getHighlightObjectForTest"
+}
+
+// OverlayGetHighlightObjectForTestResult https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getHighlightObjectForTest
+type OverlayGetHighlightObjectForTestResult struct {
+	Highlight map[string]interface{} `json:"highlight"`
+}
+
+// Do sends Overlay.getHighlightObjectForTest and returns its result
+func (p *OverlayGetHighlightObjectForTestParams) Do(e Executor) (*OverlayGetHighlightObjectForTestResult, error) {
+	var result = new(OverlayGetHighlightObjectForTestResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OverlayGetGridHighlightObjectsForTestParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getGridHighlightObjectsForTest
+type OverlayGetGridHighlightObjectsForTestParams struct {
+	NodeIds []DOMNodeID `json:"nodeIds"`
+}
+
+// ProtocolMethod returns Overlay.getGridHighlightObjectsForTest
+func (*OverlayGetGridHighlightObjectsForTestParams) ProtocolMethod() string {
+	return "Overlay.getGridHighlightObjectsForTest"
+}
+
+// OverlayGetGridHighlightObjectsForTestResult https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getGridHighlightObjectsForTest
+type OverlayGetGridHighlightObjectsForTestResult struct {
+	Highlights map[string]interface{} `json:"highlights"`
+}
+
+// Do sends Overlay.getGridHighlightObjectsForTest and returns its result
+func (p *OverlayGetGridHighlightObjectsForTestParams) Do(e Executor) (*OverlayGetGridHighlightObjectsForTestResult, error) {
+	var result = new(OverlayGetGridHighlightObjectsForTestResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OverlayGetSourceOrderHighlightObjectForTestParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getSourceOrderHighlightObjectForTest
+type OverlayGetSourceOrderHighlightObjectForTestParams struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns Overlay.getSourceOrderHighlightObjectForTest
+func (*OverlayGetSourceOrderHighlightObjectForTestParams) ProtocolMethod() string {
+	return "Overlay.getSourceOrderHighlightObjectForTest"
+}
+
+// OverlayGetSourceOrderHighlightObjectForTestResult https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-getSourceOrderHighlightObjectForTest
+type OverlayGetSourceOrderHighlightObjectForTestResult struct {
+	Highlight map[string]interface{} `json:"highlight"`
+}
+
+// Do sends Overlay.getSourceOrderHighlightObjectForTest and returns its result
+func (p *OverlayGetSourceOrderHighlightObjectForTestParams) Do(e Executor) (*OverlayGetSourceOrderHighlightObjectForTestResult, error) {
+	var result = new(OverlayGetSourceOrderHighlightObjectForTestResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OverlayHideHighlightParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-hideHighlight
+type OverlayHideHighlightParams struct {
+}
+
+// ProtocolMethod returns Overlay.hideHighlight
+func (*OverlayHideHighlightParams) ProtocolMethod() string {
+	return "Overlay.hideHighlight"
+}
+
+// Do sends Overlay.hideHighlight
+func (p *OverlayHideHighlightParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayHighlightFrameParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightFrame
+//
+// Deprecated: deprecated in protocol
+type OverlayHighlightFrameParams struct {
+	FrameID             PageFrameID `json:"frameId"`
+	ContentColor        *DOMRGBA    `json:"contentColor,omitempty"`
+	ContentOutlineColor *DOMRGBA    `json:"contentOutlineColor,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.highlightFrame
+func (*OverlayHighlightFrameParams) ProtocolMethod() string {
+	return "Overlay.highlightFrame"
+}
+
+// Do sends Overlay.highlightFrame
+func (p *OverlayHighlightFrameParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayHighlightNodeParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightNode
+type OverlayHighlightNodeParams struct {
+	HighlightConfig *OverlayHighlightConfig `json:"highlightConfig"`
+	NodeID          DOMNodeID               `json:"nodeId,omitempty"`
+	BackendNodeID   DOMBackendNodeID        `json:"backendNodeId,omitempty"`
+	ObjectID        RuntimeRemoteObjectID   `json:"objectId,omitempty"`
+	Selector        string                  `json:"selector,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.highlightNode
+func (*OverlayHighlightNodeParams) ProtocolMethod() string {
+	return "Overlay.highlightNode"
+}
+
+// Do sends Overlay.highlightNode
+func (p *OverlayHighlightNodeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayHighlightQuadParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightQuad
+type OverlayHighlightQuadParams struct {
+	Quad         DOMQuad  `json:"quad"`
+	Color        *DOMRGBA `json:"color,omitempty"`
+	OutlineColor *DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.highlightQuad
+func (*OverlayHighlightQuadParams) ProtocolMethod() string {
+	return "Overlay.highlightQuad"
+}
+
+// Do sends Overlay.highlightQuad
+func (p *OverlayHighlightQuadParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayHighlightRectParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightRect
+type OverlayHighlightRectParams struct {
+	X            int64    `json:"x"`
+	Y            int64    `json:"y"`
+	Width        int64    `json:"width"`
+	Height       int64    `json:"height"`
+	Color        *DOMRGBA `json:"color,omitempty"`
+	OutlineColor *DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.highlightRect
+func (*OverlayHighlightRectParams) ProtocolMethod() string {
+	return "Overlay.highlightRect"
+}
+
+// Do sends Overlay.highlightRect
+func (p *OverlayHighlightRectParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayHighlightSourceOrderParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-highlightSourceOrder
+type OverlayHighlightSourceOrderParams struct {
+	SourceOrderConfig *OverlaySourceOrderConfig `json:"sourceOrderConfig"`
+	NodeID            DOMNodeID                 `json:"nodeId,omitempty"`
+	BackendNodeID     DOMBackendNodeID          `json:"backendNodeId,omitempty"`
+	ObjectID          RuntimeRemoteObjectID     `json:"objectId,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.highlightSourceOrder
+func (*OverlayHighlightSourceOrderParams) ProtocolMethod() string {
+	return "Overlay.highlightSourceOrder"
+}
+
+// Do sends Overlay.highlightSourceOrder
+func (p *OverlayHighlightSourceOrderParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetInspectModeParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setInspectMode
+type OverlaySetInspectModeParams struct {
+	Mode            OverlayInspectMode      `json:"mode"`
+	HighlightConfig *OverlayHighlightConfig `json:"highlightConfig,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.setInspectMode
+func (*OverlaySetInspectModeParams) ProtocolMethod() string {
+	return "Overlay.setInspectMode"
+}
+
+// Do sends Overlay.setInspectMode
+func (p *OverlaySetInspectModeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowAdHighlightsParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowAdHighlights
+type OverlaySetShowAdHighlightsParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowAdHighlights
+func (*OverlaySetShowAdHighlightsParams) ProtocolMethod() string {
+	return "Overlay.setShowAdHighlights"
+}
+
+// Do sends Overlay.setShowAdHighlights
+func (p *OverlaySetShowAdHighlightsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetPausedInDebuggerMessageParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setPausedInDebuggerMessage
+type OverlaySetPausedInDebuggerMessageParams struct {
+	Message string `json:"message,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.setPausedInDebuggerMessage
+func (*OverlaySetPausedInDebuggerMessageParams) ProtocolMethod() string {
+	return "Overlay.setPausedInDebuggerMessage"
+}
+
+// Do sends Overlay.setPausedInDebuggerMessage
+func (p *OverlaySetPausedInDebuggerMessageParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowDebugBordersParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowDebugBorders
+type OverlaySetShowDebugBordersParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowDebugBorders
+func (*OverlaySetShowDebugBordersParams) ProtocolMethod() string {
+	return "Overlay.setShowDebugBorders"
+}
+
+// Do sends Overlay.setShowDebugBorders
+func (p *OverlaySetShowDebugBordersParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowFPSCounterParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowFPSCounter
+type OverlaySetShowFPSCounterParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowFPSCounter
+func (*OverlaySetShowFPSCounterParams) ProtocolMethod() string {
+	return "Overlay.setShowFPSCounter"
+}
+
+// Do sends Overlay.setShowFPSCounter
+func (p *OverlaySetShowFPSCounterParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowGridOverlaysParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowGridOverlays
+type OverlaySetShowGridOverlaysParams struct {
+	GridNodeHighlightConfigs []*OverlayGridNodeHighlightConfig `json:"gridNodeHighlightConfigs"`
+}
+
+// ProtocolMethod returns Overlay.setShowGridOverlays
+func (*OverlaySetShowGridOverlaysParams) ProtocolMethod() string {
+	return "Overlay.setShowGridOverlays"
+}
+
+// Do sends Overlay.setShowGridOverlays
+func (p *OverlaySetShowGridOverlaysParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowFlexOverlaysParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowFlexOverlays
+type OverlaySetShowFlexOverlaysParams struct {
+	FlexNodeHighlightConfigs []*OverlayFlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"`
+}
+
+// ProtocolMethod returns Overlay.setShowFlexOverlays
+func (*OverlaySetShowFlexOverlaysParams) ProtocolMethod() string {
+	return "Overlay.setShowFlexOverlays"
+}
+
+// Do sends Overlay.setShowFlexOverlays
+func (p *OverlaySetShowFlexOverlaysParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowScrollSnapOverlaysParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowScrollSnapOverlays
+type OverlaySetShowScrollSnapOverlaysParams struct {
+	ScrollSnapHighlightConfigs []*OverlayScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"`
+}
+
+// ProtocolMethod returns Overlay.setShowScrollSnapOverlays
+func (*OverlaySetShowScrollSnapOverlaysParams) ProtocolMethod() string {
+	return "Overlay.setShowScrollSnapOverlays"
+}
+
+// Do sends Overlay.setShowScrollSnapOverlays
+func (p *OverlaySetShowScrollSnapOverlaysParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowContainerQueryOverlaysParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowContainerQueryOverlays
+type OverlaySetShowContainerQueryOverlaysParams struct {
+	ContainerQueryHighlightConfigs []*OverlayContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"`
+}
+
+// ProtocolMethod returns Overlay.setShowContainerQueryOverlays
+func (*OverlaySetShowContainerQueryOverlaysParams) ProtocolMethod() string {
+	return "Overlay.setShowContainerQueryOverlays"
+}
+
+// Do sends Overlay.setShowContainerQueryOverlays
+func (p *OverlaySetShowContainerQueryOverlaysParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowPaintRectsParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowPaintRects
+type OverlaySetShowPaintRectsParams struct {
+	Result bool `json:"result"`
+}
+
+// ProtocolMethod returns Overlay.setShowPaintRects
+func (*OverlaySetShowPaintRectsParams) ProtocolMethod() string {
+	return "Overlay.setShowPaintRects"
+}
+
+// Do sends Overlay.setShowPaintRects
+func (p *OverlaySetShowPaintRectsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowLayoutShiftRegionsParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowLayoutShiftRegions
+type OverlaySetShowLayoutShiftRegionsParams struct {
+	Result bool `json:"result"`
+}
+
+// ProtocolMethod returns Overlay.setShowLayoutShiftRegions
+func (*OverlaySetShowLayoutShiftRegionsParams) ProtocolMethod() string {
+	return "Overlay.setShowLayoutShiftRegions"
+}
+
+// Do sends Overlay.setShowLayoutShiftRegions
+func (p *OverlaySetShowLayoutShiftRegionsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowScrollBottleneckRectsParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowScrollBottleneckRects
+type OverlaySetShowScrollBottleneckRectsParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowScrollBottleneckRects
+func (*OverlaySetShowScrollBottleneckRectsParams) ProtocolMethod() string {
+	return "Overlay.setShowScrollBottleneckRects"
+}
+
+// Do sends Overlay.setShowScrollBottleneckRects
+func (p *OverlaySetShowScrollBottleneckRectsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowHitTestBordersParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowHitTestBorders
+//
+// Deprecated: deprecated in protocol
+type OverlaySetShowHitTestBordersParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowHitTestBorders
+func (*OverlaySetShowHitTestBordersParams) ProtocolMethod() string {
+	return "Overlay.setShowHitTestBorders"
+}
+
+// Do sends Overlay.setShowHitTestBorders
+func (p *OverlaySetShowHitTestBordersParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowWebVitalsParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowWebVitals
+//
+// Deprecated: deprecated in protocol
+type OverlaySetShowWebVitalsParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowWebVitals
+func (*OverlaySetShowWebVitalsParams) ProtocolMethod() string {
+	return "Overlay.setShowWebVitals"
+}
+
+// Do sends Overlay.setShowWebVitals
+func (p *OverlaySetShowWebVitalsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowViewportSizeOnResizeParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowViewportSizeOnResize
+type OverlaySetShowViewportSizeOnResizeParams struct {
+	Show bool `json:"show"`
+}
+
+// ProtocolMethod returns Overlay.setShowViewportSizeOnResize
+func (*OverlaySetShowViewportSizeOnResizeParams) ProtocolMethod() string {
+	return "Overlay.setShowViewportSizeOnResize"
+}
+
+// Do sends Overlay.setShowViewportSizeOnResize
+func (p *OverlaySetShowViewportSizeOnResizeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowHingeParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowHinge
+type OverlaySetShowHingeParams struct {
+	HingeConfig *OverlayHingeConfig `json:"hingeConfig,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.setShowHinge
+func (*OverlaySetShowHingeParams) ProtocolMethod() string {
+	return "Overlay.setShowHinge"
+}
+
+// Do sends Overlay.setShowHinge
+func (p *OverlaySetShowHingeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowIsolatedElementsParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowIsolatedElements
+type OverlaySetShowIsolatedElementsParams struct {
+	IsolatedElementHighlightConfigs []*OverlayIsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"`
+}
+
+// ProtocolMethod returns Overlay.setShowIsolatedElements
+func (*OverlaySetShowIsolatedElementsParams) ProtocolMethod() string {
+	return "Overlay.setShowIsolatedElements"
+}
+
+// Do sends Overlay.setShowIsolatedElements
+func (p *OverlaySetShowIsolatedElementsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlaySetShowWindowControlsOverlayParams https://chromedevtools.github.io/devtools-protocol/tot/Overlay#method-setShowWindowControlsOverlay
+type OverlaySetShowWindowControlsOverlayParams struct {
+	WindowControlsOverlayConfig *OverlayWindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"`
+}
+
+// ProtocolMethod returns Overlay.setShowWindowControlsOverlay
+func (*OverlaySetShowWindowControlsOverlayParams) ProtocolMethod() string {
+	return "Overlay.setShowWindowControlsOverlay"
+}
+
+// Do sends Overlay.setShowWindowControlsOverlay
+func (p *OverlaySetShowWindowControlsOverlayParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// OverlayInspectNodeRequestedEvent https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-inspectNodeRequested
+type OverlayInspectNodeRequestedEvent struct {
+	BackendNodeID DOMBackendNodeID `json:"backendNodeId"`
+}
+
+// ProtocolMethod returns Overlay.inspectNodeRequested
+func (*OverlayInspectNodeRequestedEvent) ProtocolMethod() string {
+	return "Overlay.inspectNodeRequested"
+}
+
+// OverlayNodeHighlightRequestedEvent https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-nodeHighlightRequested
+type OverlayNodeHighlightRequestedEvent struct {
+	NodeID DOMNodeID `json:"nodeId"`
+}
+
+// ProtocolMethod returns Overlay.nodeHighlightRequested
+func (*OverlayNodeHighlightRequestedEvent) ProtocolMethod() string {
+	return "Overlay.nodeHighlightRequested"
+}
+
+// OverlayScreenshotRequestedEvent https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-screenshotRequested
+type OverlayScreenshotRequestedEvent struct {
+	Viewport *PageViewport `json:"viewport"`
+}
+
+// ProtocolMethod returns Overlay.screenshotRequested
+func (*OverlayScreenshotRequestedEvent) ProtocolMethod() string {
+	return "Overlay.screenshotRequested"
+}
+
+// OverlayInspectModeCanceledEvent https://chromedevtools.github.io/devtools-protocol/tot/Overlay#event-inspectModeCanceled
+type OverlayInspectModeCanceledEvent struct {
+}
+
+// ProtocolMethod returns Overlay.inspectModeCanceled
+func (*OverlayInspectModeCanceledEvent) ProtocolMethod() string {
+	return "Overlay.inspectModeCanceled"
+}
+
+// OverlayLineStylePattern enum
+type OverlayLineStylePattern string
+
+// OverlayLineStylePattern values
+const (
+	OverlayLineStylePatternDashed OverlayLineStylePattern = "dashed"
+	OverlayLineStylePatternDotted OverlayLineStylePattern = "dotted"
+)
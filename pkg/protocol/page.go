@@ -0,0 +1,2305 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Page events
+const (
+	EventPageDOMContentEventFired            = "Page.domContentEventFired"
+	EventPageFileChooserOpened               = "Page.fileChooserOpened"
+	EventPageFrameAttached                   = "Page.frameAttached"
+	EventPageFrameClearedScheduledNavigation = "Page.frameClearedScheduledNavigation"
+	EventPageFrameDetached                   = "Page.frameDetached"
+	EventPageFrameSubtreeWillBeDetached      = "Page.frameSubtreeWillBeDetached"
+	EventPageFrameNavigated                  = "Page.frameNavigated"
+	EventPageDocumentOpened                  = "Page.documentOpened"
+	EventPageFrameResized                    = "Page.frameResized"
+	EventPageFrameStartedNavigating          = "Page.frameStartedNavigating"
+	EventPageFrameRequestedNavigation        = "Page.frameRequestedNavigation"
+	EventPageFrameScheduledNavigation        = "Page.frameScheduledNavigation"
+	EventPageFrameStartedLoading             = "Page.frameStartedLoading"
+	EventPageFrameStoppedLoading             = "Page.frameStoppedLoading"
+	EventPageDownloadWillBegin               = "Page.downloadWillBegin"
+	EventPageDownloadProgress                = "Page.downloadProgress"
+	EventPageInterstitialHidden              = "Page.interstitialHidden"
+	EventPageInterstitialShown               = "Page.interstitialShown"
+	EventPageJavascriptDialogClosed          = "Page.javascriptDialogClosed"
+	EventPageJavascriptDialogOpening         = "Page.javascriptDialogOpening"
+	EventPageLifecycleEvent                  = "Page.lifecycleEvent"
+	EventPageBackForwardCacheNotUsed         = "Page.backForwardCacheNotUsed"
+	EventPageLoadEventFired                  = "Page.loadEventFired"
+	EventPageNavigatedWithinDocument         = "Page.navigatedWithinDocument"
+	EventPageScreencastFrame                 = "Page.screencastFrame"
+	EventPageScreencastVisibilityChanged     = "Page.screencastVisibilityChanged"
+	EventPageWindowOpen                      = "Page.windowOpen"
+	EventPageCompilationCacheProduced        = "Page.compilationCacheProduced"
+)
+
+// PageFrameID https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameId
+type PageFrameID string
+
+// PageAdFrameType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameType
+type PageAdFrameType string
+
+// PageAdFrameType values
+const (
+	PageAdFrameTypeNone  PageAdFrameType = "none"
+	PageAdFrameTypeChild PageAdFrameType = "child"
+	PageAdFrameTypeRoot  PageAdFrameType = "root"
+)
+
+// PageAdFrameExplanation https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameExplanation
+type PageAdFrameExplanation string
+
+// PageAdFrameExplanation values
+const (
+	PageAdFrameExplanationParentIsAd          PageAdFrameExplanation = "ParentIsAd"
+	PageAdFrameExplanationCreatedByAdScript   PageAdFrameExplanation = "CreatedByAdScript"
+	PageAdFrameExplanationMatchedBlockingRule PageAdFrameExplanation = "MatchedBlockingRule"
+)
+
+// PageAdFrameStatus https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdFrameStatus
+type PageAdFrameStatus struct {
+	AdFrameType  PageAdFrameType          `json:"adFrameType"`
+	Explanations []PageAdFrameExplanation `json:"explanations,omitempty"`
+}
+
+// PageAdScriptID https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdScriptId
+type PageAdScriptID struct {
+	ScriptID   RuntimeScriptID         `json:"scriptId"`
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId"`
+}
+
+// PageAdScriptAncestry https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AdScriptAncestry
+type PageAdScriptAncestry struct {
+	AncestryChain            []*PageAdScriptID `json:"ancestryChain"`
+	RootScriptFilterlistRule string            `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+// PageSecureContextType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-SecureContextType
+type PageSecureContextType string
+
+// PageSecureContextType values
+const (
+	PageSecureContextTypeSecure           PageSecureContextType = "Secure"
+	PageSecureContextTypeSecureLocalhost  PageSecureContextType = "SecureLocalhost"
+	PageSecureContextTypeInsecureScheme   PageSecureContextType = "InsecureScheme"
+	PageSecureContextTypeInsecureAncestor PageSecureContextType = "InsecureAncestor"
+)
+
+// PageCrossOriginIsolatedContextType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-CrossOriginIsolatedContextType
+type PageCrossOriginIsolatedContextType string
+
+// PageCrossOriginIsolatedContextType values
+const (
+	PageCrossOriginIsolatedContextTypeIsolated                   PageCrossOriginIsolatedContextType = "Isolated"
+	PageCrossOriginIsolatedContextTypeNotIsolated                PageCrossOriginIsolatedContextType = "NotIsolated"
+	PageCrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled PageCrossOriginIsolatedContextType = "NotIsolatedFeatureDisabled"
+)
+
+// PageGatedAPIFeatures https://chromedevtools.github.io/devtools-protocol/tot/Page#type-GatedAPIFeatures
+type PageGatedAPIFeatures string
+
+// PageGatedAPIFeatures values
+const (
+	PageGatedAPIFeaturesSharedArrayBuffers                PageGatedAPIFeatures = "SharedArrayBuffers"
+	PageGatedAPIFeaturesSharedArrayBuffersTransferAllowed PageGatedAPIFeatures = "SharedArrayBuffersTransferAllowed"
+	PageGatedAPIFeaturesPerformanceMeasureMemory          PageGatedAPIFeatures = "PerformanceMeasureMemory"
+	PageGatedAPIFeaturesPerformanceProfile                PageGatedAPIFeatures = "PerformanceProfile"
+)
+
+// PagePermissionsPolicyFeature https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyFeature
+type PagePermissionsPolicyFeature string
+
+// PagePermissionsPolicyFeature values
+const (
+	PagePermissionsPolicyFeatureAccelerometer                  PagePermissionsPolicyFeature = "accelerometer"
+	PagePermissionsPolicyFeatureAllScreensCapture              PagePermissionsPolicyFeature = "all-screens-capture"
+	PagePermissionsPolicyFeatureAmbientLightSensor             PagePermissionsPolicyFeature = "ambient-light-sensor"
+	PagePermissionsPolicyFeatureAriaNotify                     PagePermissionsPolicyFeature = "aria-notify"
+	PagePermissionsPolicyFeatureAttributionReporting           PagePermissionsPolicyFeature = "attribution-reporting"
+	PagePermissionsPolicyFeatureAutoplay                       PagePermissionsPolicyFeature = "autoplay"
+	PagePermissionsPolicyFeatureBluetooth                      PagePermissionsPolicyFeature = "bluetooth"
+	PagePermissionsPolicyFeatureBrowsingTopics                 PagePermissionsPolicyFeature = "browsing-topics"
+	PagePermissionsPolicyFeatureCamera                         PagePermissionsPolicyFeature = "camera"
+	PagePermissionsPolicyFeatureCapturedSurfaceControl         PagePermissionsPolicyFeature = "captured-surface-control"
+	PagePermissionsPolicyFeatureChDpr                          PagePermissionsPolicyFeature = "ch-dpr"
+	PagePermissionsPolicyFeatureChDeviceMemory                 PagePermissionsPolicyFeature = "ch-device-memory"
+	PagePermissionsPolicyFeatureChDownlink                     PagePermissionsPolicyFeature = "ch-downlink"
+	PagePermissionsPolicyFeatureChEct                          PagePermissionsPolicyFeature = "ch-ect"
+	PagePermissionsPolicyFeatureChPrefersColorScheme           PagePermissionsPolicyFeature = "ch-prefers-color-scheme"
+	PagePermissionsPolicyFeatureChPrefersReducedMotion         PagePermissionsPolicyFeature = "ch-prefers-reduced-motion"
+	PagePermissionsPolicyFeatureChPrefersReducedTransparency   PagePermissionsPolicyFeature = "ch-prefers-reduced-transparency"
+	PagePermissionsPolicyFeatureChRtt                          PagePermissionsPolicyFeature = "ch-rtt"
+	PagePermissionsPolicyFeatureChSaveData                     PagePermissionsPolicyFeature = "ch-save-data"
+	PagePermissionsPolicyFeatureChUa                           PagePermissionsPolicyFeature = "ch-ua"
+	PagePermissionsPolicyFeatureChUaArch                       PagePermissionsPolicyFeature = "ch-ua-arch"
+	PagePermissionsPolicyFeatureChUaBitness                    PagePermissionsPolicyFeature = "ch-ua-bitness"
+	PagePermissionsPolicyFeatureChUaHighEntropyValues          PagePermissionsPolicyFeature = "ch-ua-high-entropy-values"
+	PagePermissionsPolicyFeatureChUaPlatform                   PagePermissionsPolicyFeature = "ch-ua-platform"
+	PagePermissionsPolicyFeatureChUaModel                      PagePermissionsPolicyFeature = "ch-ua-model"
+	PagePermissionsPolicyFeatureChUaMobile                     PagePermissionsPolicyFeature = "ch-ua-mobile"
+	PagePermissionsPolicyFeatureChUaFormFactors                PagePermissionsPolicyFeature = "ch-ua-form-factors"
+	PagePermissionsPolicyFeatureChUaFullVersion                PagePermissionsPolicyFeature = "ch-ua-full-version"
+	PagePermissionsPolicyFeatureChUaFullVersionList            PagePermissionsPolicyFeature = "ch-ua-full-version-list"
+	PagePermissionsPolicyFeatureChUaPlatformVersion            PagePermissionsPolicyFeature = "ch-ua-platform-version"
+	PagePermissionsPolicyFeatureChUaWow64                      PagePermissionsPolicyFeature = "ch-ua-wow64"
+	PagePermissionsPolicyFeatureChViewportHeight               PagePermissionsPolicyFeature = "ch-viewport-height"
+	PagePermissionsPolicyFeatureChViewportWidth                PagePermissionsPolicyFeature = "ch-viewport-width"
+	PagePermissionsPolicyFeatureChWidth                        PagePermissionsPolicyFeature = "ch-width"
+	PagePermissionsPolicyFeatureClipboardRead                  PagePermissionsPolicyFeature = "clipboard-read"
+	PagePermissionsPolicyFeatureClipboardWrite                 PagePermissionsPolicyFeature = "clipboard-write"
+	PagePermissionsPolicyFeatureComputePressure                PagePermissionsPolicyFeature = "compute-pressure"
+	PagePermissionsPolicyFeatureControlledFrame                PagePermissionsPolicyFeature = "controlled-frame"
+	PagePermissionsPolicyFeatureCrossOriginIsolated            PagePermissionsPolicyFeature = "cross-origin-isolated"
+	PagePermissionsPolicyFeatureDeferredFetch                  PagePermissionsPolicyFeature = "deferred-fetch"
+	PagePermissionsPolicyFeatureDeferredFetchMinimal           PagePermissionsPolicyFeature = "deferred-fetch-minimal"
+	PagePermissionsPolicyFeatureDeviceAttributes               PagePermissionsPolicyFeature = "device-attributes"
+	PagePermissionsPolicyFeatureDigitalCredentialsGet          PagePermissionsPolicyFeature = "digital-credentials-get"
+	PagePermissionsPolicyFeatureDirectSockets                  PagePermissionsPolicyFeature = "direct-sockets"
+	PagePermissionsPolicyFeatureDirectSocketsPrivate           PagePermissionsPolicyFeature = "direct-sockets-private"
+	PagePermissionsPolicyFeatureDisplayCapture                 PagePermissionsPolicyFeature = "display-capture"
+	PagePermissionsPolicyFeatureDocumentDomain                 PagePermissionsPolicyFeature = "document-domain"
+	PagePermissionsPolicyFeatureEncryptedMedia                 PagePermissionsPolicyFeature = "encrypted-media"
+	PagePermissionsPolicyFeatureExecutionWhileOutOfViewport    PagePermissionsPolicyFeature = "execution-while-out-of-viewport"
+	PagePermissionsPolicyFeatureExecutionWhileNotRendered      PagePermissionsPolicyFeature = "execution-while-not-rendered"
+	PagePermissionsPolicyFeatureFencedUnpartitionedStorageRead PagePermissionsPolicyFeature = "fenced-unpartitioned-storage-read"
+	PagePermissionsPolicyFeatureFocusWithoutUserActivation     PagePermissionsPolicyFeature = "focus-without-user-activation"
+	PagePermissionsPolicyFeatureFullscreen                     PagePermissionsPolicyFeature = "fullscreen"
+	PagePermissionsPolicyFeatureFrobulate                      PagePermissionsPolicyFeature = "frobulate"
+	PagePermissionsPolicyFeatureGamepad                        PagePermissionsPolicyFeature = "gamepad"
+	PagePermissionsPolicyFeatureGeolocation                    PagePermissionsPolicyFeature = "geolocation"
+	PagePermissionsPolicyFeatureGyroscope                      PagePermissionsPolicyFeature = "gyroscope"
+	PagePermissionsPolicyFeatureHid                            PagePermissionsPolicyFeature = "hid"
+	PagePermissionsPolicyFeatureIdentityCredentialsGet         PagePermissionsPolicyFeature = "identity-credentials-get"
+	PagePermissionsPolicyFeatureIdleDetection                  PagePermissionsPolicyFeature = "idle-detection"
+	PagePermissionsPolicyFeatureInterestCohort                 PagePermissionsPolicyFeature = "interest-cohort"
+	PagePermissionsPolicyFeatureJoinAdInterestGroup            PagePermissionsPolicyFeature = "join-ad-interest-group"
+	PagePermissionsPolicyFeatureKeyboardMap                    PagePermissionsPolicyFeature = "keyboard-map"
+	PagePermissionsPolicyFeatureLanguageDetector               PagePermissionsPolicyFeature = "language-detector"
+	PagePermissionsPolicyFeatureLanguageModel                  PagePermissionsPolicyFeature = "language-model"
+	PagePermissionsPolicyFeatureLocalFonts                     PagePermissionsPolicyFeature = "local-fonts"
+	PagePermissionsPolicyFeatureLocalNetworkAccess             PagePermissionsPolicyFeature = "local-network-access"
+	PagePermissionsPolicyFeatureMagnetometer                   PagePermissionsPolicyFeature = "magnetometer"
+	PagePermissionsPolicyFeatureMediaPlaybackWhileNotVisible   PagePermissionsPolicyFeature = "media-playback-while-not-visible"
+	PagePermissionsPolicyFeatureMicrophone                     PagePermissionsPolicyFeature = "microphone"
+	PagePermissionsPolicyFeatureMidi                           PagePermissionsPolicyFeature = "midi"
+	PagePermissionsPolicyFeatureOnDeviceSpeechRecognition      PagePermissionsPolicyFeature = "on-device-speech-recognition"
+	PagePermissionsPolicyFeatureOtpCredentials                 PagePermissionsPolicyFeature = "otp-credentials"
+	PagePermissionsPolicyFeaturePayment                        PagePermissionsPolicyFeature = "payment"
+	PagePermissionsPolicyFeaturePictureInPicture               PagePermissionsPolicyFeature = "picture-in-picture"
+	PagePermissionsPolicyFeaturePopins                         PagePermissionsPolicyFeature = "popins"
+	PagePermissionsPolicyFeaturePrivateAggregation             PagePermissionsPolicyFeature = "private-aggregation"
+	PagePermissionsPolicyFeaturePrivateStateTokenIssuance      PagePermissionsPolicyFeature = "private-state-token-issuance"
+	PagePermissionsPolicyFeaturePrivateStateTokenRedemption    PagePermissionsPolicyFeature = "private-state-token-redemption"
+	PagePermissionsPolicyFeaturePublickeyCredentialsCreate     PagePermissionsPolicyFeature = "publickey-credentials-create"
+	PagePermissionsPolicyFeaturePublickeyCredentialsGet        PagePermissionsPolicyFeature = "publickey-credentials-get"
+	PagePermissionsPolicyFeatureRecordAdAuctionEvents          PagePermissionsPolicyFeature = "record-ad-auction-events"
+	PagePermissionsPolicyFeatureRewriter                       PagePermissionsPolicyFeature = "rewriter"
+	PagePermissionsPolicyFeatureRunAdAuction                   PagePermissionsPolicyFeature = "run-ad-auction"
+	PagePermissionsPolicyFeatureScreenWakeLock                 PagePermissionsPolicyFeature = "screen-wake-lock"
+	PagePermissionsPolicyFeatureSerial                         PagePermissionsPolicyFeature = "serial"
+	PagePermissionsPolicyFeatureSharedAutofill                 PagePermissionsPolicyFeature = "shared-autofill"
+	PagePermissionsPolicyFeatureSharedStorage                  PagePermissionsPolicyFeature = "shared-storage"
+	PagePermissionsPolicyFeatureSharedStorageSelectURL         PagePermissionsPolicyFeature = "shared-storage-select-url"
+	PagePermissionsPolicyFeatureSmartCard                      PagePermissionsPolicyFeature = "smart-card"
+	PagePermissionsPolicyFeatureSpeakerSelection               PagePermissionsPolicyFeature = "speaker-selection"
+	PagePermissionsPolicyFeatureStorageAccess                  PagePermissionsPolicyFeature = "storage-access"
+	PagePermissionsPolicyFeatureSubApps                        PagePermissionsPolicyFeature = "sub-apps"
+	PagePermissionsPolicyFeatureSummarizer                     PagePermissionsPolicyFeature = "summarizer"
+	PagePermissionsPolicyFeatureSyncXhr                        PagePermissionsPolicyFeature = "sync-xhr"
+	PagePermissionsPolicyFeatureTranslator                     PagePermissionsPolicyFeature = "translator"
+	PagePermissionsPolicyFeatureUnload                         PagePermissionsPolicyFeature = "unload"
+	PagePermissionsPolicyFeatureUsb                            PagePermissionsPolicyFeature = "usb"
+	PagePermissionsPolicyFeatureUsbUnrestricted                PagePermissionsPolicyFeature = "usb-unrestricted"
+	PagePermissionsPolicyFeatureVerticalScroll                 PagePermissionsPolicyFeature = "vertical-scroll"
+	PagePermissionsPolicyFeatureWebAppInstallation             PagePermissionsPolicyFeature = "web-app-installation"
+	PagePermissionsPolicyFeatureWebPrinting                    PagePermissionsPolicyFeature = "web-printing"
+	PagePermissionsPolicyFeatureWebShare                       PagePermissionsPolicyFeature = "web-share"
+	PagePermissionsPolicyFeatureWindowManagement               PagePermissionsPolicyFeature = "window-management"
+	PagePermissionsPolicyFeatureWriter                         PagePermissionsPolicyFeature = "writer"
+	PagePermissionsPolicyFeatureXrSpatialTracking              PagePermissionsPolicyFeature = "xr-spatial-tracking"
+)
+
+// PagePermissionsPolicyBlockReason https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyBlockReason
+type PagePermissionsPolicyBlockReason string
+
+// PagePermissionsPolicyBlockReason values
+const (
+	PagePermissionsPolicyBlockReasonHeader            PagePermissionsPolicyBlockReason = "Header"
+	PagePermissionsPolicyBlockReasonIframeAttribute   PagePermissionsPolicyBlockReason = "IframeAttribute"
+	PagePermissionsPolicyBlockReasonInFencedFrameTree PagePermissionsPolicyBlockReason = "InFencedFrameTree"
+	PagePermissionsPolicyBlockReasonInIsolatedApp     PagePermissionsPolicyBlockReason = "InIsolatedApp"
+)
+
+// PagePermissionsPolicyBlockLocator https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyBlockLocator
+type PagePermissionsPolicyBlockLocator struct {
+	FrameID     PageFrameID                      `json:"frameId"`
+	BlockReason PagePermissionsPolicyBlockReason `json:"blockReason"`
+}
+
+// PagePermissionsPolicyFeatureState https://chromedevtools.github.io/devtools-protocol/tot/Page#type-PermissionsPolicyFeatureState
+type PagePermissionsPolicyFeatureState struct {
+	Feature PagePermissionsPolicyFeature       `json:"feature"`
+	Allowed bool                               `json:"allowed"`
+	Locator *PagePermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+// PageOriginTrialTokenStatus https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialTokenStatus
+type PageOriginTrialTokenStatus string
+
+// PageOriginTrialTokenStatus values
+const (
+	PageOriginTrialTokenStatusSuccess                PageOriginTrialTokenStatus = "Success"
+	PageOriginTrialTokenStatusNotSupported           PageOriginTrialTokenStatus = "NotSupported"
+	PageOriginTrialTokenStatusInsecure               PageOriginTrialTokenStatus = "Insecure"
+	PageOriginTrialTokenStatusExpired                PageOriginTrialTokenStatus = "Expired"
+	PageOriginTrialTokenStatusWrongOrigin            PageOriginTrialTokenStatus = "WrongOrigin"
+	PageOriginTrialTokenStatusInvalidSignature       PageOriginTrialTokenStatus = "InvalidSignature"
+	PageOriginTrialTokenStatusMalformed              PageOriginTrialTokenStatus = "Malformed"
+	PageOriginTrialTokenStatusWrongVersion           PageOriginTrialTokenStatus = "WrongVersion"
+	PageOriginTrialTokenStatusFeatureDisabled        PageOriginTrialTokenStatus = "FeatureDisabled"
+	PageOriginTrialTokenStatusTokenDisabled          PageOriginTrialTokenStatus = "TokenDisabled"
+	PageOriginTrialTokenStatusFeatureDisabledForUser PageOriginTrialTokenStatus = "FeatureDisabledForUser"
+	PageOriginTrialTokenStatusUnknownTrial           PageOriginTrialTokenStatus = "UnknownTrial"
+)
+
+// PageOriginTrialStatus https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialStatus
+type PageOriginTrialStatus string
+
+// PageOriginTrialStatus values
+const (
+	PageOriginTrialStatusEnabled               PageOriginTrialStatus = "Enabled"
+	PageOriginTrialStatusValidTokenNotProvided PageOriginTrialStatus = "ValidTokenNotProvided"
+	PageOriginTrialStatusOSNotSupported        PageOriginTrialStatus = "OSNotSupported"
+	PageOriginTrialStatusTrialNotAllowed       PageOriginTrialStatus = "TrialNotAllowed"
+)
+
+// PageOriginTrialUsageRestriction https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialUsageRestriction
+type PageOriginTrialUsageRestriction string
+
+// PageOriginTrialUsageRestriction values
+const (
+	PageOriginTrialUsageRestrictionNone   PageOriginTrialUsageRestriction = "None"
+	PageOriginTrialUsageRestrictionSubset PageOriginTrialUsageRestriction = "Subset"
+)
+
+// PageOriginTrialToken https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialToken
+type PageOriginTrialToken struct {
+	Origin           string                          `json:"origin"`
+	MatchSubDomains  bool                            `json:"matchSubDomains"`
+	TrialName        string                          `json:"trialName"`
+	ExpiryTime       NetworkTimeSinceEpoch           `json:"expiryTime"`
+	IsThirdParty     bool                            `json:"isThirdParty"`
+	UsageRestriction PageOriginTrialUsageRestriction `json:"usageRestriction"`
+}
+
+// PageOriginTrialTokenWithStatus https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrialTokenWithStatus
+type PageOriginTrialTokenWithStatus struct {
+	RawTokenText string                     `json:"rawTokenText"`
+	ParsedToken  *PageOriginTrialToken      `json:"parsedToken,omitempty"`
+	Status       PageOriginTrialTokenStatus `json:"status"`
+}
+
+// PageOriginTrial https://chromedevtools.github.io/devtools-protocol/tot/Page#type-OriginTrial
+type PageOriginTrial struct {
+	TrialName        string                            `json:"trialName"`
+	Status           PageOriginTrialStatus             `json:"status"`
+	TokensWithStatus []*PageOriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+// PageSecurityOriginDetails https://chromedevtools.github.io/devtools-protocol/tot/Page#type-SecurityOriginDetails
+type PageSecurityOriginDetails struct {
+	IsLocalhost bool `json:"isLocalhost"`
+}
+
+// PageFrame https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Frame
+type PageFrame struct {
+	ID                             PageFrameID                        `json:"id"`
+	ParentID                       PageFrameID                        `json:"parentId,omitempty"`
+	LoaderID                       NetworkLoaderID                    `json:"loaderId"`
+	Name                           string                             `json:"name,omitempty"`
+	URL                            string                             `json:"url"`
+	URLFragment                    string                             `json:"urlFragment,omitempty"`
+	DomainAndRegistry              string                             `json:"domainAndRegistry"`
+	SecurityOrigin                 string                             `json:"securityOrigin"`
+	SecurityOriginDetails          *PageSecurityOriginDetails         `json:"securityOriginDetails,omitempty"`
+	MimeType                       string                             `json:"mimeType"`
+	UnreachableURL                 string                             `json:"unreachableUrl,omitempty"`
+	AdFrameStatus                  *PageAdFrameStatus                 `json:"adFrameStatus,omitempty"`
+	SecureContextType              PageSecureContextType              `json:"secureContextType"`
+	CrossOriginIsolatedContextType PageCrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`
+	GatedAPIFeatures               []PageGatedAPIFeatures             `json:"gatedAPIFeatures"`
+}
+
+// PageFrameResource https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameResource
+type PageFrameResource struct {
+	URL          string                `json:"url"`
+	Type         NetworkResourceType   `json:"type"`
+	MimeType     string                `json:"mimeType"`
+	LastModified NetworkTimeSinceEpoch `json:"lastModified,omitempty"`
+	ContentSize  float64               `json:"contentSize,omitempty"`
+	Failed       bool                  `json:"failed,omitempty"`
+	Canceled     bool                  `json:"canceled,omitempty"`
+}
+
+// PageFrameResourceTree https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameResourceTree
+type PageFrameResourceTree struct {
+	Frame       *PageFrame               `json:"frame"`
+	ChildFrames []*PageFrameResourceTree `json:"childFrames,omitempty"`
+	Resources   []*PageFrameResource     `json:"resources"`
+}
+
+// PageFrameTree https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FrameTree
+type PageFrameTree struct {
+	Frame       *PageFrame       `json:"frame"`
+	ChildFrames []*PageFrameTree `json:"childFrames,omitempty"`
+}
+
+// PageScriptIdentifier https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScriptIdentifier
+type PageScriptIdentifier string
+
+// PageTransitionType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-TransitionType
+type PageTransitionType string
+
+// PageTransitionType values
+const (
+	PageTransitionTypeLink             PageTransitionType = "link"
+	PageTransitionTypeTyped            PageTransitionType = "typed"
+	PageTransitionTypeAddressBar       PageTransitionType = "address_bar"
+	PageTransitionTypeAutoBookmark     PageTransitionType = "auto_bookmark"
+	PageTransitionTypeAutoSubframe     PageTransitionType = "auto_subframe"
+	PageTransitionTypeManualSubframe   PageTransitionType = "manual_subframe"
+	PageTransitionTypeGenerated        PageTransitionType = "generated"
+	PageTransitionTypeAutoToplevel     PageTransitionType = "auto_toplevel"
+	PageTransitionTypeFormSubmit       PageTransitionType = "form_submit"
+	PageTransitionTypeReload           PageTransitionType = "reload"
+	PageTransitionTypeKeyword          PageTransitionType = "keyword"
+	PageTransitionTypeKeywordGenerated PageTransitionType = "keyword_generated"
+	PageTransitionTypeOther            PageTransitionType = "other"
+)
+
+// PageNavigationEntry https://chromedevtools.github.io/devtools-protocol/tot/Page#type-NavigationEntry
+type PageNavigationEntry struct {
+	ID             int64              `json:"id"`
+	URL            string             `json:"url"`
+	UserTypedURL   string             `json:"userTypedURL"`
+	Title          string             `json:"title"`
+	TransitionType PageTransitionType `json:"transitionType"`
+}
+
+// PageScreencastFrameMetadata https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScreencastFrameMetadata
+type PageScreencastFrameMetadata struct {
+	OffsetTop       float64               `json:"offsetTop"`
+	PageScaleFactor float64               `json:"pageScaleFactor"`
+	DeviceWidth     float64               `json:"deviceWidth"`
+	DeviceHeight    float64               `json:"deviceHeight"`
+	ScrollOffsetX   float64               `json:"scrollOffsetX"`
+	ScrollOffsetY   float64               `json:"scrollOffsetY"`
+	Timestamp       NetworkTimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// PageDialogType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-DialogType
+type PageDialogType string
+
+// PageDialogType values
+const (
+	PageDialogTypeAlert        PageDialogType = "alert"
+	PageDialogTypeConfirm      PageDialogType = "confirm"
+	PageDialogTypePrompt       PageDialogType = "prompt"
+	PageDialogTypeBeforeunload PageDialogType = "beforeunload"
+)
+
+// PageAppManifestError https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AppManifestError
+type PageAppManifestError struct {
+	Message  string `json:"message"`
+	Critical int64  `json:"critical"`
+	Line     int64  `json:"line"`
+	Column   int64  `json:"column"`
+}
+
+// PageAppManifestParsedProperties https://chromedevtools.github.io/devtools-protocol/tot/Page#type-AppManifestParsedProperties
+type PageAppManifestParsedProperties struct {
+	Scope string `json:"scope"`
+}
+
+// PageLayoutViewport https://chromedevtools.github.io/devtools-protocol/tot/Page#type-LayoutViewport
+type PageLayoutViewport struct {
+	PageX        int64 `json:"pageX"`
+	PageY        int64 `json:"pageY"`
+	ClientWidth  int64 `json:"clientWidth"`
+	ClientHeight int64 `json:"clientHeight"`
+}
+
+// PageVisualViewport https://chromedevtools.github.io/devtools-protocol/tot/Page#type-VisualViewport
+type PageVisualViewport struct {
+	OffsetX      float64 `json:"offsetX"`
+	OffsetY      float64 `json:"offsetY"`
+	PageX        float64 `json:"pageX"`
+	PageY        float64 `json:"pageY"`
+	ClientWidth  float64 `json:"clientWidth"`
+	ClientHeight float64 `json:"clientHeight"`
+	Scale        float64 `json:"scale"`
+	Zoom         float64 `json:"zoom,omitempty"`
+}
+
+// PageViewport https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Viewport
+type PageViewport struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Scale  float64 `json:"scale"`
+}
+
+// PageFontFamilies https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FontFamilies
+type PageFontFamilies struct {
+	Standard  string `json:"standard,omitempty"`
+	Fixed     string `json:"fixed,omitempty"`
+	Serif     string `json:"serif,omitempty"`
+	SansSerif string `json:"sansSerif,omitempty"`
+	Cursive   string `json:"cursive,omitempty"`
+	Fantasy   string `json:"fantasy,omitempty"`
+	Math      string `json:"math,omitempty"`
+}
+
+// PageScriptFontFamilies https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScriptFontFamilies
+type PageScriptFontFamilies struct {
+	Script       string            `json:"script"`
+	FontFamilies *PageFontFamilies `json:"fontFamilies"`
+}
+
+// PageFontSizes https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FontSizes
+type PageFontSizes struct {
+	Standard int64 `json:"standard,omitempty"`
+	Fixed    int64 `json:"fixed,omitempty"`
+}
+
+// PageClientNavigationReason https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ClientNavigationReason
+type PageClientNavigationReason string
+
+// PageClientNavigationReason values
+const (
+	PageClientNavigationReasonAnchorClick            PageClientNavigationReason = "anchorClick"
+	PageClientNavigationReasonFormSubmissionGet      PageClientNavigationReason = "formSubmissionGet"
+	PageClientNavigationReasonFormSubmissionPost     PageClientNavigationReason = "formSubmissionPost"
+	PageClientNavigationReasonHTTPHeaderRefresh      PageClientNavigationReason = "httpHeaderRefresh"
+	PageClientNavigationReasonInitialFrameNavigation PageClientNavigationReason = "initialFrameNavigation"
+	PageClientNavigationReasonMetaTagRefresh         PageClientNavigationReason = "metaTagRefresh"
+	PageClientNavigationReasonOther                  PageClientNavigationReason = "other"
+	PageClientNavigationReasonPageBlockInterstitial  PageClientNavigationReason = "pageBlockInterstitial"
+	PageClientNavigationReasonReload                 PageClientNavigationReason = "reload"
+	PageClientNavigationReasonScriptInitiated        PageClientNavigationReason = "scriptInitiated"
+)
+
+// PageClientNavigationDisposition https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ClientNavigationDisposition
+type PageClientNavigationDisposition string
+
+// PageClientNavigationDisposition values
+const (
+	PageClientNavigationDispositionCurrentTab PageClientNavigationDisposition = "currentTab"
+	PageClientNavigationDispositionNewTab     PageClientNavigationDisposition = "newTab"
+	PageClientNavigationDispositionNewWindow  PageClientNavigationDisposition = "newWindow"
+	PageClientNavigationDispositionDownload   PageClientNavigationDisposition = "download"
+)
+
+// PageInstallabilityErrorArgument https://chromedevtools.github.io/devtools-protocol/tot/Page#type-InstallabilityErrorArgument
+type PageInstallabilityErrorArgument struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PageInstallabilityError https://chromedevtools.github.io/devtools-protocol/tot/Page#type-InstallabilityError
+type PageInstallabilityError struct {
+	ErrorID        string                             `json:"errorId"`
+	ErrorArguments []*PageInstallabilityErrorArgument `json:"errorArguments"`
+}
+
+// PageReferrerPolicy https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ReferrerPolicy
+type PageReferrerPolicy string
+
+// PageReferrerPolicy values
+const (
+	PageReferrerPolicyNoReferrer                  PageReferrerPolicy = "noReferrer"
+	PageReferrerPolicyNoReferrerWhenDowngrade     PageReferrerPolicy = "noReferrerWhenDowngrade"
+	PageReferrerPolicyOrigin                      PageReferrerPolicy = "origin"
+	PageReferrerPolicyOriginWhenCrossOrigin       PageReferrerPolicy = "originWhenCrossOrigin"
+	PageReferrerPolicySameOrigin                  PageReferrerPolicy = "sameOrigin"
+	PageReferrerPolicyStrictOrigin                PageReferrerPolicy = "strictOrigin"
+	PageReferrerPolicyStrictOriginWhenCrossOrigin PageReferrerPolicy = "strictOriginWhenCrossOrigin"
+	PageReferrerPolicyUnsafeURL                   PageReferrerPolicy = "unsafeUrl"
+)
+
+// PageCompilationCacheParams https://chromedevtools.github.io/devtools-protocol/tot/Page#type-CompilationCacheParams
+type PageCompilationCacheParams struct {
+	URL   string `json:"url"`
+	Eager bool   `json:"eager,omitempty"`
+}
+
+// PageFileFilter https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FileFilter
+type PageFileFilter struct {
+	Name    string   `json:"name,omitempty"`
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+// PageFileHandler https://chromedevtools.github.io/devtools-protocol/tot/Page#type-FileHandler
+type PageFileHandler struct {
+	Action     string               `json:"action"`
+	Name       string               `json:"name"`
+	Icons      []*PageImageResource `json:"icons,omitempty"`
+	Accepts    []*PageFileFilter    `json:"accepts,omitempty"`
+	LaunchType string               `json:"launchType"`
+}
+
+// PageImageResource https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ImageResource
+type PageImageResource struct {
+	URL   string `json:"url"`
+	Sizes string `json:"sizes,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// PageLaunchHandler https://chromedevtools.github.io/devtools-protocol/tot/Page#type-LaunchHandler
+type PageLaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+// PageProtocolHandler https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ProtocolHandler
+type PageProtocolHandler struct {
+	Protocol string `json:"protocol"`
+	URL      string `json:"url"`
+}
+
+// PageRelatedApplication https://chromedevtools.github.io/devtools-protocol/tot/Page#type-RelatedApplication
+type PageRelatedApplication struct {
+	ID  string `json:"id,omitempty"`
+	URL string `json:"url"`
+}
+
+// PageScopeExtension https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ScopeExtension
+type PageScopeExtension struct {
+	Origin            string `json:"origin"`
+	HasOriginWildcard bool   `json:"hasOriginWildcard"`
+}
+
+// PageScreenshot https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Screenshot
+type PageScreenshot struct {
+	Image      *PageImageResource `json:"image"`
+	FormFactor string             `json:"formFactor"`
+	Label      string             `json:"label,omitempty"`
+}
+
+// PageShareTarget https://chromedevtools.github.io/devtools-protocol/tot/Page#type-ShareTarget
+type PageShareTarget struct {
+	Action  string            `json:"action"`
+	Method  string            `json:"method"`
+	Enctype string            `json:"enctype"`
+	Title   string            `json:"title,omitempty"`
+	Text    string            `json:"text,omitempty"`
+	URL     string            `json:"url,omitempty"`
+	Files   []*PageFileFilter `json:"files,omitempty"`
+}
+
+// PageShortcut https://chromedevtools.github.io/devtools-protocol/tot/Page#type-Shortcut
+type PageShortcut struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// PageWebAppManifest https://chromedevtools.github.io/devtools-protocol/tot/Page#type-WebAppManifest
+type PageWebAppManifest struct {
+	BackgroundColor           string                    `json:"backgroundColor,omitempty"`
+	Description               string                    `json:"description,omitempty"`
+	Dir                       string                    `json:"dir,omitempty"`
+	Display                   string                    `json:"display,omitempty"`
+	DisplayOverrides          []string                  `json:"displayOverrides,omitempty"`
+	FileHandlers              []*PageFileHandler        `json:"fileHandlers,omitempty"`
+	Icons                     []*PageImageResource      `json:"icons,omitempty"`
+	ID                        string                    `json:"id,omitempty"`
+	Lang                      string                    `json:"lang,omitempty"`
+	LaunchHandler             *PageLaunchHandler        `json:"launchHandler,omitempty"`
+	Name                      string                    `json:"name,omitempty"`
+	Orientation               string                    `json:"orientation,omitempty"`
+	PreferRelatedApplications bool                      `json:"preferRelatedApplications,omitempty"`
+	ProtocolHandlers          []*PageProtocolHandler    `json:"protocolHandlers,omitempty"`
+	RelatedApplications       []*PageRelatedApplication `json:"relatedApplications,omitempty"`
+	Scope                     string                    `json:"scope,omitempty"`
+	ScopeExtensions           []*PageScopeExtension     `json:"scopeExtensions,omitempty"`
+	Screenshots               []*PageScreenshot         `json:"screenshots,omitempty"`
+	ShareTarget               *PageShareTarget          `json:"shareTarget,omitempty"`
+	ShortName                 string                    `json:"shortName,omitempty"`
+	Shortcuts                 []*PageShortcut           `json:"shortcuts,omitempty"`
+	StartURL                  string                    `json:"startUrl,omitempty"`
+	ThemeColor                string                    `json:"themeColor,omitempty"`
+}
+
+// PageNavigationType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-NavigationType
+type PageNavigationType string
+
+// PageNavigationType values
+const (
+	PageNavigationTypeNavigation              PageNavigationType = "Navigation"
+	PageNavigationTypeBackForwardCacheRestore PageNavigationType = "BackForwardCacheRestore"
+)
+
+// PageBackForwardCacheNotRestoredReason https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredReason
+type PageBackForwardCacheNotRestoredReason string
+
+// PageBackForwardCacheNotRestoredReason values
+const (
+	PageBackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      PageBackForwardCacheNotRestoredReason = "NotPrimaryMainFrame"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabled"
+	PageBackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               PageBackForwardCacheNotRestoredReason = "RelatedActiveContentsExist"
+	PageBackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          PageBackForwardCacheNotRestoredReason = "HTTPStatusNotOK"
+	PageBackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     PageBackForwardCacheNotRestoredReason = "SchemeNotHTTPOrHTTPS"
+	PageBackForwardCacheNotRestoredReasonLoading                                                  PageBackForwardCacheNotRestoredReason = "Loading"
+	PageBackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    PageBackForwardCacheNotRestoredReason = "WasGrantedMediaAccess"
+	PageBackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          PageBackForwardCacheNotRestoredReason = "DisableForRenderFrameHostCalled"
+	PageBackForwardCacheNotRestoredReasonDomainNotAllowed                                         PageBackForwardCacheNotRestoredReason = "DomainNotAllowed"
+	PageBackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         PageBackForwardCacheNotRestoredReason = "HTTPMethodNotGET"
+	PageBackForwardCacheNotRestoredReasonSubframeIsNavigating                                     PageBackForwardCacheNotRestoredReason = "SubframeIsNavigating"
+	PageBackForwardCacheNotRestoredReasonTimeout                                                  PageBackForwardCacheNotRestoredReason = "Timeout"
+	PageBackForwardCacheNotRestoredReasonCacheLimit                                               PageBackForwardCacheNotRestoredReason = "CacheLimit"
+	PageBackForwardCacheNotRestoredReasonJavaScriptExecution                                      PageBackForwardCacheNotRestoredReason = "JavaScriptExecution"
+	PageBackForwardCacheNotRestoredReasonRendererProcessKilled                                    PageBackForwardCacheNotRestoredReason = "RendererProcessKilled"
+	PageBackForwardCacheNotRestoredReasonRendererProcessCrashed                                   PageBackForwardCacheNotRestoredReason = "RendererProcessCrashed"
+	PageBackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              PageBackForwardCacheNotRestoredReason = "SchedulerTrackedFeatureUsed"
+	PageBackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              PageBackForwardCacheNotRestoredReason = "ConflictingBrowsingInstance"
+	PageBackForwardCacheNotRestoredReasonCacheFlushed                                             PageBackForwardCacheNotRestoredReason = "CacheFlushed"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           PageBackForwardCacheNotRestoredReason = "ServiceWorkerVersionActivation"
+	PageBackForwardCacheNotRestoredReasonSessionRestored                                          PageBackForwardCacheNotRestoredReason = "SessionRestored"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 PageBackForwardCacheNotRestoredReason = "ServiceWorkerPostMessage"
+	PageBackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      PageBackForwardCacheNotRestoredReason = "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+	PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            PageBackForwardCacheNotRestoredReason = "RenderFrameHostReused_SameSite"
+	PageBackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           PageBackForwardCacheNotRestoredReason = "RenderFrameHostReused_CrossSite"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerClaim                                       PageBackForwardCacheNotRestoredReason = "ServiceWorkerClaim"
+	PageBackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      PageBackForwardCacheNotRestoredReason = "IgnoreEventAndEvict"
+	PageBackForwardCacheNotRestoredReasonHaveInnerContents                                        PageBackForwardCacheNotRestoredReason = "HaveInnerContents"
+	PageBackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    PageBackForwardCacheNotRestoredReason = "TimeoutPuttingInCache"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByLowMemory"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByCommandLine"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             PageBackForwardCacheNotRestoredReason = "NetworkRequestDatapipeDrainedAsBytesConsumer"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 PageBackForwardCacheNotRestoredReason = "NetworkRequestRedirected"
+	PageBackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    PageBackForwardCacheNotRestoredReason = "NetworkRequestTimeout"
+	PageBackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                PageBackForwardCacheNotRestoredReason = "NetworkExceedsBufferLimit"
+	PageBackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        PageBackForwardCacheNotRestoredReason = "NavigationCancelledWhileRestoring"
+	PageBackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             PageBackForwardCacheNotRestoredReason = "NotMostRecentNavigationEntry"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForPrerender"
+	PageBackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 PageBackForwardCacheNotRestoredReason = "UserAgentOverrideDiffers"
+	PageBackForwardCacheNotRestoredReasonForegroundCacheLimit                                     PageBackForwardCacheNotRestoredReason = "ForegroundCacheLimit"
+	PageBackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               PageBackForwardCacheNotRestoredReason = "BrowsingInstanceNotSwapped"
+	PageBackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      PageBackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForDelegate"
+	PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           PageBackForwardCacheNotRestoredReason = "UnloadHandlerExistsInMainFrame"
+	PageBackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            PageBackForwardCacheNotRestoredReason = "UnloadHandlerExistsInSubFrame"
+	PageBackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              PageBackForwardCacheNotRestoredReason = "ServiceWorkerUnregistration"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStore                                      PageBackForwardCacheNotRestoredReason = "CacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreCookieModified"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreHTTPOnlyCookieModified"
+	PageBackForwardCacheNotRestoredReasonNoResponseHead                                           PageBackForwardCacheNotRestoredReason = "NoResponseHead"
+	PageBackForwardCacheNotRestoredReasonUnknown                                                  PageBackForwardCacheNotRestoredReason = "Unknown"
+	PageBackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             PageBackForwardCacheNotRestoredReason = "ActivationNavigationsDisallowedForBug1234857"
+	PageBackForwardCacheNotRestoredReasonErrorDocument                                            PageBackForwardCacheNotRestoredReason = "ErrorDocument"
+	PageBackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     PageBackForwardCacheNotRestoredReason = "FencedFramesEmbedder"
+	PageBackForwardCacheNotRestoredReasonCookieDisabled                                           PageBackForwardCacheNotRestoredReason = "CookieDisabled"
+	PageBackForwardCacheNotRestoredReasonHTTPAuthRequired                                         PageBackForwardCacheNotRestoredReason = "HTTPAuthRequired"
+	PageBackForwardCacheNotRestoredReasonCookieFlushed                                            PageBackForwardCacheNotRestoredReason = "CookieFlushed"
+	PageBackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                PageBackForwardCacheNotRestoredReason = "BroadcastChannelOnMessage"
+	PageBackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   PageBackForwardCacheNotRestoredReason = "WebViewSettingsChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           PageBackForwardCacheNotRestoredReason = "WebViewJavaScriptObjectChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           PageBackForwardCacheNotRestoredReason = "WebViewMessageListenerInjected"
+	PageBackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      PageBackForwardCacheNotRestoredReason = "WebViewSafeBrowsingAllowlistChanged"
+	PageBackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    PageBackForwardCacheNotRestoredReason = "WebViewDocumentStartJavascriptChanged"
+	PageBackForwardCacheNotRestoredReasonWebSocket                                                PageBackForwardCacheNotRestoredReason = "WebSocket"
+	PageBackForwardCacheNotRestoredReasonWebTransport                                             PageBackForwardCacheNotRestoredReason = "WebTransport"
+	PageBackForwardCacheNotRestoredReasonWebRTC                                                   PageBackForwardCacheNotRestoredReason = "WebRTC"
+	PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       PageBackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       PageBackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoCache"
+	PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        PageBackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoStore"
+	PageBackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        PageBackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoCache"
+	PageBackForwardCacheNotRestoredReasonContainsPlugins                                          PageBackForwardCacheNotRestoredReason = "ContainsPlugins"
+	PageBackForwardCacheNotRestoredReasonDocumentLoaded                                           PageBackForwardCacheNotRestoredReason = "DocumentLoaded"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestOthers"
+	PageBackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  PageBackForwardCacheNotRestoredReason = "RequestedMIDIPermission"
+	PageBackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          PageBackForwardCacheNotRestoredReason = "RequestedAudioCapturePermission"
+	PageBackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          PageBackForwardCacheNotRestoredReason = "RequestedVideoCapturePermission"
+	PageBackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  PageBackForwardCacheNotRestoredReason = "RequestedBackForwardCacheBlockedSensors"
+	PageBackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        PageBackForwardCacheNotRestoredReason = "RequestedBackgroundWorkPermission"
+	PageBackForwardCacheNotRestoredReasonBroadcastChannel                                         PageBackForwardCacheNotRestoredReason = "BroadcastChannel"
+	PageBackForwardCacheNotRestoredReasonWebXR                                                    PageBackForwardCacheNotRestoredReason = "WebXR"
+	PageBackForwardCacheNotRestoredReasonSharedWorker                                             PageBackForwardCacheNotRestoredReason = "SharedWorker"
+	PageBackForwardCacheNotRestoredReasonSharedWorkerMessage                                      PageBackForwardCacheNotRestoredReason = "SharedWorkerMessage"
+	PageBackForwardCacheNotRestoredReasonWebLocks                                                 PageBackForwardCacheNotRestoredReason = "WebLocks"
+	PageBackForwardCacheNotRestoredReasonWebHID                                                   PageBackForwardCacheNotRestoredReason = "WebHID"
+	PageBackForwardCacheNotRestoredReasonWebShare                                                 PageBackForwardCacheNotRestoredReason = "WebShare"
+	PageBackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              PageBackForwardCacheNotRestoredReason = "RequestedStorageAccessGrant"
+	PageBackForwardCacheNotRestoredReasonWebNfc                                                   PageBackForwardCacheNotRestoredReason = "WebNfc"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestFetch"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestXHR"
+	PageBackForwardCacheNotRestoredReasonAppBanner                                                PageBackForwardCacheNotRestoredReason = "AppBanner"
+	PageBackForwardCacheNotRestoredReasonPrinting                                                 PageBackForwardCacheNotRestoredReason = "Printing"
+	PageBackForwardCacheNotRestoredReasonWebDatabase                                              PageBackForwardCacheNotRestoredReason = "WebDatabase"
+	PageBackForwardCacheNotRestoredReasonPictureInPicture                                         PageBackForwardCacheNotRestoredReason = "PictureInPicture"
+	PageBackForwardCacheNotRestoredReasonSpeechRecognizer                                         PageBackForwardCacheNotRestoredReason = "SpeechRecognizer"
+	PageBackForwardCacheNotRestoredReasonIdleManager                                              PageBackForwardCacheNotRestoredReason = "IdleManager"
+	PageBackForwardCacheNotRestoredReasonPaymentManager                                           PageBackForwardCacheNotRestoredReason = "PaymentManager"
+	PageBackForwardCacheNotRestoredReasonSpeechSynthesis                                          PageBackForwardCacheNotRestoredReason = "SpeechSynthesis"
+	PageBackForwardCacheNotRestoredReasonKeyboardLock                                             PageBackForwardCacheNotRestoredReason = "KeyboardLock"
+	PageBackForwardCacheNotRestoredReasonWebOTPService                                            PageBackForwardCacheNotRestoredReason = "WebOTPService"
+	PageBackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    PageBackForwardCacheNotRestoredReason = "OutstandingNetworkRequestDirectSocket"
+	PageBackForwardCacheNotRestoredReasonInjectedJavascript                                       PageBackForwardCacheNotRestoredReason = "InjectedJavascript"
+	PageBackForwardCacheNotRestoredReasonInjectedStyleSheet                                       PageBackForwardCacheNotRestoredReason = "InjectedStyleSheet"
+	PageBackForwardCacheNotRestoredReasonKeepaliveRequest                                         PageBackForwardCacheNotRestoredReason = "KeepaliveRequest"
+	PageBackForwardCacheNotRestoredReasonIndexedDBEvent                                           PageBackForwardCacheNotRestoredReason = "IndexedDBEvent"
+	PageBackForwardCacheNotRestoredReasonDummy                                                    PageBackForwardCacheNotRestoredReason = "Dummy"
+	PageBackForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource      PageBackForwardCacheNotRestoredReason = "JsNetworkRequestReceivedCacheControlNoStoreResource"
+	PageBackForwardCacheNotRestoredReasonWebRTCSticky                                             PageBackForwardCacheNotRestoredReason = "WebRTCSticky"
+	PageBackForwardCacheNotRestoredReasonWebTransportSticky                                       PageBackForwardCacheNotRestoredReason = "WebTransportSticky"
+	PageBackForwardCacheNotRestoredReasonWebSocketSticky                                          PageBackForwardCacheNotRestoredReason = "WebSocketSticky"
+	PageBackForwardCacheNotRestoredReasonSmartCard                                                PageBackForwardCacheNotRestoredReason = "SmartCard"
+	PageBackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     PageBackForwardCacheNotRestoredReason = "LiveMediaStreamTrack"
+	PageBackForwardCacheNotRestoredReasonUnloadHandler                                            PageBackForwardCacheNotRestoredReason = "UnloadHandler"
+	PageBackForwardCacheNotRestoredReasonParserAborted                                            PageBackForwardCacheNotRestoredReason = "ParserAborted"
+	PageBackForwardCacheNotRestoredReasonContentSecurityHandler                                   PageBackForwardCacheNotRestoredReason = "ContentSecurityHandler"
+	PageBackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              PageBackForwardCacheNotRestoredReason = "ContentWebAuthenticationAPI"
+	PageBackForwardCacheNotRestoredReasonContentFileChooser                                       PageBackForwardCacheNotRestoredReason = "ContentFileChooser"
+	PageBackForwardCacheNotRestoredReasonContentSerial                                            PageBackForwardCacheNotRestoredReason = "ContentSerial"
+	PageBackForwardCacheNotRestoredReasonContentFileSystemAccess                                  PageBackForwardCacheNotRestoredReason = "ContentFileSystemAccess"
+	PageBackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        PageBackForwardCacheNotRestoredReason = "ContentMediaDevicesDispatcherHost"
+	PageBackForwardCacheNotRestoredReasonContentWebBluetooth                                      PageBackForwardCacheNotRestoredReason = "ContentWebBluetooth"
+	PageBackForwardCacheNotRestoredReasonContentWebUSB                                            PageBackForwardCacheNotRestoredReason = "ContentWebUSB"
+	PageBackForwardCacheNotRestoredReasonContentMediaSessionService                               PageBackForwardCacheNotRestoredReason = "ContentMediaSessionService"
+	PageBackForwardCacheNotRestoredReasonContentScreenReader                                      PageBackForwardCacheNotRestoredReason = "ContentScreenReader"
+	PageBackForwardCacheNotRestoredReasonContentDiscarded                                         PageBackForwardCacheNotRestoredReason = "ContentDiscarded"
+	PageBackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            PageBackForwardCacheNotRestoredReason = "EmbedderPopupBlockerTabHelper"
+	PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                PageBackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingTriggeredPopupBlocker"
+	PageBackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        PageBackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingThreatDetails"
+	PageBackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 PageBackForwardCacheNotRestoredReason = "EmbedderAppBannerManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource                         PageBackForwardCacheNotRestoredReason = "EmbedderDomDistillerViewerSource"
+	PageBackForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate          PageBackForwardCacheNotRestoredReason = "EmbedderDomDistillerSelfDeletingRequestDelegate"
+	PageBackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         PageBackForwardCacheNotRestoredReason = "EmbedderOomInterventionTabHelper"
+	PageBackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      PageBackForwardCacheNotRestoredReason = "EmbedderOfflinePage"
+	PageBackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager PageBackForwardCacheNotRestoredReason = "EmbedderChromePasswordManagerClientBindCredentialManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         PageBackForwardCacheNotRestoredReason = "EmbedderPermissionRequestManager"
+	PageBackForwardCacheNotRestoredReasonEmbedderModalDialog                                      PageBackForwardCacheNotRestoredReason = "EmbedderModalDialog"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensions                                       PageBackForwardCacheNotRestoredReason = "EmbedderExtensions"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               PageBackForwardCacheNotRestoredReason = "EmbedderExtensionMessaging"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    PageBackForwardCacheNotRestoredReason = "EmbedderExtensionMessagingForOpenPort"
+	PageBackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                PageBackForwardCacheNotRestoredReason = "EmbedderExtensionSentMessageToCachedFrame"
+	PageBackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 PageBackForwardCacheNotRestoredReason = "RequestedByWebViewClient"
+	PageBackForwardCacheNotRestoredReasonPostMessageByWebViewClient                               PageBackForwardCacheNotRestoredReason = "PostMessageByWebViewClient"
+	PageBackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated          PageBackForwardCacheNotRestoredReason = "CacheControlNoStoreDeviceBoundSessionTerminated"
+	PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure                 PageBackForwardCacheNotRestoredReason = "CacheLimitPrunedOnModerateMemoryPressure"
+	PageBackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure                 PageBackForwardCacheNotRestoredReason = "CacheLimitPrunedOnCriticalMemoryPressure"
+)
+
+// PageBackForwardCacheNotRestoredReasonType https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredReasonType
+type PageBackForwardCacheNotRestoredReasonType string
+
+// PageBackForwardCacheNotRestoredReasonType values
+const (
+	PageBackForwardCacheNotRestoredReasonTypeSupportPending    PageBackForwardCacheNotRestoredReasonType = "SupportPending"
+	PageBackForwardCacheNotRestoredReasonTypePageSupportNeeded PageBackForwardCacheNotRestoredReasonType = "PageSupportNeeded"
+	PageBackForwardCacheNotRestoredReasonTypeCircumstantial    PageBackForwardCacheNotRestoredReasonType = "Circumstantial"
+)
+
+// PageBackForwardCacheBlockingDetails https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheBlockingDetails
+type PageBackForwardCacheBlockingDetails struct {
+	URL          string `json:"url,omitempty"`
+	Function     string `json:"function,omitempty"`
+	LineNumber   int64  `json:"lineNumber"`
+	ColumnNumber int64  `json:"columnNumber"`
+}
+
+// PageBackForwardCacheNotRestoredExplanation https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredExplanation
+type PageBackForwardCacheNotRestoredExplanation struct {
+	Type    PageBackForwardCacheNotRestoredReasonType `json:"type"`
+	Reason  PageBackForwardCacheNotRestoredReason     `json:"reason"`
+	Context string                                    `json:"context,omitempty"`
+	Details []*PageBackForwardCacheBlockingDetails    `json:"details,omitempty"`
+}
+
+// PageBackForwardCacheNotRestoredExplanationTree https://chromedevtools.github.io/devtools-protocol/tot/Page#type-BackForwardCacheNotRestoredExplanationTree
+type PageBackForwardCacheNotRestoredExplanationTree struct {
+	URL          string                                            `json:"url"`
+	Explanations []*PageBackForwardCacheNotRestoredExplanation     `json:"explanations"`
+	Children     []*PageBackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
+
+// PageAddScriptToEvaluateOnLoadParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnLoad
+//
+// Deprecated: deprecated in protocol
+type PageAddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+}
+
+// ProtocolMethod returns Page.addScriptToEvaluateOnLoad
+func (*PageAddScriptToEvaluateOnLoadParams) ProtocolMethod() string {
+	return "Page.addScriptToEvaluateOnLoad"
+}
+
+// PageAddScriptToEvaluateOnLoadResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnLoad
+//
+// Deprecated: deprecated in protocol
+type PageAddScriptToEvaluateOnLoadResult struct {
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// Do sends Page.addScriptToEvaluateOnLoad and returns its result
+func (p *PageAddScriptToEvaluateOnLoadParams) Do(e Executor) (*PageAddScriptToEvaluateOnLoadResult, error) {
+	var result = new(PageAddScriptToEvaluateOnLoadResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageAddScriptToEvaluateOnNewDocumentParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnNewDocument
+type PageAddScriptToEvaluateOnNewDocumentParams struct {
+	Source                string `json:"source"`
+	WorldName             string `json:"worldName,omitempty"`
+	IncludeCommandLineAPI bool   `json:"includeCommandLineAPI,omitempty"`
+	RunImmediately        bool   `json:"runImmediately,omitempty"`
+}
+
+// ProtocolMethod returns Page.addScriptToEvaluateOnNewDocument
+func (*PageAddScriptToEvaluateOnNewDocumentParams) ProtocolMethod() string {
+	return "Page.addScriptToEvaluateOnNewDocument"
+}
+
+// PageAddScriptToEvaluateOnNewDocumentResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addScriptToEvaluateOnNewDocument
+type PageAddScriptToEvaluateOnNewDocumentResult struct {
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// Do sends Page.addScriptToEvaluateOnNewDocument and returns its result
+func (p *PageAddScriptToEvaluateOnNewDocumentParams) Do(e Executor) (*PageAddScriptToEvaluateOnNewDocumentResult, error) {
+	var result = new(PageAddScriptToEvaluateOnNewDocumentResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageBringToFrontParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-bringToFront
+type PageBringToFrontParams struct {
+}
+
+// ProtocolMethod returns Page.bringToFront
+func (*PageBringToFrontParams) ProtocolMethod() string {
+	return "Page.bringToFront"
+}
+
+// Do sends Page.bringToFront
+func (p *PageBringToFrontParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageCaptureScreenshotParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-captureScreenshot
+type PageCaptureScreenshotParams struct {
+	Format                PageCaptureScreenshotParamsFormat `json:"format,omitempty"`
+	Quality               int64                             `json:"quality,omitempty"`
+	Clip                  *PageViewport                     `json:"clip,omitempty"`
+	FromSurface           bool                              `json:"fromSurface,omitempty"`
+	CaptureBeyondViewport bool                              `json:"captureBeyondViewport,omitempty"`
+	OptimizeForSpeed      bool                              `json:"optimizeForSpeed,omitempty"`
+}
+
+// ProtocolMethod returns Page.captureScreenshot
+func (*PageCaptureScreenshotParams) ProtocolMethod() string {
+	return "Page.captureScreenshot"
+}
+
+// PageCaptureScreenshotResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-captureScreenshot
+type PageCaptureScreenshotResult struct {
+	Data string `json:"data"`
+}
+
+// Do sends Page.captureScreenshot and returns its result
+func (p *PageCaptureScreenshotParams) Do(e Executor) (*PageCaptureScreenshotResult, error) {
+	var result = new(PageCaptureScreenshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageCaptureSnapshotParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-captureSnapshot
+type PageCaptureSnapshotParams struct {
+	Format PageCaptureSnapshotParamsFormat `json:"format,omitempty"`
+}
+
+// ProtocolMethod returns Page.captureSnapshot
+func (*PageCaptureSnapshotParams) ProtocolMethod() string {
+	return "Page.captureSnapshot"
+}
+
+// PageCaptureSnapshotResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-captureSnapshot
+type PageCaptureSnapshotResult struct {
+	Data string `json:"data"`
+}
+
+// Do sends Page.captureSnapshot and returns its result
+func (p *PageCaptureSnapshotParams) Do(e Executor) (*PageCaptureSnapshotResult, error) {
+	var result = new(PageCaptureSnapshotResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageCreateIsolatedWorldParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-createIsolatedWorld
+type PageCreateIsolatedWorldParams struct {
+	FrameID             PageFrameID `json:"frameId"`
+	WorldName           string      `json:"worldName,omitempty"`
+	GrantUniveralAccess bool        `json:"grantUniveralAccess,omitempty"`
+}
+
+// ProtocolMethod returns Page.createIsolatedWorld
+func (*PageCreateIsolatedWorldParams) ProtocolMethod() string {
+	return "Page.createIsolatedWorld"
+}
+
+// PageCreateIsolatedWorldResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-createIsolatedWorld
+type PageCreateIsolatedWorldResult struct {
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+}
+
+// Do sends Page.createIsolatedWorld and returns its result
+func (p *PageCreateIsolatedWorldParams) Do(e Executor) (*PageCreateIsolatedWorldResult, error) {
+	var result = new(PageCreateIsolatedWorldResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-disable
+type PageDisableParams struct {
+}
+
+// ProtocolMethod returns Page.disable
+func (*PageDisableParams) ProtocolMethod() string {
+	return "Page.disable"
+}
+
+// Do sends Page.disable
+func (p *PageDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-enable
+type PageEnableParams struct {
+	EnableFileChooserOpenedEvent bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
+// ProtocolMethod returns Page.enable
+func (*PageEnableParams) ProtocolMethod() string {
+	return "Page.enable"
+}
+
+// Do sends Page.enable
+func (p *PageEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageGetAppManifestParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAppManifest
+type PageGetAppManifestParams struct {
+	ManifestID string `json:"manifestId,omitempty"`
+}
+
+// ProtocolMethod returns Page.getAppManifest
+func (*PageGetAppManifestParams) ProtocolMethod() string {
+	return "Page.getAppManifest"
+}
+
+// PageGetAppManifestResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAppManifest
+type PageGetAppManifestResult struct {
+	URL    string                  `json:"url"`
+	Errors []*PageAppManifestError `json:"errors"`
+	Data   string                  `json:"data,omitempty"`
+	// Deprecated: deprecated in protocol
+	Parsed   *PageAppManifestParsedProperties `json:"parsed,omitempty"`
+	Manifest *PageWebAppManifest              `json:"manifest"`
+}
+
+// Do sends Page.getAppManifest and returns its result
+func (p *PageGetAppManifestParams) Do(e Executor) (*PageGetAppManifestResult, error) {
+	var result = new(PageGetAppManifestResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetInstallabilityErrorsParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getInstallabilityErrors
+type PageGetInstallabilityErrorsParams struct {
+}
+
+// ProtocolMethod returns Page.getInstallabilityErrors
+func (*PageGetInstallabilityErrorsParams) ProtocolMethod() string {
+	return "Page.getInstallabilityErrors"
+}
+
+// PageGetInstallabilityErrorsResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getInstallabilityErrors
+type PageGetInstallabilityErrorsResult struct {
+	InstallabilityErrors []*PageInstallabilityError `json:"installabilityErrors"`
+}
+
+// Do sends Page.getInstallabilityErrors and returns its result
+func (p *PageGetInstallabilityErrorsParams) Do(e Executor) (*PageGetInstallabilityErrorsResult, error) {
+	var result = new(PageGetInstallabilityErrorsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetManifestIconsParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getManifestIcons
+//
+// Deprecated: deprecated in protocol
+type PageGetManifestIconsParams struct {
+}
+
+// ProtocolMethod returns Page.getManifestIcons
+func (*PageGetManifestIconsParams) ProtocolMethod() string {
+	return "Page.getManifestIcons"
+}
+
+// PageGetManifestIconsResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getManifestIcons
+//
+// Deprecated: deprecated in protocol
+type PageGetManifestIconsResult struct {
+	PrimaryIcon string `json:"primaryIcon,omitempty"`
+}
+
+// Do sends Page.getManifestIcons and returns its result
+func (p *PageGetManifestIconsParams) Do(e Executor) (*PageGetManifestIconsResult, error) {
+	var result = new(PageGetManifestIconsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetAppIDParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAppId
+type PageGetAppIDParams struct {
+}
+
+// ProtocolMethod returns Page.getAppId
+func (*PageGetAppIDParams) ProtocolMethod() string {
+	return "Page.getAppId"
+}
+
+// PageGetAppIDResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAppId
+type PageGetAppIDResult struct {
+	AppID         string `json:"appId,omitempty"`
+	RecommendedID string `json:"recommendedId,omitempty"`
+}
+
+// Do sends Page.getAppId and returns its result
+func (p *PageGetAppIDParams) Do(e Executor) (*PageGetAppIDResult, error) {
+	var result = new(PageGetAppIDResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetAdScriptAncestryParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAdScriptAncestry
+type PageGetAdScriptAncestryParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.getAdScriptAncestry
+func (*PageGetAdScriptAncestryParams) ProtocolMethod() string {
+	return "Page.getAdScriptAncestry"
+}
+
+// PageGetAdScriptAncestryResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getAdScriptAncestry
+type PageGetAdScriptAncestryResult struct {
+	AdScriptAncestry *PageAdScriptAncestry `json:"adScriptAncestry,omitempty"`
+}
+
+// Do sends Page.getAdScriptAncestry and returns its result
+func (p *PageGetAdScriptAncestryParams) Do(e Executor) (*PageGetAdScriptAncestryResult, error) {
+	var result = new(PageGetAdScriptAncestryResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetFrameTreeParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getFrameTree
+type PageGetFrameTreeParams struct {
+}
+
+// ProtocolMethod returns Page.getFrameTree
+func (*PageGetFrameTreeParams) ProtocolMethod() string {
+	return "Page.getFrameTree"
+}
+
+// PageGetFrameTreeResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getFrameTree
+type PageGetFrameTreeResult struct {
+	FrameTree *PageFrameTree `json:"frameTree"`
+}
+
+// Do sends Page.getFrameTree and returns its result
+func (p *PageGetFrameTreeParams) Do(e Executor) (*PageGetFrameTreeResult, error) {
+	var result = new(PageGetFrameTreeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetLayoutMetricsParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getLayoutMetrics
+type PageGetLayoutMetricsParams struct {
+}
+
+// ProtocolMethod returns Page.getLayoutMetrics
+func (*PageGetLayoutMetricsParams) ProtocolMethod() string {
+	return "Page.getLayoutMetrics"
+}
+
+// PageGetLayoutMetricsResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getLayoutMetrics
+type PageGetLayoutMetricsResult struct {
+	// Deprecated: deprecated in protocol
+	LayoutViewport *PageLayoutViewport `json:"layoutViewport"`
+	// Deprecated: deprecated in protocol
+	VisualViewport *PageVisualViewport `json:"visualViewport"`
+	// Deprecated: deprecated in protocol
+	ContentSize       *DOMRect            `json:"contentSize"`
+	CSSLayoutViewport *PageLayoutViewport `json:"cssLayoutViewport"`
+	CSSVisualViewport *PageVisualViewport `json:"cssVisualViewport"`
+	CSSContentSize    *DOMRect            `json:"cssContentSize"`
+}
+
+// Do sends Page.getLayoutMetrics and returns its result
+func (p *PageGetLayoutMetricsParams) Do(e Executor) (*PageGetLayoutMetricsResult, error) {
+	var result = new(PageGetLayoutMetricsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetNavigationHistoryParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getNavigationHistory
+type PageGetNavigationHistoryParams struct {
+}
+
+// ProtocolMethod returns Page.getNavigationHistory
+func (*PageGetNavigationHistoryParams) ProtocolMethod() string {
+	return "Page.getNavigationHistory"
+}
+
+// PageGetNavigationHistoryResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getNavigationHistory
+type PageGetNavigationHistoryResult struct {
+	CurrentIndex int64                  `json:"currentIndex"`
+	Entries      []*PageNavigationEntry `json:"entries"`
+}
+
+// Do sends Page.getNavigationHistory and returns its result
+func (p *PageGetNavigationHistoryParams) Do(e Executor) (*PageGetNavigationHistoryResult, error) {
+	var result = new(PageGetNavigationHistoryResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageResetNavigationHistoryParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-resetNavigationHistory
+type PageResetNavigationHistoryParams struct {
+}
+
+// ProtocolMethod returns Page.resetNavigationHistory
+func (*PageResetNavigationHistoryParams) ProtocolMethod() string {
+	return "Page.resetNavigationHistory"
+}
+
+// Do sends Page.resetNavigationHistory
+func (p *PageResetNavigationHistoryParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageGetResourceContentParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getResourceContent
+type PageGetResourceContentParams struct {
+	FrameID PageFrameID `json:"frameId"`
+	URL     string      `json:"url"`
+}
+
+// ProtocolMethod returns Page.getResourceContent
+func (*PageGetResourceContentParams) ProtocolMethod() string {
+	return "Page.getResourceContent"
+}
+
+// PageGetResourceContentResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getResourceContent
+type PageGetResourceContentResult struct {
+	Content       string `json:"content"`
+	Base64Encoded bool   `json:"base64Encoded"`
+}
+
+// Do sends Page.getResourceContent and returns its result
+func (p *PageGetResourceContentParams) Do(e Executor) (*PageGetResourceContentResult, error) {
+	var result = new(PageGetResourceContentResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetResourceTreeParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getResourceTree
+type PageGetResourceTreeParams struct {
+}
+
+// ProtocolMethod returns Page.getResourceTree
+func (*PageGetResourceTreeParams) ProtocolMethod() string {
+	return "Page.getResourceTree"
+}
+
+// PageGetResourceTreeResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getResourceTree
+type PageGetResourceTreeResult struct {
+	FrameTree *PageFrameResourceTree `json:"frameTree"`
+}
+
+// Do sends Page.getResourceTree and returns its result
+func (p *PageGetResourceTreeParams) Do(e Executor) (*PageGetResourceTreeResult, error) {
+	var result = new(PageGetResourceTreeResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageHandleJavaScriptDialogParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-handleJavaScriptDialog
+type PageHandleJavaScriptDialogParams struct {
+	Accept     bool   `json:"accept"`
+	PromptText string `json:"promptText,omitempty"`
+}
+
+// ProtocolMethod returns Page.handleJavaScriptDialog
+func (*PageHandleJavaScriptDialogParams) ProtocolMethod() string {
+	return "Page.handleJavaScriptDialog"
+}
+
+// Do sends Page.handleJavaScriptDialog
+func (p *PageHandleJavaScriptDialogParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageNavigateParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-navigate
+type PageNavigateParams struct {
+	URL            string             `json:"url"`
+	Referrer       string             `json:"referrer,omitempty"`
+	TransitionType PageTransitionType `json:"transitionType,omitempty"`
+	FrameID        PageFrameID        `json:"frameId,omitempty"`
+	ReferrerPolicy PageReferrerPolicy `json:"referrerPolicy,omitempty"`
+}
+
+// ProtocolMethod returns Page.navigate
+func (*PageNavigateParams) ProtocolMethod() string {
+	return "Page.navigate"
+}
+
+// PageNavigateResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-navigate
+type PageNavigateResult struct {
+	FrameID    PageFrameID     `json:"frameId"`
+	LoaderID   NetworkLoaderID `json:"loaderId,omitempty"`
+	ErrorText  string          `json:"errorText,omitempty"`
+	IsDownload bool            `json:"isDownload,omitempty"`
+}
+
+// Do sends Page.navigate and returns its result
+func (p *PageNavigateParams) Do(e Executor) (*PageNavigateResult, error) {
+	var result = new(PageNavigateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageNavigateToHistoryEntryParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-navigateToHistoryEntry
+type PageNavigateToHistoryEntryParams struct {
+	EntryID int64 `json:"entryId"`
+}
+
+// ProtocolMethod returns Page.navigateToHistoryEntry
+func (*PageNavigateToHistoryEntryParams) ProtocolMethod() string {
+	return "Page.navigateToHistoryEntry"
+}
+
+// Do sends Page.navigateToHistoryEntry
+func (p *PageNavigateToHistoryEntryParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PagePrintToPDFParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-printToPDF
+type PagePrintToPDFParams struct {
+	Landscape               bool                             `json:"landscape,omitempty"`
+	DisplayHeaderFooter     bool                             `json:"displayHeaderFooter,omitempty"`
+	PrintBackground         bool                             `json:"printBackground,omitempty"`
+	Scale                   float64                          `json:"scale,omitempty"`
+	PaperWidth              float64                          `json:"paperWidth,omitempty"`
+	PaperHeight             float64                          `json:"paperHeight,omitempty"`
+	MarginTop               float64                          `json:"marginTop,omitempty"`
+	MarginBottom            float64                          `json:"marginBottom,omitempty"`
+	MarginLeft              float64                          `json:"marginLeft,omitempty"`
+	MarginRight             float64                          `json:"marginRight,omitempty"`
+	PageRanges              string                           `json:"pageRanges,omitempty"`
+	HeaderTemplate          string                           `json:"headerTemplate,omitempty"`
+	FooterTemplate          string                           `json:"footerTemplate,omitempty"`
+	PreferCSSPageSize       bool                             `json:"preferCSSPageSize,omitempty"`
+	TransferMode            PagePrintToPDFParamsTransferMode `json:"transferMode,omitempty"`
+	GenerateTaggedPDF       bool                             `json:"generateTaggedPDF,omitempty"`
+	GenerateDocumentOutline bool                             `json:"generateDocumentOutline,omitempty"`
+}
+
+// ProtocolMethod returns Page.printToPDF
+func (*PagePrintToPDFParams) ProtocolMethod() string {
+	return "Page.printToPDF"
+}
+
+// PagePrintToPDFResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-printToPDF
+type PagePrintToPDFResult struct {
+	Data   string         `json:"data"`
+	Stream IOStreamHandle `json:"stream,omitempty"`
+}
+
+// Do sends Page.printToPDF and returns its result
+func (p *PagePrintToPDFParams) Do(e Executor) (*PagePrintToPDFResult, error) {
+	var result = new(PagePrintToPDFResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageReloadParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-reload
+type PageReloadParams struct {
+	IgnoreCache            bool            `json:"ignoreCache,omitempty"`
+	ScriptToEvaluateOnLoad string          `json:"scriptToEvaluateOnLoad,omitempty"`
+	LoaderID               NetworkLoaderID `json:"loaderId,omitempty"`
+}
+
+// ProtocolMethod returns Page.reload
+func (*PageReloadParams) ProtocolMethod() string {
+	return "Page.reload"
+}
+
+// Do sends Page.reload
+func (p *PageReloadParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageRemoveScriptToEvaluateOnLoadParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-removeScriptToEvaluateOnLoad
+//
+// Deprecated: deprecated in protocol
+type PageRemoveScriptToEvaluateOnLoadParams struct {
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// ProtocolMethod returns Page.removeScriptToEvaluateOnLoad
+func (*PageRemoveScriptToEvaluateOnLoadParams) ProtocolMethod() string {
+	return "Page.removeScriptToEvaluateOnLoad"
+}
+
+// Do sends Page.removeScriptToEvaluateOnLoad
+func (p *PageRemoveScriptToEvaluateOnLoadParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageRemoveScriptToEvaluateOnNewDocumentParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-removeScriptToEvaluateOnNewDocument
+type PageRemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier PageScriptIdentifier `json:"identifier"`
+}
+
+// ProtocolMethod returns Page.removeScriptToEvaluateOnNewDocument
+func (*PageRemoveScriptToEvaluateOnNewDocumentParams) ProtocolMethod() string {
+	return "Page.removeScriptToEvaluateOnNewDocument"
+}
+
+// Do sends Page.removeScriptToEvaluateOnNewDocument
+func (p *PageRemoveScriptToEvaluateOnNewDocumentParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageScreencastFrameAckParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-screencastFrameAck
+type PageScreencastFrameAckParams struct {
+	SessionID int64 `json:"sessionId"`
+}
+
+// ProtocolMethod returns Page.screencastFrameAck
+func (*PageScreencastFrameAckParams) ProtocolMethod() string {
+	return "Page.screencastFrameAck"
+}
+
+// Do sends Page.screencastFrameAck
+func (p *PageScreencastFrameAckParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSearchInResourceParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-searchInResource
+type PageSearchInResourceParams struct {
+	FrameID       PageFrameID `json:"frameId"`
+	URL           string      `json:"url"`
+	Query         string      `json:"query"`
+	CaseSensitive bool        `json:"caseSensitive,omitempty"`
+	IsRegex       bool        `json:"isRegex,omitempty"`
+}
+
+// ProtocolMethod returns Page.searchInResource
+func (*PageSearchInResourceParams) ProtocolMethod() string {
+	return "Page.searchInResource"
+}
+
+// PageSearchInResourceResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-searchInResource
+type PageSearchInResourceResult struct {
+	Result []*DebuggerSearchMatch `json:"result"`
+}
+
+// Do sends Page.searchInResource and returns its result
+func (p *PageSearchInResourceParams) Do(e Executor) (*PageSearchInResourceResult, error) {
+	var result = new(PageSearchInResourceResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageSetAdBlockingEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setAdBlockingEnabled
+type PageSetAdBlockingEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Page.setAdBlockingEnabled
+func (*PageSetAdBlockingEnabledParams) ProtocolMethod() string {
+	return "Page.setAdBlockingEnabled"
+}
+
+// Do sends Page.setAdBlockingEnabled
+func (p *PageSetAdBlockingEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetBypassCSPParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setBypassCSP
+type PageSetBypassCSPParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Page.setBypassCSP
+func (*PageSetBypassCSPParams) ProtocolMethod() string {
+	return "Page.setBypassCSP"
+}
+
+// Do sends Page.setBypassCSP
+func (p *PageSetBypassCSPParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageGetPermissionsPolicyStateParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getPermissionsPolicyState
+type PageGetPermissionsPolicyStateParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.getPermissionsPolicyState
+func (*PageGetPermissionsPolicyStateParams) ProtocolMethod() string {
+	return "Page.getPermissionsPolicyState"
+}
+
+// PageGetPermissionsPolicyStateResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getPermissionsPolicyState
+type PageGetPermissionsPolicyStateResult struct {
+	States []*PagePermissionsPolicyFeatureState `json:"states"`
+}
+
+// Do sends Page.getPermissionsPolicyState and returns its result
+func (p *PageGetPermissionsPolicyStateParams) Do(e Executor) (*PageGetPermissionsPolicyStateResult, error) {
+	var result = new(PageGetPermissionsPolicyStateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageGetOriginTrialsParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getOriginTrials
+type PageGetOriginTrialsParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.getOriginTrials
+func (*PageGetOriginTrialsParams) ProtocolMethod() string {
+	return "Page.getOriginTrials"
+}
+
+// PageGetOriginTrialsResult https://chromedevtools.github.io/devtools-protocol/tot/Page#method-getOriginTrials
+type PageGetOriginTrialsResult struct {
+	OriginTrials []*PageOriginTrial `json:"originTrials"`
+}
+
+// Do sends Page.getOriginTrials and returns its result
+func (p *PageGetOriginTrialsParams) Do(e Executor) (*PageGetOriginTrialsResult, error) {
+	var result = new(PageGetOriginTrialsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PageSetFontFamiliesParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setFontFamilies
+type PageSetFontFamiliesParams struct {
+	FontFamilies *PageFontFamilies         `json:"fontFamilies"`
+	ForScripts   []*PageScriptFontFamilies `json:"forScripts,omitempty"`
+}
+
+// ProtocolMethod returns Page.setFontFamilies
+func (*PageSetFontFamiliesParams) ProtocolMethod() string {
+	return "Page.setFontFamilies"
+}
+
+// Do sends Page.setFontFamilies
+func (p *PageSetFontFamiliesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetFontSizesParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setFontSizes
+type PageSetFontSizesParams struct {
+	FontSizes *PageFontSizes `json:"fontSizes"`
+}
+
+// ProtocolMethod returns Page.setFontSizes
+func (*PageSetFontSizesParams) ProtocolMethod() string {
+	return "Page.setFontSizes"
+}
+
+// Do sends Page.setFontSizes
+func (p *PageSetFontSizesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetDocumentContentParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setDocumentContent
+type PageSetDocumentContentParams struct {
+	FrameID PageFrameID `json:"frameId"`
+	HTML    string      `json:"html"`
+}
+
+// ProtocolMethod returns Page.setDocumentContent
+func (*PageSetDocumentContentParams) ProtocolMethod() string {
+	return "Page.setDocumentContent"
+}
+
+// Do sends Page.setDocumentContent
+func (p *PageSetDocumentContentParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetDownloadBehaviorParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setDownloadBehavior
+//
+// Deprecated: deprecated in protocol
+type PageSetDownloadBehaviorParams struct {
+	Behavior     PageSetDownloadBehaviorParamsBehavior `json:"behavior"`
+	DownloadPath string                                `json:"downloadPath,omitempty"`
+}
+
+// ProtocolMethod returns Page.setDownloadBehavior
+func (*PageSetDownloadBehaviorParams) ProtocolMethod() string {
+	return "Page.setDownloadBehavior"
+}
+
+// Do sends Page.setDownloadBehavior
+func (p *PageSetDownloadBehaviorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetLifecycleEventsEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setLifecycleEventsEnabled
+type PageSetLifecycleEventsEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Page.setLifecycleEventsEnabled
+func (*PageSetLifecycleEventsEnabledParams) ProtocolMethod() string {
+	return "Page.setLifecycleEventsEnabled"
+}
+
+// Do sends Page.setLifecycleEventsEnabled
+func (p *PageSetLifecycleEventsEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageStartScreencastParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-startScreencast
+type PageStartScreencastParams struct {
+	Format        PageStartScreencastParamsFormat `json:"format,omitempty"`
+	Quality       int64                           `json:"quality,omitempty"`
+	MaxWidth      int64                           `json:"maxWidth,omitempty"`
+	MaxHeight     int64                           `json:"maxHeight,omitempty"`
+	EveryNthFrame int64                           `json:"everyNthFrame,omitempty"`
+}
+
+// ProtocolMethod returns Page.startScreencast
+func (*PageStartScreencastParams) ProtocolMethod() string {
+	return "Page.startScreencast"
+}
+
+// Do sends Page.startScreencast
+func (p *PageStartScreencastParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageStopLoadingParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-stopLoading
+type PageStopLoadingParams struct {
+}
+
+// ProtocolMethod returns Page.stopLoading
+func (*PageStopLoadingParams) ProtocolMethod() string {
+	return "Page.stopLoading"
+}
+
+// Do sends Page.stopLoading
+func (p *PageStopLoadingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageCrashParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-crash
+type PageCrashParams struct {
+}
+
+// ProtocolMethod returns Page.crash
+func (*PageCrashParams) ProtocolMethod() string {
+	return "Page.crash"
+}
+
+// Do sends Page.crash
+func (p *PageCrashParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageCloseParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-close
+type PageCloseParams struct {
+}
+
+// ProtocolMethod returns Page.close
+func (*PageCloseParams) ProtocolMethod() string {
+	return "Page.close"
+}
+
+// Do sends Page.close
+func (p *PageCloseParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetWebLifecycleStateParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setWebLifecycleState
+type PageSetWebLifecycleStateParams struct {
+	State PageSetWebLifecycleStateParamsState `json:"state"`
+}
+
+// ProtocolMethod returns Page.setWebLifecycleState
+func (*PageSetWebLifecycleStateParams) ProtocolMethod() string {
+	return "Page.setWebLifecycleState"
+}
+
+// Do sends Page.setWebLifecycleState
+func (p *PageSetWebLifecycleStateParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageStopScreencastParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-stopScreencast
+type PageStopScreencastParams struct {
+}
+
+// ProtocolMethod returns Page.stopScreencast
+func (*PageStopScreencastParams) ProtocolMethod() string {
+	return "Page.stopScreencast"
+}
+
+// Do sends Page.stopScreencast
+func (p *PageStopScreencastParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageProduceCompilationCacheParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-produceCompilationCache
+type PageProduceCompilationCacheParams struct {
+	Scripts []*PageCompilationCacheParams `json:"scripts"`
+}
+
+// ProtocolMethod returns Page.produceCompilationCache
+func (*PageProduceCompilationCacheParams) ProtocolMethod() string {
+	return "Page.produceCompilationCache"
+}
+
+// Do sends Page.produceCompilationCache
+func (p *PageProduceCompilationCacheParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageAddCompilationCacheParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-addCompilationCache
+type PageAddCompilationCacheParams struct {
+	URL  string `json:"url"`
+	Data string `json:"data"`
+}
+
+// ProtocolMethod returns Page.addCompilationCache
+func (*PageAddCompilationCacheParams) ProtocolMethod() string {
+	return "Page.addCompilationCache"
+}
+
+// Do sends Page.addCompilationCache
+func (p *PageAddCompilationCacheParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageClearCompilationCacheParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-clearCompilationCache
+type PageClearCompilationCacheParams struct {
+}
+
+// ProtocolMethod returns Page.clearCompilationCache
+func (*PageClearCompilationCacheParams) ProtocolMethod() string {
+	return "Page.clearCompilationCache"
+}
+
+// Do sends Page.clearCompilationCache
+func (p *PageClearCompilationCacheParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetSPCTransactionModeParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setSPCTransactionMode
+type PageSetSPCTransactionModeParams struct {
+	Mode PageSetSPCTransactionModeParamsMode `json:"mode"`
+}
+
+// ProtocolMethod returns Page.setSPCTransactionMode
+func (*PageSetSPCTransactionModeParams) ProtocolMethod() string {
+	return "Page.setSPCTransactionMode"
+}
+
+// Do sends Page.setSPCTransactionMode
+func (p *PageSetSPCTransactionModeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetRPHRegistrationModeParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setRPHRegistrationMode
+type PageSetRPHRegistrationModeParams struct {
+	Mode PageSetRPHRegistrationModeParamsMode `json:"mode"`
+}
+
+// ProtocolMethod returns Page.setRPHRegistrationMode
+func (*PageSetRPHRegistrationModeParams) ProtocolMethod() string {
+	return "Page.setRPHRegistrationMode"
+}
+
+// Do sends Page.setRPHRegistrationMode
+func (p *PageSetRPHRegistrationModeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageGenerateTestReportParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-generateTestReport
+type PageGenerateTestReportParams struct {
+	Message string `json:"message"`
+	Group   string `json:"group,omitempty"`
+}
+
+// ProtocolMethod returns Page.generateTestReport
+func (*PageGenerateTestReportParams) ProtocolMethod() string {
+	return "Page.generateTestReport"
+}
+
+// Do sends Page.generateTestReport
+func (p *PageGenerateTestReportParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageWaitForDebuggerParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-waitForDebugger
+type PageWaitForDebuggerParams struct {
+}
+
+// ProtocolMethod returns Page.waitForDebugger
+func (*PageWaitForDebuggerParams) ProtocolMethod() string {
+	return "Page.waitForDebugger"
+}
+
+// Do sends Page.waitForDebugger
+func (p *PageWaitForDebuggerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetInterceptFileChooserDialogParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setInterceptFileChooserDialog
+type PageSetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+	Cancel  bool `json:"cancel,omitempty"`
+}
+
+// ProtocolMethod returns Page.setInterceptFileChooserDialog
+func (*PageSetInterceptFileChooserDialogParams) ProtocolMethod() string {
+	return "Page.setInterceptFileChooserDialog"
+}
+
+// Do sends Page.setInterceptFileChooserDialog
+func (p *PageSetInterceptFileChooserDialogParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageSetPrerenderingAllowedParams https://chromedevtools.github.io/devtools-protocol/tot/Page#method-setPrerenderingAllowed
+type PageSetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// ProtocolMethod returns Page.setPrerenderingAllowed
+func (*PageSetPrerenderingAllowedParams) ProtocolMethod() string {
+	return "Page.setPrerenderingAllowed"
+}
+
+// Do sends Page.setPrerenderingAllowed
+func (p *PageSetPrerenderingAllowedParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PageDOMContentEventFiredEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-domContentEventFired
+type PageDOMContentEventFiredEvent struct {
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Page.domContentEventFired
+func (*PageDOMContentEventFiredEvent) ProtocolMethod() string {
+	return "Page.domContentEventFired"
+}
+
+// PageFileChooserOpenedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-fileChooserOpened
+type PageFileChooserOpenedEvent struct {
+	FrameID       PageFrameID                    `json:"frameId"`
+	Mode          PageFileChooserOpenedEventMode `json:"mode"`
+	BackendNodeID DOMBackendNodeID               `json:"backendNodeId,omitempty"`
+}
+
+// ProtocolMethod returns Page.fileChooserOpened
+func (*PageFileChooserOpenedEvent) ProtocolMethod() string {
+	return "Page.fileChooserOpened"
+}
+
+// PageFrameAttachedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameAttached
+type PageFrameAttachedEvent struct {
+	FrameID       PageFrameID        `json:"frameId"`
+	ParentFrameID PageFrameID        `json:"parentFrameId"`
+	Stack         *RuntimeStackTrace `json:"stack,omitempty"`
+}
+
+// ProtocolMethod returns Page.frameAttached
+func (*PageFrameAttachedEvent) ProtocolMethod() string {
+	return "Page.frameAttached"
+}
+
+// PageFrameClearedScheduledNavigationEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameClearedScheduledNavigation
+//
+// Deprecated: deprecated in protocol
+type PageFrameClearedScheduledNavigationEvent struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.frameClearedScheduledNavigation
+func (*PageFrameClearedScheduledNavigationEvent) ProtocolMethod() string {
+	return "Page.frameClearedScheduledNavigation"
+}
+
+// PageFrameDetachedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameDetached
+type PageFrameDetachedEvent struct {
+	FrameID PageFrameID                  `json:"frameId"`
+	Reason  PageFrameDetachedEventReason `json:"reason"`
+}
+
+// ProtocolMethod returns Page.frameDetached
+func (*PageFrameDetachedEvent) ProtocolMethod() string {
+	return "Page.frameDetached"
+}
+
+// PageFrameSubtreeWillBeDetachedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameSubtreeWillBeDetached
+type PageFrameSubtreeWillBeDetachedEvent struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.frameSubtreeWillBeDetached
+func (*PageFrameSubtreeWillBeDetachedEvent) ProtocolMethod() string {
+	return "Page.frameSubtreeWillBeDetached"
+}
+
+// PageFrameNavigatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameNavigated
+type PageFrameNavigatedEvent struct {
+	Frame *PageFrame         `json:"frame"`
+	Type  PageNavigationType `json:"type"`
+}
+
+// ProtocolMethod returns Page.frameNavigated
+func (*PageFrameNavigatedEvent) ProtocolMethod() string {
+	return "Page.frameNavigated"
+}
+
+// PageDocumentOpenedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-documentOpened
+type PageDocumentOpenedEvent struct {
+	Frame *PageFrame `json:"frame"`
+}
+
+// ProtocolMethod returns Page.documentOpened
+func (*PageDocumentOpenedEvent) ProtocolMethod() string {
+	return "Page.documentOpened"
+}
+
+// PageFrameResizedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameResized
+type PageFrameResizedEvent struct {
+}
+
+// ProtocolMethod returns Page.frameResized
+func (*PageFrameResizedEvent) ProtocolMethod() string {
+	return "Page.frameResized"
+}
+
+// PageFrameStartedNavigatingEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameStartedNavigating
+type PageFrameStartedNavigatingEvent struct {
+	FrameID        PageFrameID                                   `json:"frameId"`
+	URL            string                                        `json:"url"`
+	LoaderID       NetworkLoaderID                               `json:"loaderId"`
+	NavigationType PageFrameStartedNavigatingEventNavigationType `json:"navigationType"`
+}
+
+// ProtocolMethod returns Page.frameStartedNavigating
+func (*PageFrameStartedNavigatingEvent) ProtocolMethod() string {
+	return "Page.frameStartedNavigating"
+}
+
+// PageFrameRequestedNavigationEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameRequestedNavigation
+type PageFrameRequestedNavigationEvent struct {
+	FrameID     PageFrameID                     `json:"frameId"`
+	Reason      PageClientNavigationReason      `json:"reason"`
+	URL         string                          `json:"url"`
+	Disposition PageClientNavigationDisposition `json:"disposition"`
+}
+
+// ProtocolMethod returns Page.frameRequestedNavigation
+func (*PageFrameRequestedNavigationEvent) ProtocolMethod() string {
+	return "Page.frameRequestedNavigation"
+}
+
+// PageFrameScheduledNavigationEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameScheduledNavigation
+//
+// Deprecated: deprecated in protocol
+type PageFrameScheduledNavigationEvent struct {
+	FrameID PageFrameID                `json:"frameId"`
+	Delay   float64                    `json:"delay"`
+	Reason  PageClientNavigationReason `json:"reason"`
+	URL     string                     `json:"url"`
+}
+
+// ProtocolMethod returns Page.frameScheduledNavigation
+func (*PageFrameScheduledNavigationEvent) ProtocolMethod() string {
+	return "Page.frameScheduledNavigation"
+}
+
+// PageFrameStartedLoadingEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameStartedLoading
+type PageFrameStartedLoadingEvent struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.frameStartedLoading
+func (*PageFrameStartedLoadingEvent) ProtocolMethod() string {
+	return "Page.frameStartedLoading"
+}
+
+// PageFrameStoppedLoadingEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-frameStoppedLoading
+type PageFrameStoppedLoadingEvent struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Page.frameStoppedLoading
+func (*PageFrameStoppedLoadingEvent) ProtocolMethod() string {
+	return "Page.frameStoppedLoading"
+}
+
+// PageDownloadWillBeginEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-downloadWillBegin
+//
+// Deprecated: deprecated in protocol
+type PageDownloadWillBeginEvent struct {
+	FrameID           PageFrameID `json:"frameId"`
+	Guid              string      `json:"guid"`
+	URL               string      `json:"url"`
+	SuggestedFilename string      `json:"suggestedFilename"`
+}
+
+// ProtocolMethod returns Page.downloadWillBegin
+func (*PageDownloadWillBeginEvent) ProtocolMethod() string {
+	return "Page.downloadWillBegin"
+}
+
+// PageDownloadProgressEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-downloadProgress
+//
+// Deprecated: deprecated in protocol
+type PageDownloadProgressEvent struct {
+	Guid          string                         `json:"guid"`
+	TotalBytes    float64                        `json:"totalBytes"`
+	ReceivedBytes float64                        `json:"receivedBytes"`
+	State         PageDownloadProgressEventState `json:"state"`
+}
+
+// ProtocolMethod returns Page.downloadProgress
+func (*PageDownloadProgressEvent) ProtocolMethod() string {
+	return "Page.downloadProgress"
+}
+
+// PageInterstitialHiddenEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-interstitialHidden
+type PageInterstitialHiddenEvent struct {
+}
+
+// ProtocolMethod returns Page.interstitialHidden
+func (*PageInterstitialHiddenEvent) ProtocolMethod() string {
+	return "Page.interstitialHidden"
+}
+
+// PageInterstitialShownEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-interstitialShown
+type PageInterstitialShownEvent struct {
+}
+
+// ProtocolMethod returns Page.interstitialShown
+func (*PageInterstitialShownEvent) ProtocolMethod() string {
+	return "Page.interstitialShown"
+}
+
+// PageJavascriptDialogClosedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-javascriptDialogClosed
+type PageJavascriptDialogClosedEvent struct {
+	FrameID   PageFrameID `json:"frameId"`
+	Result    bool        `json:"result"`
+	UserInput string      `json:"userInput"`
+}
+
+// ProtocolMethod returns Page.javascriptDialogClosed
+func (*PageJavascriptDialogClosedEvent) ProtocolMethod() string {
+	return "Page.javascriptDialogClosed"
+}
+
+// PageJavascriptDialogOpeningEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-javascriptDialogOpening
+type PageJavascriptDialogOpeningEvent struct {
+	URL               string         `json:"url"`
+	FrameID           PageFrameID    `json:"frameId"`
+	Message           string         `json:"message"`
+	Type              PageDialogType `json:"type"`
+	HasBrowserHandler bool           `json:"hasBrowserHandler"`
+	DefaultPrompt     string         `json:"defaultPrompt,omitempty"`
+}
+
+// ProtocolMethod returns Page.javascriptDialogOpening
+func (*PageJavascriptDialogOpeningEvent) ProtocolMethod() string {
+	return "Page.javascriptDialogOpening"
+}
+
+// PageLifecycleEventEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-lifecycleEvent
+type PageLifecycleEventEvent struct {
+	FrameID   PageFrameID          `json:"frameId"`
+	LoaderID  NetworkLoaderID      `json:"loaderId"`
+	Name      string               `json:"name"`
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Page.lifecycleEvent
+func (*PageLifecycleEventEvent) ProtocolMethod() string {
+	return "Page.lifecycleEvent"
+}
+
+// PageBackForwardCacheNotUsedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-backForwardCacheNotUsed
+type PageBackForwardCacheNotUsedEvent struct {
+	LoaderID                    NetworkLoaderID                                 `json:"loaderId"`
+	FrameID                     PageFrameID                                     `json:"frameId"`
+	NotRestoredExplanations     []*PageBackForwardCacheNotRestoredExplanation   `json:"notRestoredExplanations"`
+	NotRestoredExplanationsTree *PageBackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+}
+
+// ProtocolMethod returns Page.backForwardCacheNotUsed
+func (*PageBackForwardCacheNotUsedEvent) ProtocolMethod() string {
+	return "Page.backForwardCacheNotUsed"
+}
+
+// PageLoadEventFiredEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-loadEventFired
+type PageLoadEventFiredEvent struct {
+	Timestamp NetworkMonotonicTime `json:"timestamp"`
+}
+
+// ProtocolMethod returns Page.loadEventFired
+func (*PageLoadEventFiredEvent) ProtocolMethod() string {
+	return "Page.loadEventFired"
+}
+
+// PageNavigatedWithinDocumentEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-navigatedWithinDocument
+type PageNavigatedWithinDocumentEvent struct {
+	FrameID        PageFrameID                                    `json:"frameId"`
+	URL            string                                         `json:"url"`
+	NavigationType PageNavigatedWithinDocumentEventNavigationType `json:"navigationType"`
+}
+
+// ProtocolMethod returns Page.navigatedWithinDocument
+func (*PageNavigatedWithinDocumentEvent) ProtocolMethod() string {
+	return "Page.navigatedWithinDocument"
+}
+
+// PageScreencastFrameEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-screencastFrame
+type PageScreencastFrameEvent struct {
+	Data      string                       `json:"data"`
+	Metadata  *PageScreencastFrameMetadata `json:"metadata"`
+	SessionID int64                        `json:"sessionId"`
+}
+
+// ProtocolMethod returns Page.screencastFrame
+func (*PageScreencastFrameEvent) ProtocolMethod() string {
+	return "Page.screencastFrame"
+}
+
+// PageScreencastVisibilityChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-screencastVisibilityChanged
+type PageScreencastVisibilityChangedEvent struct {
+	Visible bool `json:"visible"`
+}
+
+// ProtocolMethod returns Page.screencastVisibilityChanged
+func (*PageScreencastVisibilityChangedEvent) ProtocolMethod() string {
+	return "Page.screencastVisibilityChanged"
+}
+
+// PageWindowOpenEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-windowOpen
+type PageWindowOpenEvent struct {
+	URL            string   `json:"url"`
+	WindowName     string   `json:"windowName"`
+	WindowFeatures []string `json:"windowFeatures"`
+	UserGesture    bool     `json:"userGesture"`
+}
+
+// ProtocolMethod returns Page.windowOpen
+func (*PageWindowOpenEvent) ProtocolMethod() string {
+	return "Page.windowOpen"
+}
+
+// PageCompilationCacheProducedEvent https://chromedevtools.github.io/devtools-protocol/tot/Page#event-compilationCacheProduced
+type PageCompilationCacheProducedEvent struct {
+	URL  string `json:"url"`
+	Data string `json:"data"`
+}
+
+// ProtocolMethod returns Page.compilationCacheProduced
+func (*PageCompilationCacheProducedEvent) ProtocolMethod() string {
+	return "Page.compilationCacheProduced"
+}
+
+// PageCaptureScreenshotParamsFormat enum
+type PageCaptureScreenshotParamsFormat string
+
+// PageCaptureScreenshotParamsFormat values
+const (
+	PageCaptureScreenshotParamsFormatJpeg PageCaptureScreenshotParamsFormat = "jpeg"
+	PageCaptureScreenshotParamsFormatPng  PageCaptureScreenshotParamsFormat = "png"
+	PageCaptureScreenshotParamsFormatWebp PageCaptureScreenshotParamsFormat = "webp"
+)
+
+// PageCaptureSnapshotParamsFormat enum
+type PageCaptureSnapshotParamsFormat string
+
+// PageCaptureSnapshotParamsFormat values
+const (
+	PageCaptureSnapshotParamsFormatMhtml PageCaptureSnapshotParamsFormat = "mhtml"
+)
+
+// PagePrintToPDFParamsTransferMode enum
+type PagePrintToPDFParamsTransferMode string
+
+// PagePrintToPDFParamsTransferMode values
+const (
+	PagePrintToPDFParamsTransferModeReturnAsBase64 PagePrintToPDFParamsTransferMode = "ReturnAsBase64"
+	PagePrintToPDFParamsTransferModeReturnAsStream PagePrintToPDFParamsTransferMode = "ReturnAsStream"
+)
+
+// PageSetDownloadBehaviorParamsBehavior enum
+type PageSetDownloadBehaviorParamsBehavior string
+
+// PageSetDownloadBehaviorParamsBehavior values
+const (
+	PageSetDownloadBehaviorParamsBehaviorDeny    PageSetDownloadBehaviorParamsBehavior = "deny"
+	PageSetDownloadBehaviorParamsBehaviorAllow   PageSetDownloadBehaviorParamsBehavior = "allow"
+	PageSetDownloadBehaviorParamsBehaviorDefault PageSetDownloadBehaviorParamsBehavior = "default"
+)
+
+// PageStartScreencastParamsFormat enum
+type PageStartScreencastParamsFormat string
+
+// PageStartScreencastParamsFormat values
+const (
+	PageStartScreencastParamsFormatJpeg PageStartScreencastParamsFormat = "jpeg"
+	PageStartScreencastParamsFormatPng  PageStartScreencastParamsFormat = "png"
+)
+
+// PageSetWebLifecycleStateParamsState enum
+type PageSetWebLifecycleStateParamsState string
+
+// PageSetWebLifecycleStateParamsState values
+const (
+	PageSetWebLifecycleStateParamsStateFrozen PageSetWebLifecycleStateParamsState = "frozen"
+	PageSetWebLifecycleStateParamsStateActive PageSetWebLifecycleStateParamsState = "active"
+)
+
+// PageSetSPCTransactionModeParamsMode enum
+type PageSetSPCTransactionModeParamsMode string
+
+// PageSetSPCTransactionModeParamsMode values
+const (
+	PageSetSPCTransactionModeParamsModeNone                       PageSetSPCTransactionModeParamsMode = "none"
+	PageSetSPCTransactionModeParamsModeAutoAccept                 PageSetSPCTransactionModeParamsMode = "autoAccept"
+	PageSetSPCTransactionModeParamsModeAutoChooseToAuthAnotherWay PageSetSPCTransactionModeParamsMode = "autoChooseToAuthAnotherWay"
+	PageSetSPCTransactionModeParamsModeAutoReject                 PageSetSPCTransactionModeParamsMode = "autoReject"
+	PageSetSPCTransactionModeParamsModeAutoOptOut                 PageSetSPCTransactionModeParamsMode = "autoOptOut"
+)
+
+// PageSetRPHRegistrationModeParamsMode enum
+type PageSetRPHRegistrationModeParamsMode string
+
+// PageSetRPHRegistrationModeParamsMode values
+const (
+	PageSetRPHRegistrationModeParamsModeNone       PageSetRPHRegistrationModeParamsMode = "none"
+	PageSetRPHRegistrationModeParamsModeAutoAccept PageSetRPHRegistrationModeParamsMode = "autoAccept"
+	PageSetRPHRegistrationModeParamsModeAutoReject PageSetRPHRegistrationModeParamsMode = "autoReject"
+)
+
+// PageFileChooserOpenedEventMode enum
+type PageFileChooserOpenedEventMode string
+
+// PageFileChooserOpenedEventMode values
+const (
+	PageFileChooserOpenedEventModeSelectSingle   PageFileChooserOpenedEventMode = "selectSingle"
+	PageFileChooserOpenedEventModeSelectMultiple PageFileChooserOpenedEventMode = "selectMultiple"
+)
+
+// PageFrameDetachedEventReason enum
+type PageFrameDetachedEventReason string
+
+// PageFrameDetachedEventReason values
+const (
+	PageFrameDetachedEventReasonRemove PageFrameDetachedEventReason = "remove"
+	PageFrameDetachedEventReasonSwap   PageFrameDetachedEventReason = "swap"
+)
+
+// PageFrameStartedNavigatingEventNavigationType enum
+type PageFrameStartedNavigatingEventNavigationType string
+
+// PageFrameStartedNavigatingEventNavigationType values
+const (
+	PageFrameStartedNavigatingEventNavigationTypeReload                   PageFrameStartedNavigatingEventNavigationType = "reload"
+	PageFrameStartedNavigatingEventNavigationTypeReloadBypassingCache     PageFrameStartedNavigatingEventNavigationType = "reloadBypassingCache"
+	PageFrameStartedNavigatingEventNavigationTypeRestore                  PageFrameStartedNavigatingEventNavigationType = "restore"
+	PageFrameStartedNavigatingEventNavigationTypeRestoreWithPost          PageFrameStartedNavigatingEventNavigationType = "restoreWithPost"
+	PageFrameStartedNavigatingEventNavigationTypeHistorySameDocument      PageFrameStartedNavigatingEventNavigationType = "historySameDocument"
+	PageFrameStartedNavigatingEventNavigationTypeHistoryDifferentDocument PageFrameStartedNavigatingEventNavigationType = "historyDifferentDocument"
+	PageFrameStartedNavigatingEventNavigationTypeSameDocument             PageFrameStartedNavigatingEventNavigationType = "sameDocument"
+	PageFrameStartedNavigatingEventNavigationTypeDifferentDocument        PageFrameStartedNavigatingEventNavigationType = "differentDocument"
+)
+
+// PageDownloadProgressEventState enum
+type PageDownloadProgressEventState string
+
+// PageDownloadProgressEventState values
+const (
+	PageDownloadProgressEventStateInProgress PageDownloadProgressEventState = "inProgress"
+	PageDownloadProgressEventStateCompleted  PageDownloadProgressEventState = "completed"
+	PageDownloadProgressEventStateCanceled   PageDownloadProgressEventState = "canceled"
+)
+
+// PageNavigatedWithinDocumentEventNavigationType enum
+type PageNavigatedWithinDocumentEventNavigationType string
+
+// PageNavigatedWithinDocumentEventNavigationType values
+const (
+	PageNavigatedWithinDocumentEventNavigationTypeFragment   PageNavigatedWithinDocumentEventNavigationType = "fragment"
+	PageNavigatedWithinDocumentEventNavigationTypeHistoryAPI PageNavigatedWithinDocumentEventNavigationType = "historyApi"
+	PageNavigatedWithinDocumentEventNavigationTypeOther      PageNavigatedWithinDocumentEventNavigationType = "other"
+)
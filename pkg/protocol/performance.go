@@ -0,0 +1,112 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Performance events
+const (
+	EventPerformanceMetrics = "Performance.metrics"
+)
+
+// PerformanceMetric https://chromedevtools.github.io/devtools-protocol/tot/Performance#type-Metric
+type PerformanceMetric struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+// PerformanceDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-disable
+type PerformanceDisableParams struct {
+}
+
+// ProtocolMethod returns Performance.disable
+func (*PerformanceDisableParams) ProtocolMethod() string {
+	return "Performance.disable"
+}
+
+// Do sends Performance.disable
+func (p *PerformanceDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PerformanceEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-enable
+type PerformanceEnableParams struct {
+	TimeDomain PerformanceEnableParamsTimeDomain `json:"timeDomain,omitempty"`
+}
+
+// ProtocolMethod returns Performance.enable
+func (*PerformanceEnableParams) ProtocolMethod() string {
+	return "Performance.enable"
+}
+
+// Do sends Performance.enable
+func (p *PerformanceEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PerformanceSetTimeDomainParams https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-setTimeDomain
+//
+// Deprecated: deprecated in protocol
+type PerformanceSetTimeDomainParams struct {
+	TimeDomain PerformanceSetTimeDomainParamsTimeDomain `json:"timeDomain"`
+}
+
+// ProtocolMethod returns Performance.setTimeDomain
+func (*PerformanceSetTimeDomainParams) ProtocolMethod() string {
+	return "Performance.setTimeDomain"
+}
+
+// Do sends Performance.setTimeDomain
+func (p *PerformanceSetTimeDomainParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PerformanceGetMetricsParams https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-getMetrics
+type PerformanceGetMetricsParams struct {
+}
+
+// ProtocolMethod returns Performance.getMetrics
+func (*PerformanceGetMetricsParams) ProtocolMethod() string {
+	return "Performance.getMetrics"
+}
+
+// PerformanceGetMetricsResult https://chromedevtools.github.io/devtools-protocol/tot/Performance#method-getMetrics
+type PerformanceGetMetricsResult struct {
+	Metrics []*PerformanceMetric `json:"metrics"`
+}
+
+// Do sends Performance.getMetrics and returns its result
+func (p *PerformanceGetMetricsParams) Do(e Executor) (*PerformanceGetMetricsResult, error) {
+	var result = new(PerformanceGetMetricsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PerformanceMetricsEvent https://chromedevtools.github.io/devtools-protocol/tot/Performance#event-metrics
+type PerformanceMetricsEvent struct {
+	Metrics []*PerformanceMetric `json:"metrics"`
+	Title   string               `json:"title"`
+}
+
+// ProtocolMethod returns Performance.metrics
+func (*PerformanceMetricsEvent) ProtocolMethod() string {
+	return "Performance.metrics"
+}
+
+// PerformanceEnableParamsTimeDomain enum
+type PerformanceEnableParamsTimeDomain string
+
+// PerformanceEnableParamsTimeDomain values
+const (
+	PerformanceEnableParamsTimeDomainTimeTicks   PerformanceEnableParamsTimeDomain = "timeTicks"
+	PerformanceEnableParamsTimeDomainThreadTicks PerformanceEnableParamsTimeDomain = "threadTicks"
+)
+
+// PerformanceSetTimeDomainParamsTimeDomain enum
+type PerformanceSetTimeDomainParamsTimeDomain string
+
+// PerformanceSetTimeDomainParamsTimeDomain values
+const (
+	PerformanceSetTimeDomainParamsTimeDomainTimeTicks   PerformanceSetTimeDomainParamsTimeDomain = "timeTicks"
+	PerformanceSetTimeDomainParamsTimeDomainThreadTicks PerformanceSetTimeDomainParamsTimeDomain = "threadTicks"
+)
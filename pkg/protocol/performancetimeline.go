@@ -0,0 +1,69 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// PerformanceTimeline events
+const (
+	EventPerformanceTimelineTimelineEventAdded = "PerformanceTimeline.timelineEventAdded"
+)
+
+// PerformanceTimelineLargestContentfulPaint https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LargestContentfulPaint
+type PerformanceTimelineLargestContentfulPaint struct {
+	RenderTime NetworkTimeSinceEpoch `json:"renderTime"`
+	LoadTime   NetworkTimeSinceEpoch `json:"loadTime"`
+	Size       float64               `json:"size"`
+	ElementID  string                `json:"elementId,omitempty"`
+	URL        string                `json:"url,omitempty"`
+	NodeID     DOMBackendNodeID      `json:"nodeId,omitempty"`
+}
+
+// PerformanceTimelineLayoutShiftAttribution https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LayoutShiftAttribution
+type PerformanceTimelineLayoutShiftAttribution struct {
+	PreviousRect *DOMRect         `json:"previousRect"`
+	CurrentRect  *DOMRect         `json:"currentRect"`
+	NodeID       DOMBackendNodeID `json:"nodeId,omitempty"`
+}
+
+// PerformanceTimelineLayoutShift https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-LayoutShift
+type PerformanceTimelineLayoutShift struct {
+	Value          float64                                      `json:"value"`
+	HadRecentInput bool                                         `json:"hadRecentInput"`
+	LastInputTime  NetworkTimeSinceEpoch                        `json:"lastInputTime"`
+	Sources        []*PerformanceTimelineLayoutShiftAttribution `json:"sources"`
+}
+
+// PerformanceTimelineTimelineEvent https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#type-TimelineEvent
+type PerformanceTimelineTimelineEvent struct {
+	FrameID            PageFrameID                                `json:"frameId"`
+	Type               string                                     `json:"type"`
+	Name               string                                     `json:"name"`
+	Time               NetworkTimeSinceEpoch                      `json:"time"`
+	Duration           float64                                    `json:"duration,omitempty"`
+	LcpDetails         *PerformanceTimelineLargestContentfulPaint `json:"lcpDetails,omitempty"`
+	LayoutShiftDetails *PerformanceTimelineLayoutShift            `json:"layoutShiftDetails,omitempty"`
+}
+
+// PerformanceTimelineEnableParams https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#method-enable
+type PerformanceTimelineEnableParams struct {
+	EventTypes []string `json:"eventTypes"`
+}
+
+// ProtocolMethod returns PerformanceTimeline.enable
+func (*PerformanceTimelineEnableParams) ProtocolMethod() string {
+	return "PerformanceTimeline.enable"
+}
+
+// Do sends PerformanceTimeline.enable
+func (p *PerformanceTimelineEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PerformanceTimelineTimelineEventAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline#event-timelineEventAdded
+type PerformanceTimelineTimelineEventAddedEvent struct {
+	Event *PerformanceTimelineTimelineEvent `json:"event"`
+}
+
+// ProtocolMethod returns PerformanceTimeline.timelineEventAdded
+func (*PerformanceTimelineTimelineEventAddedEvent) ProtocolMethod() string {
+	return "PerformanceTimeline.timelineEventAdded"
+}
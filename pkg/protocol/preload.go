@@ -0,0 +1,321 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Preload events
+const (
+	EventPreloadRuleSetUpdated                  = "Preload.ruleSetUpdated"
+	EventPreloadRuleSetRemoved                  = "Preload.ruleSetRemoved"
+	EventPreloadPreloadEnabledStateUpdated      = "Preload.preloadEnabledStateUpdated"
+	EventPreloadPrefetchStatusUpdated           = "Preload.prefetchStatusUpdated"
+	EventPreloadPrerenderStatusUpdated          = "Preload.prerenderStatusUpdated"
+	EventPreloadPreloadingAttemptSourcesUpdated = "Preload.preloadingAttemptSourcesUpdated"
+)
+
+// PreloadRuleSetID https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSetId
+type PreloadRuleSetID string
+
+// PreloadRuleSet https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSet
+type PreloadRuleSet struct {
+	ID            PreloadRuleSetID        `json:"id"`
+	LoaderID      NetworkLoaderID         `json:"loaderId"`
+	SourceText    string                  `json:"sourceText"`
+	BackendNodeID DOMBackendNodeID        `json:"backendNodeId,omitempty"`
+	URL           string                  `json:"url,omitempty"`
+	RequestID     NetworkRequestID        `json:"requestId,omitempty"`
+	ErrorType     PreloadRuleSetErrorType `json:"errorType,omitempty"`
+	// Deprecated: deprecated in protocol
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// PreloadRuleSetErrorType https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-RuleSetErrorType
+type PreloadRuleSetErrorType string
+
+// PreloadRuleSetErrorType values
+const (
+	PreloadRuleSetErrorTypeSourceIsNotJSONObject  PreloadRuleSetErrorType = "SourceIsNotJsonObject"
+	PreloadRuleSetErrorTypeInvalidRulesSkipped    PreloadRuleSetErrorType = "InvalidRulesSkipped"
+	PreloadRuleSetErrorTypeInvalidRulesetLevelTag PreloadRuleSetErrorType = "InvalidRulesetLevelTag"
+)
+
+// PreloadSpeculationAction https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-SpeculationAction
+type PreloadSpeculationAction string
+
+// PreloadSpeculationAction values
+const (
+	PreloadSpeculationActionPrefetch  PreloadSpeculationAction = "Prefetch"
+	PreloadSpeculationActionPrerender PreloadSpeculationAction = "Prerender"
+)
+
+// PreloadSpeculationTargetHint https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-SpeculationTargetHint
+type PreloadSpeculationTargetHint string
+
+// PreloadSpeculationTargetHint values
+const (
+	PreloadSpeculationTargetHintBlank PreloadSpeculationTargetHint = "Blank"
+	PreloadSpeculationTargetHintSelf  PreloadSpeculationTargetHint = "Self"
+)
+
+// PreloadPreloadingAttemptKey https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingAttemptKey
+type PreloadPreloadingAttemptKey struct {
+	LoaderID   NetworkLoaderID              `json:"loaderId"`
+	Action     PreloadSpeculationAction     `json:"action"`
+	URL        string                       `json:"url"`
+	TargetHint PreloadSpeculationTargetHint `json:"targetHint,omitempty"`
+}
+
+// PreloadPreloadingAttemptSource https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingAttemptSource
+type PreloadPreloadingAttemptSource struct {
+	Key        *PreloadPreloadingAttemptKey `json:"key"`
+	RuleSetIds []PreloadRuleSetID           `json:"ruleSetIds"`
+	NodeIds    []DOMBackendNodeID           `json:"nodeIds"`
+}
+
+// PreloadPreloadPipelineID https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadPipelineId
+type PreloadPreloadPipelineID string
+
+// PreloadPrerenderFinalStatus https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrerenderFinalStatus
+type PreloadPrerenderFinalStatus string
+
+// PreloadPrerenderFinalStatus values
+const (
+	PreloadPrerenderFinalStatusActivated                                                  PreloadPrerenderFinalStatus = "Activated"
+	PreloadPrerenderFinalStatusDestroyed                                                  PreloadPrerenderFinalStatus = "Destroyed"
+	PreloadPrerenderFinalStatusLowEndDevice                                               PreloadPrerenderFinalStatus = "LowEndDevice"
+	PreloadPrerenderFinalStatusInvalidSchemeRedirect                                      PreloadPrerenderFinalStatus = "InvalidSchemeRedirect"
+	PreloadPrerenderFinalStatusInvalidSchemeNavigation                                    PreloadPrerenderFinalStatus = "InvalidSchemeNavigation"
+	PreloadPrerenderFinalStatusNavigationRequestBlockedByCsp                              PreloadPrerenderFinalStatus = "NavigationRequestBlockedByCsp"
+	PreloadPrerenderFinalStatusMojoBinderPolicy                                           PreloadPrerenderFinalStatus = "MojoBinderPolicy"
+	PreloadPrerenderFinalStatusRendererProcessCrashed                                     PreloadPrerenderFinalStatus = "RendererProcessCrashed"
+	PreloadPrerenderFinalStatusRendererProcessKilled                                      PreloadPrerenderFinalStatus = "RendererProcessKilled"
+	PreloadPrerenderFinalStatusDownload                                                   PreloadPrerenderFinalStatus = "Download"
+	PreloadPrerenderFinalStatusTriggerDestroyed                                           PreloadPrerenderFinalStatus = "TriggerDestroyed"
+	PreloadPrerenderFinalStatusNavigationNotCommitted                                     PreloadPrerenderFinalStatus = "NavigationNotCommitted"
+	PreloadPrerenderFinalStatusNavigationBadHTTPStatus                                    PreloadPrerenderFinalStatus = "NavigationBadHttpStatus"
+	PreloadPrerenderFinalStatusClientCertRequested                                        PreloadPrerenderFinalStatus = "ClientCertRequested"
+	PreloadPrerenderFinalStatusNavigationRequestNetworkError                              PreloadPrerenderFinalStatus = "NavigationRequestNetworkError"
+	PreloadPrerenderFinalStatusCancelAllHostsForTesting                                   PreloadPrerenderFinalStatus = "CancelAllHostsForTesting"
+	PreloadPrerenderFinalStatusDidFailLoad                                                PreloadPrerenderFinalStatus = "DidFailLoad"
+	PreloadPrerenderFinalStatusStop                                                       PreloadPrerenderFinalStatus = "Stop"
+	PreloadPrerenderFinalStatusSslCertificateError                                        PreloadPrerenderFinalStatus = "SslCertificateError"
+	PreloadPrerenderFinalStatusLoginAuthRequested                                         PreloadPrerenderFinalStatus = "LoginAuthRequested"
+	PreloadPrerenderFinalStatusUaChangeRequiresReload                                     PreloadPrerenderFinalStatus = "UaChangeRequiresReload"
+	PreloadPrerenderFinalStatusBlockedByClient                                            PreloadPrerenderFinalStatus = "BlockedByClient"
+	PreloadPrerenderFinalStatusAudioOutputDeviceRequested                                 PreloadPrerenderFinalStatus = "AudioOutputDeviceRequested"
+	PreloadPrerenderFinalStatusMixedContent                                               PreloadPrerenderFinalStatus = "MixedContent"
+	PreloadPrerenderFinalStatusTriggerBackgrounded                                        PreloadPrerenderFinalStatus = "TriggerBackgrounded"
+	PreloadPrerenderFinalStatusMemoryLimitExceeded                                        PreloadPrerenderFinalStatus = "MemoryLimitExceeded"
+	PreloadPrerenderFinalStatusDataSaverEnabled                                           PreloadPrerenderFinalStatus = "DataSaverEnabled"
+	PreloadPrerenderFinalStatusTriggerURLHasEffectiveURL                                  PreloadPrerenderFinalStatus = "TriggerUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusActivatedBeforeStarted                                     PreloadPrerenderFinalStatus = "ActivatedBeforeStarted"
+	PreloadPrerenderFinalStatusInactivePageRestriction                                    PreloadPrerenderFinalStatus = "InactivePageRestriction"
+	PreloadPrerenderFinalStatusStartFailed                                                PreloadPrerenderFinalStatus = "StartFailed"
+	PreloadPrerenderFinalStatusTimeoutBackgrounded                                        PreloadPrerenderFinalStatus = "TimeoutBackgrounded"
+	PreloadPrerenderFinalStatusCrossSiteRedirectInInitialNavigation                       PreloadPrerenderFinalStatus = "CrossSiteRedirectInInitialNavigation"
+	PreloadPrerenderFinalStatusCrossSiteNavigationInInitialNavigation                     PreloadPrerenderFinalStatus = "CrossSiteNavigationInInitialNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation     PreloadPrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInInitialNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation   PreloadPrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInInitialNavigation"
+	PreloadPrerenderFinalStatusActivationNavigationParameterMismatch                      PreloadPrerenderFinalStatus = "ActivationNavigationParameterMismatch"
+	PreloadPrerenderFinalStatusActivatedInBackground                                      PreloadPrerenderFinalStatus = "ActivatedInBackground"
+	PreloadPrerenderFinalStatusEmbedderHostDisallowed                                     PreloadPrerenderFinalStatus = "EmbedderHostDisallowed"
+	PreloadPrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess                 PreloadPrerenderFinalStatus = "ActivationNavigationDestroyedBeforeSuccess"
+	PreloadPrerenderFinalStatusTabClosedByUserGesture                                     PreloadPrerenderFinalStatus = "TabClosedByUserGesture"
+	PreloadPrerenderFinalStatusTabClosedWithoutUserGesture                                PreloadPrerenderFinalStatus = "TabClosedWithoutUserGesture"
+	PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed                     PreloadPrerenderFinalStatus = "PrimaryMainFrameRendererProcessCrashed"
+	PreloadPrerenderFinalStatusPrimaryMainFrameRendererProcessKilled                      PreloadPrerenderFinalStatus = "PrimaryMainFrameRendererProcessKilled"
+	PreloadPrerenderFinalStatusActivationFramePolicyNotCompatible                         PreloadPrerenderFinalStatus = "ActivationFramePolicyNotCompatible"
+	PreloadPrerenderFinalStatusPreloadingDisabled                                         PreloadPrerenderFinalStatus = "PreloadingDisabled"
+	PreloadPrerenderFinalStatusBatterySaverEnabled                                        PreloadPrerenderFinalStatus = "BatterySaverEnabled"
+	PreloadPrerenderFinalStatusActivatedDuringMainFrameNavigation                         PreloadPrerenderFinalStatus = "ActivatedDuringMainFrameNavigation"
+	PreloadPrerenderFinalStatusPreloadingUnsupportedByWebContents                         PreloadPrerenderFinalStatus = "PreloadingUnsupportedByWebContents"
+	PreloadPrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation                     PreloadPrerenderFinalStatus = "CrossSiteRedirectInMainFrameNavigation"
+	PreloadPrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation                   PreloadPrerenderFinalStatus = "CrossSiteNavigationInMainFrameNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   PreloadPrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"
+	PreloadPrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation PreloadPrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"
+	PreloadPrerenderFinalStatusMemoryPressureOnTrigger                                    PreloadPrerenderFinalStatus = "MemoryPressureOnTrigger"
+	PreloadPrerenderFinalStatusMemoryPressureAfterTriggered                               PreloadPrerenderFinalStatus = "MemoryPressureAfterTriggered"
+	PreloadPrerenderFinalStatusPrerenderingDisabledByDevTools                             PreloadPrerenderFinalStatus = "PrerenderingDisabledByDevTools"
+	PreloadPrerenderFinalStatusSpeculationRuleRemoved                                     PreloadPrerenderFinalStatus = "SpeculationRuleRemoved"
+	PreloadPrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts                     PreloadPrerenderFinalStatus = "ActivatedWithAuxiliaryBrowsingContexts"
+	PreloadPrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded                     PreloadPrerenderFinalStatus = "MaxNumOfRunningEagerPrerendersExceeded"
+	PreloadPrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded                  PreloadPrerenderFinalStatus = "MaxNumOfRunningNonEagerPrerendersExceeded"
+	PreloadPrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded                  PreloadPrerenderFinalStatus = "MaxNumOfRunningEmbedderPrerendersExceeded"
+	PreloadPrerenderFinalStatusPrerenderingURLHasEffectiveURL                             PreloadPrerenderFinalStatus = "PrerenderingUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL                   PreloadPrerenderFinalStatus = "RedirectedPrerenderingUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusActivationURLHasEffectiveURL                               PreloadPrerenderFinalStatus = "ActivationUrlHasEffectiveUrl"
+	PreloadPrerenderFinalStatusJavaScriptInterfaceAdded                                   PreloadPrerenderFinalStatus = "JavaScriptInterfaceAdded"
+	PreloadPrerenderFinalStatusJavaScriptInterfaceRemoved                                 PreloadPrerenderFinalStatus = "JavaScriptInterfaceRemoved"
+	PreloadPrerenderFinalStatusAllPrerenderingCanceled                                    PreloadPrerenderFinalStatus = "AllPrerenderingCanceled"
+	PreloadPrerenderFinalStatusWindowClosed                                               PreloadPrerenderFinalStatus = "WindowClosed"
+	PreloadPrerenderFinalStatusSlowNetwork                                                PreloadPrerenderFinalStatus = "SlowNetwork"
+	PreloadPrerenderFinalStatusOtherPrerenderedPageActivated                              PreloadPrerenderFinalStatus = "OtherPrerenderedPageActivated"
+	PreloadPrerenderFinalStatusV8OptimizerDisabled                                        PreloadPrerenderFinalStatus = "V8OptimizerDisabled"
+	PreloadPrerenderFinalStatusPrerenderFailedDuringPrefetch                              PreloadPrerenderFinalStatus = "PrerenderFailedDuringPrefetch"
+	PreloadPrerenderFinalStatusBrowsingDataRemoved                                        PreloadPrerenderFinalStatus = "BrowsingDataRemoved"
+	PreloadPrerenderFinalStatusPrerenderHostReused                                        PreloadPrerenderFinalStatus = "PrerenderHostReused"
+)
+
+// PreloadPreloadingStatus https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PreloadingStatus
+type PreloadPreloadingStatus string
+
+// PreloadPreloadingStatus values
+const (
+	PreloadPreloadingStatusPending      PreloadPreloadingStatus = "Pending"
+	PreloadPreloadingStatusRunning      PreloadPreloadingStatus = "Running"
+	PreloadPreloadingStatusReady        PreloadPreloadingStatus = "Ready"
+	PreloadPreloadingStatusSuccess      PreloadPreloadingStatus = "Success"
+	PreloadPreloadingStatusFailure      PreloadPreloadingStatus = "Failure"
+	PreloadPreloadingStatusNotSupported PreloadPreloadingStatus = "NotSupported"
+)
+
+// PreloadPrefetchStatus https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrefetchStatus
+type PreloadPrefetchStatus string
+
+// PreloadPrefetchStatus values
+const (
+	PreloadPrefetchStatusPrefetchAllowed                                             PreloadPrefetchStatus = "PrefetchAllowed"
+	PreloadPrefetchStatusPrefetchFailedIneligibleRedirect                            PreloadPrefetchStatus = "PrefetchFailedIneligibleRedirect"
+	PreloadPrefetchStatusPrefetchFailedInvalidRedirect                               PreloadPrefetchStatus = "PrefetchFailedInvalidRedirect"
+	PreloadPrefetchStatusPrefetchFailedMIMENotSupported                              PreloadPrefetchStatus = "PrefetchFailedMIMENotSupported"
+	PreloadPrefetchStatusPrefetchFailedNetError                                      PreloadPrefetchStatus = "PrefetchFailedNetError"
+	PreloadPrefetchStatusPrefetchFailedNon2XX                                        PreloadPrefetchStatus = "PrefetchFailedNon2XX"
+	PreloadPrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved                     PreloadPrefetchStatus = "PrefetchEvictedAfterBrowsingDataRemoved"
+	PreloadPrefetchStatusPrefetchEvictedAfterCandidateRemoved                        PreloadPrefetchStatus = "PrefetchEvictedAfterCandidateRemoved"
+	PreloadPrefetchStatusPrefetchEvictedForNewerPrefetch                             PreloadPrefetchStatus = "PrefetchEvictedForNewerPrefetch"
+	PreloadPrefetchStatusPrefetchHeldback                                            PreloadPrefetchStatus = "PrefetchHeldback"
+	PreloadPrefetchStatusPrefetchIneligibleRetryAfter                                PreloadPrefetchStatus = "PrefetchIneligibleRetryAfter"
+	PreloadPrefetchStatusPrefetchIsPrivacyDecoy                                      PreloadPrefetchStatus = "PrefetchIsPrivacyDecoy"
+	PreloadPrefetchStatusPrefetchIsStale                                             PreloadPrefetchStatus = "PrefetchIsStale"
+	PreloadPrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord               PreloadPrefetchStatus = "PrefetchNotEligibleBrowserContextOffTheRecord"
+	PreloadPrefetchStatusPrefetchNotEligibleDataSaverEnabled                         PreloadPrefetchStatus = "PrefetchNotEligibleDataSaverEnabled"
+	PreloadPrefetchStatusPrefetchNotEligibleExistingProxy                            PreloadPrefetchStatus = "PrefetchNotEligibleExistingProxy"
+	PreloadPrefetchStatusPrefetchNotEligibleHostIsNonUnique                          PreloadPrefetchStatus = "PrefetchNotEligibleHostIsNonUnique"
+	PreloadPrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition               PreloadPrefetchStatus = "PrefetchNotEligibleNonDefaultStoragePartition"
+	PreloadPrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy PreloadPrefetchStatus = "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"
+	PreloadPrefetchStatusPrefetchNotEligibleSchemeIsNotHTTPS                         PreloadPrefetchStatus = "PrefetchNotEligibleSchemeIsNotHttps"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasCookies                           PreloadPrefetchStatus = "PrefetchNotEligibleUserHasCookies"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorker                     PreloadPrefetchStatus = "PrefetchNotEligibleUserHasServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler       PreloadPrefetchStatus = "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"
+	PreloadPrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker                PreloadPrefetchStatus = "PrefetchNotEligibleRedirectFromServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleRedirectToServiceWorker                  PreloadPrefetchStatus = "PrefetchNotEligibleRedirectToServiceWorker"
+	PreloadPrefetchStatusPrefetchNotEligibleBatterySaverEnabled                      PreloadPrefetchStatus = "PrefetchNotEligibleBatterySaverEnabled"
+	PreloadPrefetchStatusPrefetchNotEligiblePreloadingDisabled                       PreloadPrefetchStatus = "PrefetchNotEligiblePreloadingDisabled"
+	PreloadPrefetchStatusPrefetchNotFinishedInTime                                   PreloadPrefetchStatus = "PrefetchNotFinishedInTime"
+	PreloadPrefetchStatusPrefetchNotStarted                                          PreloadPrefetchStatus = "PrefetchNotStarted"
+	PreloadPrefetchStatusPrefetchNotUsedCookiesChanged                               PreloadPrefetchStatus = "PrefetchNotUsedCookiesChanged"
+	PreloadPrefetchStatusPrefetchProxyNotAvailable                                   PreloadPrefetchStatus = "PrefetchProxyNotAvailable"
+	PreloadPrefetchStatusPrefetchResponseUsed                                        PreloadPrefetchStatus = "PrefetchResponseUsed"
+	PreloadPrefetchStatusPrefetchSuccessfulButNotUsed                                PreloadPrefetchStatus = "PrefetchSuccessfulButNotUsed"
+	PreloadPrefetchStatusPrefetchNotUsedProbeFailed                                  PreloadPrefetchStatus = "PrefetchNotUsedProbeFailed"
+)
+
+// PreloadPrerenderMismatchedHeaders https://chromedevtools.github.io/devtools-protocol/tot/Preload#type-PrerenderMismatchedHeaders
+type PreloadPrerenderMismatchedHeaders struct {
+	HeaderName      string `json:"headerName"`
+	InitialValue    string `json:"initialValue,omitempty"`
+	ActivationValue string `json:"activationValue,omitempty"`
+}
+
+// PreloadEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Preload#method-enable
+type PreloadEnableParams struct {
+}
+
+// ProtocolMethod returns Preload.enable
+func (*PreloadEnableParams) ProtocolMethod() string {
+	return "Preload.enable"
+}
+
+// Do sends Preload.enable
+func (p *PreloadEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PreloadDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Preload#method-disable
+type PreloadDisableParams struct {
+}
+
+// ProtocolMethod returns Preload.disable
+func (*PreloadDisableParams) ProtocolMethod() string {
+	return "Preload.disable"
+}
+
+// Do sends Preload.disable
+func (p *PreloadDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PreloadRuleSetUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-ruleSetUpdated
+type PreloadRuleSetUpdatedEvent struct {
+	RuleSet *PreloadRuleSet `json:"ruleSet"`
+}
+
+// ProtocolMethod returns Preload.ruleSetUpdated
+func (*PreloadRuleSetUpdatedEvent) ProtocolMethod() string {
+	return "Preload.ruleSetUpdated"
+}
+
+// PreloadRuleSetRemovedEvent https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-ruleSetRemoved
+type PreloadRuleSetRemovedEvent struct {
+	ID PreloadRuleSetID `json:"id"`
+}
+
+// ProtocolMethod returns Preload.ruleSetRemoved
+func (*PreloadRuleSetRemovedEvent) ProtocolMethod() string {
+	return "Preload.ruleSetRemoved"
+}
+
+// PreloadPreloadEnabledStateUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-preloadEnabledStateUpdated
+type PreloadPreloadEnabledStateUpdatedEvent struct {
+	DisabledByPreference                        bool `json:"disabledByPreference"`
+	DisabledByDataSaver                         bool `json:"disabledByDataSaver"`
+	DisabledByBatterySaver                      bool `json:"disabledByBatterySaver"`
+	DisabledByHoldbackPrefetchSpeculationRules  bool `json:"disabledByHoldbackPrefetchSpeculationRules"`
+	DisabledByHoldbackPrerenderSpeculationRules bool `json:"disabledByHoldbackPrerenderSpeculationRules"`
+}
+
+// ProtocolMethod returns Preload.preloadEnabledStateUpdated
+func (*PreloadPreloadEnabledStateUpdatedEvent) ProtocolMethod() string {
+	return "Preload.preloadEnabledStateUpdated"
+}
+
+// PreloadPrefetchStatusUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-prefetchStatusUpdated
+type PreloadPrefetchStatusUpdatedEvent struct {
+	Key               *PreloadPreloadingAttemptKey `json:"key"`
+	PipelineID        PreloadPreloadPipelineID     `json:"pipelineId"`
+	InitiatingFrameID PageFrameID                  `json:"initiatingFrameId"`
+	PrefetchURL       string                       `json:"prefetchUrl"`
+	Status            PreloadPreloadingStatus      `json:"status"`
+	PrefetchStatus    PreloadPrefetchStatus        `json:"prefetchStatus"`
+	RequestID         NetworkRequestID             `json:"requestId"`
+}
+
+// ProtocolMethod returns Preload.prefetchStatusUpdated
+func (*PreloadPrefetchStatusUpdatedEvent) ProtocolMethod() string {
+	return "Preload.prefetchStatusUpdated"
+}
+
+// PreloadPrerenderStatusUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-prerenderStatusUpdated
+type PreloadPrerenderStatusUpdatedEvent struct {
+	Key                     *PreloadPreloadingAttemptKey         `json:"key"`
+	PipelineID              PreloadPreloadPipelineID             `json:"pipelineId"`
+	Status                  PreloadPreloadingStatus              `json:"status"`
+	PrerenderStatus         PreloadPrerenderFinalStatus          `json:"prerenderStatus,omitempty"`
+	DisallowedMojoInterface string                               `json:"disallowedMojoInterface,omitempty"`
+	MismatchedHeaders       []*PreloadPrerenderMismatchedHeaders `json:"mismatchedHeaders,omitempty"`
+}
+
+// ProtocolMethod returns Preload.prerenderStatusUpdated
+func (*PreloadPrerenderStatusUpdatedEvent) ProtocolMethod() string {
+	return "Preload.prerenderStatusUpdated"
+}
+
+// PreloadPreloadingAttemptSourcesUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Preload#event-preloadingAttemptSourcesUpdated
+type PreloadPreloadingAttemptSourcesUpdatedEvent struct {
+	LoaderID                 NetworkLoaderID                   `json:"loaderId"`
+	PreloadingAttemptSources []*PreloadPreloadingAttemptSource `json:"preloadingAttemptSources"`
+}
+
+// ProtocolMethod returns Preload.preloadingAttemptSourcesUpdated
+func (*PreloadPreloadingAttemptSourcesUpdatedEvent) ProtocolMethod() string {
+	return "Preload.preloadingAttemptSourcesUpdated"
+}
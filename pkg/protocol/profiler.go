@@ -0,0 +1,260 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Profiler events
+const (
+	EventProfilerConsoleProfileFinished     = "Profiler.consoleProfileFinished"
+	EventProfilerConsoleProfileStarted      = "Profiler.consoleProfileStarted"
+	EventProfilerPreciseCoverageDeltaUpdate = "Profiler.preciseCoverageDeltaUpdate"
+)
+
+// ProfilerProfileNode https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-ProfileNode
+type ProfilerProfileNode struct {
+	ID            int64                       `json:"id"`
+	CallFrame     *RuntimeCallFrame           `json:"callFrame"`
+	HitCount      int64                       `json:"hitCount,omitempty"`
+	Children      []int64                     `json:"children,omitempty"`
+	DeoptReason   string                      `json:"deoptReason,omitempty"`
+	PositionTicks []*ProfilerPositionTickInfo `json:"positionTicks,omitempty"`
+}
+
+// ProfilerProfile https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-Profile
+type ProfilerProfile struct {
+	Nodes      []*ProfilerProfileNode `json:"nodes"`
+	StartTime  float64                `json:"startTime"`
+	EndTime    float64                `json:"endTime"`
+	Samples    []int64                `json:"samples,omitempty"`
+	TimeDeltas []int64                `json:"timeDeltas,omitempty"`
+}
+
+// ProfilerPositionTickInfo https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-PositionTickInfo
+type ProfilerPositionTickInfo struct {
+	Line  int64 `json:"line"`
+	Ticks int64 `json:"ticks"`
+}
+
+// ProfilerCoverageRange https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-CoverageRange
+type ProfilerCoverageRange struct {
+	StartOffset int64 `json:"startOffset"`
+	EndOffset   int64 `json:"endOffset"`
+	Count       int64 `json:"count"`
+}
+
+// ProfilerFunctionCoverage https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-FunctionCoverage
+type ProfilerFunctionCoverage struct {
+	FunctionName    string                   `json:"functionName"`
+	Ranges          []*ProfilerCoverageRange `json:"ranges"`
+	IsBlockCoverage bool                     `json:"isBlockCoverage"`
+}
+
+// ProfilerScriptCoverage https://chromedevtools.github.io/devtools-protocol/tot/Profiler#type-ScriptCoverage
+type ProfilerScriptCoverage struct {
+	ScriptID  RuntimeScriptID             `json:"scriptId"`
+	URL       string                      `json:"url"`
+	Functions []*ProfilerFunctionCoverage `json:"functions"`
+}
+
+// ProfilerDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-disable
+type ProfilerDisableParams struct {
+}
+
+// ProtocolMethod returns Profiler.disable
+func (*ProfilerDisableParams) ProtocolMethod() string {
+	return "Profiler.disable"
+}
+
+// Do sends Profiler.disable
+func (p *ProfilerDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ProfilerEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-enable
+type ProfilerEnableParams struct {
+}
+
+// ProtocolMethod returns Profiler.enable
+func (*ProfilerEnableParams) ProtocolMethod() string {
+	return "Profiler.enable"
+}
+
+// Do sends Profiler.enable
+func (p *ProfilerEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ProfilerGetBestEffortCoverageParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-getBestEffortCoverage
+type ProfilerGetBestEffortCoverageParams struct {
+}
+
+// ProtocolMethod returns Profiler.getBestEffortCoverage
+func (*ProfilerGetBestEffortCoverageParams) ProtocolMethod() string {
+	return "Profiler.getBestEffortCoverage"
+}
+
+// ProfilerGetBestEffortCoverageResult https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-getBestEffortCoverage
+type ProfilerGetBestEffortCoverageResult struct {
+	Result []*ProfilerScriptCoverage `json:"result"`
+}
+
+// Do sends Profiler.getBestEffortCoverage and returns its result
+func (p *ProfilerGetBestEffortCoverageParams) Do(e Executor) (*ProfilerGetBestEffortCoverageResult, error) {
+	var result = new(ProfilerGetBestEffortCoverageResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ProfilerSetSamplingIntervalParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-setSamplingInterval
+type ProfilerSetSamplingIntervalParams struct {
+	Interval int64 `json:"interval"`
+}
+
+// ProtocolMethod returns Profiler.setSamplingInterval
+func (*ProfilerSetSamplingIntervalParams) ProtocolMethod() string {
+	return "Profiler.setSamplingInterval"
+}
+
+// Do sends Profiler.setSamplingInterval
+func (p *ProfilerSetSamplingIntervalParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ProfilerStartParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-start
+type ProfilerStartParams struct {
+}
+
+// ProtocolMethod returns Profiler.start
+func (*ProfilerStartParams) ProtocolMethod() string {
+	return "Profiler.start"
+}
+
+// Do sends Profiler.start
+func (p *ProfilerStartParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ProfilerStartPreciseCoverageParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-startPreciseCoverage
+type ProfilerStartPreciseCoverageParams struct {
+	CallCount             bool `json:"callCount,omitempty"`
+	Detailed              bool `json:"detailed,omitempty"`
+	AllowTriggeredUpdates bool `json:"allowTriggeredUpdates,omitempty"`
+}
+
+// ProtocolMethod returns Profiler.startPreciseCoverage
+func (*ProfilerStartPreciseCoverageParams) ProtocolMethod() string {
+	return "Profiler.startPreciseCoverage"
+}
+
+// ProfilerStartPreciseCoverageResult https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-startPreciseCoverage
+type ProfilerStartPreciseCoverageResult struct {
+	Timestamp float64 `json:"timestamp"`
+}
+
+// Do sends Profiler.startPreciseCoverage and returns its result
+func (p *ProfilerStartPreciseCoverageParams) Do(e Executor) (*ProfilerStartPreciseCoverageResult, error) {
+	var result = new(ProfilerStartPreciseCoverageResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ProfilerStopParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-stop
+type ProfilerStopParams struct {
+}
+
+// ProtocolMethod returns Profiler.stop
+func (*ProfilerStopParams) ProtocolMethod() string {
+	return "Profiler.stop"
+}
+
+// ProfilerStopResult https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-stop
+type ProfilerStopResult struct {
+	Profile *ProfilerProfile `json:"profile"`
+}
+
+// Do sends Profiler.stop and returns its result
+func (p *ProfilerStopParams) Do(e Executor) (*ProfilerStopResult, error) {
+	var result = new(ProfilerStopResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ProfilerStopPreciseCoverageParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-stopPreciseCoverage
+type ProfilerStopPreciseCoverageParams struct {
+}
+
+// ProtocolMethod returns Profiler.stopPreciseCoverage
+func (*ProfilerStopPreciseCoverageParams) ProtocolMethod() string {
+	return "Profiler.stopPreciseCoverage"
+}
+
+// Do sends Profiler.stopPreciseCoverage
+func (p *ProfilerStopPreciseCoverageParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ProfilerTakePreciseCoverageParams https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-takePreciseCoverage
+type ProfilerTakePreciseCoverageParams struct {
+}
+
+// ProtocolMethod returns Profiler.takePreciseCoverage
+func (*ProfilerTakePreciseCoverageParams) ProtocolMethod() string {
+	return "Profiler.takePreciseCoverage"
+}
+
+// ProfilerTakePreciseCoverageResult https://chromedevtools.github.io/devtools-protocol/tot/Profiler#method-takePreciseCoverage
+type ProfilerTakePreciseCoverageResult struct {
+	Result    []*ProfilerScriptCoverage `json:"result"`
+	Timestamp float64                   `json:"timestamp"`
+}
+
+// Do sends Profiler.takePreciseCoverage and returns its result
+func (p *ProfilerTakePreciseCoverageParams) Do(e Executor) (*ProfilerTakePreciseCoverageResult, error) {
+	var result = new(ProfilerTakePreciseCoverageResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ProfilerConsoleProfileFinishedEvent https://chromedevtools.github.io/devtools-protocol/tot/Profiler#event-consoleProfileFinished
+type ProfilerConsoleProfileFinishedEvent struct {
+	ID       string            `json:"id"`
+	Location *DebuggerLocation `json:"location"`
+	Profile  *ProfilerProfile  `json:"profile"`
+	Title    string            `json:"title,omitempty"`
+}
+
+// ProtocolMethod returns Profiler.consoleProfileFinished
+func (*ProfilerConsoleProfileFinishedEvent) ProtocolMethod() string {
+	return "Profiler.consoleProfileFinished"
+}
+
+// ProfilerConsoleProfileStartedEvent https://chromedevtools.github.io/devtools-protocol/tot/Profiler#event-consoleProfileStarted
+type ProfilerConsoleProfileStartedEvent struct {
+	ID       string            `json:"id"`
+	Location *DebuggerLocation `json:"location"`
+	Title    string            `json:"title,omitempty"`
+}
+
+// ProtocolMethod returns Profiler.consoleProfileStarted
+func (*ProfilerConsoleProfileStartedEvent) ProtocolMethod() string {
+	return "Profiler.consoleProfileStarted"
+}
+
+// ProfilerPreciseCoverageDeltaUpdateEvent https://chromedevtools.github.io/devtools-protocol/tot/Profiler#event-preciseCoverageDeltaUpdate
+type ProfilerPreciseCoverageDeltaUpdateEvent struct {
+	Timestamp float64                   `json:"timestamp"`
+	Occasion  string                    `json:"occasion"`
+	Result    []*ProfilerScriptCoverage `json:"result"`
+}
+
+// ProtocolMethod returns Profiler.preciseCoverageDeltaUpdate
+func (*ProfilerPreciseCoverageDeltaUpdateEvent) ProtocolMethod() string {
+	return "Profiler.preciseCoverageDeltaUpdate"
+}
@@ -0,0 +1,29 @@
+// Package protocol provides typed params, results, events and enums of devtools protocol.
+// Bindings are generated from definitions vendored in gen/json, run go generate to update them.
+package protocol
+
+//go:generate go run ./gen
+
+// Executor sends protocol commands, cdp.Session implements it
+type Executor interface {
+	Call(method string, params interface{}, result interface{}) error
+}
+
+// Command params of protocol command
+type Command interface {
+	ProtocolMethod() string
+}
+
+// Execute sends command and decodes its result into result, result can be nil
+func Execute(e Executor, params Command, result interface{}) error {
+	return e.Call(params.ProtocolMethod(), params, result)
+}
+
+// NewEvent returns pointer to new value of event's type, false if method is not known event
+func NewEvent(method string) (interface{}, bool) {
+	newValue, has := eventTypes[method]
+	if !has {
+		return nil, false
+	}
+	return newValue(), true
+}
@@ -0,0 +1,163 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// PWAFileHandlerAccept https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-FileHandlerAccept
+type PWAFileHandlerAccept struct {
+	MediaType      string   `json:"mediaType"`
+	FileExtensions []string `json:"fileExtensions"`
+}
+
+// PWAFileHandler https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-FileHandler
+type PWAFileHandler struct {
+	Action      string                  `json:"action"`
+	Accepts     []*PWAFileHandlerAccept `json:"accepts"`
+	DisplayName string                  `json:"displayName"`
+}
+
+// PWADisplayMode https://chromedevtools.github.io/devtools-protocol/tot/PWA#type-DisplayMode
+type PWADisplayMode string
+
+// PWADisplayMode values
+const (
+	PWADisplayModeStandalone PWADisplayMode = "standalone"
+	PWADisplayModeBrowser    PWADisplayMode = "browser"
+)
+
+// PWAGetOsAppStateParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-getOsAppState
+type PWAGetOsAppStateParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// ProtocolMethod returns PWA.getOsAppState
+func (*PWAGetOsAppStateParams) ProtocolMethod() string {
+	return "PWA.getOsAppState"
+}
+
+// PWAGetOsAppStateResult https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-getOsAppState
+type PWAGetOsAppStateResult struct {
+	BadgeCount   int64             `json:"badgeCount"`
+	FileHandlers []*PWAFileHandler `json:"fileHandlers"`
+}
+
+// Do sends PWA.getOsAppState and returns its result
+func (p *PWAGetOsAppStateParams) Do(e Executor) (*PWAGetOsAppStateResult, error) {
+	var result = new(PWAGetOsAppStateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PWAInstallParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-install
+type PWAInstallParams struct {
+	ManifestID            string `json:"manifestId"`
+	InstallURLOrBundleURL string `json:"installUrlOrBundleUrl,omitempty"`
+}
+
+// ProtocolMethod returns PWA.install
+func (*PWAInstallParams) ProtocolMethod() string {
+	return "PWA.install"
+}
+
+// Do sends PWA.install
+func (p *PWAInstallParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PWAUninstallParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-uninstall
+type PWAUninstallParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// ProtocolMethod returns PWA.uninstall
+func (*PWAUninstallParams) ProtocolMethod() string {
+	return "PWA.uninstall"
+}
+
+// Do sends PWA.uninstall
+func (p *PWAUninstallParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PWALaunchParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-launch
+type PWALaunchParams struct {
+	ManifestID string `json:"manifestId"`
+	URL        string `json:"url,omitempty"`
+}
+
+// ProtocolMethod returns PWA.launch
+func (*PWALaunchParams) ProtocolMethod() string {
+	return "PWA.launch"
+}
+
+// PWALaunchResult https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-launch
+type PWALaunchResult struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// Do sends PWA.launch and returns its result
+func (p *PWALaunchParams) Do(e Executor) (*PWALaunchResult, error) {
+	var result = new(PWALaunchResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PWALaunchFilesInAppParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-launchFilesInApp
+type PWALaunchFilesInAppParams struct {
+	ManifestID string   `json:"manifestId"`
+	Files      []string `json:"files"`
+}
+
+// ProtocolMethod returns PWA.launchFilesInApp
+func (*PWALaunchFilesInAppParams) ProtocolMethod() string {
+	return "PWA.launchFilesInApp"
+}
+
+// PWALaunchFilesInAppResult https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-launchFilesInApp
+type PWALaunchFilesInAppResult struct {
+	TargetIds []TargetTargetID `json:"targetIds"`
+}
+
+// Do sends PWA.launchFilesInApp and returns its result
+func (p *PWALaunchFilesInAppParams) Do(e Executor) (*PWALaunchFilesInAppResult, error) {
+	var result = new(PWALaunchFilesInAppResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PWAOpenCurrentPageInAppParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-openCurrentPageInApp
+type PWAOpenCurrentPageInAppParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+// ProtocolMethod returns PWA.openCurrentPageInApp
+func (*PWAOpenCurrentPageInAppParams) ProtocolMethod() string {
+	return "PWA.openCurrentPageInApp"
+}
+
+// Do sends PWA.openCurrentPageInApp
+func (p *PWAOpenCurrentPageInAppParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// PWAChangeAppUserSettingsParams https://chromedevtools.github.io/devtools-protocol/tot/PWA#method-changeAppUserSettings
+type PWAChangeAppUserSettingsParams struct {
+	ManifestID    string         `json:"manifestId"`
+	LinkCapturing bool           `json:"linkCapturing,omitempty"`
+	DisplayMode   PWADisplayMode `json:"displayMode,omitempty"`
+}
+
+// ProtocolMethod returns PWA.changeAppUserSettings
+func (*PWAChangeAppUserSettingsParams) ProtocolMethod() string {
+	return "PWA.changeAppUserSettings"
+}
+
+// Do sends PWA.changeAppUserSettings
+func (p *PWAChangeAppUserSettingsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
@@ -0,0 +1,941 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+import "encoding/json"
+
+// Runtime events
+const (
+	EventRuntimeBindingCalled             = "Runtime.bindingCalled"
+	EventRuntimeConsoleAPICalled          = "Runtime.consoleAPICalled"
+	EventRuntimeExceptionRevoked          = "Runtime.exceptionRevoked"
+	EventRuntimeExceptionThrown           = "Runtime.exceptionThrown"
+	EventRuntimeExecutionContextCreated   = "Runtime.executionContextCreated"
+	EventRuntimeExecutionContextDestroyed = "Runtime.executionContextDestroyed"
+	EventRuntimeExecutionContextsCleared  = "Runtime.executionContextsCleared"
+	EventRuntimeInspectRequested          = "Runtime.inspectRequested"
+)
+
+// RuntimeScriptID https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ScriptId
+type RuntimeScriptID string
+
+// RuntimeSerializationOptions https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-SerializationOptions
+type RuntimeSerializationOptions struct {
+	Serialization        RuntimeSerializationOptionsSerialization `json:"serialization"`
+	MaxDepth             int64                                    `json:"maxDepth,omitempty"`
+	AdditionalParameters map[string]interface{}                   `json:"additionalParameters,omitempty"`
+}
+
+// RuntimeDeepSerializedValue https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-DeepSerializedValue
+type RuntimeDeepSerializedValue struct {
+	Type                     RuntimeDeepSerializedValueType `json:"type"`
+	Value                    json.RawMessage                `json:"value,omitempty"`
+	ObjectID                 string                         `json:"objectId,omitempty"`
+	WeakLocalObjectReference int64                          `json:"weakLocalObjectReference,omitempty"`
+}
+
+// RuntimeRemoteObjectID https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-RemoteObjectId
+type RuntimeRemoteObjectID string
+
+// RuntimeUnserializableValue https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-UnserializableValue
+type RuntimeUnserializableValue string
+
+// RuntimeRemoteObject https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-RemoteObject
+type RuntimeRemoteObject struct {
+	Type                RuntimeRemoteObjectType     `json:"type"`
+	Subtype             RuntimeRemoteObjectSubtype  `json:"subtype,omitempty"`
+	ClassName           string                      `json:"className,omitempty"`
+	Value               json.RawMessage             `json:"value,omitempty"`
+	UnserializableValue RuntimeUnserializableValue  `json:"unserializableValue,omitempty"`
+	Description         string                      `json:"description,omitempty"`
+	DeepSerializedValue *RuntimeDeepSerializedValue `json:"deepSerializedValue,omitempty"`
+	ObjectID            RuntimeRemoteObjectID       `json:"objectId,omitempty"`
+	Preview             *RuntimeObjectPreview       `json:"preview,omitempty"`
+	CustomPreview       *RuntimeCustomPreview       `json:"customPreview,omitempty"`
+}
+
+// RuntimeCustomPreview https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CustomPreview
+type RuntimeCustomPreview struct {
+	Header       string                `json:"header"`
+	BodyGetterID RuntimeRemoteObjectID `json:"bodyGetterId,omitempty"`
+}
+
+// RuntimeObjectPreview https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ObjectPreview
+type RuntimeObjectPreview struct {
+	Type        RuntimeObjectPreviewType    `json:"type"`
+	Subtype     RuntimeObjectPreviewSubtype `json:"subtype,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Overflow    bool                        `json:"overflow"`
+	Properties  []*RuntimePropertyPreview   `json:"properties"`
+	Entries     []*RuntimeEntryPreview      `json:"entries,omitempty"`
+}
+
+// RuntimePropertyPreview https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PropertyPreview
+type RuntimePropertyPreview struct {
+	Name         string                        `json:"name"`
+	Type         RuntimePropertyPreviewType    `json:"type"`
+	Value        string                        `json:"value,omitempty"`
+	ValuePreview *RuntimeObjectPreview         `json:"valuePreview,omitempty"`
+	Subtype      RuntimePropertyPreviewSubtype `json:"subtype,omitempty"`
+}
+
+// RuntimeEntryPreview https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-EntryPreview
+type RuntimeEntryPreview struct {
+	Key   *RuntimeObjectPreview `json:"key,omitempty"`
+	Value *RuntimeObjectPreview `json:"value"`
+}
+
+// RuntimePropertyDescriptor https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PropertyDescriptor
+type RuntimePropertyDescriptor struct {
+	Name         string               `json:"name"`
+	Value        *RuntimeRemoteObject `json:"value,omitempty"`
+	Writable     bool                 `json:"writable,omitempty"`
+	Get          *RuntimeRemoteObject `json:"get,omitempty"`
+	Set          *RuntimeRemoteObject `json:"set,omitempty"`
+	Configurable bool                 `json:"configurable"`
+	Enumerable   bool                 `json:"enumerable"`
+	WasThrown    bool                 `json:"wasThrown,omitempty"`
+	IsOwn        bool                 `json:"isOwn,omitempty"`
+	Symbol       *RuntimeRemoteObject `json:"symbol,omitempty"`
+}
+
+// RuntimeInternalPropertyDescriptor https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-InternalPropertyDescriptor
+type RuntimeInternalPropertyDescriptor struct {
+	Name  string               `json:"name"`
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+}
+
+// RuntimePrivatePropertyDescriptor https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-PrivatePropertyDescriptor
+type RuntimePrivatePropertyDescriptor struct {
+	Name  string               `json:"name"`
+	Value *RuntimeRemoteObject `json:"value,omitempty"`
+	Get   *RuntimeRemoteObject `json:"get,omitempty"`
+	Set   *RuntimeRemoteObject `json:"set,omitempty"`
+}
+
+// RuntimeCallArgument https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CallArgument
+type RuntimeCallArgument struct {
+	Value               json.RawMessage            `json:"value,omitempty"`
+	UnserializableValue RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	ObjectID            RuntimeRemoteObjectID      `json:"objectId,omitempty"`
+}
+
+// RuntimeExecutionContextID https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExecutionContextId
+type RuntimeExecutionContextID int64
+
+// RuntimeExecutionContextDescription https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExecutionContextDescription
+type RuntimeExecutionContextDescription struct {
+	ID       RuntimeExecutionContextID `json:"id"`
+	Origin   string                    `json:"origin"`
+	Name     string                    `json:"name"`
+	UniqueID string                    `json:"uniqueId"`
+	AuxData  map[string]interface{}    `json:"auxData,omitempty"`
+}
+
+// RuntimeExceptionDetails https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-ExceptionDetails
+type RuntimeExceptionDetails struct {
+	ExceptionID        int64                     `json:"exceptionId"`
+	Text               string                    `json:"text"`
+	LineNumber         int64                     `json:"lineNumber"`
+	ColumnNumber       int64                     `json:"columnNumber"`
+	ScriptID           RuntimeScriptID           `json:"scriptId,omitempty"`
+	URL                string                    `json:"url,omitempty"`
+	StackTrace         *RuntimeStackTrace        `json:"stackTrace,omitempty"`
+	Exception          *RuntimeRemoteObject      `json:"exception,omitempty"`
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	ExceptionMetaData  map[string]interface{}    `json:"exceptionMetaData,omitempty"`
+}
+
+// RuntimeTimestamp https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-Timestamp
+type RuntimeTimestamp float64
+
+// RuntimeTimeDelta https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-TimeDelta
+type RuntimeTimeDelta float64
+
+// RuntimeCallFrame https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-CallFrame
+type RuntimeCallFrame struct {
+	FunctionName string          `json:"functionName"`
+	ScriptID     RuntimeScriptID `json:"scriptId"`
+	URL          string          `json:"url"`
+	LineNumber   int64           `json:"lineNumber"`
+	ColumnNumber int64           `json:"columnNumber"`
+}
+
+// RuntimeStackTrace https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-StackTrace
+type RuntimeStackTrace struct {
+	Description string               `json:"description,omitempty"`
+	CallFrames  []*RuntimeCallFrame  `json:"callFrames"`
+	Parent      *RuntimeStackTrace   `json:"parent,omitempty"`
+	ParentID    *RuntimeStackTraceID `json:"parentId,omitempty"`
+}
+
+// RuntimeUniqueDebuggerID https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-UniqueDebuggerId
+type RuntimeUniqueDebuggerID string
+
+// RuntimeStackTraceID https://chromedevtools.github.io/devtools-protocol/tot/Runtime#type-StackTraceId
+type RuntimeStackTraceID struct {
+	ID         string                  `json:"id"`
+	DebuggerID RuntimeUniqueDebuggerID `json:"debuggerId,omitempty"`
+}
+
+// RuntimeAwaitPromiseParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-awaitPromise
+type RuntimeAwaitPromiseParams struct {
+	PromiseObjectID RuntimeRemoteObjectID `json:"promiseObjectId"`
+	ReturnByValue   bool                  `json:"returnByValue,omitempty"`
+	GeneratePreview bool                  `json:"generatePreview,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.awaitPromise
+func (*RuntimeAwaitPromiseParams) ProtocolMethod() string {
+	return "Runtime.awaitPromise"
+}
+
+// RuntimeAwaitPromiseResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-awaitPromise
+type RuntimeAwaitPromiseResult struct {
+	Result           *RuntimeRemoteObject     `json:"result"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.awaitPromise and returns its result
+func (p *RuntimeAwaitPromiseParams) Do(e Executor) (*RuntimeAwaitPromiseResult, error) {
+	var result = new(RuntimeAwaitPromiseResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeCallFunctionOnParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-callFunctionOn
+type RuntimeCallFunctionOnParams struct {
+	FunctionDeclaration  string                       `json:"functionDeclaration"`
+	ObjectID             RuntimeRemoteObjectID        `json:"objectId,omitempty"`
+	Arguments            []*RuntimeCallArgument       `json:"arguments,omitempty"`
+	Silent               bool                         `json:"silent,omitempty"`
+	ReturnByValue        bool                         `json:"returnByValue,omitempty"`
+	GeneratePreview      bool                         `json:"generatePreview,omitempty"`
+	UserGesture          bool                         `json:"userGesture,omitempty"`
+	AwaitPromise         bool                         `json:"awaitPromise,omitempty"`
+	ExecutionContextID   RuntimeExecutionContextID    `json:"executionContextId,omitempty"`
+	ObjectGroup          string                       `json:"objectGroup,omitempty"`
+	ThrowOnSideEffect    bool                         `json:"throwOnSideEffect,omitempty"`
+	UniqueContextID      string                       `json:"uniqueContextId,omitempty"`
+	SerializationOptions *RuntimeSerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.callFunctionOn
+func (*RuntimeCallFunctionOnParams) ProtocolMethod() string {
+	return "Runtime.callFunctionOn"
+}
+
+// RuntimeCallFunctionOnResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-callFunctionOn
+type RuntimeCallFunctionOnResult struct {
+	Result           *RuntimeRemoteObject     `json:"result"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.callFunctionOn and returns its result
+func (p *RuntimeCallFunctionOnParams) Do(e Executor) (*RuntimeCallFunctionOnResult, error) {
+	var result = new(RuntimeCallFunctionOnResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeCompileScriptParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-compileScript
+type RuntimeCompileScriptParams struct {
+	Expression         string                    `json:"expression"`
+	SourceURL          string                    `json:"sourceURL"`
+	PersistScript      bool                      `json:"persistScript"`
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.compileScript
+func (*RuntimeCompileScriptParams) ProtocolMethod() string {
+	return "Runtime.compileScript"
+}
+
+// RuntimeCompileScriptResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-compileScript
+type RuntimeCompileScriptResult struct {
+	ScriptID         RuntimeScriptID          `json:"scriptId,omitempty"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.compileScript and returns its result
+func (p *RuntimeCompileScriptParams) Do(e Executor) (*RuntimeCompileScriptResult, error) {
+	var result = new(RuntimeCompileScriptResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-disable
+type RuntimeDisableParams struct {
+}
+
+// ProtocolMethod returns Runtime.disable
+func (*RuntimeDisableParams) ProtocolMethod() string {
+	return "Runtime.disable"
+}
+
+// Do sends Runtime.disable
+func (p *RuntimeDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeDiscardConsoleEntriesParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-discardConsoleEntries
+type RuntimeDiscardConsoleEntriesParams struct {
+}
+
+// ProtocolMethod returns Runtime.discardConsoleEntries
+func (*RuntimeDiscardConsoleEntriesParams) ProtocolMethod() string {
+	return "Runtime.discardConsoleEntries"
+}
+
+// Do sends Runtime.discardConsoleEntries
+func (p *RuntimeDiscardConsoleEntriesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-enable
+type RuntimeEnableParams struct {
+}
+
+// ProtocolMethod returns Runtime.enable
+func (*RuntimeEnableParams) ProtocolMethod() string {
+	return "Runtime.enable"
+}
+
+// Do sends Runtime.enable
+func (p *RuntimeEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeEvaluateParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-evaluate
+type RuntimeEvaluateParams struct {
+	Expression                  string                       `json:"expression"`
+	ObjectGroup                 string                       `json:"objectGroup,omitempty"`
+	IncludeCommandLineAPI       bool                         `json:"includeCommandLineAPI,omitempty"`
+	Silent                      bool                         `json:"silent,omitempty"`
+	ContextID                   RuntimeExecutionContextID    `json:"contextId,omitempty"`
+	ReturnByValue               bool                         `json:"returnByValue,omitempty"`
+	GeneratePreview             bool                         `json:"generatePreview,omitempty"`
+	UserGesture                 bool                         `json:"userGesture,omitempty"`
+	AwaitPromise                bool                         `json:"awaitPromise,omitempty"`
+	ThrowOnSideEffect           bool                         `json:"throwOnSideEffect,omitempty"`
+	Timeout                     RuntimeTimeDelta             `json:"timeout,omitempty"`
+	DisableBreaks               bool                         `json:"disableBreaks,omitempty"`
+	ReplMode                    bool                         `json:"replMode,omitempty"`
+	AllowUnsafeEvalBlockedByCSP bool                         `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	UniqueContextID             string                       `json:"uniqueContextId,omitempty"`
+	SerializationOptions        *RuntimeSerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.evaluate
+func (*RuntimeEvaluateParams) ProtocolMethod() string {
+	return "Runtime.evaluate"
+}
+
+// RuntimeEvaluateResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-evaluate
+type RuntimeEvaluateResult struct {
+	Result           *RuntimeRemoteObject     `json:"result"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.evaluate and returns its result
+func (p *RuntimeEvaluateParams) Do(e Executor) (*RuntimeEvaluateResult, error) {
+	var result = new(RuntimeEvaluateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeGetIsolateIDParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getIsolateId
+type RuntimeGetIsolateIDParams struct {
+}
+
+// ProtocolMethod returns Runtime.getIsolateId
+func (*RuntimeGetIsolateIDParams) ProtocolMethod() string {
+	return "Runtime.getIsolateId"
+}
+
+// RuntimeGetIsolateIDResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getIsolateId
+type RuntimeGetIsolateIDResult struct {
+	ID string `json:"id"`
+}
+
+// Do sends Runtime.getIsolateId and returns its result
+func (p *RuntimeGetIsolateIDParams) Do(e Executor) (*RuntimeGetIsolateIDResult, error) {
+	var result = new(RuntimeGetIsolateIDResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeGetHeapUsageParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getHeapUsage
+type RuntimeGetHeapUsageParams struct {
+}
+
+// ProtocolMethod returns Runtime.getHeapUsage
+func (*RuntimeGetHeapUsageParams) ProtocolMethod() string {
+	return "Runtime.getHeapUsage"
+}
+
+// RuntimeGetHeapUsageResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getHeapUsage
+type RuntimeGetHeapUsageResult struct {
+	UsedSize             float64 `json:"usedSize"`
+	TotalSize            float64 `json:"totalSize"`
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"`
+	BackingStorageSize   float64 `json:"backingStorageSize"`
+}
+
+// Do sends Runtime.getHeapUsage and returns its result
+func (p *RuntimeGetHeapUsageParams) Do(e Executor) (*RuntimeGetHeapUsageResult, error) {
+	var result = new(RuntimeGetHeapUsageResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeGetPropertiesParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getProperties
+type RuntimeGetPropertiesParams struct {
+	ObjectID                 RuntimeRemoteObjectID `json:"objectId"`
+	OwnProperties            bool                  `json:"ownProperties,omitempty"`
+	AccessorPropertiesOnly   bool                  `json:"accessorPropertiesOnly,omitempty"`
+	GeneratePreview          bool                  `json:"generatePreview,omitempty"`
+	NonIndexedPropertiesOnly bool                  `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.getProperties
+func (*RuntimeGetPropertiesParams) ProtocolMethod() string {
+	return "Runtime.getProperties"
+}
+
+// RuntimeGetPropertiesResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getProperties
+type RuntimeGetPropertiesResult struct {
+	Result             []*RuntimePropertyDescriptor         `json:"result"`
+	InternalProperties []*RuntimeInternalPropertyDescriptor `json:"internalProperties,omitempty"`
+	PrivateProperties  []*RuntimePrivatePropertyDescriptor  `json:"privateProperties,omitempty"`
+	ExceptionDetails   *RuntimeExceptionDetails             `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.getProperties and returns its result
+func (p *RuntimeGetPropertiesParams) Do(e Executor) (*RuntimeGetPropertiesResult, error) {
+	var result = new(RuntimeGetPropertiesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeGlobalLexicalScopeNamesParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-globalLexicalScopeNames
+type RuntimeGlobalLexicalScopeNamesParams struct {
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.globalLexicalScopeNames
+func (*RuntimeGlobalLexicalScopeNamesParams) ProtocolMethod() string {
+	return "Runtime.globalLexicalScopeNames"
+}
+
+// RuntimeGlobalLexicalScopeNamesResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-globalLexicalScopeNames
+type RuntimeGlobalLexicalScopeNamesResult struct {
+	Names []string `json:"names"`
+}
+
+// Do sends Runtime.globalLexicalScopeNames and returns its result
+func (p *RuntimeGlobalLexicalScopeNamesParams) Do(e Executor) (*RuntimeGlobalLexicalScopeNamesResult, error) {
+	var result = new(RuntimeGlobalLexicalScopeNamesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeQueryObjectsParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-queryObjects
+type RuntimeQueryObjectsParams struct {
+	PrototypeObjectID RuntimeRemoteObjectID `json:"prototypeObjectId"`
+	ObjectGroup       string                `json:"objectGroup,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.queryObjects
+func (*RuntimeQueryObjectsParams) ProtocolMethod() string {
+	return "Runtime.queryObjects"
+}
+
+// RuntimeQueryObjectsResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-queryObjects
+type RuntimeQueryObjectsResult struct {
+	Objects *RuntimeRemoteObject `json:"objects"`
+}
+
+// Do sends Runtime.queryObjects and returns its result
+func (p *RuntimeQueryObjectsParams) Do(e Executor) (*RuntimeQueryObjectsResult, error) {
+	var result = new(RuntimeQueryObjectsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeReleaseObjectParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-releaseObject
+type RuntimeReleaseObjectParams struct {
+	ObjectID RuntimeRemoteObjectID `json:"objectId"`
+}
+
+// ProtocolMethod returns Runtime.releaseObject
+func (*RuntimeReleaseObjectParams) ProtocolMethod() string {
+	return "Runtime.releaseObject"
+}
+
+// Do sends Runtime.releaseObject
+func (p *RuntimeReleaseObjectParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeReleaseObjectGroupParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-releaseObjectGroup
+type RuntimeReleaseObjectGroupParams struct {
+	ObjectGroup string `json:"objectGroup"`
+}
+
+// ProtocolMethod returns Runtime.releaseObjectGroup
+func (*RuntimeReleaseObjectGroupParams) ProtocolMethod() string {
+	return "Runtime.releaseObjectGroup"
+}
+
+// Do sends Runtime.releaseObjectGroup
+func (p *RuntimeReleaseObjectGroupParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeRunIfWaitingForDebuggerParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-runIfWaitingForDebugger
+type RuntimeRunIfWaitingForDebuggerParams struct {
+}
+
+// ProtocolMethod returns Runtime.runIfWaitingForDebugger
+func (*RuntimeRunIfWaitingForDebuggerParams) ProtocolMethod() string {
+	return "Runtime.runIfWaitingForDebugger"
+}
+
+// Do sends Runtime.runIfWaitingForDebugger
+func (p *RuntimeRunIfWaitingForDebuggerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeRunScriptParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-runScript
+type RuntimeRunScriptParams struct {
+	ScriptID              RuntimeScriptID           `json:"scriptId"`
+	ExecutionContextID    RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	ObjectGroup           string                    `json:"objectGroup,omitempty"`
+	Silent                bool                      `json:"silent,omitempty"`
+	IncludeCommandLineAPI bool                      `json:"includeCommandLineAPI,omitempty"`
+	ReturnByValue         bool                      `json:"returnByValue,omitempty"`
+	GeneratePreview       bool                      `json:"generatePreview,omitempty"`
+	AwaitPromise          bool                      `json:"awaitPromise,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.runScript
+func (*RuntimeRunScriptParams) ProtocolMethod() string {
+	return "Runtime.runScript"
+}
+
+// RuntimeRunScriptResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-runScript
+type RuntimeRunScriptResult struct {
+	Result           *RuntimeRemoteObject     `json:"result"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.runScript and returns its result
+func (p *RuntimeRunScriptParams) Do(e Executor) (*RuntimeRunScriptResult, error) {
+	var result = new(RuntimeRunScriptResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeSetCustomObjectFormatterEnabledParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-setCustomObjectFormatterEnabled
+type RuntimeSetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Runtime.setCustomObjectFormatterEnabled
+func (*RuntimeSetCustomObjectFormatterEnabledParams) ProtocolMethod() string {
+	return "Runtime.setCustomObjectFormatterEnabled"
+}
+
+// Do sends Runtime.setCustomObjectFormatterEnabled
+func (p *RuntimeSetCustomObjectFormatterEnabledParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeSetMaxCallStackSizeToCaptureParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-setMaxCallStackSizeToCapture
+type RuntimeSetMaxCallStackSizeToCaptureParams struct {
+	Size int64 `json:"size"`
+}
+
+// ProtocolMethod returns Runtime.setMaxCallStackSizeToCapture
+func (*RuntimeSetMaxCallStackSizeToCaptureParams) ProtocolMethod() string {
+	return "Runtime.setMaxCallStackSizeToCapture"
+}
+
+// Do sends Runtime.setMaxCallStackSizeToCapture
+func (p *RuntimeSetMaxCallStackSizeToCaptureParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeTerminateExecutionParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-terminateExecution
+type RuntimeTerminateExecutionParams struct {
+}
+
+// ProtocolMethod returns Runtime.terminateExecution
+func (*RuntimeTerminateExecutionParams) ProtocolMethod() string {
+	return "Runtime.terminateExecution"
+}
+
+// Do sends Runtime.terminateExecution
+func (p *RuntimeTerminateExecutionParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeAddBindingParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-addBinding
+type RuntimeAddBindingParams struct {
+	Name string `json:"name"`
+	// Deprecated: deprecated in protocol
+	ExecutionContextID   RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+	ExecutionContextName string                    `json:"executionContextName,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.addBinding
+func (*RuntimeAddBindingParams) ProtocolMethod() string {
+	return "Runtime.addBinding"
+}
+
+// Do sends Runtime.addBinding
+func (p *RuntimeAddBindingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeRemoveBindingParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-removeBinding
+type RuntimeRemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+// ProtocolMethod returns Runtime.removeBinding
+func (*RuntimeRemoveBindingParams) ProtocolMethod() string {
+	return "Runtime.removeBinding"
+}
+
+// Do sends Runtime.removeBinding
+func (p *RuntimeRemoveBindingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// RuntimeGetExceptionDetailsParams https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getExceptionDetails
+type RuntimeGetExceptionDetailsParams struct {
+	ErrorObjectID RuntimeRemoteObjectID `json:"errorObjectId"`
+}
+
+// ProtocolMethod returns Runtime.getExceptionDetails
+func (*RuntimeGetExceptionDetailsParams) ProtocolMethod() string {
+	return "Runtime.getExceptionDetails"
+}
+
+// RuntimeGetExceptionDetailsResult https://chromedevtools.github.io/devtools-protocol/tot/Runtime#method-getExceptionDetails
+type RuntimeGetExceptionDetailsResult struct {
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// Do sends Runtime.getExceptionDetails and returns its result
+func (p *RuntimeGetExceptionDetailsParams) Do(e Executor) (*RuntimeGetExceptionDetailsResult, error) {
+	var result = new(RuntimeGetExceptionDetailsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RuntimeBindingCalledEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-bindingCalled
+type RuntimeBindingCalledEvent struct {
+	Name               string                    `json:"name"`
+	Payload            string                    `json:"payload"`
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId"`
+}
+
+// ProtocolMethod returns Runtime.bindingCalled
+func (*RuntimeBindingCalledEvent) ProtocolMethod() string {
+	return "Runtime.bindingCalled"
+}
+
+// RuntimeConsoleAPICalledEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-consoleAPICalled
+type RuntimeConsoleAPICalledEvent struct {
+	Type               RuntimeConsoleAPICalledEventType `json:"type"`
+	Args               []*RuntimeRemoteObject           `json:"args"`
+	ExecutionContextID RuntimeExecutionContextID        `json:"executionContextId"`
+	Timestamp          RuntimeTimestamp                 `json:"timestamp"`
+	StackTrace         *RuntimeStackTrace               `json:"stackTrace,omitempty"`
+	Context            string                           `json:"context,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.consoleAPICalled
+func (*RuntimeConsoleAPICalledEvent) ProtocolMethod() string {
+	return "Runtime.consoleAPICalled"
+}
+
+// RuntimeExceptionRevokedEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-exceptionRevoked
+type RuntimeExceptionRevokedEvent struct {
+	Reason      string `json:"reason"`
+	ExceptionID int64  `json:"exceptionId"`
+}
+
+// ProtocolMethod returns Runtime.exceptionRevoked
+func (*RuntimeExceptionRevokedEvent) ProtocolMethod() string {
+	return "Runtime.exceptionRevoked"
+}
+
+// RuntimeExceptionThrownEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-exceptionThrown
+type RuntimeExceptionThrownEvent struct {
+	Timestamp        RuntimeTimestamp         `json:"timestamp"`
+	ExceptionDetails *RuntimeExceptionDetails `json:"exceptionDetails"`
+}
+
+// ProtocolMethod returns Runtime.exceptionThrown
+func (*RuntimeExceptionThrownEvent) ProtocolMethod() string {
+	return "Runtime.exceptionThrown"
+}
+
+// RuntimeExecutionContextCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-executionContextCreated
+type RuntimeExecutionContextCreatedEvent struct {
+	Context *RuntimeExecutionContextDescription `json:"context"`
+}
+
+// ProtocolMethod returns Runtime.executionContextCreated
+func (*RuntimeExecutionContextCreatedEvent) ProtocolMethod() string {
+	return "Runtime.executionContextCreated"
+}
+
+// RuntimeExecutionContextDestroyedEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-executionContextDestroyed
+type RuntimeExecutionContextDestroyedEvent struct {
+	// Deprecated: deprecated in protocol
+	ExecutionContextID       RuntimeExecutionContextID `json:"executionContextId"`
+	ExecutionContextUniqueID string                    `json:"executionContextUniqueId"`
+}
+
+// ProtocolMethod returns Runtime.executionContextDestroyed
+func (*RuntimeExecutionContextDestroyedEvent) ProtocolMethod() string {
+	return "Runtime.executionContextDestroyed"
+}
+
+// RuntimeExecutionContextsClearedEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-executionContextsCleared
+type RuntimeExecutionContextsClearedEvent struct {
+}
+
+// ProtocolMethod returns Runtime.executionContextsCleared
+func (*RuntimeExecutionContextsClearedEvent) ProtocolMethod() string {
+	return "Runtime.executionContextsCleared"
+}
+
+// RuntimeInspectRequestedEvent https://chromedevtools.github.io/devtools-protocol/tot/Runtime#event-inspectRequested
+type RuntimeInspectRequestedEvent struct {
+	Object             *RuntimeRemoteObject      `json:"object"`
+	Hints              map[string]interface{}    `json:"hints"`
+	ExecutionContextID RuntimeExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+// ProtocolMethod returns Runtime.inspectRequested
+func (*RuntimeInspectRequestedEvent) ProtocolMethod() string {
+	return "Runtime.inspectRequested"
+}
+
+// RuntimeSerializationOptionsSerialization enum
+type RuntimeSerializationOptionsSerialization string
+
+// RuntimeSerializationOptionsSerialization values
+const (
+	RuntimeSerializationOptionsSerializationDeep   RuntimeSerializationOptionsSerialization = "deep"
+	RuntimeSerializationOptionsSerializationJSON   RuntimeSerializationOptionsSerialization = "json"
+	RuntimeSerializationOptionsSerializationIDOnly RuntimeSerializationOptionsSerialization = "idOnly"
+)
+
+// RuntimeDeepSerializedValueType enum
+type RuntimeDeepSerializedValueType string
+
+// RuntimeDeepSerializedValueType values
+const (
+	RuntimeDeepSerializedValueTypeUndefined   RuntimeDeepSerializedValueType = "undefined"
+	RuntimeDeepSerializedValueTypeNull        RuntimeDeepSerializedValueType = "null"
+	RuntimeDeepSerializedValueTypeString      RuntimeDeepSerializedValueType = "string"
+	RuntimeDeepSerializedValueTypeNumber      RuntimeDeepSerializedValueType = "number"
+	RuntimeDeepSerializedValueTypeBoolean     RuntimeDeepSerializedValueType = "boolean"
+	RuntimeDeepSerializedValueTypeBigint      RuntimeDeepSerializedValueType = "bigint"
+	RuntimeDeepSerializedValueTypeRegexp      RuntimeDeepSerializedValueType = "regexp"
+	RuntimeDeepSerializedValueTypeDate        RuntimeDeepSerializedValueType = "date"
+	RuntimeDeepSerializedValueTypeSymbol      RuntimeDeepSerializedValueType = "symbol"
+	RuntimeDeepSerializedValueTypeArray       RuntimeDeepSerializedValueType = "array"
+	RuntimeDeepSerializedValueTypeObject      RuntimeDeepSerializedValueType = "object"
+	RuntimeDeepSerializedValueTypeFunction    RuntimeDeepSerializedValueType = "function"
+	RuntimeDeepSerializedValueTypeMap         RuntimeDeepSerializedValueType = "map"
+	RuntimeDeepSerializedValueTypeSet         RuntimeDeepSerializedValueType = "set"
+	RuntimeDeepSerializedValueTypeWeakmap     RuntimeDeepSerializedValueType = "weakmap"
+	RuntimeDeepSerializedValueTypeWeakset     RuntimeDeepSerializedValueType = "weakset"
+	RuntimeDeepSerializedValueTypeError       RuntimeDeepSerializedValueType = "error"
+	RuntimeDeepSerializedValueTypeProxy       RuntimeDeepSerializedValueType = "proxy"
+	RuntimeDeepSerializedValueTypePromise     RuntimeDeepSerializedValueType = "promise"
+	RuntimeDeepSerializedValueTypeTypedarray  RuntimeDeepSerializedValueType = "typedarray"
+	RuntimeDeepSerializedValueTypeArraybuffer RuntimeDeepSerializedValueType = "arraybuffer"
+	RuntimeDeepSerializedValueTypeNode        RuntimeDeepSerializedValueType = "node"
+	RuntimeDeepSerializedValueTypeWindow      RuntimeDeepSerializedValueType = "window"
+	RuntimeDeepSerializedValueTypeGenerator   RuntimeDeepSerializedValueType = "generator"
+)
+
+// RuntimeRemoteObjectType enum
+type RuntimeRemoteObjectType string
+
+// RuntimeRemoteObjectType values
+const (
+	RuntimeRemoteObjectTypeObject    RuntimeRemoteObjectType = "object"
+	RuntimeRemoteObjectTypeFunction  RuntimeRemoteObjectType = "function"
+	RuntimeRemoteObjectTypeUndefined RuntimeRemoteObjectType = "undefined"
+	RuntimeRemoteObjectTypeString    RuntimeRemoteObjectType = "string"
+	RuntimeRemoteObjectTypeNumber    RuntimeRemoteObjectType = "number"
+	RuntimeRemoteObjectTypeBoolean   RuntimeRemoteObjectType = "boolean"
+	RuntimeRemoteObjectTypeSymbol    RuntimeRemoteObjectType = "symbol"
+	RuntimeRemoteObjectTypeBigint    RuntimeRemoteObjectType = "bigint"
+)
+
+// RuntimeRemoteObjectSubtype enum
+type RuntimeRemoteObjectSubtype string
+
+// RuntimeRemoteObjectSubtype values
+const (
+	RuntimeRemoteObjectSubtypeArray             RuntimeRemoteObjectSubtype = "array"
+	RuntimeRemoteObjectSubtypeNull              RuntimeRemoteObjectSubtype = "null"
+	RuntimeRemoteObjectSubtypeNode              RuntimeRemoteObjectSubtype = "node"
+	RuntimeRemoteObjectSubtypeRegexp            RuntimeRemoteObjectSubtype = "regexp"
+	RuntimeRemoteObjectSubtypeDate              RuntimeRemoteObjectSubtype = "date"
+	RuntimeRemoteObjectSubtypeMap               RuntimeRemoteObjectSubtype = "map"
+	RuntimeRemoteObjectSubtypeSet               RuntimeRemoteObjectSubtype = "set"
+	RuntimeRemoteObjectSubtypeWeakmap           RuntimeRemoteObjectSubtype = "weakmap"
+	RuntimeRemoteObjectSubtypeWeakset           RuntimeRemoteObjectSubtype = "weakset"
+	RuntimeRemoteObjectSubtypeIterator          RuntimeRemoteObjectSubtype = "iterator"
+	RuntimeRemoteObjectSubtypeGenerator         RuntimeRemoteObjectSubtype = "generator"
+	RuntimeRemoteObjectSubtypeError             RuntimeRemoteObjectSubtype = "error"
+	RuntimeRemoteObjectSubtypeProxy             RuntimeRemoteObjectSubtype = "proxy"
+	RuntimeRemoteObjectSubtypePromise           RuntimeRemoteObjectSubtype = "promise"
+	RuntimeRemoteObjectSubtypeTypedarray        RuntimeRemoteObjectSubtype = "typedarray"
+	RuntimeRemoteObjectSubtypeArraybuffer       RuntimeRemoteObjectSubtype = "arraybuffer"
+	RuntimeRemoteObjectSubtypeDataview          RuntimeRemoteObjectSubtype = "dataview"
+	RuntimeRemoteObjectSubtypeWebassemblymemory RuntimeRemoteObjectSubtype = "webassemblymemory"
+	RuntimeRemoteObjectSubtypeWasmvalue         RuntimeRemoteObjectSubtype = "wasmvalue"
+)
+
+// RuntimeObjectPreviewType enum
+type RuntimeObjectPreviewType string
+
+// RuntimeObjectPreviewType values
+const (
+	RuntimeObjectPreviewTypeObject    RuntimeObjectPreviewType = "object"
+	RuntimeObjectPreviewTypeFunction  RuntimeObjectPreviewType = "function"
+	RuntimeObjectPreviewTypeUndefined RuntimeObjectPreviewType = "undefined"
+	RuntimeObjectPreviewTypeString    RuntimeObjectPreviewType = "string"
+	RuntimeObjectPreviewTypeNumber    RuntimeObjectPreviewType = "number"
+	RuntimeObjectPreviewTypeBoolean   RuntimeObjectPreviewType = "boolean"
+	RuntimeObjectPreviewTypeSymbol    RuntimeObjectPreviewType = "symbol"
+	RuntimeObjectPreviewTypeBigint    RuntimeObjectPreviewType = "bigint"
+)
+
+// RuntimeObjectPreviewSubtype enum
+type RuntimeObjectPreviewSubtype string
+
+// RuntimeObjectPreviewSubtype values
+const (
+	RuntimeObjectPreviewSubtypeArray             RuntimeObjectPreviewSubtype = "array"
+	RuntimeObjectPreviewSubtypeNull              RuntimeObjectPreviewSubtype = "null"
+	RuntimeObjectPreviewSubtypeNode              RuntimeObjectPreviewSubtype = "node"
+	RuntimeObjectPreviewSubtypeRegexp            RuntimeObjectPreviewSubtype = "regexp"
+	RuntimeObjectPreviewSubtypeDate              RuntimeObjectPreviewSubtype = "date"
+	RuntimeObjectPreviewSubtypeMap               RuntimeObjectPreviewSubtype = "map"
+	RuntimeObjectPreviewSubtypeSet               RuntimeObjectPreviewSubtype = "set"
+	RuntimeObjectPreviewSubtypeWeakmap           RuntimeObjectPreviewSubtype = "weakmap"
+	RuntimeObjectPreviewSubtypeWeakset           RuntimeObjectPreviewSubtype = "weakset"
+	RuntimeObjectPreviewSubtypeIterator          RuntimeObjectPreviewSubtype = "iterator"
+	RuntimeObjectPreviewSubtypeGenerator         RuntimeObjectPreviewSubtype = "generator"
+	RuntimeObjectPreviewSubtypeError             RuntimeObjectPreviewSubtype = "error"
+	RuntimeObjectPreviewSubtypeProxy             RuntimeObjectPreviewSubtype = "proxy"
+	RuntimeObjectPreviewSubtypePromise           RuntimeObjectPreviewSubtype = "promise"
+	RuntimeObjectPreviewSubtypeTypedarray        RuntimeObjectPreviewSubtype = "typedarray"
+	RuntimeObjectPreviewSubtypeArraybuffer       RuntimeObjectPreviewSubtype = "arraybuffer"
+	RuntimeObjectPreviewSubtypeDataview          RuntimeObjectPreviewSubtype = "dataview"
+	RuntimeObjectPreviewSubtypeWebassemblymemory RuntimeObjectPreviewSubtype = "webassemblymemory"
+	RuntimeObjectPreviewSubtypeWasmvalue         RuntimeObjectPreviewSubtype = "wasmvalue"
+)
+
+// RuntimePropertyPreviewType enum
+type RuntimePropertyPreviewType string
+
+// RuntimePropertyPreviewType values
+const (
+	RuntimePropertyPreviewTypeObject    RuntimePropertyPreviewType = "object"
+	RuntimePropertyPreviewTypeFunction  RuntimePropertyPreviewType = "function"
+	RuntimePropertyPreviewTypeUndefined RuntimePropertyPreviewType = "undefined"
+	RuntimePropertyPreviewTypeString    RuntimePropertyPreviewType = "string"
+	RuntimePropertyPreviewTypeNumber    RuntimePropertyPreviewType = "number"
+	RuntimePropertyPreviewTypeBoolean   RuntimePropertyPreviewType = "boolean"
+	RuntimePropertyPreviewTypeSymbol    RuntimePropertyPreviewType = "symbol"
+	RuntimePropertyPreviewTypeAccessor  RuntimePropertyPreviewType = "accessor"
+	RuntimePropertyPreviewTypeBigint    RuntimePropertyPreviewType = "bigint"
+)
+
+// RuntimePropertyPreviewSubtype enum
+type RuntimePropertyPreviewSubtype string
+
+// RuntimePropertyPreviewSubtype values
+const (
+	RuntimePropertyPreviewSubtypeArray             RuntimePropertyPreviewSubtype = "array"
+	RuntimePropertyPreviewSubtypeNull              RuntimePropertyPreviewSubtype = "null"
+	RuntimePropertyPreviewSubtypeNode              RuntimePropertyPreviewSubtype = "node"
+	RuntimePropertyPreviewSubtypeRegexp            RuntimePropertyPreviewSubtype = "regexp"
+	RuntimePropertyPreviewSubtypeDate              RuntimePropertyPreviewSubtype = "date"
+	RuntimePropertyPreviewSubtypeMap               RuntimePropertyPreviewSubtype = "map"
+	RuntimePropertyPreviewSubtypeSet               RuntimePropertyPreviewSubtype = "set"
+	RuntimePropertyPreviewSubtypeWeakmap           RuntimePropertyPreviewSubtype = "weakmap"
+	RuntimePropertyPreviewSubtypeWeakset           RuntimePropertyPreviewSubtype = "weakset"
+	RuntimePropertyPreviewSubtypeIterator          RuntimePropertyPreviewSubtype = "iterator"
+	RuntimePropertyPreviewSubtypeGenerator         RuntimePropertyPreviewSubtype = "generator"
+	RuntimePropertyPreviewSubtypeError             RuntimePropertyPreviewSubtype = "error"
+	RuntimePropertyPreviewSubtypeProxy             RuntimePropertyPreviewSubtype = "proxy"
+	RuntimePropertyPreviewSubtypePromise           RuntimePropertyPreviewSubtype = "promise"
+	RuntimePropertyPreviewSubtypeTypedarray        RuntimePropertyPreviewSubtype = "typedarray"
+	RuntimePropertyPreviewSubtypeArraybuffer       RuntimePropertyPreviewSubtype = "arraybuffer"
+	RuntimePropertyPreviewSubtypeDataview          RuntimePropertyPreviewSubtype = "dataview"
+	RuntimePropertyPreviewSubtypeWebassemblymemory RuntimePropertyPreviewSubtype = "webassemblymemory"
+	RuntimePropertyPreviewSubtypeWasmvalue         RuntimePropertyPreviewSubtype = "wasmvalue"
+)
+
+// RuntimeConsoleAPICalledEventType enum
+type RuntimeConsoleAPICalledEventType string
+
+// RuntimeConsoleAPICalledEventType values
+const (
+	RuntimeConsoleAPICalledEventTypeLog                 RuntimeConsoleAPICalledEventType = "log"
+	RuntimeConsoleAPICalledEventTypeDebug               RuntimeConsoleAPICalledEventType = "debug"
+	RuntimeConsoleAPICalledEventTypeInfo                RuntimeConsoleAPICalledEventType = "info"
+	RuntimeConsoleAPICalledEventTypeError               RuntimeConsoleAPICalledEventType = "error"
+	RuntimeConsoleAPICalledEventTypeWarning             RuntimeConsoleAPICalledEventType = "warning"
+	RuntimeConsoleAPICalledEventTypeDir                 RuntimeConsoleAPICalledEventType = "dir"
+	RuntimeConsoleAPICalledEventTypeDirxml              RuntimeConsoleAPICalledEventType = "dirxml"
+	RuntimeConsoleAPICalledEventTypeTable               RuntimeConsoleAPICalledEventType = "table"
+	RuntimeConsoleAPICalledEventTypeTrace               RuntimeConsoleAPICalledEventType = "trace"
+	RuntimeConsoleAPICalledEventTypeClear               RuntimeConsoleAPICalledEventType = "clear"
+	RuntimeConsoleAPICalledEventTypeStartGroup          RuntimeConsoleAPICalledEventType = "startGroup"
+	RuntimeConsoleAPICalledEventTypeStartGroupCollapsed RuntimeConsoleAPICalledEventType = "startGroupCollapsed"
+	RuntimeConsoleAPICalledEventTypeEndGroup            RuntimeConsoleAPICalledEventType = "endGroup"
+	RuntimeConsoleAPICalledEventTypeAssert              RuntimeConsoleAPICalledEventType = "assert"
+	RuntimeConsoleAPICalledEventTypeProfile             RuntimeConsoleAPICalledEventType = "profile"
+	RuntimeConsoleAPICalledEventTypeProfileEnd          RuntimeConsoleAPICalledEventType = "profileEnd"
+	RuntimeConsoleAPICalledEventTypeCount               RuntimeConsoleAPICalledEventType = "count"
+	RuntimeConsoleAPICalledEventTypeTimeEnd             RuntimeConsoleAPICalledEventType = "timeEnd"
+)
@@ -0,0 +1,32 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// SchemaDomain https://chromedevtools.github.io/devtools-protocol/tot/Schema#type-Domain
+type SchemaDomain struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// SchemaGetDomainsParams https://chromedevtools.github.io/devtools-protocol/tot/Schema#method-getDomains
+type SchemaGetDomainsParams struct {
+}
+
+// ProtocolMethod returns Schema.getDomains
+func (*SchemaGetDomainsParams) ProtocolMethod() string {
+	return "Schema.getDomains"
+}
+
+// SchemaGetDomainsResult https://chromedevtools.github.io/devtools-protocol/tot/Schema#method-getDomains
+type SchemaGetDomainsResult struct {
+	Domains []*SchemaDomain `json:"domains"`
+}
+
+// Do sends Schema.getDomains and returns its result
+func (p *SchemaGetDomainsParams) Do(e Executor) (*SchemaGetDomainsResult, error) {
+	var result = new(SchemaGetDomainsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,236 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Security events
+const (
+	EventSecurityCertificateError            = "Security.certificateError"
+	EventSecurityVisibleSecurityStateChanged = "Security.visibleSecurityStateChanged"
+	EventSecuritySecurityStateChanged        = "Security.securityStateChanged"
+)
+
+// SecurityCertificateID https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateId
+type SecurityCertificateID int64
+
+// SecurityMixedContentType https://chromedevtools.github.io/devtools-protocol/tot/Security#type-MixedContentType
+type SecurityMixedContentType string
+
+// SecurityMixedContentType values
+const (
+	SecurityMixedContentTypeBlockable           SecurityMixedContentType = "blockable"
+	SecurityMixedContentTypeOptionallyBlockable SecurityMixedContentType = "optionally-blockable"
+	SecurityMixedContentTypeNone                SecurityMixedContentType = "none"
+)
+
+// SecuritySecurityState https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SecurityState
+type SecuritySecurityState string
+
+// SecuritySecurityState values
+const (
+	SecuritySecurityStateUnknown        SecuritySecurityState = "unknown"
+	SecuritySecurityStateNeutral        SecuritySecurityState = "neutral"
+	SecuritySecurityStateInsecure       SecuritySecurityState = "insecure"
+	SecuritySecurityStateSecure         SecuritySecurityState = "secure"
+	SecuritySecurityStateInfo           SecuritySecurityState = "info"
+	SecuritySecurityStateInsecureBroken SecuritySecurityState = "insecure-broken"
+)
+
+// SecurityCertificateSecurityState https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateSecurityState
+type SecurityCertificateSecurityState struct {
+	Protocol                    string                `json:"protocol"`
+	KeyExchange                 string                `json:"keyExchange"`
+	KeyExchangeGroup            string                `json:"keyExchangeGroup,omitempty"`
+	Cipher                      string                `json:"cipher"`
+	Mac                         string                `json:"mac,omitempty"`
+	Certificate                 []string              `json:"certificate"`
+	SubjectName                 string                `json:"subjectName"`
+	Issuer                      string                `json:"issuer"`
+	ValidFrom                   NetworkTimeSinceEpoch `json:"validFrom"`
+	ValidTo                     NetworkTimeSinceEpoch `json:"validTo"`
+	CertificateNetworkError     string                `json:"certificateNetworkError,omitempty"`
+	CertificateHasWeakSignature bool                  `json:"certificateHasWeakSignature"`
+	CertificateHasSha1Signature bool                  `json:"certificateHasSha1Signature"`
+	ModernSSL                   bool                  `json:"modernSSL"`
+	ObsoleteSslProtocol         bool                  `json:"obsoleteSslProtocol"`
+	ObsoleteSslKeyExchange      bool                  `json:"obsoleteSslKeyExchange"`
+	ObsoleteSslCipher           bool                  `json:"obsoleteSslCipher"`
+	ObsoleteSslSignature        bool                  `json:"obsoleteSslSignature"`
+}
+
+// SecuritySafetyTipStatus https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SafetyTipStatus
+type SecuritySafetyTipStatus string
+
+// SecuritySafetyTipStatus values
+const (
+	SecuritySafetyTipStatusBadReputation SecuritySafetyTipStatus = "badReputation"
+	SecuritySafetyTipStatusLookalike     SecuritySafetyTipStatus = "lookalike"
+)
+
+// SecuritySafetyTipInfo https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SafetyTipInfo
+type SecuritySafetyTipInfo struct {
+	SafetyTipStatus SecuritySafetyTipStatus `json:"safetyTipStatus"`
+	SafeURL         string                  `json:"safeUrl,omitempty"`
+}
+
+// SecurityVisibleSecurityState https://chromedevtools.github.io/devtools-protocol/tot/Security#type-VisibleSecurityState
+type SecurityVisibleSecurityState struct {
+	SecurityState            SecuritySecurityState             `json:"securityState"`
+	CertificateSecurityState *SecurityCertificateSecurityState `json:"certificateSecurityState,omitempty"`
+	SafetyTipInfo            *SecuritySafetyTipInfo            `json:"safetyTipInfo,omitempty"`
+	SecurityStateIssueIds    []string                          `json:"securityStateIssueIds"`
+}
+
+// SecuritySecurityStateExplanation https://chromedevtools.github.io/devtools-protocol/tot/Security#type-SecurityStateExplanation
+type SecuritySecurityStateExplanation struct {
+	SecurityState    SecuritySecurityState    `json:"securityState"`
+	Title            string                   `json:"title"`
+	Summary          string                   `json:"summary"`
+	Description      string                   `json:"description"`
+	MixedContentType SecurityMixedContentType `json:"mixedContentType"`
+	Certificate      []string                 `json:"certificate"`
+	Recommendations  []string                 `json:"recommendations,omitempty"`
+}
+
+// SecurityInsecureContentStatus https://chromedevtools.github.io/devtools-protocol/tot/Security#type-InsecureContentStatus
+//
+// Deprecated: deprecated in protocol
+type SecurityInsecureContentStatus struct {
+	RanMixedContent                bool                  `json:"ranMixedContent"`
+	DisplayedMixedContent          bool                  `json:"displayedMixedContent"`
+	ContainedMixedForm             bool                  `json:"containedMixedForm"`
+	RanContentWithCertErrors       bool                  `json:"ranContentWithCertErrors"`
+	DisplayedContentWithCertErrors bool                  `json:"displayedContentWithCertErrors"`
+	RanInsecureContentStyle        SecuritySecurityState `json:"ranInsecureContentStyle"`
+	DisplayedInsecureContentStyle  SecuritySecurityState `json:"displayedInsecureContentStyle"`
+}
+
+// SecurityCertificateErrorAction https://chromedevtools.github.io/devtools-protocol/tot/Security#type-CertificateErrorAction
+type SecurityCertificateErrorAction string
+
+// SecurityCertificateErrorAction values
+const (
+	SecurityCertificateErrorActionContinue SecurityCertificateErrorAction = "continue"
+	SecurityCertificateErrorActionCancel   SecurityCertificateErrorAction = "cancel"
+)
+
+// SecurityDisableParams https://chromedevtools.github.io/devtools-protocol/tot/Security#method-disable
+type SecurityDisableParams struct {
+}
+
+// ProtocolMethod returns Security.disable
+func (*SecurityDisableParams) ProtocolMethod() string {
+	return "Security.disable"
+}
+
+// Do sends Security.disable
+func (p *SecurityDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// SecurityEnableParams https://chromedevtools.github.io/devtools-protocol/tot/Security#method-enable
+type SecurityEnableParams struct {
+}
+
+// ProtocolMethod returns Security.enable
+func (*SecurityEnableParams) ProtocolMethod() string {
+	return "Security.enable"
+}
+
+// Do sends Security.enable
+func (p *SecurityEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// SecuritySetIgnoreCertificateErrorsParams https://chromedevtools.github.io/devtools-protocol/tot/Security#method-setIgnoreCertificateErrors
+type SecuritySetIgnoreCertificateErrorsParams struct {
+	Ignore bool `json:"ignore"`
+}
+
+// ProtocolMethod returns Security.setIgnoreCertificateErrors
+func (*SecuritySetIgnoreCertificateErrorsParams) ProtocolMethod() string {
+	return "Security.setIgnoreCertificateErrors"
+}
+
+// Do sends Security.setIgnoreCertificateErrors
+func (p *SecuritySetIgnoreCertificateErrorsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// SecurityHandleCertificateErrorParams https://chromedevtools.github.io/devtools-protocol/tot/Security#method-handleCertificateError
+//
+// Deprecated: deprecated in protocol
+type SecurityHandleCertificateErrorParams struct {
+	EventID int64                          `json:"eventId"`
+	Action  SecurityCertificateErrorAction `json:"action"`
+}
+
+// ProtocolMethod returns Security.handleCertificateError
+func (*SecurityHandleCertificateErrorParams) ProtocolMethod() string {
+	return "Security.handleCertificateError"
+}
+
+// Do sends Security.handleCertificateError
+func (p *SecurityHandleCertificateErrorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// SecuritySetOverrideCertificateErrorsParams https://chromedevtools.github.io/devtools-protocol/tot/Security#method-setOverrideCertificateErrors
+//
+// Deprecated: deprecated in protocol
+type SecuritySetOverrideCertificateErrorsParams struct {
+	Override bool `json:"override"`
+}
+
+// ProtocolMethod returns Security.setOverrideCertificateErrors
+func (*SecuritySetOverrideCertificateErrorsParams) ProtocolMethod() string {
+	return "Security.setOverrideCertificateErrors"
+}
+
+// Do sends Security.setOverrideCertificateErrors
+func (p *SecuritySetOverrideCertificateErrorsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// SecurityCertificateErrorEvent https://chromedevtools.github.io/devtools-protocol/tot/Security#event-certificateError
+//
+// Deprecated: deprecated in protocol
+type SecurityCertificateErrorEvent struct {
+	EventID    int64  `json:"eventId"`
+	ErrorType  string `json:"errorType"`
+	RequestURL string `json:"requestURL"`
+}
+
+// ProtocolMethod returns Security.certificateError
+func (*SecurityCertificateErrorEvent) ProtocolMethod() string {
+	return "Security.certificateError"
+}
+
+// SecurityVisibleSecurityStateChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/Security#event-visibleSecurityStateChanged
+type SecurityVisibleSecurityStateChangedEvent struct {
+	VisibleSecurityState *SecurityVisibleSecurityState `json:"visibleSecurityState"`
+}
+
+// ProtocolMethod returns Security.visibleSecurityStateChanged
+func (*SecurityVisibleSecurityStateChangedEvent) ProtocolMethod() string {
+	return "Security.visibleSecurityStateChanged"
+}
+
+// SecuritySecurityStateChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/Security#event-securityStateChanged
+//
+// Deprecated: deprecated in protocol
+type SecuritySecurityStateChangedEvent struct {
+	SecurityState SecuritySecurityState `json:"securityState"`
+	// Deprecated: deprecated in protocol
+	SchemeIsCryptographic bool `json:"schemeIsCryptographic"`
+	// Deprecated: deprecated in protocol
+	Explanations []*SecuritySecurityStateExplanation `json:"explanations"`
+	// Deprecated: deprecated in protocol
+	InsecureContentStatus *SecurityInsecureContentStatus `json:"insecureContentStatus"`
+	// Deprecated: deprecated in protocol
+	Summary string `json:"summary,omitempty"`
+}
+
+// ProtocolMethod returns Security.securityStateChanged
+func (*SecuritySecurityStateChangedEvent) ProtocolMethod() string {
+	return "Security.securityStateChanged"
+}
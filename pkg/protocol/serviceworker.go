@@ -0,0 +1,282 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// ServiceWorker events
+const (
+	EventServiceWorkerWorkerErrorReported       = "ServiceWorker.workerErrorReported"
+	EventServiceWorkerWorkerRegistrationUpdated = "ServiceWorker.workerRegistrationUpdated"
+	EventServiceWorkerWorkerVersionUpdated      = "ServiceWorker.workerVersionUpdated"
+)
+
+// ServiceWorkerRegistrationID https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-RegistrationID
+type ServiceWorkerRegistrationID string
+
+// ServiceWorkerServiceWorkerRegistration https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerRegistration
+type ServiceWorkerServiceWorkerRegistration struct {
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	ScopeURL       string                      `json:"scopeURL"`
+	IsDeleted      bool                        `json:"isDeleted"`
+}
+
+// ServiceWorkerServiceWorkerVersionRunningStatus https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersionRunningStatus
+type ServiceWorkerServiceWorkerVersionRunningStatus string
+
+// ServiceWorkerServiceWorkerVersionRunningStatus values
+const (
+	ServiceWorkerServiceWorkerVersionRunningStatusStopped  ServiceWorkerServiceWorkerVersionRunningStatus = "stopped"
+	ServiceWorkerServiceWorkerVersionRunningStatusStarting ServiceWorkerServiceWorkerVersionRunningStatus = "starting"
+	ServiceWorkerServiceWorkerVersionRunningStatusRunning  ServiceWorkerServiceWorkerVersionRunningStatus = "running"
+	ServiceWorkerServiceWorkerVersionRunningStatusStopping ServiceWorkerServiceWorkerVersionRunningStatus = "stopping"
+)
+
+// ServiceWorkerServiceWorkerVersionStatus https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersionStatus
+type ServiceWorkerServiceWorkerVersionStatus string
+
+// ServiceWorkerServiceWorkerVersionStatus values
+const (
+	ServiceWorkerServiceWorkerVersionStatusNew        ServiceWorkerServiceWorkerVersionStatus = "new"
+	ServiceWorkerServiceWorkerVersionStatusInstalling ServiceWorkerServiceWorkerVersionStatus = "installing"
+	ServiceWorkerServiceWorkerVersionStatusInstalled  ServiceWorkerServiceWorkerVersionStatus = "installed"
+	ServiceWorkerServiceWorkerVersionStatusActivating ServiceWorkerServiceWorkerVersionStatus = "activating"
+	ServiceWorkerServiceWorkerVersionStatusActivated  ServiceWorkerServiceWorkerVersionStatus = "activated"
+	ServiceWorkerServiceWorkerVersionStatusRedundant  ServiceWorkerServiceWorkerVersionStatus = "redundant"
+)
+
+// ServiceWorkerServiceWorkerVersion https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerVersion
+type ServiceWorkerServiceWorkerVersion struct {
+	VersionID          string                                         `json:"versionId"`
+	RegistrationID     ServiceWorkerRegistrationID                    `json:"registrationId"`
+	ScriptURL          string                                         `json:"scriptURL"`
+	RunningStatus      ServiceWorkerServiceWorkerVersionRunningStatus `json:"runningStatus"`
+	Status             ServiceWorkerServiceWorkerVersionStatus        `json:"status"`
+	ScriptLastModified float64                                        `json:"scriptLastModified,omitempty"`
+	ScriptResponseTime float64                                        `json:"scriptResponseTime,omitempty"`
+	ControlledClients  []TargetTargetID                               `json:"controlledClients,omitempty"`
+	TargetID           TargetTargetID                                 `json:"targetId,omitempty"`
+	RouterRules        string                                         `json:"routerRules,omitempty"`
+}
+
+// ServiceWorkerServiceWorkerErrorMessage https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#type-ServiceWorkerErrorMessage
+type ServiceWorkerServiceWorkerErrorMessage struct {
+	ErrorMessage   string                      `json:"errorMessage"`
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	VersionID      string                      `json:"versionId"`
+	SourceURL      string                      `json:"sourceURL"`
+	LineNumber     int64                       `json:"lineNumber"`
+	ColumnNumber   int64                       `json:"columnNumber"`
+}
+
+// ServiceWorkerDeliverPushMessageParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-deliverPushMessage
+type ServiceWorkerDeliverPushMessageParams struct {
+	Origin         string                      `json:"origin"`
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	Data           string                      `json:"data"`
+}
+
+// ProtocolMethod returns ServiceWorker.deliverPushMessage
+func (*ServiceWorkerDeliverPushMessageParams) ProtocolMethod() string {
+	return "ServiceWorker.deliverPushMessage"
+}
+
+// Do sends ServiceWorker.deliverPushMessage
+func (p *ServiceWorkerDeliverPushMessageParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerDisableParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-disable
+type ServiceWorkerDisableParams struct {
+}
+
+// ProtocolMethod returns ServiceWorker.disable
+func (*ServiceWorkerDisableParams) ProtocolMethod() string {
+	return "ServiceWorker.disable"
+}
+
+// Do sends ServiceWorker.disable
+func (p *ServiceWorkerDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerDispatchSyncEventParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-dispatchSyncEvent
+type ServiceWorkerDispatchSyncEventParams struct {
+	Origin         string                      `json:"origin"`
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	Tag            string                      `json:"tag"`
+	LastChance     bool                        `json:"lastChance"`
+}
+
+// ProtocolMethod returns ServiceWorker.dispatchSyncEvent
+func (*ServiceWorkerDispatchSyncEventParams) ProtocolMethod() string {
+	return "ServiceWorker.dispatchSyncEvent"
+}
+
+// Do sends ServiceWorker.dispatchSyncEvent
+func (p *ServiceWorkerDispatchSyncEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerDispatchPeriodicSyncEventParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-dispatchPeriodicSyncEvent
+type ServiceWorkerDispatchPeriodicSyncEventParams struct {
+	Origin         string                      `json:"origin"`
+	RegistrationID ServiceWorkerRegistrationID `json:"registrationId"`
+	Tag            string                      `json:"tag"`
+}
+
+// ProtocolMethod returns ServiceWorker.dispatchPeriodicSyncEvent
+func (*ServiceWorkerDispatchPeriodicSyncEventParams) ProtocolMethod() string {
+	return "ServiceWorker.dispatchPeriodicSyncEvent"
+}
+
+// Do sends ServiceWorker.dispatchPeriodicSyncEvent
+func (p *ServiceWorkerDispatchPeriodicSyncEventParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerEnableParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-enable
+type ServiceWorkerEnableParams struct {
+}
+
+// ProtocolMethod returns ServiceWorker.enable
+func (*ServiceWorkerEnableParams) ProtocolMethod() string {
+	return "ServiceWorker.enable"
+}
+
+// Do sends ServiceWorker.enable
+func (p *ServiceWorkerEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerSetForceUpdateOnPageLoadParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-setForceUpdateOnPageLoad
+type ServiceWorkerSetForceUpdateOnPageLoadParams struct {
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
+}
+
+// ProtocolMethod returns ServiceWorker.setForceUpdateOnPageLoad
+func (*ServiceWorkerSetForceUpdateOnPageLoadParams) ProtocolMethod() string {
+	return "ServiceWorker.setForceUpdateOnPageLoad"
+}
+
+// Do sends ServiceWorker.setForceUpdateOnPageLoad
+func (p *ServiceWorkerSetForceUpdateOnPageLoadParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerSkipWaitingParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-skipWaiting
+type ServiceWorkerSkipWaitingParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// ProtocolMethod returns ServiceWorker.skipWaiting
+func (*ServiceWorkerSkipWaitingParams) ProtocolMethod() string {
+	return "ServiceWorker.skipWaiting"
+}
+
+// Do sends ServiceWorker.skipWaiting
+func (p *ServiceWorkerSkipWaitingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerStartWorkerParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-startWorker
+type ServiceWorkerStartWorkerParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// ProtocolMethod returns ServiceWorker.startWorker
+func (*ServiceWorkerStartWorkerParams) ProtocolMethod() string {
+	return "ServiceWorker.startWorker"
+}
+
+// Do sends ServiceWorker.startWorker
+func (p *ServiceWorkerStartWorkerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerStopAllWorkersParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-stopAllWorkers
+type ServiceWorkerStopAllWorkersParams struct {
+}
+
+// ProtocolMethod returns ServiceWorker.stopAllWorkers
+func (*ServiceWorkerStopAllWorkersParams) ProtocolMethod() string {
+	return "ServiceWorker.stopAllWorkers"
+}
+
+// Do sends ServiceWorker.stopAllWorkers
+func (p *ServiceWorkerStopAllWorkersParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerStopWorkerParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-stopWorker
+type ServiceWorkerStopWorkerParams struct {
+	VersionID string `json:"versionId"`
+}
+
+// ProtocolMethod returns ServiceWorker.stopWorker
+func (*ServiceWorkerStopWorkerParams) ProtocolMethod() string {
+	return "ServiceWorker.stopWorker"
+}
+
+// Do sends ServiceWorker.stopWorker
+func (p *ServiceWorkerStopWorkerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerUnregisterParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-unregister
+type ServiceWorkerUnregisterParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// ProtocolMethod returns ServiceWorker.unregister
+func (*ServiceWorkerUnregisterParams) ProtocolMethod() string {
+	return "ServiceWorker.unregister"
+}
+
+// Do sends ServiceWorker.unregister
+func (p *ServiceWorkerUnregisterParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerUpdateRegistrationParams https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#method-updateRegistration
+type ServiceWorkerUpdateRegistrationParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// ProtocolMethod returns ServiceWorker.updateRegistration
+func (*ServiceWorkerUpdateRegistrationParams) ProtocolMethod() string {
+	return "ServiceWorker.updateRegistration"
+}
+
+// Do sends ServiceWorker.updateRegistration
+func (p *ServiceWorkerUpdateRegistrationParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// ServiceWorkerWorkerErrorReportedEvent https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#event-workerErrorReported
+type ServiceWorkerWorkerErrorReportedEvent struct {
+	ErrorMessage *ServiceWorkerServiceWorkerErrorMessage `json:"errorMessage"`
+}
+
+// ProtocolMethod returns ServiceWorker.workerErrorReported
+func (*ServiceWorkerWorkerErrorReportedEvent) ProtocolMethod() string {
+	return "ServiceWorker.workerErrorReported"
+}
+
+// ServiceWorkerWorkerRegistrationUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#event-workerRegistrationUpdated
+type ServiceWorkerWorkerRegistrationUpdatedEvent struct {
+	Registrations []*ServiceWorkerServiceWorkerRegistration `json:"registrations"`
+}
+
+// ProtocolMethod returns ServiceWorker.workerRegistrationUpdated
+func (*ServiceWorkerWorkerRegistrationUpdatedEvent) ProtocolMethod() string {
+	return "ServiceWorker.workerRegistrationUpdated"
+}
+
+// ServiceWorkerWorkerVersionUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker#event-workerVersionUpdated
+type ServiceWorkerWorkerVersionUpdatedEvent struct {
+	Versions []*ServiceWorkerServiceWorkerVersion `json:"versions"`
+}
+
+// ProtocolMethod returns ServiceWorker.workerVersionUpdated
+func (*ServiceWorkerWorkerVersionUpdatedEvent) ProtocolMethod() string {
+	return "ServiceWorker.workerVersionUpdated"
+}
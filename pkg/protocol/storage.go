@@ -0,0 +1,1352 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Storage events
+const (
+	EventStorageCacheStorageContentUpdated                     = "Storage.cacheStorageContentUpdated"
+	EventStorageCacheStorageListUpdated                        = "Storage.cacheStorageListUpdated"
+	EventStorageIndexedDBContentUpdated                        = "Storage.indexedDBContentUpdated"
+	EventStorageIndexedDBListUpdated                           = "Storage.indexedDBListUpdated"
+	EventStorageInterestGroupAccessed                          = "Storage.interestGroupAccessed"
+	EventStorageInterestGroupAuctionEventOccurred              = "Storage.interestGroupAuctionEventOccurred"
+	EventStorageInterestGroupAuctionNetworkRequestCreated      = "Storage.interestGroupAuctionNetworkRequestCreated"
+	EventStorageSharedStorageAccessed                          = "Storage.sharedStorageAccessed"
+	EventStorageSharedStorageWorkletOperationExecutionFinished = "Storage.sharedStorageWorkletOperationExecutionFinished"
+	EventStorageStorageBucketCreatedOrUpdated                  = "Storage.storageBucketCreatedOrUpdated"
+	EventStorageStorageBucketDeleted                           = "Storage.storageBucketDeleted"
+	EventStorageAttributionReportingSourceRegistered           = "Storage.attributionReportingSourceRegistered"
+	EventStorageAttributionReportingTriggerRegistered          = "Storage.attributionReportingTriggerRegistered"
+	EventStorageAttributionReportingReportSent                 = "Storage.attributionReportingReportSent"
+	EventStorageAttributionReportingVerboseDebugReportSent     = "Storage.attributionReportingVerboseDebugReportSent"
+)
+
+// StorageSerializedStorageKey https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SerializedStorageKey
+type StorageSerializedStorageKey string
+
+// StorageStorageType https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageType
+type StorageStorageType string
+
+// StorageStorageType values
+const (
+	StorageStorageTypeCookies        StorageStorageType = "cookies"
+	StorageStorageTypeFileSystems    StorageStorageType = "file_systems"
+	StorageStorageTypeIndexeddb      StorageStorageType = "indexeddb"
+	StorageStorageTypeLocalStorage   StorageStorageType = "local_storage"
+	StorageStorageTypeShaderCache    StorageStorageType = "shader_cache"
+	StorageStorageTypeWebsql         StorageStorageType = "websql"
+	StorageStorageTypeServiceWorkers StorageStorageType = "service_workers"
+	StorageStorageTypeCacheStorage   StorageStorageType = "cache_storage"
+	StorageStorageTypeInterestGroups StorageStorageType = "interest_groups"
+	StorageStorageTypeSharedStorage  StorageStorageType = "shared_storage"
+	StorageStorageTypeStorageBuckets StorageStorageType = "storage_buckets"
+	StorageStorageTypeAll            StorageStorageType = "all"
+	StorageStorageTypeOther          StorageStorageType = "other"
+)
+
+// StorageUsageForType https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UsageForType
+type StorageUsageForType struct {
+	StorageType StorageStorageType `json:"storageType"`
+	Usage       float64            `json:"usage"`
+}
+
+// StorageTrustTokens https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-TrustTokens
+type StorageTrustTokens struct {
+	IssuerOrigin string  `json:"issuerOrigin"`
+	Count        float64 `json:"count"`
+}
+
+// StorageInterestGroupAuctionID https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionId
+type StorageInterestGroupAuctionID string
+
+// StorageInterestGroupAccessType https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAccessType
+type StorageInterestGroupAccessType string
+
+// StorageInterestGroupAccessType values
+const (
+	StorageInterestGroupAccessTypeJoin                  StorageInterestGroupAccessType = "join"
+	StorageInterestGroupAccessTypeLeave                 StorageInterestGroupAccessType = "leave"
+	StorageInterestGroupAccessTypeUpdate                StorageInterestGroupAccessType = "update"
+	StorageInterestGroupAccessTypeLoaded                StorageInterestGroupAccessType = "loaded"
+	StorageInterestGroupAccessTypeBid                   StorageInterestGroupAccessType = "bid"
+	StorageInterestGroupAccessTypeWin                   StorageInterestGroupAccessType = "win"
+	StorageInterestGroupAccessTypeAdditionalBid         StorageInterestGroupAccessType = "additionalBid"
+	StorageInterestGroupAccessTypeAdditionalBidWin      StorageInterestGroupAccessType = "additionalBidWin"
+	StorageInterestGroupAccessTypeTopLevelBid           StorageInterestGroupAccessType = "topLevelBid"
+	StorageInterestGroupAccessTypeTopLevelAdditionalBid StorageInterestGroupAccessType = "topLevelAdditionalBid"
+	StorageInterestGroupAccessTypeClear                 StorageInterestGroupAccessType = "clear"
+)
+
+// StorageInterestGroupAuctionEventType https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionEventType
+type StorageInterestGroupAuctionEventType string
+
+// StorageInterestGroupAuctionEventType values
+const (
+	StorageInterestGroupAuctionEventTypeStarted        StorageInterestGroupAuctionEventType = "started"
+	StorageInterestGroupAuctionEventTypeConfigResolved StorageInterestGroupAuctionEventType = "configResolved"
+)
+
+// StorageInterestGroupAuctionFetchType https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-InterestGroupAuctionFetchType
+type StorageInterestGroupAuctionFetchType string
+
+// StorageInterestGroupAuctionFetchType values
+const (
+	StorageInterestGroupAuctionFetchTypeBidderJS             StorageInterestGroupAuctionFetchType = "bidderJs"
+	StorageInterestGroupAuctionFetchTypeBidderWasm           StorageInterestGroupAuctionFetchType = "bidderWasm"
+	StorageInterestGroupAuctionFetchTypeSellerJS             StorageInterestGroupAuctionFetchType = "sellerJs"
+	StorageInterestGroupAuctionFetchTypeBidderTrustedSignals StorageInterestGroupAuctionFetchType = "bidderTrustedSignals"
+	StorageInterestGroupAuctionFetchTypeSellerTrustedSignals StorageInterestGroupAuctionFetchType = "sellerTrustedSignals"
+)
+
+// StorageSharedStorageAccessScope https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessScope
+type StorageSharedStorageAccessScope string
+
+// StorageSharedStorageAccessScope values
+const (
+	StorageSharedStorageAccessScopeWindow                   StorageSharedStorageAccessScope = "window"
+	StorageSharedStorageAccessScopeSharedStorageWorklet     StorageSharedStorageAccessScope = "sharedStorageWorklet"
+	StorageSharedStorageAccessScopeProtectedAudienceWorklet StorageSharedStorageAccessScope = "protectedAudienceWorklet"
+	StorageSharedStorageAccessScopeHeader                   StorageSharedStorageAccessScope = "header"
+)
+
+// StorageSharedStorageAccessMethod https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessMethod
+type StorageSharedStorageAccessMethod string
+
+// StorageSharedStorageAccessMethod values
+const (
+	StorageSharedStorageAccessMethodAddModule       StorageSharedStorageAccessMethod = "addModule"
+	StorageSharedStorageAccessMethodCreateWorklet   StorageSharedStorageAccessMethod = "createWorklet"
+	StorageSharedStorageAccessMethodSelectURL       StorageSharedStorageAccessMethod = "selectURL"
+	StorageSharedStorageAccessMethodRun             StorageSharedStorageAccessMethod = "run"
+	StorageSharedStorageAccessMethodBatchUpdate     StorageSharedStorageAccessMethod = "batchUpdate"
+	StorageSharedStorageAccessMethodSet             StorageSharedStorageAccessMethod = "set"
+	StorageSharedStorageAccessMethodAppend          StorageSharedStorageAccessMethod = "append"
+	StorageSharedStorageAccessMethodDelete          StorageSharedStorageAccessMethod = "delete"
+	StorageSharedStorageAccessMethodClear           StorageSharedStorageAccessMethod = "clear"
+	StorageSharedStorageAccessMethodGet             StorageSharedStorageAccessMethod = "get"
+	StorageSharedStorageAccessMethodKeys            StorageSharedStorageAccessMethod = "keys"
+	StorageSharedStorageAccessMethodValues          StorageSharedStorageAccessMethod = "values"
+	StorageSharedStorageAccessMethodEntries         StorageSharedStorageAccessMethod = "entries"
+	StorageSharedStorageAccessMethodLength          StorageSharedStorageAccessMethod = "length"
+	StorageSharedStorageAccessMethodRemainingBudget StorageSharedStorageAccessMethod = "remainingBudget"
+)
+
+// StorageSharedStorageEntry https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageEntry
+type StorageSharedStorageEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StorageSharedStorageMetadata https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageMetadata
+type StorageSharedStorageMetadata struct {
+	CreationTime    NetworkTimeSinceEpoch `json:"creationTime"`
+	Length          int64                 `json:"length"`
+	RemainingBudget float64               `json:"remainingBudget"`
+	BytesUsed       int64                 `json:"bytesUsed"`
+}
+
+// StorageSharedStoragePrivateAggregationConfig https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStoragePrivateAggregationConfig
+type StorageSharedStoragePrivateAggregationConfig struct {
+	AggregationCoordinatorOrigin string `json:"aggregationCoordinatorOrigin,omitempty"`
+	ContextID                    string `json:"contextId,omitempty"`
+	FilteringIDMaxBytes          int64  `json:"filteringIdMaxBytes"`
+	MaxContributions             int64  `json:"maxContributions,omitempty"`
+}
+
+// StorageSharedStorageReportingMetadata https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageReportingMetadata
+type StorageSharedStorageReportingMetadata struct {
+	EventType    string `json:"eventType"`
+	ReportingURL string `json:"reportingUrl"`
+}
+
+// StorageSharedStorageURLWithMetadata https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageUrlWithMetadata
+type StorageSharedStorageURLWithMetadata struct {
+	URL               string                                   `json:"url"`
+	ReportingMetadata []*StorageSharedStorageReportingMetadata `json:"reportingMetadata"`
+}
+
+// StorageSharedStorageAccessParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SharedStorageAccessParams
+type StorageSharedStorageAccessParams struct {
+	ScriptSourceURL          string                                        `json:"scriptSourceUrl,omitempty"`
+	DataOrigin               string                                        `json:"dataOrigin,omitempty"`
+	OperationName            string                                        `json:"operationName,omitempty"`
+	OperationID              string                                        `json:"operationId,omitempty"`
+	KeepAlive                bool                                          `json:"keepAlive,omitempty"`
+	PrivateAggregationConfig *StorageSharedStoragePrivateAggregationConfig `json:"privateAggregationConfig,omitempty"`
+	SerializedData           string                                        `json:"serializedData,omitempty"`
+	UrlsWithMetadata         []*StorageSharedStorageURLWithMetadata        `json:"urlsWithMetadata,omitempty"`
+	UrnUuid                  string                                        `json:"urnUuid,omitempty"`
+	Key                      string                                        `json:"key,omitempty"`
+	Value                    string                                        `json:"value,omitempty"`
+	IgnoreIfPresent          bool                                          `json:"ignoreIfPresent,omitempty"`
+	WorkletOrdinal           int64                                         `json:"workletOrdinal,omitempty"`
+	WorkletTargetID          TargetTargetID                                `json:"workletTargetId,omitempty"`
+	WithLock                 string                                        `json:"withLock,omitempty"`
+	BatchUpdateID            string                                        `json:"batchUpdateId,omitempty"`
+	BatchSize                int64                                         `json:"batchSize,omitempty"`
+}
+
+// StorageStorageBucketsDurability https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucketsDurability
+type StorageStorageBucketsDurability string
+
+// StorageStorageBucketsDurability values
+const (
+	StorageStorageBucketsDurabilityRelaxed StorageStorageBucketsDurability = "relaxed"
+	StorageStorageBucketsDurabilityStrict  StorageStorageBucketsDurability = "strict"
+)
+
+// StorageStorageBucket https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucket
+type StorageStorageBucket struct {
+	StorageKey StorageSerializedStorageKey `json:"storageKey"`
+	Name       string                      `json:"name,omitempty"`
+}
+
+// StorageStorageBucketInfo https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-StorageBucketInfo
+type StorageStorageBucketInfo struct {
+	Bucket     *StorageStorageBucket           `json:"bucket"`
+	ID         string                          `json:"id"`
+	Expiration NetworkTimeSinceEpoch           `json:"expiration"`
+	Quota      float64                         `json:"quota"`
+	Persistent bool                            `json:"persistent"`
+	Durability StorageStorageBucketsDurability `json:"durability"`
+}
+
+// StorageAttributionReportingSourceType https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceType
+type StorageAttributionReportingSourceType string
+
+// StorageAttributionReportingSourceType values
+const (
+	StorageAttributionReportingSourceTypeNavigation StorageAttributionReportingSourceType = "navigation"
+	StorageAttributionReportingSourceTypeEvent      StorageAttributionReportingSourceType = "event"
+)
+
+// StorageUnsignedInt64AsBase10 https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UnsignedInt64AsBase10
+type StorageUnsignedInt64AsBase10 string
+
+// StorageUnsignedInt128AsBase16 https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-UnsignedInt128AsBase16
+type StorageUnsignedInt128AsBase16 string
+
+// StorageSignedInt64AsBase10 https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-SignedInt64AsBase10
+type StorageSignedInt64AsBase10 string
+
+// StorageAttributionReportingFilterDataEntry https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterDataEntry
+type StorageAttributionReportingFilterDataEntry struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// StorageAttributionReportingFilterConfig https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterConfig
+type StorageAttributionReportingFilterConfig struct {
+	FilterValues   []*StorageAttributionReportingFilterDataEntry `json:"filterValues"`
+	LookbackWindow int64                                         `json:"lookbackWindow,omitempty"`
+}
+
+// StorageAttributionReportingFilterPair https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingFilterPair
+type StorageAttributionReportingFilterPair struct {
+	Filters    []*StorageAttributionReportingFilterConfig `json:"filters"`
+	NotFilters []*StorageAttributionReportingFilterConfig `json:"notFilters"`
+}
+
+// StorageAttributionReportingAggregationKeysEntry https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregationKeysEntry
+type StorageAttributionReportingAggregationKeysEntry struct {
+	Key   string                        `json:"key"`
+	Value StorageUnsignedInt128AsBase16 `json:"value"`
+}
+
+// StorageAttributionReportingEventReportWindows https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventReportWindows
+type StorageAttributionReportingEventReportWindows struct {
+	Start int64   `json:"start"`
+	Ends  []int64 `json:"ends"`
+}
+
+// StorageAttributionReportingTriggerDataMatching https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingTriggerDataMatching
+type StorageAttributionReportingTriggerDataMatching string
+
+// StorageAttributionReportingTriggerDataMatching values
+const (
+	StorageAttributionReportingTriggerDataMatchingExact   StorageAttributionReportingTriggerDataMatching = "exact"
+	StorageAttributionReportingTriggerDataMatchingModulus StorageAttributionReportingTriggerDataMatching = "modulus"
+)
+
+// StorageAttributionReportingAggregatableDebugReportingData https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDebugReportingData
+type StorageAttributionReportingAggregatableDebugReportingData struct {
+	KeyPiece StorageUnsignedInt128AsBase16 `json:"keyPiece"`
+	Value    float64                       `json:"value"`
+	Types    []string                      `json:"types"`
+}
+
+// StorageAttributionReportingAggregatableDebugReportingConfig https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDebugReportingConfig
+type StorageAttributionReportingAggregatableDebugReportingConfig struct {
+	Budget                       float64                                                      `json:"budget,omitempty"`
+	KeyPiece                     StorageUnsignedInt128AsBase16                                `json:"keyPiece"`
+	DebugData                    []*StorageAttributionReportingAggregatableDebugReportingData `json:"debugData"`
+	AggregationCoordinatorOrigin string                                                       `json:"aggregationCoordinatorOrigin,omitempty"`
+}
+
+// StorageAttributionScopesData https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionScopesData
+type StorageAttributionScopesData struct {
+	Values         []string `json:"values"`
+	Limit          float64  `json:"limit"`
+	MaxEventStates float64  `json:"maxEventStates"`
+}
+
+// StorageAttributionReportingNamedBudgetDef https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingNamedBudgetDef
+type StorageAttributionReportingNamedBudgetDef struct {
+	Name   string `json:"name"`
+	Budget int64  `json:"budget"`
+}
+
+// StorageAttributionReportingSourceRegistration https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistration
+type StorageAttributionReportingSourceRegistration struct {
+	Time                             NetworkTimeSinceEpoch                                        `json:"time"`
+	Expiry                           int64                                                        `json:"expiry"`
+	TriggerData                      []float64                                                    `json:"triggerData"`
+	EventReportWindows               *StorageAttributionReportingEventReportWindows               `json:"eventReportWindows"`
+	AggregatableReportWindow         int64                                                        `json:"aggregatableReportWindow"`
+	Type                             StorageAttributionReportingSourceType                        `json:"type"`
+	SourceOrigin                     string                                                       `json:"sourceOrigin"`
+	ReportingOrigin                  string                                                       `json:"reportingOrigin"`
+	DestinationSites                 []string                                                     `json:"destinationSites"`
+	EventID                          StorageUnsignedInt64AsBase10                                 `json:"eventId"`
+	Priority                         StorageSignedInt64AsBase10                                   `json:"priority"`
+	FilterData                       []*StorageAttributionReportingFilterDataEntry                `json:"filterData"`
+	AggregationKeys                  []*StorageAttributionReportingAggregationKeysEntry           `json:"aggregationKeys"`
+	DebugKey                         StorageUnsignedInt64AsBase10                                 `json:"debugKey,omitempty"`
+	TriggerDataMatching              StorageAttributionReportingTriggerDataMatching               `json:"triggerDataMatching"`
+	DestinationLimitPriority         StorageSignedInt64AsBase10                                   `json:"destinationLimitPriority"`
+	AggregatableDebugReportingConfig *StorageAttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	ScopesData                       *StorageAttributionScopesData                                `json:"scopesData,omitempty"`
+	MaxEventLevelReports             int64                                                        `json:"maxEventLevelReports"`
+	NamedBudgets                     []*StorageAttributionReportingNamedBudgetDef                 `json:"namedBudgets"`
+	DebugReporting                   bool                                                         `json:"debugReporting"`
+	EventLevelEpsilon                float64                                                      `json:"eventLevelEpsilon"`
+}
+
+// StorageAttributionReportingSourceRegistrationResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistrationResult
+type StorageAttributionReportingSourceRegistrationResult string
+
+// StorageAttributionReportingSourceRegistrationResult values
+const (
+	StorageAttributionReportingSourceRegistrationResultSuccess                                StorageAttributionReportingSourceRegistrationResult = "success"
+	StorageAttributionReportingSourceRegistrationResultInternalError                          StorageAttributionReportingSourceRegistrationResult = "internalError"
+	StorageAttributionReportingSourceRegistrationResultInsufficientSourceCapacity             StorageAttributionReportingSourceRegistrationResult = "insufficientSourceCapacity"
+	StorageAttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity  StorageAttributionReportingSourceRegistrationResult = "insufficientUniqueDestinationCapacity"
+	StorageAttributionReportingSourceRegistrationResultExcessiveReportingOrigins              StorageAttributionReportingSourceRegistrationResult = "excessiveReportingOrigins"
+	StorageAttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy              StorageAttributionReportingSourceRegistrationResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingSourceRegistrationResultSuccessNoised                          StorageAttributionReportingSourceRegistrationResult = "successNoised"
+	StorageAttributionReportingSourceRegistrationResultDestinationReportingLimitReached       StorageAttributionReportingSourceRegistrationResult = "destinationReportingLimitReached"
+	StorageAttributionReportingSourceRegistrationResultDestinationGlobalLimitReached          StorageAttributionReportingSourceRegistrationResult = "destinationGlobalLimitReached"
+	StorageAttributionReportingSourceRegistrationResultDestinationBothLimitsReached           StorageAttributionReportingSourceRegistrationResult = "destinationBothLimitsReached"
+	StorageAttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached    StorageAttributionReportingSourceRegistrationResult = "reportingOriginsPerSiteLimitReached"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity              StorageAttributionReportingSourceRegistrationResult = "exceedsMaxChannelCapacity"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity        StorageAttributionReportingSourceRegistrationResult = "exceedsMaxScopesChannelCapacity"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality      StorageAttributionReportingSourceRegistrationResult = "exceedsMaxTriggerStateCardinality"
+	StorageAttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit             StorageAttributionReportingSourceRegistrationResult = "exceedsMaxEventStatesLimit"
+	StorageAttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached StorageAttributionReportingSourceRegistrationResult = "destinationPerDayReportingLimitReached"
+)
+
+// StorageAttributionReportingSourceRegistrationTimeConfig https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingSourceRegistrationTimeConfig
+type StorageAttributionReportingSourceRegistrationTimeConfig string
+
+// StorageAttributionReportingSourceRegistrationTimeConfig values
+const (
+	StorageAttributionReportingSourceRegistrationTimeConfigInclude StorageAttributionReportingSourceRegistrationTimeConfig = "include"
+	StorageAttributionReportingSourceRegistrationTimeConfigExclude StorageAttributionReportingSourceRegistrationTimeConfig = "exclude"
+)
+
+// StorageAttributionReportingAggregatableValueDictEntry https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableValueDictEntry
+type StorageAttributionReportingAggregatableValueDictEntry struct {
+	Key         string                       `json:"key"`
+	Value       float64                      `json:"value"`
+	FilteringID StorageUnsignedInt64AsBase10 `json:"filteringId"`
+}
+
+// StorageAttributionReportingAggregatableValueEntry https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableValueEntry
+type StorageAttributionReportingAggregatableValueEntry struct {
+	Values  []*StorageAttributionReportingAggregatableValueDictEntry `json:"values"`
+	Filters *StorageAttributionReportingFilterPair                   `json:"filters"`
+}
+
+// StorageAttributionReportingEventTriggerData https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventTriggerData
+type StorageAttributionReportingEventTriggerData struct {
+	Data     StorageUnsignedInt64AsBase10           `json:"data"`
+	Priority StorageSignedInt64AsBase10             `json:"priority"`
+	DedupKey StorageUnsignedInt64AsBase10           `json:"dedupKey,omitempty"`
+	Filters  *StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingAggregatableTriggerData https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableTriggerData
+type StorageAttributionReportingAggregatableTriggerData struct {
+	KeyPiece   StorageUnsignedInt128AsBase16          `json:"keyPiece"`
+	SourceKeys []string                               `json:"sourceKeys"`
+	Filters    *StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingAggregatableDedupKey https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableDedupKey
+type StorageAttributionReportingAggregatableDedupKey struct {
+	DedupKey StorageUnsignedInt64AsBase10           `json:"dedupKey,omitempty"`
+	Filters  *StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingNamedBudgetCandidate https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingNamedBudgetCandidate
+type StorageAttributionReportingNamedBudgetCandidate struct {
+	Name    string                                 `json:"name,omitempty"`
+	Filters *StorageAttributionReportingFilterPair `json:"filters"`
+}
+
+// StorageAttributionReportingTriggerRegistration https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingTriggerRegistration
+type StorageAttributionReportingTriggerRegistration struct {
+	Filters                          *StorageAttributionReportingFilterPair                       `json:"filters"`
+	DebugKey                         StorageUnsignedInt64AsBase10                                 `json:"debugKey,omitempty"`
+	AggregatableDedupKeys            []*StorageAttributionReportingAggregatableDedupKey           `json:"aggregatableDedupKeys"`
+	EventTriggerData                 []*StorageAttributionReportingEventTriggerData               `json:"eventTriggerData"`
+	AggregatableTriggerData          []*StorageAttributionReportingAggregatableTriggerData        `json:"aggregatableTriggerData"`
+	AggregatableValues               []*StorageAttributionReportingAggregatableValueEntry         `json:"aggregatableValues"`
+	AggregatableFilteringIDMaxBytes  int64                                                        `json:"aggregatableFilteringIdMaxBytes"`
+	DebugReporting                   bool                                                         `json:"debugReporting"`
+	AggregationCoordinatorOrigin     string                                                       `json:"aggregationCoordinatorOrigin,omitempty"`
+	SourceRegistrationTimeConfig     StorageAttributionReportingSourceRegistrationTimeConfig      `json:"sourceRegistrationTimeConfig"`
+	TriggerContextID                 string                                                       `json:"triggerContextId,omitempty"`
+	AggregatableDebugReportingConfig *StorageAttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	Scopes                           []string                                                     `json:"scopes"`
+	NamedBudgets                     []*StorageAttributionReportingNamedBudgetCandidate           `json:"namedBudgets"`
+}
+
+// StorageAttributionReportingEventLevelResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingEventLevelResult
+type StorageAttributionReportingEventLevelResult string
+
+// StorageAttributionReportingEventLevelResult values
+const (
+	StorageAttributionReportingEventLevelResultSuccess                             StorageAttributionReportingEventLevelResult = "success"
+	StorageAttributionReportingEventLevelResultSuccessDroppedLowerPriority         StorageAttributionReportingEventLevelResult = "successDroppedLowerPriority"
+	StorageAttributionReportingEventLevelResultInternalError                       StorageAttributionReportingEventLevelResult = "internalError"
+	StorageAttributionReportingEventLevelResultNoCapacityForAttributionDestination StorageAttributionReportingEventLevelResult = "noCapacityForAttributionDestination"
+	StorageAttributionReportingEventLevelResultNoMatchingSources                   StorageAttributionReportingEventLevelResult = "noMatchingSources"
+	StorageAttributionReportingEventLevelResultDeduplicated                        StorageAttributionReportingEventLevelResult = "deduplicated"
+	StorageAttributionReportingEventLevelResultExcessiveAttributions               StorageAttributionReportingEventLevelResult = "excessiveAttributions"
+	StorageAttributionReportingEventLevelResultPriorityTooLow                      StorageAttributionReportingEventLevelResult = "priorityTooLow"
+	StorageAttributionReportingEventLevelResultNeverAttributedSource               StorageAttributionReportingEventLevelResult = "neverAttributedSource"
+	StorageAttributionReportingEventLevelResultExcessiveReportingOrigins           StorageAttributionReportingEventLevelResult = "excessiveReportingOrigins"
+	StorageAttributionReportingEventLevelResultNoMatchingSourceFilterData          StorageAttributionReportingEventLevelResult = "noMatchingSourceFilterData"
+	StorageAttributionReportingEventLevelResultProhibitedByBrowserPolicy           StorageAttributionReportingEventLevelResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingEventLevelResultNoMatchingConfigurations            StorageAttributionReportingEventLevelResult = "noMatchingConfigurations"
+	StorageAttributionReportingEventLevelResultExcessiveReports                    StorageAttributionReportingEventLevelResult = "excessiveReports"
+	StorageAttributionReportingEventLevelResultFalselyAttributedSource             StorageAttributionReportingEventLevelResult = "falselyAttributedSource"
+	StorageAttributionReportingEventLevelResultReportWindowPassed                  StorageAttributionReportingEventLevelResult = "reportWindowPassed"
+	StorageAttributionReportingEventLevelResultNotRegistered                       StorageAttributionReportingEventLevelResult = "notRegistered"
+	StorageAttributionReportingEventLevelResultReportWindowNotStarted              StorageAttributionReportingEventLevelResult = "reportWindowNotStarted"
+	StorageAttributionReportingEventLevelResultNoMatchingTriggerData               StorageAttributionReportingEventLevelResult = "noMatchingTriggerData"
+)
+
+// StorageAttributionReportingAggregatableResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingAggregatableResult
+type StorageAttributionReportingAggregatableResult string
+
+// StorageAttributionReportingAggregatableResult values
+const (
+	StorageAttributionReportingAggregatableResultSuccess                             StorageAttributionReportingAggregatableResult = "success"
+	StorageAttributionReportingAggregatableResultInternalError                       StorageAttributionReportingAggregatableResult = "internalError"
+	StorageAttributionReportingAggregatableResultNoCapacityForAttributionDestination StorageAttributionReportingAggregatableResult = "noCapacityForAttributionDestination"
+	StorageAttributionReportingAggregatableResultNoMatchingSources                   StorageAttributionReportingAggregatableResult = "noMatchingSources"
+	StorageAttributionReportingAggregatableResultExcessiveAttributions               StorageAttributionReportingAggregatableResult = "excessiveAttributions"
+	StorageAttributionReportingAggregatableResultExcessiveReportingOrigins           StorageAttributionReportingAggregatableResult = "excessiveReportingOrigins"
+	StorageAttributionReportingAggregatableResultNoHistograms                        StorageAttributionReportingAggregatableResult = "noHistograms"
+	StorageAttributionReportingAggregatableResultInsufficientBudget                  StorageAttributionReportingAggregatableResult = "insufficientBudget"
+	StorageAttributionReportingAggregatableResultInsufficientNamedBudget             StorageAttributionReportingAggregatableResult = "insufficientNamedBudget"
+	StorageAttributionReportingAggregatableResultNoMatchingSourceFilterData          StorageAttributionReportingAggregatableResult = "noMatchingSourceFilterData"
+	StorageAttributionReportingAggregatableResultNotRegistered                       StorageAttributionReportingAggregatableResult = "notRegistered"
+	StorageAttributionReportingAggregatableResultProhibitedByBrowserPolicy           StorageAttributionReportingAggregatableResult = "prohibitedByBrowserPolicy"
+	StorageAttributionReportingAggregatableResultDeduplicated                        StorageAttributionReportingAggregatableResult = "deduplicated"
+	StorageAttributionReportingAggregatableResultReportWindowPassed                  StorageAttributionReportingAggregatableResult = "reportWindowPassed"
+	StorageAttributionReportingAggregatableResultExcessiveReports                    StorageAttributionReportingAggregatableResult = "excessiveReports"
+)
+
+// StorageAttributionReportingReportResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-AttributionReportingReportResult
+type StorageAttributionReportingReportResult string
+
+// StorageAttributionReportingReportResult values
+const (
+	StorageAttributionReportingReportResultSent             StorageAttributionReportingReportResult = "sent"
+	StorageAttributionReportingReportResultProhibited       StorageAttributionReportingReportResult = "prohibited"
+	StorageAttributionReportingReportResultFailedToAssemble StorageAttributionReportingReportResult = "failedToAssemble"
+	StorageAttributionReportingReportResultExpired          StorageAttributionReportingReportResult = "expired"
+)
+
+// StorageRelatedWebsiteSet https://chromedevtools.github.io/devtools-protocol/tot/Storage#type-RelatedWebsiteSet
+type StorageRelatedWebsiteSet struct {
+	PrimarySites    []string `json:"primarySites"`
+	AssociatedSites []string `json:"associatedSites"`
+	ServiceSites    []string `json:"serviceSites"`
+}
+
+// StorageGetStorageKeyForFrameParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getStorageKeyForFrame
+type StorageGetStorageKeyForFrameParams struct {
+	FrameID PageFrameID `json:"frameId"`
+}
+
+// ProtocolMethod returns Storage.getStorageKeyForFrame
+func (*StorageGetStorageKeyForFrameParams) ProtocolMethod() string {
+	return "Storage.getStorageKeyForFrame"
+}
+
+// StorageGetStorageKeyForFrameResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getStorageKeyForFrame
+type StorageGetStorageKeyForFrameResult struct {
+	StorageKey StorageSerializedStorageKey `json:"storageKey"`
+}
+
+// Do sends Storage.getStorageKeyForFrame and returns its result
+func (p *StorageGetStorageKeyForFrameParams) Do(e Executor) (*StorageGetStorageKeyForFrameResult, error) {
+	var result = new(StorageGetStorageKeyForFrameResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageClearDataForOriginParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearDataForOrigin
+type StorageClearDataForOriginParams struct {
+	Origin       string `json:"origin"`
+	StorageTypes string `json:"storageTypes"`
+}
+
+// ProtocolMethod returns Storage.clearDataForOrigin
+func (*StorageClearDataForOriginParams) ProtocolMethod() string {
+	return "Storage.clearDataForOrigin"
+}
+
+// Do sends Storage.clearDataForOrigin
+func (p *StorageClearDataForOriginParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageClearDataForStorageKeyParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearDataForStorageKey
+type StorageClearDataForStorageKeyParams struct {
+	StorageKey   string `json:"storageKey"`
+	StorageTypes string `json:"storageTypes"`
+}
+
+// ProtocolMethod returns Storage.clearDataForStorageKey
+func (*StorageClearDataForStorageKeyParams) ProtocolMethod() string {
+	return "Storage.clearDataForStorageKey"
+}
+
+// Do sends Storage.clearDataForStorageKey
+func (p *StorageClearDataForStorageKeyParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageGetCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getCookies
+type StorageGetCookiesParams struct {
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Storage.getCookies
+func (*StorageGetCookiesParams) ProtocolMethod() string {
+	return "Storage.getCookies"
+}
+
+// StorageGetCookiesResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getCookies
+type StorageGetCookiesResult struct {
+	Cookies []*NetworkCookie `json:"cookies"`
+}
+
+// Do sends Storage.getCookies and returns its result
+func (p *StorageGetCookiesParams) Do(e Executor) (*StorageGetCookiesResult, error) {
+	var result = new(StorageGetCookiesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageSetCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setCookies
+type StorageSetCookiesParams struct {
+	Cookies          []*NetworkCookieParam   `json:"cookies"`
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Storage.setCookies
+func (*StorageSetCookiesParams) ProtocolMethod() string {
+	return "Storage.setCookies"
+}
+
+// Do sends Storage.setCookies
+func (p *StorageSetCookiesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageClearCookiesParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearCookies
+type StorageClearCookiesParams struct {
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// ProtocolMethod returns Storage.clearCookies
+func (*StorageClearCookiesParams) ProtocolMethod() string {
+	return "Storage.clearCookies"
+}
+
+// Do sends Storage.clearCookies
+func (p *StorageClearCookiesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageGetUsageAndQuotaParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getUsageAndQuota
+type StorageGetUsageAndQuotaParams struct {
+	Origin string `json:"origin"`
+}
+
+// ProtocolMethod returns Storage.getUsageAndQuota
+func (*StorageGetUsageAndQuotaParams) ProtocolMethod() string {
+	return "Storage.getUsageAndQuota"
+}
+
+// StorageGetUsageAndQuotaResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getUsageAndQuota
+type StorageGetUsageAndQuotaResult struct {
+	Usage          float64                `json:"usage"`
+	Quota          float64                `json:"quota"`
+	OverrideActive bool                   `json:"overrideActive"`
+	UsageBreakdown []*StorageUsageForType `json:"usageBreakdown"`
+}
+
+// Do sends Storage.getUsageAndQuota and returns its result
+func (p *StorageGetUsageAndQuotaParams) Do(e Executor) (*StorageGetUsageAndQuotaResult, error) {
+	var result = new(StorageGetUsageAndQuotaResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageOverrideQuotaForOriginParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-overrideQuotaForOrigin
+type StorageOverrideQuotaForOriginParams struct {
+	Origin    string  `json:"origin"`
+	QuotaSize float64 `json:"quotaSize,omitempty"`
+}
+
+// ProtocolMethod returns Storage.overrideQuotaForOrigin
+func (*StorageOverrideQuotaForOriginParams) ProtocolMethod() string {
+	return "Storage.overrideQuotaForOrigin"
+}
+
+// Do sends Storage.overrideQuotaForOrigin
+func (p *StorageOverrideQuotaForOriginParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageTrackCacheStorageForOriginParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackCacheStorageForOrigin
+type StorageTrackCacheStorageForOriginParams struct {
+	Origin string `json:"origin"`
+}
+
+// ProtocolMethod returns Storage.trackCacheStorageForOrigin
+func (*StorageTrackCacheStorageForOriginParams) ProtocolMethod() string {
+	return "Storage.trackCacheStorageForOrigin"
+}
+
+// Do sends Storage.trackCacheStorageForOrigin
+func (p *StorageTrackCacheStorageForOriginParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageTrackCacheStorageForStorageKeyParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackCacheStorageForStorageKey
+type StorageTrackCacheStorageForStorageKeyParams struct {
+	StorageKey string `json:"storageKey"`
+}
+
+// ProtocolMethod returns Storage.trackCacheStorageForStorageKey
+func (*StorageTrackCacheStorageForStorageKeyParams) ProtocolMethod() string {
+	return "Storage.trackCacheStorageForStorageKey"
+}
+
+// Do sends Storage.trackCacheStorageForStorageKey
+func (p *StorageTrackCacheStorageForStorageKeyParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageTrackIndexedDBForOriginParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackIndexedDBForOrigin
+type StorageTrackIndexedDBForOriginParams struct {
+	Origin string `json:"origin"`
+}
+
+// ProtocolMethod returns Storage.trackIndexedDBForOrigin
+func (*StorageTrackIndexedDBForOriginParams) ProtocolMethod() string {
+	return "Storage.trackIndexedDBForOrigin"
+}
+
+// Do sends Storage.trackIndexedDBForOrigin
+func (p *StorageTrackIndexedDBForOriginParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageTrackIndexedDBForStorageKeyParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-trackIndexedDBForStorageKey
+type StorageTrackIndexedDBForStorageKeyParams struct {
+	StorageKey string `json:"storageKey"`
+}
+
+// ProtocolMethod returns Storage.trackIndexedDBForStorageKey
+func (*StorageTrackIndexedDBForStorageKeyParams) ProtocolMethod() string {
+	return "Storage.trackIndexedDBForStorageKey"
+}
+
+// Do sends Storage.trackIndexedDBForStorageKey
+func (p *StorageTrackIndexedDBForStorageKeyParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageUntrackCacheStorageForOriginParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackCacheStorageForOrigin
+type StorageUntrackCacheStorageForOriginParams struct {
+	Origin string `json:"origin"`
+}
+
+// ProtocolMethod returns Storage.untrackCacheStorageForOrigin
+func (*StorageUntrackCacheStorageForOriginParams) ProtocolMethod() string {
+	return "Storage.untrackCacheStorageForOrigin"
+}
+
+// Do sends Storage.untrackCacheStorageForOrigin
+func (p *StorageUntrackCacheStorageForOriginParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageUntrackCacheStorageForStorageKeyParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackCacheStorageForStorageKey
+type StorageUntrackCacheStorageForStorageKeyParams struct {
+	StorageKey string `json:"storageKey"`
+}
+
+// ProtocolMethod returns Storage.untrackCacheStorageForStorageKey
+func (*StorageUntrackCacheStorageForStorageKeyParams) ProtocolMethod() string {
+	return "Storage.untrackCacheStorageForStorageKey"
+}
+
+// Do sends Storage.untrackCacheStorageForStorageKey
+func (p *StorageUntrackCacheStorageForStorageKeyParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageUntrackIndexedDBForOriginParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackIndexedDBForOrigin
+type StorageUntrackIndexedDBForOriginParams struct {
+	Origin string `json:"origin"`
+}
+
+// ProtocolMethod returns Storage.untrackIndexedDBForOrigin
+func (*StorageUntrackIndexedDBForOriginParams) ProtocolMethod() string {
+	return "Storage.untrackIndexedDBForOrigin"
+}
+
+// Do sends Storage.untrackIndexedDBForOrigin
+func (p *StorageUntrackIndexedDBForOriginParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageUntrackIndexedDBForStorageKeyParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-untrackIndexedDBForStorageKey
+type StorageUntrackIndexedDBForStorageKeyParams struct {
+	StorageKey string `json:"storageKey"`
+}
+
+// ProtocolMethod returns Storage.untrackIndexedDBForStorageKey
+func (*StorageUntrackIndexedDBForStorageKeyParams) ProtocolMethod() string {
+	return "Storage.untrackIndexedDBForStorageKey"
+}
+
+// Do sends Storage.untrackIndexedDBForStorageKey
+func (p *StorageUntrackIndexedDBForStorageKeyParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageGetTrustTokensParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getTrustTokens
+type StorageGetTrustTokensParams struct {
+}
+
+// ProtocolMethod returns Storage.getTrustTokens
+func (*StorageGetTrustTokensParams) ProtocolMethod() string {
+	return "Storage.getTrustTokens"
+}
+
+// StorageGetTrustTokensResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getTrustTokens
+type StorageGetTrustTokensResult struct {
+	Tokens []*StorageTrustTokens `json:"tokens"`
+}
+
+// Do sends Storage.getTrustTokens and returns its result
+func (p *StorageGetTrustTokensParams) Do(e Executor) (*StorageGetTrustTokensResult, error) {
+	var result = new(StorageGetTrustTokensResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageClearTrustTokensParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearTrustTokens
+type StorageClearTrustTokensParams struct {
+	IssuerOrigin string `json:"issuerOrigin"`
+}
+
+// ProtocolMethod returns Storage.clearTrustTokens
+func (*StorageClearTrustTokensParams) ProtocolMethod() string {
+	return "Storage.clearTrustTokens"
+}
+
+// StorageClearTrustTokensResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearTrustTokens
+type StorageClearTrustTokensResult struct {
+	DidDeleteTokens bool `json:"didDeleteTokens"`
+}
+
+// Do sends Storage.clearTrustTokens and returns its result
+func (p *StorageClearTrustTokensParams) Do(e Executor) (*StorageClearTrustTokensResult, error) {
+	var result = new(StorageClearTrustTokensResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageGetInterestGroupDetailsParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getInterestGroupDetails
+type StorageGetInterestGroupDetailsParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Name        string `json:"name"`
+}
+
+// ProtocolMethod returns Storage.getInterestGroupDetails
+func (*StorageGetInterestGroupDetailsParams) ProtocolMethod() string {
+	return "Storage.getInterestGroupDetails"
+}
+
+// StorageGetInterestGroupDetailsResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getInterestGroupDetails
+type StorageGetInterestGroupDetailsResult struct {
+	Details map[string]interface{} `json:"details"`
+}
+
+// Do sends Storage.getInterestGroupDetails and returns its result
+func (p *StorageGetInterestGroupDetailsParams) Do(e Executor) (*StorageGetInterestGroupDetailsResult, error) {
+	var result = new(StorageGetInterestGroupDetailsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageSetInterestGroupTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setInterestGroupTracking
+type StorageSetInterestGroupTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// ProtocolMethod returns Storage.setInterestGroupTracking
+func (*StorageSetInterestGroupTrackingParams) ProtocolMethod() string {
+	return "Storage.setInterestGroupTracking"
+}
+
+// Do sends Storage.setInterestGroupTracking
+func (p *StorageSetInterestGroupTrackingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageSetInterestGroupAuctionTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setInterestGroupAuctionTracking
+type StorageSetInterestGroupAuctionTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// ProtocolMethod returns Storage.setInterestGroupAuctionTracking
+func (*StorageSetInterestGroupAuctionTrackingParams) ProtocolMethod() string {
+	return "Storage.setInterestGroupAuctionTracking"
+}
+
+// Do sends Storage.setInterestGroupAuctionTracking
+func (p *StorageSetInterestGroupAuctionTrackingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageGetSharedStorageMetadataParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getSharedStorageMetadata
+type StorageGetSharedStorageMetadataParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ProtocolMethod returns Storage.getSharedStorageMetadata
+func (*StorageGetSharedStorageMetadataParams) ProtocolMethod() string {
+	return "Storage.getSharedStorageMetadata"
+}
+
+// StorageGetSharedStorageMetadataResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getSharedStorageMetadata
+type StorageGetSharedStorageMetadataResult struct {
+	Metadata *StorageSharedStorageMetadata `json:"metadata"`
+}
+
+// Do sends Storage.getSharedStorageMetadata and returns its result
+func (p *StorageGetSharedStorageMetadataParams) Do(e Executor) (*StorageGetSharedStorageMetadataResult, error) {
+	var result = new(StorageGetSharedStorageMetadataResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageGetSharedStorageEntriesParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getSharedStorageEntries
+type StorageGetSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ProtocolMethod returns Storage.getSharedStorageEntries
+func (*StorageGetSharedStorageEntriesParams) ProtocolMethod() string {
+	return "Storage.getSharedStorageEntries"
+}
+
+// StorageGetSharedStorageEntriesResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getSharedStorageEntries
+type StorageGetSharedStorageEntriesResult struct {
+	Entries []*StorageSharedStorageEntry `json:"entries"`
+}
+
+// Do sends Storage.getSharedStorageEntries and returns its result
+func (p *StorageGetSharedStorageEntriesParams) Do(e Executor) (*StorageGetSharedStorageEntriesResult, error) {
+	var result = new(StorageGetSharedStorageEntriesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageSetSharedStorageEntryParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setSharedStorageEntry
+type StorageSetSharedStorageEntryParams struct {
+	OwnerOrigin     string `json:"ownerOrigin"`
+	Key             string `json:"key"`
+	Value           string `json:"value"`
+	IgnoreIfPresent bool   `json:"ignoreIfPresent,omitempty"`
+}
+
+// ProtocolMethod returns Storage.setSharedStorageEntry
+func (*StorageSetSharedStorageEntryParams) ProtocolMethod() string {
+	return "Storage.setSharedStorageEntry"
+}
+
+// Do sends Storage.setSharedStorageEntry
+func (p *StorageSetSharedStorageEntryParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageDeleteSharedStorageEntryParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-deleteSharedStorageEntry
+type StorageDeleteSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+}
+
+// ProtocolMethod returns Storage.deleteSharedStorageEntry
+func (*StorageDeleteSharedStorageEntryParams) ProtocolMethod() string {
+	return "Storage.deleteSharedStorageEntry"
+}
+
+// Do sends Storage.deleteSharedStorageEntry
+func (p *StorageDeleteSharedStorageEntryParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageClearSharedStorageEntriesParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-clearSharedStorageEntries
+type StorageClearSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ProtocolMethod returns Storage.clearSharedStorageEntries
+func (*StorageClearSharedStorageEntriesParams) ProtocolMethod() string {
+	return "Storage.clearSharedStorageEntries"
+}
+
+// Do sends Storage.clearSharedStorageEntries
+func (p *StorageClearSharedStorageEntriesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageResetSharedStorageBudgetParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-resetSharedStorageBudget
+type StorageResetSharedStorageBudgetParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// ProtocolMethod returns Storage.resetSharedStorageBudget
+func (*StorageResetSharedStorageBudgetParams) ProtocolMethod() string {
+	return "Storage.resetSharedStorageBudget"
+}
+
+// Do sends Storage.resetSharedStorageBudget
+func (p *StorageResetSharedStorageBudgetParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageSetSharedStorageTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setSharedStorageTracking
+type StorageSetSharedStorageTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// ProtocolMethod returns Storage.setSharedStorageTracking
+func (*StorageSetSharedStorageTrackingParams) ProtocolMethod() string {
+	return "Storage.setSharedStorageTracking"
+}
+
+// Do sends Storage.setSharedStorageTracking
+func (p *StorageSetSharedStorageTrackingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageSetStorageBucketTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setStorageBucketTracking
+type StorageSetStorageBucketTrackingParams struct {
+	StorageKey string `json:"storageKey"`
+	Enable     bool   `json:"enable"`
+}
+
+// ProtocolMethod returns Storage.setStorageBucketTracking
+func (*StorageSetStorageBucketTrackingParams) ProtocolMethod() string {
+	return "Storage.setStorageBucketTracking"
+}
+
+// Do sends Storage.setStorageBucketTracking
+func (p *StorageSetStorageBucketTrackingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageDeleteStorageBucketParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-deleteStorageBucket
+type StorageDeleteStorageBucketParams struct {
+	Bucket *StorageStorageBucket `json:"bucket"`
+}
+
+// ProtocolMethod returns Storage.deleteStorageBucket
+func (*StorageDeleteStorageBucketParams) ProtocolMethod() string {
+	return "Storage.deleteStorageBucket"
+}
+
+// Do sends Storage.deleteStorageBucket
+func (p *StorageDeleteStorageBucketParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageRunBounceTrackingMitigationsParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-runBounceTrackingMitigations
+type StorageRunBounceTrackingMitigationsParams struct {
+}
+
+// ProtocolMethod returns Storage.runBounceTrackingMitigations
+func (*StorageRunBounceTrackingMitigationsParams) ProtocolMethod() string {
+	return "Storage.runBounceTrackingMitigations"
+}
+
+// StorageRunBounceTrackingMitigationsResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-runBounceTrackingMitigations
+type StorageRunBounceTrackingMitigationsResult struct {
+	DeletedSites []string `json:"deletedSites"`
+}
+
+// Do sends Storage.runBounceTrackingMitigations and returns its result
+func (p *StorageRunBounceTrackingMitigationsParams) Do(e Executor) (*StorageRunBounceTrackingMitigationsResult, error) {
+	var result = new(StorageRunBounceTrackingMitigationsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageSetAttributionReportingLocalTestingModeParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setAttributionReportingLocalTestingMode
+type StorageSetAttributionReportingLocalTestingModeParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ProtocolMethod returns Storage.setAttributionReportingLocalTestingMode
+func (*StorageSetAttributionReportingLocalTestingModeParams) ProtocolMethod() string {
+	return "Storage.setAttributionReportingLocalTestingMode"
+}
+
+// Do sends Storage.setAttributionReportingLocalTestingMode
+func (p *StorageSetAttributionReportingLocalTestingModeParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageSetAttributionReportingTrackingParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setAttributionReportingTracking
+type StorageSetAttributionReportingTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// ProtocolMethod returns Storage.setAttributionReportingTracking
+func (*StorageSetAttributionReportingTrackingParams) ProtocolMethod() string {
+	return "Storage.setAttributionReportingTracking"
+}
+
+// Do sends Storage.setAttributionReportingTracking
+func (p *StorageSetAttributionReportingTrackingParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageSendPendingAttributionReportsParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-sendPendingAttributionReports
+type StorageSendPendingAttributionReportsParams struct {
+}
+
+// ProtocolMethod returns Storage.sendPendingAttributionReports
+func (*StorageSendPendingAttributionReportsParams) ProtocolMethod() string {
+	return "Storage.sendPendingAttributionReports"
+}
+
+// StorageSendPendingAttributionReportsResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-sendPendingAttributionReports
+type StorageSendPendingAttributionReportsResult struct {
+	NumSent int64 `json:"numSent"`
+}
+
+// Do sends Storage.sendPendingAttributionReports and returns its result
+func (p *StorageSendPendingAttributionReportsParams) Do(e Executor) (*StorageSendPendingAttributionReportsResult, error) {
+	var result = new(StorageSendPendingAttributionReportsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageGetRelatedWebsiteSetsParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getRelatedWebsiteSets
+type StorageGetRelatedWebsiteSetsParams struct {
+}
+
+// ProtocolMethod returns Storage.getRelatedWebsiteSets
+func (*StorageGetRelatedWebsiteSetsParams) ProtocolMethod() string {
+	return "Storage.getRelatedWebsiteSets"
+}
+
+// StorageGetRelatedWebsiteSetsResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getRelatedWebsiteSets
+type StorageGetRelatedWebsiteSetsResult struct {
+	Sets []*StorageRelatedWebsiteSet `json:"sets"`
+}
+
+// Do sends Storage.getRelatedWebsiteSets and returns its result
+func (p *StorageGetRelatedWebsiteSetsParams) Do(e Executor) (*StorageGetRelatedWebsiteSetsResult, error) {
+	var result = new(StorageGetRelatedWebsiteSetsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageGetAffectedUrlsForThirdPartyCookieMetadataParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getAffectedUrlsForThirdPartyCookieMetadata
+type StorageGetAffectedUrlsForThirdPartyCookieMetadataParams struct {
+	FirstPartyURL  string   `json:"firstPartyUrl"`
+	ThirdPartyUrls []string `json:"thirdPartyUrls"`
+}
+
+// ProtocolMethod returns Storage.getAffectedUrlsForThirdPartyCookieMetadata
+func (*StorageGetAffectedUrlsForThirdPartyCookieMetadataParams) ProtocolMethod() string {
+	return "Storage.getAffectedUrlsForThirdPartyCookieMetadata"
+}
+
+// StorageGetAffectedUrlsForThirdPartyCookieMetadataResult https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-getAffectedUrlsForThirdPartyCookieMetadata
+type StorageGetAffectedUrlsForThirdPartyCookieMetadataResult struct {
+	MatchedUrls []string `json:"matchedUrls"`
+}
+
+// Do sends Storage.getAffectedUrlsForThirdPartyCookieMetadata and returns its result
+func (p *StorageGetAffectedUrlsForThirdPartyCookieMetadataParams) Do(e Executor) (*StorageGetAffectedUrlsForThirdPartyCookieMetadataResult, error) {
+	var result = new(StorageGetAffectedUrlsForThirdPartyCookieMetadataResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StorageSetProtectedAudienceKAnonymityParams https://chromedevtools.github.io/devtools-protocol/tot/Storage#method-setProtectedAudienceKAnonymity
+type StorageSetProtectedAudienceKAnonymityParams struct {
+	Owner  string   `json:"owner"`
+	Name   string   `json:"name"`
+	Hashes []string `json:"hashes"`
+}
+
+// ProtocolMethod returns Storage.setProtectedAudienceKAnonymity
+func (*StorageSetProtectedAudienceKAnonymityParams) ProtocolMethod() string {
+	return "Storage.setProtectedAudienceKAnonymity"
+}
+
+// Do sends Storage.setProtectedAudienceKAnonymity
+func (p *StorageSetProtectedAudienceKAnonymityParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// StorageCacheStorageContentUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-cacheStorageContentUpdated
+type StorageCacheStorageContentUpdatedEvent struct {
+	Origin     string `json:"origin"`
+	StorageKey string `json:"storageKey"`
+	BucketID   string `json:"bucketId"`
+	CacheName  string `json:"cacheName"`
+}
+
+// ProtocolMethod returns Storage.cacheStorageContentUpdated
+func (*StorageCacheStorageContentUpdatedEvent) ProtocolMethod() string {
+	return "Storage.cacheStorageContentUpdated"
+}
+
+// StorageCacheStorageListUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-cacheStorageListUpdated
+type StorageCacheStorageListUpdatedEvent struct {
+	Origin     string `json:"origin"`
+	StorageKey string `json:"storageKey"`
+	BucketID   string `json:"bucketId"`
+}
+
+// ProtocolMethod returns Storage.cacheStorageListUpdated
+func (*StorageCacheStorageListUpdatedEvent) ProtocolMethod() string {
+	return "Storage.cacheStorageListUpdated"
+}
+
+// StorageIndexedDBContentUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-indexedDBContentUpdated
+type StorageIndexedDBContentUpdatedEvent struct {
+	Origin          string `json:"origin"`
+	StorageKey      string `json:"storageKey"`
+	BucketID        string `json:"bucketId"`
+	DatabaseName    string `json:"databaseName"`
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// ProtocolMethod returns Storage.indexedDBContentUpdated
+func (*StorageIndexedDBContentUpdatedEvent) ProtocolMethod() string {
+	return "Storage.indexedDBContentUpdated"
+}
+
+// StorageIndexedDBListUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-indexedDBListUpdated
+type StorageIndexedDBListUpdatedEvent struct {
+	Origin     string `json:"origin"`
+	StorageKey string `json:"storageKey"`
+	BucketID   string `json:"bucketId"`
+}
+
+// ProtocolMethod returns Storage.indexedDBListUpdated
+func (*StorageIndexedDBListUpdatedEvent) ProtocolMethod() string {
+	return "Storage.indexedDBListUpdated"
+}
+
+// StorageInterestGroupAccessedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-interestGroupAccessed
+type StorageInterestGroupAccessedEvent struct {
+	AccessTime            NetworkTimeSinceEpoch          `json:"accessTime"`
+	Type                  StorageInterestGroupAccessType `json:"type"`
+	OwnerOrigin           string                         `json:"ownerOrigin"`
+	Name                  string                         `json:"name"`
+	ComponentSellerOrigin string                         `json:"componentSellerOrigin,omitempty"`
+	Bid                   float64                        `json:"bid,omitempty"`
+	BidCurrency           string                         `json:"bidCurrency,omitempty"`
+	UniqueAuctionID       StorageInterestGroupAuctionID  `json:"uniqueAuctionId,omitempty"`
+}
+
+// ProtocolMethod returns Storage.interestGroupAccessed
+func (*StorageInterestGroupAccessedEvent) ProtocolMethod() string {
+	return "Storage.interestGroupAccessed"
+}
+
+// StorageInterestGroupAuctionEventOccurredEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-interestGroupAuctionEventOccurred
+type StorageInterestGroupAuctionEventOccurredEvent struct {
+	EventTime       NetworkTimeSinceEpoch                `json:"eventTime"`
+	Type            StorageInterestGroupAuctionEventType `json:"type"`
+	UniqueAuctionID StorageInterestGroupAuctionID        `json:"uniqueAuctionId"`
+	ParentAuctionID StorageInterestGroupAuctionID        `json:"parentAuctionId,omitempty"`
+	AuctionConfig   map[string]interface{}               `json:"auctionConfig,omitempty"`
+}
+
+// ProtocolMethod returns Storage.interestGroupAuctionEventOccurred
+func (*StorageInterestGroupAuctionEventOccurredEvent) ProtocolMethod() string {
+	return "Storage.interestGroupAuctionEventOccurred"
+}
+
+// StorageInterestGroupAuctionNetworkRequestCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-interestGroupAuctionNetworkRequestCreated
+type StorageInterestGroupAuctionNetworkRequestCreatedEvent struct {
+	Type      StorageInterestGroupAuctionFetchType `json:"type"`
+	RequestID NetworkRequestID                     `json:"requestId"`
+	Auctions  []StorageInterestGroupAuctionID      `json:"auctions"`
+}
+
+// ProtocolMethod returns Storage.interestGroupAuctionNetworkRequestCreated
+func (*StorageInterestGroupAuctionNetworkRequestCreatedEvent) ProtocolMethod() string {
+	return "Storage.interestGroupAuctionNetworkRequestCreated"
+}
+
+// StorageSharedStorageAccessedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-sharedStorageAccessed
+type StorageSharedStorageAccessedEvent struct {
+	AccessTime  NetworkTimeSinceEpoch             `json:"accessTime"`
+	Scope       StorageSharedStorageAccessScope   `json:"scope"`
+	Method      StorageSharedStorageAccessMethod  `json:"method"`
+	MainFrameID PageFrameID                       `json:"mainFrameId"`
+	OwnerOrigin string                            `json:"ownerOrigin"`
+	OwnerSite   string                            `json:"ownerSite"`
+	Params      *StorageSharedStorageAccessParams `json:"params"`
+}
+
+// ProtocolMethod returns Storage.sharedStorageAccessed
+func (*StorageSharedStorageAccessedEvent) ProtocolMethod() string {
+	return "Storage.sharedStorageAccessed"
+}
+
+// StorageSharedStorageWorkletOperationExecutionFinishedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-sharedStorageWorkletOperationExecutionFinished
+type StorageSharedStorageWorkletOperationExecutionFinishedEvent struct {
+	FinishedTime    NetworkTimeSinceEpoch            `json:"finishedTime"`
+	ExecutionTime   int64                            `json:"executionTime"`
+	Method          StorageSharedStorageAccessMethod `json:"method"`
+	OperationID     string                           `json:"operationId"`
+	WorkletTargetID TargetTargetID                   `json:"workletTargetId"`
+	MainFrameID     PageFrameID                      `json:"mainFrameId"`
+	OwnerOrigin     string                           `json:"ownerOrigin"`
+}
+
+// ProtocolMethod returns Storage.sharedStorageWorkletOperationExecutionFinished
+func (*StorageSharedStorageWorkletOperationExecutionFinishedEvent) ProtocolMethod() string {
+	return "Storage.sharedStorageWorkletOperationExecutionFinished"
+}
+
+// StorageStorageBucketCreatedOrUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-storageBucketCreatedOrUpdated
+type StorageStorageBucketCreatedOrUpdatedEvent struct {
+	BucketInfo *StorageStorageBucketInfo `json:"bucketInfo"`
+}
+
+// ProtocolMethod returns Storage.storageBucketCreatedOrUpdated
+func (*StorageStorageBucketCreatedOrUpdatedEvent) ProtocolMethod() string {
+	return "Storage.storageBucketCreatedOrUpdated"
+}
+
+// StorageStorageBucketDeletedEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-storageBucketDeleted
+type StorageStorageBucketDeletedEvent struct {
+	BucketID string `json:"bucketId"`
+}
+
+// ProtocolMethod returns Storage.storageBucketDeleted
+func (*StorageStorageBucketDeletedEvent) ProtocolMethod() string {
+	return "Storage.storageBucketDeleted"
+}
+
+// StorageAttributionReportingSourceRegisteredEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingSourceRegistered
+type StorageAttributionReportingSourceRegisteredEvent struct {
+	Registration *StorageAttributionReportingSourceRegistration      `json:"registration"`
+	Result       StorageAttributionReportingSourceRegistrationResult `json:"result"`
+}
+
+// ProtocolMethod returns Storage.attributionReportingSourceRegistered
+func (*StorageAttributionReportingSourceRegisteredEvent) ProtocolMethod() string {
+	return "Storage.attributionReportingSourceRegistered"
+}
+
+// StorageAttributionReportingTriggerRegisteredEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingTriggerRegistered
+type StorageAttributionReportingTriggerRegisteredEvent struct {
+	Registration *StorageAttributionReportingTriggerRegistration `json:"registration"`
+	EventLevel   StorageAttributionReportingEventLevelResult     `json:"eventLevel"`
+	Aggregatable StorageAttributionReportingAggregatableResult   `json:"aggregatable"`
+}
+
+// ProtocolMethod returns Storage.attributionReportingTriggerRegistered
+func (*StorageAttributionReportingTriggerRegisteredEvent) ProtocolMethod() string {
+	return "Storage.attributionReportingTriggerRegistered"
+}
+
+// StorageAttributionReportingReportSentEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingReportSent
+type StorageAttributionReportingReportSentEvent struct {
+	URL            string                                  `json:"url"`
+	Body           map[string]interface{}                  `json:"body"`
+	Result         StorageAttributionReportingReportResult `json:"result"`
+	NetError       int64                                   `json:"netError,omitempty"`
+	NetErrorName   string                                  `json:"netErrorName,omitempty"`
+	HTTPStatusCode int64                                   `json:"httpStatusCode,omitempty"`
+}
+
+// ProtocolMethod returns Storage.attributionReportingReportSent
+func (*StorageAttributionReportingReportSentEvent) ProtocolMethod() string {
+	return "Storage.attributionReportingReportSent"
+}
+
+// StorageAttributionReportingVerboseDebugReportSentEvent https://chromedevtools.github.io/devtools-protocol/tot/Storage#event-attributionReportingVerboseDebugReportSent
+type StorageAttributionReportingVerboseDebugReportSentEvent struct {
+	URL            string                   `json:"url"`
+	Body           []map[string]interface{} `json:"body,omitempty"`
+	NetError       int64                    `json:"netError,omitempty"`
+	NetErrorName   string                   `json:"netErrorName,omitempty"`
+	HTTPStatusCode int64                    `json:"httpStatusCode,omitempty"`
+}
+
+// ProtocolMethod returns Storage.attributionReportingVerboseDebugReportSent
+func (*StorageAttributionReportingVerboseDebugReportSentEvent) ProtocolMethod() string {
+	return "Storage.attributionReportingVerboseDebugReportSent"
+}
@@ -0,0 +1,155 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// SystemInfoGPUDevice https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-GPUDevice
+type SystemInfoGPUDevice struct {
+	VendorID      float64 `json:"vendorId"`
+	DeviceID      float64 `json:"deviceId"`
+	SubSysID      float64 `json:"subSysId,omitempty"`
+	Revision      float64 `json:"revision,omitempty"`
+	VendorString  string  `json:"vendorString"`
+	DeviceString  string  `json:"deviceString"`
+	DriverVendor  string  `json:"driverVendor"`
+	DriverVersion string  `json:"driverVersion"`
+}
+
+// SystemInfoSize https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-Size
+type SystemInfoSize struct {
+	Width  int64 `json:"width"`
+	Height int64 `json:"height"`
+}
+
+// SystemInfoVideoDecodeAcceleratorCapability https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-VideoDecodeAcceleratorCapability
+type SystemInfoVideoDecodeAcceleratorCapability struct {
+	Profile       string          `json:"profile"`
+	MaxResolution *SystemInfoSize `json:"maxResolution"`
+	MinResolution *SystemInfoSize `json:"minResolution"`
+}
+
+// SystemInfoVideoEncodeAcceleratorCapability https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-VideoEncodeAcceleratorCapability
+type SystemInfoVideoEncodeAcceleratorCapability struct {
+	Profile                 string          `json:"profile"`
+	MaxResolution           *SystemInfoSize `json:"maxResolution"`
+	MaxFramerateNumerator   int64           `json:"maxFramerateNumerator"`
+	MaxFramerateDenominator int64           `json:"maxFramerateDenominator"`
+}
+
+// SystemInfoSubsamplingFormat https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-SubsamplingFormat
+type SystemInfoSubsamplingFormat string
+
+// SystemInfoSubsamplingFormat values
+const (
+	SystemInfoSubsamplingFormatYuv420 SystemInfoSubsamplingFormat = "yuv420"
+	SystemInfoSubsamplingFormatYuv422 SystemInfoSubsamplingFormat = "yuv422"
+	SystemInfoSubsamplingFormatYuv444 SystemInfoSubsamplingFormat = "yuv444"
+)
+
+// SystemInfoImageType https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ImageType
+type SystemInfoImageType string
+
+// SystemInfoImageType values
+const (
+	SystemInfoImageTypeJpeg    SystemInfoImageType = "jpeg"
+	SystemInfoImageTypeWebp    SystemInfoImageType = "webp"
+	SystemInfoImageTypeUnknown SystemInfoImageType = "unknown"
+)
+
+// SystemInfoImageDecodeAcceleratorCapability https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ImageDecodeAcceleratorCapability
+type SystemInfoImageDecodeAcceleratorCapability struct {
+	ImageType     SystemInfoImageType           `json:"imageType"`
+	MaxDimensions *SystemInfoSize               `json:"maxDimensions"`
+	MinDimensions *SystemInfoSize               `json:"minDimensions"`
+	Subsamplings  []SystemInfoSubsamplingFormat `json:"subsamplings"`
+}
+
+// SystemInfoGPUInfo https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-GPUInfo
+type SystemInfoGPUInfo struct {
+	Devices              []*SystemInfoGPUDevice                        `json:"devices"`
+	AuxAttributes        map[string]interface{}                        `json:"auxAttributes,omitempty"`
+	FeatureStatus        map[string]interface{}                        `json:"featureStatus,omitempty"`
+	DriverBugWorkarounds []string                                      `json:"driverBugWorkarounds"`
+	VideoDecoding        []*SystemInfoVideoDecodeAcceleratorCapability `json:"videoDecoding"`
+	VideoEncoding        []*SystemInfoVideoEncodeAcceleratorCapability `json:"videoEncoding"`
+	ImageDecoding        []*SystemInfoImageDecodeAcceleratorCapability `json:"imageDecoding"`
+}
+
+// SystemInfoProcessInfo https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#type-ProcessInfo
+type SystemInfoProcessInfo struct {
+	Type    string  `json:"type"`
+	ID      int64   `json:"id"`
+	CPUTime float64 `json:"cpuTime"`
+}
+
+// SystemInfoGetInfoParams https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getInfo
+type SystemInfoGetInfoParams struct {
+}
+
+// ProtocolMethod returns SystemInfo.getInfo
+func (*SystemInfoGetInfoParams) ProtocolMethod() string {
+	return "SystemInfo.getInfo"
+}
+
+// SystemInfoGetInfoResult https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getInfo
+type SystemInfoGetInfoResult struct {
+	GPU          *SystemInfoGPUInfo `json:"gpu"`
+	ModelName    string             `json:"modelName"`
+	ModelVersion string             `json:"modelVersion"`
+	CommandLine  string             `json:"commandLine"`
+}
+
+// Do sends SystemInfo.getInfo and returns its result
+func (p *SystemInfoGetInfoParams) Do(e Executor) (*SystemInfoGetInfoResult, error) {
+	var result = new(SystemInfoGetInfoResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SystemInfoGetFeatureStateParams https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getFeatureState
+type SystemInfoGetFeatureStateParams struct {
+	FeatureState string `json:"featureState"`
+}
+
+// ProtocolMethod returns SystemInfo.getFeatureState
+func (*SystemInfoGetFeatureStateParams) ProtocolMethod() string {
+	return "SystemInfo.getFeatureState"
+}
+
+// SystemInfoGetFeatureStateResult https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getFeatureState
+type SystemInfoGetFeatureStateResult struct {
+	FeatureEnabled bool `json:"featureEnabled"`
+}
+
+// Do sends SystemInfo.getFeatureState and returns its result
+func (p *SystemInfoGetFeatureStateParams) Do(e Executor) (*SystemInfoGetFeatureStateResult, error) {
+	var result = new(SystemInfoGetFeatureStateResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SystemInfoGetProcessInfoParams https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getProcessInfo
+type SystemInfoGetProcessInfoParams struct {
+}
+
+// ProtocolMethod returns SystemInfo.getProcessInfo
+func (*SystemInfoGetProcessInfoParams) ProtocolMethod() string {
+	return "SystemInfo.getProcessInfo"
+}
+
+// SystemInfoGetProcessInfoResult https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo#method-getProcessInfo
+type SystemInfoGetProcessInfoResult struct {
+	ProcessInfo []*SystemInfoProcessInfo `json:"processInfo"`
+}
+
+// Do sends SystemInfo.getProcessInfo and returns its result
+func (p *SystemInfoGetProcessInfoParams) Do(e Executor) (*SystemInfoGetProcessInfoResult, error) {
+	var result = new(SystemInfoGetProcessInfoResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,519 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Target events
+const (
+	EventTargetAttachedToTarget          = "Target.attachedToTarget"
+	EventTargetDetachedFromTarget        = "Target.detachedFromTarget"
+	EventTargetReceivedMessageFromTarget = "Target.receivedMessageFromTarget"
+	EventTargetTargetCreated             = "Target.targetCreated"
+	EventTargetTargetDestroyed           = "Target.targetDestroyed"
+	EventTargetTargetCrashed             = "Target.targetCrashed"
+	EventTargetTargetInfoChanged         = "Target.targetInfoChanged"
+)
+
+// TargetTargetID https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetID
+type TargetTargetID string
+
+// TargetSessionID https://chromedevtools.github.io/devtools-protocol/tot/Target#type-SessionID
+type TargetSessionID string
+
+// TargetTargetInfo https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetInfo
+type TargetTargetInfo struct {
+	TargetID         TargetTargetID          `json:"targetId"`
+	Type             string                  `json:"type"`
+	Title            string                  `json:"title"`
+	URL              string                  `json:"url"`
+	Attached         bool                    `json:"attached"`
+	OpenerID         TargetTargetID          `json:"openerId,omitempty"`
+	CanAccessOpener  bool                    `json:"canAccessOpener"`
+	OpenerFrameID    PageFrameID             `json:"openerFrameId,omitempty"`
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	Subtype          string                  `json:"subtype,omitempty"`
+}
+
+// TargetFilterEntry https://chromedevtools.github.io/devtools-protocol/tot/Target#type-FilterEntry
+type TargetFilterEntry struct {
+	Exclude bool   `json:"exclude,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// TargetTargetFilter https://chromedevtools.github.io/devtools-protocol/tot/Target#type-TargetFilter
+type TargetTargetFilter []*TargetFilterEntry
+
+// TargetRemoteLocation https://chromedevtools.github.io/devtools-protocol/tot/Target#type-RemoteLocation
+type TargetRemoteLocation struct {
+	Host string `json:"host"`
+	Port int64  `json:"port"`
+}
+
+// TargetWindowState https://chromedevtools.github.io/devtools-protocol/tot/Target#type-WindowState
+type TargetWindowState string
+
+// TargetWindowState values
+const (
+	TargetWindowStateNormal     TargetWindowState = "normal"
+	TargetWindowStateMinimized  TargetWindowState = "minimized"
+	TargetWindowStateMaximized  TargetWindowState = "maximized"
+	TargetWindowStateFullscreen TargetWindowState = "fullscreen"
+)
+
+// TargetActivateTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-activateTarget
+type TargetActivateTargetParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// ProtocolMethod returns Target.activateTarget
+func (*TargetActivateTargetParams) ProtocolMethod() string {
+	return "Target.activateTarget"
+}
+
+// Do sends Target.activateTarget
+func (p *TargetActivateTargetParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetAttachToTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-attachToTarget
+type TargetAttachToTargetParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+	Flatten  bool           `json:"flatten,omitempty"`
+}
+
+// ProtocolMethod returns Target.attachToTarget
+func (*TargetAttachToTargetParams) ProtocolMethod() string {
+	return "Target.attachToTarget"
+}
+
+// TargetAttachToTargetResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-attachToTarget
+type TargetAttachToTargetResult struct {
+	SessionID TargetSessionID `json:"sessionId"`
+}
+
+// Do sends Target.attachToTarget and returns its result
+func (p *TargetAttachToTargetParams) Do(e Executor) (*TargetAttachToTargetResult, error) {
+	var result = new(TargetAttachToTargetResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetAttachToBrowserTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-attachToBrowserTarget
+type TargetAttachToBrowserTargetParams struct {
+}
+
+// ProtocolMethod returns Target.attachToBrowserTarget
+func (*TargetAttachToBrowserTargetParams) ProtocolMethod() string {
+	return "Target.attachToBrowserTarget"
+}
+
+// TargetAttachToBrowserTargetResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-attachToBrowserTarget
+type TargetAttachToBrowserTargetResult struct {
+	SessionID TargetSessionID `json:"sessionId"`
+}
+
+// Do sends Target.attachToBrowserTarget and returns its result
+func (p *TargetAttachToBrowserTargetParams) Do(e Executor) (*TargetAttachToBrowserTargetResult, error) {
+	var result = new(TargetAttachToBrowserTargetResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetCloseTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-closeTarget
+type TargetCloseTargetParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// ProtocolMethod returns Target.closeTarget
+func (*TargetCloseTargetParams) ProtocolMethod() string {
+	return "Target.closeTarget"
+}
+
+// TargetCloseTargetResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-closeTarget
+type TargetCloseTargetResult struct {
+	// Deprecated: deprecated in protocol
+	Success bool `json:"success"`
+}
+
+// Do sends Target.closeTarget and returns its result
+func (p *TargetCloseTargetParams) Do(e Executor) (*TargetCloseTargetResult, error) {
+	var result = new(TargetCloseTargetResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetExposeDevToolsProtocolParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-exposeDevToolsProtocol
+type TargetExposeDevToolsProtocolParams struct {
+	TargetID           TargetTargetID `json:"targetId"`
+	BindingName        string         `json:"bindingName,omitempty"`
+	InheritPermissions bool           `json:"inheritPermissions,omitempty"`
+}
+
+// ProtocolMethod returns Target.exposeDevToolsProtocol
+func (*TargetExposeDevToolsProtocolParams) ProtocolMethod() string {
+	return "Target.exposeDevToolsProtocol"
+}
+
+// Do sends Target.exposeDevToolsProtocol
+func (p *TargetExposeDevToolsProtocolParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetCreateBrowserContextParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createBrowserContext
+type TargetCreateBrowserContextParams struct {
+	DisposeOnDetach                   bool     `json:"disposeOnDetach,omitempty"`
+	ProxyServer                       string   `json:"proxyServer,omitempty"`
+	ProxyBypassList                   string   `json:"proxyBypassList,omitempty"`
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
+// ProtocolMethod returns Target.createBrowserContext
+func (*TargetCreateBrowserContextParams) ProtocolMethod() string {
+	return "Target.createBrowserContext"
+}
+
+// TargetCreateBrowserContextResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createBrowserContext
+type TargetCreateBrowserContextResult struct {
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// Do sends Target.createBrowserContext and returns its result
+func (p *TargetCreateBrowserContextParams) Do(e Executor) (*TargetCreateBrowserContextResult, error) {
+	var result = new(TargetCreateBrowserContextResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetGetBrowserContextsParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getBrowserContexts
+type TargetGetBrowserContextsParams struct {
+}
+
+// ProtocolMethod returns Target.getBrowserContexts
+func (*TargetGetBrowserContextsParams) ProtocolMethod() string {
+	return "Target.getBrowserContexts"
+}
+
+// TargetGetBrowserContextsResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getBrowserContexts
+type TargetGetBrowserContextsResult struct {
+	BrowserContextIds []BrowserBrowserContextID `json:"browserContextIds"`
+}
+
+// Do sends Target.getBrowserContexts and returns its result
+func (p *TargetGetBrowserContextsParams) Do(e Executor) (*TargetGetBrowserContextsResult, error) {
+	var result = new(TargetGetBrowserContextsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetCreateTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createTarget
+type TargetCreateTargetParams struct {
+	URL                     string                  `json:"url"`
+	Left                    int64                   `json:"left,omitempty"`
+	Top                     int64                   `json:"top,omitempty"`
+	Width                   int64                   `json:"width,omitempty"`
+	Height                  int64                   `json:"height,omitempty"`
+	WindowState             TargetWindowState       `json:"windowState,omitempty"`
+	BrowserContextID        BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	EnableBeginFrameControl bool                    `json:"enableBeginFrameControl,omitempty"`
+	NewWindow               bool                    `json:"newWindow,omitempty"`
+	Background              bool                    `json:"background,omitempty"`
+	ForTab                  bool                    `json:"forTab,omitempty"`
+	Hidden                  bool                    `json:"hidden,omitempty"`
+}
+
+// ProtocolMethod returns Target.createTarget
+func (*TargetCreateTargetParams) ProtocolMethod() string {
+	return "Target.createTarget"
+}
+
+// TargetCreateTargetResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-createTarget
+type TargetCreateTargetResult struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// Do sends Target.createTarget and returns its result
+func (p *TargetCreateTargetParams) Do(e Executor) (*TargetCreateTargetResult, error) {
+	var result = new(TargetCreateTargetResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetDetachFromTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-detachFromTarget
+type TargetDetachFromTargetParams struct {
+	SessionID TargetSessionID `json:"sessionId,omitempty"`
+	// Deprecated: deprecated in protocol
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// ProtocolMethod returns Target.detachFromTarget
+func (*TargetDetachFromTargetParams) ProtocolMethod() string {
+	return "Target.detachFromTarget"
+}
+
+// Do sends Target.detachFromTarget
+func (p *TargetDetachFromTargetParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetDisposeBrowserContextParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-disposeBrowserContext
+type TargetDisposeBrowserContextParams struct {
+	BrowserContextID BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// ProtocolMethod returns Target.disposeBrowserContext
+func (*TargetDisposeBrowserContextParams) ProtocolMethod() string {
+	return "Target.disposeBrowserContext"
+}
+
+// Do sends Target.disposeBrowserContext
+func (p *TargetDisposeBrowserContextParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetGetTargetInfoParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getTargetInfo
+type TargetGetTargetInfoParams struct {
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// ProtocolMethod returns Target.getTargetInfo
+func (*TargetGetTargetInfoParams) ProtocolMethod() string {
+	return "Target.getTargetInfo"
+}
+
+// TargetGetTargetInfoResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getTargetInfo
+type TargetGetTargetInfoResult struct {
+	TargetInfo *TargetTargetInfo `json:"targetInfo"`
+}
+
+// Do sends Target.getTargetInfo and returns its result
+func (p *TargetGetTargetInfoParams) Do(e Executor) (*TargetGetTargetInfoResult, error) {
+	var result = new(TargetGetTargetInfoResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetGetTargetsParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getTargets
+type TargetGetTargetsParams struct {
+	Filter TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// ProtocolMethod returns Target.getTargets
+func (*TargetGetTargetsParams) ProtocolMethod() string {
+	return "Target.getTargets"
+}
+
+// TargetGetTargetsResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-getTargets
+type TargetGetTargetsResult struct {
+	TargetInfos []*TargetTargetInfo `json:"targetInfos"`
+}
+
+// Do sends Target.getTargets and returns its result
+func (p *TargetGetTargetsParams) Do(e Executor) (*TargetGetTargetsResult, error) {
+	var result = new(TargetGetTargetsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetSendMessageToTargetParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-sendMessageToTarget
+//
+// Deprecated: deprecated in protocol
+type TargetSendMessageToTargetParams struct {
+	Message   string          `json:"message"`
+	SessionID TargetSessionID `json:"sessionId,omitempty"`
+	// Deprecated: deprecated in protocol
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// ProtocolMethod returns Target.sendMessageToTarget
+func (*TargetSendMessageToTargetParams) ProtocolMethod() string {
+	return "Target.sendMessageToTarget"
+}
+
+// Do sends Target.sendMessageToTarget
+func (p *TargetSendMessageToTargetParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetSetAutoAttachParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-setAutoAttach
+type TargetSetAutoAttachParams struct {
+	AutoAttach             bool               `json:"autoAttach"`
+	WaitForDebuggerOnStart bool               `json:"waitForDebuggerOnStart"`
+	Flatten                bool               `json:"flatten,omitempty"`
+	Filter                 TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// ProtocolMethod returns Target.setAutoAttach
+func (*TargetSetAutoAttachParams) ProtocolMethod() string {
+	return "Target.setAutoAttach"
+}
+
+// Do sends Target.setAutoAttach
+func (p *TargetSetAutoAttachParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetAutoAttachRelatedParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-autoAttachRelated
+type TargetAutoAttachRelatedParams struct {
+	TargetID               TargetTargetID     `json:"targetId"`
+	WaitForDebuggerOnStart bool               `json:"waitForDebuggerOnStart"`
+	Filter                 TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// ProtocolMethod returns Target.autoAttachRelated
+func (*TargetAutoAttachRelatedParams) ProtocolMethod() string {
+	return "Target.autoAttachRelated"
+}
+
+// Do sends Target.autoAttachRelated
+func (p *TargetAutoAttachRelatedParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetSetDiscoverTargetsParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-setDiscoverTargets
+type TargetSetDiscoverTargetsParams struct {
+	Discover bool               `json:"discover"`
+	Filter   TargetTargetFilter `json:"filter,omitempty"`
+}
+
+// ProtocolMethod returns Target.setDiscoverTargets
+func (*TargetSetDiscoverTargetsParams) ProtocolMethod() string {
+	return "Target.setDiscoverTargets"
+}
+
+// Do sends Target.setDiscoverTargets
+func (p *TargetSetDiscoverTargetsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetSetRemoteLocationsParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-setRemoteLocations
+type TargetSetRemoteLocationsParams struct {
+	Locations []*TargetRemoteLocation `json:"locations"`
+}
+
+// ProtocolMethod returns Target.setRemoteLocations
+func (*TargetSetRemoteLocationsParams) ProtocolMethod() string {
+	return "Target.setRemoteLocations"
+}
+
+// Do sends Target.setRemoteLocations
+func (p *TargetSetRemoteLocationsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TargetOpenDevToolsParams https://chromedevtools.github.io/devtools-protocol/tot/Target#method-openDevTools
+type TargetOpenDevToolsParams struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// ProtocolMethod returns Target.openDevTools
+func (*TargetOpenDevToolsParams) ProtocolMethod() string {
+	return "Target.openDevTools"
+}
+
+// TargetOpenDevToolsResult https://chromedevtools.github.io/devtools-protocol/tot/Target#method-openDevTools
+type TargetOpenDevToolsResult struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// Do sends Target.openDevTools and returns its result
+func (p *TargetOpenDevToolsParams) Do(e Executor) (*TargetOpenDevToolsResult, error) {
+	var result = new(TargetOpenDevToolsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TargetAttachedToTargetEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-attachedToTarget
+type TargetAttachedToTargetEvent struct {
+	SessionID          TargetSessionID   `json:"sessionId"`
+	TargetInfo         *TargetTargetInfo `json:"targetInfo"`
+	WaitingForDebugger bool              `json:"waitingForDebugger"`
+}
+
+// ProtocolMethod returns Target.attachedToTarget
+func (*TargetAttachedToTargetEvent) ProtocolMethod() string {
+	return "Target.attachedToTarget"
+}
+
+// TargetDetachedFromTargetEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-detachedFromTarget
+type TargetDetachedFromTargetEvent struct {
+	SessionID TargetSessionID `json:"sessionId"`
+	// Deprecated: deprecated in protocol
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// ProtocolMethod returns Target.detachedFromTarget
+func (*TargetDetachedFromTargetEvent) ProtocolMethod() string {
+	return "Target.detachedFromTarget"
+}
+
+// TargetReceivedMessageFromTargetEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-receivedMessageFromTarget
+type TargetReceivedMessageFromTargetEvent struct {
+	SessionID TargetSessionID `json:"sessionId"`
+	Message   string          `json:"message"`
+	// Deprecated: deprecated in protocol
+	TargetID TargetTargetID `json:"targetId,omitempty"`
+}
+
+// ProtocolMethod returns Target.receivedMessageFromTarget
+func (*TargetReceivedMessageFromTargetEvent) ProtocolMethod() string {
+	return "Target.receivedMessageFromTarget"
+}
+
+// TargetTargetCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetCreated
+type TargetTargetCreatedEvent struct {
+	TargetInfo *TargetTargetInfo `json:"targetInfo"`
+}
+
+// ProtocolMethod returns Target.targetCreated
+func (*TargetTargetCreatedEvent) ProtocolMethod() string {
+	return "Target.targetCreated"
+}
+
+// TargetTargetDestroyedEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetDestroyed
+type TargetTargetDestroyedEvent struct {
+	TargetID TargetTargetID `json:"targetId"`
+}
+
+// ProtocolMethod returns Target.targetDestroyed
+func (*TargetTargetDestroyedEvent) ProtocolMethod() string {
+	return "Target.targetDestroyed"
+}
+
+// TargetTargetCrashedEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetCrashed
+type TargetTargetCrashedEvent struct {
+	TargetID  TargetTargetID `json:"targetId"`
+	Status    string         `json:"status"`
+	ErrorCode int64          `json:"errorCode"`
+}
+
+// ProtocolMethod returns Target.targetCrashed
+func (*TargetTargetCrashedEvent) ProtocolMethod() string {
+	return "Target.targetCrashed"
+}
+
+// TargetTargetInfoChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/Target#event-targetInfoChanged
+type TargetTargetInfoChangedEvent struct {
+	TargetInfo *TargetTargetInfo `json:"targetInfo"`
+}
+
+// ProtocolMethod returns Target.targetInfoChanged
+func (*TargetTargetInfoChangedEvent) ProtocolMethod() string {
+	return "Target.targetInfoChanged"
+}
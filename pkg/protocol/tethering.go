@@ -0,0 +1,49 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Tethering events
+const (
+	EventTetheringAccepted = "Tethering.accepted"
+)
+
+// TetheringBindParams https://chromedevtools.github.io/devtools-protocol/tot/Tethering#method-bind
+type TetheringBindParams struct {
+	Port int64 `json:"port"`
+}
+
+// ProtocolMethod returns Tethering.bind
+func (*TetheringBindParams) ProtocolMethod() string {
+	return "Tethering.bind"
+}
+
+// Do sends Tethering.bind
+func (p *TetheringBindParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TetheringUnbindParams https://chromedevtools.github.io/devtools-protocol/tot/Tethering#method-unbind
+type TetheringUnbindParams struct {
+	Port int64 `json:"port"`
+}
+
+// ProtocolMethod returns Tethering.unbind
+func (*TetheringUnbindParams) ProtocolMethod() string {
+	return "Tethering.unbind"
+}
+
+// Do sends Tethering.unbind
+func (p *TetheringUnbindParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TetheringAcceptedEvent https://chromedevtools.github.io/devtools-protocol/tot/Tethering#event-accepted
+type TetheringAcceptedEvent struct {
+	Port         int64  `json:"port"`
+	ConnectionID string `json:"connectionId"`
+}
+
+// ProtocolMethod returns Tethering.accepted
+func (*TetheringAcceptedEvent) ProtocolMethod() string {
+	return "Tethering.accepted"
+}
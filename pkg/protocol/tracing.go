@@ -0,0 +1,222 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// Tracing events
+const (
+	EventTracingBufferUsage     = "Tracing.bufferUsage"
+	EventTracingDataCollected   = "Tracing.dataCollected"
+	EventTracingTracingComplete = "Tracing.tracingComplete"
+)
+
+// TracingMemoryDumpConfig https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-MemoryDumpConfig
+type TracingMemoryDumpConfig map[string]interface{}
+
+// TracingTraceConfig https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-TraceConfig
+type TracingTraceConfig struct {
+	RecordMode           TracingTraceConfigRecordMode `json:"recordMode,omitempty"`
+	TraceBufferSizeInKb  float64                      `json:"traceBufferSizeInKb,omitempty"`
+	EnableSampling       bool                         `json:"enableSampling,omitempty"`
+	EnableSystrace       bool                         `json:"enableSystrace,omitempty"`
+	EnableArgumentFilter bool                         `json:"enableArgumentFilter,omitempty"`
+	IncludedCategories   []string                     `json:"includedCategories,omitempty"`
+	ExcludedCategories   []string                     `json:"excludedCategories,omitempty"`
+	SyntheticDelays      []string                     `json:"syntheticDelays,omitempty"`
+	MemoryDumpConfig     TracingMemoryDumpConfig      `json:"memoryDumpConfig,omitempty"`
+}
+
+// TracingStreamFormat https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-StreamFormat
+type TracingStreamFormat string
+
+// TracingStreamFormat values
+const (
+	TracingStreamFormatJSON  TracingStreamFormat = "json"
+	TracingStreamFormatProto TracingStreamFormat = "proto"
+)
+
+// TracingStreamCompression https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-StreamCompression
+type TracingStreamCompression string
+
+// TracingStreamCompression values
+const (
+	TracingStreamCompressionNone TracingStreamCompression = "none"
+	TracingStreamCompressionGzip TracingStreamCompression = "gzip"
+)
+
+// TracingMemoryDumpLevelOfDetail https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-MemoryDumpLevelOfDetail
+type TracingMemoryDumpLevelOfDetail string
+
+// TracingMemoryDumpLevelOfDetail values
+const (
+	TracingMemoryDumpLevelOfDetailBackground TracingMemoryDumpLevelOfDetail = "background"
+	TracingMemoryDumpLevelOfDetailLight      TracingMemoryDumpLevelOfDetail = "light"
+	TracingMemoryDumpLevelOfDetailDetailed   TracingMemoryDumpLevelOfDetail = "detailed"
+)
+
+// TracingTracingBackend https://chromedevtools.github.io/devtools-protocol/tot/Tracing#type-TracingBackend
+type TracingTracingBackend string
+
+// TracingTracingBackend values
+const (
+	TracingTracingBackendAuto   TracingTracingBackend = "auto"
+	TracingTracingBackendChrome TracingTracingBackend = "chrome"
+	TracingTracingBackendSystem TracingTracingBackend = "system"
+)
+
+// TracingEndParams https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-end
+type TracingEndParams struct {
+}
+
+// ProtocolMethod returns Tracing.end
+func (*TracingEndParams) ProtocolMethod() string {
+	return "Tracing.end"
+}
+
+// Do sends Tracing.end
+func (p *TracingEndParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TracingGetCategoriesParams https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-getCategories
+type TracingGetCategoriesParams struct {
+}
+
+// ProtocolMethod returns Tracing.getCategories
+func (*TracingGetCategoriesParams) ProtocolMethod() string {
+	return "Tracing.getCategories"
+}
+
+// TracingGetCategoriesResult https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-getCategories
+type TracingGetCategoriesResult struct {
+	Categories []string `json:"categories"`
+}
+
+// Do sends Tracing.getCategories and returns its result
+func (p *TracingGetCategoriesParams) Do(e Executor) (*TracingGetCategoriesResult, error) {
+	var result = new(TracingGetCategoriesResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TracingRecordClockSyncMarkerParams https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-recordClockSyncMarker
+type TracingRecordClockSyncMarkerParams struct {
+	SyncID string `json:"syncId"`
+}
+
+// ProtocolMethod returns Tracing.recordClockSyncMarker
+func (*TracingRecordClockSyncMarkerParams) ProtocolMethod() string {
+	return "Tracing.recordClockSyncMarker"
+}
+
+// Do sends Tracing.recordClockSyncMarker
+func (p *TracingRecordClockSyncMarkerParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TracingRequestMemoryDumpParams https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-requestMemoryDump
+type TracingRequestMemoryDumpParams struct {
+	Deterministic bool                           `json:"deterministic,omitempty"`
+	LevelOfDetail TracingMemoryDumpLevelOfDetail `json:"levelOfDetail,omitempty"`
+}
+
+// ProtocolMethod returns Tracing.requestMemoryDump
+func (*TracingRequestMemoryDumpParams) ProtocolMethod() string {
+	return "Tracing.requestMemoryDump"
+}
+
+// TracingRequestMemoryDumpResult https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-requestMemoryDump
+type TracingRequestMemoryDumpResult struct {
+	DumpGuid string `json:"dumpGuid"`
+	Success  bool   `json:"success"`
+}
+
+// Do sends Tracing.requestMemoryDump and returns its result
+func (p *TracingRequestMemoryDumpParams) Do(e Executor) (*TracingRequestMemoryDumpResult, error) {
+	var result = new(TracingRequestMemoryDumpResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TracingStartParams https://chromedevtools.github.io/devtools-protocol/tot/Tracing#method-start
+type TracingStartParams struct {
+	// Deprecated: deprecated in protocol
+	Categories string `json:"categories,omitempty"`
+	// Deprecated: deprecated in protocol
+	Options                      string                         `json:"options,omitempty"`
+	BufferUsageReportingInterval float64                        `json:"bufferUsageReportingInterval,omitempty"`
+	TransferMode                 TracingStartParamsTransferMode `json:"transferMode,omitempty"`
+	StreamFormat                 TracingStreamFormat            `json:"streamFormat,omitempty"`
+	StreamCompression            TracingStreamCompression       `json:"streamCompression,omitempty"`
+	TraceConfig                  *TracingTraceConfig            `json:"traceConfig,omitempty"`
+	PerfettoConfig               string                         `json:"perfettoConfig,omitempty"`
+	TracingBackend               TracingTracingBackend          `json:"tracingBackend,omitempty"`
+}
+
+// ProtocolMethod returns Tracing.start
+func (*TracingStartParams) ProtocolMethod() string {
+	return "Tracing.start"
+}
+
+// Do sends Tracing.start
+func (p *TracingStartParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// TracingBufferUsageEvent https://chromedevtools.github.io/devtools-protocol/tot/Tracing#event-bufferUsage
+type TracingBufferUsageEvent struct {
+	PercentFull float64 `json:"percentFull,omitempty"`
+	EventCount  float64 `json:"eventCount,omitempty"`
+	Value       float64 `json:"value,omitempty"`
+}
+
+// ProtocolMethod returns Tracing.bufferUsage
+func (*TracingBufferUsageEvent) ProtocolMethod() string {
+	return "Tracing.bufferUsage"
+}
+
+// TracingDataCollectedEvent https://chromedevtools.github.io/devtools-protocol/tot/Tracing#event-dataCollected
+type TracingDataCollectedEvent struct {
+	Value []map[string]interface{} `json:"value"`
+}
+
+// ProtocolMethod returns Tracing.dataCollected
+func (*TracingDataCollectedEvent) ProtocolMethod() string {
+	return "Tracing.dataCollected"
+}
+
+// TracingTracingCompleteEvent https://chromedevtools.github.io/devtools-protocol/tot/Tracing#event-tracingComplete
+type TracingTracingCompleteEvent struct {
+	DataLossOccurred  bool                     `json:"dataLossOccurred"`
+	Stream            IOStreamHandle           `json:"stream,omitempty"`
+	TraceFormat       TracingStreamFormat      `json:"traceFormat,omitempty"`
+	StreamCompression TracingStreamCompression `json:"streamCompression,omitempty"`
+}
+
+// ProtocolMethod returns Tracing.tracingComplete
+func (*TracingTracingCompleteEvent) ProtocolMethod() string {
+	return "Tracing.tracingComplete"
+}
+
+// TracingTraceConfigRecordMode enum
+type TracingTraceConfigRecordMode string
+
+// TracingTraceConfigRecordMode values
+const (
+	TracingTraceConfigRecordModeRecordUntilFull        TracingTraceConfigRecordMode = "recordUntilFull"
+	TracingTraceConfigRecordModeRecordContinuously     TracingTraceConfigRecordMode = "recordContinuously"
+	TracingTraceConfigRecordModeRecordAsMuchAsPossible TracingTraceConfigRecordMode = "recordAsMuchAsPossible"
+	TracingTraceConfigRecordModeEchoToConsole          TracingTraceConfigRecordMode = "echoToConsole"
+)
+
+// TracingStartParamsTransferMode enum
+type TracingStartParamsTransferMode string
+
+// TracingStartParamsTransferMode values
+const (
+	TracingStartParamsTransferModeReportEvents   TracingStartParamsTransferMode = "ReportEvents"
+	TracingStartParamsTransferModeReturnAsStream TracingStartParamsTransferMode = "ReturnAsStream"
+)
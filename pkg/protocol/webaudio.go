@@ -0,0 +1,326 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// WebAudio events
+const (
+	EventWebAudioContextCreated               = "WebAudio.contextCreated"
+	EventWebAudioContextWillBeDestroyed       = "WebAudio.contextWillBeDestroyed"
+	EventWebAudioContextChanged               = "WebAudio.contextChanged"
+	EventWebAudioAudioListenerCreated         = "WebAudio.audioListenerCreated"
+	EventWebAudioAudioListenerWillBeDestroyed = "WebAudio.audioListenerWillBeDestroyed"
+	EventWebAudioAudioNodeCreated             = "WebAudio.audioNodeCreated"
+	EventWebAudioAudioNodeWillBeDestroyed     = "WebAudio.audioNodeWillBeDestroyed"
+	EventWebAudioAudioParamCreated            = "WebAudio.audioParamCreated"
+	EventWebAudioAudioParamWillBeDestroyed    = "WebAudio.audioParamWillBeDestroyed"
+	EventWebAudioNodesConnected               = "WebAudio.nodesConnected"
+	EventWebAudioNodesDisconnected            = "WebAudio.nodesDisconnected"
+	EventWebAudioNodeParamConnected           = "WebAudio.nodeParamConnected"
+	EventWebAudioNodeParamDisconnected        = "WebAudio.nodeParamDisconnected"
+)
+
+// WebAudioGraphObjectID https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-GraphObjectId
+type WebAudioGraphObjectID string
+
+// WebAudioContextType https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextType
+type WebAudioContextType string
+
+// WebAudioContextType values
+const (
+	WebAudioContextTypeRealtime WebAudioContextType = "realtime"
+	WebAudioContextTypeOffline  WebAudioContextType = "offline"
+)
+
+// WebAudioContextState https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextState
+type WebAudioContextState string
+
+// WebAudioContextState values
+const (
+	WebAudioContextStateSuspended   WebAudioContextState = "suspended"
+	WebAudioContextStateRunning     WebAudioContextState = "running"
+	WebAudioContextStateClosed      WebAudioContextState = "closed"
+	WebAudioContextStateInterrupted WebAudioContextState = "interrupted"
+)
+
+// WebAudioNodeType https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-NodeType
+type WebAudioNodeType string
+
+// WebAudioChannelCountMode https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ChannelCountMode
+type WebAudioChannelCountMode string
+
+// WebAudioChannelCountMode values
+const (
+	WebAudioChannelCountModeClampedMax WebAudioChannelCountMode = "clamped-max"
+	WebAudioChannelCountModeExplicit   WebAudioChannelCountMode = "explicit"
+	WebAudioChannelCountModeMax        WebAudioChannelCountMode = "max"
+)
+
+// WebAudioChannelInterpretation https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ChannelInterpretation
+type WebAudioChannelInterpretation string
+
+// WebAudioChannelInterpretation values
+const (
+	WebAudioChannelInterpretationDiscrete WebAudioChannelInterpretation = "discrete"
+	WebAudioChannelInterpretationSpeakers WebAudioChannelInterpretation = "speakers"
+)
+
+// WebAudioParamType https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ParamType
+type WebAudioParamType string
+
+// WebAudioAutomationRate https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AutomationRate
+type WebAudioAutomationRate string
+
+// WebAudioAutomationRate values
+const (
+	WebAudioAutomationRateARate WebAudioAutomationRate = "a-rate"
+	WebAudioAutomationRateKRate WebAudioAutomationRate = "k-rate"
+)
+
+// WebAudioContextRealtimeData https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-ContextRealtimeData
+type WebAudioContextRealtimeData struct {
+	CurrentTime              float64 `json:"currentTime"`
+	RenderCapacity           float64 `json:"renderCapacity"`
+	CallbackIntervalMean     float64 `json:"callbackIntervalMean"`
+	CallbackIntervalVariance float64 `json:"callbackIntervalVariance"`
+}
+
+// WebAudioBaseAudioContext https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-BaseAudioContext
+type WebAudioBaseAudioContext struct {
+	ContextID             WebAudioGraphObjectID        `json:"contextId"`
+	ContextType           WebAudioContextType          `json:"contextType"`
+	ContextState          WebAudioContextState         `json:"contextState"`
+	RealtimeData          *WebAudioContextRealtimeData `json:"realtimeData,omitempty"`
+	CallbackBufferSize    float64                      `json:"callbackBufferSize"`
+	MaxOutputChannelCount float64                      `json:"maxOutputChannelCount"`
+	SampleRate            float64                      `json:"sampleRate"`
+}
+
+// WebAudioAudioListener https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioListener
+type WebAudioAudioListener struct {
+	ListenerID WebAudioGraphObjectID `json:"listenerId"`
+	ContextID  WebAudioGraphObjectID `json:"contextId"`
+}
+
+// WebAudioAudioNode https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioNode
+type WebAudioAudioNode struct {
+	NodeID                WebAudioGraphObjectID         `json:"nodeId"`
+	ContextID             WebAudioGraphObjectID         `json:"contextId"`
+	NodeType              WebAudioNodeType              `json:"nodeType"`
+	NumberOfInputs        float64                       `json:"numberOfInputs"`
+	NumberOfOutputs       float64                       `json:"numberOfOutputs"`
+	ChannelCount          float64                       `json:"channelCount"`
+	ChannelCountMode      WebAudioChannelCountMode      `json:"channelCountMode"`
+	ChannelInterpretation WebAudioChannelInterpretation `json:"channelInterpretation"`
+}
+
+// WebAudioAudioParam https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#type-AudioParam
+type WebAudioAudioParam struct {
+	ParamID      WebAudioGraphObjectID  `json:"paramId"`
+	NodeID       WebAudioGraphObjectID  `json:"nodeId"`
+	ContextID    WebAudioGraphObjectID  `json:"contextId"`
+	ParamType    WebAudioParamType      `json:"paramType"`
+	Rate         WebAudioAutomationRate `json:"rate"`
+	DefaultValue float64                `json:"defaultValue"`
+	MinValue     float64                `json:"minValue"`
+	MaxValue     float64                `json:"maxValue"`
+}
+
+// WebAudioEnableParams https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-enable
+type WebAudioEnableParams struct {
+}
+
+// ProtocolMethod returns WebAudio.enable
+func (*WebAudioEnableParams) ProtocolMethod() string {
+	return "WebAudio.enable"
+}
+
+// Do sends WebAudio.enable
+func (p *WebAudioEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAudioDisableParams https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-disable
+type WebAudioDisableParams struct {
+}
+
+// ProtocolMethod returns WebAudio.disable
+func (*WebAudioDisableParams) ProtocolMethod() string {
+	return "WebAudio.disable"
+}
+
+// Do sends WebAudio.disable
+func (p *WebAudioDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAudioGetRealtimeDataParams https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-getRealtimeData
+type WebAudioGetRealtimeDataParams struct {
+	ContextID WebAudioGraphObjectID `json:"contextId"`
+}
+
+// ProtocolMethod returns WebAudio.getRealtimeData
+func (*WebAudioGetRealtimeDataParams) ProtocolMethod() string {
+	return "WebAudio.getRealtimeData"
+}
+
+// WebAudioGetRealtimeDataResult https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#method-getRealtimeData
+type WebAudioGetRealtimeDataResult struct {
+	RealtimeData *WebAudioContextRealtimeData `json:"realtimeData"`
+}
+
+// Do sends WebAudio.getRealtimeData and returns its result
+func (p *WebAudioGetRealtimeDataParams) Do(e Executor) (*WebAudioGetRealtimeDataResult, error) {
+	var result = new(WebAudioGetRealtimeDataResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WebAudioContextCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-contextCreated
+type WebAudioContextCreatedEvent struct {
+	Context *WebAudioBaseAudioContext `json:"context"`
+}
+
+// ProtocolMethod returns WebAudio.contextCreated
+func (*WebAudioContextCreatedEvent) ProtocolMethod() string {
+	return "WebAudio.contextCreated"
+}
+
+// WebAudioContextWillBeDestroyedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-contextWillBeDestroyed
+type WebAudioContextWillBeDestroyedEvent struct {
+	ContextID WebAudioGraphObjectID `json:"contextId"`
+}
+
+// ProtocolMethod returns WebAudio.contextWillBeDestroyed
+func (*WebAudioContextWillBeDestroyedEvent) ProtocolMethod() string {
+	return "WebAudio.contextWillBeDestroyed"
+}
+
+// WebAudioContextChangedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-contextChanged
+type WebAudioContextChangedEvent struct {
+	Context *WebAudioBaseAudioContext `json:"context"`
+}
+
+// ProtocolMethod returns WebAudio.contextChanged
+func (*WebAudioContextChangedEvent) ProtocolMethod() string {
+	return "WebAudio.contextChanged"
+}
+
+// WebAudioAudioListenerCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioListenerCreated
+type WebAudioAudioListenerCreatedEvent struct {
+	Listener *WebAudioAudioListener `json:"listener"`
+}
+
+// ProtocolMethod returns WebAudio.audioListenerCreated
+func (*WebAudioAudioListenerCreatedEvent) ProtocolMethod() string {
+	return "WebAudio.audioListenerCreated"
+}
+
+// WebAudioAudioListenerWillBeDestroyedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioListenerWillBeDestroyed
+type WebAudioAudioListenerWillBeDestroyedEvent struct {
+	ContextID  WebAudioGraphObjectID `json:"contextId"`
+	ListenerID WebAudioGraphObjectID `json:"listenerId"`
+}
+
+// ProtocolMethod returns WebAudio.audioListenerWillBeDestroyed
+func (*WebAudioAudioListenerWillBeDestroyedEvent) ProtocolMethod() string {
+	return "WebAudio.audioListenerWillBeDestroyed"
+}
+
+// WebAudioAudioNodeCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioNodeCreated
+type WebAudioAudioNodeCreatedEvent struct {
+	Node *WebAudioAudioNode `json:"node"`
+}
+
+// ProtocolMethod returns WebAudio.audioNodeCreated
+func (*WebAudioAudioNodeCreatedEvent) ProtocolMethod() string {
+	return "WebAudio.audioNodeCreated"
+}
+
+// WebAudioAudioNodeWillBeDestroyedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioNodeWillBeDestroyed
+type WebAudioAudioNodeWillBeDestroyedEvent struct {
+	ContextID WebAudioGraphObjectID `json:"contextId"`
+	NodeID    WebAudioGraphObjectID `json:"nodeId"`
+}
+
+// ProtocolMethod returns WebAudio.audioNodeWillBeDestroyed
+func (*WebAudioAudioNodeWillBeDestroyedEvent) ProtocolMethod() string {
+	return "WebAudio.audioNodeWillBeDestroyed"
+}
+
+// WebAudioAudioParamCreatedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioParamCreated
+type WebAudioAudioParamCreatedEvent struct {
+	Param *WebAudioAudioParam `json:"param"`
+}
+
+// ProtocolMethod returns WebAudio.audioParamCreated
+func (*WebAudioAudioParamCreatedEvent) ProtocolMethod() string {
+	return "WebAudio.audioParamCreated"
+}
+
+// WebAudioAudioParamWillBeDestroyedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-audioParamWillBeDestroyed
+type WebAudioAudioParamWillBeDestroyedEvent struct {
+	ContextID WebAudioGraphObjectID `json:"contextId"`
+	NodeID    WebAudioGraphObjectID `json:"nodeId"`
+	ParamID   WebAudioGraphObjectID `json:"paramId"`
+}
+
+// ProtocolMethod returns WebAudio.audioParamWillBeDestroyed
+func (*WebAudioAudioParamWillBeDestroyedEvent) ProtocolMethod() string {
+	return "WebAudio.audioParamWillBeDestroyed"
+}
+
+// WebAudioNodesConnectedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodesConnected
+type WebAudioNodesConnectedEvent struct {
+	ContextID             WebAudioGraphObjectID `json:"contextId"`
+	SourceID              WebAudioGraphObjectID `json:"sourceId"`
+	DestinationID         WebAudioGraphObjectID `json:"destinationId"`
+	SourceOutputIndex     float64               `json:"sourceOutputIndex,omitempty"`
+	DestinationInputIndex float64               `json:"destinationInputIndex,omitempty"`
+}
+
+// ProtocolMethod returns WebAudio.nodesConnected
+func (*WebAudioNodesConnectedEvent) ProtocolMethod() string {
+	return "WebAudio.nodesConnected"
+}
+
+// WebAudioNodesDisconnectedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodesDisconnected
+type WebAudioNodesDisconnectedEvent struct {
+	ContextID             WebAudioGraphObjectID `json:"contextId"`
+	SourceID              WebAudioGraphObjectID `json:"sourceId"`
+	DestinationID         WebAudioGraphObjectID `json:"destinationId"`
+	SourceOutputIndex     float64               `json:"sourceOutputIndex,omitempty"`
+	DestinationInputIndex float64               `json:"destinationInputIndex,omitempty"`
+}
+
+// ProtocolMethod returns WebAudio.nodesDisconnected
+func (*WebAudioNodesDisconnectedEvent) ProtocolMethod() string {
+	return "WebAudio.nodesDisconnected"
+}
+
+// WebAudioNodeParamConnectedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodeParamConnected
+type WebAudioNodeParamConnectedEvent struct {
+	ContextID         WebAudioGraphObjectID `json:"contextId"`
+	SourceID          WebAudioGraphObjectID `json:"sourceId"`
+	DestinationID     WebAudioGraphObjectID `json:"destinationId"`
+	SourceOutputIndex float64               `json:"sourceOutputIndex,omitempty"`
+}
+
+// ProtocolMethod returns WebAudio.nodeParamConnected
+func (*WebAudioNodeParamConnectedEvent) ProtocolMethod() string {
+	return "WebAudio.nodeParamConnected"
+}
+
+// WebAudioNodeParamDisconnectedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAudio#event-nodeParamDisconnected
+type WebAudioNodeParamDisconnectedEvent struct {
+	ContextID         WebAudioGraphObjectID `json:"contextId"`
+	SourceID          WebAudioGraphObjectID `json:"sourceId"`
+	DestinationID     WebAudioGraphObjectID `json:"destinationId"`
+	SourceOutputIndex float64               `json:"sourceOutputIndex,omitempty"`
+}
+
+// ProtocolMethod returns WebAudio.nodeParamDisconnected
+func (*WebAudioNodeParamDisconnectedEvent) ProtocolMethod() string {
+	return "WebAudio.nodeParamDisconnected"
+}
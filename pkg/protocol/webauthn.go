@@ -0,0 +1,352 @@
+// Code generated by gen; DO NOT EDIT.
+
+package protocol
+
+// WebAuthn events
+const (
+	EventWebAuthnCredentialAdded    = "WebAuthn.credentialAdded"
+	EventWebAuthnCredentialDeleted  = "WebAuthn.credentialDeleted"
+	EventWebAuthnCredentialUpdated  = "WebAuthn.credentialUpdated"
+	EventWebAuthnCredentialAsserted = "WebAuthn.credentialAsserted"
+)
+
+// WebAuthnAuthenticatorID https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorId
+type WebAuthnAuthenticatorID string
+
+// WebAuthnAuthenticatorProtocol https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorProtocol
+type WebAuthnAuthenticatorProtocol string
+
+// WebAuthnAuthenticatorProtocol values
+const (
+	WebAuthnAuthenticatorProtocolU2f   WebAuthnAuthenticatorProtocol = "u2f"
+	WebAuthnAuthenticatorProtocolCtap2 WebAuthnAuthenticatorProtocol = "ctap2"
+)
+
+// WebAuthnCtap2Version https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-Ctap2Version
+type WebAuthnCtap2Version string
+
+// WebAuthnCtap2Version values
+const (
+	WebAuthnCtap2VersionCtap20 WebAuthnCtap2Version = "ctap2_0"
+	WebAuthnCtap2VersionCtap21 WebAuthnCtap2Version = "ctap2_1"
+)
+
+// WebAuthnAuthenticatorTransport https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-AuthenticatorTransport
+type WebAuthnAuthenticatorTransport string
+
+// WebAuthnAuthenticatorTransport values
+const (
+	WebAuthnAuthenticatorTransportUsb      WebAuthnAuthenticatorTransport = "usb"
+	WebAuthnAuthenticatorTransportNfc      WebAuthnAuthenticatorTransport = "nfc"
+	WebAuthnAuthenticatorTransportBle      WebAuthnAuthenticatorTransport = "ble"
+	WebAuthnAuthenticatorTransportCable    WebAuthnAuthenticatorTransport = "cable"
+	WebAuthnAuthenticatorTransportInternal WebAuthnAuthenticatorTransport = "internal"
+)
+
+// WebAuthnVirtualAuthenticatorOptions https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-VirtualAuthenticatorOptions
+type WebAuthnVirtualAuthenticatorOptions struct {
+	Protocol                    WebAuthnAuthenticatorProtocol  `json:"protocol"`
+	Ctap2Version                WebAuthnCtap2Version           `json:"ctap2Version,omitempty"`
+	Transport                   WebAuthnAuthenticatorTransport `json:"transport"`
+	HasResidentKey              bool                           `json:"hasResidentKey,omitempty"`
+	HasUserVerification         bool                           `json:"hasUserVerification,omitempty"`
+	HasLargeBlob                bool                           `json:"hasLargeBlob,omitempty"`
+	HasCredBlob                 bool                           `json:"hasCredBlob,omitempty"`
+	HasMinPinLength             bool                           `json:"hasMinPinLength,omitempty"`
+	HasPrf                      bool                           `json:"hasPrf,omitempty"`
+	AutomaticPresenceSimulation bool                           `json:"automaticPresenceSimulation,omitempty"`
+	IsUserVerified              bool                           `json:"isUserVerified,omitempty"`
+	DefaultBackupEligibility    bool                           `json:"defaultBackupEligibility,omitempty"`
+	DefaultBackupState          bool                           `json:"defaultBackupState,omitempty"`
+}
+
+// WebAuthnCredential https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#type-Credential
+type WebAuthnCredential struct {
+	CredentialID         string `json:"credentialId"`
+	IsResidentCredential bool   `json:"isResidentCredential"`
+	RpID                 string `json:"rpId,omitempty"`
+	PrivateKey           string `json:"privateKey"`
+	UserHandle           string `json:"userHandle,omitempty"`
+	SignCount            int64  `json:"signCount"`
+	LargeBlob            string `json:"largeBlob,omitempty"`
+	BackupEligibility    bool   `json:"backupEligibility,omitempty"`
+	BackupState          bool   `json:"backupState,omitempty"`
+	UserName             string `json:"userName,omitempty"`
+	UserDisplayName      string `json:"userDisplayName,omitempty"`
+}
+
+// WebAuthnEnableParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-enable
+type WebAuthnEnableParams struct {
+	EnableUI bool `json:"enableUI,omitempty"`
+}
+
+// ProtocolMethod returns WebAuthn.enable
+func (*WebAuthnEnableParams) ProtocolMethod() string {
+	return "WebAuthn.enable"
+}
+
+// Do sends WebAuthn.enable
+func (p *WebAuthnEnableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnDisableParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-disable
+type WebAuthnDisableParams struct {
+}
+
+// ProtocolMethod returns WebAuthn.disable
+func (*WebAuthnDisableParams) ProtocolMethod() string {
+	return "WebAuthn.disable"
+}
+
+// Do sends WebAuthn.disable
+func (p *WebAuthnDisableParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnAddVirtualAuthenticatorParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-addVirtualAuthenticator
+type WebAuthnAddVirtualAuthenticatorParams struct {
+	Options *WebAuthnVirtualAuthenticatorOptions `json:"options"`
+}
+
+// ProtocolMethod returns WebAuthn.addVirtualAuthenticator
+func (*WebAuthnAddVirtualAuthenticatorParams) ProtocolMethod() string {
+	return "WebAuthn.addVirtualAuthenticator"
+}
+
+// WebAuthnAddVirtualAuthenticatorResult https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-addVirtualAuthenticator
+type WebAuthnAddVirtualAuthenticatorResult struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+}
+
+// Do sends WebAuthn.addVirtualAuthenticator and returns its result
+func (p *WebAuthnAddVirtualAuthenticatorParams) Do(e Executor) (*WebAuthnAddVirtualAuthenticatorResult, error) {
+	var result = new(WebAuthnAddVirtualAuthenticatorResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WebAuthnSetResponseOverrideBitsParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setResponseOverrideBits
+type WebAuthnSetResponseOverrideBitsParams struct {
+	AuthenticatorID  WebAuthnAuthenticatorID `json:"authenticatorId"`
+	IsBogusSignature bool                    `json:"isBogusSignature,omitempty"`
+	IsBadUV          bool                    `json:"isBadUV,omitempty"`
+	IsBadUP          bool                    `json:"isBadUP,omitempty"`
+}
+
+// ProtocolMethod returns WebAuthn.setResponseOverrideBits
+func (*WebAuthnSetResponseOverrideBitsParams) ProtocolMethod() string {
+	return "WebAuthn.setResponseOverrideBits"
+}
+
+// Do sends WebAuthn.setResponseOverrideBits
+func (p *WebAuthnSetResponseOverrideBitsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnRemoveVirtualAuthenticatorParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-removeVirtualAuthenticator
+type WebAuthnRemoveVirtualAuthenticatorParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+}
+
+// ProtocolMethod returns WebAuthn.removeVirtualAuthenticator
+func (*WebAuthnRemoveVirtualAuthenticatorParams) ProtocolMethod() string {
+	return "WebAuthn.removeVirtualAuthenticator"
+}
+
+// Do sends WebAuthn.removeVirtualAuthenticator
+func (p *WebAuthnRemoveVirtualAuthenticatorParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnAddCredentialParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-addCredential
+type WebAuthnAddCredentialParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	Credential      *WebAuthnCredential     `json:"credential"`
+}
+
+// ProtocolMethod returns WebAuthn.addCredential
+func (*WebAuthnAddCredentialParams) ProtocolMethod() string {
+	return "WebAuthn.addCredential"
+}
+
+// Do sends WebAuthn.addCredential
+func (p *WebAuthnAddCredentialParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnGetCredentialParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-getCredential
+type WebAuthnGetCredentialParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	CredentialID    string                  `json:"credentialId"`
+}
+
+// ProtocolMethod returns WebAuthn.getCredential
+func (*WebAuthnGetCredentialParams) ProtocolMethod() string {
+	return "WebAuthn.getCredential"
+}
+
+// WebAuthnGetCredentialResult https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-getCredential
+type WebAuthnGetCredentialResult struct {
+	Credential *WebAuthnCredential `json:"credential"`
+}
+
+// Do sends WebAuthn.getCredential and returns its result
+func (p *WebAuthnGetCredentialParams) Do(e Executor) (*WebAuthnGetCredentialResult, error) {
+	var result = new(WebAuthnGetCredentialResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WebAuthnGetCredentialsParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-getCredentials
+type WebAuthnGetCredentialsParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+}
+
+// ProtocolMethod returns WebAuthn.getCredentials
+func (*WebAuthnGetCredentialsParams) ProtocolMethod() string {
+	return "WebAuthn.getCredentials"
+}
+
+// WebAuthnGetCredentialsResult https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-getCredentials
+type WebAuthnGetCredentialsResult struct {
+	Credentials []*WebAuthnCredential `json:"credentials"`
+}
+
+// Do sends WebAuthn.getCredentials and returns its result
+func (p *WebAuthnGetCredentialsParams) Do(e Executor) (*WebAuthnGetCredentialsResult, error) {
+	var result = new(WebAuthnGetCredentialsResult)
+	if err := e.Call(p.ProtocolMethod(), p, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WebAuthnRemoveCredentialParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-removeCredential
+type WebAuthnRemoveCredentialParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	CredentialID    string                  `json:"credentialId"`
+}
+
+// ProtocolMethod returns WebAuthn.removeCredential
+func (*WebAuthnRemoveCredentialParams) ProtocolMethod() string {
+	return "WebAuthn.removeCredential"
+}
+
+// Do sends WebAuthn.removeCredential
+func (p *WebAuthnRemoveCredentialParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnClearCredentialsParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-clearCredentials
+type WebAuthnClearCredentialsParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+}
+
+// ProtocolMethod returns WebAuthn.clearCredentials
+func (*WebAuthnClearCredentialsParams) ProtocolMethod() string {
+	return "WebAuthn.clearCredentials"
+}
+
+// Do sends WebAuthn.clearCredentials
+func (p *WebAuthnClearCredentialsParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnSetUserVerifiedParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setUserVerified
+type WebAuthnSetUserVerifiedParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	IsUserVerified  bool                    `json:"isUserVerified"`
+}
+
+// ProtocolMethod returns WebAuthn.setUserVerified
+func (*WebAuthnSetUserVerifiedParams) ProtocolMethod() string {
+	return "WebAuthn.setUserVerified"
+}
+
+// Do sends WebAuthn.setUserVerified
+func (p *WebAuthnSetUserVerifiedParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnSetAutomaticPresenceSimulationParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setAutomaticPresenceSimulation
+type WebAuthnSetAutomaticPresenceSimulationParams struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	Enabled         bool                    `json:"enabled"`
+}
+
+// ProtocolMethod returns WebAuthn.setAutomaticPresenceSimulation
+func (*WebAuthnSetAutomaticPresenceSimulationParams) ProtocolMethod() string {
+	return "WebAuthn.setAutomaticPresenceSimulation"
+}
+
+// Do sends WebAuthn.setAutomaticPresenceSimulation
+func (p *WebAuthnSetAutomaticPresenceSimulationParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnSetCredentialPropertiesParams https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#method-setCredentialProperties
+type WebAuthnSetCredentialPropertiesParams struct {
+	AuthenticatorID   WebAuthnAuthenticatorID `json:"authenticatorId"`
+	CredentialID      string                  `json:"credentialId"`
+	BackupEligibility bool                    `json:"backupEligibility,omitempty"`
+	BackupState       bool                    `json:"backupState,omitempty"`
+}
+
+// ProtocolMethod returns WebAuthn.setCredentialProperties
+func (*WebAuthnSetCredentialPropertiesParams) ProtocolMethod() string {
+	return "WebAuthn.setCredentialProperties"
+}
+
+// Do sends WebAuthn.setCredentialProperties
+func (p *WebAuthnSetCredentialPropertiesParams) Do(e Executor) error {
+	return e.Call(p.ProtocolMethod(), p, nil)
+}
+
+// WebAuthnCredentialAddedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialAdded
+type WebAuthnCredentialAddedEvent struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	Credential      *WebAuthnCredential     `json:"credential"`
+}
+
+// ProtocolMethod returns WebAuthn.credentialAdded
+func (*WebAuthnCredentialAddedEvent) ProtocolMethod() string {
+	return "WebAuthn.credentialAdded"
+}
+
+// WebAuthnCredentialDeletedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialDeleted
+type WebAuthnCredentialDeletedEvent struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	CredentialID    string                  `json:"credentialId"`
+}
+
+// ProtocolMethod returns WebAuthn.credentialDeleted
+func (*WebAuthnCredentialDeletedEvent) ProtocolMethod() string {
+	return "WebAuthn.credentialDeleted"
+}
+
+// WebAuthnCredentialUpdatedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialUpdated
+type WebAuthnCredentialUpdatedEvent struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	Credential      *WebAuthnCredential     `json:"credential"`
+}
+
+// ProtocolMethod returns WebAuthn.credentialUpdated
+func (*WebAuthnCredentialUpdatedEvent) ProtocolMethod() string {
+	return "WebAuthn.credentialUpdated"
+}
+
+// WebAuthnCredentialAssertedEvent https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn#event-credentialAsserted
+type WebAuthnCredentialAssertedEvent struct {
+	AuthenticatorID WebAuthnAuthenticatorID `json:"authenticatorId"`
+	Credential      *WebAuthnCredential     `json:"credential"`
+}
+
+// ProtocolMethod returns WebAuthn.credentialAsserted
+func (*WebAuthnCredentialAssertedEvent) ProtocolMethod() string {
+	return "WebAuthn.credentialAsserted"
+}
@@ -0,0 +1,276 @@
+package cdp
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// PoolOptions options of browser pool
+type PoolOptions struct {
+	Size          int                                         // number of browsers, runtime.NumCPU() if zero
+	MaxUses       int                                         // browser is relaunched after it was acquired this many times, never if zero
+	HealthTimeout time.Duration                               // timeout of Browser.getVersion health check, 5 seconds if zero
+	LaunchOptions *LaunchOptions                              // options of launched browsers
+	Launch        func(ctx context.Context) (*Browser, error) // custom launcher, e.g. to connect to remote browsers, LaunchOptions are ignored if set
+}
+
+// PoolStats counters of pool
+type PoolStats struct {
+	Size     int // number of slots
+	Idle     int // slots waiting for Acquire
+	InUse    int // sessions acquired and not released yet
+	Acquired int // total number of acquisitions
+	Launched int // total number of launched browsers
+	Recycled int // browsers closed after MaxUses
+	Crashed  int // browsers closed because of failed health check or broken session
+}
+
+// Pool keeps warm browsers and hands out sessions opened in isolated browser contexts,
+// so cookies and storage are not shared between acquisitions
+type Pool struct {
+	ctx    context.Context
+	opts   PoolOptions
+	idle   chan *poolSlot
+	done   chan struct{}
+	once   *sync.Once
+	mutex  *sync.Mutex
+	closed bool
+	stats  PoolStats
+	inUse  map[*PoolSession]struct{}
+	launch func(ctx context.Context) (*Browser, error)
+}
+
+type poolSlot struct {
+	browser *Browser
+	uses    int
+}
+
+// PoolSession session acquired from pool, must be returned with Pool.Release
+type PoolSession struct {
+	*Session
+	BrowserContext *BrowserContext
+	slot           *poolSlot
+}
+
+// NewPool launch opts.Size browsers, ctx bounds lifetime of launched browsers
+func NewPool(ctx context.Context, opts *PoolOptions) (*Pool, error) {
+	if opts == nil {
+		opts = &PoolOptions{}
+	}
+	pool := &Pool{
+		ctx:   ctx,
+		opts:  *opts,
+		done:  make(chan struct{}),
+		once:  &sync.Once{},
+		mutex: &sync.Mutex{},
+		inUse: map[*PoolSession]struct{}{},
+	}
+	if pool.opts.Size <= 0 {
+		pool.opts.Size = runtime.NumCPU()
+	}
+	if pool.opts.HealthTimeout == 0 {
+		pool.opts.HealthTimeout = 5 * time.Second
+	}
+	pool.launch = pool.opts.Launch
+	if pool.launch == nil {
+		pool.launch = func(ctx context.Context) (*Browser, error) {
+			return LaunchWithOptions(ctx, pool.opts.LaunchOptions)
+		}
+	}
+	pool.stats.Size = pool.opts.Size
+	pool.idle = make(chan *poolSlot, pool.opts.Size)
+	for i := 0; i < pool.opts.Size; i++ {
+		slot := &poolSlot{}
+		if err := pool.start(slot); err != nil {
+			_ = pool.Close()
+			return nil, err
+		}
+		pool.idle <- slot
+	}
+	return pool, nil
+}
+
+func (p *Pool) start(slot *poolSlot) error {
+	browser, err := p.launch(p.ctx)
+	if err != nil {
+		return err
+	}
+	slot.browser = browser
+	slot.uses = 0
+	p.mutex.Lock()
+	p.stats.Launched++
+	p.mutex.Unlock()
+	return nil
+}
+
+// stop closes browser of slot, next acquisition of slot launches new one
+func (p *Pool) stop(slot *poolSlot, counter *int) error {
+	if slot.browser == nil {
+		return nil
+	}
+	err := slot.browser.Close()
+	slot.browser = nil
+	if counter != nil {
+		p.mutex.Lock()
+		*counter++
+		p.mutex.Unlock()
+	}
+	return err
+}
+
+// healthy checks browser responds with Browser.getVersion
+func (p *Pool) healthy(browser *Browser) bool {
+	select {
+	case <-browser.wsClient.disconnected:
+		return false
+	default:
+	}
+	b := *browser
+	b.deadline = p.opts.HealthTimeout
+	_, err := b.getVersion()
+	return err == nil
+}
+
+// Acquire wait for idle browser and open new page in new browser context,
+// ctx also cancels waiting for browser being launched and page being opened
+func (p *Pool) Acquire(ctx context.Context) (*PoolSession, error) {
+	var slot *poolSlot
+	select {
+	case slot = <-p.idle:
+	case <-p.done:
+		return nil, ErrPoolClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	type opened struct {
+		session *PoolSession
+		err     error
+	}
+	result := make(chan opened, 1)
+	go func() {
+		session, err := p.open(slot)
+		result <- opened{session: session, err: err}
+	}()
+	select {
+	case r := <-result:
+		if r.err != nil {
+			p.crashed(slot)
+			return nil, r.err
+		}
+		p.mutex.Lock()
+		p.stats.Acquired++
+		p.inUse[r.session] = struct{}{}
+		p.mutex.Unlock()
+		return r.session, nil
+	case <-ctx.Done():
+		// launched browser is kept, slot is returned to pool when page is opened
+		go func() {
+			if r := <-result; r.err != nil {
+				p.crashed(slot)
+			} else {
+				_ = p.recycle(r.session)
+			}
+		}()
+		return nil, ctx.Err()
+	}
+}
+
+// crashed returns slot without browser, it is relaunched by next acquisition
+func (p *Pool) crashed(slot *poolSlot) {
+	_ = p.stop(slot, &p.stats.Crashed)
+	p.put(slot)
+}
+
+func (p *Pool) open(slot *poolSlot) (*PoolSession, error) {
+	if slot.browser != nil && !p.healthy(slot.browser) {
+		_ = p.stop(slot, &p.stats.Crashed)
+	}
+	if slot.browser == nil {
+		if err := p.start(slot); err != nil {
+			return nil, err
+		}
+	}
+	bc, err := slot.browser.NewContext(nil)
+	if err != nil {
+		return nil, err
+	}
+	session, err := bc.NewPage(blankPage)
+	if err != nil {
+		_ = bc.Close()
+		return nil, err
+	}
+	slot.uses++
+	return &PoolSession{Session: session, BrowserContext: bc, slot: slot}, nil
+}
+
+func (p *Pool) put(slot *poolSlot) {
+	p.mutex.Lock()
+	if !p.closed {
+		// never blocks, capacity of idle is number of slots
+		p.idle <- slot
+		p.mutex.Unlock()
+		return
+	}
+	p.mutex.Unlock()
+	_ = p.stop(slot, nil)
+}
+
+// Release close browser context of session and return browser to pool,
+// browser is relaunched if it was used MaxUses times or it is broken
+func (p *Pool) Release(s *PoolSession) error {
+	p.mutex.Lock()
+	if _, has := p.inUse[s]; !has {
+		p.mutex.Unlock()
+		return nil
+	}
+	delete(p.inUse, s)
+	p.mutex.Unlock()
+	return p.recycle(s)
+}
+
+// recycle closes browser context of session and returns slot to pool
+func (p *Pool) recycle(s *PoolSession) error {
+	err := s.BrowserContext.Close()
+	switch {
+	case err != nil:
+		_ = p.stop(s.slot, &p.stats.Crashed)
+	case p.opts.MaxUses > 0 && s.slot.uses >= p.opts.MaxUses:
+		_ = p.stop(s.slot, &p.stats.Recycled)
+	}
+	p.put(s.slot)
+	return err
+}
+
+// Stats returns counters of pool
+func (p *Pool) Stats() PoolStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	stats := p.stats
+	stats.Idle = len(p.idle)
+	stats.InUse = len(p.inUse)
+	return stats
+}
+
+// Close close idle browsers, browsers in use are closed on Release
+func (p *Pool) Close() error {
+	p.once.Do(func() { close(p.done) })
+	p.mutex.Lock()
+	p.closed = true
+	var slots []*poolSlot
+	for len(p.idle) > 0 {
+		slots = append(slots, <-p.idle)
+	}
+	p.mutex.Unlock()
+	cleanup := &PoolCloseError{}
+	for _, slot := range slots {
+		if err := p.stop(slot, nil); err != nil {
+			cleanup.Errors = append(cleanup.Errors, err)
+		}
+	}
+	if len(cleanup.Errors) > 0 {
+		return cleanup
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"sync"
+)
+
+var errProcessReaped = errors.New("process is already reaped")
+
+// process browser's process, it is reaped only after its group was killed,
+// so id of the group can not be reused by another process before that
+type process struct {
+	cmd    *exec.Cmd
+	mutex  *sync.Mutex
+	reaped bool
+	exited chan struct{} // closed when process exits, it is not reaped yet
+}
+
+// startProcess starts cmd in its own process group, the group is killed when ctx is done
+func startProcess(ctx context.Context, cmd *exec.Cmd) (*process, error) {
+	setProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	p := &process{cmd: cmd, mutex: &sync.Mutex{}, exited: make(chan struct{})}
+	go func() {
+		_ = waitExited(cmd.Process)
+		close(p.exited)
+	}()
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = p.kill()
+		case <-p.exited:
+		}
+	}()
+	return p, nil
+}
+
+// kill kills process with all its group, returns error if there was nothing to kill
+func (p *process) kill() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.reaped {
+		return errProcessReaped
+	}
+	return killProcessGroup(p.cmd.Process)
+}
+
+// reap waits until process exits and reaps it, id of its group can be reused after that
+func (p *process) reap() {
+	<-p.exited
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if !p.reaped {
+		_, _ = p.cmd.Process.Wait()
+		p.reaped = true
+	}
+}
+
+// wait reaps process and releases its stdio, it waits until the rest of group closes stdio too
+func (p *process) wait() {
+	p.reap()
+	_ = p.cmd.Wait()
+}
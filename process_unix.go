@@ -0,0 +1,25 @@
+//go:build !windows
+// +build !windows
+
+package cdp
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts browser in its own process group so it can be killed with all its children
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup kills all processes of group, returns error if there was nothing to kill
+func killProcessGroup(p *os.Process) error {
+	return syscall.Kill(-p.Pid, syscall.SIGKILL)
+}
+
+// processGroupAlive is any process of group still alive (or not reaped yet)
+func processGroupAlive(p *os.Process) bool {
+	return syscall.Kill(-p.Pid, 0) == nil
+}
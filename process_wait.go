@@ -0,0 +1,15 @@
+//go:build !windows && !linux && !darwin
+// +build !windows,!linux,!darwin
+
+package cdp
+
+import (
+	"os"
+)
+
+// waitExited waits until process exits, there is no way to leave it unreaped on this platform,
+// so group of exited browser can not be killed safely
+func waitExited(p *os.Process) error {
+	_, err := p.Wait()
+	return err
+}
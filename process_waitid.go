@@ -0,0 +1,26 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package cdp
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const waitidPID = 1 // P_PID
+
+// waitExited waits until process exits, process is left unreaped (WNOWAIT)
+func waitExited(p *os.Process) error {
+	var info [128]byte // siginfo_t
+	for {
+		_, _, errno := syscall.Syscall6(syscall.SYS_WAITID, waitidPID, uintptr(p.Pid), uintptr(unsafe.Pointer(&info)), syscall.WEXITED|syscall.WNOWAIT, 0, 0)
+		if errno != syscall.EINTR {
+			if errno != 0 {
+				return errno
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,32 @@
+//go:build windows
+// +build windows
+
+package cdp
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills browser process only, children are terminated by browser itself
+func killProcessGroup(p *os.Process) error {
+	return p.Kill()
+}
+
+func processGroupAlive(p *os.Process) bool {
+	return false
+}
+
+// waitExited waits until process exits, process handle is not released
+func waitExited(p *os.Process) error {
+	h, err := syscall.OpenProcess(syscall.SYNCHRONIZE, false, uint32(p.Pid))
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(h)
+	_, err = syscall.WaitForSingleObject(h, syscall.INFINITE)
+	return err
+}
@@ -0,0 +1,3425 @@
+// Code generated by gen; DO NOT EDIT.
+
+package cdp
+
+import (
+	"context"
+
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+// OnAccessibilityLoadComplete subscribe to Accessibility.loadComplete
+func (session *Session) OnAccessibilityLoadComplete(cb func(*protocol.AccessibilityLoadCompleteEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAccessibilityLoadComplete, func() interface{} { return new(protocol.AccessibilityLoadCompleteEvent) }, func(v interface{}) { cb(v.(*protocol.AccessibilityLoadCompleteEvent)) }, onError)
+}
+
+// WaitForAccessibilityLoadComplete waits for Accessibility.loadComplete matching predicate, see WaitForEvent
+func (session Session) WaitForAccessibilityLoadComplete(ctx context.Context, predicate func(*protocol.AccessibilityLoadCompleteEvent) bool, action func() error) (*protocol.AccessibilityLoadCompleteEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAccessibilityLoadComplete, func() interface{} { return new(protocol.AccessibilityLoadCompleteEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AccessibilityLoadCompleteEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AccessibilityLoadCompleteEvent), nil
+}
+
+// OnAccessibilityNodesUpdated subscribe to Accessibility.nodesUpdated
+func (session *Session) OnAccessibilityNodesUpdated(cb func(*protocol.AccessibilityNodesUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAccessibilityNodesUpdated, func() interface{} { return new(protocol.AccessibilityNodesUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.AccessibilityNodesUpdatedEvent)) }, onError)
+}
+
+// WaitForAccessibilityNodesUpdated waits for Accessibility.nodesUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForAccessibilityNodesUpdated(ctx context.Context, predicate func(*protocol.AccessibilityNodesUpdatedEvent) bool, action func() error) (*protocol.AccessibilityNodesUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAccessibilityNodesUpdated, func() interface{} { return new(protocol.AccessibilityNodesUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AccessibilityNodesUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AccessibilityNodesUpdatedEvent), nil
+}
+
+// OnAnimationAnimationCanceled subscribe to Animation.animationCanceled
+func (session *Session) OnAnimationAnimationCanceled(cb func(*protocol.AnimationAnimationCanceledEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAnimationAnimationCanceled, func() interface{} { return new(protocol.AnimationAnimationCanceledEvent) }, func(v interface{}) { cb(v.(*protocol.AnimationAnimationCanceledEvent)) }, onError)
+}
+
+// WaitForAnimationAnimationCanceled waits for Animation.animationCanceled matching predicate, see WaitForEvent
+func (session Session) WaitForAnimationAnimationCanceled(ctx context.Context, predicate func(*protocol.AnimationAnimationCanceledEvent) bool, action func() error) (*protocol.AnimationAnimationCanceledEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAnimationAnimationCanceled, func() interface{} { return new(protocol.AnimationAnimationCanceledEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AnimationAnimationCanceledEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AnimationAnimationCanceledEvent), nil
+}
+
+// OnAnimationAnimationCreated subscribe to Animation.animationCreated
+func (session *Session) OnAnimationAnimationCreated(cb func(*protocol.AnimationAnimationCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAnimationAnimationCreated, func() interface{} { return new(protocol.AnimationAnimationCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.AnimationAnimationCreatedEvent)) }, onError)
+}
+
+// WaitForAnimationAnimationCreated waits for Animation.animationCreated matching predicate, see WaitForEvent
+func (session Session) WaitForAnimationAnimationCreated(ctx context.Context, predicate func(*protocol.AnimationAnimationCreatedEvent) bool, action func() error) (*protocol.AnimationAnimationCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAnimationAnimationCreated, func() interface{} { return new(protocol.AnimationAnimationCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AnimationAnimationCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AnimationAnimationCreatedEvent), nil
+}
+
+// OnAnimationAnimationStarted subscribe to Animation.animationStarted
+func (session *Session) OnAnimationAnimationStarted(cb func(*protocol.AnimationAnimationStartedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAnimationAnimationStarted, func() interface{} { return new(protocol.AnimationAnimationStartedEvent) }, func(v interface{}) { cb(v.(*protocol.AnimationAnimationStartedEvent)) }, onError)
+}
+
+// WaitForAnimationAnimationStarted waits for Animation.animationStarted matching predicate, see WaitForEvent
+func (session Session) WaitForAnimationAnimationStarted(ctx context.Context, predicate func(*protocol.AnimationAnimationStartedEvent) bool, action func() error) (*protocol.AnimationAnimationStartedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAnimationAnimationStarted, func() interface{} { return new(protocol.AnimationAnimationStartedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AnimationAnimationStartedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AnimationAnimationStartedEvent), nil
+}
+
+// OnAnimationAnimationUpdated subscribe to Animation.animationUpdated
+func (session *Session) OnAnimationAnimationUpdated(cb func(*protocol.AnimationAnimationUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAnimationAnimationUpdated, func() interface{} { return new(protocol.AnimationAnimationUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.AnimationAnimationUpdatedEvent)) }, onError)
+}
+
+// WaitForAnimationAnimationUpdated waits for Animation.animationUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForAnimationAnimationUpdated(ctx context.Context, predicate func(*protocol.AnimationAnimationUpdatedEvent) bool, action func() error) (*protocol.AnimationAnimationUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAnimationAnimationUpdated, func() interface{} { return new(protocol.AnimationAnimationUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AnimationAnimationUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AnimationAnimationUpdatedEvent), nil
+}
+
+// OnAuditsIssueAdded subscribe to Audits.issueAdded
+func (session *Session) OnAuditsIssueAdded(cb func(*protocol.AuditsIssueAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAuditsIssueAdded, func() interface{} { return new(protocol.AuditsIssueAddedEvent) }, func(v interface{}) { cb(v.(*protocol.AuditsIssueAddedEvent)) }, onError)
+}
+
+// WaitForAuditsIssueAdded waits for Audits.issueAdded matching predicate, see WaitForEvent
+func (session Session) WaitForAuditsIssueAdded(ctx context.Context, predicate func(*protocol.AuditsIssueAddedEvent) bool, action func() error) (*protocol.AuditsIssueAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAuditsIssueAdded, func() interface{} { return new(protocol.AuditsIssueAddedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.AuditsIssueAddedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AuditsIssueAddedEvent), nil
+}
+
+// OnAutofillAddressFormFilled subscribe to Autofill.addressFormFilled
+func (session *Session) OnAutofillAddressFormFilled(cb func(*protocol.AutofillAddressFormFilledEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventAutofillAddressFormFilled, func() interface{} { return new(protocol.AutofillAddressFormFilledEvent) }, func(v interface{}) { cb(v.(*protocol.AutofillAddressFormFilledEvent)) }, onError)
+}
+
+// WaitForAutofillAddressFormFilled waits for Autofill.addressFormFilled matching predicate, see WaitForEvent
+func (session Session) WaitForAutofillAddressFormFilled(ctx context.Context, predicate func(*protocol.AutofillAddressFormFilledEvent) bool, action func() error) (*protocol.AutofillAddressFormFilledEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventAutofillAddressFormFilled, func() interface{} { return new(protocol.AutofillAddressFormFilledEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.AutofillAddressFormFilledEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.AutofillAddressFormFilledEvent), nil
+}
+
+// OnBackgroundServiceRecordingStateChanged subscribe to BackgroundService.recordingStateChanged
+func (session *Session) OnBackgroundServiceRecordingStateChanged(cb func(*protocol.BackgroundServiceRecordingStateChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBackgroundServiceRecordingStateChanged, func() interface{} { return new(protocol.BackgroundServiceRecordingStateChangedEvent) }, func(v interface{}) { cb(v.(*protocol.BackgroundServiceRecordingStateChangedEvent)) }, onError)
+}
+
+// WaitForBackgroundServiceRecordingStateChanged waits for BackgroundService.recordingStateChanged matching predicate, see WaitForEvent
+func (session Session) WaitForBackgroundServiceRecordingStateChanged(ctx context.Context, predicate func(*protocol.BackgroundServiceRecordingStateChangedEvent) bool, action func() error) (*protocol.BackgroundServiceRecordingStateChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBackgroundServiceRecordingStateChanged, func() interface{} { return new(protocol.BackgroundServiceRecordingStateChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BackgroundServiceRecordingStateChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BackgroundServiceRecordingStateChangedEvent), nil
+}
+
+// OnBackgroundServiceBackgroundServiceEventReceived subscribe to BackgroundService.backgroundServiceEventReceived
+func (session *Session) OnBackgroundServiceBackgroundServiceEventReceived(cb func(*protocol.BackgroundServiceBackgroundServiceEventReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBackgroundServiceBackgroundServiceEventReceived, func() interface{} { return new(protocol.BackgroundServiceBackgroundServiceEventReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.BackgroundServiceBackgroundServiceEventReceivedEvent)) }, onError)
+}
+
+// WaitForBackgroundServiceBackgroundServiceEventReceived waits for BackgroundService.backgroundServiceEventReceived matching predicate, see WaitForEvent
+func (session Session) WaitForBackgroundServiceBackgroundServiceEventReceived(ctx context.Context, predicate func(*protocol.BackgroundServiceBackgroundServiceEventReceivedEvent) bool, action func() error) (*protocol.BackgroundServiceBackgroundServiceEventReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBackgroundServiceBackgroundServiceEventReceived, func() interface{} { return new(protocol.BackgroundServiceBackgroundServiceEventReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BackgroundServiceBackgroundServiceEventReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BackgroundServiceBackgroundServiceEventReceivedEvent), nil
+}
+
+// OnBluetoothEmulationGattOperationReceived subscribe to BluetoothEmulation.gattOperationReceived
+func (session *Session) OnBluetoothEmulationGattOperationReceived(cb func(*protocol.BluetoothEmulationGattOperationReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBluetoothEmulationGattOperationReceived, func() interface{} { return new(protocol.BluetoothEmulationGattOperationReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.BluetoothEmulationGattOperationReceivedEvent)) }, onError)
+}
+
+// WaitForBluetoothEmulationGattOperationReceived waits for BluetoothEmulation.gattOperationReceived matching predicate, see WaitForEvent
+func (session Session) WaitForBluetoothEmulationGattOperationReceived(ctx context.Context, predicate func(*protocol.BluetoothEmulationGattOperationReceivedEvent) bool, action func() error) (*protocol.BluetoothEmulationGattOperationReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBluetoothEmulationGattOperationReceived, func() interface{} { return new(protocol.BluetoothEmulationGattOperationReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BluetoothEmulationGattOperationReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BluetoothEmulationGattOperationReceivedEvent), nil
+}
+
+// OnBluetoothEmulationCharacteristicOperationReceived subscribe to BluetoothEmulation.characteristicOperationReceived
+func (session *Session) OnBluetoothEmulationCharacteristicOperationReceived(cb func(*protocol.BluetoothEmulationCharacteristicOperationReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBluetoothEmulationCharacteristicOperationReceived, func() interface{} { return new(protocol.BluetoothEmulationCharacteristicOperationReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.BluetoothEmulationCharacteristicOperationReceivedEvent)) }, onError)
+}
+
+// WaitForBluetoothEmulationCharacteristicOperationReceived waits for BluetoothEmulation.characteristicOperationReceived matching predicate, see WaitForEvent
+func (session Session) WaitForBluetoothEmulationCharacteristicOperationReceived(ctx context.Context, predicate func(*protocol.BluetoothEmulationCharacteristicOperationReceivedEvent) bool, action func() error) (*protocol.BluetoothEmulationCharacteristicOperationReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBluetoothEmulationCharacteristicOperationReceived, func() interface{} { return new(protocol.BluetoothEmulationCharacteristicOperationReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BluetoothEmulationCharacteristicOperationReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BluetoothEmulationCharacteristicOperationReceivedEvent), nil
+}
+
+// OnBluetoothEmulationDescriptorOperationReceived subscribe to BluetoothEmulation.descriptorOperationReceived
+func (session *Session) OnBluetoothEmulationDescriptorOperationReceived(cb func(*protocol.BluetoothEmulationDescriptorOperationReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBluetoothEmulationDescriptorOperationReceived, func() interface{} { return new(protocol.BluetoothEmulationDescriptorOperationReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.BluetoothEmulationDescriptorOperationReceivedEvent)) }, onError)
+}
+
+// WaitForBluetoothEmulationDescriptorOperationReceived waits for BluetoothEmulation.descriptorOperationReceived matching predicate, see WaitForEvent
+func (session Session) WaitForBluetoothEmulationDescriptorOperationReceived(ctx context.Context, predicate func(*protocol.BluetoothEmulationDescriptorOperationReceivedEvent) bool, action func() error) (*protocol.BluetoothEmulationDescriptorOperationReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBluetoothEmulationDescriptorOperationReceived, func() interface{} { return new(protocol.BluetoothEmulationDescriptorOperationReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BluetoothEmulationDescriptorOperationReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BluetoothEmulationDescriptorOperationReceivedEvent), nil
+}
+
+// OnBrowserDownloadWillBegin subscribe to Browser.downloadWillBegin
+func (session *Session) OnBrowserDownloadWillBegin(cb func(*protocol.BrowserDownloadWillBeginEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBrowserDownloadWillBegin, func() interface{} { return new(protocol.BrowserDownloadWillBeginEvent) }, func(v interface{}) { cb(v.(*protocol.BrowserDownloadWillBeginEvent)) }, onError)
+}
+
+// WaitForBrowserDownloadWillBegin waits for Browser.downloadWillBegin matching predicate, see WaitForEvent
+func (session Session) WaitForBrowserDownloadWillBegin(ctx context.Context, predicate func(*protocol.BrowserDownloadWillBeginEvent) bool, action func() error) (*protocol.BrowserDownloadWillBeginEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBrowserDownloadWillBegin, func() interface{} { return new(protocol.BrowserDownloadWillBeginEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BrowserDownloadWillBeginEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BrowserDownloadWillBeginEvent), nil
+}
+
+// OnBrowserDownloadProgress subscribe to Browser.downloadProgress
+func (session *Session) OnBrowserDownloadProgress(cb func(*protocol.BrowserDownloadProgressEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventBrowserDownloadProgress, func() interface{} { return new(protocol.BrowserDownloadProgressEvent) }, func(v interface{}) { cb(v.(*protocol.BrowserDownloadProgressEvent)) }, onError)
+}
+
+// WaitForBrowserDownloadProgress waits for Browser.downloadProgress matching predicate, see WaitForEvent
+func (session Session) WaitForBrowserDownloadProgress(ctx context.Context, predicate func(*protocol.BrowserDownloadProgressEvent) bool, action func() error) (*protocol.BrowserDownloadProgressEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventBrowserDownloadProgress, func() interface{} { return new(protocol.BrowserDownloadProgressEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.BrowserDownloadProgressEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.BrowserDownloadProgressEvent), nil
+}
+
+// OnCSSFontsUpdated subscribe to CSS.fontsUpdated
+func (session *Session) OnCSSFontsUpdated(cb func(*protocol.CSSFontsUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCSSFontsUpdated, func() interface{} { return new(protocol.CSSFontsUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.CSSFontsUpdatedEvent)) }, onError)
+}
+
+// WaitForCSSFontsUpdated waits for CSS.fontsUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForCSSFontsUpdated(ctx context.Context, predicate func(*protocol.CSSFontsUpdatedEvent) bool, action func() error) (*protocol.CSSFontsUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCSSFontsUpdated, func() interface{} { return new(protocol.CSSFontsUpdatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.CSSFontsUpdatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CSSFontsUpdatedEvent), nil
+}
+
+// OnCSSMediaQueryResultChanged subscribe to CSS.mediaQueryResultChanged
+func (session *Session) OnCSSMediaQueryResultChanged(cb func(*protocol.CSSMediaQueryResultChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCSSMediaQueryResultChanged, func() interface{} { return new(protocol.CSSMediaQueryResultChangedEvent) }, func(v interface{}) { cb(v.(*protocol.CSSMediaQueryResultChangedEvent)) }, onError)
+}
+
+// WaitForCSSMediaQueryResultChanged waits for CSS.mediaQueryResultChanged matching predicate, see WaitForEvent
+func (session Session) WaitForCSSMediaQueryResultChanged(ctx context.Context, predicate func(*protocol.CSSMediaQueryResultChangedEvent) bool, action func() error) (*protocol.CSSMediaQueryResultChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCSSMediaQueryResultChanged, func() interface{} { return new(protocol.CSSMediaQueryResultChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.CSSMediaQueryResultChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CSSMediaQueryResultChangedEvent), nil
+}
+
+// OnCSSStyleSheetAdded subscribe to CSS.styleSheetAdded
+func (session *Session) OnCSSStyleSheetAdded(cb func(*protocol.CSSStyleSheetAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCSSStyleSheetAdded, func() interface{} { return new(protocol.CSSStyleSheetAddedEvent) }, func(v interface{}) { cb(v.(*protocol.CSSStyleSheetAddedEvent)) }, onError)
+}
+
+// WaitForCSSStyleSheetAdded waits for CSS.styleSheetAdded matching predicate, see WaitForEvent
+func (session Session) WaitForCSSStyleSheetAdded(ctx context.Context, predicate func(*protocol.CSSStyleSheetAddedEvent) bool, action func() error) (*protocol.CSSStyleSheetAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCSSStyleSheetAdded, func() interface{} { return new(protocol.CSSStyleSheetAddedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.CSSStyleSheetAddedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CSSStyleSheetAddedEvent), nil
+}
+
+// OnCSSStyleSheetChanged subscribe to CSS.styleSheetChanged
+func (session *Session) OnCSSStyleSheetChanged(cb func(*protocol.CSSStyleSheetChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCSSStyleSheetChanged, func() interface{} { return new(protocol.CSSStyleSheetChangedEvent) }, func(v interface{}) { cb(v.(*protocol.CSSStyleSheetChangedEvent)) }, onError)
+}
+
+// WaitForCSSStyleSheetChanged waits for CSS.styleSheetChanged matching predicate, see WaitForEvent
+func (session Session) WaitForCSSStyleSheetChanged(ctx context.Context, predicate func(*protocol.CSSStyleSheetChangedEvent) bool, action func() error) (*protocol.CSSStyleSheetChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCSSStyleSheetChanged, func() interface{} { return new(protocol.CSSStyleSheetChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.CSSStyleSheetChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CSSStyleSheetChangedEvent), nil
+}
+
+// OnCSSStyleSheetRemoved subscribe to CSS.styleSheetRemoved
+func (session *Session) OnCSSStyleSheetRemoved(cb func(*protocol.CSSStyleSheetRemovedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCSSStyleSheetRemoved, func() interface{} { return new(protocol.CSSStyleSheetRemovedEvent) }, func(v interface{}) { cb(v.(*protocol.CSSStyleSheetRemovedEvent)) }, onError)
+}
+
+// WaitForCSSStyleSheetRemoved waits for CSS.styleSheetRemoved matching predicate, see WaitForEvent
+func (session Session) WaitForCSSStyleSheetRemoved(ctx context.Context, predicate func(*protocol.CSSStyleSheetRemovedEvent) bool, action func() error) (*protocol.CSSStyleSheetRemovedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCSSStyleSheetRemoved, func() interface{} { return new(protocol.CSSStyleSheetRemovedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.CSSStyleSheetRemovedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CSSStyleSheetRemovedEvent), nil
+}
+
+// OnCSSComputedStyleUpdated subscribe to CSS.computedStyleUpdated
+func (session *Session) OnCSSComputedStyleUpdated(cb func(*protocol.CSSComputedStyleUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCSSComputedStyleUpdated, func() interface{} { return new(protocol.CSSComputedStyleUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.CSSComputedStyleUpdatedEvent)) }, onError)
+}
+
+// WaitForCSSComputedStyleUpdated waits for CSS.computedStyleUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForCSSComputedStyleUpdated(ctx context.Context, predicate func(*protocol.CSSComputedStyleUpdatedEvent) bool, action func() error) (*protocol.CSSComputedStyleUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCSSComputedStyleUpdated, func() interface{} { return new(protocol.CSSComputedStyleUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.CSSComputedStyleUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CSSComputedStyleUpdatedEvent), nil
+}
+
+// OnCastSinksUpdated subscribe to Cast.sinksUpdated
+func (session *Session) OnCastSinksUpdated(cb func(*protocol.CastSinksUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCastSinksUpdated, func() interface{} { return new(protocol.CastSinksUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.CastSinksUpdatedEvent)) }, onError)
+}
+
+// WaitForCastSinksUpdated waits for Cast.sinksUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForCastSinksUpdated(ctx context.Context, predicate func(*protocol.CastSinksUpdatedEvent) bool, action func() error) (*protocol.CastSinksUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCastSinksUpdated, func() interface{} { return new(protocol.CastSinksUpdatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.CastSinksUpdatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CastSinksUpdatedEvent), nil
+}
+
+// OnCastIssueUpdated subscribe to Cast.issueUpdated
+func (session *Session) OnCastIssueUpdated(cb func(*protocol.CastIssueUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventCastIssueUpdated, func() interface{} { return new(protocol.CastIssueUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.CastIssueUpdatedEvent)) }, onError)
+}
+
+// WaitForCastIssueUpdated waits for Cast.issueUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForCastIssueUpdated(ctx context.Context, predicate func(*protocol.CastIssueUpdatedEvent) bool, action func() error) (*protocol.CastIssueUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventCastIssueUpdated, func() interface{} { return new(protocol.CastIssueUpdatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.CastIssueUpdatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.CastIssueUpdatedEvent), nil
+}
+
+// OnConsoleMessageAdded subscribe to Console.messageAdded
+func (session *Session) OnConsoleMessageAdded(cb func(*protocol.ConsoleMessageAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventConsoleMessageAdded, func() interface{} { return new(protocol.ConsoleMessageAddedEvent) }, func(v interface{}) { cb(v.(*protocol.ConsoleMessageAddedEvent)) }, onError)
+}
+
+// WaitForConsoleMessageAdded waits for Console.messageAdded matching predicate, see WaitForEvent
+func (session Session) WaitForConsoleMessageAdded(ctx context.Context, predicate func(*protocol.ConsoleMessageAddedEvent) bool, action func() error) (*protocol.ConsoleMessageAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventConsoleMessageAdded, func() interface{} { return new(protocol.ConsoleMessageAddedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.ConsoleMessageAddedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ConsoleMessageAddedEvent), nil
+}
+
+// OnDOMAttributeModified subscribe to DOM.attributeModified
+func (session *Session) OnDOMAttributeModified(cb func(*protocol.DOMAttributeModifiedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMAttributeModified, func() interface{} { return new(protocol.DOMAttributeModifiedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMAttributeModifiedEvent)) }, onError)
+}
+
+// WaitForDOMAttributeModified waits for DOM.attributeModified matching predicate, see WaitForEvent
+func (session Session) WaitForDOMAttributeModified(ctx context.Context, predicate func(*protocol.DOMAttributeModifiedEvent) bool, action func() error) (*protocol.DOMAttributeModifiedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMAttributeModified, func() interface{} { return new(protocol.DOMAttributeModifiedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMAttributeModifiedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMAttributeModifiedEvent), nil
+}
+
+// OnDOMAttributeRemoved subscribe to DOM.attributeRemoved
+func (session *Session) OnDOMAttributeRemoved(cb func(*protocol.DOMAttributeRemovedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMAttributeRemoved, func() interface{} { return new(protocol.DOMAttributeRemovedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMAttributeRemovedEvent)) }, onError)
+}
+
+// WaitForDOMAttributeRemoved waits for DOM.attributeRemoved matching predicate, see WaitForEvent
+func (session Session) WaitForDOMAttributeRemoved(ctx context.Context, predicate func(*protocol.DOMAttributeRemovedEvent) bool, action func() error) (*protocol.DOMAttributeRemovedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMAttributeRemoved, func() interface{} { return new(protocol.DOMAttributeRemovedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DOMAttributeRemovedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMAttributeRemovedEvent), nil
+}
+
+// OnDOMCharacterDataModified subscribe to DOM.characterDataModified
+func (session *Session) OnDOMCharacterDataModified(cb func(*protocol.DOMCharacterDataModifiedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMCharacterDataModified, func() interface{} { return new(protocol.DOMCharacterDataModifiedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMCharacterDataModifiedEvent)) }, onError)
+}
+
+// WaitForDOMCharacterDataModified waits for DOM.characterDataModified matching predicate, see WaitForEvent
+func (session Session) WaitForDOMCharacterDataModified(ctx context.Context, predicate func(*protocol.DOMCharacterDataModifiedEvent) bool, action func() error) (*protocol.DOMCharacterDataModifiedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMCharacterDataModified, func() interface{} { return new(protocol.DOMCharacterDataModifiedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMCharacterDataModifiedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMCharacterDataModifiedEvent), nil
+}
+
+// OnDOMChildNodeCountUpdated subscribe to DOM.childNodeCountUpdated
+func (session *Session) OnDOMChildNodeCountUpdated(cb func(*protocol.DOMChildNodeCountUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMChildNodeCountUpdated, func() interface{} { return new(protocol.DOMChildNodeCountUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMChildNodeCountUpdatedEvent)) }, onError)
+}
+
+// WaitForDOMChildNodeCountUpdated waits for DOM.childNodeCountUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMChildNodeCountUpdated(ctx context.Context, predicate func(*protocol.DOMChildNodeCountUpdatedEvent) bool, action func() error) (*protocol.DOMChildNodeCountUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMChildNodeCountUpdated, func() interface{} { return new(protocol.DOMChildNodeCountUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMChildNodeCountUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMChildNodeCountUpdatedEvent), nil
+}
+
+// OnDOMChildNodeInserted subscribe to DOM.childNodeInserted
+func (session *Session) OnDOMChildNodeInserted(cb func(*protocol.DOMChildNodeInsertedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMChildNodeInserted, func() interface{} { return new(protocol.DOMChildNodeInsertedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMChildNodeInsertedEvent)) }, onError)
+}
+
+// WaitForDOMChildNodeInserted waits for DOM.childNodeInserted matching predicate, see WaitForEvent
+func (session Session) WaitForDOMChildNodeInserted(ctx context.Context, predicate func(*protocol.DOMChildNodeInsertedEvent) bool, action func() error) (*protocol.DOMChildNodeInsertedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMChildNodeInserted, func() interface{} { return new(protocol.DOMChildNodeInsertedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMChildNodeInsertedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMChildNodeInsertedEvent), nil
+}
+
+// OnDOMChildNodeRemoved subscribe to DOM.childNodeRemoved
+func (session *Session) OnDOMChildNodeRemoved(cb func(*protocol.DOMChildNodeRemovedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMChildNodeRemoved, func() interface{} { return new(protocol.DOMChildNodeRemovedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMChildNodeRemovedEvent)) }, onError)
+}
+
+// WaitForDOMChildNodeRemoved waits for DOM.childNodeRemoved matching predicate, see WaitForEvent
+func (session Session) WaitForDOMChildNodeRemoved(ctx context.Context, predicate func(*protocol.DOMChildNodeRemovedEvent) bool, action func() error) (*protocol.DOMChildNodeRemovedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMChildNodeRemoved, func() interface{} { return new(protocol.DOMChildNodeRemovedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DOMChildNodeRemovedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMChildNodeRemovedEvent), nil
+}
+
+// OnDOMDistributedNodesUpdated subscribe to DOM.distributedNodesUpdated
+func (session *Session) OnDOMDistributedNodesUpdated(cb func(*protocol.DOMDistributedNodesUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMDistributedNodesUpdated, func() interface{} { return new(protocol.DOMDistributedNodesUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMDistributedNodesUpdatedEvent)) }, onError)
+}
+
+// WaitForDOMDistributedNodesUpdated waits for DOM.distributedNodesUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMDistributedNodesUpdated(ctx context.Context, predicate func(*protocol.DOMDistributedNodesUpdatedEvent) bool, action func() error) (*protocol.DOMDistributedNodesUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMDistributedNodesUpdated, func() interface{} { return new(protocol.DOMDistributedNodesUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMDistributedNodesUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMDistributedNodesUpdatedEvent), nil
+}
+
+// OnDOMDocumentUpdated subscribe to DOM.documentUpdated
+func (session *Session) OnDOMDocumentUpdated(cb func(*protocol.DOMDocumentUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMDocumentUpdated, func() interface{} { return new(protocol.DOMDocumentUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMDocumentUpdatedEvent)) }, onError)
+}
+
+// WaitForDOMDocumentUpdated waits for DOM.documentUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMDocumentUpdated(ctx context.Context, predicate func(*protocol.DOMDocumentUpdatedEvent) bool, action func() error) (*protocol.DOMDocumentUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMDocumentUpdated, func() interface{} { return new(protocol.DOMDocumentUpdatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DOMDocumentUpdatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMDocumentUpdatedEvent), nil
+}
+
+// OnDOMInlineStyleInvalidated subscribe to DOM.inlineStyleInvalidated
+func (session *Session) OnDOMInlineStyleInvalidated(cb func(*protocol.DOMInlineStyleInvalidatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMInlineStyleInvalidated, func() interface{} { return new(protocol.DOMInlineStyleInvalidatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMInlineStyleInvalidatedEvent)) }, onError)
+}
+
+// WaitForDOMInlineStyleInvalidated waits for DOM.inlineStyleInvalidated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMInlineStyleInvalidated(ctx context.Context, predicate func(*protocol.DOMInlineStyleInvalidatedEvent) bool, action func() error) (*protocol.DOMInlineStyleInvalidatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMInlineStyleInvalidated, func() interface{} { return new(protocol.DOMInlineStyleInvalidatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMInlineStyleInvalidatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMInlineStyleInvalidatedEvent), nil
+}
+
+// OnDOMPseudoElementAdded subscribe to DOM.pseudoElementAdded
+func (session *Session) OnDOMPseudoElementAdded(cb func(*protocol.DOMPseudoElementAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMPseudoElementAdded, func() interface{} { return new(protocol.DOMPseudoElementAddedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMPseudoElementAddedEvent)) }, onError)
+}
+
+// WaitForDOMPseudoElementAdded waits for DOM.pseudoElementAdded matching predicate, see WaitForEvent
+func (session Session) WaitForDOMPseudoElementAdded(ctx context.Context, predicate func(*protocol.DOMPseudoElementAddedEvent) bool, action func() error) (*protocol.DOMPseudoElementAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMPseudoElementAdded, func() interface{} { return new(protocol.DOMPseudoElementAddedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMPseudoElementAddedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMPseudoElementAddedEvent), nil
+}
+
+// OnDOMTopLayerElementsUpdated subscribe to DOM.topLayerElementsUpdated
+func (session *Session) OnDOMTopLayerElementsUpdated(cb func(*protocol.DOMTopLayerElementsUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMTopLayerElementsUpdated, func() interface{} { return new(protocol.DOMTopLayerElementsUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMTopLayerElementsUpdatedEvent)) }, onError)
+}
+
+// WaitForDOMTopLayerElementsUpdated waits for DOM.topLayerElementsUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMTopLayerElementsUpdated(ctx context.Context, predicate func(*protocol.DOMTopLayerElementsUpdatedEvent) bool, action func() error) (*protocol.DOMTopLayerElementsUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMTopLayerElementsUpdated, func() interface{} { return new(protocol.DOMTopLayerElementsUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMTopLayerElementsUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMTopLayerElementsUpdatedEvent), nil
+}
+
+// OnDOMScrollableFlagUpdated subscribe to DOM.scrollableFlagUpdated
+func (session *Session) OnDOMScrollableFlagUpdated(cb func(*protocol.DOMScrollableFlagUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMScrollableFlagUpdated, func() interface{} { return new(protocol.DOMScrollableFlagUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMScrollableFlagUpdatedEvent)) }, onError)
+}
+
+// WaitForDOMScrollableFlagUpdated waits for DOM.scrollableFlagUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMScrollableFlagUpdated(ctx context.Context, predicate func(*protocol.DOMScrollableFlagUpdatedEvent) bool, action func() error) (*protocol.DOMScrollableFlagUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMScrollableFlagUpdated, func() interface{} { return new(protocol.DOMScrollableFlagUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMScrollableFlagUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMScrollableFlagUpdatedEvent), nil
+}
+
+// OnDOMPseudoElementRemoved subscribe to DOM.pseudoElementRemoved
+func (session *Session) OnDOMPseudoElementRemoved(cb func(*protocol.DOMPseudoElementRemovedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMPseudoElementRemoved, func() interface{} { return new(protocol.DOMPseudoElementRemovedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMPseudoElementRemovedEvent)) }, onError)
+}
+
+// WaitForDOMPseudoElementRemoved waits for DOM.pseudoElementRemoved matching predicate, see WaitForEvent
+func (session Session) WaitForDOMPseudoElementRemoved(ctx context.Context, predicate func(*protocol.DOMPseudoElementRemovedEvent) bool, action func() error) (*protocol.DOMPseudoElementRemovedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMPseudoElementRemoved, func() interface{} { return new(protocol.DOMPseudoElementRemovedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMPseudoElementRemovedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMPseudoElementRemovedEvent), nil
+}
+
+// OnDOMSetChildNodes subscribe to DOM.setChildNodes
+func (session *Session) OnDOMSetChildNodes(cb func(*protocol.DOMSetChildNodesEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMSetChildNodes, func() interface{} { return new(protocol.DOMSetChildNodesEvent) }, func(v interface{}) { cb(v.(*protocol.DOMSetChildNodesEvent)) }, onError)
+}
+
+// WaitForDOMSetChildNodes waits for DOM.setChildNodes matching predicate, see WaitForEvent
+func (session Session) WaitForDOMSetChildNodes(ctx context.Context, predicate func(*protocol.DOMSetChildNodesEvent) bool, action func() error) (*protocol.DOMSetChildNodesEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMSetChildNodes, func() interface{} { return new(protocol.DOMSetChildNodesEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DOMSetChildNodesEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMSetChildNodesEvent), nil
+}
+
+// OnDOMShadowRootPopped subscribe to DOM.shadowRootPopped
+func (session *Session) OnDOMShadowRootPopped(cb func(*protocol.DOMShadowRootPoppedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMShadowRootPopped, func() interface{} { return new(protocol.DOMShadowRootPoppedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMShadowRootPoppedEvent)) }, onError)
+}
+
+// WaitForDOMShadowRootPopped waits for DOM.shadowRootPopped matching predicate, see WaitForEvent
+func (session Session) WaitForDOMShadowRootPopped(ctx context.Context, predicate func(*protocol.DOMShadowRootPoppedEvent) bool, action func() error) (*protocol.DOMShadowRootPoppedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMShadowRootPopped, func() interface{} { return new(protocol.DOMShadowRootPoppedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DOMShadowRootPoppedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMShadowRootPoppedEvent), nil
+}
+
+// OnDOMShadowRootPushed subscribe to DOM.shadowRootPushed
+func (session *Session) OnDOMShadowRootPushed(cb func(*protocol.DOMShadowRootPushedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMShadowRootPushed, func() interface{} { return new(protocol.DOMShadowRootPushedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMShadowRootPushedEvent)) }, onError)
+}
+
+// WaitForDOMShadowRootPushed waits for DOM.shadowRootPushed matching predicate, see WaitForEvent
+func (session Session) WaitForDOMShadowRootPushed(ctx context.Context, predicate func(*protocol.DOMShadowRootPushedEvent) bool, action func() error) (*protocol.DOMShadowRootPushedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMShadowRootPushed, func() interface{} { return new(protocol.DOMShadowRootPushedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DOMShadowRootPushedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMShadowRootPushedEvent), nil
+}
+
+// OnDOMStorageDOMStorageItemAdded subscribe to DOMStorage.domStorageItemAdded
+func (session *Session) OnDOMStorageDOMStorageItemAdded(cb func(*protocol.DOMStorageDOMStorageItemAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMStorageDOMStorageItemAdded, func() interface{} { return new(protocol.DOMStorageDOMStorageItemAddedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMStorageDOMStorageItemAddedEvent)) }, onError)
+}
+
+// WaitForDOMStorageDOMStorageItemAdded waits for DOMStorage.domStorageItemAdded matching predicate, see WaitForEvent
+func (session Session) WaitForDOMStorageDOMStorageItemAdded(ctx context.Context, predicate func(*protocol.DOMStorageDOMStorageItemAddedEvent) bool, action func() error) (*protocol.DOMStorageDOMStorageItemAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMStorageDOMStorageItemAdded, func() interface{} { return new(protocol.DOMStorageDOMStorageItemAddedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMStorageDOMStorageItemAddedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMStorageDOMStorageItemAddedEvent), nil
+}
+
+// OnDOMStorageDOMStorageItemRemoved subscribe to DOMStorage.domStorageItemRemoved
+func (session *Session) OnDOMStorageDOMStorageItemRemoved(cb func(*protocol.DOMStorageDOMStorageItemRemovedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMStorageDOMStorageItemRemoved, func() interface{} { return new(protocol.DOMStorageDOMStorageItemRemovedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMStorageDOMStorageItemRemovedEvent)) }, onError)
+}
+
+// WaitForDOMStorageDOMStorageItemRemoved waits for DOMStorage.domStorageItemRemoved matching predicate, see WaitForEvent
+func (session Session) WaitForDOMStorageDOMStorageItemRemoved(ctx context.Context, predicate func(*protocol.DOMStorageDOMStorageItemRemovedEvent) bool, action func() error) (*protocol.DOMStorageDOMStorageItemRemovedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMStorageDOMStorageItemRemoved, func() interface{} { return new(protocol.DOMStorageDOMStorageItemRemovedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMStorageDOMStorageItemRemovedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMStorageDOMStorageItemRemovedEvent), nil
+}
+
+// OnDOMStorageDOMStorageItemUpdated subscribe to DOMStorage.domStorageItemUpdated
+func (session *Session) OnDOMStorageDOMStorageItemUpdated(cb func(*protocol.DOMStorageDOMStorageItemUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMStorageDOMStorageItemUpdated, func() interface{} { return new(protocol.DOMStorageDOMStorageItemUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMStorageDOMStorageItemUpdatedEvent)) }, onError)
+}
+
+// WaitForDOMStorageDOMStorageItemUpdated waits for DOMStorage.domStorageItemUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForDOMStorageDOMStorageItemUpdated(ctx context.Context, predicate func(*protocol.DOMStorageDOMStorageItemUpdatedEvent) bool, action func() error) (*protocol.DOMStorageDOMStorageItemUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMStorageDOMStorageItemUpdated, func() interface{} { return new(protocol.DOMStorageDOMStorageItemUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMStorageDOMStorageItemUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMStorageDOMStorageItemUpdatedEvent), nil
+}
+
+// OnDOMStorageDOMStorageItemsCleared subscribe to DOMStorage.domStorageItemsCleared
+func (session *Session) OnDOMStorageDOMStorageItemsCleared(cb func(*protocol.DOMStorageDOMStorageItemsClearedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDOMStorageDOMStorageItemsCleared, func() interface{} { return new(protocol.DOMStorageDOMStorageItemsClearedEvent) }, func(v interface{}) { cb(v.(*protocol.DOMStorageDOMStorageItemsClearedEvent)) }, onError)
+}
+
+// WaitForDOMStorageDOMStorageItemsCleared waits for DOMStorage.domStorageItemsCleared matching predicate, see WaitForEvent
+func (session Session) WaitForDOMStorageDOMStorageItemsCleared(ctx context.Context, predicate func(*protocol.DOMStorageDOMStorageItemsClearedEvent) bool, action func() error) (*protocol.DOMStorageDOMStorageItemsClearedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDOMStorageDOMStorageItemsCleared, func() interface{} { return new(protocol.DOMStorageDOMStorageItemsClearedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DOMStorageDOMStorageItemsClearedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DOMStorageDOMStorageItemsClearedEvent), nil
+}
+
+// OnDebuggerBreakpointResolved subscribe to Debugger.breakpointResolved
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnDebuggerBreakpointResolved(cb func(*protocol.DebuggerBreakpointResolvedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDebuggerBreakpointResolved, func() interface{} { return new(protocol.DebuggerBreakpointResolvedEvent) }, func(v interface{}) { cb(v.(*protocol.DebuggerBreakpointResolvedEvent)) }, onError)
+}
+
+// WaitForDebuggerBreakpointResolved waits for Debugger.breakpointResolved matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForDebuggerBreakpointResolved(ctx context.Context, predicate func(*protocol.DebuggerBreakpointResolvedEvent) bool, action func() error) (*protocol.DebuggerBreakpointResolvedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDebuggerBreakpointResolved, func() interface{} { return new(protocol.DebuggerBreakpointResolvedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DebuggerBreakpointResolvedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DebuggerBreakpointResolvedEvent), nil
+}
+
+// OnDebuggerPaused subscribe to Debugger.paused
+func (session *Session) OnDebuggerPaused(cb func(*protocol.DebuggerPausedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDebuggerPaused, func() interface{} { return new(protocol.DebuggerPausedEvent) }, func(v interface{}) { cb(v.(*protocol.DebuggerPausedEvent)) }, onError)
+}
+
+// WaitForDebuggerPaused waits for Debugger.paused matching predicate, see WaitForEvent
+func (session Session) WaitForDebuggerPaused(ctx context.Context, predicate func(*protocol.DebuggerPausedEvent) bool, action func() error) (*protocol.DebuggerPausedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDebuggerPaused, func() interface{} { return new(protocol.DebuggerPausedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DebuggerPausedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DebuggerPausedEvent), nil
+}
+
+// OnDebuggerResumed subscribe to Debugger.resumed
+func (session *Session) OnDebuggerResumed(cb func(*protocol.DebuggerResumedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDebuggerResumed, func() interface{} { return new(protocol.DebuggerResumedEvent) }, func(v interface{}) { cb(v.(*protocol.DebuggerResumedEvent)) }, onError)
+}
+
+// WaitForDebuggerResumed waits for Debugger.resumed matching predicate, see WaitForEvent
+func (session Session) WaitForDebuggerResumed(ctx context.Context, predicate func(*protocol.DebuggerResumedEvent) bool, action func() error) (*protocol.DebuggerResumedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDebuggerResumed, func() interface{} { return new(protocol.DebuggerResumedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.DebuggerResumedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DebuggerResumedEvent), nil
+}
+
+// OnDebuggerScriptFailedToParse subscribe to Debugger.scriptFailedToParse
+func (session *Session) OnDebuggerScriptFailedToParse(cb func(*protocol.DebuggerScriptFailedToParseEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDebuggerScriptFailedToParse, func() interface{} { return new(protocol.DebuggerScriptFailedToParseEvent) }, func(v interface{}) { cb(v.(*protocol.DebuggerScriptFailedToParseEvent)) }, onError)
+}
+
+// WaitForDebuggerScriptFailedToParse waits for Debugger.scriptFailedToParse matching predicate, see WaitForEvent
+func (session Session) WaitForDebuggerScriptFailedToParse(ctx context.Context, predicate func(*protocol.DebuggerScriptFailedToParseEvent) bool, action func() error) (*protocol.DebuggerScriptFailedToParseEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDebuggerScriptFailedToParse, func() interface{} { return new(protocol.DebuggerScriptFailedToParseEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DebuggerScriptFailedToParseEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DebuggerScriptFailedToParseEvent), nil
+}
+
+// OnDebuggerScriptParsed subscribe to Debugger.scriptParsed
+func (session *Session) OnDebuggerScriptParsed(cb func(*protocol.DebuggerScriptParsedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDebuggerScriptParsed, func() interface{} { return new(protocol.DebuggerScriptParsedEvent) }, func(v interface{}) { cb(v.(*protocol.DebuggerScriptParsedEvent)) }, onError)
+}
+
+// WaitForDebuggerScriptParsed waits for Debugger.scriptParsed matching predicate, see WaitForEvent
+func (session Session) WaitForDebuggerScriptParsed(ctx context.Context, predicate func(*protocol.DebuggerScriptParsedEvent) bool, action func() error) (*protocol.DebuggerScriptParsedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDebuggerScriptParsed, func() interface{} { return new(protocol.DebuggerScriptParsedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DebuggerScriptParsedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DebuggerScriptParsedEvent), nil
+}
+
+// OnDeviceAccessDeviceRequestPrompted subscribe to DeviceAccess.deviceRequestPrompted
+func (session *Session) OnDeviceAccessDeviceRequestPrompted(cb func(*protocol.DeviceAccessDeviceRequestPromptedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventDeviceAccessDeviceRequestPrompted, func() interface{} { return new(protocol.DeviceAccessDeviceRequestPromptedEvent) }, func(v interface{}) { cb(v.(*protocol.DeviceAccessDeviceRequestPromptedEvent)) }, onError)
+}
+
+// WaitForDeviceAccessDeviceRequestPrompted waits for DeviceAccess.deviceRequestPrompted matching predicate, see WaitForEvent
+func (session Session) WaitForDeviceAccessDeviceRequestPrompted(ctx context.Context, predicate func(*protocol.DeviceAccessDeviceRequestPromptedEvent) bool, action func() error) (*protocol.DeviceAccessDeviceRequestPromptedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventDeviceAccessDeviceRequestPrompted, func() interface{} { return new(protocol.DeviceAccessDeviceRequestPromptedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.DeviceAccessDeviceRequestPromptedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.DeviceAccessDeviceRequestPromptedEvent), nil
+}
+
+// OnEmulationVirtualTimeBudgetExpired subscribe to Emulation.virtualTimeBudgetExpired
+func (session *Session) OnEmulationVirtualTimeBudgetExpired(cb func(*protocol.EmulationVirtualTimeBudgetExpiredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventEmulationVirtualTimeBudgetExpired, func() interface{} { return new(protocol.EmulationVirtualTimeBudgetExpiredEvent) }, func(v interface{}) { cb(v.(*protocol.EmulationVirtualTimeBudgetExpiredEvent)) }, onError)
+}
+
+// WaitForEmulationVirtualTimeBudgetExpired waits for Emulation.virtualTimeBudgetExpired matching predicate, see WaitForEvent
+func (session Session) WaitForEmulationVirtualTimeBudgetExpired(ctx context.Context, predicate func(*protocol.EmulationVirtualTimeBudgetExpiredEvent) bool, action func() error) (*protocol.EmulationVirtualTimeBudgetExpiredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventEmulationVirtualTimeBudgetExpired, func() interface{} { return new(protocol.EmulationVirtualTimeBudgetExpiredEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.EmulationVirtualTimeBudgetExpiredEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.EmulationVirtualTimeBudgetExpiredEvent), nil
+}
+
+// OnFedCmDialogShown subscribe to FedCm.dialogShown
+func (session *Session) OnFedCmDialogShown(cb func(*protocol.FedCmDialogShownEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventFedCmDialogShown, func() interface{} { return new(protocol.FedCmDialogShownEvent) }, func(v interface{}) { cb(v.(*protocol.FedCmDialogShownEvent)) }, onError)
+}
+
+// WaitForFedCmDialogShown waits for FedCm.dialogShown matching predicate, see WaitForEvent
+func (session Session) WaitForFedCmDialogShown(ctx context.Context, predicate func(*protocol.FedCmDialogShownEvent) bool, action func() error) (*protocol.FedCmDialogShownEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventFedCmDialogShown, func() interface{} { return new(protocol.FedCmDialogShownEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.FedCmDialogShownEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.FedCmDialogShownEvent), nil
+}
+
+// OnFedCmDialogClosed subscribe to FedCm.dialogClosed
+func (session *Session) OnFedCmDialogClosed(cb func(*protocol.FedCmDialogClosedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventFedCmDialogClosed, func() interface{} { return new(protocol.FedCmDialogClosedEvent) }, func(v interface{}) { cb(v.(*protocol.FedCmDialogClosedEvent)) }, onError)
+}
+
+// WaitForFedCmDialogClosed waits for FedCm.dialogClosed matching predicate, see WaitForEvent
+func (session Session) WaitForFedCmDialogClosed(ctx context.Context, predicate func(*protocol.FedCmDialogClosedEvent) bool, action func() error) (*protocol.FedCmDialogClosedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventFedCmDialogClosed, func() interface{} { return new(protocol.FedCmDialogClosedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.FedCmDialogClosedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.FedCmDialogClosedEvent), nil
+}
+
+// OnFetchRequestPaused subscribe to Fetch.requestPaused
+func (session *Session) OnFetchRequestPaused(cb func(*protocol.FetchRequestPausedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventFetchRequestPaused, func() interface{} { return new(protocol.FetchRequestPausedEvent) }, func(v interface{}) { cb(v.(*protocol.FetchRequestPausedEvent)) }, onError)
+}
+
+// WaitForFetchRequestPaused waits for Fetch.requestPaused matching predicate, see WaitForEvent
+func (session Session) WaitForFetchRequestPaused(ctx context.Context, predicate func(*protocol.FetchRequestPausedEvent) bool, action func() error) (*protocol.FetchRequestPausedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventFetchRequestPaused, func() interface{} { return new(protocol.FetchRequestPausedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.FetchRequestPausedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.FetchRequestPausedEvent), nil
+}
+
+// OnFetchAuthRequired subscribe to Fetch.authRequired
+func (session *Session) OnFetchAuthRequired(cb func(*protocol.FetchAuthRequiredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventFetchAuthRequired, func() interface{} { return new(protocol.FetchAuthRequiredEvent) }, func(v interface{}) { cb(v.(*protocol.FetchAuthRequiredEvent)) }, onError)
+}
+
+// WaitForFetchAuthRequired waits for Fetch.authRequired matching predicate, see WaitForEvent
+func (session Session) WaitForFetchAuthRequired(ctx context.Context, predicate func(*protocol.FetchAuthRequiredEvent) bool, action func() error) (*protocol.FetchAuthRequiredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventFetchAuthRequired, func() interface{} { return new(protocol.FetchAuthRequiredEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.FetchAuthRequiredEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.FetchAuthRequiredEvent), nil
+}
+
+// OnHeapProfilerAddHeapSnapshotChunk subscribe to HeapProfiler.addHeapSnapshotChunk
+func (session *Session) OnHeapProfilerAddHeapSnapshotChunk(cb func(*protocol.HeapProfilerAddHeapSnapshotChunkEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventHeapProfilerAddHeapSnapshotChunk, func() interface{} { return new(protocol.HeapProfilerAddHeapSnapshotChunkEvent) }, func(v interface{}) { cb(v.(*protocol.HeapProfilerAddHeapSnapshotChunkEvent)) }, onError)
+}
+
+// WaitForHeapProfilerAddHeapSnapshotChunk waits for HeapProfiler.addHeapSnapshotChunk matching predicate, see WaitForEvent
+func (session Session) WaitForHeapProfilerAddHeapSnapshotChunk(ctx context.Context, predicate func(*protocol.HeapProfilerAddHeapSnapshotChunkEvent) bool, action func() error) (*protocol.HeapProfilerAddHeapSnapshotChunkEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventHeapProfilerAddHeapSnapshotChunk, func() interface{} { return new(protocol.HeapProfilerAddHeapSnapshotChunkEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.HeapProfilerAddHeapSnapshotChunkEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.HeapProfilerAddHeapSnapshotChunkEvent), nil
+}
+
+// OnHeapProfilerHeapStatsUpdate subscribe to HeapProfiler.heapStatsUpdate
+func (session *Session) OnHeapProfilerHeapStatsUpdate(cb func(*protocol.HeapProfilerHeapStatsUpdateEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventHeapProfilerHeapStatsUpdate, func() interface{} { return new(protocol.HeapProfilerHeapStatsUpdateEvent) }, func(v interface{}) { cb(v.(*protocol.HeapProfilerHeapStatsUpdateEvent)) }, onError)
+}
+
+// WaitForHeapProfilerHeapStatsUpdate waits for HeapProfiler.heapStatsUpdate matching predicate, see WaitForEvent
+func (session Session) WaitForHeapProfilerHeapStatsUpdate(ctx context.Context, predicate func(*protocol.HeapProfilerHeapStatsUpdateEvent) bool, action func() error) (*protocol.HeapProfilerHeapStatsUpdateEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventHeapProfilerHeapStatsUpdate, func() interface{} { return new(protocol.HeapProfilerHeapStatsUpdateEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.HeapProfilerHeapStatsUpdateEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.HeapProfilerHeapStatsUpdateEvent), nil
+}
+
+// OnHeapProfilerLastSeenObjectID subscribe to HeapProfiler.lastSeenObjectId
+func (session *Session) OnHeapProfilerLastSeenObjectID(cb func(*protocol.HeapProfilerLastSeenObjectIDEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventHeapProfilerLastSeenObjectID, func() interface{} { return new(protocol.HeapProfilerLastSeenObjectIDEvent) }, func(v interface{}) { cb(v.(*protocol.HeapProfilerLastSeenObjectIDEvent)) }, onError)
+}
+
+// WaitForHeapProfilerLastSeenObjectID waits for HeapProfiler.lastSeenObjectId matching predicate, see WaitForEvent
+func (session Session) WaitForHeapProfilerLastSeenObjectID(ctx context.Context, predicate func(*protocol.HeapProfilerLastSeenObjectIDEvent) bool, action func() error) (*protocol.HeapProfilerLastSeenObjectIDEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventHeapProfilerLastSeenObjectID, func() interface{} { return new(protocol.HeapProfilerLastSeenObjectIDEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.HeapProfilerLastSeenObjectIDEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.HeapProfilerLastSeenObjectIDEvent), nil
+}
+
+// OnHeapProfilerReportHeapSnapshotProgress subscribe to HeapProfiler.reportHeapSnapshotProgress
+func (session *Session) OnHeapProfilerReportHeapSnapshotProgress(cb func(*protocol.HeapProfilerReportHeapSnapshotProgressEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventHeapProfilerReportHeapSnapshotProgress, func() interface{} { return new(protocol.HeapProfilerReportHeapSnapshotProgressEvent) }, func(v interface{}) { cb(v.(*protocol.HeapProfilerReportHeapSnapshotProgressEvent)) }, onError)
+}
+
+// WaitForHeapProfilerReportHeapSnapshotProgress waits for HeapProfiler.reportHeapSnapshotProgress matching predicate, see WaitForEvent
+func (session Session) WaitForHeapProfilerReportHeapSnapshotProgress(ctx context.Context, predicate func(*protocol.HeapProfilerReportHeapSnapshotProgressEvent) bool, action func() error) (*protocol.HeapProfilerReportHeapSnapshotProgressEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventHeapProfilerReportHeapSnapshotProgress, func() interface{} { return new(protocol.HeapProfilerReportHeapSnapshotProgressEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.HeapProfilerReportHeapSnapshotProgressEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.HeapProfilerReportHeapSnapshotProgressEvent), nil
+}
+
+// OnHeapProfilerResetProfiles subscribe to HeapProfiler.resetProfiles
+func (session *Session) OnHeapProfilerResetProfiles(cb func(*protocol.HeapProfilerResetProfilesEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventHeapProfilerResetProfiles, func() interface{} { return new(protocol.HeapProfilerResetProfilesEvent) }, func(v interface{}) { cb(v.(*protocol.HeapProfilerResetProfilesEvent)) }, onError)
+}
+
+// WaitForHeapProfilerResetProfiles waits for HeapProfiler.resetProfiles matching predicate, see WaitForEvent
+func (session Session) WaitForHeapProfilerResetProfiles(ctx context.Context, predicate func(*protocol.HeapProfilerResetProfilesEvent) bool, action func() error) (*protocol.HeapProfilerResetProfilesEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventHeapProfilerResetProfiles, func() interface{} { return new(protocol.HeapProfilerResetProfilesEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.HeapProfilerResetProfilesEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.HeapProfilerResetProfilesEvent), nil
+}
+
+// OnInputDragIntercepted subscribe to Input.dragIntercepted
+func (session *Session) OnInputDragIntercepted(cb func(*protocol.InputDragInterceptedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventInputDragIntercepted, func() interface{} { return new(protocol.InputDragInterceptedEvent) }, func(v interface{}) { cb(v.(*protocol.InputDragInterceptedEvent)) }, onError)
+}
+
+// WaitForInputDragIntercepted waits for Input.dragIntercepted matching predicate, see WaitForEvent
+func (session Session) WaitForInputDragIntercepted(ctx context.Context, predicate func(*protocol.InputDragInterceptedEvent) bool, action func() error) (*protocol.InputDragInterceptedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventInputDragIntercepted, func() interface{} { return new(protocol.InputDragInterceptedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.InputDragInterceptedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.InputDragInterceptedEvent), nil
+}
+
+// OnInspectorDetached subscribe to Inspector.detached
+func (session *Session) OnInspectorDetached(cb func(*protocol.InspectorDetachedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventInspectorDetached, func() interface{} { return new(protocol.InspectorDetachedEvent) }, func(v interface{}) { cb(v.(*protocol.InspectorDetachedEvent)) }, onError)
+}
+
+// WaitForInspectorDetached waits for Inspector.detached matching predicate, see WaitForEvent
+func (session Session) WaitForInspectorDetached(ctx context.Context, predicate func(*protocol.InspectorDetachedEvent) bool, action func() error) (*protocol.InspectorDetachedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventInspectorDetached, func() interface{} { return new(protocol.InspectorDetachedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.InspectorDetachedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.InspectorDetachedEvent), nil
+}
+
+// OnInspectorTargetCrashed subscribe to Inspector.targetCrashed
+func (session *Session) OnInspectorTargetCrashed(cb func(*protocol.InspectorTargetCrashedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventInspectorTargetCrashed, func() interface{} { return new(protocol.InspectorTargetCrashedEvent) }, func(v interface{}) { cb(v.(*protocol.InspectorTargetCrashedEvent)) }, onError)
+}
+
+// WaitForInspectorTargetCrashed waits for Inspector.targetCrashed matching predicate, see WaitForEvent
+func (session Session) WaitForInspectorTargetCrashed(ctx context.Context, predicate func(*protocol.InspectorTargetCrashedEvent) bool, action func() error) (*protocol.InspectorTargetCrashedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventInspectorTargetCrashed, func() interface{} { return new(protocol.InspectorTargetCrashedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.InspectorTargetCrashedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.InspectorTargetCrashedEvent), nil
+}
+
+// OnInspectorTargetReloadedAfterCrash subscribe to Inspector.targetReloadedAfterCrash
+func (session *Session) OnInspectorTargetReloadedAfterCrash(cb func(*protocol.InspectorTargetReloadedAfterCrashEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventInspectorTargetReloadedAfterCrash, func() interface{} { return new(protocol.InspectorTargetReloadedAfterCrashEvent) }, func(v interface{}) { cb(v.(*protocol.InspectorTargetReloadedAfterCrashEvent)) }, onError)
+}
+
+// WaitForInspectorTargetReloadedAfterCrash waits for Inspector.targetReloadedAfterCrash matching predicate, see WaitForEvent
+func (session Session) WaitForInspectorTargetReloadedAfterCrash(ctx context.Context, predicate func(*protocol.InspectorTargetReloadedAfterCrashEvent) bool, action func() error) (*protocol.InspectorTargetReloadedAfterCrashEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventInspectorTargetReloadedAfterCrash, func() interface{} { return new(protocol.InspectorTargetReloadedAfterCrashEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.InspectorTargetReloadedAfterCrashEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.InspectorTargetReloadedAfterCrashEvent), nil
+}
+
+// OnLayerTreeLayerPainted subscribe to LayerTree.layerPainted
+func (session *Session) OnLayerTreeLayerPainted(cb func(*protocol.LayerTreeLayerPaintedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventLayerTreeLayerPainted, func() interface{} { return new(protocol.LayerTreeLayerPaintedEvent) }, func(v interface{}) { cb(v.(*protocol.LayerTreeLayerPaintedEvent)) }, onError)
+}
+
+// WaitForLayerTreeLayerPainted waits for LayerTree.layerPainted matching predicate, see WaitForEvent
+func (session Session) WaitForLayerTreeLayerPainted(ctx context.Context, predicate func(*protocol.LayerTreeLayerPaintedEvent) bool, action func() error) (*protocol.LayerTreeLayerPaintedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventLayerTreeLayerPainted, func() interface{} { return new(protocol.LayerTreeLayerPaintedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.LayerTreeLayerPaintedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.LayerTreeLayerPaintedEvent), nil
+}
+
+// OnLayerTreeLayerTreeDidChange subscribe to LayerTree.layerTreeDidChange
+func (session *Session) OnLayerTreeLayerTreeDidChange(cb func(*protocol.LayerTreeLayerTreeDidChangeEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventLayerTreeLayerTreeDidChange, func() interface{} { return new(protocol.LayerTreeLayerTreeDidChangeEvent) }, func(v interface{}) { cb(v.(*protocol.LayerTreeLayerTreeDidChangeEvent)) }, onError)
+}
+
+// WaitForLayerTreeLayerTreeDidChange waits for LayerTree.layerTreeDidChange matching predicate, see WaitForEvent
+func (session Session) WaitForLayerTreeLayerTreeDidChange(ctx context.Context, predicate func(*protocol.LayerTreeLayerTreeDidChangeEvent) bool, action func() error) (*protocol.LayerTreeLayerTreeDidChangeEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventLayerTreeLayerTreeDidChange, func() interface{} { return new(protocol.LayerTreeLayerTreeDidChangeEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.LayerTreeLayerTreeDidChangeEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.LayerTreeLayerTreeDidChangeEvent), nil
+}
+
+// OnLogEntryAdded subscribe to Log.entryAdded
+func (session *Session) OnLogEntryAdded(cb func(*protocol.LogEntryAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventLogEntryAdded, func() interface{} { return new(protocol.LogEntryAddedEvent) }, func(v interface{}) { cb(v.(*protocol.LogEntryAddedEvent)) }, onError)
+}
+
+// WaitForLogEntryAdded waits for Log.entryAdded matching predicate, see WaitForEvent
+func (session Session) WaitForLogEntryAdded(ctx context.Context, predicate func(*protocol.LogEntryAddedEvent) bool, action func() error) (*protocol.LogEntryAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventLogEntryAdded, func() interface{} { return new(protocol.LogEntryAddedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.LogEntryAddedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.LogEntryAddedEvent), nil
+}
+
+// OnMediaPlayerPropertiesChanged subscribe to Media.playerPropertiesChanged
+func (session *Session) OnMediaPlayerPropertiesChanged(cb func(*protocol.MediaPlayerPropertiesChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventMediaPlayerPropertiesChanged, func() interface{} { return new(protocol.MediaPlayerPropertiesChangedEvent) }, func(v interface{}) { cb(v.(*protocol.MediaPlayerPropertiesChangedEvent)) }, onError)
+}
+
+// WaitForMediaPlayerPropertiesChanged waits for Media.playerPropertiesChanged matching predicate, see WaitForEvent
+func (session Session) WaitForMediaPlayerPropertiesChanged(ctx context.Context, predicate func(*protocol.MediaPlayerPropertiesChangedEvent) bool, action func() error) (*protocol.MediaPlayerPropertiesChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventMediaPlayerPropertiesChanged, func() interface{} { return new(protocol.MediaPlayerPropertiesChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.MediaPlayerPropertiesChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.MediaPlayerPropertiesChangedEvent), nil
+}
+
+// OnMediaPlayerEventsAdded subscribe to Media.playerEventsAdded
+func (session *Session) OnMediaPlayerEventsAdded(cb func(*protocol.MediaPlayerEventsAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventMediaPlayerEventsAdded, func() interface{} { return new(protocol.MediaPlayerEventsAddedEvent) }, func(v interface{}) { cb(v.(*protocol.MediaPlayerEventsAddedEvent)) }, onError)
+}
+
+// WaitForMediaPlayerEventsAdded waits for Media.playerEventsAdded matching predicate, see WaitForEvent
+func (session Session) WaitForMediaPlayerEventsAdded(ctx context.Context, predicate func(*protocol.MediaPlayerEventsAddedEvent) bool, action func() error) (*protocol.MediaPlayerEventsAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventMediaPlayerEventsAdded, func() interface{} { return new(protocol.MediaPlayerEventsAddedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.MediaPlayerEventsAddedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.MediaPlayerEventsAddedEvent), nil
+}
+
+// OnMediaPlayerMessagesLogged subscribe to Media.playerMessagesLogged
+func (session *Session) OnMediaPlayerMessagesLogged(cb func(*protocol.MediaPlayerMessagesLoggedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventMediaPlayerMessagesLogged, func() interface{} { return new(protocol.MediaPlayerMessagesLoggedEvent) }, func(v interface{}) { cb(v.(*protocol.MediaPlayerMessagesLoggedEvent)) }, onError)
+}
+
+// WaitForMediaPlayerMessagesLogged waits for Media.playerMessagesLogged matching predicate, see WaitForEvent
+func (session Session) WaitForMediaPlayerMessagesLogged(ctx context.Context, predicate func(*protocol.MediaPlayerMessagesLoggedEvent) bool, action func() error) (*protocol.MediaPlayerMessagesLoggedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventMediaPlayerMessagesLogged, func() interface{} { return new(protocol.MediaPlayerMessagesLoggedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.MediaPlayerMessagesLoggedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.MediaPlayerMessagesLoggedEvent), nil
+}
+
+// OnMediaPlayerErrorsRaised subscribe to Media.playerErrorsRaised
+func (session *Session) OnMediaPlayerErrorsRaised(cb func(*protocol.MediaPlayerErrorsRaisedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventMediaPlayerErrorsRaised, func() interface{} { return new(protocol.MediaPlayerErrorsRaisedEvent) }, func(v interface{}) { cb(v.(*protocol.MediaPlayerErrorsRaisedEvent)) }, onError)
+}
+
+// WaitForMediaPlayerErrorsRaised waits for Media.playerErrorsRaised matching predicate, see WaitForEvent
+func (session Session) WaitForMediaPlayerErrorsRaised(ctx context.Context, predicate func(*protocol.MediaPlayerErrorsRaisedEvent) bool, action func() error) (*protocol.MediaPlayerErrorsRaisedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventMediaPlayerErrorsRaised, func() interface{} { return new(protocol.MediaPlayerErrorsRaisedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.MediaPlayerErrorsRaisedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.MediaPlayerErrorsRaisedEvent), nil
+}
+
+// OnMediaPlayersCreated subscribe to Media.playersCreated
+func (session *Session) OnMediaPlayersCreated(cb func(*protocol.MediaPlayersCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventMediaPlayersCreated, func() interface{} { return new(protocol.MediaPlayersCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.MediaPlayersCreatedEvent)) }, onError)
+}
+
+// WaitForMediaPlayersCreated waits for Media.playersCreated matching predicate, see WaitForEvent
+func (session Session) WaitForMediaPlayersCreated(ctx context.Context, predicate func(*protocol.MediaPlayersCreatedEvent) bool, action func() error) (*protocol.MediaPlayersCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventMediaPlayersCreated, func() interface{} { return new(protocol.MediaPlayersCreatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.MediaPlayersCreatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.MediaPlayersCreatedEvent), nil
+}
+
+// OnNetworkDataReceived subscribe to Network.dataReceived
+func (session *Session) OnNetworkDataReceived(cb func(*protocol.NetworkDataReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDataReceived, func() interface{} { return new(protocol.NetworkDataReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDataReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkDataReceived waits for Network.dataReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDataReceived(ctx context.Context, predicate func(*protocol.NetworkDataReceivedEvent) bool, action func() error) (*protocol.NetworkDataReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDataReceived, func() interface{} { return new(protocol.NetworkDataReceivedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.NetworkDataReceivedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDataReceivedEvent), nil
+}
+
+// OnNetworkEventSourceMessageReceived subscribe to Network.eventSourceMessageReceived
+func (session *Session) OnNetworkEventSourceMessageReceived(cb func(*protocol.NetworkEventSourceMessageReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkEventSourceMessageReceived, func() interface{} { return new(protocol.NetworkEventSourceMessageReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkEventSourceMessageReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkEventSourceMessageReceived waits for Network.eventSourceMessageReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkEventSourceMessageReceived(ctx context.Context, predicate func(*protocol.NetworkEventSourceMessageReceivedEvent) bool, action func() error) (*protocol.NetworkEventSourceMessageReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkEventSourceMessageReceived, func() interface{} { return new(protocol.NetworkEventSourceMessageReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkEventSourceMessageReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkEventSourceMessageReceivedEvent), nil
+}
+
+// OnNetworkLoadingFailed subscribe to Network.loadingFailed
+func (session *Session) OnNetworkLoadingFailed(cb func(*protocol.NetworkLoadingFailedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkLoadingFailed, func() interface{} { return new(protocol.NetworkLoadingFailedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkLoadingFailedEvent)) }, onError)
+}
+
+// WaitForNetworkLoadingFailed waits for Network.loadingFailed matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkLoadingFailed(ctx context.Context, predicate func(*protocol.NetworkLoadingFailedEvent) bool, action func() error) (*protocol.NetworkLoadingFailedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkLoadingFailed, func() interface{} { return new(protocol.NetworkLoadingFailedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkLoadingFailedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkLoadingFailedEvent), nil
+}
+
+// OnNetworkLoadingFinished subscribe to Network.loadingFinished
+func (session *Session) OnNetworkLoadingFinished(cb func(*protocol.NetworkLoadingFinishedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkLoadingFinished, func() interface{} { return new(protocol.NetworkLoadingFinishedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkLoadingFinishedEvent)) }, onError)
+}
+
+// WaitForNetworkLoadingFinished waits for Network.loadingFinished matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkLoadingFinished(ctx context.Context, predicate func(*protocol.NetworkLoadingFinishedEvent) bool, action func() error) (*protocol.NetworkLoadingFinishedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkLoadingFinished, func() interface{} { return new(protocol.NetworkLoadingFinishedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkLoadingFinishedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkLoadingFinishedEvent), nil
+}
+
+// OnNetworkRequestIntercepted subscribe to Network.requestIntercepted
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnNetworkRequestIntercepted(cb func(*protocol.NetworkRequestInterceptedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkRequestIntercepted, func() interface{} { return new(protocol.NetworkRequestInterceptedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkRequestInterceptedEvent)) }, onError)
+}
+
+// WaitForNetworkRequestIntercepted waits for Network.requestIntercepted matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForNetworkRequestIntercepted(ctx context.Context, predicate func(*protocol.NetworkRequestInterceptedEvent) bool, action func() error) (*protocol.NetworkRequestInterceptedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkRequestIntercepted, func() interface{} { return new(protocol.NetworkRequestInterceptedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkRequestInterceptedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkRequestInterceptedEvent), nil
+}
+
+// OnNetworkRequestServedFromCache subscribe to Network.requestServedFromCache
+func (session *Session) OnNetworkRequestServedFromCache(cb func(*protocol.NetworkRequestServedFromCacheEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkRequestServedFromCache, func() interface{} { return new(protocol.NetworkRequestServedFromCacheEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkRequestServedFromCacheEvent)) }, onError)
+}
+
+// WaitForNetworkRequestServedFromCache waits for Network.requestServedFromCache matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkRequestServedFromCache(ctx context.Context, predicate func(*protocol.NetworkRequestServedFromCacheEvent) bool, action func() error) (*protocol.NetworkRequestServedFromCacheEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkRequestServedFromCache, func() interface{} { return new(protocol.NetworkRequestServedFromCacheEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkRequestServedFromCacheEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkRequestServedFromCacheEvent), nil
+}
+
+// OnNetworkRequestWillBeSent subscribe to Network.requestWillBeSent
+func (session *Session) OnNetworkRequestWillBeSent(cb func(*protocol.NetworkRequestWillBeSentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkRequestWillBeSent, func() interface{} { return new(protocol.NetworkRequestWillBeSentEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkRequestWillBeSentEvent)) }, onError)
+}
+
+// WaitForNetworkRequestWillBeSent waits for Network.requestWillBeSent matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkRequestWillBeSent(ctx context.Context, predicate func(*protocol.NetworkRequestWillBeSentEvent) bool, action func() error) (*protocol.NetworkRequestWillBeSentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkRequestWillBeSent, func() interface{} { return new(protocol.NetworkRequestWillBeSentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkRequestWillBeSentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkRequestWillBeSentEvent), nil
+}
+
+// OnNetworkResourceChangedPriority subscribe to Network.resourceChangedPriority
+func (session *Session) OnNetworkResourceChangedPriority(cb func(*protocol.NetworkResourceChangedPriorityEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkResourceChangedPriority, func() interface{} { return new(protocol.NetworkResourceChangedPriorityEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkResourceChangedPriorityEvent)) }, onError)
+}
+
+// WaitForNetworkResourceChangedPriority waits for Network.resourceChangedPriority matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkResourceChangedPriority(ctx context.Context, predicate func(*protocol.NetworkResourceChangedPriorityEvent) bool, action func() error) (*protocol.NetworkResourceChangedPriorityEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkResourceChangedPriority, func() interface{} { return new(protocol.NetworkResourceChangedPriorityEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkResourceChangedPriorityEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkResourceChangedPriorityEvent), nil
+}
+
+// OnNetworkSignedExchangeReceived subscribe to Network.signedExchangeReceived
+func (session *Session) OnNetworkSignedExchangeReceived(cb func(*protocol.NetworkSignedExchangeReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkSignedExchangeReceived, func() interface{} { return new(protocol.NetworkSignedExchangeReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkSignedExchangeReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkSignedExchangeReceived waits for Network.signedExchangeReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkSignedExchangeReceived(ctx context.Context, predicate func(*protocol.NetworkSignedExchangeReceivedEvent) bool, action func() error) (*protocol.NetworkSignedExchangeReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkSignedExchangeReceived, func() interface{} { return new(protocol.NetworkSignedExchangeReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkSignedExchangeReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkSignedExchangeReceivedEvent), nil
+}
+
+// OnNetworkResponseReceived subscribe to Network.responseReceived
+func (session *Session) OnNetworkResponseReceived(cb func(*protocol.NetworkResponseReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkResponseReceived, func() interface{} { return new(protocol.NetworkResponseReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkResponseReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkResponseReceived waits for Network.responseReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkResponseReceived(ctx context.Context, predicate func(*protocol.NetworkResponseReceivedEvent) bool, action func() error) (*protocol.NetworkResponseReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkResponseReceived, func() interface{} { return new(protocol.NetworkResponseReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkResponseReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkResponseReceivedEvent), nil
+}
+
+// OnNetworkWebSocketClosed subscribe to Network.webSocketClosed
+func (session *Session) OnNetworkWebSocketClosed(cb func(*protocol.NetworkWebSocketClosedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketClosed, func() interface{} { return new(protocol.NetworkWebSocketClosedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketClosedEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketClosed waits for Network.webSocketClosed matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketClosed(ctx context.Context, predicate func(*protocol.NetworkWebSocketClosedEvent) bool, action func() error) (*protocol.NetworkWebSocketClosedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketClosed, func() interface{} { return new(protocol.NetworkWebSocketClosedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketClosedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketClosedEvent), nil
+}
+
+// OnNetworkWebSocketCreated subscribe to Network.webSocketCreated
+func (session *Session) OnNetworkWebSocketCreated(cb func(*protocol.NetworkWebSocketCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketCreated, func() interface{} { return new(protocol.NetworkWebSocketCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketCreatedEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketCreated waits for Network.webSocketCreated matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketCreated(ctx context.Context, predicate func(*protocol.NetworkWebSocketCreatedEvent) bool, action func() error) (*protocol.NetworkWebSocketCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketCreated, func() interface{} { return new(protocol.NetworkWebSocketCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketCreatedEvent), nil
+}
+
+// OnNetworkWebSocketFrameError subscribe to Network.webSocketFrameError
+func (session *Session) OnNetworkWebSocketFrameError(cb func(*protocol.NetworkWebSocketFrameErrorEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketFrameError, func() interface{} { return new(protocol.NetworkWebSocketFrameErrorEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketFrameErrorEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketFrameError waits for Network.webSocketFrameError matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketFrameError(ctx context.Context, predicate func(*protocol.NetworkWebSocketFrameErrorEvent) bool, action func() error) (*protocol.NetworkWebSocketFrameErrorEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketFrameError, func() interface{} { return new(protocol.NetworkWebSocketFrameErrorEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketFrameErrorEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketFrameErrorEvent), nil
+}
+
+// OnNetworkWebSocketFrameReceived subscribe to Network.webSocketFrameReceived
+func (session *Session) OnNetworkWebSocketFrameReceived(cb func(*protocol.NetworkWebSocketFrameReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketFrameReceived, func() interface{} { return new(protocol.NetworkWebSocketFrameReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketFrameReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketFrameReceived waits for Network.webSocketFrameReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketFrameReceived(ctx context.Context, predicate func(*protocol.NetworkWebSocketFrameReceivedEvent) bool, action func() error) (*protocol.NetworkWebSocketFrameReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketFrameReceived, func() interface{} { return new(protocol.NetworkWebSocketFrameReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketFrameReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketFrameReceivedEvent), nil
+}
+
+// OnNetworkWebSocketFrameSent subscribe to Network.webSocketFrameSent
+func (session *Session) OnNetworkWebSocketFrameSent(cb func(*protocol.NetworkWebSocketFrameSentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketFrameSent, func() interface{} { return new(protocol.NetworkWebSocketFrameSentEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketFrameSentEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketFrameSent waits for Network.webSocketFrameSent matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketFrameSent(ctx context.Context, predicate func(*protocol.NetworkWebSocketFrameSentEvent) bool, action func() error) (*protocol.NetworkWebSocketFrameSentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketFrameSent, func() interface{} { return new(protocol.NetworkWebSocketFrameSentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketFrameSentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketFrameSentEvent), nil
+}
+
+// OnNetworkWebSocketHandshakeResponseReceived subscribe to Network.webSocketHandshakeResponseReceived
+func (session *Session) OnNetworkWebSocketHandshakeResponseReceived(cb func(*protocol.NetworkWebSocketHandshakeResponseReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketHandshakeResponseReceived, func() interface{} { return new(protocol.NetworkWebSocketHandshakeResponseReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketHandshakeResponseReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketHandshakeResponseReceived waits for Network.webSocketHandshakeResponseReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketHandshakeResponseReceived(ctx context.Context, predicate func(*protocol.NetworkWebSocketHandshakeResponseReceivedEvent) bool, action func() error) (*protocol.NetworkWebSocketHandshakeResponseReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketHandshakeResponseReceived, func() interface{} { return new(protocol.NetworkWebSocketHandshakeResponseReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketHandshakeResponseReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketHandshakeResponseReceivedEvent), nil
+}
+
+// OnNetworkWebSocketWillSendHandshakeRequest subscribe to Network.webSocketWillSendHandshakeRequest
+func (session *Session) OnNetworkWebSocketWillSendHandshakeRequest(cb func(*protocol.NetworkWebSocketWillSendHandshakeRequestEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebSocketWillSendHandshakeRequest, func() interface{} { return new(protocol.NetworkWebSocketWillSendHandshakeRequestEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebSocketWillSendHandshakeRequestEvent)) }, onError)
+}
+
+// WaitForNetworkWebSocketWillSendHandshakeRequest waits for Network.webSocketWillSendHandshakeRequest matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebSocketWillSendHandshakeRequest(ctx context.Context, predicate func(*protocol.NetworkWebSocketWillSendHandshakeRequestEvent) bool, action func() error) (*protocol.NetworkWebSocketWillSendHandshakeRequestEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebSocketWillSendHandshakeRequest, func() interface{} { return new(protocol.NetworkWebSocketWillSendHandshakeRequestEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebSocketWillSendHandshakeRequestEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebSocketWillSendHandshakeRequestEvent), nil
+}
+
+// OnNetworkWebTransportCreated subscribe to Network.webTransportCreated
+func (session *Session) OnNetworkWebTransportCreated(cb func(*protocol.NetworkWebTransportCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebTransportCreated, func() interface{} { return new(protocol.NetworkWebTransportCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebTransportCreatedEvent)) }, onError)
+}
+
+// WaitForNetworkWebTransportCreated waits for Network.webTransportCreated matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebTransportCreated(ctx context.Context, predicate func(*protocol.NetworkWebTransportCreatedEvent) bool, action func() error) (*protocol.NetworkWebTransportCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebTransportCreated, func() interface{} { return new(protocol.NetworkWebTransportCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebTransportCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebTransportCreatedEvent), nil
+}
+
+// OnNetworkWebTransportConnectionEstablished subscribe to Network.webTransportConnectionEstablished
+func (session *Session) OnNetworkWebTransportConnectionEstablished(cb func(*protocol.NetworkWebTransportConnectionEstablishedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebTransportConnectionEstablished, func() interface{} { return new(protocol.NetworkWebTransportConnectionEstablishedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebTransportConnectionEstablishedEvent)) }, onError)
+}
+
+// WaitForNetworkWebTransportConnectionEstablished waits for Network.webTransportConnectionEstablished matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebTransportConnectionEstablished(ctx context.Context, predicate func(*protocol.NetworkWebTransportConnectionEstablishedEvent) bool, action func() error) (*protocol.NetworkWebTransportConnectionEstablishedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebTransportConnectionEstablished, func() interface{} { return new(protocol.NetworkWebTransportConnectionEstablishedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebTransportConnectionEstablishedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebTransportConnectionEstablishedEvent), nil
+}
+
+// OnNetworkWebTransportClosed subscribe to Network.webTransportClosed
+func (session *Session) OnNetworkWebTransportClosed(cb func(*protocol.NetworkWebTransportClosedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkWebTransportClosed, func() interface{} { return new(protocol.NetworkWebTransportClosedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkWebTransportClosedEvent)) }, onError)
+}
+
+// WaitForNetworkWebTransportClosed waits for Network.webTransportClosed matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkWebTransportClosed(ctx context.Context, predicate func(*protocol.NetworkWebTransportClosedEvent) bool, action func() error) (*protocol.NetworkWebTransportClosedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkWebTransportClosed, func() interface{} { return new(protocol.NetworkWebTransportClosedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkWebTransportClosedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkWebTransportClosedEvent), nil
+}
+
+// OnNetworkDirectTCPSocketCreated subscribe to Network.directTCPSocketCreated
+func (session *Session) OnNetworkDirectTCPSocketCreated(cb func(*protocol.NetworkDirectTCPSocketCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectTCPSocketCreated, func() interface{} { return new(protocol.NetworkDirectTCPSocketCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectTCPSocketCreatedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectTCPSocketCreated waits for Network.directTCPSocketCreated matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectTCPSocketCreated(ctx context.Context, predicate func(*protocol.NetworkDirectTCPSocketCreatedEvent) bool, action func() error) (*protocol.NetworkDirectTCPSocketCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectTCPSocketCreated, func() interface{} { return new(protocol.NetworkDirectTCPSocketCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectTCPSocketCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectTCPSocketCreatedEvent), nil
+}
+
+// OnNetworkDirectTCPSocketOpened subscribe to Network.directTCPSocketOpened
+func (session *Session) OnNetworkDirectTCPSocketOpened(cb func(*protocol.NetworkDirectTCPSocketOpenedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectTCPSocketOpened, func() interface{} { return new(protocol.NetworkDirectTCPSocketOpenedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectTCPSocketOpenedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectTCPSocketOpened waits for Network.directTCPSocketOpened matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectTCPSocketOpened(ctx context.Context, predicate func(*protocol.NetworkDirectTCPSocketOpenedEvent) bool, action func() error) (*protocol.NetworkDirectTCPSocketOpenedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectTCPSocketOpened, func() interface{} { return new(protocol.NetworkDirectTCPSocketOpenedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectTCPSocketOpenedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectTCPSocketOpenedEvent), nil
+}
+
+// OnNetworkDirectTCPSocketAborted subscribe to Network.directTCPSocketAborted
+func (session *Session) OnNetworkDirectTCPSocketAborted(cb func(*protocol.NetworkDirectTCPSocketAbortedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectTCPSocketAborted, func() interface{} { return new(protocol.NetworkDirectTCPSocketAbortedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectTCPSocketAbortedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectTCPSocketAborted waits for Network.directTCPSocketAborted matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectTCPSocketAborted(ctx context.Context, predicate func(*protocol.NetworkDirectTCPSocketAbortedEvent) bool, action func() error) (*protocol.NetworkDirectTCPSocketAbortedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectTCPSocketAborted, func() interface{} { return new(protocol.NetworkDirectTCPSocketAbortedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectTCPSocketAbortedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectTCPSocketAbortedEvent), nil
+}
+
+// OnNetworkDirectTCPSocketClosed subscribe to Network.directTCPSocketClosed
+func (session *Session) OnNetworkDirectTCPSocketClosed(cb func(*protocol.NetworkDirectTCPSocketClosedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectTCPSocketClosed, func() interface{} { return new(protocol.NetworkDirectTCPSocketClosedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectTCPSocketClosedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectTCPSocketClosed waits for Network.directTCPSocketClosed matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectTCPSocketClosed(ctx context.Context, predicate func(*protocol.NetworkDirectTCPSocketClosedEvent) bool, action func() error) (*protocol.NetworkDirectTCPSocketClosedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectTCPSocketClosed, func() interface{} { return new(protocol.NetworkDirectTCPSocketClosedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectTCPSocketClosedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectTCPSocketClosedEvent), nil
+}
+
+// OnNetworkDirectTCPSocketChunkSent subscribe to Network.directTCPSocketChunkSent
+func (session *Session) OnNetworkDirectTCPSocketChunkSent(cb func(*protocol.NetworkDirectTCPSocketChunkSentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectTCPSocketChunkSent, func() interface{} { return new(protocol.NetworkDirectTCPSocketChunkSentEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectTCPSocketChunkSentEvent)) }, onError)
+}
+
+// WaitForNetworkDirectTCPSocketChunkSent waits for Network.directTCPSocketChunkSent matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectTCPSocketChunkSent(ctx context.Context, predicate func(*protocol.NetworkDirectTCPSocketChunkSentEvent) bool, action func() error) (*protocol.NetworkDirectTCPSocketChunkSentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectTCPSocketChunkSent, func() interface{} { return new(protocol.NetworkDirectTCPSocketChunkSentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectTCPSocketChunkSentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectTCPSocketChunkSentEvent), nil
+}
+
+// OnNetworkDirectTCPSocketChunkReceived subscribe to Network.directTCPSocketChunkReceived
+func (session *Session) OnNetworkDirectTCPSocketChunkReceived(cb func(*protocol.NetworkDirectTCPSocketChunkReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectTCPSocketChunkReceived, func() interface{} { return new(protocol.NetworkDirectTCPSocketChunkReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectTCPSocketChunkReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectTCPSocketChunkReceived waits for Network.directTCPSocketChunkReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectTCPSocketChunkReceived(ctx context.Context, predicate func(*protocol.NetworkDirectTCPSocketChunkReceivedEvent) bool, action func() error) (*protocol.NetworkDirectTCPSocketChunkReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectTCPSocketChunkReceived, func() interface{} { return new(protocol.NetworkDirectTCPSocketChunkReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectTCPSocketChunkReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectTCPSocketChunkReceivedEvent), nil
+}
+
+// OnNetworkDirectUDPSocketCreated subscribe to Network.directUDPSocketCreated
+func (session *Session) OnNetworkDirectUDPSocketCreated(cb func(*protocol.NetworkDirectUDPSocketCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectUDPSocketCreated, func() interface{} { return new(protocol.NetworkDirectUDPSocketCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectUDPSocketCreatedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectUDPSocketCreated waits for Network.directUDPSocketCreated matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectUDPSocketCreated(ctx context.Context, predicate func(*protocol.NetworkDirectUDPSocketCreatedEvent) bool, action func() error) (*protocol.NetworkDirectUDPSocketCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectUDPSocketCreated, func() interface{} { return new(protocol.NetworkDirectUDPSocketCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectUDPSocketCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectUDPSocketCreatedEvent), nil
+}
+
+// OnNetworkDirectUDPSocketOpened subscribe to Network.directUDPSocketOpened
+func (session *Session) OnNetworkDirectUDPSocketOpened(cb func(*protocol.NetworkDirectUDPSocketOpenedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectUDPSocketOpened, func() interface{} { return new(protocol.NetworkDirectUDPSocketOpenedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectUDPSocketOpenedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectUDPSocketOpened waits for Network.directUDPSocketOpened matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectUDPSocketOpened(ctx context.Context, predicate func(*protocol.NetworkDirectUDPSocketOpenedEvent) bool, action func() error) (*protocol.NetworkDirectUDPSocketOpenedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectUDPSocketOpened, func() interface{} { return new(protocol.NetworkDirectUDPSocketOpenedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectUDPSocketOpenedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectUDPSocketOpenedEvent), nil
+}
+
+// OnNetworkDirectUDPSocketAborted subscribe to Network.directUDPSocketAborted
+func (session *Session) OnNetworkDirectUDPSocketAborted(cb func(*protocol.NetworkDirectUDPSocketAbortedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectUDPSocketAborted, func() interface{} { return new(protocol.NetworkDirectUDPSocketAbortedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectUDPSocketAbortedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectUDPSocketAborted waits for Network.directUDPSocketAborted matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectUDPSocketAborted(ctx context.Context, predicate func(*protocol.NetworkDirectUDPSocketAbortedEvent) bool, action func() error) (*protocol.NetworkDirectUDPSocketAbortedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectUDPSocketAborted, func() interface{} { return new(protocol.NetworkDirectUDPSocketAbortedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectUDPSocketAbortedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectUDPSocketAbortedEvent), nil
+}
+
+// OnNetworkDirectUDPSocketClosed subscribe to Network.directUDPSocketClosed
+func (session *Session) OnNetworkDirectUDPSocketClosed(cb func(*protocol.NetworkDirectUDPSocketClosedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectUDPSocketClosed, func() interface{} { return new(protocol.NetworkDirectUDPSocketClosedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectUDPSocketClosedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectUDPSocketClosed waits for Network.directUDPSocketClosed matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectUDPSocketClosed(ctx context.Context, predicate func(*protocol.NetworkDirectUDPSocketClosedEvent) bool, action func() error) (*protocol.NetworkDirectUDPSocketClosedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectUDPSocketClosed, func() interface{} { return new(protocol.NetworkDirectUDPSocketClosedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectUDPSocketClosedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectUDPSocketClosedEvent), nil
+}
+
+// OnNetworkDirectUDPSocketChunkSent subscribe to Network.directUDPSocketChunkSent
+func (session *Session) OnNetworkDirectUDPSocketChunkSent(cb func(*protocol.NetworkDirectUDPSocketChunkSentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectUDPSocketChunkSent, func() interface{} { return new(protocol.NetworkDirectUDPSocketChunkSentEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectUDPSocketChunkSentEvent)) }, onError)
+}
+
+// WaitForNetworkDirectUDPSocketChunkSent waits for Network.directUDPSocketChunkSent matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectUDPSocketChunkSent(ctx context.Context, predicate func(*protocol.NetworkDirectUDPSocketChunkSentEvent) bool, action func() error) (*protocol.NetworkDirectUDPSocketChunkSentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectUDPSocketChunkSent, func() interface{} { return new(protocol.NetworkDirectUDPSocketChunkSentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectUDPSocketChunkSentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectUDPSocketChunkSentEvent), nil
+}
+
+// OnNetworkDirectUDPSocketChunkReceived subscribe to Network.directUDPSocketChunkReceived
+func (session *Session) OnNetworkDirectUDPSocketChunkReceived(cb func(*protocol.NetworkDirectUDPSocketChunkReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkDirectUDPSocketChunkReceived, func() interface{} { return new(protocol.NetworkDirectUDPSocketChunkReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkDirectUDPSocketChunkReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkDirectUDPSocketChunkReceived waits for Network.directUDPSocketChunkReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkDirectUDPSocketChunkReceived(ctx context.Context, predicate func(*protocol.NetworkDirectUDPSocketChunkReceivedEvent) bool, action func() error) (*protocol.NetworkDirectUDPSocketChunkReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkDirectUDPSocketChunkReceived, func() interface{} { return new(protocol.NetworkDirectUDPSocketChunkReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkDirectUDPSocketChunkReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkDirectUDPSocketChunkReceivedEvent), nil
+}
+
+// OnNetworkRequestWillBeSentExtraInfo subscribe to Network.requestWillBeSentExtraInfo
+func (session *Session) OnNetworkRequestWillBeSentExtraInfo(cb func(*protocol.NetworkRequestWillBeSentExtraInfoEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkRequestWillBeSentExtraInfo, func() interface{} { return new(protocol.NetworkRequestWillBeSentExtraInfoEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkRequestWillBeSentExtraInfoEvent)) }, onError)
+}
+
+// WaitForNetworkRequestWillBeSentExtraInfo waits for Network.requestWillBeSentExtraInfo matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkRequestWillBeSentExtraInfo(ctx context.Context, predicate func(*protocol.NetworkRequestWillBeSentExtraInfoEvent) bool, action func() error) (*protocol.NetworkRequestWillBeSentExtraInfoEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkRequestWillBeSentExtraInfo, func() interface{} { return new(protocol.NetworkRequestWillBeSentExtraInfoEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkRequestWillBeSentExtraInfoEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkRequestWillBeSentExtraInfoEvent), nil
+}
+
+// OnNetworkResponseReceivedExtraInfo subscribe to Network.responseReceivedExtraInfo
+func (session *Session) OnNetworkResponseReceivedExtraInfo(cb func(*protocol.NetworkResponseReceivedExtraInfoEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkResponseReceivedExtraInfo, func() interface{} { return new(protocol.NetworkResponseReceivedExtraInfoEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkResponseReceivedExtraInfoEvent)) }, onError)
+}
+
+// WaitForNetworkResponseReceivedExtraInfo waits for Network.responseReceivedExtraInfo matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkResponseReceivedExtraInfo(ctx context.Context, predicate func(*protocol.NetworkResponseReceivedExtraInfoEvent) bool, action func() error) (*protocol.NetworkResponseReceivedExtraInfoEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkResponseReceivedExtraInfo, func() interface{} { return new(protocol.NetworkResponseReceivedExtraInfoEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkResponseReceivedExtraInfoEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkResponseReceivedExtraInfoEvent), nil
+}
+
+// OnNetworkResponseReceivedEarlyHints subscribe to Network.responseReceivedEarlyHints
+func (session *Session) OnNetworkResponseReceivedEarlyHints(cb func(*protocol.NetworkResponseReceivedEarlyHintsEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkResponseReceivedEarlyHints, func() interface{} { return new(protocol.NetworkResponseReceivedEarlyHintsEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkResponseReceivedEarlyHintsEvent)) }, onError)
+}
+
+// WaitForNetworkResponseReceivedEarlyHints waits for Network.responseReceivedEarlyHints matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkResponseReceivedEarlyHints(ctx context.Context, predicate func(*protocol.NetworkResponseReceivedEarlyHintsEvent) bool, action func() error) (*protocol.NetworkResponseReceivedEarlyHintsEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkResponseReceivedEarlyHints, func() interface{} { return new(protocol.NetworkResponseReceivedEarlyHintsEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkResponseReceivedEarlyHintsEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkResponseReceivedEarlyHintsEvent), nil
+}
+
+// OnNetworkTrustTokenOperationDone subscribe to Network.trustTokenOperationDone
+func (session *Session) OnNetworkTrustTokenOperationDone(cb func(*protocol.NetworkTrustTokenOperationDoneEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkTrustTokenOperationDone, func() interface{} { return new(protocol.NetworkTrustTokenOperationDoneEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkTrustTokenOperationDoneEvent)) }, onError)
+}
+
+// WaitForNetworkTrustTokenOperationDone waits for Network.trustTokenOperationDone matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkTrustTokenOperationDone(ctx context.Context, predicate func(*protocol.NetworkTrustTokenOperationDoneEvent) bool, action func() error) (*protocol.NetworkTrustTokenOperationDoneEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkTrustTokenOperationDone, func() interface{} { return new(protocol.NetworkTrustTokenOperationDoneEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkTrustTokenOperationDoneEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkTrustTokenOperationDoneEvent), nil
+}
+
+// OnNetworkPolicyUpdated subscribe to Network.policyUpdated
+func (session *Session) OnNetworkPolicyUpdated(cb func(*protocol.NetworkPolicyUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkPolicyUpdated, func() interface{} { return new(protocol.NetworkPolicyUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkPolicyUpdatedEvent)) }, onError)
+}
+
+// WaitForNetworkPolicyUpdated waits for Network.policyUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkPolicyUpdated(ctx context.Context, predicate func(*protocol.NetworkPolicyUpdatedEvent) bool, action func() error) (*protocol.NetworkPolicyUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkPolicyUpdated, func() interface{} { return new(protocol.NetworkPolicyUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkPolicyUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkPolicyUpdatedEvent), nil
+}
+
+// OnNetworkSubresourceWebBundleMetadataReceived subscribe to Network.subresourceWebBundleMetadataReceived
+func (session *Session) OnNetworkSubresourceWebBundleMetadataReceived(cb func(*protocol.NetworkSubresourceWebBundleMetadataReceivedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkSubresourceWebBundleMetadataReceived, func() interface{} { return new(protocol.NetworkSubresourceWebBundleMetadataReceivedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkSubresourceWebBundleMetadataReceivedEvent)) }, onError)
+}
+
+// WaitForNetworkSubresourceWebBundleMetadataReceived waits for Network.subresourceWebBundleMetadataReceived matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkSubresourceWebBundleMetadataReceived(ctx context.Context, predicate func(*protocol.NetworkSubresourceWebBundleMetadataReceivedEvent) bool, action func() error) (*protocol.NetworkSubresourceWebBundleMetadataReceivedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkSubresourceWebBundleMetadataReceived, func() interface{} { return new(protocol.NetworkSubresourceWebBundleMetadataReceivedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkSubresourceWebBundleMetadataReceivedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkSubresourceWebBundleMetadataReceivedEvent), nil
+}
+
+// OnNetworkSubresourceWebBundleMetadataError subscribe to Network.subresourceWebBundleMetadataError
+func (session *Session) OnNetworkSubresourceWebBundleMetadataError(cb func(*protocol.NetworkSubresourceWebBundleMetadataErrorEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkSubresourceWebBundleMetadataError, func() interface{} { return new(protocol.NetworkSubresourceWebBundleMetadataErrorEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkSubresourceWebBundleMetadataErrorEvent)) }, onError)
+}
+
+// WaitForNetworkSubresourceWebBundleMetadataError waits for Network.subresourceWebBundleMetadataError matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkSubresourceWebBundleMetadataError(ctx context.Context, predicate func(*protocol.NetworkSubresourceWebBundleMetadataErrorEvent) bool, action func() error) (*protocol.NetworkSubresourceWebBundleMetadataErrorEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkSubresourceWebBundleMetadataError, func() interface{} { return new(protocol.NetworkSubresourceWebBundleMetadataErrorEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkSubresourceWebBundleMetadataErrorEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkSubresourceWebBundleMetadataErrorEvent), nil
+}
+
+// OnNetworkSubresourceWebBundleInnerResponseParsed subscribe to Network.subresourceWebBundleInnerResponseParsed
+func (session *Session) OnNetworkSubresourceWebBundleInnerResponseParsed(cb func(*protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkSubresourceWebBundleInnerResponseParsed, func() interface{} { return new(protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent)) }, onError)
+}
+
+// WaitForNetworkSubresourceWebBundleInnerResponseParsed waits for Network.subresourceWebBundleInnerResponseParsed matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkSubresourceWebBundleInnerResponseParsed(ctx context.Context, predicate func(*protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent) bool, action func() error) (*protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkSubresourceWebBundleInnerResponseParsed, func() interface{} { return new(protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkSubresourceWebBundleInnerResponseParsedEvent), nil
+}
+
+// OnNetworkSubresourceWebBundleInnerResponseError subscribe to Network.subresourceWebBundleInnerResponseError
+func (session *Session) OnNetworkSubresourceWebBundleInnerResponseError(cb func(*protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkSubresourceWebBundleInnerResponseError, func() interface{} { return new(protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent)) }, onError)
+}
+
+// WaitForNetworkSubresourceWebBundleInnerResponseError waits for Network.subresourceWebBundleInnerResponseError matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkSubresourceWebBundleInnerResponseError(ctx context.Context, predicate func(*protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent) bool, action func() error) (*protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkSubresourceWebBundleInnerResponseError, func() interface{} { return new(protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkSubresourceWebBundleInnerResponseErrorEvent), nil
+}
+
+// OnNetworkReportingAPIReportAdded subscribe to Network.reportingApiReportAdded
+func (session *Session) OnNetworkReportingAPIReportAdded(cb func(*protocol.NetworkReportingAPIReportAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkReportingAPIReportAdded, func() interface{} { return new(protocol.NetworkReportingAPIReportAddedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkReportingAPIReportAddedEvent)) }, onError)
+}
+
+// WaitForNetworkReportingAPIReportAdded waits for Network.reportingApiReportAdded matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkReportingAPIReportAdded(ctx context.Context, predicate func(*protocol.NetworkReportingAPIReportAddedEvent) bool, action func() error) (*protocol.NetworkReportingAPIReportAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkReportingAPIReportAdded, func() interface{} { return new(protocol.NetworkReportingAPIReportAddedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkReportingAPIReportAddedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkReportingAPIReportAddedEvent), nil
+}
+
+// OnNetworkReportingAPIReportUpdated subscribe to Network.reportingApiReportUpdated
+func (session *Session) OnNetworkReportingAPIReportUpdated(cb func(*protocol.NetworkReportingAPIReportUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkReportingAPIReportUpdated, func() interface{} { return new(protocol.NetworkReportingAPIReportUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkReportingAPIReportUpdatedEvent)) }, onError)
+}
+
+// WaitForNetworkReportingAPIReportUpdated waits for Network.reportingApiReportUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkReportingAPIReportUpdated(ctx context.Context, predicate func(*protocol.NetworkReportingAPIReportUpdatedEvent) bool, action func() error) (*protocol.NetworkReportingAPIReportUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkReportingAPIReportUpdated, func() interface{} { return new(protocol.NetworkReportingAPIReportUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkReportingAPIReportUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkReportingAPIReportUpdatedEvent), nil
+}
+
+// OnNetworkReportingAPIEndpointsChangedForOrigin subscribe to Network.reportingApiEndpointsChangedForOrigin
+func (session *Session) OnNetworkReportingAPIEndpointsChangedForOrigin(cb func(*protocol.NetworkReportingAPIEndpointsChangedForOriginEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventNetworkReportingAPIEndpointsChangedForOrigin, func() interface{} { return new(protocol.NetworkReportingAPIEndpointsChangedForOriginEvent) }, func(v interface{}) { cb(v.(*protocol.NetworkReportingAPIEndpointsChangedForOriginEvent)) }, onError)
+}
+
+// WaitForNetworkReportingAPIEndpointsChangedForOrigin waits for Network.reportingApiEndpointsChangedForOrigin matching predicate, see WaitForEvent
+func (session Session) WaitForNetworkReportingAPIEndpointsChangedForOrigin(ctx context.Context, predicate func(*protocol.NetworkReportingAPIEndpointsChangedForOriginEvent) bool, action func() error) (*protocol.NetworkReportingAPIEndpointsChangedForOriginEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventNetworkReportingAPIEndpointsChangedForOrigin, func() interface{} { return new(protocol.NetworkReportingAPIEndpointsChangedForOriginEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.NetworkReportingAPIEndpointsChangedForOriginEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.NetworkReportingAPIEndpointsChangedForOriginEvent), nil
+}
+
+// OnOverlayInspectNodeRequested subscribe to Overlay.inspectNodeRequested
+func (session *Session) OnOverlayInspectNodeRequested(cb func(*protocol.OverlayInspectNodeRequestedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventOverlayInspectNodeRequested, func() interface{} { return new(protocol.OverlayInspectNodeRequestedEvent) }, func(v interface{}) { cb(v.(*protocol.OverlayInspectNodeRequestedEvent)) }, onError)
+}
+
+// WaitForOverlayInspectNodeRequested waits for Overlay.inspectNodeRequested matching predicate, see WaitForEvent
+func (session Session) WaitForOverlayInspectNodeRequested(ctx context.Context, predicate func(*protocol.OverlayInspectNodeRequestedEvent) bool, action func() error) (*protocol.OverlayInspectNodeRequestedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventOverlayInspectNodeRequested, func() interface{} { return new(protocol.OverlayInspectNodeRequestedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.OverlayInspectNodeRequestedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.OverlayInspectNodeRequestedEvent), nil
+}
+
+// OnOverlayNodeHighlightRequested subscribe to Overlay.nodeHighlightRequested
+func (session *Session) OnOverlayNodeHighlightRequested(cb func(*protocol.OverlayNodeHighlightRequestedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventOverlayNodeHighlightRequested, func() interface{} { return new(protocol.OverlayNodeHighlightRequestedEvent) }, func(v interface{}) { cb(v.(*protocol.OverlayNodeHighlightRequestedEvent)) }, onError)
+}
+
+// WaitForOverlayNodeHighlightRequested waits for Overlay.nodeHighlightRequested matching predicate, see WaitForEvent
+func (session Session) WaitForOverlayNodeHighlightRequested(ctx context.Context, predicate func(*protocol.OverlayNodeHighlightRequestedEvent) bool, action func() error) (*protocol.OverlayNodeHighlightRequestedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventOverlayNodeHighlightRequested, func() interface{} { return new(protocol.OverlayNodeHighlightRequestedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.OverlayNodeHighlightRequestedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.OverlayNodeHighlightRequestedEvent), nil
+}
+
+// OnOverlayScreenshotRequested subscribe to Overlay.screenshotRequested
+func (session *Session) OnOverlayScreenshotRequested(cb func(*protocol.OverlayScreenshotRequestedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventOverlayScreenshotRequested, func() interface{} { return new(protocol.OverlayScreenshotRequestedEvent) }, func(v interface{}) { cb(v.(*protocol.OverlayScreenshotRequestedEvent)) }, onError)
+}
+
+// WaitForOverlayScreenshotRequested waits for Overlay.screenshotRequested matching predicate, see WaitForEvent
+func (session Session) WaitForOverlayScreenshotRequested(ctx context.Context, predicate func(*protocol.OverlayScreenshotRequestedEvent) bool, action func() error) (*protocol.OverlayScreenshotRequestedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventOverlayScreenshotRequested, func() interface{} { return new(protocol.OverlayScreenshotRequestedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.OverlayScreenshotRequestedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.OverlayScreenshotRequestedEvent), nil
+}
+
+// OnOverlayInspectModeCanceled subscribe to Overlay.inspectModeCanceled
+func (session *Session) OnOverlayInspectModeCanceled(cb func(*protocol.OverlayInspectModeCanceledEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventOverlayInspectModeCanceled, func() interface{} { return new(protocol.OverlayInspectModeCanceledEvent) }, func(v interface{}) { cb(v.(*protocol.OverlayInspectModeCanceledEvent)) }, onError)
+}
+
+// WaitForOverlayInspectModeCanceled waits for Overlay.inspectModeCanceled matching predicate, see WaitForEvent
+func (session Session) WaitForOverlayInspectModeCanceled(ctx context.Context, predicate func(*protocol.OverlayInspectModeCanceledEvent) bool, action func() error) (*protocol.OverlayInspectModeCanceledEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventOverlayInspectModeCanceled, func() interface{} { return new(protocol.OverlayInspectModeCanceledEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.OverlayInspectModeCanceledEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.OverlayInspectModeCanceledEvent), nil
+}
+
+// OnPageDOMContentEventFired subscribe to Page.domContentEventFired
+func (session *Session) OnPageDOMContentEventFired(cb func(*protocol.PageDOMContentEventFiredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageDOMContentEventFired, func() interface{} { return new(protocol.PageDOMContentEventFiredEvent) }, func(v interface{}) { cb(v.(*protocol.PageDOMContentEventFiredEvent)) }, onError)
+}
+
+// WaitForPageDOMContentEventFired waits for Page.domContentEventFired matching predicate, see WaitForEvent
+func (session Session) WaitForPageDOMContentEventFired(ctx context.Context, predicate func(*protocol.PageDOMContentEventFiredEvent) bool, action func() error) (*protocol.PageDOMContentEventFiredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageDOMContentEventFired, func() interface{} { return new(protocol.PageDOMContentEventFiredEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageDOMContentEventFiredEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageDOMContentEventFiredEvent), nil
+}
+
+// OnPageFileChooserOpened subscribe to Page.fileChooserOpened
+func (session *Session) OnPageFileChooserOpened(cb func(*protocol.PageFileChooserOpenedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFileChooserOpened, func() interface{} { return new(protocol.PageFileChooserOpenedEvent) }, func(v interface{}) { cb(v.(*protocol.PageFileChooserOpenedEvent)) }, onError)
+}
+
+// WaitForPageFileChooserOpened waits for Page.fileChooserOpened matching predicate, see WaitForEvent
+func (session Session) WaitForPageFileChooserOpened(ctx context.Context, predicate func(*protocol.PageFileChooserOpenedEvent) bool, action func() error) (*protocol.PageFileChooserOpenedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFileChooserOpened, func() interface{} { return new(protocol.PageFileChooserOpenedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFileChooserOpenedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFileChooserOpenedEvent), nil
+}
+
+// OnPageFrameAttached subscribe to Page.frameAttached
+func (session *Session) OnPageFrameAttached(cb func(*protocol.PageFrameAttachedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameAttached, func() interface{} { return new(protocol.PageFrameAttachedEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameAttachedEvent)) }, onError)
+}
+
+// WaitForPageFrameAttached waits for Page.frameAttached matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameAttached(ctx context.Context, predicate func(*protocol.PageFrameAttachedEvent) bool, action func() error) (*protocol.PageFrameAttachedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameAttached, func() interface{} { return new(protocol.PageFrameAttachedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageFrameAttachedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameAttachedEvent), nil
+}
+
+// OnPageFrameClearedScheduledNavigation subscribe to Page.frameClearedScheduledNavigation
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnPageFrameClearedScheduledNavigation(cb func(*protocol.PageFrameClearedScheduledNavigationEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameClearedScheduledNavigation, func() interface{} { return new(protocol.PageFrameClearedScheduledNavigationEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameClearedScheduledNavigationEvent)) }, onError)
+}
+
+// WaitForPageFrameClearedScheduledNavigation waits for Page.frameClearedScheduledNavigation matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForPageFrameClearedScheduledNavigation(ctx context.Context, predicate func(*protocol.PageFrameClearedScheduledNavigationEvent) bool, action func() error) (*protocol.PageFrameClearedScheduledNavigationEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameClearedScheduledNavigation, func() interface{} { return new(protocol.PageFrameClearedScheduledNavigationEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameClearedScheduledNavigationEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameClearedScheduledNavigationEvent), nil
+}
+
+// OnPageFrameDetached subscribe to Page.frameDetached
+func (session *Session) OnPageFrameDetached(cb func(*protocol.PageFrameDetachedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameDetached, func() interface{} { return new(protocol.PageFrameDetachedEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameDetachedEvent)) }, onError)
+}
+
+// WaitForPageFrameDetached waits for Page.frameDetached matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameDetached(ctx context.Context, predicate func(*protocol.PageFrameDetachedEvent) bool, action func() error) (*protocol.PageFrameDetachedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameDetached, func() interface{} { return new(protocol.PageFrameDetachedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageFrameDetachedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameDetachedEvent), nil
+}
+
+// OnPageFrameSubtreeWillBeDetached subscribe to Page.frameSubtreeWillBeDetached
+func (session *Session) OnPageFrameSubtreeWillBeDetached(cb func(*protocol.PageFrameSubtreeWillBeDetachedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameSubtreeWillBeDetached, func() interface{} { return new(protocol.PageFrameSubtreeWillBeDetachedEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameSubtreeWillBeDetachedEvent)) }, onError)
+}
+
+// WaitForPageFrameSubtreeWillBeDetached waits for Page.frameSubtreeWillBeDetached matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameSubtreeWillBeDetached(ctx context.Context, predicate func(*protocol.PageFrameSubtreeWillBeDetachedEvent) bool, action func() error) (*protocol.PageFrameSubtreeWillBeDetachedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameSubtreeWillBeDetached, func() interface{} { return new(protocol.PageFrameSubtreeWillBeDetachedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameSubtreeWillBeDetachedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameSubtreeWillBeDetachedEvent), nil
+}
+
+// OnPageFrameNavigated subscribe to Page.frameNavigated
+func (session *Session) OnPageFrameNavigated(cb func(*protocol.PageFrameNavigatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameNavigated, func() interface{} { return new(protocol.PageFrameNavigatedEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameNavigatedEvent)) }, onError)
+}
+
+// WaitForPageFrameNavigated waits for Page.frameNavigated matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameNavigated(ctx context.Context, predicate func(*protocol.PageFrameNavigatedEvent) bool, action func() error) (*protocol.PageFrameNavigatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameNavigated, func() interface{} { return new(protocol.PageFrameNavigatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageFrameNavigatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameNavigatedEvent), nil
+}
+
+// OnPageDocumentOpened subscribe to Page.documentOpened
+func (session *Session) OnPageDocumentOpened(cb func(*protocol.PageDocumentOpenedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageDocumentOpened, func() interface{} { return new(protocol.PageDocumentOpenedEvent) }, func(v interface{}) { cb(v.(*protocol.PageDocumentOpenedEvent)) }, onError)
+}
+
+// WaitForPageDocumentOpened waits for Page.documentOpened matching predicate, see WaitForEvent
+func (session Session) WaitForPageDocumentOpened(ctx context.Context, predicate func(*protocol.PageDocumentOpenedEvent) bool, action func() error) (*protocol.PageDocumentOpenedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageDocumentOpened, func() interface{} { return new(protocol.PageDocumentOpenedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageDocumentOpenedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageDocumentOpenedEvent), nil
+}
+
+// OnPageFrameResized subscribe to Page.frameResized
+func (session *Session) OnPageFrameResized(cb func(*protocol.PageFrameResizedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameResized, func() interface{} { return new(protocol.PageFrameResizedEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameResizedEvent)) }, onError)
+}
+
+// WaitForPageFrameResized waits for Page.frameResized matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameResized(ctx context.Context, predicate func(*protocol.PageFrameResizedEvent) bool, action func() error) (*protocol.PageFrameResizedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameResized, func() interface{} { return new(protocol.PageFrameResizedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageFrameResizedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameResizedEvent), nil
+}
+
+// OnPageFrameStartedNavigating subscribe to Page.frameStartedNavigating
+func (session *Session) OnPageFrameStartedNavigating(cb func(*protocol.PageFrameStartedNavigatingEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameStartedNavigating, func() interface{} { return new(protocol.PageFrameStartedNavigatingEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameStartedNavigatingEvent)) }, onError)
+}
+
+// WaitForPageFrameStartedNavigating waits for Page.frameStartedNavigating matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameStartedNavigating(ctx context.Context, predicate func(*protocol.PageFrameStartedNavigatingEvent) bool, action func() error) (*protocol.PageFrameStartedNavigatingEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameStartedNavigating, func() interface{} { return new(protocol.PageFrameStartedNavigatingEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameStartedNavigatingEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameStartedNavigatingEvent), nil
+}
+
+// OnPageFrameRequestedNavigation subscribe to Page.frameRequestedNavigation
+func (session *Session) OnPageFrameRequestedNavigation(cb func(*protocol.PageFrameRequestedNavigationEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameRequestedNavigation, func() interface{} { return new(protocol.PageFrameRequestedNavigationEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameRequestedNavigationEvent)) }, onError)
+}
+
+// WaitForPageFrameRequestedNavigation waits for Page.frameRequestedNavigation matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameRequestedNavigation(ctx context.Context, predicate func(*protocol.PageFrameRequestedNavigationEvent) bool, action func() error) (*protocol.PageFrameRequestedNavigationEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameRequestedNavigation, func() interface{} { return new(protocol.PageFrameRequestedNavigationEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameRequestedNavigationEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameRequestedNavigationEvent), nil
+}
+
+// OnPageFrameScheduledNavigation subscribe to Page.frameScheduledNavigation
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnPageFrameScheduledNavigation(cb func(*protocol.PageFrameScheduledNavigationEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameScheduledNavigation, func() interface{} { return new(protocol.PageFrameScheduledNavigationEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameScheduledNavigationEvent)) }, onError)
+}
+
+// WaitForPageFrameScheduledNavigation waits for Page.frameScheduledNavigation matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForPageFrameScheduledNavigation(ctx context.Context, predicate func(*protocol.PageFrameScheduledNavigationEvent) bool, action func() error) (*protocol.PageFrameScheduledNavigationEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameScheduledNavigation, func() interface{} { return new(protocol.PageFrameScheduledNavigationEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameScheduledNavigationEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameScheduledNavigationEvent), nil
+}
+
+// OnPageFrameStartedLoading subscribe to Page.frameStartedLoading
+func (session *Session) OnPageFrameStartedLoading(cb func(*protocol.PageFrameStartedLoadingEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameStartedLoading, func() interface{} { return new(protocol.PageFrameStartedLoadingEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameStartedLoadingEvent)) }, onError)
+}
+
+// WaitForPageFrameStartedLoading waits for Page.frameStartedLoading matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameStartedLoading(ctx context.Context, predicate func(*protocol.PageFrameStartedLoadingEvent) bool, action func() error) (*protocol.PageFrameStartedLoadingEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameStartedLoading, func() interface{} { return new(protocol.PageFrameStartedLoadingEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameStartedLoadingEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameStartedLoadingEvent), nil
+}
+
+// OnPageFrameStoppedLoading subscribe to Page.frameStoppedLoading
+func (session *Session) OnPageFrameStoppedLoading(cb func(*protocol.PageFrameStoppedLoadingEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageFrameStoppedLoading, func() interface{} { return new(protocol.PageFrameStoppedLoadingEvent) }, func(v interface{}) { cb(v.(*protocol.PageFrameStoppedLoadingEvent)) }, onError)
+}
+
+// WaitForPageFrameStoppedLoading waits for Page.frameStoppedLoading matching predicate, see WaitForEvent
+func (session Session) WaitForPageFrameStoppedLoading(ctx context.Context, predicate func(*protocol.PageFrameStoppedLoadingEvent) bool, action func() error) (*protocol.PageFrameStoppedLoadingEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageFrameStoppedLoading, func() interface{} { return new(protocol.PageFrameStoppedLoadingEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageFrameStoppedLoadingEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageFrameStoppedLoadingEvent), nil
+}
+
+// OnPageDownloadWillBegin subscribe to Page.downloadWillBegin
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnPageDownloadWillBegin(cb func(*protocol.PageDownloadWillBeginEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageDownloadWillBegin, func() interface{} { return new(protocol.PageDownloadWillBeginEvent) }, func(v interface{}) { cb(v.(*protocol.PageDownloadWillBeginEvent)) }, onError)
+}
+
+// WaitForPageDownloadWillBegin waits for Page.downloadWillBegin matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForPageDownloadWillBegin(ctx context.Context, predicate func(*protocol.PageDownloadWillBeginEvent) bool, action func() error) (*protocol.PageDownloadWillBeginEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageDownloadWillBegin, func() interface{} { return new(protocol.PageDownloadWillBeginEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageDownloadWillBeginEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageDownloadWillBeginEvent), nil
+}
+
+// OnPageDownloadProgress subscribe to Page.downloadProgress
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnPageDownloadProgress(cb func(*protocol.PageDownloadProgressEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageDownloadProgress, func() interface{} { return new(protocol.PageDownloadProgressEvent) }, func(v interface{}) { cb(v.(*protocol.PageDownloadProgressEvent)) }, onError)
+}
+
+// WaitForPageDownloadProgress waits for Page.downloadProgress matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForPageDownloadProgress(ctx context.Context, predicate func(*protocol.PageDownloadProgressEvent) bool, action func() error) (*protocol.PageDownloadProgressEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageDownloadProgress, func() interface{} { return new(protocol.PageDownloadProgressEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageDownloadProgressEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageDownloadProgressEvent), nil
+}
+
+// OnPageInterstitialHidden subscribe to Page.interstitialHidden
+func (session *Session) OnPageInterstitialHidden(cb func(*protocol.PageInterstitialHiddenEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageInterstitialHidden, func() interface{} { return new(protocol.PageInterstitialHiddenEvent) }, func(v interface{}) { cb(v.(*protocol.PageInterstitialHiddenEvent)) }, onError)
+}
+
+// WaitForPageInterstitialHidden waits for Page.interstitialHidden matching predicate, see WaitForEvent
+func (session Session) WaitForPageInterstitialHidden(ctx context.Context, predicate func(*protocol.PageInterstitialHiddenEvent) bool, action func() error) (*protocol.PageInterstitialHiddenEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageInterstitialHidden, func() interface{} { return new(protocol.PageInterstitialHiddenEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageInterstitialHiddenEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageInterstitialHiddenEvent), nil
+}
+
+// OnPageInterstitialShown subscribe to Page.interstitialShown
+func (session *Session) OnPageInterstitialShown(cb func(*protocol.PageInterstitialShownEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageInterstitialShown, func() interface{} { return new(protocol.PageInterstitialShownEvent) }, func(v interface{}) { cb(v.(*protocol.PageInterstitialShownEvent)) }, onError)
+}
+
+// WaitForPageInterstitialShown waits for Page.interstitialShown matching predicate, see WaitForEvent
+func (session Session) WaitForPageInterstitialShown(ctx context.Context, predicate func(*protocol.PageInterstitialShownEvent) bool, action func() error) (*protocol.PageInterstitialShownEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageInterstitialShown, func() interface{} { return new(protocol.PageInterstitialShownEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageInterstitialShownEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageInterstitialShownEvent), nil
+}
+
+// OnPageJavascriptDialogClosed subscribe to Page.javascriptDialogClosed
+func (session *Session) OnPageJavascriptDialogClosed(cb func(*protocol.PageJavascriptDialogClosedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageJavascriptDialogClosed, func() interface{} { return new(protocol.PageJavascriptDialogClosedEvent) }, func(v interface{}) { cb(v.(*protocol.PageJavascriptDialogClosedEvent)) }, onError)
+}
+
+// WaitForPageJavascriptDialogClosed waits for Page.javascriptDialogClosed matching predicate, see WaitForEvent
+func (session Session) WaitForPageJavascriptDialogClosed(ctx context.Context, predicate func(*protocol.PageJavascriptDialogClosedEvent) bool, action func() error) (*protocol.PageJavascriptDialogClosedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageJavascriptDialogClosed, func() interface{} { return new(protocol.PageJavascriptDialogClosedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageJavascriptDialogClosedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageJavascriptDialogClosedEvent), nil
+}
+
+// OnPageJavascriptDialogOpening subscribe to Page.javascriptDialogOpening
+func (session *Session) OnPageJavascriptDialogOpening(cb func(*protocol.PageJavascriptDialogOpeningEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageJavascriptDialogOpening, func() interface{} { return new(protocol.PageJavascriptDialogOpeningEvent) }, func(v interface{}) { cb(v.(*protocol.PageJavascriptDialogOpeningEvent)) }, onError)
+}
+
+// WaitForPageJavascriptDialogOpening waits for Page.javascriptDialogOpening matching predicate, see WaitForEvent
+func (session Session) WaitForPageJavascriptDialogOpening(ctx context.Context, predicate func(*protocol.PageJavascriptDialogOpeningEvent) bool, action func() error) (*protocol.PageJavascriptDialogOpeningEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageJavascriptDialogOpening, func() interface{} { return new(protocol.PageJavascriptDialogOpeningEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageJavascriptDialogOpeningEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageJavascriptDialogOpeningEvent), nil
+}
+
+// OnPageLifecycleEvent subscribe to Page.lifecycleEvent
+func (session *Session) OnPageLifecycleEvent(cb func(*protocol.PageLifecycleEventEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageLifecycleEvent, func() interface{} { return new(protocol.PageLifecycleEventEvent) }, func(v interface{}) { cb(v.(*protocol.PageLifecycleEventEvent)) }, onError)
+}
+
+// WaitForPageLifecycleEvent waits for Page.lifecycleEvent matching predicate, see WaitForEvent
+func (session Session) WaitForPageLifecycleEvent(ctx context.Context, predicate func(*protocol.PageLifecycleEventEvent) bool, action func() error) (*protocol.PageLifecycleEventEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageLifecycleEvent, func() interface{} { return new(protocol.PageLifecycleEventEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageLifecycleEventEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageLifecycleEventEvent), nil
+}
+
+// OnPageBackForwardCacheNotUsed subscribe to Page.backForwardCacheNotUsed
+func (session *Session) OnPageBackForwardCacheNotUsed(cb func(*protocol.PageBackForwardCacheNotUsedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageBackForwardCacheNotUsed, func() interface{} { return new(protocol.PageBackForwardCacheNotUsedEvent) }, func(v interface{}) { cb(v.(*protocol.PageBackForwardCacheNotUsedEvent)) }, onError)
+}
+
+// WaitForPageBackForwardCacheNotUsed waits for Page.backForwardCacheNotUsed matching predicate, see WaitForEvent
+func (session Session) WaitForPageBackForwardCacheNotUsed(ctx context.Context, predicate func(*protocol.PageBackForwardCacheNotUsedEvent) bool, action func() error) (*protocol.PageBackForwardCacheNotUsedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageBackForwardCacheNotUsed, func() interface{} { return new(protocol.PageBackForwardCacheNotUsedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageBackForwardCacheNotUsedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageBackForwardCacheNotUsedEvent), nil
+}
+
+// OnPageLoadEventFired subscribe to Page.loadEventFired
+func (session *Session) OnPageLoadEventFired(cb func(*protocol.PageLoadEventFiredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageLoadEventFired, func() interface{} { return new(protocol.PageLoadEventFiredEvent) }, func(v interface{}) { cb(v.(*protocol.PageLoadEventFiredEvent)) }, onError)
+}
+
+// WaitForPageLoadEventFired waits for Page.loadEventFired matching predicate, see WaitForEvent
+func (session Session) WaitForPageLoadEventFired(ctx context.Context, predicate func(*protocol.PageLoadEventFiredEvent) bool, action func() error) (*protocol.PageLoadEventFiredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageLoadEventFired, func() interface{} { return new(protocol.PageLoadEventFiredEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageLoadEventFiredEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageLoadEventFiredEvent), nil
+}
+
+// OnPageNavigatedWithinDocument subscribe to Page.navigatedWithinDocument
+func (session *Session) OnPageNavigatedWithinDocument(cb func(*protocol.PageNavigatedWithinDocumentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageNavigatedWithinDocument, func() interface{} { return new(protocol.PageNavigatedWithinDocumentEvent) }, func(v interface{}) { cb(v.(*protocol.PageNavigatedWithinDocumentEvent)) }, onError)
+}
+
+// WaitForPageNavigatedWithinDocument waits for Page.navigatedWithinDocument matching predicate, see WaitForEvent
+func (session Session) WaitForPageNavigatedWithinDocument(ctx context.Context, predicate func(*protocol.PageNavigatedWithinDocumentEvent) bool, action func() error) (*protocol.PageNavigatedWithinDocumentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageNavigatedWithinDocument, func() interface{} { return new(protocol.PageNavigatedWithinDocumentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageNavigatedWithinDocumentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageNavigatedWithinDocumentEvent), nil
+}
+
+// OnPageScreencastFrame subscribe to Page.screencastFrame
+func (session *Session) OnPageScreencastFrame(cb func(*protocol.PageScreencastFrameEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageScreencastFrame, func() interface{} { return new(protocol.PageScreencastFrameEvent) }, func(v interface{}) { cb(v.(*protocol.PageScreencastFrameEvent)) }, onError)
+}
+
+// WaitForPageScreencastFrame waits for Page.screencastFrame matching predicate, see WaitForEvent
+func (session Session) WaitForPageScreencastFrame(ctx context.Context, predicate func(*protocol.PageScreencastFrameEvent) bool, action func() error) (*protocol.PageScreencastFrameEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageScreencastFrame, func() interface{} { return new(protocol.PageScreencastFrameEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageScreencastFrameEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageScreencastFrameEvent), nil
+}
+
+// OnPageScreencastVisibilityChanged subscribe to Page.screencastVisibilityChanged
+func (session *Session) OnPageScreencastVisibilityChanged(cb func(*protocol.PageScreencastVisibilityChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageScreencastVisibilityChanged, func() interface{} { return new(protocol.PageScreencastVisibilityChangedEvent) }, func(v interface{}) { cb(v.(*protocol.PageScreencastVisibilityChangedEvent)) }, onError)
+}
+
+// WaitForPageScreencastVisibilityChanged waits for Page.screencastVisibilityChanged matching predicate, see WaitForEvent
+func (session Session) WaitForPageScreencastVisibilityChanged(ctx context.Context, predicate func(*protocol.PageScreencastVisibilityChangedEvent) bool, action func() error) (*protocol.PageScreencastVisibilityChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageScreencastVisibilityChanged, func() interface{} { return new(protocol.PageScreencastVisibilityChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageScreencastVisibilityChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageScreencastVisibilityChangedEvent), nil
+}
+
+// OnPageWindowOpen subscribe to Page.windowOpen
+func (session *Session) OnPageWindowOpen(cb func(*protocol.PageWindowOpenEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageWindowOpen, func() interface{} { return new(protocol.PageWindowOpenEvent) }, func(v interface{}) { cb(v.(*protocol.PageWindowOpenEvent)) }, onError)
+}
+
+// WaitForPageWindowOpen waits for Page.windowOpen matching predicate, see WaitForEvent
+func (session Session) WaitForPageWindowOpen(ctx context.Context, predicate func(*protocol.PageWindowOpenEvent) bool, action func() error) (*protocol.PageWindowOpenEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageWindowOpen, func() interface{} { return new(protocol.PageWindowOpenEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PageWindowOpenEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageWindowOpenEvent), nil
+}
+
+// OnPageCompilationCacheProduced subscribe to Page.compilationCacheProduced
+func (session *Session) OnPageCompilationCacheProduced(cb func(*protocol.PageCompilationCacheProducedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPageCompilationCacheProduced, func() interface{} { return new(protocol.PageCompilationCacheProducedEvent) }, func(v interface{}) { cb(v.(*protocol.PageCompilationCacheProducedEvent)) }, onError)
+}
+
+// WaitForPageCompilationCacheProduced waits for Page.compilationCacheProduced matching predicate, see WaitForEvent
+func (session Session) WaitForPageCompilationCacheProduced(ctx context.Context, predicate func(*protocol.PageCompilationCacheProducedEvent) bool, action func() error) (*protocol.PageCompilationCacheProducedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPageCompilationCacheProduced, func() interface{} { return new(protocol.PageCompilationCacheProducedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PageCompilationCacheProducedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PageCompilationCacheProducedEvent), nil
+}
+
+// OnPerformanceMetrics subscribe to Performance.metrics
+func (session *Session) OnPerformanceMetrics(cb func(*protocol.PerformanceMetricsEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPerformanceMetrics, func() interface{} { return new(protocol.PerformanceMetricsEvent) }, func(v interface{}) { cb(v.(*protocol.PerformanceMetricsEvent)) }, onError)
+}
+
+// WaitForPerformanceMetrics waits for Performance.metrics matching predicate, see WaitForEvent
+func (session Session) WaitForPerformanceMetrics(ctx context.Context, predicate func(*protocol.PerformanceMetricsEvent) bool, action func() error) (*protocol.PerformanceMetricsEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPerformanceMetrics, func() interface{} { return new(protocol.PerformanceMetricsEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.PerformanceMetricsEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PerformanceMetricsEvent), nil
+}
+
+// OnPerformanceTimelineTimelineEventAdded subscribe to PerformanceTimeline.timelineEventAdded
+func (session *Session) OnPerformanceTimelineTimelineEventAdded(cb func(*protocol.PerformanceTimelineTimelineEventAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPerformanceTimelineTimelineEventAdded, func() interface{} { return new(protocol.PerformanceTimelineTimelineEventAddedEvent) }, func(v interface{}) { cb(v.(*protocol.PerformanceTimelineTimelineEventAddedEvent)) }, onError)
+}
+
+// WaitForPerformanceTimelineTimelineEventAdded waits for PerformanceTimeline.timelineEventAdded matching predicate, see WaitForEvent
+func (session Session) WaitForPerformanceTimelineTimelineEventAdded(ctx context.Context, predicate func(*protocol.PerformanceTimelineTimelineEventAddedEvent) bool, action func() error) (*protocol.PerformanceTimelineTimelineEventAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPerformanceTimelineTimelineEventAdded, func() interface{} { return new(protocol.PerformanceTimelineTimelineEventAddedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PerformanceTimelineTimelineEventAddedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PerformanceTimelineTimelineEventAddedEvent), nil
+}
+
+// OnPreloadRuleSetUpdated subscribe to Preload.ruleSetUpdated
+func (session *Session) OnPreloadRuleSetUpdated(cb func(*protocol.PreloadRuleSetUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPreloadRuleSetUpdated, func() interface{} { return new(protocol.PreloadRuleSetUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.PreloadRuleSetUpdatedEvent)) }, onError)
+}
+
+// WaitForPreloadRuleSetUpdated waits for Preload.ruleSetUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForPreloadRuleSetUpdated(ctx context.Context, predicate func(*protocol.PreloadRuleSetUpdatedEvent) bool, action func() error) (*protocol.PreloadRuleSetUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPreloadRuleSetUpdated, func() interface{} { return new(protocol.PreloadRuleSetUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PreloadRuleSetUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PreloadRuleSetUpdatedEvent), nil
+}
+
+// OnPreloadRuleSetRemoved subscribe to Preload.ruleSetRemoved
+func (session *Session) OnPreloadRuleSetRemoved(cb func(*protocol.PreloadRuleSetRemovedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPreloadRuleSetRemoved, func() interface{} { return new(protocol.PreloadRuleSetRemovedEvent) }, func(v interface{}) { cb(v.(*protocol.PreloadRuleSetRemovedEvent)) }, onError)
+}
+
+// WaitForPreloadRuleSetRemoved waits for Preload.ruleSetRemoved matching predicate, see WaitForEvent
+func (session Session) WaitForPreloadRuleSetRemoved(ctx context.Context, predicate func(*protocol.PreloadRuleSetRemovedEvent) bool, action func() error) (*protocol.PreloadRuleSetRemovedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPreloadRuleSetRemoved, func() interface{} { return new(protocol.PreloadRuleSetRemovedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PreloadRuleSetRemovedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PreloadRuleSetRemovedEvent), nil
+}
+
+// OnPreloadPreloadEnabledStateUpdated subscribe to Preload.preloadEnabledStateUpdated
+func (session *Session) OnPreloadPreloadEnabledStateUpdated(cb func(*protocol.PreloadPreloadEnabledStateUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPreloadPreloadEnabledStateUpdated, func() interface{} { return new(protocol.PreloadPreloadEnabledStateUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.PreloadPreloadEnabledStateUpdatedEvent)) }, onError)
+}
+
+// WaitForPreloadPreloadEnabledStateUpdated waits for Preload.preloadEnabledStateUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForPreloadPreloadEnabledStateUpdated(ctx context.Context, predicate func(*protocol.PreloadPreloadEnabledStateUpdatedEvent) bool, action func() error) (*protocol.PreloadPreloadEnabledStateUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPreloadPreloadEnabledStateUpdated, func() interface{} { return new(protocol.PreloadPreloadEnabledStateUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PreloadPreloadEnabledStateUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PreloadPreloadEnabledStateUpdatedEvent), nil
+}
+
+// OnPreloadPrefetchStatusUpdated subscribe to Preload.prefetchStatusUpdated
+func (session *Session) OnPreloadPrefetchStatusUpdated(cb func(*protocol.PreloadPrefetchStatusUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPreloadPrefetchStatusUpdated, func() interface{} { return new(protocol.PreloadPrefetchStatusUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.PreloadPrefetchStatusUpdatedEvent)) }, onError)
+}
+
+// WaitForPreloadPrefetchStatusUpdated waits for Preload.prefetchStatusUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForPreloadPrefetchStatusUpdated(ctx context.Context, predicate func(*protocol.PreloadPrefetchStatusUpdatedEvent) bool, action func() error) (*protocol.PreloadPrefetchStatusUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPreloadPrefetchStatusUpdated, func() interface{} { return new(protocol.PreloadPrefetchStatusUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PreloadPrefetchStatusUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PreloadPrefetchStatusUpdatedEvent), nil
+}
+
+// OnPreloadPrerenderStatusUpdated subscribe to Preload.prerenderStatusUpdated
+func (session *Session) OnPreloadPrerenderStatusUpdated(cb func(*protocol.PreloadPrerenderStatusUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPreloadPrerenderStatusUpdated, func() interface{} { return new(protocol.PreloadPrerenderStatusUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.PreloadPrerenderStatusUpdatedEvent)) }, onError)
+}
+
+// WaitForPreloadPrerenderStatusUpdated waits for Preload.prerenderStatusUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForPreloadPrerenderStatusUpdated(ctx context.Context, predicate func(*protocol.PreloadPrerenderStatusUpdatedEvent) bool, action func() error) (*protocol.PreloadPrerenderStatusUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPreloadPrerenderStatusUpdated, func() interface{} { return new(protocol.PreloadPrerenderStatusUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PreloadPrerenderStatusUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PreloadPrerenderStatusUpdatedEvent), nil
+}
+
+// OnPreloadPreloadingAttemptSourcesUpdated subscribe to Preload.preloadingAttemptSourcesUpdated
+func (session *Session) OnPreloadPreloadingAttemptSourcesUpdated(cb func(*protocol.PreloadPreloadingAttemptSourcesUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventPreloadPreloadingAttemptSourcesUpdated, func() interface{} { return new(protocol.PreloadPreloadingAttemptSourcesUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.PreloadPreloadingAttemptSourcesUpdatedEvent)) }, onError)
+}
+
+// WaitForPreloadPreloadingAttemptSourcesUpdated waits for Preload.preloadingAttemptSourcesUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForPreloadPreloadingAttemptSourcesUpdated(ctx context.Context, predicate func(*protocol.PreloadPreloadingAttemptSourcesUpdatedEvent) bool, action func() error) (*protocol.PreloadPreloadingAttemptSourcesUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventPreloadPreloadingAttemptSourcesUpdated, func() interface{} { return new(protocol.PreloadPreloadingAttemptSourcesUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.PreloadPreloadingAttemptSourcesUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.PreloadPreloadingAttemptSourcesUpdatedEvent), nil
+}
+
+// OnProfilerConsoleProfileFinished subscribe to Profiler.consoleProfileFinished
+func (session *Session) OnProfilerConsoleProfileFinished(cb func(*protocol.ProfilerConsoleProfileFinishedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventProfilerConsoleProfileFinished, func() interface{} { return new(protocol.ProfilerConsoleProfileFinishedEvent) }, func(v interface{}) { cb(v.(*protocol.ProfilerConsoleProfileFinishedEvent)) }, onError)
+}
+
+// WaitForProfilerConsoleProfileFinished waits for Profiler.consoleProfileFinished matching predicate, see WaitForEvent
+func (session Session) WaitForProfilerConsoleProfileFinished(ctx context.Context, predicate func(*protocol.ProfilerConsoleProfileFinishedEvent) bool, action func() error) (*protocol.ProfilerConsoleProfileFinishedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventProfilerConsoleProfileFinished, func() interface{} { return new(protocol.ProfilerConsoleProfileFinishedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.ProfilerConsoleProfileFinishedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ProfilerConsoleProfileFinishedEvent), nil
+}
+
+// OnProfilerConsoleProfileStarted subscribe to Profiler.consoleProfileStarted
+func (session *Session) OnProfilerConsoleProfileStarted(cb func(*protocol.ProfilerConsoleProfileStartedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventProfilerConsoleProfileStarted, func() interface{} { return new(protocol.ProfilerConsoleProfileStartedEvent) }, func(v interface{}) { cb(v.(*protocol.ProfilerConsoleProfileStartedEvent)) }, onError)
+}
+
+// WaitForProfilerConsoleProfileStarted waits for Profiler.consoleProfileStarted matching predicate, see WaitForEvent
+func (session Session) WaitForProfilerConsoleProfileStarted(ctx context.Context, predicate func(*protocol.ProfilerConsoleProfileStartedEvent) bool, action func() error) (*protocol.ProfilerConsoleProfileStartedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventProfilerConsoleProfileStarted, func() interface{} { return new(protocol.ProfilerConsoleProfileStartedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.ProfilerConsoleProfileStartedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ProfilerConsoleProfileStartedEvent), nil
+}
+
+// OnProfilerPreciseCoverageDeltaUpdate subscribe to Profiler.preciseCoverageDeltaUpdate
+func (session *Session) OnProfilerPreciseCoverageDeltaUpdate(cb func(*protocol.ProfilerPreciseCoverageDeltaUpdateEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventProfilerPreciseCoverageDeltaUpdate, func() interface{} { return new(protocol.ProfilerPreciseCoverageDeltaUpdateEvent) }, func(v interface{}) { cb(v.(*protocol.ProfilerPreciseCoverageDeltaUpdateEvent)) }, onError)
+}
+
+// WaitForProfilerPreciseCoverageDeltaUpdate waits for Profiler.preciseCoverageDeltaUpdate matching predicate, see WaitForEvent
+func (session Session) WaitForProfilerPreciseCoverageDeltaUpdate(ctx context.Context, predicate func(*protocol.ProfilerPreciseCoverageDeltaUpdateEvent) bool, action func() error) (*protocol.ProfilerPreciseCoverageDeltaUpdateEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventProfilerPreciseCoverageDeltaUpdate, func() interface{} { return new(protocol.ProfilerPreciseCoverageDeltaUpdateEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.ProfilerPreciseCoverageDeltaUpdateEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ProfilerPreciseCoverageDeltaUpdateEvent), nil
+}
+
+// OnRuntimeBindingCalled subscribe to Runtime.bindingCalled
+func (session *Session) OnRuntimeBindingCalled(cb func(*protocol.RuntimeBindingCalledEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeBindingCalled, func() interface{} { return new(protocol.RuntimeBindingCalledEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeBindingCalledEvent)) }, onError)
+}
+
+// WaitForRuntimeBindingCalled waits for Runtime.bindingCalled matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeBindingCalled(ctx context.Context, predicate func(*protocol.RuntimeBindingCalledEvent) bool, action func() error) (*protocol.RuntimeBindingCalledEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeBindingCalled, func() interface{} { return new(protocol.RuntimeBindingCalledEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeBindingCalledEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeBindingCalledEvent), nil
+}
+
+// OnRuntimeConsoleAPICalled subscribe to Runtime.consoleAPICalled
+func (session *Session) OnRuntimeConsoleAPICalled(cb func(*protocol.RuntimeConsoleAPICalledEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeConsoleAPICalled, func() interface{} { return new(protocol.RuntimeConsoleAPICalledEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeConsoleAPICalledEvent)) }, onError)
+}
+
+// WaitForRuntimeConsoleAPICalled waits for Runtime.consoleAPICalled matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeConsoleAPICalled(ctx context.Context, predicate func(*protocol.RuntimeConsoleAPICalledEvent) bool, action func() error) (*protocol.RuntimeConsoleAPICalledEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeConsoleAPICalled, func() interface{} { return new(protocol.RuntimeConsoleAPICalledEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeConsoleAPICalledEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeConsoleAPICalledEvent), nil
+}
+
+// OnRuntimeExceptionRevoked subscribe to Runtime.exceptionRevoked
+func (session *Session) OnRuntimeExceptionRevoked(cb func(*protocol.RuntimeExceptionRevokedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeExceptionRevoked, func() interface{} { return new(protocol.RuntimeExceptionRevokedEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeExceptionRevokedEvent)) }, onError)
+}
+
+// WaitForRuntimeExceptionRevoked waits for Runtime.exceptionRevoked matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeExceptionRevoked(ctx context.Context, predicate func(*protocol.RuntimeExceptionRevokedEvent) bool, action func() error) (*protocol.RuntimeExceptionRevokedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeExceptionRevoked, func() interface{} { return new(protocol.RuntimeExceptionRevokedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeExceptionRevokedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeExceptionRevokedEvent), nil
+}
+
+// OnRuntimeExceptionThrown subscribe to Runtime.exceptionThrown
+func (session *Session) OnRuntimeExceptionThrown(cb func(*protocol.RuntimeExceptionThrownEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeExceptionThrown, func() interface{} { return new(protocol.RuntimeExceptionThrownEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeExceptionThrownEvent)) }, onError)
+}
+
+// WaitForRuntimeExceptionThrown waits for Runtime.exceptionThrown matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeExceptionThrown(ctx context.Context, predicate func(*protocol.RuntimeExceptionThrownEvent) bool, action func() error) (*protocol.RuntimeExceptionThrownEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeExceptionThrown, func() interface{} { return new(protocol.RuntimeExceptionThrownEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeExceptionThrownEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeExceptionThrownEvent), nil
+}
+
+// OnRuntimeExecutionContextCreated subscribe to Runtime.executionContextCreated
+func (session *Session) OnRuntimeExecutionContextCreated(cb func(*protocol.RuntimeExecutionContextCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeExecutionContextCreated, func() interface{} { return new(protocol.RuntimeExecutionContextCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeExecutionContextCreatedEvent)) }, onError)
+}
+
+// WaitForRuntimeExecutionContextCreated waits for Runtime.executionContextCreated matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeExecutionContextCreated(ctx context.Context, predicate func(*protocol.RuntimeExecutionContextCreatedEvent) bool, action func() error) (*protocol.RuntimeExecutionContextCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeExecutionContextCreated, func() interface{} { return new(protocol.RuntimeExecutionContextCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeExecutionContextCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeExecutionContextCreatedEvent), nil
+}
+
+// OnRuntimeExecutionContextDestroyed subscribe to Runtime.executionContextDestroyed
+func (session *Session) OnRuntimeExecutionContextDestroyed(cb func(*protocol.RuntimeExecutionContextDestroyedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeExecutionContextDestroyed, func() interface{} { return new(protocol.RuntimeExecutionContextDestroyedEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeExecutionContextDestroyedEvent)) }, onError)
+}
+
+// WaitForRuntimeExecutionContextDestroyed waits for Runtime.executionContextDestroyed matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeExecutionContextDestroyed(ctx context.Context, predicate func(*protocol.RuntimeExecutionContextDestroyedEvent) bool, action func() error) (*protocol.RuntimeExecutionContextDestroyedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeExecutionContextDestroyed, func() interface{} { return new(protocol.RuntimeExecutionContextDestroyedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeExecutionContextDestroyedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeExecutionContextDestroyedEvent), nil
+}
+
+// OnRuntimeExecutionContextsCleared subscribe to Runtime.executionContextsCleared
+func (session *Session) OnRuntimeExecutionContextsCleared(cb func(*protocol.RuntimeExecutionContextsClearedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeExecutionContextsCleared, func() interface{} { return new(protocol.RuntimeExecutionContextsClearedEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeExecutionContextsClearedEvent)) }, onError)
+}
+
+// WaitForRuntimeExecutionContextsCleared waits for Runtime.executionContextsCleared matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeExecutionContextsCleared(ctx context.Context, predicate func(*protocol.RuntimeExecutionContextsClearedEvent) bool, action func() error) (*protocol.RuntimeExecutionContextsClearedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeExecutionContextsCleared, func() interface{} { return new(protocol.RuntimeExecutionContextsClearedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeExecutionContextsClearedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeExecutionContextsClearedEvent), nil
+}
+
+// OnRuntimeInspectRequested subscribe to Runtime.inspectRequested
+func (session *Session) OnRuntimeInspectRequested(cb func(*protocol.RuntimeInspectRequestedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventRuntimeInspectRequested, func() interface{} { return new(protocol.RuntimeInspectRequestedEvent) }, func(v interface{}) { cb(v.(*protocol.RuntimeInspectRequestedEvent)) }, onError)
+}
+
+// WaitForRuntimeInspectRequested waits for Runtime.inspectRequested matching predicate, see WaitForEvent
+func (session Session) WaitForRuntimeInspectRequested(ctx context.Context, predicate func(*protocol.RuntimeInspectRequestedEvent) bool, action func() error) (*protocol.RuntimeInspectRequestedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventRuntimeInspectRequested, func() interface{} { return new(protocol.RuntimeInspectRequestedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.RuntimeInspectRequestedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.RuntimeInspectRequestedEvent), nil
+}
+
+// OnSecurityCertificateError subscribe to Security.certificateError
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnSecurityCertificateError(cb func(*protocol.SecurityCertificateErrorEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventSecurityCertificateError, func() interface{} { return new(protocol.SecurityCertificateErrorEvent) }, func(v interface{}) { cb(v.(*protocol.SecurityCertificateErrorEvent)) }, onError)
+}
+
+// WaitForSecurityCertificateError waits for Security.certificateError matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForSecurityCertificateError(ctx context.Context, predicate func(*protocol.SecurityCertificateErrorEvent) bool, action func() error) (*protocol.SecurityCertificateErrorEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventSecurityCertificateError, func() interface{} { return new(protocol.SecurityCertificateErrorEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.SecurityCertificateErrorEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.SecurityCertificateErrorEvent), nil
+}
+
+// OnSecurityVisibleSecurityStateChanged subscribe to Security.visibleSecurityStateChanged
+func (session *Session) OnSecurityVisibleSecurityStateChanged(cb func(*protocol.SecurityVisibleSecurityStateChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventSecurityVisibleSecurityStateChanged, func() interface{} { return new(protocol.SecurityVisibleSecurityStateChangedEvent) }, func(v interface{}) { cb(v.(*protocol.SecurityVisibleSecurityStateChangedEvent)) }, onError)
+}
+
+// WaitForSecurityVisibleSecurityStateChanged waits for Security.visibleSecurityStateChanged matching predicate, see WaitForEvent
+func (session Session) WaitForSecurityVisibleSecurityStateChanged(ctx context.Context, predicate func(*protocol.SecurityVisibleSecurityStateChangedEvent) bool, action func() error) (*protocol.SecurityVisibleSecurityStateChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventSecurityVisibleSecurityStateChanged, func() interface{} { return new(protocol.SecurityVisibleSecurityStateChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.SecurityVisibleSecurityStateChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.SecurityVisibleSecurityStateChangedEvent), nil
+}
+
+// OnSecuritySecurityStateChanged subscribe to Security.securityStateChanged
+//
+// Deprecated: deprecated in protocol
+func (session *Session) OnSecuritySecurityStateChanged(cb func(*protocol.SecuritySecurityStateChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventSecuritySecurityStateChanged, func() interface{} { return new(protocol.SecuritySecurityStateChangedEvent) }, func(v interface{}) { cb(v.(*protocol.SecuritySecurityStateChangedEvent)) }, onError)
+}
+
+// WaitForSecuritySecurityStateChanged waits for Security.securityStateChanged matching predicate, see WaitForEvent
+//
+// Deprecated: deprecated in protocol
+func (session Session) WaitForSecuritySecurityStateChanged(ctx context.Context, predicate func(*protocol.SecuritySecurityStateChangedEvent) bool, action func() error) (*protocol.SecuritySecurityStateChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventSecuritySecurityStateChanged, func() interface{} { return new(protocol.SecuritySecurityStateChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.SecuritySecurityStateChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.SecuritySecurityStateChangedEvent), nil
+}
+
+// OnServiceWorkerWorkerErrorReported subscribe to ServiceWorker.workerErrorReported
+func (session *Session) OnServiceWorkerWorkerErrorReported(cb func(*protocol.ServiceWorkerWorkerErrorReportedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventServiceWorkerWorkerErrorReported, func() interface{} { return new(protocol.ServiceWorkerWorkerErrorReportedEvent) }, func(v interface{}) { cb(v.(*protocol.ServiceWorkerWorkerErrorReportedEvent)) }, onError)
+}
+
+// WaitForServiceWorkerWorkerErrorReported waits for ServiceWorker.workerErrorReported matching predicate, see WaitForEvent
+func (session Session) WaitForServiceWorkerWorkerErrorReported(ctx context.Context, predicate func(*protocol.ServiceWorkerWorkerErrorReportedEvent) bool, action func() error) (*protocol.ServiceWorkerWorkerErrorReportedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventServiceWorkerWorkerErrorReported, func() interface{} { return new(protocol.ServiceWorkerWorkerErrorReportedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.ServiceWorkerWorkerErrorReportedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ServiceWorkerWorkerErrorReportedEvent), nil
+}
+
+// OnServiceWorkerWorkerRegistrationUpdated subscribe to ServiceWorker.workerRegistrationUpdated
+func (session *Session) OnServiceWorkerWorkerRegistrationUpdated(cb func(*protocol.ServiceWorkerWorkerRegistrationUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventServiceWorkerWorkerRegistrationUpdated, func() interface{} { return new(protocol.ServiceWorkerWorkerRegistrationUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.ServiceWorkerWorkerRegistrationUpdatedEvent)) }, onError)
+}
+
+// WaitForServiceWorkerWorkerRegistrationUpdated waits for ServiceWorker.workerRegistrationUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForServiceWorkerWorkerRegistrationUpdated(ctx context.Context, predicate func(*protocol.ServiceWorkerWorkerRegistrationUpdatedEvent) bool, action func() error) (*protocol.ServiceWorkerWorkerRegistrationUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventServiceWorkerWorkerRegistrationUpdated, func() interface{} { return new(protocol.ServiceWorkerWorkerRegistrationUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.ServiceWorkerWorkerRegistrationUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ServiceWorkerWorkerRegistrationUpdatedEvent), nil
+}
+
+// OnServiceWorkerWorkerVersionUpdated subscribe to ServiceWorker.workerVersionUpdated
+func (session *Session) OnServiceWorkerWorkerVersionUpdated(cb func(*protocol.ServiceWorkerWorkerVersionUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventServiceWorkerWorkerVersionUpdated, func() interface{} { return new(protocol.ServiceWorkerWorkerVersionUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.ServiceWorkerWorkerVersionUpdatedEvent)) }, onError)
+}
+
+// WaitForServiceWorkerWorkerVersionUpdated waits for ServiceWorker.workerVersionUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForServiceWorkerWorkerVersionUpdated(ctx context.Context, predicate func(*protocol.ServiceWorkerWorkerVersionUpdatedEvent) bool, action func() error) (*protocol.ServiceWorkerWorkerVersionUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventServiceWorkerWorkerVersionUpdated, func() interface{} { return new(protocol.ServiceWorkerWorkerVersionUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.ServiceWorkerWorkerVersionUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.ServiceWorkerWorkerVersionUpdatedEvent), nil
+}
+
+// OnStorageCacheStorageContentUpdated subscribe to Storage.cacheStorageContentUpdated
+func (session *Session) OnStorageCacheStorageContentUpdated(cb func(*protocol.StorageCacheStorageContentUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageCacheStorageContentUpdated, func() interface{} { return new(protocol.StorageCacheStorageContentUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageCacheStorageContentUpdatedEvent)) }, onError)
+}
+
+// WaitForStorageCacheStorageContentUpdated waits for Storage.cacheStorageContentUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForStorageCacheStorageContentUpdated(ctx context.Context, predicate func(*protocol.StorageCacheStorageContentUpdatedEvent) bool, action func() error) (*protocol.StorageCacheStorageContentUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageCacheStorageContentUpdated, func() interface{} { return new(protocol.StorageCacheStorageContentUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageCacheStorageContentUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageCacheStorageContentUpdatedEvent), nil
+}
+
+// OnStorageCacheStorageListUpdated subscribe to Storage.cacheStorageListUpdated
+func (session *Session) OnStorageCacheStorageListUpdated(cb func(*protocol.StorageCacheStorageListUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageCacheStorageListUpdated, func() interface{} { return new(protocol.StorageCacheStorageListUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageCacheStorageListUpdatedEvent)) }, onError)
+}
+
+// WaitForStorageCacheStorageListUpdated waits for Storage.cacheStorageListUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForStorageCacheStorageListUpdated(ctx context.Context, predicate func(*protocol.StorageCacheStorageListUpdatedEvent) bool, action func() error) (*protocol.StorageCacheStorageListUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageCacheStorageListUpdated, func() interface{} { return new(protocol.StorageCacheStorageListUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageCacheStorageListUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageCacheStorageListUpdatedEvent), nil
+}
+
+// OnStorageIndexedDBContentUpdated subscribe to Storage.indexedDBContentUpdated
+func (session *Session) OnStorageIndexedDBContentUpdated(cb func(*protocol.StorageIndexedDBContentUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageIndexedDBContentUpdated, func() interface{} { return new(protocol.StorageIndexedDBContentUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageIndexedDBContentUpdatedEvent)) }, onError)
+}
+
+// WaitForStorageIndexedDBContentUpdated waits for Storage.indexedDBContentUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForStorageIndexedDBContentUpdated(ctx context.Context, predicate func(*protocol.StorageIndexedDBContentUpdatedEvent) bool, action func() error) (*protocol.StorageIndexedDBContentUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageIndexedDBContentUpdated, func() interface{} { return new(protocol.StorageIndexedDBContentUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageIndexedDBContentUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageIndexedDBContentUpdatedEvent), nil
+}
+
+// OnStorageIndexedDBListUpdated subscribe to Storage.indexedDBListUpdated
+func (session *Session) OnStorageIndexedDBListUpdated(cb func(*protocol.StorageIndexedDBListUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageIndexedDBListUpdated, func() interface{} { return new(protocol.StorageIndexedDBListUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageIndexedDBListUpdatedEvent)) }, onError)
+}
+
+// WaitForStorageIndexedDBListUpdated waits for Storage.indexedDBListUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForStorageIndexedDBListUpdated(ctx context.Context, predicate func(*protocol.StorageIndexedDBListUpdatedEvent) bool, action func() error) (*protocol.StorageIndexedDBListUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageIndexedDBListUpdated, func() interface{} { return new(protocol.StorageIndexedDBListUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageIndexedDBListUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageIndexedDBListUpdatedEvent), nil
+}
+
+// OnStorageInterestGroupAccessed subscribe to Storage.interestGroupAccessed
+func (session *Session) OnStorageInterestGroupAccessed(cb func(*protocol.StorageInterestGroupAccessedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageInterestGroupAccessed, func() interface{} { return new(protocol.StorageInterestGroupAccessedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageInterestGroupAccessedEvent)) }, onError)
+}
+
+// WaitForStorageInterestGroupAccessed waits for Storage.interestGroupAccessed matching predicate, see WaitForEvent
+func (session Session) WaitForStorageInterestGroupAccessed(ctx context.Context, predicate func(*protocol.StorageInterestGroupAccessedEvent) bool, action func() error) (*protocol.StorageInterestGroupAccessedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageInterestGroupAccessed, func() interface{} { return new(protocol.StorageInterestGroupAccessedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageInterestGroupAccessedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageInterestGroupAccessedEvent), nil
+}
+
+// OnStorageInterestGroupAuctionEventOccurred subscribe to Storage.interestGroupAuctionEventOccurred
+func (session *Session) OnStorageInterestGroupAuctionEventOccurred(cb func(*protocol.StorageInterestGroupAuctionEventOccurredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageInterestGroupAuctionEventOccurred, func() interface{} { return new(protocol.StorageInterestGroupAuctionEventOccurredEvent) }, func(v interface{}) { cb(v.(*protocol.StorageInterestGroupAuctionEventOccurredEvent)) }, onError)
+}
+
+// WaitForStorageInterestGroupAuctionEventOccurred waits for Storage.interestGroupAuctionEventOccurred matching predicate, see WaitForEvent
+func (session Session) WaitForStorageInterestGroupAuctionEventOccurred(ctx context.Context, predicate func(*protocol.StorageInterestGroupAuctionEventOccurredEvent) bool, action func() error) (*protocol.StorageInterestGroupAuctionEventOccurredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageInterestGroupAuctionEventOccurred, func() interface{} { return new(protocol.StorageInterestGroupAuctionEventOccurredEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageInterestGroupAuctionEventOccurredEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageInterestGroupAuctionEventOccurredEvent), nil
+}
+
+// OnStorageInterestGroupAuctionNetworkRequestCreated subscribe to Storage.interestGroupAuctionNetworkRequestCreated
+func (session *Session) OnStorageInterestGroupAuctionNetworkRequestCreated(cb func(*protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageInterestGroupAuctionNetworkRequestCreated, func() interface{} { return new(protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent)) }, onError)
+}
+
+// WaitForStorageInterestGroupAuctionNetworkRequestCreated waits for Storage.interestGroupAuctionNetworkRequestCreated matching predicate, see WaitForEvent
+func (session Session) WaitForStorageInterestGroupAuctionNetworkRequestCreated(ctx context.Context, predicate func(*protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent) bool, action func() error) (*protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageInterestGroupAuctionNetworkRequestCreated, func() interface{} { return new(protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageInterestGroupAuctionNetworkRequestCreatedEvent), nil
+}
+
+// OnStorageSharedStorageAccessed subscribe to Storage.sharedStorageAccessed
+func (session *Session) OnStorageSharedStorageAccessed(cb func(*protocol.StorageSharedStorageAccessedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageSharedStorageAccessed, func() interface{} { return new(protocol.StorageSharedStorageAccessedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageSharedStorageAccessedEvent)) }, onError)
+}
+
+// WaitForStorageSharedStorageAccessed waits for Storage.sharedStorageAccessed matching predicate, see WaitForEvent
+func (session Session) WaitForStorageSharedStorageAccessed(ctx context.Context, predicate func(*protocol.StorageSharedStorageAccessedEvent) bool, action func() error) (*protocol.StorageSharedStorageAccessedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageSharedStorageAccessed, func() interface{} { return new(protocol.StorageSharedStorageAccessedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageSharedStorageAccessedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageSharedStorageAccessedEvent), nil
+}
+
+// OnStorageSharedStorageWorkletOperationExecutionFinished subscribe to Storage.sharedStorageWorkletOperationExecutionFinished
+func (session *Session) OnStorageSharedStorageWorkletOperationExecutionFinished(cb func(*protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageSharedStorageWorkletOperationExecutionFinished, func() interface{} { return new(protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent)) }, onError)
+}
+
+// WaitForStorageSharedStorageWorkletOperationExecutionFinished waits for Storage.sharedStorageWorkletOperationExecutionFinished matching predicate, see WaitForEvent
+func (session Session) WaitForStorageSharedStorageWorkletOperationExecutionFinished(ctx context.Context, predicate func(*protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent) bool, action func() error) (*protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageSharedStorageWorkletOperationExecutionFinished, func() interface{} { return new(protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageSharedStorageWorkletOperationExecutionFinishedEvent), nil
+}
+
+// OnStorageStorageBucketCreatedOrUpdated subscribe to Storage.storageBucketCreatedOrUpdated
+func (session *Session) OnStorageStorageBucketCreatedOrUpdated(cb func(*protocol.StorageStorageBucketCreatedOrUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageStorageBucketCreatedOrUpdated, func() interface{} { return new(protocol.StorageStorageBucketCreatedOrUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageStorageBucketCreatedOrUpdatedEvent)) }, onError)
+}
+
+// WaitForStorageStorageBucketCreatedOrUpdated waits for Storage.storageBucketCreatedOrUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForStorageStorageBucketCreatedOrUpdated(ctx context.Context, predicate func(*protocol.StorageStorageBucketCreatedOrUpdatedEvent) bool, action func() error) (*protocol.StorageStorageBucketCreatedOrUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageStorageBucketCreatedOrUpdated, func() interface{} { return new(protocol.StorageStorageBucketCreatedOrUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageStorageBucketCreatedOrUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageStorageBucketCreatedOrUpdatedEvent), nil
+}
+
+// OnStorageStorageBucketDeleted subscribe to Storage.storageBucketDeleted
+func (session *Session) OnStorageStorageBucketDeleted(cb func(*protocol.StorageStorageBucketDeletedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageStorageBucketDeleted, func() interface{} { return new(protocol.StorageStorageBucketDeletedEvent) }, func(v interface{}) { cb(v.(*protocol.StorageStorageBucketDeletedEvent)) }, onError)
+}
+
+// WaitForStorageStorageBucketDeleted waits for Storage.storageBucketDeleted matching predicate, see WaitForEvent
+func (session Session) WaitForStorageStorageBucketDeleted(ctx context.Context, predicate func(*protocol.StorageStorageBucketDeletedEvent) bool, action func() error) (*protocol.StorageStorageBucketDeletedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageStorageBucketDeleted, func() interface{} { return new(protocol.StorageStorageBucketDeletedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageStorageBucketDeletedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageStorageBucketDeletedEvent), nil
+}
+
+// OnStorageAttributionReportingSourceRegistered subscribe to Storage.attributionReportingSourceRegistered
+func (session *Session) OnStorageAttributionReportingSourceRegistered(cb func(*protocol.StorageAttributionReportingSourceRegisteredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageAttributionReportingSourceRegistered, func() interface{} { return new(protocol.StorageAttributionReportingSourceRegisteredEvent) }, func(v interface{}) { cb(v.(*protocol.StorageAttributionReportingSourceRegisteredEvent)) }, onError)
+}
+
+// WaitForStorageAttributionReportingSourceRegistered waits for Storage.attributionReportingSourceRegistered matching predicate, see WaitForEvent
+func (session Session) WaitForStorageAttributionReportingSourceRegistered(ctx context.Context, predicate func(*protocol.StorageAttributionReportingSourceRegisteredEvent) bool, action func() error) (*protocol.StorageAttributionReportingSourceRegisteredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageAttributionReportingSourceRegistered, func() interface{} { return new(protocol.StorageAttributionReportingSourceRegisteredEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageAttributionReportingSourceRegisteredEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageAttributionReportingSourceRegisteredEvent), nil
+}
+
+// OnStorageAttributionReportingTriggerRegistered subscribe to Storage.attributionReportingTriggerRegistered
+func (session *Session) OnStorageAttributionReportingTriggerRegistered(cb func(*protocol.StorageAttributionReportingTriggerRegisteredEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageAttributionReportingTriggerRegistered, func() interface{} { return new(protocol.StorageAttributionReportingTriggerRegisteredEvent) }, func(v interface{}) { cb(v.(*protocol.StorageAttributionReportingTriggerRegisteredEvent)) }, onError)
+}
+
+// WaitForStorageAttributionReportingTriggerRegistered waits for Storage.attributionReportingTriggerRegistered matching predicate, see WaitForEvent
+func (session Session) WaitForStorageAttributionReportingTriggerRegistered(ctx context.Context, predicate func(*protocol.StorageAttributionReportingTriggerRegisteredEvent) bool, action func() error) (*protocol.StorageAttributionReportingTriggerRegisteredEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageAttributionReportingTriggerRegistered, func() interface{} { return new(protocol.StorageAttributionReportingTriggerRegisteredEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageAttributionReportingTriggerRegisteredEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageAttributionReportingTriggerRegisteredEvent), nil
+}
+
+// OnStorageAttributionReportingReportSent subscribe to Storage.attributionReportingReportSent
+func (session *Session) OnStorageAttributionReportingReportSent(cb func(*protocol.StorageAttributionReportingReportSentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageAttributionReportingReportSent, func() interface{} { return new(protocol.StorageAttributionReportingReportSentEvent) }, func(v interface{}) { cb(v.(*protocol.StorageAttributionReportingReportSentEvent)) }, onError)
+}
+
+// WaitForStorageAttributionReportingReportSent waits for Storage.attributionReportingReportSent matching predicate, see WaitForEvent
+func (session Session) WaitForStorageAttributionReportingReportSent(ctx context.Context, predicate func(*protocol.StorageAttributionReportingReportSentEvent) bool, action func() error) (*protocol.StorageAttributionReportingReportSentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageAttributionReportingReportSent, func() interface{} { return new(protocol.StorageAttributionReportingReportSentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageAttributionReportingReportSentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageAttributionReportingReportSentEvent), nil
+}
+
+// OnStorageAttributionReportingVerboseDebugReportSent subscribe to Storage.attributionReportingVerboseDebugReportSent
+func (session *Session) OnStorageAttributionReportingVerboseDebugReportSent(cb func(*protocol.StorageAttributionReportingVerboseDebugReportSentEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventStorageAttributionReportingVerboseDebugReportSent, func() interface{} { return new(protocol.StorageAttributionReportingVerboseDebugReportSentEvent) }, func(v interface{}) { cb(v.(*protocol.StorageAttributionReportingVerboseDebugReportSentEvent)) }, onError)
+}
+
+// WaitForStorageAttributionReportingVerboseDebugReportSent waits for Storage.attributionReportingVerboseDebugReportSent matching predicate, see WaitForEvent
+func (session Session) WaitForStorageAttributionReportingVerboseDebugReportSent(ctx context.Context, predicate func(*protocol.StorageAttributionReportingVerboseDebugReportSentEvent) bool, action func() error) (*protocol.StorageAttributionReportingVerboseDebugReportSentEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventStorageAttributionReportingVerboseDebugReportSent, func() interface{} { return new(protocol.StorageAttributionReportingVerboseDebugReportSentEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.StorageAttributionReportingVerboseDebugReportSentEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.StorageAttributionReportingVerboseDebugReportSentEvent), nil
+}
+
+// OnTargetAttachedToTarget subscribe to Target.attachedToTarget
+func (session *Session) OnTargetAttachedToTarget(cb func(*protocol.TargetAttachedToTargetEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetAttachedToTarget, func() interface{} { return new(protocol.TargetAttachedToTargetEvent) }, func(v interface{}) { cb(v.(*protocol.TargetAttachedToTargetEvent)) }, onError)
+}
+
+// WaitForTargetAttachedToTarget waits for Target.attachedToTarget matching predicate, see WaitForEvent
+func (session Session) WaitForTargetAttachedToTarget(ctx context.Context, predicate func(*protocol.TargetAttachedToTargetEvent) bool, action func() error) (*protocol.TargetAttachedToTargetEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetAttachedToTarget, func() interface{} { return new(protocol.TargetAttachedToTargetEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TargetAttachedToTargetEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetAttachedToTargetEvent), nil
+}
+
+// OnTargetDetachedFromTarget subscribe to Target.detachedFromTarget
+func (session *Session) OnTargetDetachedFromTarget(cb func(*protocol.TargetDetachedFromTargetEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetDetachedFromTarget, func() interface{} { return new(protocol.TargetDetachedFromTargetEvent) }, func(v interface{}) { cb(v.(*protocol.TargetDetachedFromTargetEvent)) }, onError)
+}
+
+// WaitForTargetDetachedFromTarget waits for Target.detachedFromTarget matching predicate, see WaitForEvent
+func (session Session) WaitForTargetDetachedFromTarget(ctx context.Context, predicate func(*protocol.TargetDetachedFromTargetEvent) bool, action func() error) (*protocol.TargetDetachedFromTargetEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetDetachedFromTarget, func() interface{} { return new(protocol.TargetDetachedFromTargetEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TargetDetachedFromTargetEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetDetachedFromTargetEvent), nil
+}
+
+// OnTargetReceivedMessageFromTarget subscribe to Target.receivedMessageFromTarget
+func (session *Session) OnTargetReceivedMessageFromTarget(cb func(*protocol.TargetReceivedMessageFromTargetEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetReceivedMessageFromTarget, func() interface{} { return new(protocol.TargetReceivedMessageFromTargetEvent) }, func(v interface{}) { cb(v.(*protocol.TargetReceivedMessageFromTargetEvent)) }, onError)
+}
+
+// WaitForTargetReceivedMessageFromTarget waits for Target.receivedMessageFromTarget matching predicate, see WaitForEvent
+func (session Session) WaitForTargetReceivedMessageFromTarget(ctx context.Context, predicate func(*protocol.TargetReceivedMessageFromTargetEvent) bool, action func() error) (*protocol.TargetReceivedMessageFromTargetEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetReceivedMessageFromTarget, func() interface{} { return new(protocol.TargetReceivedMessageFromTargetEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TargetReceivedMessageFromTargetEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetReceivedMessageFromTargetEvent), nil
+}
+
+// OnTargetTargetCreated subscribe to Target.targetCreated
+func (session *Session) OnTargetTargetCreated(cb func(*protocol.TargetTargetCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetTargetCreated, func() interface{} { return new(protocol.TargetTargetCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.TargetTargetCreatedEvent)) }, onError)
+}
+
+// WaitForTargetTargetCreated waits for Target.targetCreated matching predicate, see WaitForEvent
+func (session Session) WaitForTargetTargetCreated(ctx context.Context, predicate func(*protocol.TargetTargetCreatedEvent) bool, action func() error) (*protocol.TargetTargetCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetTargetCreated, func() interface{} { return new(protocol.TargetTargetCreatedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.TargetTargetCreatedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetTargetCreatedEvent), nil
+}
+
+// OnTargetTargetDestroyed subscribe to Target.targetDestroyed
+func (session *Session) OnTargetTargetDestroyed(cb func(*protocol.TargetTargetDestroyedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetTargetDestroyed, func() interface{} { return new(protocol.TargetTargetDestroyedEvent) }, func(v interface{}) { cb(v.(*protocol.TargetTargetDestroyedEvent)) }, onError)
+}
+
+// WaitForTargetTargetDestroyed waits for Target.targetDestroyed matching predicate, see WaitForEvent
+func (session Session) WaitForTargetTargetDestroyed(ctx context.Context, predicate func(*protocol.TargetTargetDestroyedEvent) bool, action func() error) (*protocol.TargetTargetDestroyedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetTargetDestroyed, func() interface{} { return new(protocol.TargetTargetDestroyedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TargetTargetDestroyedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetTargetDestroyedEvent), nil
+}
+
+// OnTargetTargetCrashed subscribe to Target.targetCrashed
+func (session *Session) OnTargetTargetCrashed(cb func(*protocol.TargetTargetCrashedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetTargetCrashed, func() interface{} { return new(protocol.TargetTargetCrashedEvent) }, func(v interface{}) { cb(v.(*protocol.TargetTargetCrashedEvent)) }, onError)
+}
+
+// WaitForTargetTargetCrashed waits for Target.targetCrashed matching predicate, see WaitForEvent
+func (session Session) WaitForTargetTargetCrashed(ctx context.Context, predicate func(*protocol.TargetTargetCrashedEvent) bool, action func() error) (*protocol.TargetTargetCrashedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetTargetCrashed, func() interface{} { return new(protocol.TargetTargetCrashedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.TargetTargetCrashedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetTargetCrashedEvent), nil
+}
+
+// OnTargetTargetInfoChanged subscribe to Target.targetInfoChanged
+func (session *Session) OnTargetTargetInfoChanged(cb func(*protocol.TargetTargetInfoChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTargetTargetInfoChanged, func() interface{} { return new(protocol.TargetTargetInfoChangedEvent) }, func(v interface{}) { cb(v.(*protocol.TargetTargetInfoChangedEvent)) }, onError)
+}
+
+// WaitForTargetTargetInfoChanged waits for Target.targetInfoChanged matching predicate, see WaitForEvent
+func (session Session) WaitForTargetTargetInfoChanged(ctx context.Context, predicate func(*protocol.TargetTargetInfoChangedEvent) bool, action func() error) (*protocol.TargetTargetInfoChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTargetTargetInfoChanged, func() interface{} { return new(protocol.TargetTargetInfoChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TargetTargetInfoChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TargetTargetInfoChangedEvent), nil
+}
+
+// OnTetheringAccepted subscribe to Tethering.accepted
+func (session *Session) OnTetheringAccepted(cb func(*protocol.TetheringAcceptedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTetheringAccepted, func() interface{} { return new(protocol.TetheringAcceptedEvent) }, func(v interface{}) { cb(v.(*protocol.TetheringAcceptedEvent)) }, onError)
+}
+
+// WaitForTetheringAccepted waits for Tethering.accepted matching predicate, see WaitForEvent
+func (session Session) WaitForTetheringAccepted(ctx context.Context, predicate func(*protocol.TetheringAcceptedEvent) bool, action func() error) (*protocol.TetheringAcceptedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTetheringAccepted, func() interface{} { return new(protocol.TetheringAcceptedEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.TetheringAcceptedEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TetheringAcceptedEvent), nil
+}
+
+// OnTracingBufferUsage subscribe to Tracing.bufferUsage
+func (session *Session) OnTracingBufferUsage(cb func(*protocol.TracingBufferUsageEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTracingBufferUsage, func() interface{} { return new(protocol.TracingBufferUsageEvent) }, func(v interface{}) { cb(v.(*protocol.TracingBufferUsageEvent)) }, onError)
+}
+
+// WaitForTracingBufferUsage waits for Tracing.bufferUsage matching predicate, see WaitForEvent
+func (session Session) WaitForTracingBufferUsage(ctx context.Context, predicate func(*protocol.TracingBufferUsageEvent) bool, action func() error) (*protocol.TracingBufferUsageEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTracingBufferUsage, func() interface{} { return new(protocol.TracingBufferUsageEvent) }, func(v interface{}) bool { return predicate == nil || predicate(v.(*protocol.TracingBufferUsageEvent)) }, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TracingBufferUsageEvent), nil
+}
+
+// OnTracingDataCollected subscribe to Tracing.dataCollected
+func (session *Session) OnTracingDataCollected(cb func(*protocol.TracingDataCollectedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTracingDataCollected, func() interface{} { return new(protocol.TracingDataCollectedEvent) }, func(v interface{}) { cb(v.(*protocol.TracingDataCollectedEvent)) }, onError)
+}
+
+// WaitForTracingDataCollected waits for Tracing.dataCollected matching predicate, see WaitForEvent
+func (session Session) WaitForTracingDataCollected(ctx context.Context, predicate func(*protocol.TracingDataCollectedEvent) bool, action func() error) (*protocol.TracingDataCollectedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTracingDataCollected, func() interface{} { return new(protocol.TracingDataCollectedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TracingDataCollectedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TracingDataCollectedEvent), nil
+}
+
+// OnTracingTracingComplete subscribe to Tracing.tracingComplete
+func (session *Session) OnTracingTracingComplete(cb func(*protocol.TracingTracingCompleteEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventTracingTracingComplete, func() interface{} { return new(protocol.TracingTracingCompleteEvent) }, func(v interface{}) { cb(v.(*protocol.TracingTracingCompleteEvent)) }, onError)
+}
+
+// WaitForTracingTracingComplete waits for Tracing.tracingComplete matching predicate, see WaitForEvent
+func (session Session) WaitForTracingTracingComplete(ctx context.Context, predicate func(*protocol.TracingTracingCompleteEvent) bool, action func() error) (*protocol.TracingTracingCompleteEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventTracingTracingComplete, func() interface{} { return new(protocol.TracingTracingCompleteEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.TracingTracingCompleteEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.TracingTracingCompleteEvent), nil
+}
+
+// OnWebAudioContextCreated subscribe to WebAudio.contextCreated
+func (session *Session) OnWebAudioContextCreated(cb func(*protocol.WebAudioContextCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioContextCreated, func() interface{} { return new(protocol.WebAudioContextCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioContextCreatedEvent)) }, onError)
+}
+
+// WaitForWebAudioContextCreated waits for WebAudio.contextCreated matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioContextCreated(ctx context.Context, predicate func(*protocol.WebAudioContextCreatedEvent) bool, action func() error) (*protocol.WebAudioContextCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioContextCreated, func() interface{} { return new(protocol.WebAudioContextCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioContextCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioContextCreatedEvent), nil
+}
+
+// OnWebAudioContextWillBeDestroyed subscribe to WebAudio.contextWillBeDestroyed
+func (session *Session) OnWebAudioContextWillBeDestroyed(cb func(*protocol.WebAudioContextWillBeDestroyedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioContextWillBeDestroyed, func() interface{} { return new(protocol.WebAudioContextWillBeDestroyedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioContextWillBeDestroyedEvent)) }, onError)
+}
+
+// WaitForWebAudioContextWillBeDestroyed waits for WebAudio.contextWillBeDestroyed matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioContextWillBeDestroyed(ctx context.Context, predicate func(*protocol.WebAudioContextWillBeDestroyedEvent) bool, action func() error) (*protocol.WebAudioContextWillBeDestroyedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioContextWillBeDestroyed, func() interface{} { return new(protocol.WebAudioContextWillBeDestroyedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioContextWillBeDestroyedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioContextWillBeDestroyedEvent), nil
+}
+
+// OnWebAudioContextChanged subscribe to WebAudio.contextChanged
+func (session *Session) OnWebAudioContextChanged(cb func(*protocol.WebAudioContextChangedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioContextChanged, func() interface{} { return new(protocol.WebAudioContextChangedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioContextChangedEvent)) }, onError)
+}
+
+// WaitForWebAudioContextChanged waits for WebAudio.contextChanged matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioContextChanged(ctx context.Context, predicate func(*protocol.WebAudioContextChangedEvent) bool, action func() error) (*protocol.WebAudioContextChangedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioContextChanged, func() interface{} { return new(protocol.WebAudioContextChangedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioContextChangedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioContextChangedEvent), nil
+}
+
+// OnWebAudioAudioListenerCreated subscribe to WebAudio.audioListenerCreated
+func (session *Session) OnWebAudioAudioListenerCreated(cb func(*protocol.WebAudioAudioListenerCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioAudioListenerCreated, func() interface{} { return new(protocol.WebAudioAudioListenerCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioAudioListenerCreatedEvent)) }, onError)
+}
+
+// WaitForWebAudioAudioListenerCreated waits for WebAudio.audioListenerCreated matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioAudioListenerCreated(ctx context.Context, predicate func(*protocol.WebAudioAudioListenerCreatedEvent) bool, action func() error) (*protocol.WebAudioAudioListenerCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioAudioListenerCreated, func() interface{} { return new(protocol.WebAudioAudioListenerCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioAudioListenerCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioAudioListenerCreatedEvent), nil
+}
+
+// OnWebAudioAudioListenerWillBeDestroyed subscribe to WebAudio.audioListenerWillBeDestroyed
+func (session *Session) OnWebAudioAudioListenerWillBeDestroyed(cb func(*protocol.WebAudioAudioListenerWillBeDestroyedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioAudioListenerWillBeDestroyed, func() interface{} { return new(protocol.WebAudioAudioListenerWillBeDestroyedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioAudioListenerWillBeDestroyedEvent)) }, onError)
+}
+
+// WaitForWebAudioAudioListenerWillBeDestroyed waits for WebAudio.audioListenerWillBeDestroyed matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioAudioListenerWillBeDestroyed(ctx context.Context, predicate func(*protocol.WebAudioAudioListenerWillBeDestroyedEvent) bool, action func() error) (*protocol.WebAudioAudioListenerWillBeDestroyedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioAudioListenerWillBeDestroyed, func() interface{} { return new(protocol.WebAudioAudioListenerWillBeDestroyedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioAudioListenerWillBeDestroyedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioAudioListenerWillBeDestroyedEvent), nil
+}
+
+// OnWebAudioAudioNodeCreated subscribe to WebAudio.audioNodeCreated
+func (session *Session) OnWebAudioAudioNodeCreated(cb func(*protocol.WebAudioAudioNodeCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioAudioNodeCreated, func() interface{} { return new(protocol.WebAudioAudioNodeCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioAudioNodeCreatedEvent)) }, onError)
+}
+
+// WaitForWebAudioAudioNodeCreated waits for WebAudio.audioNodeCreated matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioAudioNodeCreated(ctx context.Context, predicate func(*protocol.WebAudioAudioNodeCreatedEvent) bool, action func() error) (*protocol.WebAudioAudioNodeCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioAudioNodeCreated, func() interface{} { return new(protocol.WebAudioAudioNodeCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioAudioNodeCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioAudioNodeCreatedEvent), nil
+}
+
+// OnWebAudioAudioNodeWillBeDestroyed subscribe to WebAudio.audioNodeWillBeDestroyed
+func (session *Session) OnWebAudioAudioNodeWillBeDestroyed(cb func(*protocol.WebAudioAudioNodeWillBeDestroyedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioAudioNodeWillBeDestroyed, func() interface{} { return new(protocol.WebAudioAudioNodeWillBeDestroyedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioAudioNodeWillBeDestroyedEvent)) }, onError)
+}
+
+// WaitForWebAudioAudioNodeWillBeDestroyed waits for WebAudio.audioNodeWillBeDestroyed matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioAudioNodeWillBeDestroyed(ctx context.Context, predicate func(*protocol.WebAudioAudioNodeWillBeDestroyedEvent) bool, action func() error) (*protocol.WebAudioAudioNodeWillBeDestroyedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioAudioNodeWillBeDestroyed, func() interface{} { return new(protocol.WebAudioAudioNodeWillBeDestroyedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioAudioNodeWillBeDestroyedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioAudioNodeWillBeDestroyedEvent), nil
+}
+
+// OnWebAudioAudioParamCreated subscribe to WebAudio.audioParamCreated
+func (session *Session) OnWebAudioAudioParamCreated(cb func(*protocol.WebAudioAudioParamCreatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioAudioParamCreated, func() interface{} { return new(protocol.WebAudioAudioParamCreatedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioAudioParamCreatedEvent)) }, onError)
+}
+
+// WaitForWebAudioAudioParamCreated waits for WebAudio.audioParamCreated matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioAudioParamCreated(ctx context.Context, predicate func(*protocol.WebAudioAudioParamCreatedEvent) bool, action func() error) (*protocol.WebAudioAudioParamCreatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioAudioParamCreated, func() interface{} { return new(protocol.WebAudioAudioParamCreatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioAudioParamCreatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioAudioParamCreatedEvent), nil
+}
+
+// OnWebAudioAudioParamWillBeDestroyed subscribe to WebAudio.audioParamWillBeDestroyed
+func (session *Session) OnWebAudioAudioParamWillBeDestroyed(cb func(*protocol.WebAudioAudioParamWillBeDestroyedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioAudioParamWillBeDestroyed, func() interface{} { return new(protocol.WebAudioAudioParamWillBeDestroyedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioAudioParamWillBeDestroyedEvent)) }, onError)
+}
+
+// WaitForWebAudioAudioParamWillBeDestroyed waits for WebAudio.audioParamWillBeDestroyed matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioAudioParamWillBeDestroyed(ctx context.Context, predicate func(*protocol.WebAudioAudioParamWillBeDestroyedEvent) bool, action func() error) (*protocol.WebAudioAudioParamWillBeDestroyedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioAudioParamWillBeDestroyed, func() interface{} { return new(protocol.WebAudioAudioParamWillBeDestroyedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioAudioParamWillBeDestroyedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioAudioParamWillBeDestroyedEvent), nil
+}
+
+// OnWebAudioNodesConnected subscribe to WebAudio.nodesConnected
+func (session *Session) OnWebAudioNodesConnected(cb func(*protocol.WebAudioNodesConnectedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioNodesConnected, func() interface{} { return new(protocol.WebAudioNodesConnectedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioNodesConnectedEvent)) }, onError)
+}
+
+// WaitForWebAudioNodesConnected waits for WebAudio.nodesConnected matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioNodesConnected(ctx context.Context, predicate func(*protocol.WebAudioNodesConnectedEvent) bool, action func() error) (*protocol.WebAudioNodesConnectedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioNodesConnected, func() interface{} { return new(protocol.WebAudioNodesConnectedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioNodesConnectedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioNodesConnectedEvent), nil
+}
+
+// OnWebAudioNodesDisconnected subscribe to WebAudio.nodesDisconnected
+func (session *Session) OnWebAudioNodesDisconnected(cb func(*protocol.WebAudioNodesDisconnectedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioNodesDisconnected, func() interface{} { return new(protocol.WebAudioNodesDisconnectedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioNodesDisconnectedEvent)) }, onError)
+}
+
+// WaitForWebAudioNodesDisconnected waits for WebAudio.nodesDisconnected matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioNodesDisconnected(ctx context.Context, predicate func(*protocol.WebAudioNodesDisconnectedEvent) bool, action func() error) (*protocol.WebAudioNodesDisconnectedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioNodesDisconnected, func() interface{} { return new(protocol.WebAudioNodesDisconnectedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioNodesDisconnectedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioNodesDisconnectedEvent), nil
+}
+
+// OnWebAudioNodeParamConnected subscribe to WebAudio.nodeParamConnected
+func (session *Session) OnWebAudioNodeParamConnected(cb func(*protocol.WebAudioNodeParamConnectedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioNodeParamConnected, func() interface{} { return new(protocol.WebAudioNodeParamConnectedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioNodeParamConnectedEvent)) }, onError)
+}
+
+// WaitForWebAudioNodeParamConnected waits for WebAudio.nodeParamConnected matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioNodeParamConnected(ctx context.Context, predicate func(*protocol.WebAudioNodeParamConnectedEvent) bool, action func() error) (*protocol.WebAudioNodeParamConnectedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioNodeParamConnected, func() interface{} { return new(protocol.WebAudioNodeParamConnectedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioNodeParamConnectedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioNodeParamConnectedEvent), nil
+}
+
+// OnWebAudioNodeParamDisconnected subscribe to WebAudio.nodeParamDisconnected
+func (session *Session) OnWebAudioNodeParamDisconnected(cb func(*protocol.WebAudioNodeParamDisconnectedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAudioNodeParamDisconnected, func() interface{} { return new(protocol.WebAudioNodeParamDisconnectedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAudioNodeParamDisconnectedEvent)) }, onError)
+}
+
+// WaitForWebAudioNodeParamDisconnected waits for WebAudio.nodeParamDisconnected matching predicate, see WaitForEvent
+func (session Session) WaitForWebAudioNodeParamDisconnected(ctx context.Context, predicate func(*protocol.WebAudioNodeParamDisconnectedEvent) bool, action func() error) (*protocol.WebAudioNodeParamDisconnectedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAudioNodeParamDisconnected, func() interface{} { return new(protocol.WebAudioNodeParamDisconnectedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAudioNodeParamDisconnectedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAudioNodeParamDisconnectedEvent), nil
+}
+
+// OnWebAuthnCredentialAdded subscribe to WebAuthn.credentialAdded
+func (session *Session) OnWebAuthnCredentialAdded(cb func(*protocol.WebAuthnCredentialAddedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAuthnCredentialAdded, func() interface{} { return new(protocol.WebAuthnCredentialAddedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAuthnCredentialAddedEvent)) }, onError)
+}
+
+// WaitForWebAuthnCredentialAdded waits for WebAuthn.credentialAdded matching predicate, see WaitForEvent
+func (session Session) WaitForWebAuthnCredentialAdded(ctx context.Context, predicate func(*protocol.WebAuthnCredentialAddedEvent) bool, action func() error) (*protocol.WebAuthnCredentialAddedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAuthnCredentialAdded, func() interface{} { return new(protocol.WebAuthnCredentialAddedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAuthnCredentialAddedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAuthnCredentialAddedEvent), nil
+}
+
+// OnWebAuthnCredentialDeleted subscribe to WebAuthn.credentialDeleted
+func (session *Session) OnWebAuthnCredentialDeleted(cb func(*protocol.WebAuthnCredentialDeletedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAuthnCredentialDeleted, func() interface{} { return new(protocol.WebAuthnCredentialDeletedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAuthnCredentialDeletedEvent)) }, onError)
+}
+
+// WaitForWebAuthnCredentialDeleted waits for WebAuthn.credentialDeleted matching predicate, see WaitForEvent
+func (session Session) WaitForWebAuthnCredentialDeleted(ctx context.Context, predicate func(*protocol.WebAuthnCredentialDeletedEvent) bool, action func() error) (*protocol.WebAuthnCredentialDeletedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAuthnCredentialDeleted, func() interface{} { return new(protocol.WebAuthnCredentialDeletedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAuthnCredentialDeletedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAuthnCredentialDeletedEvent), nil
+}
+
+// OnWebAuthnCredentialUpdated subscribe to WebAuthn.credentialUpdated
+func (session *Session) OnWebAuthnCredentialUpdated(cb func(*protocol.WebAuthnCredentialUpdatedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAuthnCredentialUpdated, func() interface{} { return new(protocol.WebAuthnCredentialUpdatedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAuthnCredentialUpdatedEvent)) }, onError)
+}
+
+// WaitForWebAuthnCredentialUpdated waits for WebAuthn.credentialUpdated matching predicate, see WaitForEvent
+func (session Session) WaitForWebAuthnCredentialUpdated(ctx context.Context, predicate func(*protocol.WebAuthnCredentialUpdatedEvent) bool, action func() error) (*protocol.WebAuthnCredentialUpdatedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAuthnCredentialUpdated, func() interface{} { return new(protocol.WebAuthnCredentialUpdatedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAuthnCredentialUpdatedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAuthnCredentialUpdatedEvent), nil
+}
+
+// OnWebAuthnCredentialAsserted subscribe to WebAuthn.credentialAsserted
+func (session *Session) OnWebAuthnCredentialAsserted(cb func(*protocol.WebAuthnCredentialAssertedEvent), onError func(error)) (unsubscribe func()) {
+	return session.subscribeEvent(protocol.EventWebAuthnCredentialAsserted, func() interface{} { return new(protocol.WebAuthnCredentialAssertedEvent) }, func(v interface{}) { cb(v.(*protocol.WebAuthnCredentialAssertedEvent)) }, onError)
+}
+
+// WaitForWebAuthnCredentialAsserted waits for WebAuthn.credentialAsserted matching predicate, see WaitForEvent
+func (session Session) WaitForWebAuthnCredentialAsserted(ctx context.Context, predicate func(*protocol.WebAuthnCredentialAssertedEvent) bool, action func() error) (*protocol.WebAuthnCredentialAssertedEvent, error) {
+	v, err := session.waitForEvent(ctx, protocol.EventWebAuthnCredentialAsserted, func() interface{} { return new(protocol.WebAuthnCredentialAssertedEvent) }, func(v interface{}) bool {
+		return predicate == nil || predicate(v.(*protocol.WebAuthnCredentialAssertedEvent))
+	}, action)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*protocol.WebAuthnCredentialAssertedEvent), nil
+}
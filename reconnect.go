@@ -0,0 +1,322 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/gorilla/websocket"
+)
+
+// EventReconnected synthetic event that is sent to all sessions after connection was restored
+const EventReconnected = "Client.reconnected"
+
+// Reconnected params of EventReconnected
+type Reconnected struct {
+	Attempt int `json:"attempt"`
+}
+
+// ReconnectPolicy policy of reconnecting after connection was dropped
+type ReconnectPolicy struct {
+	MaxAttempts int           // how many times to dial before giving up
+	Backoff     time.Duration // delay before first attempt, doubled for every next attempt
+	MaxBackoff  time.Duration // max delay between attempts, no limit if zero
+}
+
+// requests that fail because of connection drop get error with this code, it's not a protocol one
+const connectionLostCode = -1
+
+// time limit of every call that restores session after reconnect
+const restoreTimeout = 10 * time.Second
+
+// methods which effect is bound to protocol session and is lost after re-attaching to target,
+// also every Domain.enable method is restored
+var restoredMethods = map[string]bool{
+	"Page.setLifecycleEventsEnabled":       true,
+	"Target.setDiscoverTargets":            true,
+	"Target.setAutoAttach":                 true,
+	"Network.setExtraHTTPHeaders":          true,
+	"Network.setBlockedURLs":               true,
+	"Emulation.setDeviceMetricsOverride":   true,
+	"Emulation.setUserAgentOverride":       true,
+	"Emulation.setScrollbarsHidden":        true,
+	"Emulation.setCPUThrottlingRate":       true,
+	"Emulation.setDocumentCookieDisabled":  true,
+	"Emulation.clearDeviceMetricsOverride": true,
+}
+
+// attachedSession target of session and calls to repeat after re-attach
+type attachedSession struct {
+	targetID string
+	calls    []*wsMessage
+}
+
+// reconnection state of client
+type reconnection struct {
+	mutex   *sync.Mutex
+	policy  *ReconnectPolicy
+	dial    func() (Transport, error)
+	aliases map[string]string // original session id -> session id of current connection
+	reverse map[string]string // session id of current connection -> original session id
+	pending chan struct{}     // closed when sessions are restored, nil if connection is not being restored
+}
+
+func newReconnection() *reconnection {
+	return &reconnection{
+		mutex:   &sync.Mutex{},
+		aliases: map[string]string{},
+		reverse: map[string]string{},
+	}
+}
+
+func dialWebSocket(webSocketURL string) func() (Transport, error) {
+	return func() (Transport, error) {
+		conn, _, err := websocket.DefaultDialer.Dial(webSocketURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &wsTransport{conn: conn}, nil
+	}
+}
+
+// SetReconnectPolicy enable reconnection after connection drop, nil disables it.
+// Reconnection is possible for websocket clients only, sessions are re-attached to their targets
+// and domains are enabled again, listeners stay subscribed. Session calls made meanwhile wait until target is attached again.
+// EventReconnected is sent to all sessions on success
+func (w *WSClient) SetReconnectPolicy(policy *ReconnectPolicy) {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	w.reconnection.policy = policy
+}
+
+// route returns session id of current connection
+func (w *WSClient) route(sessionID string) string {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	if alias, has := w.reconnection.aliases[sessionID]; has {
+		return alias
+	}
+	return sessionID
+}
+
+// unroute returns original session id
+func (w *WSClient) unroute(sessionID string) string {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	if original, has := w.reconnection.reverse[sessionID]; has {
+		return original
+	}
+	return sessionID
+}
+
+func (w *WSClient) setAlias(original, current string) {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	delete(w.reconnection.reverse, w.reconnection.aliases[original])
+	w.reconnection.aliases[original] = current
+	w.reconnection.reverse[current] = original
+}
+
+// restoring returns channel that is closed when sessions are re-attached, nil if there is nothing to wait
+func (w *WSClient) restoring() chan struct{} {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	return w.reconnection.pending
+}
+
+func (w *WSClient) beginRestore() {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	w.reconnection.pending = make(chan struct{})
+}
+
+func (w *WSClient) endRestore() {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	if w.reconnection.pending != nil {
+		close(w.reconnection.pending)
+		w.reconnection.pending = nil
+	}
+}
+
+// unrouteEvent rewrites session id in event params that are compared with original session id
+func (w *WSClient) unrouteEvent(response *wsResponse) {
+	if response.Method != "Target.detachedFromTarget" {
+		return
+	}
+	event := new(devtool.DetachedFromTarget)
+	if err := json.Unmarshal(response.Params, event); err != nil {
+		return
+	}
+	if original := w.unroute(event.SessionID); original != event.SessionID {
+		event.SessionID = original
+		response.Params, _ = json.Marshal(event)
+	}
+}
+
+// track remembers calls that have to be repeated after re-attach
+func (w *WSClient) track(sessionID, method string, params interface{}) {
+	if !strings.HasSuffix(method, ".enable") && !strings.HasSuffix(method, ".disable") && !restoredMethods[method] {
+		return
+	}
+	w.sessionsMutex.Lock()
+	defer w.sessionsMutex.Unlock()
+	session, has := w.sessions[sessionID]
+	if !has {
+		return
+	}
+	var key = method
+	switch {
+	case strings.HasSuffix(method, ".disable"):
+		key = strings.TrimSuffix(method, ".disable") + ".enable"
+	case method == "Emulation.clearDeviceMetricsOverride":
+		key = "Emulation.setDeviceMetricsOverride"
+	}
+	calls := session.calls[:0]
+	for _, c := range session.calls {
+		if c.Method != key {
+			calls = append(calls, c)
+		}
+	}
+	if key == method {
+		calls = append(calls, &wsMessage{Method: method, Params: params})
+	}
+	session.calls = calls
+}
+
+func (w *WSClient) isClosing() bool {
+	select {
+	case <-w.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// reconnect dials browser again according to policy, returns false if connection was not restored
+func (w *WSClient) reconnect(cause error) bool {
+	w.reconnection.mutex.Lock()
+	policy, dial := w.reconnection.policy, w.reconnection.dial
+	w.reconnection.mutex.Unlock()
+	if policy == nil || dial == nil {
+		return false
+	}
+	w.beginRestore()
+	w.logf(&LogEntry{Level: LevelProtocolErrors, Error: cause, Message: "connection lost, reconnecting"}, nil)
+	_ = w.getTransport().Close()
+	w.dropPending()
+	backoff := policy.Backoff
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		select {
+		case <-time.After(backoff):
+		case <-w.closing:
+			w.endRestore()
+			return false
+		}
+		transport, err := dial()
+		if err == nil {
+			w.setTransport(transport)
+			// reader must be running to receive responses of restoring calls
+			go w.restore(attempt)
+			return true
+		}
+		w.logf(&LogEntry{Level: LevelProtocolErrors, Error: err, Message: "reconnect attempt failed"}, nil)
+		backoff *= 2
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+	w.endRestore()
+	return false
+}
+
+// dropPending fails all requests that are waiting for response from lost connection
+func (w *WSClient) dropPending() {
+	w.queueMutex.Lock()
+	defer w.queueMutex.Unlock()
+	for id, request := range w.receive {
+		request.response <- &wsResponse{ID: id, Error: wsError{Code: connectionLostCode, Message: ErrConnectionClosed.Error()}}
+		delete(w.receive, id)
+	}
+}
+
+// restore re-attaches sessions to their targets and repeats tracked calls
+func (w *WSClient) restore(attempt int) {
+	w.sessionsMutex.Lock()
+	sessions := make(map[string]attachedSession, len(w.sessions))
+	for id, s := range w.sessions {
+		sessions[id] = attachedSession{targetID: s.targetID, calls: append([]*wsMessage{}, s.calls...)}
+	}
+	w.sessionsMutex.Unlock()
+	for id, s := range sessions {
+		if err := w.reattach(id, s); err != nil {
+			w.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: id, Error: err, Message: "session is not restored"}, nil)
+			w.publish(&wsResponse{SessionID: id, Error: wsError{Message: err.Error()}})
+		}
+	}
+	w.endRestore()
+	params, _ := json.Marshal(Reconnected{Attempt: attempt})
+	w.publish(&wsResponse{Method: EventReconnected, Params: params})
+}
+
+func (w *WSClient) reattach(sessionID string, s attachedSession) error {
+	var result = new(struct {
+		SessionID string `json:"sessionId"`
+	})
+	if err := w.call("", "Target.attachToTarget", Map{"targetId": s.targetID, "flatten": true}, result); err != nil {
+		return err
+	}
+	w.setAlias(sessionID, result.SessionID)
+	for _, c := range s.calls {
+		if err := w.call(sessionID, c.Method, c.Params, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// call sends message and waits for its result, used by client itself when there is no session
+func (w *WSClient) call(sessionID, method string, params interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+	defer cancel()
+	id, recv := w.sendOverProtocol(ctx, sessionID, method, params)
+	defer w.forget(id)
+	select {
+	case response := <-recv:
+		if response.isError() {
+			return response.Error
+		}
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(response.Result, result)
+	case <-ctx.Done():
+		return errors.New(method + " was not responded while restoring session")
+	}
+}
+
+func (w *WSClient) hasReconnectPolicy() bool {
+	w.reconnection.mutex.Lock()
+	defer w.reconnection.mutex.Unlock()
+	return w.reconnection.policy != nil && w.reconnection.dial != nil
+}
+
+// dropRequest fails request that was not sent because of connection drop
+func (w *WSClient) dropRequest(request []byte) {
+	var m = new(struct {
+		ID int64 `json:"id"`
+	})
+	if err := json.Unmarshal(request, m); err != nil {
+		return
+	}
+	w.queueMutex.Lock()
+	defer w.queueMutex.Unlock()
+	if pending, has := w.receive[m.ID]; has {
+		pending.response <- &wsResponse{ID: m.ID, Error: wsError{Code: connectionLostCode, Message: ErrConnectionClosed.Error()}}
+		delete(w.receive, m.ID)
+	}
+}
@@ -0,0 +1,244 @@
+package cdp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Direction of recorded message
+const (
+	RecordSend    = "send"
+	RecordReceive = "recv"
+	RecordEvent   = "event"
+)
+
+// Record one protocol message written by recorder, records are stored as JSON lines
+type Record struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Message   json.RawMessage `json:"message"`
+}
+
+type recorder struct {
+	mutex *sync.Mutex
+	out   io.Writer
+}
+
+func (r *recorder) record(direction string, message []byte) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.out == nil {
+		return
+	}
+	b, err := json.Marshal(Record{Time: time.Now(), Direction: direction, Message: message})
+	if err != nil {
+		return
+	}
+	_, _ = r.out.Write(append(b, '\n'))
+}
+
+// SetRecorder write every sent message, response and event to out as JSON lines, nil disables recording
+func (w *WSClient) SetRecorder(out io.Writer) {
+	w.recorder.mutex.Lock()
+	defer w.recorder.mutex.Unlock()
+	w.recorder.out = out
+}
+
+// ReplayDivergence sent message that does not match recorded session
+type ReplayDivergence struct {
+	Expected json.RawMessage // next recorded message that is not replayed yet, nil if recording is over
+	Actual   json.RawMessage
+}
+
+func (d ReplayDivergence) Error() string {
+	return fmt.Sprintf("replay diverged: expected %s, but was %s", string(d.Expected), string(d.Actual))
+}
+
+// ReplayTransport serves recorded session back instead of browser.
+// Sent message is matched with recorded one by method, session and params, then recorded
+// responses and events are delivered in recorded order. Not matched message gets error response
+type ReplayTransport struct {
+	mutex       *sync.Mutex
+	records     []*Record
+	matched     map[int]bool
+	ids         map[int64]int64 // recorded message id -> actual id
+	cursor      int
+	pending     [][]byte
+	ready       chan struct{}
+	closed      chan struct{}
+	closeOnce   *sync.Once
+	divergences []*ReplayDivergence
+}
+
+type replayMessage struct {
+	ID        int64           `json:"id"`
+	Method    string          `json:"method"`
+	SessionID string          `json:"sessionId,omitempty"`
+	Params    json.RawMessage `json:"params,omitempty"`
+}
+
+// NewReplayTransport creates transport from recording written by WSClient.SetRecorder
+func NewReplayTransport(r io.Reader) (*ReplayTransport, error) {
+	t := &ReplayTransport{
+		mutex:     &sync.Mutex{},
+		matched:   map[int]bool{},
+		ids:       map[int64]int64{},
+		ready:     make(chan struct{}, 1),
+		closed:    make(chan struct{}),
+		closeOnce: &sync.Once{},
+	}
+	decoder := json.NewDecoder(r)
+	for {
+		record := new(Record)
+		if err := decoder.Decode(record); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		t.records = append(t.records, record)
+	}
+	t.mutex.Lock()
+	t.advance()
+	t.mutex.Unlock()
+	return t, nil
+}
+
+// Divergences returns all sent messages that did not match recording
+func (t *ReplayTransport) Divergences() []*ReplayDivergence {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return append([]*ReplayDivergence{}, t.divergences...)
+}
+
+// Send ...
+func (t *ReplayTransport) Send(message []byte) error {
+	actual := new(replayMessage)
+	if err := json.Unmarshal(message, actual); err != nil {
+		return err
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var expected json.RawMessage
+	for n := t.cursor; n < len(t.records); n++ {
+		if t.records[n].Direction != RecordSend || t.matched[n] {
+			continue
+		}
+		recorded := new(replayMessage)
+		if err := json.Unmarshal(t.records[n].Message, recorded); err != nil {
+			return err
+		}
+		if expected == nil {
+			expected = t.records[n].Message
+		}
+		if sameMessage(recorded, actual) {
+			t.matched[n] = true
+			t.ids[recorded.ID] = actual.ID
+			t.advance()
+			return nil
+		}
+	}
+	divergence := &ReplayDivergence{Expected: expected, Actual: message}
+	t.divergences = append(t.divergences, divergence)
+	response, err := json.Marshal(wsResponse{
+		ID:        actual.ID,
+		SessionID: actual.SessionID,
+		Error:     wsError{Code: -32000, Message: divergence.Error()},
+	})
+	if err != nil {
+		return err
+	}
+	t.deliver(response)
+	return nil
+}
+
+func sameMessage(recorded, actual *replayMessage) bool {
+	if recorded.Method != actual.Method || recorded.SessionID != actual.SessionID {
+		return false
+	}
+	var p1, p2 interface{}
+	if len(recorded.Params) != 0 {
+		if err := json.Unmarshal(recorded.Params, &p1); err != nil {
+			return false
+		}
+	}
+	if len(actual.Params) != 0 {
+		if err := json.Unmarshal(actual.Params, &p2); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(p1, p2)
+}
+
+// advance delivers recorded responses and events until first not yet sent message
+func (t *ReplayTransport) advance() {
+	for ; t.cursor < len(t.records); t.cursor++ {
+		record := t.records[t.cursor]
+		switch record.Direction {
+		case RecordSend:
+			if !t.matched[t.cursor] {
+				return
+			}
+		case RecordReceive:
+			t.deliver(t.rewriteID(record.Message))
+		default:
+			t.deliver(record.Message)
+		}
+	}
+}
+
+// rewriteID replaces recorded message id with actual one
+func (t *ReplayTransport) rewriteID(message json.RawMessage) []byte {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(message, &m); err != nil {
+		return message
+	}
+	var id int64
+	if err := json.Unmarshal(m["id"], &id); err != nil {
+		return message
+	}
+	if actual, has := t.ids[id]; has {
+		m["id"], _ = json.Marshal(actual)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return message
+	}
+	return b
+}
+
+func (t *ReplayTransport) deliver(message []byte) {
+	t.pending = append(t.pending, message)
+	select {
+	case t.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Receive ...
+func (t *ReplayTransport) Receive() ([]byte, error) {
+	for {
+		t.mutex.Lock()
+		if len(t.pending) > 0 {
+			message := t.pending[0]
+			t.pending = t.pending[1:]
+			t.mutex.Unlock()
+			return message, nil
+		}
+		t.mutex.Unlock()
+		select {
+		case <-t.ready:
+		case <-t.closed:
+			return nil, io.EOF
+		}
+	}
+}
+
+// Close ...
+func (t *ReplayTransport) Close() error {
+	t.closeOnce.Do(func() { close(t.closed) })
+	return nil
+}
@@ -1,6 +1,8 @@
 package cdp
 
 import (
+	"fmt"
+
 	"github.com/ecwid/cdp/pkg/devtool"
 )
 
@@ -70,6 +72,49 @@ func (session Runtime) callFunctionOn(objectID string, functionDeclaration strin
 	return result.Result, nil
 }
 
+// errNoDocument default execution context has no document to call function on, e.g. while page is navigated
+var errNoDocument = fmt.Errorf("%w: execution context has no document", ErrStaleElementReference)
+
+// callFunctionIn calls function in execution context, zero context is default one of current frame,
+// function is called on its document if the default context is not known from frame tree yet
+func (session Runtime) callFunctionIn(contextID int64, functionDeclaration string, awaitPromise, returnByValue bool, arg ...interface{}) (*devtool.RemoteObject, error) {
+	// map keeps zero values of arguments which are omitted by CallArgument
+	args := make([]Map, len(arg))
+	for i, a := range arg {
+		args[i] = Map{"value": a}
+	}
+	p := Map{
+		"functionDeclaration": functionDeclaration,
+		"arguments":           args,
+		"awaitPromise":        awaitPromise,
+		"returnByValue":       returnByValue,
+	}
+	if contextID == 0 {
+		contextID = session.defaultContext()
+	}
+	if contextID != 0 {
+		p["executionContextId"] = contextID
+	} else {
+		doc, err := session.evaluate("document", 0, false, false)
+		if err != nil {
+			return nil, err
+		}
+		if doc.ObjectID == "" {
+			return nil, errNoDocument
+		}
+		defer func() { _ = session.releaseObject(doc.ObjectID) }()
+		p["objectId"] = doc.ObjectID
+	}
+	result := new(devtool.EvaluatesResult)
+	if err := session.call("Runtime.callFunctionOn", p, result); err != nil {
+		return nil, err
+	}
+	if result.ExceptionDetails != nil {
+		return nil, result.ExceptionDetails
+	}
+	return result.Result, nil
+}
+
 func (session Runtime) releaseObject(objectID string) error {
 	return session.call("Runtime.releaseObject", Map{"objectId": objectID}, nil)
 }
@@ -0,0 +1,134 @@
+package cdp
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+// Selector engines, every engine is JS function(root, selector) returning iterable of matching elements
+const (
+	engineCSS    = `function(r,s){return r.querySelectorAll(s)}`
+	engineXPath  = `function(r,s){const d=r.ownerDocument||r,x=d.evaluate(s,r,null,XPathResult.ORDERED_NODE_SNAPSHOT_TYPE,null),n=[];for(let i=0;i<x.snapshotLength;i++)n.push(x.snapshotItem(i));return n}`
+	engineTestID = `function(r,s){return r.querySelectorAll('[data-testid="'+CSS.escape(s)+'"]')}`
+	// text="Exact text" matches whole normalized text, otherwise case-insensitive substring; the deepest matching elements are returned
+	engineText = `function(r,s){const n=t=>(t||"").replace(/\s+/g," ").trim(),q=s.length>1&&s[0]==='"'&&s[s.length-1]==='"',v=q?n(s.slice(1,-1)):n(s).toLowerCase(),m=e=>{const t=n(void 0!==e.innerText?e.innerText:e.textContent);return q?t===v:t.toLowerCase().includes(v)},x=["SCRIPT","STYLE","NOSCRIPT","HEAD","TITLE"];return Array.from(r.querySelectorAll("*")).filter(e=>!x.includes(e.nodeName)&&m(e)&&!Array.from(e.children).some(c=>!x.includes(c.nodeName)&&m(c)))}`
+	// role=button[name="Submit"] matches explicit or implicit ARIA role and exact accessible name, [name="sub" i] matches case-insensitive substring of name
+	engineRole = `function(r,s){const p=s.match(/^([\w-]+)\s*(?:\[\s*name\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\]\s]*))\s*(i)?\s*\])?$/);if(!p)throw new Error("invalid role selector: "+s);const o=p[1].toLowerCase(),w=void 0!==p[2]?p[2]:void 0!==p[3]?p[3]:p[4],n=t=>(t||"").replace(/\s+/g," ").trim(),g=e=>{const t=e.localName,y=(e.getAttribute("type")||"").toLowerCase();switch(t){case"a":case"area":return e.hasAttribute("href")?"link":"";case"button":return"button";case"input":return["button","submit","reset","image"].includes(y)?"button":"checkbox"===y?"checkbox":"radio"===y?"radio":"range"===y?"slider":"number"===y?"spinbutton":"hidden"===y?"":e.hasAttribute("list")?"combobox":"search"===y?"searchbox":"textbox";case"textarea":return"textbox";case"select":return e.multiple||e.size>1?"listbox":"combobox";case"option":return"option";case"h1":case"h2":case"h3":case"h4":case"h5":case"h6":return"heading";case"img":return""===e.getAttribute("alt")?"presentation":"img";case"ul":case"ol":return"list";case"li":return"listitem";case"nav":return"navigation";case"main":return"main";case"header":return"banner";case"footer":return"contentinfo";case"aside":return"complementary";case"form":return"form";case"dialog":return"dialog";case"table":return"table";case"tr":return"row";case"td":return"cell";case"th":return"columnheader";case"article":return"article";case"hr":return"separator";case"progress":return"progressbar"}return""},l=e=>{const d=e.getRootNode();if(e.hasAttribute("aria-labelledby"))return n(e.getAttribute("aria-labelledby").split(/\s+/).map(i=>{const b=d.getElementById(i);return b?b.textContent:""}).join(" "));if(e.hasAttribute("aria-label"))return n(e.getAttribute("aria-label"));if(e.labels&&e.labels.length)return n(Array.from(e.labels).map(b=>b.textContent).join(" "));if("img"===e.localName||"area"===e.localName||"image"===e.type)return n(e.getAttribute("alt"));if("input"===e.localName&&["button","submit","reset"].includes(e.type))return n(e.value);return n(e.innerText||e.textContent)||n(e.getAttribute("title"))};return Array.from(r.querySelectorAll("*")).filter(e=>{if(((e.getAttribute("role")||"").trim().split(/\s+/)[0]||g(e))!==o)return!1;if(void 0===w)return!0;const b=l(e);return p[5]?b.toLowerCase().includes(w.toLowerCase()):b===n(w)})}`
+	// pierce=selector matches css selector in document and in all open shadow roots
+	enginePierce = `function(r,s){const f=[],w=n=>{for(const e of n.querySelectorAll(s))f.push(e);for(const e of n.querySelectorAll("*"))e.shadowRoot&&w(e.shadowRoot)};w(r);return f}`
+	// selectorQuery calls engines of parts on element or on document, every next part is queried in shadow roots of previous part's matches;
+	// p is array of [engine index, selector] and %s is list of engines
+	selectorQuery = `function(p,a){const g=[%s];let r=[this&&this.nodeType?this:document];for(let i=0;i<p.length;i++){const n=new Set;for(const o of r){const k=i?o.shadowRoot:o;if(k)for(const e of Array.from(g[p[i][0]](k,p[i][1])||[]))e&&1===e.nodeType&&n.add(e)}r=Array.from(n)}return a?r:r[0]||null}`
+)
+
+// registry of selector engines by name, selector "name=body" is queried by engine name
+var selectorEngines = struct {
+	mutex   *sync.RWMutex
+	engines map[string]string
+}{
+	mutex: &sync.RWMutex{},
+	engines: map[string]string{
+		"css":         engineCSS,
+		"xpath":       engineXPath,
+		"text":        engineText,
+		"role":        engineRole,
+		"data-testid": engineTestID,
+		"pierce":      enginePierce,
+	},
+}
+
+// RegisterSelectorEngine sets engine used by selectors "name=...",
+// source is JS function(root, selector) returning array or NodeList of matching elements of root (element or document)
+func RegisterSelectorEngine(name, source string) {
+	selectorEngines.mutex.Lock()
+	defer selectorEngines.mutex.Unlock()
+	selectorEngines.engines[name] = source
+}
+
+// shadowCombinator separates parts of selector, right part is queried in open shadow roots of elements matched by left one
+const shadowCombinator = ">>>"
+
+// selectorEngine returns engine's source and selector without engine prefix,
+// selectors without known prefix are css ones except of ones starting with // which are xpath
+func selectorEngine(selector string) (string, string) {
+	selectorEngines.mutex.RLock()
+	defer selectorEngines.mutex.RUnlock()
+	if i := strings.Index(selector, "="); i > 0 {
+		if source, has := selectorEngines.engines[strings.TrimSpace(selector[:i])]; has {
+			return source, strings.TrimSpace(selector[i+1:])
+		}
+	}
+	if strings.HasPrefix(selector, "//") || strings.HasPrefix(selector, "(//") {
+		return engineXPath, selector
+	}
+	return engineCSS, selector
+}
+
+// splitSelector splits selector by shadow combinators which are outside of quotes and brackets,
+// so text=">>>" or [title='a >>> b'] are not split
+func splitSelector(selector string) []string {
+	var (
+		parts []string
+		quote byte
+		depth int
+		start int
+	)
+	for i := 0; i < len(selector); i++ {
+		switch c := selector[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case (c == ']' || c == ')') && depth > 0:
+			depth--
+		case depth == 0 && strings.HasPrefix(selector[i:], shadowCombinator):
+			parts = append(parts, selector[start:i])
+			i += len(shadowCombinator) - 1
+			start = i + 1
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+// selectorQueryArgs returns engines used by selector and its parts as [engine index, selector] pairs
+func selectorQueryArgs(selector string) ([]string, [][]interface{}) {
+	var (
+		engines []string
+		parts   [][]interface{}
+	)
+	for _, part := range splitSelector(selector) {
+		source, body := selectorEngine(strings.TrimSpace(part))
+		index := -1
+		for n, e := range engines {
+			if e == source {
+				index = n
+				break
+			}
+		}
+		if index == -1 {
+			index = len(engines)
+			engines = append(engines, source)
+		}
+		parts = append(parts, []interface{}{index, body})
+	}
+	return engines, parts
+}
+
+// selectorCall queries selector in parent, or in document of execution context if parent is nil
+func (session Session) selectorCall(context int64, parent *Element, selector string, all bool) (*devtool.RemoteObject, error) {
+	engines, parts := selectorQueryArgs(selector)
+	functionDeclaration := fmt.Sprintf(selectorQuery, strings.Join(engines, ","))
+	if parent != nil {
+		return session.callFunctionOn(parent.ID, functionDeclaration, false, false, parts, all)
+	}
+	return session.callFunctionIn(context, functionDeclaration, false, false, parts, all)
+}
@@ -2,14 +2,15 @@ package cdp
 
 import (
 	"container/list"
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"reflect"
 	"sync"
 	"time"
 
 	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
 )
 
 // Map ...
@@ -17,46 +18,75 @@ type Map map[string]interface{}
 
 // Session ...
 type Session struct {
+	ctx         context.Context
 	ws          *WSClient
 	id          string
 	state       *state
 	target      string
-	broadcast   chan *wsBroadcast
+	broadcast   *eventQueue
 	closed      chan struct{}
 	err         chan error
 	deadline    time.Duration
 	eventsMutex *sync.Mutex
 	listeners   map[string]*list.List
+	crash       *crashState
+	children    *children
+	frames      *frameTree
 }
 
 func newSession(ws *WSClient) *Session {
 	return &Session{
+		ctx:         context.Background(),
 		id:          "",
 		ws:          ws,
 		eventsMutex: &sync.Mutex{},
 		state:       newState(),
 		listeners:   map[string]*list.List{},
-		broadcast:   make(chan *wsBroadcast, 10),
+		broadcast:   newEventQueue(),
 		closed:      make(chan struct{}, 1),
 		err:         make(chan error, 1),
 		deadline:    60 * time.Second,
+		crash:       newCrashState(),
+		children:    newChildren(),
+		frames:      newFrameTree(),
 	}
 }
 
 // NewSession ...
+// Context of parent session is used while attaching, new session itself is not bound to it
 func NewSession(session *Session, target string) (*Session, error) {
 	newsess := newSession(session.ws)
+	newsess.ctx = session.Context()
 	err := newsess.attachToTarget(target)
+	newsess.ctx = context.Background()
 	return newsess, err
 }
 
+// WithContext returns shallow copy of session which calls are cancelled by ctx.
+// Copy shares connection, state and event listeners with original session
+func (session Session) WithContext(ctx context.Context) *Session {
+	if ctx == nil {
+		panic("nil context")
+	}
+	session.ctx = ctx
+	return &session
+}
+
+// Context returns session's context
+func (session Session) Context() context.Context {
+	if session.ctx == nil {
+		return context.Background()
+	}
+	return session.ctx
+}
+
 // ID session's ID
 func (session Session) ID() string {
 	return session.target
 }
 
 func (session Session) exception(err error) {
-	session.ws.printf(LevelProtocolFatal, err.Error())
+	session.ws.logf(&LogEntry{Level: LevelProtocolFatal, SessionID: session.id, Error: err}, nil)
 	select {
 	case <-session.closed:
 		return
@@ -65,25 +95,42 @@ func (session Session) exception(err error) {
 	}
 }
 
-func (session *Session) attachToTarget(targetID string) error {
-	var result = make(Map)
-	err := session.call("Target.attachToTarget", Map{"targetId": targetID, "flatten": true}, &result)
+// attach attaches to target and starts listener, domains are not enabled
+func (session *Session) attach(targetID string) error {
+	result, err := (&protocol.TargetAttachToTargetParams{
+		TargetID: protocol.TargetTargetID(targetID),
+		Flatten:  true,
+	}).Do(session)
 	if err != nil {
 		return err
 	}
 	session.target = targetID
-	session.id = result["sessionId"].(string)
-	session.ws.register(session.id, session.broadcast)
+	session.id = string(result.SessionID)
+	session.ws.register(session.id, session.target, session.broadcast)
 	go session.listener()
+	return nil
+}
+
+func (session *Session) attachToTarget(targetID string) error {
+	err := session.attach(targetID)
+	if err != nil {
+		return err
+	}
 
 	session.state.reset()
 
 	if err := session.call("Target.setDiscoverTargets", Map{"discover": true}, nil); err != nil {
 		return err
 	}
+	if err = session.setAutoAttach(); err != nil {
+		return err
+	}
 	if err = session.call("Page.enable", nil, nil); err != nil {
 		return err
 	}
+	if err = session.loadFrameTree(); err != nil {
+		return err
+	}
 	if err = session.call("Runtime.enable", nil, nil); err != nil {
 		return err
 	}
@@ -101,39 +148,51 @@ func (session Session) listener() {
 	defer func() {
 		close(session.closed)
 		session.ws.unregister(session.id)
+		session.broadcast.close()
+		session.stopSubscribers()
+		session.detachChildren()
 	}()
-	for e := range session.broadcast {
+	for {
+		e, ok := session.broadcast.pop()
+		if !ok {
+			return
+		}
 
 		if e.Error != "" {
 			session.exception(errors.New(e.Error))
 			return
 		}
 
-		session.eventsMutex.Lock()
-		if list, has := session.listeners[e.Method]; has {
-			for p := list.Front(); p != nil; p = p.Next() {
-				p.Value.(func(*Event))(&e.Event)
+		switch e.Method {
+		case "Target.targetCrashed":
+			session.targetCrashed(e.Params)
+		case "Target.attachedToTarget":
+			// child must be registered before its own events arrive
+			session.attachedToTarget(e.Params)
+		case "Target.detachedFromTarget":
+			session.detachedFromTarget(e.Params)
+		case "Page.frameAttached", "Page.frameNavigated", "Page.frameDetached",
+			"Runtime.executionContextCreated", "Runtime.executionContextDestroyed", "Runtime.executionContextsCleared":
+			if err := session.frames.update(e.Method, e.Params); err != nil {
+				session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: e.Method, Error: err}, e.Params)
 			}
 		}
-		session.eventsMutex.Unlock()
+
+		session.dispatch(&e.Event)
 
 		switch e.Method {
 
 		case "Runtime.executionContextCreated":
 			c := new(devtool.ExecutionContextCreated)
 			if err := json.Unmarshal(e.Params, c); err != nil {
-				session.exception(err)
+				session.ws.logf(&LogEntry{Level: LevelProtocolErrors, SessionID: session.id, Method: e.Method, Error: err}, e.Params)
+				continue
 			}
-			var fid = c.Context.AuxData["frameId"].(string)
-			if fid == session.state.GetFrame() {
+			// contexts of workers have no frame
+			if fid, ok := c.Context.AuxData["frameId"].(string); ok && fid == session.state.GetFrame() {
 				session.state.set(fid, c.Context.ID)
 			}
 
-		case "Target.targetCrashed":
-			session.exception(errors.New(string(e.Params)))
-			// ws client will be disconnected
-			return
-
 		case "Target.targetDestroyed":
 			event := new(devtool.TargetDestroyed)
 			if err := json.Unmarshal(e.Params, event); err != nil {
@@ -160,25 +219,75 @@ func (session Session) listener() {
 }
 
 func (session Session) blockingSend(method string, params interface{}) ([]byte, error) {
-	recv := session.ws.sendOverProtocol(session.id, method, params)
+	ctx := session.Context()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// commands are allowed when target has already crashed, e.g. Page.reload
+	var crashed chan struct{}
+	incident, crashErr := session.crash.current()
+	if crashErr == nil {
+		crashed = incident.done
+	}
+	timeout := time.After(session.deadline)
+	// after reconnect target must be attached again before session can send anything
+	if restored := session.ws.restoring(); restored != nil {
+		select {
+		case <-restored:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-session.closed:
+			return nil, ErrSessionAlreadyClosed
+		case <-session.ws.disconnected:
+			return nil, ErrConnectionClosed
+		case <-timeout:
+			return nil, &TimeoutError{Method: method, Params: params, Deadline: session.deadline}
+		}
+	}
+	id, recv := session.ws.sendOverProtocol(ctx, session.callSessionID(method), method, params)
+	defer session.ws.forget(id)
 	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	case err := <-session.err:
 		return nil, err
 	case <-session.closed:
 		return nil, ErrSessionAlreadyClosed
+	case <-crashed:
+		return nil, incident.err
 	case response := <-recv:
-		if response.Error.Code != 0 {
-			switch response.Error.Message {
-			case "Cannot find context with specified id":
-				return nil, ErrStaleElementReference
-			default:
-				return nil, response.Error
-			}
+		return session.result(method, params, response)
+	case <-session.ws.disconnected:
+		// response could be received just before connection was closed
+		select {
+		case response := <-recv:
+			return session.result(method, params, response)
+		default:
+			return nil, ErrConnectionClosed
+		}
+	case <-timeout:
+		return nil, &TimeoutError{Method: method, Params: params, Deadline: session.deadline}
+	}
+}
+
+func (session Session) result(method string, params interface{}, response *wsResponse) ([]byte, error) {
+	if response.isError() {
+		return nil, &ProtocolError{
+			Code:      response.Error.Code,
+			Message:   response.Error.Message,
+			Data:      response.Error.Data,
+			Method:    method,
+			Params:    params,
+			SessionID: session.id,
 		}
-		return response.Result, nil
-	case <-time.After(session.deadline):
-		return nil, fmt.Errorf("websocket response timeout was reached %s for %s(%+v)", session.deadline.String(), method, params)
 	}
+	return response.Result, nil
+}
+
+// Call sends protocol command and decodes its result into resp, resp can be nil.
+// Session implements protocol.Executor, so typed bindings can be used as (&protocol.PageReloadParams{}).Do(session)
+func (session Session) Call(method string, req interface{}, resp interface{}) error {
+	return session.call(method, req, resp)
 }
 
 func (session Session) call(method string, req interface{}, resp interface{}) error {
@@ -192,21 +301,6 @@ func (session Session) call(method string, req interface{}, resp interface{}) er
 	return json.Unmarshal(b, resp)
 }
 
-// Subscribe subscribe to CDP event
-func (session *Session) Subscribe(method string, cb func(event *Event)) (unsubscribe func()) {
-	session.eventsMutex.Lock()
-	defer session.eventsMutex.Unlock()
-	if _, has := session.listeners[method]; !has {
-		session.listeners[method] = list.New()
-	}
-	p := session.listeners[method].PushBack(cb)
-	return func() {
-		session.eventsMutex.Lock()
-		defer session.eventsMutex.Unlock()
-		session.listeners[method].Remove(p)
-	}
-}
-
 // GetTargets ....
 func (session Session) GetTargets() ([]*devtool.TargetInfo, error) {
 	var info = new(devtool.TargetInfos)
@@ -231,16 +325,16 @@ func (session Session) GetTimeout() time.Duration {
 	return session.deadline
 }
 
-// SetOutLevel ...
+// SetOutLevel set log level of this session only, other sessions of client are not affected
 func (session *Session) SetOutLevel(level OutLevel) {
-	session.ws.outLevel = level
+	session.ws.setSessionLogLevel(session.id, level)
 }
 
 // Close close this sessions
 func (session Session) Close() error {
 	err := session.call("Target.closeTarget", Map{"targetId": session.target}, nil)
 	// event 'Target.targetDestroyed' can be received early than message response
-	if err == ErrSessionAlreadyClosed {
+	if errors.Is(err, ErrSessionAlreadyClosed) || errors.Is(err, ErrTargetClosed) {
 		return nil
 	}
 	return err
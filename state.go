@@ -1,6 +1,7 @@
 package cdp
 
 import (
+	"strings"
 	"sync"
 )
 
@@ -8,6 +9,7 @@ type state struct {
 	sync.Mutex
 	context int64
 	frame   string
+	session string // session of out-of-process frame which frame scoped calls are sent to
 }
 
 func newState() *state {
@@ -32,8 +34,43 @@ func (l *state) set(frameID string, contextID int64) {
 	l.context = contextID
 }
 
+// setSession switch to out-of-process frame, frame scoped calls are sent to its own session in its default context
+func (l *state) setSession(frameID, sessionID string) {
+	l.Lock()
+	defer l.Unlock()
+	l.frame = frameID
+	l.context = 0
+	l.session = sessionID
+}
+
 func (l *state) reset() {
-	l.set("", 0)
+	l.setSession("", "")
+}
+
+// frameDomains domains which calls are scoped to frame, they are sent to session of out-of-process frame after switching to it
+var frameDomains = map[string]bool{
+	"Runtime": true,
+	"DOM":     true,
+}
+
+// switchedSession session of out-of-process frame which was switched to, empty for main frame
+func (session Session) switchedSession() string {
+	session.state.Lock()
+	defer session.state.Unlock()
+	return session.state.session
+}
+
+// callSessionID session which method is sent to, frame scoped calls go to session of out-of-process frame after switching to it,
+// other ones (Page, Input, Emulation, Target...) are sent to page's own session
+func (session Session) callSessionID(method string) string {
+	domain := method
+	if i := strings.IndexByte(method, '.'); i > 0 {
+		domain = method[:i]
+	}
+	if s := session.switchedSession(); s != "" && frameDomains[domain] {
+		return s
+	}
+	return session.id
 }
 
 func (session *Session) currentContext() int64 {
@@ -42,9 +79,23 @@ func (session *Session) currentContext() int64 {
 	return session.state.context
 }
 
+// defaultContext returns default execution context of current main or out-of-process frame from frame tree, zero if it is not known yet
+func (session Session) defaultContext() int64 {
+	session.state.Lock()
+	frameID, sessionID := session.state.frame, session.state.session
+	session.state.Unlock()
+	if sessionID == "" {
+		return session.frames.context(session.target, "")
+	}
+	if s := session.frameSession(frameID); s != nil {
+		return s.frames.context(frameID, "")
+	}
+	return 0
+}
+
 func (session *Session) createContext(frameID string) (int64, error) {
 	if frameID != "" {
-		session.ws.printf(LevelSessionState, "create_context for %s", frameID)
+		session.ws.logf(&LogEntry{Level: LevelSessionState, SessionID: session.id, Message: "create_context for " + frameID}, nil)
 		return session.createIsolatedWorld(frameID, "my-current-frame-context")
 	}
 	return 0, nil
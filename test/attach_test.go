@@ -0,0 +1,149 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+// waitFor polls cond until it is true or second is passed
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition was not met")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAutoAttach(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	if len(server.Received("Target.setAutoAttach")) != 1 {
+		t.Fatal("Target.setAutoAttach was not called")
+	}
+	frame := &devtool.TargetInfo{TargetID: "oopif-1", Type: "iframe", URL: "https://example.org/"}
+	worker := &devtool.TargetInfo{TargetID: "worker-1", Type: "worker"}
+	check(t, server.Emit(sess.GetID(), "Target.attachedToTarget", devtool.AttachedToTarget{SessionID: "child-frame", TargetInfo: frame, WaitingForDebugger: true}))
+	check(t, server.Emit(sess.GetID(), "Target.attachedToTarget", devtool.AttachedToTarget{SessionID: "child-worker", TargetInfo: worker}))
+	waitFor(t, func() bool { return len(sess.Frames()) == 1 && len(sess.Workers()) == 1 })
+	waitFor(t, func() bool {
+		for _, m := range server.Received("Runtime.runIfWaitingForDebugger") {
+			if m.SessionID == "child-frame" {
+				return true
+			}
+		}
+		return false
+	})
+
+	// calls are sent to session of out-of-process frame after switching
+	check(t, sess.SwitchTo("oopif-1"))
+	_, err := sess.Evaluate("1", false, true)
+	check(t, err)
+	sess.Main()
+	_, err = sess.Evaluate("2", false, true)
+	check(t, err)
+	evaluated := server.Received("Runtime.evaluate")
+	if len(evaluated) != 2 || evaluated[0].SessionID != "child-frame" || evaluated[1].SessionID != sess.GetID() {
+		t.Fatalf("unexpected routing of calls %+v", evaluated)
+	}
+
+	// calls of page are sent to page's session after switching
+	check(t, sess.SwitchTo("oopif-1"))
+	_, err = (&protocol.PageNavigateParams{URL: "https://example.com/"}).Do(sess)
+	check(t, err)
+	sess.Main()
+	if navigated := server.Received("Page.navigate"); len(navigated) != 1 || navigated[0].SessionID != sess.GetID() {
+		t.Fatalf("page is navigated in %+v", navigated)
+	}
+
+	check(t, server.Emit("", "Target.detachedFromTarget", devtool.DetachedFromTarget{SessionID: "child-frame"}))
+	waitFor(t, func() bool { return len(sess.Frames()) == 0 })
+}
+
+func TestServiceWorkers(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.AddTarget("service_worker", "https://example.com/sw.js")
+	chrome, err := cdp.ConnectHTTP(server.URL)
+	check(t, err)
+	defer chrome.Close()
+	workers, err := chrome.ServiceWorkers()
+	check(t, err)
+	if len(workers) != 1 {
+		t.Fatalf("%d service workers", len(workers))
+	}
+}
+
+func TestWorkerExecutionContext(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	worker := &devtool.TargetInfo{TargetID: "worker-1", Type: "worker"}
+	check(t, server.Emit(sess.GetID(), "Target.attachedToTarget", devtool.AttachedToTarget{SessionID: "child-worker", TargetInfo: worker}))
+	waitFor(t, func() bool { return len(sess.Workers()) == 1 })
+	// contexts of workers have no frameId in auxData
+	context := devtool.ExecutionContextCreated{Context: &devtool.ExecutionContextDescription{ID: 7}}
+	check(t, server.Emit("child-worker", "Runtime.executionContextCreated", context))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", context))
+	_, err := sess.Evaluate("1", false, true)
+	check(t, err)
+	if sess.IsClosed() {
+		t.Fatal("session was closed")
+	}
+}
+
+func TestAutoAttachResumeOnError(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	server.Handle("Page.enable", func(m *cdptest.Message) (interface{}, error) {
+		if m.SessionID == "child-frame" {
+			return nil, &cdptest.Error{Code: -32000, Message: "Page.enable failed"}
+		}
+		return nil, nil
+	})
+	frame := &devtool.TargetInfo{TargetID: "oopif-1", Type: "iframe", URL: "https://example.org/"}
+	check(t, server.Emit(sess.GetID(), "Target.attachedToTarget", devtool.AttachedToTarget{SessionID: "child-frame", TargetInfo: frame, WaitingForDebugger: true}))
+	waitFor(t, func() bool {
+		for _, m := range server.Received("Runtime.runIfWaitingForDebugger") {
+			if m.SessionID == "child-frame" {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func TestServiceWorkersDetachOnError(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.AddTarget("service_worker", "https://example.com/sw.js")
+	second := server.AddTarget("service_worker", "https://example.org/sw.js")
+	server.Handle("Runtime.enable", func(m *cdptest.Message) (interface{}, error) {
+		if m.SessionID == cdptest.SessionID(second.TargetID) {
+			return nil, &cdptest.Error{Code: -32000, Message: "Runtime.enable failed"}
+		}
+		return nil, nil
+	})
+	chrome, err := cdp.ConnectHTTP(server.URL)
+	check(t, err)
+	defer chrome.Close()
+	if _, err = chrome.ServiceWorkers(); err == nil {
+		t.Fatal("error is expected")
+	}
+	if n := len(server.Received("Target.detachFromTarget")); n != 2 {
+		t.Fatalf("%d service workers detached", n)
+	}
+}
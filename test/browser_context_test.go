@@ -0,0 +1,81 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+func TestBrowserContext(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.Handle("Target.createBrowserContext", func(*cdptest.Message) (interface{}, error) {
+		return devtool.BrowserContext{BrowserContextID: "context-1"}, nil
+	})
+	server.Handle("Storage.getCookies", func(m *cdptest.Message) (interface{}, error) {
+		var p struct {
+			BrowserContextID string `json:"browserContextId"`
+		}
+		check(t, m.Decode(&p))
+		if p.BrowserContextID != "context-1" {
+			return devtool.GetCookies{}, nil
+		}
+		return devtool.GetCookies{Cookies: []*devtool.Cookie{{Name: "sid", Value: "1", Domain: "example.com"}}}, nil
+	})
+	chrome, err := cdp.ConnectHTTP(server.URL)
+	check(t, err)
+	defer chrome.Close()
+
+	bc, err := chrome.NewContext(&devtool.BrowserContextOptions{ProxyServer: "http://proxy:3128", ProxyBypassList: "<local>"})
+	check(t, err)
+	var opts devtool.BrowserContextOptions
+	check(t, server.Received("Target.createBrowserContext")[0].Decode(&opts))
+	if bc.ID != "context-1" || opts.ProxyServer != "http://proxy:3128" || opts.ProxyBypassList != "<local>" {
+		t.Fatalf("context %s is created with %+v", bc.ID, opts)
+	}
+
+	// pages of context are opened in it and are not mixed with default context's ones
+	page, err := bc.NewPage("https://example.com/")
+	check(t, err)
+	targets, err := bc.Targets()
+	check(t, err)
+	if len(targets) != 1 || targets[0].TargetID != page.ID() || targets[0].BrowserContextID != "context-1" {
+		t.Fatalf("unexpected targets of context %+v", targets)
+	}
+
+	cookies, err := bc.Cookies()
+	check(t, err)
+	if len(cookies) != 1 || cookies[0].Name != "sid" {
+		t.Fatalf("unexpected cookies %+v", cookies)
+	}
+	check(t, bc.ClearCookies())
+	check(t, bc.SetPermissions("https://example.com", "geolocation"))
+	var permissions struct {
+		Origin           string   `json:"origin"`
+		Permissions      []string `json:"permissions"`
+		BrowserContextID string   `json:"browserContextId"`
+	}
+	check(t, server.Received("Browser.grantPermissions")[0].Decode(&permissions))
+	if permissions.Origin != "https://example.com" || len(permissions.Permissions) != 1 || permissions.BrowserContextID != "context-1" {
+		t.Fatalf("unexpected permissions %+v", permissions)
+	}
+
+	check(t, bc.Close())
+	for _, method := range []string{"Storage.clearCookies", "Target.disposeBrowserContext"} {
+		var p struct {
+			BrowserContextID string `json:"browserContextId"`
+		}
+		received := server.Received(method)
+		if len(received) != 1 {
+			t.Fatalf("%s was called %d times", method, len(received))
+		}
+		check(t, received[0].Decode(&p))
+		if p.BrowserContextID != "context-1" {
+			t.Fatalf("%s is called for context %q", method, p.BrowserContextID)
+		}
+	}
+}
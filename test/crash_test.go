@@ -0,0 +1,64 @@
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+func TestTargetCrashed(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	other, err := sess.NewTab("about:blank")
+	check(t, err)
+	crashes := make(chan *cdp.TargetCrashedError, 1)
+	unsubscribe := sess.OnCrash(func(err *cdp.TargetCrashedError) {
+		crashes <- err
+	})
+	defer unsubscribe()
+
+	// every session with target discovery receives crash of any target
+	crash := devtool.TargetCrashed{TargetID: sess.ID(), Status: "crashed", ErrorCode: 139}
+	server.Handle("Runtime.evaluate", func(*cdptest.Message) (interface{}, error) {
+		go func() {
+			_ = server.Emit(sess.GetID(), "Target.targetCrashed", crash)
+			_ = server.Emit(other.GetID(), "Target.targetCrashed", crash)
+		}()
+		return nil, cdptest.ErrNoResponse
+	})
+	_, err = sess.Evaluate("1", false, true)
+	var crashed *cdp.TargetCrashedError
+	if !errors.As(err, &crashed) || crashed.ErrorCode != 139 {
+		t.Fatalf("not expected error: %v", err)
+	}
+	select {
+	case <-crashes:
+	case <-time.After(time.Second):
+		t.Fatal("OnCrash was not called")
+	}
+	if other.Crashed() != nil || other.IsClosed() {
+		t.Fatal("crash of other target affected session")
+	}
+	check(t, other.Activate())
+
+	server.Handle("Page.reload", func(*cdptest.Message) (interface{}, error) {
+		go func() { _ = server.Emit(sess.GetID(), "Page.loadEventFired", map[string]float64{"timestamp": 1}) }()
+		return map[string]string{}, nil
+	})
+	check(t, sess.Reload())
+	if sess.Crashed() != nil {
+		t.Fatal("crash was not reset by reload")
+	}
+	check(t, sess.Activate())
+
+	recreated, err := sess.Recreate()
+	check(t, err)
+	if recreated.ID() == sess.ID() {
+		t.Fatal("target was not recreated")
+	}
+}
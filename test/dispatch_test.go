@@ -0,0 +1,292 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+func TestSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	release := make(chan struct{})
+	defer close(release)
+	unsubscribeSlow := sess.Subscribe("Page.frameNavigated", func(*cdp.Event) {
+		<-release
+	})
+	defer unsubscribeSlow()
+	received := make(chan struct{}, 10)
+	unsubscribe := sess.Subscribe("Page.frameNavigated", func(*cdp.Event) {
+		received <- struct{}{}
+	})
+	defer unsubscribe()
+
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	for i := 0; i < 5; i++ {
+		check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	}
+	for i := 0; i < 5; i++ {
+		select {
+		case <-received:
+		case <-time.After(time.Second):
+			t.Fatal("event is blocked by slow subscriber")
+		}
+	}
+}
+
+func TestSubscriberOverflow(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	release := make(chan struct{})
+	defer close(release)
+	overflow := make(chan error, 10)
+	unsubscribe := sess.SubscribeWithOptions("Page.frameNavigated", func(*cdp.Event) {
+		<-release
+	}, &cdp.SubscribeOptions{
+		QueueSize: 1,
+		Overflow:  cdp.OverflowError,
+		OnError:   func(err error) { overflow <- err },
+	})
+	defer unsubscribe()
+
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	for i := 0; i < 5; i++ {
+		check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	}
+	select {
+	case err := <-overflow:
+		if err != cdp.ErrSubscriberOverflow {
+			t.Fatalf("not expected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("overflow was not reported")
+	}
+}
+
+func TestSessionQueueOverflow(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	release := make(chan struct{})
+	defer close(release)
+	overflow := make(chan error, 1)
+	unsubscribe := sess.SubscribeWithOptions("Page.frameNavigated", func(*cdp.Event) {
+		<-release
+	}, &cdp.SubscribeOptions{
+		QueueSize: 1,
+		Overflow:  cdp.OverflowBlock,
+		OnError: func(err error) {
+			select {
+			case overflow <- err:
+			default:
+			}
+		},
+	})
+	defer unsubscribe()
+
+	// blocked subscriber gives up when session's queue is full instead of letting it grow
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	for i := 0; i < 5000; i++ {
+		check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	}
+	select {
+	case err := <-overflow:
+		if err != cdp.ErrSubscriberOverflow {
+			t.Fatalf("not expected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("overflow was not reported")
+	}
+}
+
+func TestSubscribeInsideCallback(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	nested := make(chan struct{}, 1)
+	self := make(chan func(), 1)
+	self <- sess.Subscribe("Page.frameNavigated", func(*cdp.Event) {
+		(<-self)()
+		sess.Subscribe("Page.loadEventFired", func(*cdp.Event) {
+			nested <- struct{}{}
+		})
+	})
+
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	time.Sleep(100 * time.Millisecond)
+	check(t, server.Emit(sess.GetID(), "Page.loadEventFired", map[string]float64{"timestamp": 1}))
+	select {
+	case <-nested:
+	case <-time.After(time.Second):
+		t.Fatal("nested subscription was not called")
+	}
+}
+
+func TestMalformedContextCreated(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	chrome, err := cdp.ConnectHTTP(server.URL)
+	check(t, err)
+	defer chrome.Close()
+	logger := &memoryLogger{}
+	chrome.GetWSClient().SetLogger(logger)
+	sess, err := chrome.Session()
+	check(t, err)
+	navigated := make(chan *cdp.Event, 1)
+	unsubscribe := sess.Subscribe("Page.frameNavigated", func(e *cdp.Event) {
+		navigated <- e
+	})
+	defer unsubscribe()
+
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", map[string]string{"context": "not an object"}))
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	select {
+	case <-navigated:
+	case <-time.After(time.Second):
+		t.Fatal("events are not dispatched after malformed one")
+	}
+	if sess.IsClosed() {
+		t.Fatal("session was closed by malformed event")
+	}
+	var logged []cdp.LogEntry
+	for _, e := range logger.find(sess.GetID(), "Runtime.executionContextCreated", "") {
+		if e.Error != nil {
+			logged = append(logged, e)
+		}
+	}
+	// by frame tracking and by listener itself
+	if len(logged) != 2 {
+		t.Fatalf("decode error was logged %d times", len(logged))
+	}
+}
+
+func TestTypedEvent(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	navigated := make(chan *protocol.PageFrameNavigatedEvent, 1)
+	decodeErr := make(chan error, 1)
+	unsubscribe := sess.OnPageFrameNavigated(func(e *protocol.PageFrameNavigatedEvent) {
+		navigated <- e
+	}, func(err error) {
+		decodeErr <- err
+	})
+	defer unsubscribe()
+
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", map[string]string{"frame": "not an object"}))
+	select {
+	case <-decodeErr:
+	case <-time.After(time.Second):
+		t.Fatal("decode error was not reported")
+	}
+
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	select {
+	case e := <-navigated:
+		if e.Frame.URL != frame.URL {
+			t.Fatalf("unexpected url %s", e.Frame.URL)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event was not received")
+	}
+	if sess.IsClosed() {
+		t.Fatal("session was closed by decode error")
+	}
+}
+
+func TestGeneratedEventType(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	received := make(chan interface{}, 1)
+	unsubscribe := sess.SubscribeEvent("Page.domContentEventFired", func(v interface{}) {
+		received <- v
+	}, nil)
+	defer unsubscribe()
+
+	check(t, server.Emit(sess.GetID(), "Page.domContentEventFired", map[string]float64{"timestamp": 1}))
+	select {
+	case v := <-received:
+		if e, ok := v.(*protocol.PageDOMContentEventFiredEvent); !ok || e.Timestamp != 1 {
+			t.Fatalf("unexpected event %#v", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event was not received")
+	}
+}
+
+func TestGeneratedTypedEvent(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	received := make(chan *protocol.PageDOMContentEventFiredEvent, 1)
+	unsubscribe := sess.OnPageDOMContentEventFired(func(e *protocol.PageDOMContentEventFiredEvent) {
+		received <- e
+	}, nil)
+	defer unsubscribe()
+
+	check(t, server.Emit(sess.GetID(), "Page.domContentEventFired", map[string]float64{"timestamp": 1}))
+	select {
+	case e := <-received:
+		if e.Timestamp != 1 {
+			t.Fatalf("unexpected event %#v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event was not received")
+	}
+
+	e, err := sess.WaitForPageFrameNavigated(context.Background(), func(e *protocol.PageFrameNavigatedEvent) bool {
+		return e.Frame.URL == "https://example.com/2"
+	}, func() error {
+		for _, url := range []string{"https://example.com/1", "https://example.com/2"} {
+			frame := &devtool.Frame{ID: sess.ID(), URL: url}
+			check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+		}
+		return nil
+	})
+	check(t, err)
+	if e.Frame.URL != "https://example.com/2" {
+		t.Fatalf("unexpected event %s", e.Frame.URL)
+	}
+}
+
+func TestWaitForEvent(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	emit := func(url string) {
+		frame := &devtool.Frame{ID: sess.ID(), URL: url}
+		check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	}
+	v, err := sess.WaitForEvent(context.Background(), "Page.frameNavigated", func(v interface{}) bool {
+		return v.(*protocol.PageFrameNavigatedEvent).Frame.URL == "https://example.com/2"
+	}, func() error {
+		emit("https://example.com/1")
+		emit("https://example.com/2")
+		return nil
+	})
+	check(t, err)
+	// events are decoded into generated protocol types, as typed subscriptions do
+	if url := v.(*protocol.PageFrameNavigatedEvent).Frame.URL; url != "https://example.com/2" {
+		t.Fatalf("unexpected event %s", url)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = sess.WaitForEvent(ctx, "Page.frameNavigated", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("not expected error: %v", err)
+	}
+}
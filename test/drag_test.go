@@ -0,0 +1,134 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+type mouseEvent struct {
+	Type    string  `json:"type"`
+	Button  string  `json:"button"`
+	Buttons int     `json:"buttons"`
+	X       float64 `json:"x"`
+	Y       float64 `json:"y"`
+}
+
+func received(t *testing.T, server *cdptest.Server, method string, newValue func() interface{}) []interface{} {
+	t.Helper()
+	var values []interface{}
+	for _, m := range server.Received(method) {
+		v := newValue()
+		check(t, m.Decode(v))
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestDragAndDrop(t *testing.T) {
+	t.Parallel()
+
+	// mouse-driven drag
+	server, sess := fakeSession(t)
+	check(t, sess.DragAndDrop(devtool.Point{X: 10, Y: 10}, devtool.Point{X: 110, Y: 60}, 4))
+	events := received(t, server, "Input.dispatchMouseEvent", func() interface{} { return new(mouseEvent) })
+	if len(events) != 7 {
+		t.Fatalf("%d mouse events", len(events))
+	}
+	for n, want := range []mouseEvent{
+		{Type: "mouseMoved", Button: "none", X: 10, Y: 10},
+		{Type: "mousePressed", Button: "left", Buttons: 1, X: 10, Y: 10},
+		{Type: "mouseMoved", Button: "left", Buttons: 1, X: 35, Y: 22.5},
+		{Type: "mouseMoved", Button: "left", Buttons: 1, X: 60, Y: 35},
+		{Type: "mouseMoved", Button: "left", Buttons: 1, X: 85, Y: 47.5},
+		{Type: "mouseMoved", Button: "left", Buttons: 1, X: 110, Y: 60},
+		{Type: "mouseReleased", Button: "left", X: 110, Y: 60},
+	} {
+		if got := *events[n].(*mouseEvent); got != want {
+			t.Fatalf("mouse event %d is %+v", n, got)
+		}
+	}
+	intercepts := received(t, server, "Input.setInterceptDrags", func() interface{} { return new(protocol.InputSetInterceptDragsParams) })
+	if len(intercepts) != 2 || !intercepts[0].(*protocol.InputSetInterceptDragsParams).Enabled || intercepts[1].(*protocol.InputSetInterceptDragsParams).Enabled {
+		t.Fatal("drags interception is not enabled during drag")
+	}
+	if n := len(server.Received("Input.dispatchDragEvent")); n != 0 {
+		t.Fatalf("%d drag events without HTML5 drag", n)
+	}
+
+	// page starts HTML5 drag on first move with pressed button
+	server, sess = fakeSession(t)
+	data := &protocol.InputDragData{Items: []*protocol.InputDragDataItem{{MimeType: "text/plain", Data: "image-3"}}, DragOperationsMask: 1}
+	server.Handle("Input.dispatchMouseEvent", func(m *cdptest.Message) (interface{}, error) {
+		e := new(mouseEvent)
+		check(t, m.Decode(e))
+		if e.Type == "mouseMoved" && e.Buttons == 1 && len(server.Received("Input.dispatchMouseEvent")) == 3 {
+			go func() {
+				_ = server.Emit(sess.GetID(), protocol.EventInputDragIntercepted, protocol.InputDragInterceptedEvent{Data: data})
+			}()
+		}
+		return nil, nil
+	})
+	check(t, sess.DragAndDrop(devtool.Point{X: 10, Y: 10}, devtool.Point{X: 110, Y: 60}, 4))
+	drags := received(t, server, "Input.dispatchDragEvent", func() interface{} { return new(protocol.InputDispatchDragEventParams) })
+	if len(drags) != 3 {
+		t.Fatalf("%d drag events", len(drags))
+	}
+	for n, typ := range []protocol.InputDispatchDragEventParamsType{"dragEnter", "dragOver", "drop"} {
+		d := drags[n].(*protocol.InputDispatchDragEventParams)
+		if d.Type != typ || d.X != 110 || d.Y != 60 || d.Data == nil || d.Data.Items[0].Data != "image-3" {
+			t.Fatalf("drag event %d is %+v", n, d)
+		}
+	}
+	events = received(t, server, "Input.dispatchMouseEvent", func() interface{} { return new(mouseEvent) })
+	if last := *events[len(events)-1].(*mouseEvent); last.Type != "mouseReleased" || last.X != 110 || last.Y != 60 {
+		t.Fatalf("mouse is released with %+v", last)
+	}
+
+	// mouse is released where it is if drag fails
+	server, sess = fakeSession(t)
+	server.Handle("Input.dispatchMouseEvent", func(m *cdptest.Message) (interface{}, error) {
+		if len(server.Received("Input.dispatchMouseEvent")) == 4 {
+			return nil, &cdptest.Error{Code: -32000, Message: "Target closed"}
+		}
+		return nil, nil
+	})
+	if err := sess.DragAndDrop(devtool.Point{X: 10, Y: 10}, devtool.Point{X: 110, Y: 60}, 4); err == nil {
+		t.Fatal("error is expected")
+	}
+	events = received(t, server, "Input.dispatchMouseEvent", func() interface{} { return new(mouseEvent) })
+	if last := *events[len(events)-1].(*mouseEvent); len(events) != 5 || last != (mouseEvent{Type: "mouseReleased", Button: "left", X: 35, Y: 22.5}) {
+		t.Fatalf("mouse is released with %+v", last)
+	}
+}
+
+func TestDragTo(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	page := &fakePage{texts: []string{"image-1", "image-2"}, checks: map[string]bool{}}
+	page.handle(t, server)
+	// second image is below the first one
+	server.Handle("DOM.getContentQuads", func(m *cdptest.Message) (interface{}, error) {
+		p := struct {
+			ObjectID string `json:"objectId"`
+		}{}
+		check(t, m.Decode(&p))
+		if strings.HasSuffix(p.ObjectID, "-1") {
+			return devtool.ContentQuads{Quads: [][]float64{{10, 110, 30, 110, 30, 120, 10, 120}}}, nil
+		}
+		return devtool.ContentQuads{Quads: [][]float64{{10, 10, 30, 10, 30, 20, 10, 20}}}, nil
+	})
+
+	images, err := sess.QueryAll("li")
+	check(t, err)
+	check(t, images[0].DragTo(images[1]))
+	events := received(t, server, "Input.dispatchMouseEvent", func() interface{} { return new(mouseEvent) })
+	first, last := events[1].(*mouseEvent), events[len(events)-1].(*mouseEvent)
+	if first.Type != "mousePressed" || first.X != 20 || first.Y != 15 || last.Type != "mouseReleased" || last.X != 20 || last.Y != 115 {
+		t.Fatalf("dragged from %+v to %+v", first, last)
+	}
+}
@@ -2,10 +2,14 @@ package test
 
 import (
 	"context"
+	"errors"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
 )
 
 func Te1stFrameRefresh(t *testing.T) {
@@ -63,7 +67,218 @@ func TestFrameRenew(t *testing.T) {
 	check(t, sess.SwitchTo(fid))
 	time.Sleep(time.Second * 4)
 
-	if _, err := sess.Query("#frameButton1"); err != cdp.ErrStaleElementReference {
+	if _, err := sess.Query("#frameButton1"); !errors.Is(err, cdp.ErrStaleElementReference) {
 		t.Fatalf("not expected error: %s", err.Error())
 	}
 }
+
+func TestFrameLocatorRefresh(t *testing.T) {
+	t.Parallel()
+
+	chrome, err := cdp.Launch(context.TODO())
+	check(t, err)
+	defer chrome.Close()
+	sess, err := chrome.Session()
+	check(t, err)
+
+	check(t, sess.Navigate(getFilepath("frame_playground.html")))
+	frame, err := sess.Frame(cdp.FrameMatcher{Selectors: []string{"#my_frame"}})
+	check(t, err)
+	input := frame.Locator("#frameInput1")
+	check(t, input.Type("123456", 0))
+	check(t, frame.Locator("#refresh").Click())
+	// input of reloaded frame is queried again, no sleep is needed
+	check(t, input.Type("654321", 0))
+}
+
+// evaluatedContexts returns contextId of every Runtime.evaluate received by server
+func evaluatedContexts(t *testing.T, server *cdptest.Server) map[int64]bool {
+	t.Helper()
+	contexts := map[int64]bool{}
+	for _, m := range server.Received("Runtime.evaluate") {
+		var p struct {
+			ContextID int64 `json:"contextId"`
+		}
+		check(t, m.Decode(&p))
+		contexts[p.ContextID] = true
+	}
+	return contexts
+}
+
+func contextCreated(id int64, frameID, name string, isDefault bool) devtool.ExecutionContextCreated {
+	return devtool.ExecutionContextCreated{Context: &devtool.ExecutionContextDescription{
+		ID:      id,
+		Name:    name,
+		AuxData: map[string]interface{}{"frameId": frameID, "isDefault": isDefault},
+	}}
+}
+
+func TestFrameManager(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	mainID := sess.ID()
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: &devtool.Frame{ID: mainID, URL: "https://example.com/"}}))
+	check(t, server.Emit(sess.GetID(), "Page.frameAttached", devtool.FrameAttached{FrameID: "child-1", ParentFrameID: mainID}))
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: &devtool.Frame{ID: "child-1", ParentID: mainID, Name: "inner", URL: "https://example.com/inner"}}))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(1, mainID, "", true)))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(2, "child-1", "", true)))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(3, "child-1", "utility", false)))
+	waitFor(t, func() bool { return len(sess.AllFrames()) == 2 })
+
+	frames := sess.AllFrames()
+	if frames[0].ID() != mainID || frames[1].ID() != "child-1" {
+		t.Fatalf("unexpected order of frames %s, %s", frames[0].ID(), frames[1].ID())
+	}
+	child := frames[1]
+	if child.Name() != "inner" || child.URL() != "https://example.com/inner" || child.Parent().ID() != mainID {
+		t.Fatalf("unexpected frame %s %s", child.Name(), child.URL())
+	}
+	if children := sess.MainFrame().Children(); len(children) != 1 || children[0].ID() != "child-1" {
+		t.Fatal("main frame has no child")
+	}
+	if sess.MainFrame().Parent() != nil {
+		t.Fatal("main frame has parent")
+	}
+
+	// frames are evaluated concurrently in their own main world contexts, session's context is not changed
+	errs := make(chan error, 2)
+	for _, f := range frames {
+		go func(f *cdp.Frame) {
+			_, err := f.Evaluate("1", false, true)
+			errs <- err
+		}(f)
+	}
+	check(t, <-errs)
+	check(t, <-errs)
+	_, err := sess.Evaluate("1", false, true)
+	check(t, err)
+	if contexts := evaluatedContexts(t, server); len(contexts) != 3 || !contexts[0] || !contexts[1] || !contexts[2] {
+		t.Fatalf("unexpected contexts %v", contexts)
+	}
+
+	// evaluation waits for context of just attached frame
+	check(t, server.Emit(sess.GetID(), "Page.frameAttached", devtool.FrameAttached{FrameID: "child-2", ParentFrameID: mainID}))
+	waitFor(t, func() bool { return sess.FrameByID("child-2") != nil })
+	go func() {
+		_, err := sess.FrameByID("child-2").Evaluate("2", false, true)
+		errs <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(4, "child-2", "", true)))
+	check(t, <-errs)
+	if !evaluatedContexts(t, server)[4] {
+		t.Fatal("frame was not evaluated in its context")
+	}
+
+	check(t, server.Emit(sess.GetID(), "Page.frameDetached", devtool.FrameDetached{FrameID: "child-1", Reason: "remove"}))
+	waitFor(t, func() bool { return sess.FrameByID("child-1") == nil })
+	if _, err := child.Evaluate("1", false, true); !errors.Is(err, cdp.ErrContextDetached) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestFrameLookup(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	// documents of execution contexts, selector -> element, and frames of iframe elements
+	documents := map[int64]map[string]string{
+		1: {"#outer": "iframe-outer", "button": "main-button"},
+		2: {"#inner": "iframe-inner", "button": "payment-button"},
+		3: {"button": "confirm-button"},
+	}
+	iframes := map[string]string{"iframe-outer": "child-1", "iframe-inner": "child-2"}
+	server.Handle("Runtime.callFunctionOn", func(m *cdptest.Message) (interface{}, error) {
+		call := new(functionCall)
+		check(t, m.Decode(call))
+		if id, has := documents[call.ExecutionContextID][call.bodies()[0]]; has {
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", ObjectID: id}}, nil
+		}
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "null"}}, nil
+	})
+	server.Handle("DOM.describeNode", func(m *cdptest.Message) (interface{}, error) {
+		var p struct {
+			ObjectID string `json:"objectId"`
+		}
+		check(t, m.Decode(&p))
+		return devtool.DescribeNode{Node: &devtool.Node{NodeName: "IFRAME", FrameID: iframes[p.ObjectID]}}, nil
+	})
+
+	mainID := sess.ID()
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: &devtool.Frame{ID: mainID, URL: "https://example.com/"}}))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(1, mainID, "", true)))
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: &devtool.Frame{ID: "child-1", ParentID: mainID, Name: "payment", URL: "https://pay.example.com/checkout?id=1"}}))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(2, "child-1", "", true)))
+
+	for _, m := range []cdp.FrameMatcher{
+		{Name: "payment"},
+		{URL: "https://pay.example.com/*"},
+		{URLRegexp: regexp.MustCompile(`/checkout\?id=\d+$`)},
+		{Selectors: []string{"#outer"}, Name: "payment"},
+	} {
+		f, err := sess.Frame(m)
+		check(t, err)
+		if f.Name() != "payment" || f.URL() != "https://pay.example.com/checkout?id=1" || f.Parent().ID() != mainID {
+			t.Fatalf("%+v matched frame %s", m, f.ID())
+		}
+		// frame is queried in its own document
+		button, err := f.Query("button")
+		check(t, err)
+		if button.ID != "payment-button" {
+			t.Fatalf("%+v matched frame with %s", m, button.ID)
+		}
+	}
+
+	// nested frame is waited until it is attached and its context is created
+	found := make(chan *cdp.Frame, 1)
+	go func() {
+		f, err := sess.Frame(cdp.FrameMatcher{Selectors: []string{"#outer", "#inner"}})
+		if err != nil {
+			found <- nil
+			return
+		}
+		found <- f
+	}()
+	time.Sleep(50 * time.Millisecond)
+	check(t, server.Emit(sess.GetID(), "Page.frameAttached", devtool.FrameAttached{FrameID: "child-2", ParentFrameID: "child-1"}))
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(3, "child-2", "", true)))
+	f := <-found
+	if f == nil || f.Parent().Name() != "payment" {
+		t.Fatal("nested frame was not found")
+	}
+	button, err := f.Query("button")
+	check(t, err)
+	if button.ID != "confirm-button" {
+		t.Fatalf("nested frame is queried with %s", button.ID)
+	}
+	if children := f.Parent().Children(); len(children) != 1 || children[0].ID() != f.ID() {
+		t.Fatalf("payment frame has %d children", len(children))
+	}
+
+	short := *sess
+	short.SetTimeout(200 * time.Millisecond)
+	if _, err := short.Frame(cdp.FrameMatcher{Name: "missing"}); !errors.Is(err, cdp.ErrFrameNotFound) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// every queried iframe element is released
+	released := map[string]int{}
+	for _, m := range server.Received("Runtime.releaseObject") {
+		var p struct {
+			ObjectID string `json:"objectId"`
+		}
+		check(t, m.Decode(&p))
+		released[p.ObjectID]++
+	}
+	for _, m := range server.Received("DOM.describeNode") {
+		var p struct {
+			ObjectID string `json:"objectId"`
+		}
+		check(t, m.Decode(&p))
+		released[p.ObjectID]--
+	}
+	if released["iframe-outer"] != 0 || released["iframe-inner"] != 0 {
+		t.Fatalf("iframe elements are not released %v", released)
+	}
+}
@@ -0,0 +1,188 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+// fakePage answers queries with elements of page, elements are re-rendered (get new ids) on every query
+type fakePage struct {
+	mutex    sync.Mutex
+	renders  int
+	texts    []string          // texts of rendered elements
+	disabled int               // number of checks element is reported disabled
+	checks   map[string]bool   // results of other checks, true by default
+	fails    map[string]string // protocol errors of calls which function contains key
+}
+
+func (p *fakePage) handle(t *testing.T, server *cdptest.Server) {
+	server.Handle("Runtime.evaluate", func(*cdptest.Message) (interface{}, error) {
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", ObjectID: "document"}}, nil
+	})
+	server.Handle("Runtime.callFunctionOn", func(m *cdptest.Message) (interface{}, error) {
+		call := new(functionCall)
+		check(t, m.Decode(call))
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		for name, message := range p.fails {
+			if strings.Contains(call.FunctionDeclaration, name) {
+				return nil, &cdptest.Error{Code: -32000, Message: message}
+			}
+		}
+		switch {
+		case strings.Contains(call.FunctionDeclaration, "const g=["):
+			p.renders++
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "array", ObjectID: "array"}}, nil
+		case strings.Contains(call.FunctionDeclaration, `matches(":disabled")`):
+			p.disabled--
+			return boolean(p.disabled < 0), nil
+		case strings.Contains(call.FunctionDeclaration, "this.innerText"):
+			n := strings.LastIndex(call.ObjectID, "-")
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "string", Value: p.texts[call.ObjectID[n+1:][0]-'0']}}, nil
+		}
+		for name, result := range p.checks {
+			if strings.Contains(call.FunctionDeclaration, name) {
+				return boolean(result), nil
+			}
+		}
+		return boolean(true), nil
+	})
+	server.Handle("Runtime.getProperties", func(*cdptest.Message) (interface{}, error) {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		var props []*devtool.PropertyDescriptor
+		for n := range p.texts {
+			id := "element-" + string(rune('a'+p.renders)) + "-" + string(rune('0'+n))
+			props = append(props, &devtool.PropertyDescriptor{Enumerable: true, Value: &devtool.RemoteObject{Type: "object", ObjectID: id}})
+		}
+		return devtool.PropertiesResult{Result: props}, nil
+	})
+	server.Handle("DOM.getContentQuads", func(*cdptest.Message) (interface{}, error) {
+		return devtool.ContentQuads{Quads: [][]float64{{10, 10, 30, 10, 30, 20, 10, 20}}}, nil
+	})
+	server.Handle("Page.getLayoutMetrics", func(*cdptest.Message) (interface{}, error) {
+		return map[string]interface{}{"layoutViewport": map[string]int{"clientWidth": 800, "clientHeight": 600}}, nil
+	})
+}
+
+func (p *fakePage) set(f func()) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	f()
+}
+
+func boolean(v bool) devtool.EvaluatesResult {
+	return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "boolean", Value: v}}
+}
+
+func TestLocator(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	page := &fakePage{texts: []string{"Apple", "Banana", "Cherry"}, checks: map[string]bool{}}
+	page.handle(t, server)
+
+	items := sess.Locator("li")
+	count, err := items.Count()
+	check(t, err)
+	if count != 3 {
+		t.Fatalf("%d elements", count)
+	}
+	text, err := items.Filter(cdp.LocatorFilter{HasText: "an"}).Nth(-1).GetText()
+	check(t, err)
+	if text != "Banana" {
+		t.Fatalf("unexpected text %s", text)
+	}
+
+	// click waits until element is enabled, element is queried again on every attempt
+	page.set(func() { page.disabled = 2; page.renders = 0 })
+	check(t, items.Nth(0).Click())
+	if page.renders != 3 {
+		t.Fatalf("element was queried %d times", page.renders)
+	}
+	if n := len(server.Received("Input.dispatchMouseEvent")); n != 3 {
+		t.Fatalf("%d mouse events", n)
+	}
+
+	// covered element is not clicked until timeout
+	page.set(func() { page.checks["elementFromPoint"] = false })
+	err = items.WithTimeout(300 * time.Millisecond).Click()
+	var timeout *cdp.LocatorTimeoutError
+	if !errors.As(err, &timeout) || !errors.Is(err, cdp.ErrElementObscured) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	page.set(func() { page.texts = nil })
+	if visible, err := items.IsVisible(); err != nil || visible {
+		t.Fatalf("missing element is visible %v", err)
+	}
+	err = sess.Locator("li").Locator("a").WithTimeout(200 * time.Millisecond).Click()
+	if !errors.As(err, &cdp.NoSuchElementError{}) || !strings.Contains(err.Error(), "li >> a") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestIsVisibleError(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	page := &fakePage{texts: []string{"Apple"}, checks: map[string]bool{}}
+	page.handle(t, server)
+	el, err := sess.Query("li")
+	check(t, err)
+
+	// element without box whose style can not be read
+	server.Handle("DOM.getContentQuads", func(*cdptest.Message) (interface{}, error) {
+		return nil, &cdptest.Error{Code: -32000, Message: "Could not compute content quads."}
+	})
+	server.Handle("Runtime.callFunctionOn", func(*cdptest.Message) (interface{}, error) {
+		return nil, &cdptest.Error{Code: -32000, Message: "Cannot find context with specified id"}
+	})
+	if visible, err := el.IsVisible(); err == nil || !strings.Contains(err.Error(), "Cannot find context") || visible {
+		t.Fatalf("unexpected result %v, %v", visible, err)
+	}
+}
+
+func TestLocatorActsOnce(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	// page re-renders input after text is inserted
+	page := &fakePage{texts: []string{"Apple", "Banana"}, disabled: 1, checks: map[string]bool{}, fails: map[string]string{"dispatchEvent": "Cannot find context with specified id"}}
+	page.handle(t, server)
+
+	err := sess.Locator("input").InsertText("x")
+	var timeout *cdp.LocatorTimeoutError
+	if !errors.Is(err, cdp.ErrStaleElementReference) || errors.As(err, &timeout) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if n := len(server.Received("Input.insertText")); n != 1 {
+		t.Fatalf("text is inserted %d times", n)
+	}
+
+	// elements of both attempts are released
+	var released []string
+	for _, m := range server.Received("Runtime.releaseObject") {
+		var p struct {
+			ObjectID string `json:"objectId"`
+		}
+		check(t, m.Decode(&p))
+		if strings.HasPrefix(p.ObjectID, "element-") {
+			released = append(released, p.ObjectID)
+		}
+	}
+	sort.Strings(released)
+	if want := []string{"element-b-0", "element-b-1", "element-c-0", "element-c-1"}; !reflect.DeepEqual(released, want) {
+		t.Fatalf("released %v", released)
+	}
+}
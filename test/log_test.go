@@ -0,0 +1,147 @@
+package test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+)
+
+type memoryLogger struct {
+	mutex   sync.Mutex
+	entries []cdp.LogEntry
+}
+
+func (l *memoryLogger) Log(e *cdp.LogEntry) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.entries = append(l.entries, *e)
+}
+
+func (l *memoryLogger) find(sessionID, method, direction string) []cdp.LogEntry {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	var found []cdp.LogEntry
+	for _, e := range l.entries {
+		if e.SessionID == sessionID && e.Method == method && e.Direction == direction {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+type syncBuffer struct {
+	mutex sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.buf.String()
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.Handle("Test.echo", func(*cdptest.Message) (interface{}, error) {
+		return map[string]string{"value": strings.Repeat("x", 100)}, nil
+	})
+	server.Handle("Test.fail", func(*cdptest.Message) (interface{}, error) {
+		return nil, &cdptest.Error{Code: -32000, Message: "test failure"}
+	})
+	chrome, err := cdp.ConnectHTTP(server.URL)
+	check(t, err)
+	defer chrome.Close()
+	sess, err := chrome.Session()
+	check(t, err)
+	other, err := sess.NewTab("about:blank")
+	check(t, err)
+
+	logger := &memoryLogger{}
+	client := chrome.GetWSClient()
+	client.SetLogger(logger)
+	client.SetLogLevel(cdp.LevelProtocolErrors)
+	client.SetLogPayloadLimit(16)
+	sess.SetOutLevel(cdp.LevelProtocolMessage)
+
+	check(t, sess.Call("Test.echo", nil, nil))
+	check(t, other.Call("Test.echo", nil, nil))
+	if err = other.Call("Test.fail", nil, nil); err == nil {
+		t.Fatal("error expected")
+	}
+
+	// messages are logged for session with own level only
+	var recv []cdp.LogEntry
+	waitFor(t, func() bool {
+		recv = logger.find(sess.GetID(), "Test.echo", cdp.RecordReceive)
+		return len(recv) == 1
+	})
+	if recv[0].MessageID == 0 || recv[0].Duration <= 0 || recv[0].Level != cdp.LevelProtocolMessage {
+		t.Fatalf("unexpected entry %+v", recv[0])
+	}
+	if !strings.HasPrefix(recv[0].Payload, `{"id":`) || !strings.HasSuffix(recv[0].Payload, "bytes truncated)") {
+		t.Fatalf("payload is not truncated: %s", recv[0].Payload)
+	}
+	if len(logger.find(sess.GetID(), "Test.echo", cdp.RecordSend)) != 1 {
+		t.Fatal("sent message is not logged")
+	}
+	var failed []cdp.LogEntry
+	waitFor(t, func() bool {
+		failed = logger.find(other.GetID(), "Test.fail", cdp.RecordReceive)
+		return len(failed) == 1
+	})
+	if failed[0].Error == nil || !strings.Contains(failed[0].Error.Error(), "test failure") {
+		t.Fatalf("error is not logged %+v", failed[0])
+	}
+	if n := len(logger.find(other.GetID(), "Test.echo", cdp.RecordReceive)); n != 0 {
+		t.Fatalf("%d messages logged for session with default level", n)
+	}
+
+	// json logger writes one line per entry, truncated payload is written as string
+	out := &syncBuffer{}
+	client.SetLogger(cdp.NewJSONLogger(out))
+	check(t, sess.Call("Test.echo", nil, nil))
+	waitFor(t, func() bool { return strings.Count(out.String(), "\n") == 2 })
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(strings.NewReader(out.String()))
+	for scanner.Scan() {
+		var line map[string]interface{}
+		check(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	if lines[0]["direction"] != cdp.RecordSend || lines[1]["direction"] != cdp.RecordReceive {
+		t.Fatalf("unexpected lines %v", lines)
+	}
+	for _, line := range lines {
+		if line["sessionId"] != sess.GetID() || line["method"] != "Test.echo" {
+			t.Fatalf("unexpected line %v", line)
+		}
+		if _, ok := line["payload"].(string); !ok {
+			t.Fatalf("truncated payload is not a string %v", line)
+		}
+	}
+	if lines[1]["duration"] == nil {
+		t.Fatalf("duration is not logged %v", lines[1])
+	}
+
+	// nop logger discards everything
+	client.SetLogger(cdp.NopLogger)
+	check(t, sess.Call("Test.echo", nil, nil))
+	if strings.Count(out.String(), "\n") != 2 {
+		t.Fatal("entries are written after logger is replaced")
+	}
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+)
+
+func TestPipeTransport(t *testing.T) {
+	t.Parallel()
+
+	// browser's side of pipes
+	inR, browserW, err := os.Pipe()
+	check(t, err)
+	browserR, outW, err := os.Pipe()
+	check(t, err)
+	defer browserW.Close()
+	defer browserR.Close()
+	transport := cdp.NewPipeTransport(inR, outW)
+	defer transport.Close()
+
+	// message is terminated by NUL, bytes after message in caller's buffer are kept
+	buf := []byte(`{"id":1}` + "\xff")
+	message := buf[:len(buf)-1]
+	sendErr := make(chan error, 1)
+	go func() { sendErr <- transport.Send(message) }()
+	sent, err := bufio.NewReader(browserR).ReadBytes(0)
+	check(t, err)
+	check(t, <-sendErr)
+	if string(sent) != `{"id":1}`+"\x00" || buf[len(buf)-1] != 0xff {
+		t.Fatalf("sent %q, buffer %q", sent, buf)
+	}
+
+	// message split across reads is received whole, several messages in one read are split
+	go func() {
+		_, _ = browserW.Write([]byte(`{"id":1,"res`))
+		time.Sleep(50 * time.Millisecond)
+		_, _ = browserW.Write([]byte(`ult":{}}` + "\x00" + `{"method":"Page.loadEventFired"}` + "\x00" + `{"id"`))
+		time.Sleep(50 * time.Millisecond)
+		browserW.Close()
+	}()
+	for _, want := range []string{`{"id":1,"result":{}}`, `{"method":"Page.loadEventFired"}`} {
+		received, err := transport.Receive()
+		check(t, err)
+		if string(received) != want {
+			t.Fatalf("received %q", received)
+		}
+	}
+	// pipe closed in the middle of message
+	if _, err = transport.Receive(); err != io.ErrUnexpectedEOF {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+)
+
+// fakePool returns pool of fake browsers, launched ones are sent to servers
+func fakePool(t *testing.T, opts *cdp.PoolOptions) (*cdp.Pool, chan *cdptest.Server) {
+	t.Helper()
+	servers := make(chan *cdptest.Server, 10)
+	opts.Launch = func(context.Context) (*cdp.Browser, error) {
+		server := cdptest.NewServer()
+		t.Cleanup(server.Close)
+		servers <- server
+		return cdp.ConnectHTTP(server.URL)
+	}
+	pool, err := cdp.NewPool(context.Background(), opts)
+	check(t, err)
+	t.Cleanup(func() { _ = pool.Close() })
+	return pool, servers
+}
+
+func TestPoolRecycle(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := fakePool(t, &cdp.PoolOptions{Size: 1, MaxUses: 2})
+	for i := 0; i < 3; i++ {
+		sess, err := pool.Acquire(context.Background())
+		check(t, err)
+		if _, err = sess.Evaluate("1", false, true); err != nil {
+			t.Fatal(err)
+		}
+		check(t, pool.Release(sess))
+	}
+	stats := pool.Stats()
+	if stats.Acquired != 3 || stats.Launched != 2 || stats.Recycled != 1 || stats.Idle != 1 || stats.InUse != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestPoolAcquireTimeout(t *testing.T) {
+	t.Parallel()
+
+	pool, _ := fakePool(t, &cdp.PoolOptions{Size: 1})
+	sess, err := pool.Acquire(context.Background())
+	check(t, err)
+	defer func() { check(t, pool.Release(sess)) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = pool.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("not expected error: %v", err)
+	}
+	if stats := pool.Stats(); stats.InUse != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestPoolCrashed(t *testing.T) {
+	t.Parallel()
+
+	pool, servers := fakePool(t, &cdp.PoolOptions{Size: 1})
+	(<-servers).Disconnect()
+	sess, err := pool.Acquire(context.Background())
+	check(t, err)
+	check(t, pool.Release(sess))
+	if stats := pool.Stats(); stats.Crashed != 1 || stats.Launched != 2 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestPoolAcquireCancelLaunch(t *testing.T) {
+	t.Parallel()
+
+	launched := make(chan *cdptest.Server, 1)
+	slow := make(chan struct{})
+	launches := 0
+	pool, err := cdp.NewPool(context.Background(), &cdp.PoolOptions{Size: 1, Launch: func(context.Context) (*cdp.Browser, error) {
+		if launches++; launches > 1 {
+			<-slow
+		}
+		server := cdptest.NewServer()
+		t.Cleanup(server.Close)
+		launched <- server
+		return cdp.ConnectHTTP(server.URL)
+	}})
+	check(t, err)
+	t.Cleanup(func() { _ = pool.Close() })
+
+	// broken browser is relaunched on acquisition, ctx is not waited for the launch
+	(<-launched).Disconnect()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = pool.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("not expected error: %v", err)
+	}
+	close(slow)
+	<-launched
+
+	// browser launched for cancelled acquisition is kept in pool
+	sess, err := pool.Acquire(context.Background())
+	check(t, err)
+	if sess.Context() != context.Background() || sess.BrowserContext == nil {
+		t.Fatal("session context is hidden")
+	}
+	check(t, pool.Release(sess))
+	if stats := pool.Stats(); stats.Launched != 2 || stats.Acquired != 1 || stats.InUse != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+)
+
+func TestReconnect(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	chrome, err := cdp.Connect(context.TODO(), server.WebSocketURL())
+	check(t, err)
+	defer chrome.Close()
+	chrome.GetWSClient().SetReconnectPolicy(&cdp.ReconnectPolicy{MaxAttempts: 3, Backoff: 50 * time.Millisecond})
+	sess, err := chrome.Session()
+	check(t, err)
+
+	reconnected, unsubscribe := sess.Listen(cdp.EventReconnected)
+	defer unsubscribe()
+	server.Disconnect()
+
+	select {
+	case <-reconnected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("not reconnected")
+	}
+	if n := len(server.Received("Target.attachToTarget")); n != 2 {
+		t.Fatalf("attached %d times", n)
+	}
+	if n := len(server.Received("Network.enable")); n != 2 {
+		t.Fatalf("network enabled %d times", n)
+	}
+	if n := len(server.Received("Target.setAutoAttach")); n != 2 {
+		t.Fatalf("auto-attach set %d times", n)
+	}
+	if sess.IsClosed() {
+		t.Fatal("session was closed")
+	}
+	_, err = sess.Evaluate("1", false, true)
+	check(t, err)
+}
+
+func TestCallWaitsForRestore(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	chrome, err := cdp.Connect(context.TODO(), server.WebSocketURL())
+	check(t, err)
+	defer chrome.Close()
+	chrome.GetWSClient().SetReconnectPolicy(&cdp.ReconnectPolicy{MaxAttempts: 3, Backoff: 50 * time.Millisecond})
+	sess, err := chrome.Session()
+	check(t, err)
+	evaluated := len(server.Received("Runtime.evaluate"))
+
+	release := make(chan struct{})
+	server.Handle("Target.attachToTarget", func(*cdptest.Message) (interface{}, error) {
+		<-release
+		return map[string]string{"sessionId": "restored-session"}, nil
+	})
+	server.Disconnect()
+	waitFor(t, func() bool { return len(server.Received("Target.attachToTarget")) == 2 })
+
+	// command issued while target is being attached again is sent with new session id
+	done := make(chan error, 1)
+	go func() {
+		_, err := sess.Evaluate("1", false, true)
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	if n := len(server.Received("Runtime.evaluate")); n != evaluated {
+		t.Fatalf("%d commands sent before session was restored", n-evaluated)
+	}
+	close(release)
+	select {
+	case err = <-done:
+		check(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("command was not sent after restore")
+	}
+	received := server.Received("Runtime.evaluate")
+	if len(received) != evaluated+1 || received[evaluated].SessionID != "restored-session" {
+		t.Fatalf("command was sent to %s", received[len(received)-1].SessionID)
+	}
+}
@@ -0,0 +1,50 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+func TestRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.Handle("Runtime.evaluate", func(m *cdptest.Message) (interface{}, error) {
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "string", Value: "recorded"}}, nil
+	})
+
+	var recording bytes.Buffer
+	chrome, err := cdp.Connect(context.TODO(), server.WebSocketURL())
+	check(t, err)
+	chrome.GetWSClient().SetRecorder(&recording)
+	sess, err := chrome.Session()
+	check(t, err)
+	_, err = sess.Evaluate("document.title", false, true)
+	check(t, err)
+	check(t, chrome.Close())
+
+	transport, err := cdp.NewReplayTransport(&recording)
+	check(t, err)
+	replay, err := cdp.ConnectTransport(transport).Session()
+	check(t, err)
+	value, err := replay.Evaluate("document.title", false, true)
+	check(t, err)
+	if value != "recorded" {
+		t.Fatalf("replayed value %v", value)
+	}
+	if len(transport.Divergences()) != 0 {
+		t.Fatal(transport.Divergences()[0])
+	}
+	if _, err = replay.Evaluate("location.href", false, true); err == nil {
+		t.Fatal("divergence error expected")
+	}
+	if len(transport.Divergences()) != 1 {
+		t.Fatalf("%d divergences", len(transport.Divergences()))
+	}
+}
@@ -0,0 +1,201 @@
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+type functionCall struct {
+	FunctionDeclaration string                 `json:"functionDeclaration"`
+	ObjectID            string                 `json:"objectId"`
+	ExecutionContextID  int64                  `json:"executionContextId"`
+	AwaitPromise        bool                   `json:"awaitPromise"`
+	ReturnByValue       bool                   `json:"returnByValue"`
+	Arguments           []devtool.CallArgument `json:"arguments"`
+}
+
+// arg returns n-th argument as JSON
+func (call *functionCall) arg(n int) string {
+	b, _ := json.Marshal(call.Arguments[n].Value)
+	return string(b)
+}
+
+// bodies returns selectors of parts queried by call
+func (call *functionCall) bodies() []string {
+	var bodies []string
+	parts, _ := call.Arguments[0].Value.([]interface{})
+	for _, part := range parts {
+		bodies = append(bodies, part.([]interface{})[1].(string))
+	}
+	return bodies
+}
+
+// functionServer answers calls of functions in document with answer
+func functionServer(t *testing.T, answer func(call *functionCall) (interface{}, error)) (*cdptest.Server, *cdp.Session, func() *functionCall) {
+	t.Helper()
+	server, sess := fakeSession(t)
+	server.Handle("Runtime.evaluate", func(*cdptest.Message) (interface{}, error) {
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", ObjectID: "document-1"}}, nil
+	})
+	server.Handle("Runtime.callFunctionOn", func(m *cdptest.Message) (interface{}, error) {
+		call := new(functionCall)
+		check(t, m.Decode(call))
+		return answer(call)
+	})
+	last := func() *functionCall {
+		t.Helper()
+		calls := server.Received("Runtime.callFunctionOn")
+		if len(calls) == 0 {
+			t.Fatal("Runtime.callFunctionOn was not called")
+		}
+		call := new(functionCall)
+		check(t, calls[len(calls)-1].Decode(call))
+		return call
+	}
+	return server, sess, last
+}
+
+// selectorServer answers every query with one element, or with array of two elements if all matches are requested
+func selectorServer(t *testing.T) (*cdptest.Server, *cdp.Session, func() *functionCall) {
+	t.Helper()
+	server, sess, last := functionServer(t, func(call *functionCall) (interface{}, error) {
+		if len(call.Arguments) == 2 && call.Arguments[1].Value == true {
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "array", ObjectID: "array-1"}}, nil
+		}
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "node", ObjectID: "element-1"}}, nil
+	})
+	server.Handle("Runtime.getProperties", func(*cdptest.Message) (interface{}, error) {
+		return devtool.PropertiesResult{Result: []*devtool.PropertyDescriptor{
+			{Name: "0", Enumerable: true, Value: &devtool.RemoteObject{Type: "object", ObjectID: "element-1"}},
+			{Name: "1", Enumerable: true, Value: &devtool.RemoteObject{Type: "object", ObjectID: "element-2"}},
+			{Name: "length", Value: &devtool.RemoteObject{Type: "number", Value: 2}},
+		}}, nil
+	})
+	return server, sess, last
+}
+
+func TestSelectorEngines(t *testing.T) {
+	t.Parallel()
+
+	cdp.RegisterSelectorEngine("tag", `function(r,s){return r.getElementsByTagName(s)}`)
+	_, sess, last := selectorServer(t)
+
+	for _, tc := range []struct {
+		selector string
+		engine   string // distinctive part of engine's source
+		bodies   []string
+	}{
+		{`#cart .item[data-name="a \"b\""]`, "querySelectorAll(s)", []string{`#cart .item[data-name="a \"b\""]`}},
+		{`css=div > a`, "querySelectorAll(s)", []string{`div > a`}},
+		{`xpath=//a[text()="Buy"]`, "XPathResult", []string{`//a[text()="Buy"]`}},
+		{`//button[@id='buy']`, "XPathResult", []string{`//button[@id='buy']`}},
+		{`text="Add to cart"`, "innerText", []string{`"Add to cart"`}},
+		{`role=button[name="Submit"]`, "aria-labelledby", []string{`button[name="Submit"]`}},
+		{`data-testid=checkout`, "data-testid", []string{`checkout`}},
+		{`tag=button`, "getElementsByTagName", []string{`button`}},
+		{`pierce=button.buy`, "e.shadowRoot&&w(e.shadowRoot)", []string{`button.buy`}},
+		{`cart-widget >>> text=Buy`, "innerText", []string{`cart-widget`, `Buy`}},
+		{`text=">>>"`, "innerText", []string{`">>>"`}},
+		{`a[title='next >>>'] >>> span`, "querySelectorAll(s)", []string{`a[title='next >>>']`, `span`}},
+		{`//a[text()=">>>"]`, "XPathResult", []string{`//a[text()=">>>"]`}},
+	} {
+		el, err := sess.Query(tc.selector)
+		check(t, err)
+		call := last()
+		if !strings.Contains(call.FunctionDeclaration, tc.engine) {
+			t.Fatalf("%s is not queried by %s engine", tc.selector, tc.engine)
+		}
+		if !reflect.DeepEqual(call.bodies(), tc.bodies) || call.ObjectID != "document-1" {
+			t.Fatalf("%s is queried with %v in %s", tc.selector, call.bodies(), call.ObjectID)
+		}
+
+		// element's queries are called on element itself
+		_, err = el.Query(tc.selector)
+		check(t, err)
+		if call = last(); call.ObjectID != el.ID || !reflect.DeepEqual(call.bodies(), tc.bodies) {
+			t.Fatalf("%s is queried in %s", tc.selector, call.ObjectID)
+		}
+	}
+
+	all, err := sess.QueryAll("text=item")
+	check(t, err)
+	if len(all) != 2 || all[0].ID != "element-1" || all[1].ID != "element-2" {
+		t.Fatalf("unexpected elements %v", all)
+	}
+}
+
+func TestShadowRoot(t *testing.T) {
+	t.Parallel()
+
+	server, sess, last := selectorServer(t)
+	server.Handle("Runtime.callFunctionOn", func(m *cdptest.Message) (interface{}, error) {
+		call := new(functionCall)
+		check(t, m.Decode(call))
+		switch {
+		case strings.Contains(call.FunctionDeclaration, "return this.shadowRoot}") && call.ObjectID == "element-in-document-1":
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "node", ObjectID: "shadow-1"}}, nil
+		case strings.Contains(call.FunctionDeclaration, "return this.shadowRoot}"):
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "null"}}, nil
+		}
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "node", ObjectID: "element-in-" + call.ObjectID}}, nil
+	})
+
+	host, err := sess.Query("cart-widget")
+	check(t, err)
+	root, err := host.ShadowRoot()
+	check(t, err)
+	if root.ID != "shadow-1" {
+		t.Fatalf("unexpected shadow root %s", root.ID)
+	}
+	// shadow root scopes queries
+	button, err := root.Query("button.buy")
+	check(t, err)
+	if call := last(); call.ObjectID != "shadow-1" || button.ID != "element-in-shadow-1" {
+		t.Fatalf("query is called on %s", call.ObjectID)
+	}
+	if _, err = button.ShadowRoot(); !errors.Is(err, cdp.ErrNoShadowRoot) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestQueryWithoutDocument(t *testing.T) {
+	t.Parallel()
+
+	// document is undefined while page is navigated
+	_, sess := fakeSession(t)
+	if _, err := sess.Query("#cart"); !errors.As(err, &cdp.NoSuchElementError{}) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := sess.QueryAll("#cart"); !errors.As(err, &cdp.NoSuchElementError{}) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestQueryInDefaultContext(t *testing.T) {
+	t.Parallel()
+
+	server, sess, last := selectorServer(t)
+	check(t, server.Emit(sess.GetID(), "Runtime.executionContextCreated", contextCreated(5, sess.ID(), "", true)))
+	// context is known once event is handled
+	waitFor(t, func() bool {
+		_, err := sess.Query("#cart")
+		check(t, err)
+		return last().ExecutionContextID == 5
+	})
+	evaluated := len(server.Received("Runtime.evaluate"))
+	_, err := sess.QueryAll("#cart")
+	check(t, err)
+	if call := last(); call.ExecutionContextID != 5 || call.ObjectID != "" {
+		t.Fatalf("queried in context %d of %s", call.ExecutionContextID, call.ObjectID)
+	}
+	if n := len(server.Received("Runtime.evaluate")); n != evaluated {
+		t.Fatal("document is evaluated in known context")
+	}
+}
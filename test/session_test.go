@@ -0,0 +1,208 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+	"github.com/ecwid/cdp/pkg/protocol"
+)
+
+func fakeSession(t *testing.T) (*cdptest.Server, *cdp.Session) {
+	t.Helper()
+	server := cdptest.NewServer()
+	t.Cleanup(server.Close)
+	chrome, err := cdp.ConnectHTTP(server.URL)
+	check(t, err)
+	t.Cleanup(func() { _ = chrome.Close() })
+	sess, err := chrome.Session()
+	check(t, err)
+	return server, sess
+}
+
+func TestConnectHTTP(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	if sess.ID() != server.Targets()[0].TargetID {
+		t.Fatalf("attached to %s", sess.ID())
+	}
+	if len(server.Received("Page.enable")) != 1 {
+		t.Fatal("Page.enable was not called")
+	}
+}
+
+func TestConnectHTTPEndpoint(t *testing.T) {
+	t.Parallel()
+
+	server := cdptest.NewServer()
+	defer server.Close()
+	chrome, err := cdp.ConnectHTTP(strings.TrimPrefix(server.URL, "http://"))
+	check(t, err)
+	defer chrome.Close()
+	_, err = chrome.Session()
+	check(t, err)
+
+	for _, endpoint := range []string{server.WebSocketURL(), "ftp://127.0.0.1:9222", "http://"} {
+		if _, err = cdp.ConnectHTTP(endpoint); err == nil {
+			t.Fatalf("%s is accepted", endpoint)
+		}
+	}
+	if _, err = cdp.ConnectHTTP(server.WebSocketURL()); !strings.Contains(err.Error(), "use Connect") {
+		t.Fatalf("not expected error: %v", err)
+	}
+}
+
+func TestCallContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	server.Handle("Runtime.evaluate", func(*cdptest.Message) (interface{}, error) {
+		return nil, cdptest.ErrNoResponse
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := sess.WithContext(ctx).Evaluate("1", false, true); err != context.DeadlineExceeded {
+		t.Fatalf("not expected error: %v", err)
+	}
+}
+
+func TestCallTimeout(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	server.Handle("Runtime.evaluate", func(*cdptest.Message) (interface{}, error) {
+		return nil, cdptest.ErrNoResponse
+	})
+	sess.SetTimeout(100 * time.Millisecond)
+	_, err := sess.Evaluate("1", false, true)
+	var timeoutErr *cdp.TimeoutError
+	if !errors.As(err, &timeoutErr) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("timeout expected, got %v", err)
+	}
+}
+
+func TestLoadTimeout(t *testing.T) {
+	t.Parallel()
+
+	_, sess := fakeSession(t)
+	sess.SetTimeout(100 * time.Millisecond)
+	// fake page never fires load event
+	err := sess.Reload()
+	if !errors.Is(err, cdp.ErrLoadTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("timeout expected, got %v", err)
+	}
+	_, err = sess.OnTargetCreated(func() {})
+	if !errors.Is(err, cdp.ErrTargetCreatedTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("timeout expected, got %v", err)
+	}
+}
+
+func TestProtocolError(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	server.Handle("Runtime.evaluate", func(*cdptest.Message) (interface{}, error) {
+		return nil, &cdptest.Error{Code: -32000, Message: "Cannot find context with specified id"}
+	})
+	_, err := sess.Evaluate("1", false, true)
+	if !errors.Is(err, cdp.ErrStaleElementReference) {
+		t.Fatalf("not expected error: %v", err)
+	}
+	var protocolErr *cdp.ProtocolError
+	if !errors.As(err, &protocolErr) || protocolErr.Code != -32000 || protocolErr.Method != "Runtime.evaluate" {
+		t.Fatalf("not expected error: %#v", err)
+	}
+
+	server.Handle("Target.closeTarget", func(*cdptest.Message) (interface{}, error) {
+		return nil, &cdptest.Error{Code: -32000, Message: "No target with given id found"}
+	})
+	check(t, sess.Close())
+}
+
+func TestProtocolErrorClassify(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	for _, tc := range []struct {
+		code    int
+		message string
+		err     error
+	}{
+		{-32000, "Cannot find context with specified id", cdp.ErrStaleElementReference},
+		{-32000, "Execution context was destroyed.", cdp.ErrStaleElementReference},
+		{-32000, "Could not find node with given id", cdp.ErrNodeNotFound},
+		{-32000, "No node with given id found", cdp.ErrNodeNotFound},
+		{-32000, "Node with given id does not belong to the document", cdp.ErrNodeNotFound},
+		{-32000, "No node found for given backend id", cdp.ErrNodeNotFound},
+		{-32000, "Target closed.", cdp.ErrTargetClosed},
+		{-32602, "No target with given id found", cdp.ErrTargetClosed},
+		{-32001, "Session with given id not found.", cdp.ErrTargetClosed},
+		{-32000, "Inspected target navigated or closed", cdp.ErrTargetClosed},
+		{-32000, "net::ERR_ABORTED", cdp.ErrNavigationAborted},
+		{-32000, "Navigation was aborted", cdp.ErrNavigationAborted},
+		{-1, "websocket: close 1006 (abnormal closure)", cdp.ErrConnectionClosed},
+		{-32000, "Object reference chain is too long", nil},
+	} {
+		tc := tc
+		server.Handle("Test.fail", func(*cdptest.Message) (interface{}, error) {
+			return nil, &cdptest.Error{Code: tc.code, Message: tc.message}
+		})
+		err := sess.Call("Test.fail", nil, nil)
+		var protocolErr *cdp.ProtocolError
+		if !errors.As(err, &protocolErr) || protocolErr.Message != tc.message {
+			t.Fatalf("%s: not expected error %#v", tc.message, err)
+		}
+		if protocolErr.Unwrap() != tc.err || (tc.err != nil && !errors.Is(err, tc.err)) {
+			t.Fatalf("%s: classified as %v", tc.message, protocolErr.Unwrap())
+		}
+		// closed target is reported as is, it is not an already closed session
+		if errors.Is(err, cdp.ErrSessionAlreadyClosed) {
+			t.Fatalf("%s: reported as closed session", tc.message)
+		}
+	}
+}
+
+func TestTypedCommand(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	result, err := (&protocol.TargetCreateTargetParams{URL: "about:blank"}).Do(sess)
+	check(t, err)
+	if result.TargetID == "" {
+		t.Fatal("target id is empty")
+	}
+	received := server.Received("Target.createTarget")
+	if len(received) != 1 {
+		t.Fatalf("Target.createTarget sent %d times", len(received))
+	}
+	params := new(protocol.TargetCreateTargetParams)
+	check(t, received[0].Decode(params))
+	if params.URL != "about:blank" {
+		t.Fatalf("unexpected url %s", params.URL)
+	}
+	check(t, protocol.Execute(sess, &protocol.PageEnableParams{}, nil))
+}
+
+func TestEventListen(t *testing.T) {
+	t.Parallel()
+
+	server, sess := fakeSession(t)
+	events, unsubscribe := sess.Listen("Page.frameNavigated")
+	defer unsubscribe()
+	frame := &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: frame}))
+	select {
+	case e := <-events:
+		if e.Method != "Page.frameNavigated" {
+			t.Fatalf("unexpected event %s", e.Method)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event was not received")
+	}
+}
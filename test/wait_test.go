@@ -0,0 +1,144 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ecwid/cdp"
+	"github.com/ecwid/cdp/pkg/cdptest"
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+// waitServer answers calls of wait functions, promise of every call must be awaited
+func waitServer(t *testing.T, answer func(call *functionCall) (interface{}, error)) (*cdptest.Server, *cdp.Session, func() *functionCall) {
+	t.Helper()
+	return functionServer(t, func(call *functionCall) (interface{}, error) {
+		if strings.Contains(call.FunctionDeclaration, "new Promise") && !call.AwaitPromise {
+			t.Error("promise of wait is not awaited")
+		}
+		return answer(call)
+	})
+}
+
+func TestWaitForSelector(t *testing.T) {
+	t.Parallel()
+
+	_, sess, last := waitServer(t, func(call *functionCall) (interface{}, error) {
+		switch call.arg(5) {
+		case `"attached"`, `"visible"`:
+			if strings.Contains(call.arg(4), "missing") {
+				return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "null"}}, nil
+			}
+			return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "object", Subtype: "node", ObjectID: "element-1"}}, nil
+		}
+		return boolean(true), nil
+	})
+
+	el, err := sess.WaitForSelector("text=Added to cart", cdp.StateAttached)
+	check(t, err)
+	if el == nil || el.ID != "element-1" {
+		t.Fatal("element was not returned")
+	}
+	if call := last(); call.arg(1) != `"mutation"` || call.arg(4) != `[[0,"Added to cart"]]` {
+		t.Fatalf("unexpected arguments %s %s", call.arg(1), call.arg(4))
+	}
+
+	_, err = sess.WaitForSelector("#cart", cdp.StateVisible)
+	check(t, err)
+	if call := last(); call.arg(1) != `"raf"` {
+		t.Fatalf("visibility is polled by %s", call.arg(1))
+	}
+
+	el, err = sess.WaitForSelector("#spinner", cdp.StateHidden)
+	check(t, err)
+	if el != nil {
+		t.Fatal("hidden element was returned")
+	}
+
+	// page resolves with null when it gives up
+	_, err = sess.WaitForSelector("#missing", cdp.StateAttached)
+	var timeout *cdp.TimeoutError
+	if !errors.As(err, &timeout) || timeout.Method != "WaitForSelector" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestWaitForFunction(t *testing.T) {
+	t.Parallel()
+
+	server, sess, last := waitServer(t, func(call *functionCall) (interface{}, error) {
+		if !strings.Contains(call.FunctionDeclaration, "new Promise") {
+			return nil, nil // cancel of polling
+		}
+		if call.arg(4) == `"never"` {
+			return nil, cdptest.ErrNoResponse
+		}
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "number", Value: 42}}, nil
+	})
+
+	v, err := sess.WaitForFunction(`(n, s) => document.querySelectorAll(s).length > n && n`, []interface{}{0, "li"}, cdp.PollingInterval(50*time.Millisecond))
+	check(t, err)
+	if v != float64(42) {
+		t.Fatalf("unexpected value %v", v)
+	}
+	call := last()
+	if !call.ReturnByValue || !strings.Contains(call.FunctionDeclaration, "querySelectorAll(s).length > n") {
+		t.Fatal("unexpected function")
+	}
+	// zero values of arguments are passed as they are
+	if call.arg(1) != `"interval"` || call.arg(2) != "50" || call.arg(4) != "0" || call.arg(5) != `"li"` {
+		t.Fatalf("unexpected arguments %s %s %s %s", call.arg(1), call.arg(2), call.arg(4), call.arg(5))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = sess.WithContext(ctx).WaitForFunction(`s => s === "done"`, []interface{}{"never"}, cdp.PollingRAF); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// polling in page is cancelled by token of call
+	calls := server.Received("Runtime.callFunctionOn")
+	polled := new(functionCall)
+	check(t, calls[len(calls)-2].Decode(polled))
+	if cancelled := last(); strings.Contains(cancelled.FunctionDeclaration, "new Promise") || cancelled.arg(0) != polled.arg(0) {
+		t.Fatalf("polling was not cancelled by %s", cancelled.FunctionDeclaration)
+	}
+}
+
+func TestWaitForFunctionNavigation(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	destroyed := make(chan struct{}, 1)
+	server, sess, _ := waitServer(t, func(call *functionCall) (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			destroyed <- struct{}{}
+			return nil, &cdptest.Error{Code: -32000, Message: "Execution context was destroyed."}
+		}
+		return devtool.EvaluatesResult{Result: &devtool.RemoteObject{Type: "number", Value: 42}}, nil
+	})
+
+	type result struct {
+		value interface{}
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		v, err := sess.WaitForFunction(`() => document.readyState === "complete" && 42`, nil, cdp.PollingRAF)
+		done <- result{v, err}
+	}()
+	// predicate is polled again in document of new page
+	<-destroyed
+	check(t, server.Emit(sess.GetID(), "Page.frameNavigated", devtool.FrameNavigated{Frame: &devtool.Frame{ID: sess.ID(), URL: "https://example.com/"}}))
+	r := <-done
+	check(t, r.err)
+	if r.value != float64(42) {
+		t.Fatalf("unexpected value %v", r.value)
+	}
+	if n := len(server.Received("Runtime.callFunctionOn")); n != 2 {
+		t.Fatalf("predicate was polled %d times", n)
+	}
+}
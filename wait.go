@@ -0,0 +1,129 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ecwid/cdp/pkg/devtool"
+)
+
+const (
+	// waitForFunction resolves with truthy result of predicate %s(...a) polled by mode m ("raf", "interval" every i milliseconds or "mutation"),
+	// or with null after t milliseconds or when it is cancelled by calling window[k], polling also stops if window[k] is removed
+	waitForFunction = `function(k,m,i,t,...a){const p=(%s);return new Promise((s,j)=>{let d=!1,o,v,w;const f=(g,x)=>{d||(d=!0,delete window[k],o&&o.disconnect(),clearInterval(v),clearTimeout(w),g(x))},c=()=>{if(d)return;if(!window[k])return f(s,null);let x;try{x=p(...a)}catch(e){return f(j,e)}x&&f(s,x)};window[k]=()=>f(s,null);t>0&&(w=setTimeout(()=>f(s,null),t));c();if(d)return;if("mutation"===m){o=new MutationObserver(c);o.observe(document,{childList:!0,subtree:!0,attributes:!0,characterData:!0})}else if("interval"===m)v=setInterval(c,i);else{const r=()=>{c();d||requestAnimationFrame(r)};requestAnimationFrame(r)}})}`
+	// waitForCancel stops polling of waitForFunction with token k
+	waitForCancel = `function(k){window[k]&&window[k]()}`
+	// selectorStatePredicate returns element matched by selectorQuery %s in state s, or true for detached and hidden states
+	selectorStatePredicate = `function(p,s){const q=(%s),v=(%s),e=q.call(document,p,!1);switch(s){case"attached":return e;case"detached":return!e;case"visible":return e&&v.call(e)&&e;case"hidden":return!e||!v.call(e)}throw new Error("unknown state "+s)}`
+)
+
+// SelectorState state of element WaitForSelector waits for
+type SelectorState string
+
+// SelectorState values
+const (
+	StateAttached SelectorState = "attached" // element is in DOM
+	StateDetached SelectorState = "detached" // element is not in DOM
+	StateVisible  SelectorState = "visible"  // element is in DOM and visible
+	StateHidden   SelectorState = "hidden"   // element is not in DOM or invisible
+)
+
+// Polling defines when predicate of WaitForFunction is evaluated in page
+type Polling struct {
+	mode     string
+	interval time.Duration
+}
+
+// Polling strategies
+var (
+	PollingRAF      = Polling{mode: "raf"}      // on every animation frame
+	PollingMutation = Polling{mode: "mutation"} // on every DOM mutation
+)
+
+// PollingInterval evaluates predicate every interval
+func PollingInterval(interval time.Duration) Polling {
+	return Polling{mode: "interval", interval: interval}
+}
+
+// waitTokens counts calls of waitFor, token names cancel hook of call in window
+var waitTokens int64
+
+// waitFor polls predicate in current execution context until it returns truthy value or session's timeout is reached,
+// if context is destroyed by navigation predicate is polled again in new document until timeout
+func (session Session) waitFor(method, predicate string, polling Polling, returnByValue bool, args ...interface{}) (*devtool.RemoteObject, error) {
+	timeout := session.deadline
+	deadline := time.Now().Add(timeout)
+	for {
+		updated := session.frames.updated()
+		left := time.Until(deadline)
+		if left <= 0 {
+			return nil, &TimeoutError{Method: method, Params: args, Deadline: timeout}
+		}
+		v, err := session.poll(predicate, polling, left, returnByValue, args...)
+		if err == nil && v.Type == "object" && v.Subtype == "null" {
+			return nil, &TimeoutError{Method: method, Params: args, Deadline: timeout}
+		}
+		if !errors.Is(err, ErrStaleElementReference) {
+			return v, err
+		}
+		select {
+		case <-updated:
+		case <-time.After(left):
+		case <-session.closed:
+			return nil, ErrSessionAlreadyClosed
+		case <-session.Context().Done():
+			return nil, session.Context().Err()
+		}
+	}
+}
+
+// poll runs waitForFunction in current execution context, in-page polling is cancelled if session's context is done
+func (session Session) poll(predicate string, polling Polling, timeout time.Duration, returnByValue bool, args ...interface{}) (*devtool.RemoteObject, error) {
+	// page gives up at timeout itself, so response must not be timed out before
+	session.deadline = timeout + time.Second
+	contextID := session.currentContext()
+	token := fmt.Sprintf("__cdp_wait_%d_%d", time.Now().UnixNano(), atomic.AddInt64(&waitTokens, 1))
+	arg := append([]interface{}{token, polling.mode, polling.interval.Milliseconds(), timeout.Milliseconds()}, args...)
+	v, err := session.callFunctionIn(contextID, fmt.Sprintf(waitForFunction, predicate), true, returnByValue, arg...)
+	if err != nil && session.Context().Err() != nil {
+		s := session.WithContext(context.Background())
+		s.deadline = time.Second
+		_, _ = s.callFunctionIn(contextID, waitForCancel, false, false, token)
+	}
+	return v, err
+}
+
+// WaitForFunction waits until JS function js called with args returns truthy value and returns it (by value),
+// function is evaluated in current execution context (see SwitchTo) with polling strategy, e.g. PollingRAF,
+// it is evaluated again in new document if page is navigated while waiting
+func (session Session) WaitForFunction(js string, args []interface{}, polling Polling) (interface{}, error) {
+	v, err := session.waitFor("WaitForFunction", js, polling, true, args...)
+	if err != nil {
+		return nil, err
+	}
+	return v.Value, nil
+}
+
+// WaitForSelector waits until element matching selector is in state and returns it,
+// returned element is nil for StateDetached and StateHidden
+func (session Session) WaitForSelector(selector string, state SelectorState) (*Element, error) {
+	engines, parts := selectorQueryArgs(selector)
+	predicate := fmt.Sprintf(selectorStatePredicate, fmt.Sprintf(selectorQuery, strings.Join(engines, ",")), atomIsVisible)
+	// visibility is also changed by layout, which is not observed by mutation observer
+	polling := PollingMutation
+	if state == StateVisible || state == StateHidden {
+		polling = PollingRAF
+	}
+	v, err := session.waitFor("WaitForSelector", predicate, polling, false, parts, string(state))
+	if err != nil {
+		return nil, err
+	}
+	if v.ObjectID == "" {
+		return nil, nil
+	}
+	return newElement(&session, session.currentContext(), v), nil
+}
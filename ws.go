@@ -1,14 +1,12 @@
 package cdp
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
-	"log"
-	"os"
-	"runtime"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -27,20 +25,42 @@ const (
 	LevelVerbose         OutLevel = 0xFF
 )
 
+// Transport sends and receives framed JSON messages of devtools protocol
+type Transport interface {
+	// Send sends one message
+	Send(message []byte) error
+	// Receive blocks until next message, returns io.EOF if connection was closed gracefully
+	Receive() ([]byte, error)
+	// Close closes connection
+	Close() error
+}
+
 // WSClient ...
 type WSClient struct {
-	WebSocketURL  string
-	conn          *websocket.Conn
-	id            int64
-	queueMutex    *sync.Mutex
-	sessionsMutex *sync.Mutex
-	send          chan []byte
-	receive       map[int64]chan *wsResponse
-	listeners     map[string]chan *wsBroadcast
-	disconnected  chan struct{}
-	err           chan error
-	out           *log.Logger
-	outLevel      OutLevel
+	WebSocketURL   string
+	transport      Transport
+	transportMutex *sync.Mutex
+	id             int64
+	queueMutex     *sync.Mutex
+	sessionsMutex  *sync.Mutex
+	send           chan []byte
+	receive        map[int64]*pendingRequest
+	listeners      map[string]*eventQueue
+	sessions       map[string]*attachedSession
+	reconnection   *reconnection
+	disconnected   chan struct{}
+	closing        chan struct{}
+	closeOnce      *sync.Once
+	err            chan error
+	log            *logConfig
+	recorder       *recorder
+}
+
+type pendingRequest struct {
+	response  chan *wsResponse
+	method    string
+	sessionID string
+	sent      time.Time
 }
 
 type wsError struct {
@@ -109,77 +129,138 @@ func (r wsResponse) isError() bool {
 
 // NewWebSocketClient ...
 func NewWebSocketClient(webSocketURL string) (*WSClient, error) {
-	conn, _, err := websocket.DefaultDialer.Dial(webSocketURL, nil)
+	return newWebSocketClient(context.Background(), webSocketURL)
+}
+
+func newWebSocketClient(ctx context.Context, webSocketURL string) (*WSClient, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, webSocketURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	ws := &WSClient{
-		WebSocketURL:  webSocketURL,
-		conn:          conn,
-		queueMutex:    &sync.Mutex{},
-		sessionsMutex: &sync.Mutex{},
-		send:          make(chan []byte),
-		receive:       make(map[int64]chan *wsResponse),
-		disconnected:  make(chan struct{}, 1),
-		listeners:     make(map[string]chan *wsBroadcast),
-		id:            0,
-		out:           log.New(os.Stderr, "", log.LstdFlags),
-		outLevel:      LevelProtocolErrors,
-	}
-	go ws.writer()
-	go ws.reader()
+	ws := newClient(&wsTransport{conn: conn})
+	ws.WebSocketURL = webSocketURL
+	ws.reconnection.dial = dialWebSocket(webSocketURL)
+	ws.start()
 	return ws, nil
 }
 
-// SetLogOutput ...
-func (w *WSClient) SetLogOutput(writer io.Writer) {
-	w.out.SetOutput(writer)
+// NewClient creates protocol client over any transport
+func NewClient(transport Transport) *WSClient {
+	ws := newClient(transport)
+	ws.start()
+	return ws
+}
+
+func newClient(transport Transport) *WSClient {
+	return &WSClient{
+		transport:      transport,
+		transportMutex: &sync.Mutex{},
+		queueMutex:     &sync.Mutex{},
+		sessionsMutex:  &sync.Mutex{},
+		send:           make(chan []byte),
+		receive:        make(map[int64]*pendingRequest),
+		disconnected:   make(chan struct{}, 1),
+		closing:        make(chan struct{}),
+		closeOnce:      &sync.Once{},
+		listeners:      make(map[string]*eventQueue),
+		sessions:       make(map[string]*attachedSession),
+		reconnection:   newReconnection(),
+		err:            make(chan error, 1),
+		id:             0,
+		log:            newLogConfig(),
+		recorder:       &recorder{mutex: &sync.Mutex{}},
+	}
+}
+
+// start runs writer and reader, client must be configured before
+func (w *WSClient) start() {
+	go w.writer()
+	go w.reader()
 }
 
-// SetLogLevel ...
-func (w *WSClient) SetLogLevel(level OutLevel) {
-	w.outLevel = level
+func (w *WSClient) getTransport() Transport {
+	w.transportMutex.Lock()
+	defer w.transportMutex.Unlock()
+	return w.transport
 }
 
-func (w WSClient) printf(level OutLevel, format string, v ...interface{}) {
-	if level&w.outLevel == level {
-		_, fn, line, _ := runtime.Caller(1)
-		w.out.Printf("%s:%d %s", fn, line, fmt.Sprintf(format, v...))
+func (w *WSClient) setTransport(transport Transport) {
+	w.transportMutex.Lock()
+	defer w.transportMutex.Unlock()
+	w.transport = transport
+}
+
+// markClosing tells reader that connection is going to be closed and must not be restored
+func (w *WSClient) markClosing() {
+	w.closeOnce.Do(func() { close(w.closing) })
+}
+
+// Close close connection gracefully, browser is still alive
+func (w *WSClient) Close() error {
+	w.markClosing()
+	err := w.getTransport().Close()
+	select {
+	case <-w.disconnected:
+	case <-time.After(5 * time.Second):
 	}
+	return err
 }
 
-func (w *WSClient) sendOverProtocol(sessionID string, method string, params interface{}) chan *wsResponse {
+func (w *WSClient) sendOverProtocol(ctx context.Context, sessionID string, method string, params interface{}) (int64, chan *wsResponse) {
 	w.queueMutex.Lock()
 	w.id++
+	id := w.id
 	response := make(chan *wsResponse, 1)
-	w.receive[w.id] = response
+	w.receive[id] = &pendingRequest{
+		response:  response,
+		method:    method,
+		sessionID: sessionID,
+		sent:      time.Now(),
+	}
 	w.queueMutex.Unlock()
 
+	w.track(sessionID, method, params)
 	request, err := json.Marshal(wsMessage{
-		ID:        w.id,
-		SessionID: sessionID,
+		ID:        id,
+		SessionID: w.route(sessionID),
 		Method:    method,
 		Params:    params,
 	})
 	if err != nil {
-		w.printf(LevelProtocolFatal, err.Error())
+		w.logf(&LogEntry{Level: LevelProtocolFatal, SessionID: sessionID, Method: method, MessageID: id, Error: err}, nil)
 		response <- &wsResponse{Error: wsError{Message: err.Error()}}
-		return response
+		return id, response
 	}
 
 	select {
 	case w.send <- request:
+		w.logf(&LogEntry{
+			Level:     LevelProtocolMessage,
+			SessionID: sessionID,
+			Method:    method,
+			MessageID: id,
+			Direction: RecordSend,
+		}, request)
 	case <-w.disconnected:
+	case <-ctx.Done():
 	}
 
-	return response
+	return id, response
+}
+
+// forget drops pending response entry, e.g. when caller is no longer waiting for it
+func (w *WSClient) forget(id int64) {
+	w.queueMutex.Lock()
+	defer w.queueMutex.Unlock()
+	delete(w.receive, id)
 }
 
 // Subscribe ...
-func (w *WSClient) register(sessionID string, events chan *wsBroadcast) {
+func (w *WSClient) register(sessionID, targetID string, events *eventQueue) {
 	w.sessionsMutex.Lock()
 	defer w.sessionsMutex.Unlock()
 	w.listeners[sessionID] = events
+	w.sessions[sessionID] = &attachedSession{targetID: targetID}
 }
 
 // Unsubscribe ...
@@ -187,26 +268,38 @@ func (w *WSClient) unregister(sessionID string) {
 	w.sessionsMutex.Lock()
 	defer w.sessionsMutex.Unlock()
 	delete(w.listeners, sessionID)
+	delete(w.sessions, sessionID)
+	w.log.mutex.Lock()
+	defer w.log.mutex.Unlock()
+	delete(w.log.levels, sessionID)
 }
 
 // Close ...
 func (w *WSClient) exception(err error) {
 	if err != nil {
-		w.printf(LevelProtocolFatal, "\033[1;31m%s\033[0m", err.Error())
+		w.logf(&LogEntry{Level: LevelProtocolFatal, Error: err}, nil)
+	}
+	select {
+	case w.err <- err:
+	default:
 	}
-	w.err <- err
 }
 
 func (w *WSClient) writer() {
 	for {
 		select {
 		case <-w.disconnected:
-			_ = w.conn.Close()
+			_ = w.getTransport().Close()
 			w.publish(&wsResponse{Error: wsError{Message: ErrConnectionClosed.Error()}})
 			return
 		case req := <-w.send:
-			w.printf(LevelProtocolMessage, "\033[1;36msend -> %s\033[0m", string(req))
-			if err := w.conn.WriteMessage(websocket.TextMessage, req); err != nil {
+			w.recorder.record(RecordSend, req)
+			if err := w.getTransport().Send(req); err != nil {
+				if w.hasReconnectPolicy() {
+					// reader restores connection, only this request is lost
+					w.dropRequest(req)
+					continue
+				}
 				w.exception(err)
 			}
 		case err := <-w.err:
@@ -227,50 +320,103 @@ func (w *WSClient) publish(response *wsResponse) {
 		Error: response.Error.Message,
 	}
 	w.sessionsMutex.Lock()
-	defer w.sessionsMutex.Unlock()
+	var queues []*eventQueue
 	if response.SessionID != "" {
-		w.listeners[response.SessionID] <- event
+		if q, has := w.listeners[response.SessionID]; has {
+			queues = append(queues, q)
+		}
 	} else {
-		for _, v := range w.listeners {
-			v <- event
+		for _, q := range w.listeners {
+			queues = append(queues, q)
 		}
 	}
+	w.sessionsMutex.Unlock()
+	// publishing never blocks, so slow session can not stall reading of connection
+	for _, q := range queues {
+		q.push(event)
+	}
 }
 
 func (w *WSClient) reader() {
 	for {
-		_, body, err := w.conn.ReadMessage()
+		body, err := w.getTransport().Receive()
 		if err != nil {
-			switch err.(type) {
-			case *websocket.CloseError:
+			if !w.isClosing() && w.reconnect(err) {
+				continue
+			}
+			if w.isClosing() || err == io.EOF {
 				close(w.disconnected)
 				// do nothing, browser was closed
 				return
-			default:
-				w.exception(err)
-				return
 			}
+			w.exception(err)
+			close(w.disconnected)
+			return
 		}
 		var response = new(wsResponse)
 		if err := json.Unmarshal(body, response); err != nil {
 			w.exception(err)
+			close(w.disconnected)
 			return
 		}
+		response.SessionID = w.unroute(response.SessionID)
 		if response.isBroadcast() {
-			w.printf(LevelProtocolEvents, "\033[1;30mevent <- %s\033[0m", string(body))
+			w.unrouteEvent(response)
+			w.recorder.record(RecordEvent, body)
+			w.logf(&LogEntry{
+				Level:     LevelProtocolEvents,
+				SessionID: response.SessionID,
+				Method:    response.Method,
+				Direction: RecordEvent,
+			}, body)
 			w.publish(response)
 		} else {
-			if response.isError() {
-				w.printf(LevelProtocolErrors, "\033[1;31mrecv_err <- %s\033[0m", string(body))
-			} else {
-				w.printf(LevelProtocolMessage, "\033[1;34mrecv <- %s\033[0m", string(body))
-			}
+			w.recorder.record(RecordReceive, body)
 			w.queueMutex.Lock()
-			if recv, has := w.receive[response.ID]; has {
-				recv <- response
+			request, has := w.receive[response.ID]
+			if has {
+				request.response <- response
 				delete(w.receive, response.ID)
 			}
 			w.queueMutex.Unlock()
+			entry := &LogEntry{
+				Level:     LevelProtocolMessage,
+				SessionID: response.SessionID,
+				MessageID: response.ID,
+				Direction: RecordReceive,
+			}
+			if has {
+				entry.Method = request.method
+				entry.Duration = time.Since(request.sent)
+			}
+			if response.isError() {
+				entry.Level = LevelProtocolErrors
+				entry.Error = response.Error
+			}
+			w.logf(entry, body)
 		}
 	}
 }
+
+// wsTransport websocket transport, browser is started with --remote-debugging-port
+type wsTransport struct {
+	conn *websocket.Conn
+}
+
+func (t *wsTransport) Send(message []byte) error {
+	return t.conn.WriteMessage(websocket.TextMessage, message)
+}
+
+func (t *wsTransport) Receive() ([]byte, error) {
+	_, body, err := t.conn.ReadMessage()
+	if _, ok := err.(*websocket.CloseError); ok {
+		return nil, io.EOF
+	}
+	return body, err
+}
+
+func (t *wsTransport) Close() error {
+	msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	_ = t.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+	return t.conn.Close()
+}